@@ -42,6 +42,7 @@ include make/go/dep_minisign.mk
 include make/go/dep_protoc.mk
 include make/go/dep_protoc_gen_go.mk
 include make/go/dep_protoc_gen_connect_go.mk
+include make/go/dep_protoc_gen_go_grpc.mk
 include make/go/go.mk
 include make/go/docker.mk
 include make/go/buf.mk
@@ -93,7 +94,7 @@ privateusage:
 postprepostgenerate:: privateusage
 
 bufgeneratedeps:: \
-	$(PROTOC_GEN_GO) $(PROTOC_GEN_CONNECT_GO)
+	$(PROTOC_GEN_GO) $(PROTOC_GEN_CONNECT_GO) $(PROTOC_GEN_GO_GRPC)
 
 .PHONY: bufgeneratecleango
 bufgeneratecleango:
@@ -110,9 +111,28 @@ bufgenerateprotogo:
 bufgenerateprotogoclient:
 	$(BUF_BIN) generate proto --template data/template/buf.go-client.gen.yaml
 
+# The Connect clients are also published for connectrpc.com/connect behind the
+# connectrpc build tag, see make/buf/scripts/connectrpc.bash.
+.PHONY: bufgenerateprotogoclientconnectrpc
+bufgenerateprotogoclientconnectrpc: bufgenerateprotogoclient
+	bash make/buf/scripts/connectrpc.bash
+
+.PHONY: bufgenerateprotogogrpc
+bufgenerateprotogogrpc:
+	$(BUF_BIN) generate proto --template data/template/buf.go-grpc.gen.yaml
+	bash make/buf/scripts/grpc.bash
+
 bufgeneratesteps:: \
 	bufgenerateprotogo \
-	bufgenerateprotogoclient
+	bufgenerateprotogoclient \
+	bufgenerateprotogoclientconnectrpc \
+	bufgenerateprotogogrpc
+
+# The Connect clients for connectrpc.com/connect are not part of the default build,
+# so they are built separately with the connectrpc build tag.
+.PHONY: buildconnectrpc
+buildconnectrpc:
+	go build -tags connectrpc ./private/gen/proto/connect/...
 
 .PHONY: bufrelease
 bufrelease: $(MINISIGN)
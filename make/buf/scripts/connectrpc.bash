@@ -0,0 +1,56 @@
+#!/usr/bin/env bash
+
+# Dual-publishes the generated Connect clients for github.com/bufbuild/connect-go
+# and connectrpc.com/connect.
+#
+# Every FILE.connect.go file generated for github.com/bufbuild/connect-go is
+# constrained to builds without the connectrpc build tag, and copied to a
+# FILE.connectrpc.go file that imports connectrpc.com/connect and is constrained
+# to builds with the connectrpc build tag. Both versions of the API are the same,
+# so that code that imports the generated packages can be migrated to
+# connectrpc.com/connect independently of this module.
+
+set -euo pipefail
+
+DIR="$(CDPATH= cd "$(dirname "${0}")/../../.." && pwd)"
+cd "${DIR}"
+
+GEN_DIR="private/gen/proto/connect"
+LEGACY_IMPORT='connect_go "github.com/bufbuild/connect-go"'
+CONNECTRPC_IMPORT='connect_go "connectrpc.com/connect"'
+GENERATED_LINE='// Code generated by protoc-gen-connect-go. DO NOT EDIT.'
+
+# add_build_constraint adds the build constraint before the generated code
+# comment, so that it stays after the license header.
+add_build_constraint() {
+  local file_path="${1}"
+  local constraint="${2}"
+  if grep -q '^//go:build ' "${file_path}"; then
+    return
+  fi
+  awk -v constraint="${constraint}" -v generated_line="${GENERATED_LINE}" '
+    !done && $0 == generated_line {
+      print constraint
+      print ""
+      done = 1
+    }
+    { print }
+  ' "${file_path}" > "${file_path}.tmp"
+  mv "${file_path}.tmp" "${file_path}"
+}
+
+find "${GEN_DIR}" -name '*.connectrpc.go' -delete
+for file_path in $(find "${GEN_DIR}" -name '*.connect.go' | sort); do
+  if ! grep -q "${LEGACY_IMPORT}" "${file_path}"; then
+    echo "${file_path} does not import github.com/bufbuild/connect-go" >&2
+    exit 1
+  fi
+  add_build_constraint "${file_path}" '//go:build !connectrpc'
+  connectrpc_file_path="${file_path%.connect.go}.connectrpc.go"
+  sed \
+    -e "s#^//go:build !connectrpc\$#//go:build connectrpc#" \
+    -e "s#${LEGACY_IMPORT}#${CONNECTRPC_IMPORT}#" \
+    "${file_path}" > "${connectrpc_file_path}"
+  # the imports need to be sorted again as the import path changed
+  gofmt -w "${connectrpc_file_path}"
+done
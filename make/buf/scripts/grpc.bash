@@ -0,0 +1,44 @@
+#!/usr/bin/env bash
+
+# Moves the grpc-go stubs generated by protoc-gen-go-grpc into their own packages.
+#
+# protoc-gen-go-grpc generates the stubs in the package of the messages. The stubs
+# for the package DIR generated to private/gen/proto/grpc/DIR are moved to the
+# package NAMEgrpc in private/gen/proto/grpc/DIR/NAMEgrpc, where NAME is the name
+# of the package of the messages, in the same way as the Connect clients are
+# generated to private/gen/proto/connect/DIR/NAMEconnect. The references to the
+# messages of the package are qualified with the package of the messages.
+
+set -euo pipefail
+
+DIR="$(CDPATH= cd "$(dirname "${0}")/../../.." && pwd)"
+cd "${DIR}"
+
+MODULE="github.com/bufbuild/buf"
+GO_GEN_DIR="private/gen/proto/go"
+GRPC_GEN_DIR="private/gen/proto/grpc"
+
+# the stubs that were already moved are in directories that end with grpc
+for dir_path in $(find "${GRPC_GEN_DIR}" -name '*_grpc.pb.go' -exec dirname {} \; | grep -v 'grpc$' | sort -u); do
+  proto_dir="${dir_path#${GRPC_GEN_DIR}/}"
+  go_dir="${GO_GEN_DIR}/${proto_dir}"
+  name="$(go list -f '{{.Name}}' "./${go_dir}")"
+  grpc_dir="${dir_path}/${name}grpc"
+  mkdir -p "${grpc_dir}"
+  # the names of the messages and enums of the package
+  type_names="$(grep -h -E -o '^type [A-Za-z0-9_]+ (struct|int32)' "${go_dir}"/*.pb.go | cut -f 2 -d ' ' | sort -u)"
+  for file_path in "${dir_path}"/*_grpc.pb.go; do
+    grpc_file_path="${grpc_dir}/$(basename "${file_path}")"
+    sed \
+      -e "s#^package ${name}\$#package ${name}grpc#" \
+      -e "s#^import (\$#import (\n\t${name} \"${MODULE}/${go_dir}\"#" \
+      "${file_path}" > "${grpc_file_path}"
+    rm "${file_path}"
+    for type_name in ${type_names}; do
+      if grep -q -w "${type_name}" "${grpc_file_path}"; then
+        gofmt -r "${type_name} -> ${name}.${type_name}" -w "${grpc_file_path}"
+      fi
+    done
+    gofmt -w "${grpc_file_path}"
+  done
+done
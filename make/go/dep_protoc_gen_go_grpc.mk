@@ -0,0 +1,24 @@
+# Must be set
+$(call _assert_var,MAKEGO)
+$(call _conditional_include,$(MAKEGO)/base.mk)
+$(call _assert_var,CACHE_VERSIONS)
+$(call _assert_var,CACHE_BIN)
+
+# Settable
+# https://github.com/grpc/grpc-go/releases
+PROTOC_GEN_GO_GRPC_VERSION ?= v1.3.0
+# https://github.com/grpc/grpc-go/releases
+GRPC_VERSION ?= v1.56.3
+
+GO_GET_PKGS := $(GO_GET_PKGS) \
+	google.golang.org/grpc@$(GRPC_VERSION)
+
+PROTOC_GEN_GO_GRPC := $(CACHE_VERSIONS)/protoc-gen-go-grpc/$(PROTOC_GEN_GO_GRPC_VERSION)
+$(PROTOC_GEN_GO_GRPC):
+	@rm -f $(CACHE_BIN)/protoc-gen-go-grpc
+	GOBIN=$(CACHE_BIN) go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@$(PROTOC_GEN_GO_GRPC_VERSION)
+	@rm -rf $(dir $(PROTOC_GEN_GO_GRPC))
+	@mkdir -p $(dir $(PROTOC_GEN_GO_GRPC))
+	@touch $(PROTOC_GEN_GO_GRPC)
+
+dockerdeps:: $(PROTOC_GEN_GO_GRPC)
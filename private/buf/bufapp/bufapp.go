@@ -16,7 +16,9 @@ package bufapp
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/bufbuild/buf/private/pkg/app/appname"
 	"github.com/bufbuild/buf/private/pkg/cert/certclient"
@@ -28,18 +30,104 @@ const currentVersion = "v1"
 type ExternalConfig struct {
 	// If editing ExternalConfig, make sure to update ExternalConfig.IsEmpty!
 
-	Version string                             `json:"version,omitempty" yaml:"version,omitempty"`
-	TLS     certclient.ExternalClientTLSConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Version           string                             `json:"version,omitempty" yaml:"version,omitempty"`
+	TLS               certclient.ExternalClientTLSConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
+	ManifestSignature ExternalManifestSignatureConfig    `json:"manifest_signature,omitempty" yaml:"manifest_signature,omitempty"`
+	// CredentialHelper is the command line of the credential helper that provides
+	// the tokens of remotes.
+	CredentialHelper string `json:"credential_helper,omitempty" yaml:"credential_helper,omitempty"`
+	// Timeouts are the timeouts of registry RPCs, git fetches, and plugins.
+	Timeouts ExternalTimeoutsConfig `json:"timeouts,omitempty" yaml:"timeouts,omitempty"`
 }
 
 // IsEmpty returns true if the externalConfig is empty.
 func (e ExternalConfig) IsEmpty() bool {
-	return e.Version == "" && e.TLS.IsEmpty()
+	return e.Version == "" &&
+		e.TLS.IsEmpty() &&
+		e.ManifestSignature.IsEmpty() &&
+		e.CredentialHelper == "" &&
+		e.Timeouts.IsEmpty()
+}
+
+// ExternalManifestSignatureConfig is an external config for the manifest signatures
+// that downloaded modules are required to have.
+type ExternalManifestSignatureConfig struct {
+	// Key is the path to the PEM-encoded public key the manifests must be signed by.
+	Key string `json:"key,omitempty" yaml:"key,omitempty"`
+	// CertificateIdentity is the email or URI identity of the certificate the manifests
+	// must be signed by.
+	CertificateIdentity string `json:"certificate_identity,omitempty" yaml:"certificate_identity,omitempty"`
+	// CertificateRoots is the path to the PEM-encoded root certificates to verify signing
+	// certificates with. Defaults to the system roots.
+	CertificateRoots string `json:"certificate_roots,omitempty" yaml:"certificate_roots,omitempty"`
+}
+
+// IsEmpty returns true if the externalManifestSignatureConfig is empty.
+func (e ExternalManifestSignatureConfig) IsEmpty() bool {
+	return e.Key == "" && e.CertificateIdentity == "" && e.CertificateRoots == ""
+}
+
+// ExternalTimeoutsConfig is an external config for timeouts.
+//
+// Each timeout is a duration such as "30s". The environment variables and
+// flags for timeouts take precedence over these.
+type ExternalTimeoutsConfig struct {
+	// Registry is the timeout of each registry RPC.
+	Registry string `json:"registry,omitempty" yaml:"registry,omitempty"`
+	// RegistryRemotes are the timeouts of each registry RPC to specific remotes,
+	// keyed by remote. These take precedence over Registry.
+	RegistryRemotes map[string]string `json:"registry_remotes,omitempty" yaml:"registry_remotes,omitempty"`
+	// RegistryConnect is the timeout of establishing a connection to the registry.
+	RegistryConnect string `json:"registry_connect,omitempty" yaml:"registry_connect,omitempty"`
+	// RegistryRead is the timeout of waiting for the response headers of the registry.
+	RegistryRead string `json:"registry_read,omitempty" yaml:"registry_read,omitempty"`
+	// InputGit is the timeout of each git fetch of an input.
+	InputGit string `json:"input_git,omitempty" yaml:"input_git,omitempty"`
+	// Plugin is the timeout of each plugin execution.
+	Plugin string `json:"plugin,omitempty" yaml:"plugin,omitempty"`
+}
+
+// IsEmpty returns true if the externalTimeoutsConfig is empty.
+func (e ExternalTimeoutsConfig) IsEmpty() bool {
+	return e.Registry == "" &&
+		len(e.RegistryRemotes) == 0 &&
+		e.RegistryConnect == "" &&
+		e.RegistryRead == "" &&
+		e.InputGit == "" &&
+		e.Plugin == ""
 }
 
 // Config is a config.
 type Config struct {
 	TLS *tls.Config
+	// ManifestSignature is nil if manifest signatures are not required.
+	ManifestSignature *ManifestSignatureConfig
+	// CredentialHelper is empty if no credential helper is configured.
+	CredentialHelper string
+	Timeouts         TimeoutsConfig
+}
+
+// ManifestSignatureConfig is a config for the manifest signatures that downloaded
+// modules are required to have.
+//
+// Exactly one of KeyFilePath and CertificateIdentity is set.
+type ManifestSignatureConfig struct {
+	KeyFilePath         string
+	CertificateIdentity string
+	// CertificateRootsFilePath is only set with CertificateIdentity, and may be empty.
+	CertificateRootsFilePath string
+}
+
+// TimeoutsConfig is a config for timeouts.
+//
+// A timeout of 0 means that the timeout is not configured.
+type TimeoutsConfig struct {
+	Registry        time.Duration
+	RegistryRemotes map[string]time.Duration
+	RegistryConnect time.Duration
+	RegistryRead    time.Duration
+	InputGit        time.Duration
+	Plugin          time.Duration
 }
 
 // NewConfig returns a new Config for the ExternalConfig.
@@ -54,7 +142,83 @@ func NewConfig(
 	if err != nil {
 		return nil, err
 	}
+	manifestSignatureConfig, err := newManifestSignatureConfig(externalConfig.ManifestSignature)
+	if err != nil {
+		return nil, fmt.Errorf("buf configuration at %q: %w", container.ConfigDirPath(), err)
+	}
+	timeoutsConfig, err := newTimeoutsConfig(externalConfig.Timeouts)
+	if err != nil {
+		return nil, fmt.Errorf("buf configuration at %q: %w", container.ConfigDirPath(), err)
+	}
 	return &Config{
-		TLS: tlsConfig,
+		TLS:               tlsConfig,
+		ManifestSignature: manifestSignatureConfig,
+		CredentialHelper:  externalConfig.CredentialHelper,
+		Timeouts:          timeoutsConfig,
 	}, nil
 }
+
+func newManifestSignatureConfig(
+	externalConfig ExternalManifestSignatureConfig,
+) (*ManifestSignatureConfig, error) {
+	if externalConfig.IsEmpty() {
+		return nil, nil
+	}
+	if (externalConfig.Key == "") == (externalConfig.CertificateIdentity == "") {
+		return nil, errors.New("exactly one of manifest_signature.key or manifest_signature.certificate_identity must be set")
+	}
+	if externalConfig.CertificateRoots != "" && externalConfig.CertificateIdentity == "" {
+		return nil, errors.New("manifest_signature.certificate_roots requires manifest_signature.certificate_identity")
+	}
+	return &ManifestSignatureConfig{
+		KeyFilePath:              externalConfig.Key,
+		CertificateIdentity:      externalConfig.CertificateIdentity,
+		CertificateRootsFilePath: externalConfig.CertificateRoots,
+	}, nil
+}
+
+func newTimeoutsConfig(externalConfig ExternalTimeoutsConfig) (TimeoutsConfig, error) {
+	var timeoutsConfig TimeoutsConfig
+	for _, field := range []struct {
+		key           string
+		externalValue string
+		timeout       *time.Duration
+	}{
+		{"registry", externalConfig.Registry, &timeoutsConfig.Registry},
+		{"registry_connect", externalConfig.RegistryConnect, &timeoutsConfig.RegistryConnect},
+		{"registry_read", externalConfig.RegistryRead, &timeoutsConfig.RegistryRead},
+		{"input_git", externalConfig.InputGit, &timeoutsConfig.InputGit},
+		{"plugin", externalConfig.Plugin, &timeoutsConfig.Plugin},
+	} {
+		timeout, err := parseTimeout("timeouts."+field.key, field.externalValue)
+		if err != nil {
+			return TimeoutsConfig{}, err
+		}
+		*field.timeout = timeout
+	}
+	if len(externalConfig.RegistryRemotes) > 0 {
+		timeoutsConfig.RegistryRemotes = make(map[string]time.Duration, len(externalConfig.RegistryRemotes))
+		for remote, externalValue := range externalConfig.RegistryRemotes {
+			timeout, err := parseTimeout("timeouts.registry_remotes."+remote, externalValue)
+			if err != nil {
+				return TimeoutsConfig{}, err
+			}
+			timeoutsConfig.RegistryRemotes[remote] = timeout
+		}
+	}
+	return timeoutsConfig, nil
+}
+
+func parseTimeout(key string, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	if timeout < 0 {
+		return 0, fmt.Errorf("invalid value for %s: duration must not be negative: %q", key, value)
+	}
+	return timeout, nil
+}
@@ -16,10 +16,83 @@ package bufapp
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestExternalConfigIsEmpty(t *testing.T) {
 	assert.True(t, ExternalConfig{}.IsEmpty())
+	assert.False(t, ExternalConfig{CredentialHelper: "docker-credential-pass"}.IsEmpty())
+	assert.False(t, ExternalConfig{Timeouts: ExternalTimeoutsConfig{Plugin: "1m"}}.IsEmpty())
+}
+
+func TestNewManifestSignatureConfig(t *testing.T) {
+	t.Parallel()
+	manifestSignatureConfig, err := newManifestSignatureConfig(ExternalManifestSignatureConfig{})
+	assert.NoError(t, err)
+	assert.Nil(t, manifestSignatureConfig)
+	manifestSignatureConfig, err = newManifestSignatureConfig(
+		ExternalManifestSignatureConfig{
+			CertificateIdentity: "ci@example.com",
+			CertificateRoots:    "roots.pem",
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		&ManifestSignatureConfig{
+			CertificateIdentity:      "ci@example.com",
+			CertificateRootsFilePath: "roots.pem",
+		},
+		manifestSignatureConfig,
+	)
+	_, err = newManifestSignatureConfig(
+		ExternalManifestSignatureConfig{
+			Key:                 "key.pem",
+			CertificateIdentity: "ci@example.com",
+		},
+	)
+	assert.Error(t, err)
+	_, err = newManifestSignatureConfig(
+		ExternalManifestSignatureConfig{
+			Key:              "key.pem",
+			CertificateRoots: "roots.pem",
+		},
+	)
+	assert.Error(t, err)
+}
+
+func TestNewTimeoutsConfig(t *testing.T) {
+	t.Parallel()
+	timeoutsConfig, err := newTimeoutsConfig(ExternalTimeoutsConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, TimeoutsConfig{}, timeoutsConfig)
+	timeoutsConfig, err = newTimeoutsConfig(
+		ExternalTimeoutsConfig{
+			Registry: "30s",
+			RegistryRemotes: map[string]string{
+				"buf.example.com": "2m",
+			},
+			InputGit: "5m",
+			Plugin:   "1m",
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		TimeoutsConfig{
+			Registry: 30 * time.Second,
+			RegistryRemotes: map[string]time.Duration{
+				"buf.example.com": 2 * time.Minute,
+			},
+			InputGit: 5 * time.Minute,
+			Plugin:   time.Minute,
+		},
+		timeoutsConfig,
+	)
+	_, err = newTimeoutsConfig(ExternalTimeoutsConfig{RegistryConnect: "forever"})
+	assert.ErrorContains(t, err, "timeouts.registry_connect")
+	_, err = newTimeoutsConfig(ExternalTimeoutsConfig{RegistryRemotes: map[string]string{"buf.example.com": "-1s"}})
+	assert.ErrorContains(t, err, "timeouts.registry_remotes.buf.example.com")
 }
@@ -17,16 +17,22 @@ package bufcli
 import (
 	"bufio"
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bufbuild/buf/private/buf/bufapp"
 	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/buf/bufshard"
 	"github.com/bufbuild/buf/private/buf/bufwire"
+	"github.com/bufbuild/buf/private/buf/bufwork"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufapimodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint"
@@ -35,11 +41,14 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagebuild"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulecache"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/bufpkg/buftransport"
 	"github.com/bufbuild/buf/private/gen/data/datawkt"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
 	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
 	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
@@ -50,6 +59,8 @@ import (
 	"github.com/bufbuild/buf/private/pkg/filelock"
 	"github.com/bufbuild/buf/private/pkg/git"
 	"github.com/bufbuild/buf/private/pkg/httpauth"
+	"github.com/bufbuild/buf/private/pkg/importcycle"
+	"github.com/bufbuild/buf/private/pkg/manifest"
 	"github.com/bufbuild/buf/private/pkg/netrc"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/storage"
@@ -58,6 +69,7 @@ import (
 	"github.com/bufbuild/buf/private/pkg/transport/http/httpclient"
 	"github.com/bufbuild/connect-go"
 	"github.com/spf13/pflag"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"golang.org/x/term"
 )
@@ -70,14 +82,47 @@ const (
 	inputHTTPSPasswordEnvKey      = "BUF_INPUT_HTTPS_PASSWORD"
 	inputSSHKeyFileEnvKey         = "BUF_INPUT_SSH_KEY_FILE"
 	inputSSHKnownHostsFilesEnvKey = "BUF_INPUT_SSH_KNOWN_HOSTS_FILES"
+	inputGitTimeoutEnvKey         = "BUF_INPUT_GIT_TIMEOUT"
+
+	// registryTimeoutEnvKey is the timeout of each registry RPC. The value is either
+	// a duration such as "30s", or a comma-separated list of remote=duration pairs
+	// with an optional default, such as "30s,buf.example.com=2m".
+	registryTimeoutEnvKey        = "BUF_REGISTRY_TIMEOUT"
+	registryConnectTimeoutEnvKey = "BUF_REGISTRY_CONNECT_TIMEOUT"
+	registryReadTimeoutEnvKey    = "BUF_REGISTRY_READ_TIMEOUT"
+	// registryRateLimitEnvKey is the maximum number of registry RPCs per second.
+	registryRateLimitEnvKey = "BUF_REGISTRY_RATE_LIMIT"
+	// registryMaxConcurrentRequestsEnvKey is the maximum number of registry RPCs in flight at once.
+	registryMaxConcurrentRequestsEnvKey = "BUF_REGISTRY_MAX_CONCURRENT_REQUESTS"
+
+	// registryMaxRetries is the maximum number of retries of a registry RPC
+	// that was rejected with a Retry-After header.
+	registryMaxRetries = 3
+	// registryMaxRetryAfter is the longest Retry-After that is waited for
+	// before retrying a registry RPC.
+	registryMaxRetryAfter = time.Minute
 
 	alphaSuppressWarningsEnvKey = "BUF_ALPHA_SUPPRESS_WARNINGS"
 	betaSuppressWarningsEnvKey  = "BUF_BETA_SUPPRESS_WARNINGS"
 
 	// AlphaEnableWASMEnvKey is an env var to enable WASM local plugin execution
 	AlphaEnableWASMEnvKey = "BUF_ALPHA_ENABLE_WASM"
+	// DebugHTTPEnvKey is an env var to log the requests and responses of registry RPCs
+	DebugHTTPEnvKey = "BUF_DEBUG_HTTP"
+	// DebugHTTPFlagName is the flag that sets DebugHTTPEnvKey.
+	DebugHTTPFlagName = "debug-http"
+	// tokenEnvKey is the env var for the tokens of the remotes, which is read
+	// by bufconnect.NewTokenProviderFromContainer.
+	tokenEnvKey = "BUF_TOKEN"
+	// NoPromptEnvKey is an env var to never prompt for credentials, and fail with an
+	// authentication error instead.
+	NoPromptEnvKey = "BUF_NO_PROMPT"
+	// NoPromptFlagName is the flag that sets NoPromptEnvKey.
+	NoPromptFlagName = "no-prompt"
 	// BetaEnableTamperProofingEnvKey is an env var to enable tamper proofing
 	BetaEnableTamperProofingEnvKey = "BUF_BETA_ENABLE_TAMPER_PROOFING"
+	// PluginTimeoutEnvKey is an env var for the default timeout of each plugin execution
+	PluginTimeoutEnvKey = "BUF_PLUGIN_TIMEOUT"
 
 	inputHashtagFlagName      = "__hashtag__"
 	inputHashtagFlagShortName = "#"
@@ -87,6 +132,10 @@ const (
 	publicVisibility  = "public"
 	privateVisibility = "private"
 
+	warningsError  = "error"
+	warningsPrint  = "print"
+	warningsIgnore = "ignore"
+
 	// WASMCompilationCacheDir compiled WASM plugin cache directory
 	WASMCompilationCacheDir = "wasmplugin-bin"
 )
@@ -97,13 +146,16 @@ var (
 	defaultHTTPClient = &http.Client{}
 	// defaultHTTPAuthenticator is the default authenticator
 	// used for HTTP requests.
-	defaultHTTPAuthenticator = httpauth.NewMultiAuthenticator(
-		httpauth.NewNetrcAuthenticator(),
-		// must keep this for legacy purposes
-		httpauth.NewEnvAuthenticator(
-			inputHTTPSPasswordEnvKey,
-			inputHTTPSPasswordEnvKey,
+	defaultHTTPAuthenticator = httpauth.NewPromptAuthenticator(
+		httpauth.NewMultiAuthenticator(
+			httpauth.NewNetrcAuthenticator(),
+			// must keep this for legacy purposes
+			httpauth.NewEnvAuthenticator(
+				inputHTTPSPasswordEnvKey,
+				inputHTTPSPasswordEnvKey,
+			),
 		),
+		promptHTTPCredentials,
 	)
 	// defaultGitClonerOptions defines the default git clone options.
 	defaultGitClonerOptions = git.ClonerOptions{
@@ -111,6 +163,8 @@ var (
 		HTTPSPasswordEnvKey:      inputHTTPSPasswordEnvKey,
 		SSHKeyFileEnvKey:         inputSSHKeyFileEnvKey,
 		SSHKnownHostsFilesEnvKey: inputSSHKnownHostsFilesEnvKey,
+		TimeoutEnvKey:            inputGitTimeoutEnvKey,
+		NoPromptEnvKey:           NoPromptEnvKey,
 	}
 
 	// AllCacheModuleRelDirPaths are all directory paths for all time concerning the module cache.
@@ -165,12 +219,23 @@ var (
 	// This directory replaces the use of v1CacheModuleDataRelDirPath, v1CacheModuleLockRelDirPath, and
 	// v1CacheModuleSumRelDirPath for modules which support tamper proofing.
 	v2CacheModuleRelDirPath = normalpath.Join("v2", "module")
+	// v1CacheManifestDigestRelFilePath is the relative path to the cache file where the digests
+	// of local files are stored for building manifests.
+	//
+	// Normalized.
+	v1CacheManifestDigestRelFilePath = normalpath.Join("v1", "manifest", "digests.json")
 
 	// allVisibiltyStrings are the possible options that a user can set the visibility flag with.
 	allVisibiltyStrings = []string{
 		publicVisibility,
 		privateVisibility,
 	}
+
+	allWarningsStrings = []string{
+		warningsIgnore,
+		warningsPrint,
+		warningsError,
+	}
 )
 
 // GlobalFlags contains global flags for buf commands.
@@ -283,6 +348,128 @@ func BindVisibility(flagSet *pflag.FlagSet, addr *string, flagName string) {
 	)
 }
 
+// BindWarnings binds the warnings flag.
+func BindWarnings(flagSet *pflag.FlagSet, addr *string, flagName string) {
+	flagSet.StringVar(
+		addr,
+		flagName,
+		warningsIgnore,
+		fmt.Sprintf(
+			`How to handle compiler warnings, such as unused imports. Must be one of %s.
+"print" prints warnings to stderr, "error" prints warnings and fails`,
+			stringutil.SliceToString(allWarningsStrings),
+		),
+	)
+}
+
+// BindKeepGoing binds the keep-going flag.
+func BindKeepGoing(flagSet *pflag.FlagSet, addr *bool, flagName string) {
+	flagSet.BoolVar(
+		addr,
+		flagName,
+		false,
+		"Process every module of a workspace even if some modules fail to build, and report the failures of all modules at the end",
+	)
+}
+
+// BindMaxFailures binds the max-failures flag.
+func BindMaxFailures(flagSet *pflag.FlagSet, addr *int, flagName string) {
+	flagSet.IntVar(
+		addr,
+		flagName,
+		0,
+		"The maximum number of failures to print. The number of failures that are not printed is written to stderr. Defaults to printing all failures",
+	)
+}
+
+// BindParallelism binds the parallelism flag.
+func BindParallelism(flagSet *pflag.FlagSet, addr *int, flagName string) {
+	flagSet.IntVar(
+		addr,
+		flagName,
+		1,
+		"The number of modules of a workspace to process at once. The output is the same as if the modules were processed one at a time",
+	)
+}
+
+// BindShard binds the shard flag.
+func BindShard(flagSet *pflag.FlagSet, addr *string, flagName string) {
+	flagSet.StringVar(
+		addr,
+		flagName,
+		"",
+		`Only process the files in the given shard of the input, such as "3/8" for the third of eight shards.
+Files are assigned to shards by Protobuf package. A fingerprint of the shard is printed to stderr so that missing shards can be detected`,
+	)
+}
+
+// ParseShardFlag parses the value of the shard flag.
+//
+// Returns nil if the value is empty.
+func ParseShardFlag(value string, flagName string) (*bufshard.Shard, error) {
+	if value == "" {
+		return nil, nil
+	}
+	shard, err := bufshard.ParseShard(value)
+	if err != nil {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s: %v", flagName, err)
+	}
+	return shard, nil
+}
+
+// PrintShardFingerprint prints the Fingerprint of the shard for the Images to stderr.
+func PrintShardFingerprint(
+	container app.StderrContainer,
+	shard *bufshard.Shard,
+	images ...bufimage.Image,
+) error {
+	fingerprint, err := bufshard.NewFingerprint(shard, images...)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(container.Stderr(), fingerprint.String())
+	return err
+}
+
+// ValidateParallelismFlag validates the parallelism flag.
+func ValidateParallelismFlag(parallelism int, flagName string) error {
+	if parallelism < 1 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must be at least 1", flagName)
+	}
+	return nil
+}
+
+// ValidateMaxFailuresFlag validates the max-failures flag.
+func ValidateMaxFailuresFlag(maxFailures int, flagName string) error {
+	if maxFailures < 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must not be negative", flagName)
+	}
+	return nil
+}
+
+// LimitFileAnnotations returns the first maxFailures FileAnnotations, and
+// writes the number of FileAnnotations that were dropped to stderr.
+//
+// All of the FileAnnotations are returned if maxFailures is 0, see BindMaxFailures.
+func LimitFileAnnotations(
+	container app.StderrContainer,
+	fileAnnotations []bufanalysis.FileAnnotation,
+	maxFailures int,
+) ([]bufanalysis.FileAnnotation, error) {
+	if maxFailures == 0 || len(fileAnnotations) <= maxFailures {
+		return fileAnnotations, nil
+	}
+	if _, err := fmt.Fprintf(
+		container.Stderr(),
+		"%d of %d failures were not printed.\n",
+		len(fileAnnotations)-maxFailures,
+		len(fileAnnotations),
+	); err != nil {
+		return nil, err
+	}
+	return fileAnnotations[:maxFailures], nil
+}
+
 // GetInputLong gets the long command description for an input-based command.
 func GetInputLong(inputArgDescription string) string {
 	return fmt.Sprintf(
@@ -390,8 +577,13 @@ func NewWireImageConfigReader(
 	storageosProvider storageos.Provider,
 	runner command.Runner,
 	clientConfig *connectclient.Config,
+	options ...bufwire.ImageConfigReaderOption,
 ) (bufwire.ImageConfigReader, error) {
 	logger := container.Logger()
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
 	moduleResolver := bufapimodule.NewModuleResolver(
 		logger,
 		bufapimodule.NewRepositoryCommitServiceClientFactory(clientConfig),
@@ -403,10 +595,12 @@ func NewWireImageConfigReader(
 	return bufwire.NewImageConfigReader(
 		logger,
 		storageosProvider,
-		newFetchReader(logger, storageosProvider, runner, moduleResolver, moduleReader),
+		newFetchReader(logger, storageosProvider, runner, newGitClonerOptions(config), moduleResolver, moduleReader),
+		git.NewLister(runner),
 		bufmodulebuild.NewModuleBucketBuilder(),
 		bufmodulebuild.NewModuleFileSetBuilder(logger, moduleReader),
 		bufimagebuild.NewBuilder(logger),
+		options...,
 	), nil
 }
 
@@ -418,6 +612,10 @@ func NewWireModuleConfigReader(
 	clientConfig *connectclient.Config,
 ) (bufwire.ModuleConfigReader, error) {
 	logger := container.Logger()
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
 	moduleResolver := bufapimodule.NewModuleResolver(
 		logger,
 		bufapimodule.NewRepositoryCommitServiceClientFactory(clientConfig),
@@ -429,7 +627,8 @@ func NewWireModuleConfigReader(
 	return bufwire.NewModuleConfigReader(
 		logger,
 		storageosProvider,
-		newFetchReader(logger, storageosProvider, runner, moduleResolver, moduleReader),
+		newFetchReader(logger, storageosProvider, runner, newGitClonerOptions(config), moduleResolver, moduleReader),
+		git.NewLister(runner),
 		bufmodulebuild.NewModuleBucketBuilder(),
 	), nil
 }
@@ -444,6 +643,10 @@ func NewWireModuleConfigReaderForModuleReader(
 	moduleReader bufmodule.ModuleReader,
 ) (bufwire.ModuleConfigReader, error) {
 	logger := container.Logger()
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
 	moduleResolver := bufapimodule.NewModuleResolver(
 		logger,
 		bufapimodule.NewRepositoryCommitServiceClientFactory(clientConfig),
@@ -451,7 +654,8 @@ func NewWireModuleConfigReaderForModuleReader(
 	return bufwire.NewModuleConfigReader(
 		logger,
 		storageosProvider,
-		newFetchReader(logger, storageosProvider, runner, moduleResolver, moduleReader),
+		newFetchReader(logger, storageosProvider, runner, newGitClonerOptions(config), moduleResolver, moduleReader),
+		git.NewLister(runner),
 		bufmodulebuild.NewModuleBucketBuilder(),
 	), nil
 }
@@ -464,6 +668,10 @@ func NewWireFileLister(
 	clientConfig *connectclient.Config,
 ) (bufwire.FileLister, error) {
 	logger := container.Logger()
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
 	moduleResolver := bufapimodule.NewModuleResolver(
 		logger,
 		bufapimodule.NewRepositoryCommitServiceClientFactory(clientConfig),
@@ -475,7 +683,8 @@ func NewWireFileLister(
 	return bufwire.NewFileLister(
 		logger,
 		storageosProvider,
-		newFetchReader(logger, storageosProvider, runner, moduleResolver, moduleReader),
+		newFetchReader(logger, storageosProvider, runner, newGitClonerOptions(config), moduleResolver, moduleReader),
+		git.NewLister(runner),
 		bufmodulebuild.NewModuleBucketBuilder(),
 		bufmodulebuild.NewModuleFileSetBuilder(logger, moduleReader),
 		bufimagebuild.NewBuilder(logger),
@@ -587,6 +796,20 @@ func newModuleReaderAndCreateCacheDirs(
 	if tamperProofingEnabled {
 		moduleReaderOpts = append(moduleReaderOpts, bufapimodule.WithTamperProofing())
 	}
+	signatureVerifier, err := getRequiredSignatureVerifier(container)
+	if err != nil {
+		return nil, err
+	}
+	if signatureVerifier != nil {
+		if !tamperProofingEnabled {
+			return nil, fmt.Errorf(
+				"manifest_signature in the buf configuration at %q requires %s to be set to true",
+				container.ConfigDirPath(),
+				BetaEnableTamperProofingEnvKey,
+			)
+		}
+		moduleReaderOpts = append(moduleReaderOpts, bufapimodule.WithManifestSignatureVerifier(signatureVerifier))
+	}
 	delegateReader := bufapimodule.NewModuleReader(
 		bufapimodule.NewDownloadServiceClientFactory(clientConfig),
 		moduleReaderOpts...,
@@ -599,12 +822,20 @@ func newModuleReaderAndCreateCacheDirs(
 		if err != nil {
 			return nil, err
 		}
+		var casModuleReaderOpts []bufmodulecache.CASModuleReaderOption
+		if signatureVerifier != nil {
+			casModuleReaderOpts = append(
+				casModuleReaderOpts,
+				bufmodulecache.CASModuleReaderWithManifestSignatureVerifier(signatureVerifier),
+			)
+		}
 		moduleReader = bufmodulecache.NewCASModuleReader(
 			container.Logger(),
 			container.VerbosePrinter(),
 			casModuleBucket,
 			delegateReader,
 			repositoryClientFactory,
+			casModuleReaderOpts...,
 		)
 	} else {
 		// do NOT want to enable symlinks for our cache
@@ -645,12 +876,40 @@ func NewConfig(container appflag.Container) (*bufapp.Config, error) {
 }
 
 // Returns a registry provider with the given options applied in addition to default ones for all providers
-func newConnectClientConfigWithOptions(container appflag.Container, opts ...connectclient.ConfigOption) (*connectclient.Config, error) {
-	config, err := NewConfig(container)
+func newConnectClientConfigWithOptions(
+	container appflag.Container,
+	config *bufapp.Config,
+	opts ...connectclient.ConfigOption,
+) (*connectclient.Config, error) {
+	connectTimeout, err := app.EnvDuration(container, registryConnectTimeoutEnvKey, config.Timeouts.RegistryConnect)
 	if err != nil {
 		return nil, err
 	}
-	client := httpclient.NewClient(config.TLS)
+	readTimeout, err := app.EnvDuration(container, registryReadTimeoutEnvKey, config.Timeouts.RegistryRead)
+	if err != nil {
+		return nil, err
+	}
+	timeoutProvider, err := newRegistryTimeoutProvider(container, config.Timeouts)
+	if err != nil {
+		return nil, err
+	}
+	requestsPerSecond, err := envFloat(container, registryRateLimitEnvKey)
+	if err != nil {
+		return nil, err
+	}
+	maxConcurrentRequests, err := envInt(container, registryMaxConcurrentRequestsEnvKey)
+	if err != nil {
+		return nil, err
+	}
+	debugHTTP, err := app.EnvBool(container, DebugHTTPEnvKey, false)
+	if err != nil {
+		return nil, err
+	}
+	client := httpclient.NewClient(
+		config.TLS,
+		httpclient.WithConnectTimeout(connectTimeout),
+		httpclient.WithReadTimeout(readTimeout),
+	)
 	options := []connectclient.ConfigOption{
 		connectclient.WithAddressMapper(func(address string) string {
 			if buftransport.IsAPISubdomainEnabled(container) {
@@ -664,6 +923,13 @@ func newConnectClientConfigWithOptions(container appflag.Container, opts ...conn
 		connectclient.WithInterceptors(
 			[]connect.Interceptor{bufconnect.NewSetCLIVersionInterceptor(Version)},
 		),
+		connectclient.WithTimeoutProvider(timeoutProvider),
+		connectclient.WithRateLimit(requestsPerSecond, int(math.Ceil(requestsPerSecond))),
+		connectclient.WithMaxConcurrentRequests(maxConcurrentRequests),
+		connectclient.WithRetryAfter(registryMaxRetries, registryMaxRetryAfter),
+	}
+	if debugHTTP {
+		options = append(options, connectclient.WithDebugLogger(container.Logger()))
 	}
 	options = append(options, opts...)
 
@@ -671,31 +937,67 @@ func newConnectClientConfigWithOptions(container appflag.Container, opts ...conn
 }
 
 // NewConnectClientConfig creates a new connect.ClientConfig which uses a token reader to look
-// up the token in the container or in netrc based on the address of each individual client.
+// up the token in the container, with the configured credential helper, or in netrc based on
+// the address of each individual client.
 // It is then set in the header of all outgoing requests from clients created using this config.
 func NewConnectClientConfig(container appflag.Container) (*connectclient.Config, error) {
+	return newConnectClientConfig(container, newRegistryTokenPromptFunc(container))
+}
+
+// NewConnectClientConfigWithoutPrompt creates a new connect.ClientConfig like NewConnectClientConfig,
+// which never prompts for a token when a request is rejected as unauthenticated.
+//
+// This is used by commands that report on the credentials themselves.
+func NewConnectClientConfigWithoutPrompt(container appflag.Container) (*connectclient.Config, error) {
+	return newConnectClientConfig(container, nil)
+}
+
+// GetTokenSource returns where the token that NewConnectClientConfig uses for the
+// remote is read from, such as "netrc".
+//
+// Returns the empty string if there is no token for the remote.
+func GetTokenSource(container appflag.Container, remote string) (string, error) {
+	config, err := NewConfig(container)
+	if err != nil {
+		return "", err
+	}
 	envTokenProvider, err := bufconnect.NewTokenProviderFromContainer(container)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	netrcTokenProvider := bufconnect.NewNetrcTokenProvider(container, netrc.GetMachineForName)
-	return newConnectClientConfigWithOptions(
-		container,
-		connectclient.WithAuthInterceptorProvider(
-			bufconnect.NewAuthorizationInterceptorProvider(envTokenProvider, netrcTokenProvider),
-		),
-	)
+	if envTokenProvider.RemoteToken(remote) != "" {
+		return tokenEnvKey + " environment variable", nil
+	}
+	if config.CredentialHelper != "" {
+		credentialHelperTokenProvider := bufconnect.NewCredentialHelperTokenProvider(
+			container,
+			command.NewRunner(),
+			config.CredentialHelper,
+		)
+		if credentialHelperTokenProvider.RemoteToken(remote) != "" {
+			return fmt.Sprintf("credential helper %q", config.CredentialHelper), nil
+		}
+	}
+	if bufconnect.NewNetrcTokenProvider(container, netrc.GetMachineForName).RemoteToken(remote) != "" {
+		return "netrc", nil
+	}
+	return "", nil
 }
 
 // NewConnectClientConfigWithToken creates a new connect.ClientConfig with a given token. The provided token is
 // set in the header of all outgoing requests from this provider
 func NewConnectClientConfigWithToken(container appflag.Container, token string) (*connectclient.Config, error) {
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
 	tokenProvider, err := bufconnect.NewTokenProviderFromString(token)
 	if err != nil {
 		return nil, err
 	}
 	return newConnectClientConfigWithOptions(
 		container,
+		config,
 		connectclient.WithAuthInterceptorProvider(
 			bufconnect.NewAuthorizationInterceptorProvider(tokenProvider),
 		),
@@ -736,14 +1038,25 @@ func PromptUserForDelete(container app.Container, entityType string, expectedAns
 // The prompt is repeatedly shown until the user provides a non-empty response.
 // ErrNotATTY is returned if the input containers Stdin is not a terminal.
 func PromptUser(container app.Container, prompt string) (string, error) {
-	return promptUser(container, prompt, false)
+	return promptUser(container.Stdin(), container.Stdout(), prompt, false)
 }
 
 // PromptUserForPassword reads a line from Stdin, prompting the user with the prompt first.
 // The prompt is repeatedly shown until the user provides a non-empty response.
 // ErrNotATTY is returned if the input containers Stdin is not a terminal.
 func PromptUserForPassword(container app.Container, prompt string) (string, error) {
-	return promptUser(container, prompt, true)
+	return promptUser(container.Stdin(), container.Stdout(), prompt, true)
+}
+
+// NewManifestDigestCache returns a new manifest.FileDigestCache stored in the cache directory.
+//
+// Save must be called on the returned cache to persist it.
+func NewManifestDigestCache(container appname.Container) *manifest.FileDigestCache {
+	return manifest.NewFileDigestCache(
+		normalpath.Unnormalize(
+			normalpath.Join(container.CacheDirPath(), v1CacheManifestDigestRelFilePath),
+		),
+	)
 }
 
 // BucketAndConfigForSource returns a bucket and config. The bucket contains
@@ -753,11 +1066,35 @@ func PromptUserForPassword(container app.Container, prompt string) (string, erro
 //
 // Workspaces are disabled when fetching the source.
 func BucketAndConfigForSource(
+	ctx context.Context,
+	logger *zap.Logger,
+	container appflag.Container,
+	storageosProvider storageos.Provider,
+	runner command.Runner,
+	source string,
+) (storage.ReadBucketCloser, *bufconfig.Config, error) {
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bucketAndConfigForSource(
+		ctx,
+		logger,
+		container,
+		storageosProvider,
+		runner,
+		newGitClonerOptions(config),
+		source,
+	)
+}
+
+func bucketAndConfigForSource(
 	ctx context.Context,
 	logger *zap.Logger,
 	container app.EnvStdinContainer,
 	storageosProvider storageos.Provider,
 	runner command.Runner,
+	gitClonerOptions git.ClonerOptions,
 	source string,
 ) (storage.ReadBucketCloser, *bufconfig.Config, error) {
 	sourceRef, err := buffetch.NewSourceRefParser(
@@ -773,6 +1110,7 @@ func BucketAndConfigForSource(
 		logger,
 		storageosProvider,
 		runner,
+		gitClonerOptions,
 	).GetSourceBucket(
 		ctx,
 		container,
@@ -790,7 +1128,7 @@ func BucketAndConfigForSource(
 		return nil, nil, ErrNoConfigFile
 	}
 	// TODO: This should just read a lock file
-	sourceConfig, err := bufconfig.GetConfigForBucket(
+	sourceConfig, err := bufconfig.ReadConfigOS(
 		ctx,
 		sourceBucket,
 	)
@@ -804,7 +1142,83 @@ func BucketAndConfigForSource(
 	return sourceBucket, sourceConfig, nil
 }
 
+// WorkspaceModulesForDir reads the workspace configuration in the given directory and
+// returns the WorkspaceModules for each of the directories it lists.
+//
+// Returns an error if the directory does not contain a workspace configuration file.
+func WorkspaceModulesForDir(
+	ctx context.Context,
+	storageosProvider storageos.Provider,
+	dirPath string,
+) ([]*bufwork.WorkspaceModule, error) {
+	readBucket, err := storageosProvider.NewReadWriteBucket(
+		dirPath,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	existingConfigFilePath, err := bufwork.ExistingConfigFilePath(ctx, readBucket)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+	if existingConfigFilePath == "" {
+		return nil, fmt.Errorf(`no %s found in directory "%s"`, bufwork.ExternalConfigV1FilePath, dirPath)
+	}
+	relativeRootPath := normalpath.Normalize(dirPath)
+	workspaceConfig, err := bufwork.GetConfigForBucket(ctx, readBucket, relativeRootPath)
+	if err != nil {
+		return nil, err
+	}
+	return bufwork.GetWorkspaceModules(ctx, readBucket, relativeRootPath, workspaceConfig)
+}
+
+// WorkspaceModuleImportCycles returns the import cycles between the modules of a workspace,
+// as determined by the given WorkspaceImports. Each module is identified by its directory.
+func WorkspaceModuleImportCycles(workspaceImports []*bufwork.WorkspaceImport) []*importcycle.Cycle {
+	imports := make([]*importcycle.Import, 0, len(workspaceImports))
+	for _, workspaceImport := range workspaceImports {
+		if workspaceImport.FromDirectory == workspaceImport.ToDirectory {
+			continue
+		}
+		imports = append(
+			imports,
+			newImportCycleImport(
+				workspaceImport,
+				normalpath.Unnormalize(workspaceImport.FromDirectory),
+				normalpath.Unnormalize(workspaceImport.ToDirectory),
+			),
+		)
+	}
+	return importcycle.FindCycles(imports)
+}
+
+// WorkspaceFileImportCycles returns the import cycles between the files of a workspace,
+// as determined by the given WorkspaceImports. Each file is identified by its path.
+func WorkspaceFileImportCycles(workspaceImports []*bufwork.WorkspaceImport) []*importcycle.Cycle {
+	imports := make([]*importcycle.Import, 0, len(workspaceImports))
+	for _, workspaceImport := range workspaceImports {
+		imports = append(
+			imports,
+			newImportCycleImport(
+				workspaceImport,
+				workspaceImport.FileInfo.Path(),
+				workspaceImport.ImportPath,
+			),
+		)
+	}
+	return importcycle.FindCycles(imports)
+}
+
 // NewImageForSource resolves a single bufimage.Image from the user-provided source with the build options.
+//
+// warnings is the value of the warnings flag, see BindWarnings. The empty string
+// ignores warnings. keepGoing, maxFailures and parallelism are the values of the
+// keep-going, max-failures and parallelism flags, see BindKeepGoing,
+// BindMaxFailures and BindParallelism.
+//
+// This returns ErrNoChangedFiles if the source is a directory with the changed
+// option and no .proto files changed relative to the git ref.
 func NewImageForSource(
 	ctx context.Context,
 	container appflag.Container,
@@ -816,6 +1230,10 @@ func NewImageForSource(
 	externalExcludeDirOrFilePaths []string,
 	externalDirOrFilePathsAllowNotExist bool,
 	excludeSourceCodeInfo bool,
+	warnings string,
+	keepGoing bool,
+	maxFailures int,
+	parallelism int,
 ) (bufimage.Image, error) {
 	ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, source)
 	if err != nil {
@@ -827,11 +1245,36 @@ func NewImageForSource(
 	if err != nil {
 		return nil, err
 	}
+	var imageConfigReaderOptions []bufwire.ImageConfigReaderOption
+	var warningFileAnnotations []bufanalysis.FileAnnotation
+	if warnings != "" && warnings != warningsIgnore {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithWarnings(
+				func(moduleWarningFileAnnotations []bufanalysis.FileAnnotation) {
+					warningFileAnnotations = append(warningFileAnnotations, moduleWarningFileAnnotations...)
+				},
+			),
+		)
+	}
+	if keepGoing {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithKeepGoing(),
+		)
+	}
+	if parallelism > 1 {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithParallelism(parallelism),
+		)
+	}
 	imageConfigReader, err := NewWireImageConfigReader(
 		container,
 		storageosProvider,
 		runner,
 		clientConfig,
+		imageConfigReaderOptions...,
 	)
 	if err != nil {
 		return nil, err
@@ -850,6 +1293,10 @@ func NewImageForSource(
 		return nil, err
 	}
 	if len(fileAnnotations) > 0 {
+		fileAnnotations, err = LimitFileAnnotations(container, fileAnnotations, maxFailures)
+		if err != nil {
+			return nil, err
+		}
 		// stderr since we do output to stdout potentially
 		if err := bufanalysis.PrintFileAnnotations(
 			container.Stderr(),
@@ -860,6 +1307,21 @@ func NewImageForSource(
 		}
 		return nil, ErrFileAnnotation
 	}
+	if len(warningFileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(
+			container.Stderr(),
+			warningFileAnnotations,
+			errorFormat,
+		); err != nil {
+			return nil, err
+		}
+		if warnings == warningsError {
+			return nil, ErrFileAnnotation
+		}
+	}
+	if len(imageConfigs) == 0 {
+		return nil, ErrNoChangedFiles
+	}
 	images := make([]bufimage.Image, 0, len(imageConfigs))
 	for _, imageConfig := range imageConfigs {
 		images = append(images, imageConfig.Image())
@@ -923,6 +1385,239 @@ func IsBetaTamperProofingEnabled(container app.EnvContainer) (bool, error) {
 	return app.EnvBool(container, BetaEnableTamperProofingEnvKey, false)
 }
 
+// NewManifestSignatureVerifier returns a new manifest signature verifier that accepts
+// signatures by the PEM-encoded public key at keyFilePath, or by a certificate with the
+// given identity if keyFilePath is empty.
+//
+// Certificates must chain up to the PEM-encoded certificates at certificateRootsFilePath,
+// or to the system roots if certificateRootsFilePath is empty.
+func NewManifestSignatureVerifier(
+	keyFilePath string,
+	certificateIdentity string,
+	certificateRootsFilePath string,
+) (bufmanifest.SignatureVerifier, error) {
+	if (keyFilePath == "") == (certificateIdentity == "") {
+		return nil, errors.New("exactly one of a signature key or certificate identity must be set")
+	}
+	if keyFilePath != "" {
+		publicKeyPEM, err := os.ReadFile(keyFilePath)
+		if err != nil {
+			return nil, err
+		}
+		return bufmanifest.NewKeyVerifier(publicKeyPEM)
+	}
+	var roots *x509.CertPool
+	if certificateRootsFilePath != "" {
+		rootsPEM, err := os.ReadFile(certificateRootsFilePath)
+		if err != nil {
+			return nil, err
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(rootsPEM) {
+			return nil, fmt.Errorf("no certificates found in %s", certificateRootsFilePath)
+		}
+	}
+	return bufmanifest.NewCertificateIdentityVerifier(certificateIdentity, roots), nil
+}
+
+// ManifestForSource returns the manifest of the module built from the source, with
+// digests of the given type.
+//
+// The module is built the same way as for a push, but not compiled.
+func ManifestForSource(
+	ctx context.Context,
+	container appflag.Container,
+	storageosProvider storageos.Provider,
+	runner command.Runner,
+	source string,
+	digestType manifest.DigestType,
+) (_ *manifest.Manifest, retErr error) {
+	sourceBucket, sourceConfig, err := BucketAndConfigForSource(
+		ctx,
+		container.Logger(),
+		container,
+		storageosProvider,
+		runner,
+		source,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, sourceBucket.Close())
+	}()
+	builtModule, err := bufmodulebuild.BuildForBucket(
+		ctx,
+		sourceBucket,
+		sourceConfig.Build,
+		bufmodulebuild.WithLookupEnv(os.LookupEnv),
+	)
+	if err != nil {
+		return nil, err
+	}
+	m, _, err := manifest.NewFromBucket(ctx, builtModule.Bucket, manifest.FromBucketWithDigestType(digestType))
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ManifestForModuleReference downloads the manifest of the module reference from the registry.
+func ManifestForModuleReference(
+	ctx context.Context,
+	clientConfig *connectclient.Config,
+	moduleReference bufmoduleref.ModuleReference,
+) (*manifest.Manifest, error) {
+	downloadService := connectclient.Make(
+		clientConfig,
+		moduleReference.Remote(),
+		registryv1alpha1connect.NewDownloadServiceClient,
+	)
+	resp, err := downloadService.DownloadManifestAndBlobs(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.DownloadManifestAndBlobsRequest{
+			Owner:      moduleReference.Owner(),
+			Repository: moduleReference.Repository(),
+			Reference:  moduleReference.Reference(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, fmt.Errorf("%s does not exist", moduleReference.String())
+		}
+		return nil, err
+	}
+	if resp.Msg.Manifest == nil {
+		return nil, fmt.Errorf("no manifest for %s", moduleReference.String())
+	}
+	return bufmanifest.NewManifestFromProto(ctx, resp.Msg.Manifest)
+}
+
+// CASModuleCacheDirPath returns the path of the content addressable module cache
+// within the cache directory, which is used if tamper proofing is enabled.
+func CASModuleCacheDirPath(cacheDirPath string) string {
+	return normalpath.Join(cacheDirPath, v2CacheModuleRelDirPath)
+}
+
+// DownloadModule downloads the manifest and blobs of the module reference from the
+// registry.
+//
+// The content of every blob is checked against its digest. If verify is true, the
+// blobs must also match the paths of the manifest exactly, the digest of the manifest
+// must match the manifest digest that the registry recorded for the commit, and the
+// manifest signature is verified if signatures are required by the buf configuration.
+func DownloadModule(
+	ctx context.Context,
+	container appflag.Container,
+	clientConfig *connectclient.Config,
+	moduleReference bufmoduleref.ModuleReference,
+	verify bool,
+) (*manifest.Manifest, *manifest.BlobSet, error) {
+	downloadService := connectclient.Make(
+		clientConfig,
+		moduleReference.Remote(),
+		registryv1alpha1connect.NewDownloadServiceClient,
+	)
+	resp, err := downloadService.DownloadManifestAndBlobs(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.DownloadManifestAndBlobsRequest{
+			Owner:      moduleReference.Owner(),
+			Repository: moduleReference.Repository(),
+			Reference:  moduleReference.Reference(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, nil, NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return nil, nil, err
+	}
+	if resp.Msg.Manifest == nil {
+		return nil, nil, fmt.Errorf("no manifest for %s", moduleReference.String())
+	}
+	moduleManifest, err := bufmanifest.NewManifestFromProto(ctx, resp.Msg.Manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+	blobSet, err := bufmanifest.NewBlobSetFromProto(ctx, resp.Msg.Blobs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !verify {
+		return moduleManifest, blobSet, nil
+	}
+	if _, err := manifest.NewBucket(
+		*moduleManifest,
+		*blobSet,
+		manifest.BucketWithAllManifestBlobsValidation(),
+		manifest.BucketWithNoExtraBlobsValidation(),
+	); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", moduleReference.String(), err)
+	}
+	manifestDigest, err := bufmanifest.NewDigestFromProtoDigest(resp.Msg.Manifest.Digest)
+	if err != nil {
+		return nil, nil, err
+	}
+	commitService := connectclient.Make(
+		clientConfig,
+		moduleReference.Remote(),
+		registryv1alpha1connect.NewRepositoryCommitServiceClient,
+	)
+	commitResp, err := commitService.GetRepositoryCommitByReference(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetRepositoryCommitByReferenceRequest{
+			RepositoryOwner: moduleReference.Owner(),
+			RepositoryName:  moduleReference.Repository(),
+			Reference:       moduleReference.Reference(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, nil, NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return nil, nil, err
+	}
+	if commitResp.Msg.RepositoryCommit.ManifestDigest == "" {
+		return nil, nil, fmt.Errorf("the registry has no manifest digest for %s", moduleReference.String())
+	}
+	commitManifestDigest, err := manifest.NewDigestFromString(commitResp.Msg.RepositoryCommit.ManifestDigest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("malformed manifest digest %q: %w", commitResp.Msg.RepositoryCommit.ManifestDigest, err)
+	}
+	if !commitManifestDigest.Equal(*manifestDigest) {
+		return nil, nil, fmt.Errorf(
+			"manifest digest mismatch for %s - expected: %q, found: %q",
+			moduleReference.String(),
+			commitManifestDigest.String(),
+			manifestDigest.String(),
+		)
+	}
+	signatureVerifier, err := getRequiredSignatureVerifier(container)
+	if err != nil {
+		return nil, nil, err
+	}
+	if signatureVerifier != nil {
+		if len(resp.Msg.ManifestSignature) == 0 {
+			return nil, nil, fmt.Errorf("module %s has no manifest signature", moduleReference.String())
+		}
+		if err := bufmanifest.VerifyManifestSignature(moduleManifest, resp.Msg.ManifestSignature, signatureVerifier); err != nil {
+			return nil, nil, fmt.Errorf("module %s: %w", moduleReference.String(), err)
+		}
+	}
+	return moduleManifest, blobSet, nil
+}
+
+// GetPluginTimeout returns the default timeout of each plugin execution
+// from the PluginTimeoutEnvKey environment variable, or else from the timeouts
+// of the buf configuration, or 0 if not set.
+func GetPluginTimeout(container appflag.Container) (time.Duration, error) {
+	config, err := NewConfig(container)
+	if err != nil {
+		return 0, err
+	}
+	return app.EnvDuration(container, PluginTimeoutEnvKey, config.Timeouts.Plugin)
+}
+
 // IsAlphaWASMEnabled returns an BUF_ALPHA_ENABLE_WASM is set to true.
 func IsAlphaWASMEnabled(container app.EnvContainer) (bool, error) {
 	return app.EnvBool(container, AlphaEnableWASMEnvKey, false)
@@ -947,11 +1642,99 @@ func validateErrorFormatFlag(validFormatStrings []string, errorFormatString stri
 	return appcmd.NewInvalidArgumentErrorf("--%s: invalid format: %q", errorFormatFlagName, errorFormatString)
 }
 
-// promptUser reads a line from Stdin, prompting the user with the prompt first.
+// ValidateWarningsFlag validates the warnings flag.
+func ValidateWarningsFlag(warningsString string, warningsFlagName string) error {
+	for _, validWarningsString := range allWarningsStrings {
+		if warningsString == validWarningsString {
+			return nil
+		}
+	}
+	return appcmd.NewInvalidArgumentErrorf(
+		"--%s: invalid value: %q, expected one of %s",
+		warningsFlagName,
+		warningsString,
+		stringutil.SliceToString(allWarningsStrings),
+	)
+}
+
+// envFloat returns the non-negative float value of the environment variable, or 0 if not set.
+func envFloat(container app.EnvContainer, key string) (float64, error) {
+	value := container.Env(key)
+	if value == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid value for %s, expected a non-negative number: %q", key, value)
+	}
+	return f, nil
+}
+
+// envInt returns the non-negative int value of the environment variable, or 0 if not set.
+func envInt(container app.EnvContainer, key string) (int, error) {
+	value := container.Env(key)
+	if value == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("invalid value for %s, expected a non-negative integer: %q", key, value)
+	}
+	return i, nil
+}
+
+// newRegistryTimeoutProvider returns a function that returns the timeout for
+// registry RPCs to a remote, as configured by registryTimeoutEnvKey or else by
+// the timeouts of the buf configuration.
+//
+// A default timeout set by registryTimeoutEnvKey takes precedence over all
+// registry timeouts of the buf configuration.
+func newRegistryTimeoutProvider(
+	container app.EnvContainer,
+	timeoutsConfig bufapp.TimeoutsConfig,
+) (func(string) time.Duration, error) {
+	defaultTimeout := timeoutsConfig.Registry
+	configRemoteToTimeout := timeoutsConfig.RegistryRemotes
+	remoteToTimeout := make(map[string]time.Duration)
+	for _, entry := range strings.Split(container.Env(registryTimeoutEnvKey), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		remote, durationString, ok := strings.Cut(entry, "=")
+		if !ok {
+			remote, durationString = "", entry
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(durationString))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", registryTimeoutEnvKey, err)
+		}
+		if timeout < 0 {
+			return nil, fmt.Errorf("invalid value for %s: duration must not be negative: %q", registryTimeoutEnvKey, entry)
+		}
+		if remote = strings.TrimSpace(remote); remote == "" {
+			defaultTimeout = timeout
+			configRemoteToTimeout = nil
+		} else {
+			remoteToTimeout[remote] = timeout
+		}
+	}
+	return func(remote string) time.Duration {
+		if timeout, ok := remoteToTimeout[remote]; ok {
+			return timeout
+		}
+		if timeout, ok := configRemoteToTimeout[remote]; ok {
+			return timeout
+		}
+		return defaultTimeout
+	}, nil
+}
+
+// promptUser reads a line from stdin, writing the prompt to the writer first.
 // The prompt is repeatedly shown until the user provides a non-empty response.
-// ErrNotATTY is returned if the input containers Stdin is not a terminal.
-func promptUser(container app.Container, prompt string, isPassword bool) (string, error) {
-	file, ok := container.Stdin().(*os.File)
+// ErrNotATTY is returned if stdin is not a terminal.
+func promptUser(stdin io.Reader, writer io.Writer, prompt string, isPassword bool) (string, error) {
+	file, ok := stdin.(*os.File)
 	if !ok || !term.IsTerminal(int(file.Fd())) {
 		return "", ErrNotATTY
 	}
@@ -959,7 +1742,7 @@ func promptUser(container app.Container, prompt string, isPassword bool) (string
 	for attempts < userPromptAttempts {
 		attempts++
 		if _, err := fmt.Fprint(
-			container.Stdout(),
+			writer,
 			prompt,
 		); err != nil {
 			return "", NewInternalError(err)
@@ -979,7 +1762,7 @@ func promptUser(container app.Container, prompt string, isPassword bool) (string
 			}
 			value = string(data)
 		} else {
-			scanner := bufio.NewScanner(container.Stdin())
+			scanner := bufio.NewScanner(stdin)
 			if !scanner.Scan() {
 				// scanner.Err() returns nil on EOF.
 				if err := scanner.Err(); err != nil {
@@ -1001,7 +1784,7 @@ func promptUser(container app.Container, prompt string, isPassword bool) (string
 			// We only want to ask the user to try again if they actually
 			// have another attempt.
 			if _, err := fmt.Fprintln(
-				container.Stdout(),
+				writer,
 				"No answer was provided. Please try again.",
 			); err != nil {
 				return "", NewInternalError(err)
@@ -1011,12 +1794,118 @@ func promptUser(container app.Container, prompt string, isPassword bool) (string
 	return "", NewTooManyEmptyAnswersError(userPromptAttempts)
 }
 
+// promptHTTPCredentials prompts for the username and password of the host of an
+// HTTP input that rejected the request as unauthorized.
+//
+// Returns empty values if prompts are disabled or stdin is not a terminal.
+func promptHTTPCredentials(container app.EnvStdinContainer, host string) (string, string, error) {
+	stderrContainer, ok := container.(app.StderrContainer)
+	if !ok {
+		return "", "", nil
+	}
+	ok, err := canPromptForCredentials(container)
+	if err != nil || !ok {
+		return "", "", err
+	}
+	if _, err := fmt.Fprintf(stderrContainer.Stderr(), "%s requires authentication.\n", host); err != nil {
+		return "", "", err
+	}
+	username, err := promptUser(container.Stdin(), stderrContainer.Stderr(), "Username: ", false)
+	if err != nil {
+		return "", "", err
+	}
+	password, err := promptUser(container.Stdin(), stderrContainer.Stderr(), "Password: ", true)
+	if err != nil {
+		return "", "", err
+	}
+	// term.ReadPassword does not echo the newline.
+	if _, err := fmt.Fprintln(stderrContainer.Stderr()); err != nil {
+		return "", "", err
+	}
+	return username, password, nil
+}
+
+// newRegistryTokenPromptFunc returns a new TokenPromptFunc that prompts for the
+// token of a registry that rejected a request as unauthenticated.
+func newRegistryTokenPromptFunc(container app.Container) bufconnect.TokenPromptFunc {
+	return func(address string) (string, error) {
+		ok, err := canPromptForCredentials(container)
+		if err != nil || !ok {
+			return "", err
+		}
+		if _, err := fmt.Fprintf(
+			container.Stderr(),
+			"%s requires authentication. Enter a Buf API token, or run \"buf registry login\" to store a token.\n",
+			address,
+		); err != nil {
+			return "", err
+		}
+		token, err := promptUser(container.Stdin(), container.Stderr(), "Token: ", true)
+		if err != nil {
+			return "", err
+		}
+		if _, err := fmt.Fprintln(container.Stderr()); err != nil {
+			return "", err
+		}
+		return token, nil
+	}
+}
+
+// canPromptForCredentials returns true if prompts are not disabled with
+// NoPromptEnvKey and stdin is a terminal.
+func canPromptForCredentials(container app.EnvStdinContainer) (bool, error) {
+	noPrompt, err := app.EnvBool(container, NoPromptEnvKey, false)
+	if err != nil {
+		return false, err
+	}
+	if noPrompt {
+		return false, nil
+	}
+	file, ok := container.Stdin().(*os.File)
+	return ok && term.IsTerminal(int(file.Fd())), nil
+}
+
+func newConnectClientConfig(
+	container appflag.Container,
+	tokenPromptFunc bufconnect.TokenPromptFunc,
+) (*connectclient.Config, error) {
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
+	envTokenProvider, err := bufconnect.NewTokenProviderFromContainer(container)
+	if err != nil {
+		return nil, err
+	}
+	credentialHelperTokenProvider := bufconnect.NewCredentialHelperTokenProvider(
+		container,
+		command.NewRunner(),
+		config.CredentialHelper,
+	)
+	netrcTokenProvider := bufconnect.NewNetrcTokenProvider(container, netrc.GetMachineForName)
+	tokenProviders := []bufconnect.TokenProvider{
+		envTokenProvider,
+		credentialHelperTokenProvider,
+		netrcTokenProvider,
+	}
+	authInterceptorProvider := bufconnect.NewAuthorizationInterceptorProvider(tokenProviders...)
+	if tokenPromptFunc != nil {
+		authInterceptorProvider = bufconnect.NewAuthorizationInterceptorProviderWithPrompt(tokenPromptFunc, tokenProviders...)
+	}
+	return newConnectClientConfigWithOptions(
+		container,
+		config,
+		connectclient.WithAuthInterceptorProvider(authInterceptorProvider),
+	)
+}
+
 // newFetchReader creates a new buffetch.Reader with the default HTTP client
 // and git cloner.
 func newFetchReader(
 	logger *zap.Logger,
 	storageosProvider storageos.Provider,
 	runner command.Runner,
+	gitClonerOptions git.ClonerOptions,
 	moduleResolver bufmodule.ModuleResolver,
 	moduleReader bufmodule.ModuleReader,
 ) buffetch.Reader {
@@ -1025,7 +1914,7 @@ func newFetchReader(
 		storageosProvider,
 		defaultHTTPClient,
 		defaultHTTPAuthenticator,
-		git.NewCloner(logger, storageosProvider, runner, defaultGitClonerOptions),
+		git.NewCloner(logger, storageosProvider, runner, gitClonerOptions),
 		moduleResolver,
 		moduleReader,
 	)
@@ -1037,13 +1926,14 @@ func newFetchSourceReader(
 	logger *zap.Logger,
 	storageosProvider storageos.Provider,
 	runner command.Runner,
+	gitClonerOptions git.ClonerOptions,
 ) buffetch.SourceReader {
 	return buffetch.NewSourceReader(
 		logger,
 		storageosProvider,
 		defaultHTTPClient,
 		defaultHTTPAuthenticator,
-		git.NewCloner(logger, storageosProvider, runner, defaultGitClonerOptions),
+		git.NewCloner(logger, storageosProvider, runner, gitClonerOptions),
 	)
 }
 
@@ -1063,6 +1953,32 @@ func newFetchImageReader(
 	)
 }
 
+// newGitClonerOptions returns the default git clone options with the timeout
+// of the buf configuration.
+func newGitClonerOptions(config *bufapp.Config) git.ClonerOptions {
+	gitClonerOptions := defaultGitClonerOptions
+	gitClonerOptions.Timeout = config.Timeouts.InputGit
+	return gitClonerOptions
+}
+
+// getRequiredSignatureVerifier returns the manifest signature verifier required by the
+// manifest_signature section of the buf configuration, or nil if manifest signatures
+// are not required.
+func getRequiredSignatureVerifier(container appflag.Container) (bufmanifest.SignatureVerifier, error) {
+	config, err := NewConfig(container)
+	if err != nil {
+		return nil, err
+	}
+	if config.ManifestSignature == nil {
+		return nil, nil
+	}
+	return NewManifestSignatureVerifier(
+		config.ManifestSignature.KeyFilePath,
+		config.ManifestSignature.CertificateIdentity,
+		config.ManifestSignature.CertificateRootsFilePath,
+	)
+}
+
 func checkExistingCacheDirs(baseCacheDirPath string, dirPaths ...string) error {
 	dirPathsToCheck := make([]string, 0, len(dirPaths)+1)
 	// Check base cache directory in addition to subdirectories
@@ -1097,3 +2013,14 @@ func createCacheDirs(dirPaths ...string) error {
 	}
 	return nil
 }
+
+func newImportCycleImport(workspaceImport *bufwork.WorkspaceImport, from string, to string) *importcycle.Import {
+	return &importcycle.Import{
+		From:       from,
+		To:         to,
+		ImportPath: workspaceImport.ImportPath,
+		Path:       workspaceImport.FileInfo.ExternalPath(),
+		Line:       workspaceImport.Line,
+		Column:     workspaceImport.Column,
+	}
+}
@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/bufbuild/buf/private/buf/bufapp"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/command"
@@ -104,12 +106,13 @@ func bucketAndConfig(
 		files: files,
 	}
 	runner := command.NewRunner()
-	return BucketAndConfigForSource(
+	return bucketAndConfigForSource(
 		ctx,
 		logger,
 		container,
 		bucketProvider,
 		runner,
+		defaultGitClonerOptions,
 		source,
 	)
 }
@@ -147,3 +150,64 @@ func testBucketAndConfigForSource(
 		}
 	})
 }
+
+func TestNewRegistryTimeoutProvider(t *testing.T) {
+	t.Parallel()
+	timeoutProvider, err := newRegistryTimeoutProvider(app.NewEnvContainer(nil), bufapp.TimeoutsConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeoutProvider("buf.build"))
+	timeoutProvider, err = newRegistryTimeoutProvider(
+		app.NewEnvContainer(
+			map[string]string{
+				registryTimeoutEnvKey: "30s, buf.example.com=2m",
+			},
+		),
+		bufapp.TimeoutsConfig{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeoutProvider("buf.build"))
+	assert.Equal(t, 2*time.Minute, timeoutProvider("buf.example.com"))
+	timeoutsConfig := bufapp.TimeoutsConfig{
+		Registry: time.Minute,
+		RegistryRemotes: map[string]time.Duration{
+			"buf.example.com": 5 * time.Minute,
+		},
+	}
+	timeoutProvider, err = newRegistryTimeoutProvider(app.NewEnvContainer(nil), timeoutsConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, timeoutProvider("buf.build"))
+	assert.Equal(t, 5*time.Minute, timeoutProvider("buf.example.com"))
+	timeoutProvider, err = newRegistryTimeoutProvider(
+		app.NewEnvContainer(
+			map[string]string{
+				registryTimeoutEnvKey: "other.example.com=2m",
+			},
+		),
+		timeoutsConfig,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, timeoutProvider("buf.build"))
+	assert.Equal(t, 5*time.Minute, timeoutProvider("buf.example.com"))
+	assert.Equal(t, 2*time.Minute, timeoutProvider("other.example.com"))
+	// The default of the environment variable takes precedence over the configuration.
+	timeoutProvider, err = newRegistryTimeoutProvider(
+		app.NewEnvContainer(
+			map[string]string{
+				registryTimeoutEnvKey: "30s",
+			},
+		),
+		timeoutsConfig,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeoutProvider("buf.build"))
+	assert.Equal(t, 30*time.Second, timeoutProvider("buf.example.com"))
+	_, err = newRegistryTimeoutProvider(
+		app.NewEnvContainer(
+			map[string]string{
+				registryTimeoutEnvKey: "buf.example.com=forever",
+			},
+		),
+		bufapp.TimeoutsConfig{},
+	)
+	assert.Error(t, err)
+}
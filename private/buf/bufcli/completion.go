@@ -0,0 +1,181 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+)
+
+// CompleteInput returns the shell completion candidates for an input argument.
+//
+// The candidates are the directories of the buf.work.yaml in the current directory,
+// and the modules in the module cache.
+func CompleteInput(ctx context.Context, container appflag.Container, toComplete string) []string {
+	return filterCompletions(
+		append(
+			getWorkspaceDirectoryCompletions(ctx),
+			getCachedModuleCompletions(container)...,
+		),
+		toComplete,
+	)
+}
+
+// CompleteModuleReference returns the shell completion candidates for a module reference.
+//
+// The candidates are the modules in the module cache.
+func CompleteModuleReference(ctx context.Context, container appflag.Container, toComplete string) []string {
+	return filterCompletions(getCachedModuleCompletions(container), toComplete)
+}
+
+// CompleteTemplate returns the shell completion candidates for a buf.gen.yaml template.
+//
+// The candidates are the files named buf.gen*.yaml or buf.gen*.yml in the directory
+// being completed.
+func CompleteTemplate(ctx context.Context, container appflag.Container, toComplete string) []string {
+	// The directory prefix is kept as typed so that the candidates match toComplete.
+	dirPrefix := toComplete[:strings.LastIndex(toComplete, string(filepath.Separator))+1]
+	dirPath := dirPrefix
+	if dirPath == "" {
+		dirPath = "."
+	}
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil
+	}
+	var completions []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "buf.gen") {
+			continue
+		}
+		if ext := filepath.Ext(name); ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+		completions = append(completions, dirPrefix+name)
+	}
+	return filterCompletions(completions, toComplete)
+}
+
+// CompleteLintRuleIDOrCategory returns the shell completion candidates for a lint rule
+// or category ID.
+//
+// The candidates are the rule and category IDs of all versions of the lint configuration.
+func CompleteLintRuleIDOrCategory(ctx context.Context, container appflag.Container, toComplete string) []string {
+	return filterCompletions(
+		stringutil.SliceToUniqueSortedSlice(
+			append(
+				buflint.GetAllRulesAndCategoriesV1Beta1(),
+				buflint.GetAllRulesAndCategoriesV1()...,
+			),
+		),
+		strings.ToUpper(toComplete),
+	)
+}
+
+// CompleteBreakingRuleIDOrCategory returns the shell completion candidates for a breaking
+// rule or category ID.
+//
+// The candidates are the rule and category IDs of all versions of the breaking configuration.
+func CompleteBreakingRuleIDOrCategory(ctx context.Context, container appflag.Container, toComplete string) []string {
+	return filterCompletions(
+		stringutil.SliceToUniqueSortedSlice(
+			append(
+				bufbreaking.GetAllRulesAndCategoriesV1Beta1(),
+				bufbreaking.GetAllRulesAndCategoriesV1()...,
+			),
+		),
+		strings.ToUpper(toComplete),
+	)
+}
+
+// getWorkspaceDirectoryCompletions returns the directories of the buf.work.yaml
+// in the current directory, if any.
+func getWorkspaceDirectoryCompletions(ctx context.Context) []string {
+	readBucket, err := storageos.NewProvider().NewReadWriteBucket(".")
+	if err != nil {
+		return nil
+	}
+	workspaceConfig, err := bufwork.GetConfigForBucket(ctx, readBucket, ".")
+	if err != nil {
+		return nil
+	}
+	completions := make([]string, 0, len(workspaceConfig.Directories))
+	for _, directory := range workspaceConfig.Directories {
+		completions = append(completions, normalpath.Unnormalize(directory))
+	}
+	return completions
+}
+
+// getCachedModuleCompletions returns the names of all modules within the module cache,
+// that is remote/owner/repository.
+func getCachedModuleCompletions(container appflag.Container) []string {
+	moduleNames := make(map[string]struct{})
+	for _, cacheModuleRelDirPath := range []string{
+		v1CacheModuleDataRelDirPath,
+		v2CacheModuleRelDirPath,
+	} {
+		cacheModuleDirPath := normalpath.Unnormalize(normalpath.Join(container.CacheDirPath(), cacheModuleRelDirPath))
+		for _, remote := range readDirNames(cacheModuleDirPath) {
+			for _, owner := range readDirNames(filepath.Join(cacheModuleDirPath, remote)) {
+				for _, repository := range readDirNames(filepath.Join(cacheModuleDirPath, remote, owner)) {
+					moduleNames[normalpath.Join(remote, owner, repository)] = struct{}{}
+				}
+			}
+		}
+	}
+	completions := make([]string, 0, len(moduleNames))
+	for moduleName := range moduleNames {
+		completions = append(completions, moduleName)
+	}
+	sort.Strings(completions)
+	return completions
+}
+
+// readDirNames returns the names of the directories within the directory, ignoring errors.
+func readDirNames(dirPath string) []string {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
+}
+
+func filterCompletions(completions []string, toComplete string) []string {
+	var filtered []string
+	for _, completion := range completions {
+		if strings.HasPrefix(completion, toComplete) {
+			filtered = append(filtered, completion)
+		}
+	}
+	return filtered
+}
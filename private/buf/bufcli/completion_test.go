@@ -0,0 +1,74 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcli
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompleteLintRuleIDOrCategory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	completions := CompleteLintRuleIDOrCategory(ctx, nil, "")
+	assertSortedUniqueCompletions(t, completions)
+	// v1 rules and categories
+	assert.Contains(t, completions, "FIELD_LOWER_SNAKE_CASE")
+	assert.Contains(t, completions, "DEFAULT")
+	assert.Contains(t, completions, "MINIMAL")
+	// v1beta1 categories
+	assert.Contains(t, completions, "FILE_LAYOUT")
+	// breaking rules are not lint rules
+	assert.NotContains(t, completions, "FIELD_SAME_TYPE")
+
+	completions = CompleteLintRuleIDOrCategory(ctx, nil, "field_lower")
+	assert.Equal(t, []string{"FIELD_LOWER_SNAKE_CASE"}, completions)
+	assert.Empty(t, CompleteLintRuleIDOrCategory(ctx, nil, "UNKNOWN"))
+}
+
+func TestCompleteBreakingRuleIDOrCategory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	completions := CompleteBreakingRuleIDOrCategory(ctx, nil, "")
+	assertSortedUniqueCompletions(t, completions)
+	// v1 rules and categories
+	assert.Contains(t, completions, "FIELD_SAME_TYPE")
+	assert.Contains(t, completions, "FILE")
+	assert.Contains(t, completions, "WIRE_JSON")
+	// lint rules are not breaking rules
+	assert.NotContains(t, completions, "FIELD_LOWER_SNAKE_CASE")
+
+	for _, completion := range CompleteBreakingRuleIDOrCategory(ctx, nil, "Field_Same") {
+		assert.True(t, strings.HasPrefix(completion, "FIELD_SAME_"), completion)
+	}
+	assert.Contains(t, CompleteBreakingRuleIDOrCategory(ctx, nil, "Field_Same"), "FIELD_SAME_TYPE")
+	assert.Empty(t, CompleteBreakingRuleIDOrCategory(ctx, nil, "UNKNOWN"))
+}
+
+func assertSortedUniqueCompletions(t *testing.T, completions []string) {
+	t.Helper()
+	assert.NotEmpty(t, completions)
+	assert.True(t, sort.StringsAreSorted(completions), "completions are not sorted")
+	seen := make(map[string]struct{}, len(completions))
+	for _, completion := range completions {
+		_, ok := seen[completion]
+		assert.False(t, ok, "duplicate completion %q", completion)
+		seen[completion] = struct{}{}
+	}
+}
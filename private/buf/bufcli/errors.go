@@ -21,6 +21,7 @@ import (
 	"net"
 	"strings"
 
+	"github.com/bufbuild/buf/private/buf/buffetch"
 	"github.com/bufbuild/buf/private/bufpkg/bufconnect"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/bufpkg/buftransport"
@@ -44,6 +45,10 @@ var (
 	// ErrNoConfigFile is used when the user tries to execute a command without a configuration file.
 	ErrNoConfigFile = errors.New(`please define a configuration file in the current directory; you can create one by running "buf mod init"`)
 
+	// ErrNoChangedFiles is returned by NewImageForSource if the input is a directory
+	// with the changed option and no .proto files changed relative to the git ref.
+	ErrNoChangedFiles = errors.New("no .proto files changed relative to the git ref")
+
 	// ErrFileAnnotation is used when we print file annotations and want to return an error.
 	//
 	// The app package works on the concept that an error results in a non-zero exit
@@ -124,6 +129,18 @@ func NewTagOrDraftNameAlreadyExistsError(name string) error {
 	return fmt.Errorf("a tag or draft named %q already exists", name)
 }
 
+// NewLabelNameAlreadyExistsError informs the user that a label
+// with that name already exists.
+func NewLabelNameAlreadyExistsError(name string) error {
+	return fmt.Errorf(`a label named %q already exists, use "buf registry label move" to move it`, name)
+}
+
+// NewLabelNotFoundError informs the user that a label with
+// that name does not exist.
+func NewLabelNotFoundError(name string) error {
+	return fmt.Errorf(`a label named %q does not exist, use "buf registry label create" to create one`, name)
+}
+
 // NewOrganizationNotFoundError informs the user that an organization with
 // that name does not exist.
 func NewOrganizationNotFoundError(name string) error {
@@ -136,6 +153,18 @@ func NewRepositoryNotFoundError(name string) error {
 	return fmt.Errorf(`a repository named %q does not exist, use "buf beta registry repository create" to create one`, name)
 }
 
+// NewUserNotFoundError informs the user that a user with
+// that name does not exist.
+func NewUserNotFoundError(name string) error {
+	return fmt.Errorf("a user named %q does not exist", name)
+}
+
+// NewUserNameAlreadyExistsError informs the user that a user
+// with that name already exists.
+func NewUserNameAlreadyExistsError(name string) error {
+	return fmt.Errorf("a user named %q already exists", name)
+}
+
 // NewModuleReferenceNotFoundError informs the user that a module
 // reference does not exist.
 func NewModuleReferenceNotFoundError(reference bufmoduleref.ModuleReference) error {
@@ -201,6 +230,15 @@ func wrapError(err error) error {
 	}
 
 	// Error was not a Connect error
+	if buffetch.IsAuthError(err) {
+		return fmt.Errorf(
+			"Failure: %w. Create a new entry in your netrc for the remote, or set %s and %s. Credentials are only prompted for if stdin is a terminal and --%s is not set",
+			err,
+			inputHTTPSUsernameEnvKey,
+			inputHTTPSPasswordEnvKey,
+			NoPromptFlagName,
+		)
+	}
 	return fmt.Errorf("Failure: %w", err)
 }
 
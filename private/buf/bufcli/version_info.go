@@ -0,0 +1,75 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcli
+
+import (
+	"runtime"
+	"runtime/debug"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+)
+
+// ExternalVersionInfo is the structured version information of the CLI.
+//
+// This is printed with buf --version --format=json.
+type ExternalVersionInfo struct {
+	Version   string `json:"version,omitempty" yaml:"version,omitempty"`
+	GitCommit string `json:"git_commit,omitempty" yaml:"git_commit,omitempty"`
+	// GitCommitTime is the time of the git commit, in RFC 3339 format.
+	//
+	// This is not the time the binary was built.
+	GitCommitTime string `json:"git_commit_time,omitempty" yaml:"git_commit_time,omitempty"`
+	GoVersion     string `json:"go_version,omitempty" yaml:"go_version,omitempty"`
+	// ExperimentalFeatures are the environment variables of the experimental
+	// features that are enabled.
+	ExperimentalFeatures []string `json:"experimental_features" yaml:"experimental_features"`
+}
+
+// GetVersionInfo returns the ExternalVersionInfo for the CLI.
+//
+// The git commit and its time are only available if the binary was built with
+// VCS stamping, which is the default when building from a git checkout.
+func GetVersionInfo(container app.EnvContainer) (interface{}, error) {
+	externalVersionInfo := &ExternalVersionInfo{
+		Version:              Version,
+		GoVersion:            runtime.Version(),
+		ExperimentalFeatures: []string{},
+	}
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range buildInfo.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				externalVersionInfo.GitCommit = setting.Value
+			case "vcs.time":
+				externalVersionInfo.GitCommitTime = setting.Value
+			}
+		}
+	}
+	alphaWASMEnabled, err := IsAlphaWASMEnabled(container)
+	if err != nil {
+		return nil, err
+	}
+	if alphaWASMEnabled {
+		externalVersionInfo.ExperimentalFeatures = append(externalVersionInfo.ExperimentalFeatures, AlphaEnableWASMEnvKey)
+	}
+	betaTamperProofingEnabled, err := IsBetaTamperProofingEnabled(container)
+	if err != nil {
+		return nil, err
+	}
+	if betaTamperProofingEnabled {
+		externalVersionInfo.ExperimentalFeatures = append(externalVersionInfo.ExperimentalFeatures, BetaEnableTamperProofingEnvKey)
+	}
+	return externalVersionInfo, nil
+}
@@ -22,9 +22,11 @@ import (
 
 	"github.com/bufbuild/buf/private/buf/bufref"
 	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -76,6 +78,17 @@ func NewMessageEncodingRef(
 	return newMessageEncodingRef(path, messageEncoding), nil
 }
 
+// ApplyFieldMask clears all fields of the message that are not selected by the
+// field mask paths.
+//
+// Paths are dot-separated field names or JSON names, as in a google.protobuf.FieldMask.
+// A path that goes through a repeated message field applies to every element, and a
+// path that goes through a google.protobuf.Any applies to the packed message, which
+// is resolved with the resolver.
+func ApplyFieldMask(message proto.Message, paths []string, resolver protoencoding.Resolver) error {
+	return applyFieldMask(message, paths, resolver)
+}
+
 func getPathAndMessageEncoding(
 	ctx context.Context,
 	value string,
@@ -0,0 +1,204 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconvert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	anyFullName         = "google.protobuf.Any"
+	anyTypeURLFieldName = "type_url"
+	anyValueFieldName   = "value"
+)
+
+// fieldMaskNode is a node in the tree of field mask paths.
+//
+// A node without children selects the entire field.
+type fieldMaskNode struct {
+	children map[string]*fieldMaskNode
+}
+
+func newFieldMaskTree(paths []string) (*fieldMaskNode, error) {
+	root := &fieldMaskNode{children: make(map[string]*fieldMaskNode)}
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			return nil, fmt.Errorf("field mask path is empty")
+		}
+		node := root
+		for _, name := range strings.Split(path, ".") {
+			if name == "" {
+				return nil, fmt.Errorf("invalid field mask path %q", path)
+			}
+			if node.children == nil {
+				// A parent path was already selected in its entirety.
+				break
+			}
+			child, ok := node.children[name]
+			if !ok {
+				child = &fieldMaskNode{children: make(map[string]*fieldMaskNode)}
+				node.children[name] = child
+			}
+			node = child
+		}
+		// The last node selects the entire field, including any subpaths
+		// that were previously added.
+		node.children = nil
+	}
+	return root, nil
+}
+
+func applyFieldMask(message proto.Message, paths []string, resolver protoencoding.Resolver) error {
+	root, err := newFieldMaskTree(paths)
+	if err != nil {
+		return err
+	}
+	if err := validateFieldMaskNode(message.ProtoReflect().Descriptor(), root); err != nil {
+		return err
+	}
+	return applyFieldMaskNode(message.ProtoReflect(), root, resolver)
+}
+
+// validateFieldMaskNode validates that the field mask paths exist in the given
+// message descriptor.
+//
+// The fields of messages packed in a google.protobuf.Any are only validated when
+// the field mask is applied, as their type is not known until then.
+func validateFieldMaskNode(messageDescriptor protoreflect.MessageDescriptor, node *fieldMaskNode) error {
+	if len(node.children) == 0 || messageDescriptor.FullName() == anyFullName {
+		return nil
+	}
+	for name, child := range node.children {
+		fieldDescriptor, err := getFieldMaskFieldDescriptor(messageDescriptor, name)
+		if err != nil {
+			return err
+		}
+		if len(child.children) == 0 {
+			continue
+		}
+		if fieldDescriptor.IsMap() || fieldDescriptor.Message() == nil {
+			return fmt.Errorf("cannot select fields within field %q of message %q", name, messageDescriptor.FullName())
+		}
+		if err := validateFieldMaskNode(fieldDescriptor.Message(), child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyFieldMaskNode(message protoreflect.Message, node *fieldMaskNode, resolver protoencoding.Resolver) error {
+	if len(node.children) == 0 {
+		return nil
+	}
+	messageDescriptor := message.Descriptor()
+	if messageDescriptor.FullName() == anyFullName {
+		return applyFieldMaskAny(message, node, resolver)
+	}
+	fieldNumberToChild := make(map[protoreflect.FieldNumber]*fieldMaskNode, len(node.children))
+	for name, child := range node.children {
+		fieldDescriptor, err := getFieldMaskFieldDescriptor(messageDescriptor, name)
+		if err != nil {
+			return err
+		}
+		fieldNumberToChild[fieldDescriptor.Number()] = child
+	}
+	var clearFieldDescriptors []protoreflect.FieldDescriptor
+	var rangeErr error
+	message.Range(func(fieldDescriptor protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		child, ok := fieldNumberToChild[fieldDescriptor.Number()]
+		if !ok {
+			clearFieldDescriptors = append(clearFieldDescriptors, fieldDescriptor)
+			return true
+		}
+		if len(child.children) == 0 {
+			return true
+		}
+		if fieldDescriptor.IsList() {
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				if rangeErr = applyFieldMaskNode(list.Get(i).Message(), child, resolver); rangeErr != nil {
+					return false
+				}
+			}
+			return true
+		}
+		rangeErr = applyFieldMaskNode(value.Message(), child, resolver)
+		return rangeErr == nil
+	})
+	if rangeErr != nil {
+		return rangeErr
+	}
+	for _, fieldDescriptor := range clearFieldDescriptors {
+		message.Clear(fieldDescriptor)
+	}
+	message.SetUnknown(nil)
+	return nil
+}
+
+// applyFieldMaskAny applies the field mask to the message packed in the
+// google.protobuf.Any message.
+func applyFieldMaskAny(message protoreflect.Message, node *fieldMaskNode, resolver protoencoding.Resolver) error {
+	fields := message.Descriptor().Fields()
+	typeURLFieldDescriptor := fields.ByName(anyTypeURLFieldName)
+	valueFieldDescriptor := fields.ByName(anyValueFieldName)
+	if typeURLFieldDescriptor == nil || valueFieldDescriptor == nil {
+		return fmt.Errorf("invalid %s message", anyFullName)
+	}
+	typeURL := message.Get(typeURLFieldDescriptor).String()
+	if typeURL == "" {
+		return nil
+	}
+	messageType, err := resolver.FindMessageByURL(typeURL)
+	if err != nil {
+		return fmt.Errorf("could not resolve type %q of %s: %w", typeURL, anyFullName, err)
+	}
+	packedMessage := messageType.New()
+	if err := (proto.UnmarshalOptions{Resolver: resolver}).Unmarshal(
+		message.Get(valueFieldDescriptor).Bytes(),
+		packedMessage.Interface(),
+	); err != nil {
+		return err
+	}
+	if err := validateFieldMaskNode(packedMessage.Descriptor(), node); err != nil {
+		return err
+	}
+	if err := applyFieldMaskNode(packedMessage, node, resolver); err != nil {
+		return err
+	}
+	data, err := protoencoding.NewWireMarshaler().Marshal(packedMessage.Interface())
+	if err != nil {
+		return err
+	}
+	message.Set(valueFieldDescriptor, protoreflect.ValueOfBytes(data))
+	return nil
+}
+
+// getFieldMaskFieldDescriptor returns the field with the given name or JSON name.
+func getFieldMaskFieldDescriptor(messageDescriptor protoreflect.MessageDescriptor, name string) (protoreflect.FieldDescriptor, error) {
+	fields := messageDescriptor.Fields()
+	if fieldDescriptor := fields.ByName(protoreflect.Name(name)); fieldDescriptor != nil {
+		return fieldDescriptor, nil
+	}
+	if fieldDescriptor := fields.ByJSONName(name); fieldDescriptor != nil {
+		return fieldDescriptor, nil
+	}
+	return nil, fmt.Errorf("field %q not found in message %q", name, messageDescriptor.FullName())
+}
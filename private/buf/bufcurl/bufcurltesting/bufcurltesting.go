@@ -0,0 +1,62 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcurltesting
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// NewEchoMethod returns the test.v1.EchoService.Echo method for testing,
+// along with a Resolver for its schema.
+//
+// The method takes and returns a google.protobuf.StringValue.
+func NewEchoMethod(t testing.TB) (protoreflect.MethodDescriptor, protoencoding.Resolver) {
+	wrappersFileDescriptorProto := protodesc.ToFileDescriptorProto(wrapperspb.File_google_protobuf_wrappers_proto)
+	res, err := protoencoding.NewResolver(
+		wrappersFileDescriptorProto,
+		&descriptorpb.FileDescriptorProto{
+			Name:       proto.String("test/v1/echo.proto"),
+			Package:    proto.String("test.v1"),
+			Dependency: []string{wrappersFileDescriptorProto.GetName()},
+			Syntax:     proto.String("proto3"),
+			Service: []*descriptorpb.ServiceDescriptorProto{
+				{
+					Name: proto.String("EchoService"),
+					Method: []*descriptorpb.MethodDescriptorProto{
+						{
+							Name:       proto.String("Echo"),
+							InputType:  proto.String(".google.protobuf.StringValue"),
+							OutputType: proto.String(".google.protobuf.StringValue"),
+						},
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	descriptor, err := res.FindDescriptorByName("test.v1.EchoService.Echo")
+	require.NoError(t, err)
+	methodDescriptor, ok := descriptor.(protoreflect.MethodDescriptor)
+	require.True(t, ok)
+	return methodDescriptor, res
+}
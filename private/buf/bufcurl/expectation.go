@@ -0,0 +1,282 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcurl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ExitCodeExpectationFailed is the exit code of the error returned when an
+// expectation on the result of an RPC does not hold.
+const ExitCodeExpectationFailed = 2
+
+// BodyExpectation is an expectation on the JSON representation of a response
+// message.
+type BodyExpectation struct {
+	expression string
+	path       []interface{}
+	value      interface{}
+	notEqual   bool
+}
+
+// ParseBodyExpectation parses an expectation from an expression of the form
+// "path==value" or "path!=value".
+//
+// The path is a sequence of JSON field names separated by dots, each optionally
+// followed by list indexes in brackets, such as "items[0].name", and may start
+// with "$.". The value is a JSON value. A value that is not valid JSON, such as
+// an enum value name, is compared as a string.
+func ParseBodyExpectation(expression string) (*BodyExpectation, error) {
+	operatorIndex := strings.Index(expression, "==")
+	notEqual := false
+	if notEqualIndex := strings.Index(expression, "!="); notEqualIndex >= 0 && (operatorIndex < 0 || notEqualIndex < operatorIndex) {
+		operatorIndex = notEqualIndex
+		notEqual = true
+	}
+	if operatorIndex < 0 {
+		return nil, fmt.Errorf("invalid expectation %q: must be of the form path==value or path!=value", expression)
+	}
+	path, err := parseBodyExpectationPath(strings.TrimSpace(expression[:operatorIndex]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid expectation %q: %w", expression, err)
+	}
+	rawValue := strings.TrimSpace(expression[operatorIndex+2:])
+	value, err := decodeJSONValue([]byte(rawValue))
+	if err != nil {
+		value = rawValue
+	}
+	return &BodyExpectation{
+		expression: expression,
+		path:       path,
+		value:      value,
+		notEqual:   notEqual,
+	}, nil
+}
+
+// String returns the expression of the expectation.
+func (e *BodyExpectation) String() string {
+	return e.expression
+}
+
+// Check returns an error if the expectation does not hold for the message.
+//
+// Fields that are not set are compared with their default values, as they
+// are represented in JSON with EmitUnpopulated.
+func (e *BodyExpectation) Check(message proto.Message, res protoencoding.Resolver) error {
+	actual, ok, err := e.lookupMessage(message, res, false)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		actual, ok, err = e.lookupMessage(message, res, true)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return app.NewErrorf(ExitCodeExpectationFailed, "expectation %q failed: path not found in response", e.expression)
+		}
+	}
+	if jsonValuesEqual(actual, e.value) == e.notEqual {
+		actualData, err := json.Marshal(actual)
+		if err != nil {
+			return err
+		}
+		return app.NewErrorf(ExitCodeExpectationFailed, "expectation %q failed: value is %s", e.expression, actualData)
+	}
+	return nil
+}
+
+func (e *BodyExpectation) lookupMessage(message proto.Message, res protoencoding.Resolver, emitUnpopulated bool) (interface{}, bool, error) {
+	data, err := protojson.MarshalOptions{
+		Resolver:        res,
+		EmitUnpopulated: emitUnpopulated,
+	}.Marshal(message)
+	if err != nil {
+		return nil, false, err
+	}
+	value, err := decodeJSONValue(data)
+	if err != nil {
+		return nil, false, err
+	}
+	value, ok := e.lookup(value)
+	return value, ok, nil
+}
+
+func (e *BodyExpectation) lookup(value interface{}) (interface{}, bool) {
+	for _, element := range e.path {
+		switch element := element.(type) {
+		case string:
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if value, ok = object[element]; !ok {
+				return nil, false
+			}
+		case int:
+			list, ok := value.([]interface{})
+			if !ok || element >= len(list) {
+				return nil, false
+			}
+			value = list[element]
+		}
+	}
+	return value, true
+}
+
+// ParseCode parses a code from its name, such as "not_found" or "NOT_FOUND",
+// or from its number. The name "ok" and the number 0 are parsed as 0.
+func ParseCode(value string) (connect.Code, error) {
+	if number, err := strconv.ParseUint(value, 10, 32); err == nil && number <= uint64(connect.CodeUnauthenticated) {
+		return connect.Code(number), nil
+	}
+	name := strings.ToLower(value)
+	if name == "ok" {
+		return 0, nil
+	}
+	for code := connect.CodeCanceled; code <= connect.CodeUnauthenticated; code++ {
+		if code.String() == name {
+			return code, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown code %q", value)
+}
+
+// CodeString returns the name of the code, which is "ok" for 0.
+func CodeString(code connect.Code) string {
+	if code == 0 {
+		return "ok"
+	}
+	return code.String()
+}
+
+func parseBodyExpectationPath(path string) ([]interface{}, error) {
+	if path == "$" {
+		return nil, nil
+	}
+	path = strings.TrimPrefix(path, "$.")
+	if path == "" {
+		return nil, errors.New("path is empty")
+	}
+	var elements []interface{}
+	for _, component := range strings.Split(path, ".") {
+		name := component
+		var indexes string
+		if bracketIndex := strings.IndexByte(component, '['); bracketIndex >= 0 {
+			name, indexes = component[:bracketIndex], component[bracketIndex:]
+		}
+		if name == "" {
+			return nil, fmt.Errorf("path %q contains an empty field name", path)
+		}
+		elements = append(elements, name)
+		for indexes != "" {
+			closingIndex := strings.IndexByte(indexes, ']')
+			if indexes[0] != '[' || closingIndex < 0 {
+				return nil, fmt.Errorf("path %q contains an invalid index", path)
+			}
+			index, err := strconv.Atoi(indexes[1:closingIndex])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("path %q contains an invalid index", path)
+			}
+			elements = append(elements, index)
+			indexes = indexes[closingIndex+1:]
+		}
+	}
+	return elements, nil
+}
+
+func decodeJSONValue(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return value, nil
+}
+
+// jsonValuesEqual returns true if the decoded JSON values are equal.
+//
+// Numbers are equal to strings that contain the same number, as 64-bit
+// integers are strings in the JSON representation of messages.
+func jsonValuesEqual(a interface{}, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, aValue := range a {
+			bValue, ok := b[key]
+			if !ok || !jsonValuesEqual(aValue, bValue) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number, string:
+		aNumber, aIsNumber := jsonNumber(a)
+		bNumber, bIsNumber := jsonNumber(b)
+		if aIsNumber && bIsNumber {
+			return aNumber.Cmp(bNumber) == 0
+		}
+		_, aIsJSONNumber := a.(json.Number)
+		_, bIsJSONNumber := b.(json.Number)
+		if aIsJSONNumber || bIsJSONNumber {
+			return false
+		}
+		return a == b
+	default:
+		return a == b
+	}
+}
+
+func jsonNumber(value interface{}) (*big.Rat, bool) {
+	var s string
+	switch value := value.(type) {
+	case json.Number:
+		s = value.String()
+	case string:
+		s = value
+	default:
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
@@ -0,0 +1,121 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcurl
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestBodyExpectation(t *testing.T) {
+	t.Parallel()
+	message := &descriptorpb.FileDescriptorProto{
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: stringPointer("Foo"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:   stringPointer("bar"),
+						Number: int32Pointer(1),
+						Type:   descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+					},
+				},
+			},
+		},
+		Options: &descriptorpb.FileOptions{
+			JavaMultipleFiles: boolPointer(true),
+		},
+	}
+	testBodyExpectation(t, message, `messageType[0].name=="Foo"`, true)
+	testBodyExpectation(t, message, `messageType[0].name==Foo`, true)
+	testBodyExpectation(t, message, `$.messageType[0].name!=Foo`, false)
+	testBodyExpectation(t, message, `messageType[0].field[0].number==1`, true)
+	testBodyExpectation(t, message, `messageType[0].field[0].number=="1"`, true)
+	testBodyExpectation(t, message, `messageType[0].field[0].number==1.0`, true)
+	testBodyExpectation(t, message, `messageType[0].field[0].number==2`, false)
+	testBodyExpectation(t, message, `messageType[0].field[0].type==TYPE_INT64`, true)
+	testBodyExpectation(t, message, `options.javaMultipleFiles==true`, true)
+	testBodyExpectation(t, message, `options.javaMultipleFiles=="true"`, false)
+	testBodyExpectation(t, message, `options == {"javaMultipleFiles": true}`, true)
+	// Fields that are not set are compared with their default values.
+	testBodyExpectation(t, message, `name==null`, true)
+	testBodyExpectation(t, message, `dependency==[]`, true)
+	testBodyExpectation(t, message, `messageType[1].name==Foo`, false)
+	testBodyExpectation(t, message, `unknown!=1`, false)
+
+	for _, expression := range []string{
+		`name`,
+		`==1`,
+		`name.==1`,
+		`name[a]==1`,
+		`name[0==1`,
+	} {
+		_, err := ParseBodyExpectation(expression)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestParseCode(t *testing.T) {
+	t.Parallel()
+	for value, expectedCode := range map[string]connect.Code{
+		"ok":                0,
+		"0":                 0,
+		"not_found":         connect.CodeNotFound,
+		"NOT_FOUND":         connect.CodeNotFound,
+		"5":                 connect.CodeNotFound,
+		"unauthenticated":   connect.CodeUnauthenticated,
+		"invalid_argument":  connect.CodeInvalidArgument,
+		"deadline_exceeded": connect.CodeDeadlineExceeded,
+	} {
+		code, err := ParseCode(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expectedCode, code, value)
+	}
+	for _, value := range []string{"", "17", "notfound", "-1"} {
+		_, err := ParseCode(value)
+		assert.Error(t, err, value)
+	}
+	assert.Equal(t, "ok", CodeString(0))
+	assert.Equal(t, "not_found", CodeString(connect.CodeNotFound))
+}
+
+func testBodyExpectation(t *testing.T, message *descriptorpb.FileDescriptorProto, expression string, expectedHolds bool) {
+	bodyExpectation, err := ParseBodyExpectation(expression)
+	require.NoError(t, err, expression)
+	err = bodyExpectation.Check(message, nil)
+	if expectedHolds {
+		assert.NoError(t, err, expression)
+	} else {
+		assert.Error(t, err, expression)
+		assert.Equal(t, ExitCodeExpectationFailed, app.GetExitCode(err), expression)
+	}
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
+
+func int32Pointer(i int32) *int32 {
+	return &i
+}
+
+func boolPointer(b bool) *bool {
+	return &b
+}
@@ -70,12 +70,25 @@ func (p protoCodec) Unmarshal(bytes []byte, a any) error {
 type invokeClient = connect.Client[dynamicpb.Message, deferredMessage]
 
 type invoker struct {
-	md        protoreflect.MethodDescriptor
-	res       protoencoding.Resolver
-	client    *invokeClient
-	output    io.Writer
-	errOutput io.Writer
-	printer   verbose.Printer
+	md               protoreflect.MethodDescriptor
+	res              protoencoding.Resolver
+	client           *invokeClient
+	output           io.Writer
+	errOutput        io.Writer
+	printer          verbose.Printer
+	bodyExpectations []*BodyExpectation
+}
+
+// InvokerOption is an option for a new Invoker.
+type InvokerOption func(*invoker)
+
+// InvokerWithBodyExpectations returns a new InvokerOption that checks the
+// expectations for each response message after it is written. The RPC fails
+// with the error of the first expectation that does not hold.
+func InvokerWithBodyExpectations(bodyExpectations ...*BodyExpectation) InvokerOption {
+	return func(invoker *invoker) {
+		invoker.bodyExpectations = append(invoker.bodyExpectations, bodyExpectations...)
+	}
 }
 
 // NewInvoker creates a new invoker for invoking the method described by the
@@ -83,19 +96,27 @@ type invoker struct {
 // in JSON format. The given resolver is used to resolve Any messages and
 // extensions that appear in the input or output. Other parameters are used
 // to create a Connect client, for issuing the RPC.
-func NewInvoker(container appflag.Container, md protoreflect.MethodDescriptor, res protoencoding.Resolver, httpClient connect.HTTPClient, opts []connect.ClientOption, url string, out io.Writer) Invoker {
+func NewInvoker(container appflag.Container, md protoreflect.MethodDescriptor, res protoencoding.Resolver, httpClient connect.HTTPClient, opts []connect.ClientOption, url string, out io.Writer, options ...InvokerOption) Invoker {
+	return newInvoker(md, res, httpClient, opts, url, out, container.Stderr(), container.VerbosePrinter(), options...)
+}
+
+func newInvoker(md protoreflect.MethodDescriptor, res protoencoding.Resolver, httpClient connect.HTTPClient, opts []connect.ClientOption, url string, out io.Writer, errOut io.Writer, printer verbose.Printer, options ...InvokerOption) *invoker {
 	opts = append(opts, connect.WithCodec(protoCodec{}))
 	// TODO: could also provide custom compressor implementations that could give us
 	//  optics into when request and response messages are compressed (which could be
 	//  useful to include in verbose output).
-	return &invoker{
+	invoker := &invoker{
 		md:        md,
 		res:       res,
 		output:    out,
-		printer:   container.VerbosePrinter(),
-		errOutput: container.Stderr(),
+		printer:   printer,
+		errOutput: errOut,
 		client:    connect.NewClient[dynamicpb.Message, deferredMessage](httpClient, url, opts...),
 	}
+	for _, option := range options {
+		option(invoker)
+	}
+	return invoker
 }
 
 func (inv *invoker) Invoke(ctx context.Context, dataSource string, data io.Reader, headers http.Header) error {
@@ -285,8 +306,15 @@ func (inv *invoker) handleResponse(data []byte, msg *dynamicpb.Message) error {
 	if err != nil {
 		return err
 	}
-	_, err = fmt.Fprintf(inv.output, "%s\n", outputBytes)
-	return err
+	if _, err := fmt.Fprintf(inv.output, "%s\n", outputBytes); err != nil {
+		return err
+	}
+	for _, bodyExpectation := range inv.bodyExpectations {
+		if err := bodyExpectation.Check(msg, inv.res); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type clientStream interface {
@@ -378,7 +406,10 @@ func (inv *invoker) handleErrorResponse(connErr *connect.Error) error {
 	}
 	_, _ = inv.errOutput.Write(prettyPrinted.Bytes())
 	_, _ = inv.errOutput.Write([]byte("\n"))
-	return app.NewError(int(connErr.Code()*8), "")
+	return &rpcError{
+		code: connErr.Code(),
+		err:  app.NewError(int(connErr.Code()*8), ""),
+	}
 }
 
 func newStreamMessageProvider(dataSource string, data io.Reader, res protoencoding.Resolver) messageProvider {
@@ -431,3 +462,29 @@ func (s *streamMessageProvider) next(msg proto.Message) error {
 	proto.Reset(msg)
 	return protoencoding.NewJSONUnmarshaler(s.res).Unmarshal(jsonData, msg)
 }
+
+// ErrorCode returns the code of the RPC error returned by an Invoker, or false
+// if the error is not an RPC error.
+func ErrorCode(err error) (connect.Code, bool) {
+	var rpcErr *rpcError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.code, true
+	}
+	return 0, false
+}
+
+// rpcError is the error returned by an Invoker for a failed RPC, after the
+// error has been printed.
+type rpcError struct {
+	code connect.Code
+	// err contains the exit code for the RPC error.
+	err error
+}
+
+func (e *rpcError) Error() string {
+	return e.err.Error()
+}
+
+func (e *rpcError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,208 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcurl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/buf/private/pkg/verbose"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type protocolNegotiatingInvoker struct {
+	md              protoreflect.MethodDescriptor
+	res             protoencoding.Resolver
+	httpClient      connect.HTTPClient
+	protocols       []string
+	protocolOptions func(protocol string) []connect.ClientOption
+	url             string
+	output          io.Writer
+	errOutput       io.Writer
+	printer         verbose.Printer
+	options         []InvokerOption
+}
+
+// NewProtocolNegotiatingInvoker creates a new invoker that invokes the method
+// with the first of the given protocols, and retries with the next protocol
+// if the server responds in a way that shows that it does not support the
+// protocol: with a 415 Unsupported Media Type status, or with the content
+// type of another protocol. The given function returns the options of the
+// Connect client for each protocol. Other parameters are the same as for
+// NewInvoker.
+//
+// The request data that was read by an attempt is read again by the next
+// attempt.
+func NewProtocolNegotiatingInvoker(
+	container appflag.Container,
+	md protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	httpClient connect.HTTPClient,
+	protocols []string,
+	protocolOptions func(protocol string) []connect.ClientOption,
+	url string,
+	out io.Writer,
+	options ...InvokerOption,
+) Invoker {
+	return newProtocolNegotiatingInvoker(md, res, httpClient, protocols, protocolOptions, url, out, container.Stderr(), container.VerbosePrinter(), options...)
+}
+
+func newProtocolNegotiatingInvoker(
+	md protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	httpClient connect.HTTPClient,
+	protocols []string,
+	protocolOptions func(protocol string) []connect.ClientOption,
+	url string,
+	out io.Writer,
+	errOut io.Writer,
+	printer verbose.Printer,
+	options ...InvokerOption,
+) *protocolNegotiatingInvoker {
+	return &protocolNegotiatingInvoker{
+		md:              md,
+		res:             res,
+		httpClient:      httpClient,
+		protocols:       protocols,
+		protocolOptions: protocolOptions,
+		url:             url,
+		output:          out,
+		errOutput:       errOut,
+		printer:         printer,
+		options:         options,
+	}
+}
+
+func (p *protocolNegotiatingInvoker) Invoke(ctx context.Context, dataSource string, data io.Reader, headers http.Header) error {
+	var replayData *replayReader
+	if data != nil {
+		replayData = &replayReader{reader: data}
+		data = replayData
+	}
+	for i, protocol := range p.protocols {
+		httpClient := &responseRecordingClient{client: p.httpClient}
+		// The error output of an attempt is only written if the invocation is
+		// not retried. There is no other output, as the server rejects the
+		// protocol before sending any response message.
+		errOutput := bytes.NewBuffer(nil)
+		err := newInvoker(
+			p.md,
+			p.res,
+			httpClient,
+			p.protocolOptions(protocol),
+			p.url,
+			p.output,
+			errOutput,
+			p.printer,
+			p.options...,
+		).Invoke(ctx, dataSource, data, headers)
+		if err == nil || i == len(p.protocols)-1 || !httpClient.protocolNotSupported(protocol) {
+			if _, writeErr := p.errOutput.Write(errOutput.Bytes()); writeErr != nil && err == nil {
+				return writeErr
+			}
+			return err
+		}
+		p.printer.Printf("* Server does not support the %s protocol, retrying with the %s protocol", protocol, p.protocols[i+1])
+		if replayData != nil {
+			replayData = replayData.replay()
+			data = replayData
+		}
+	}
+	// should not be possible as there is always at least one protocol
+	return nil
+}
+
+// responseRecordingClient records the status and content type of the
+// responses of the wrapped client.
+type responseRecordingClient struct {
+	client       connect.HTTPClient
+	lock         sync.Mutex
+	statusCodes  []int
+	contentTypes []string
+}
+
+func (c *responseRecordingClient) Do(request *http.Request) (*http.Response, error) {
+	response, err := c.client.Do(request)
+	if response != nil {
+		c.lock.Lock()
+		c.statusCodes = append(c.statusCodes, response.StatusCode)
+		c.contentTypes = append(c.contentTypes, response.Header.Get("Content-Type"))
+		c.lock.Unlock()
+	}
+	return response, err
+}
+
+// protocolNotSupported returns true if a response shows that the server does
+// not support the protocol.
+func (c *responseRecordingClient) protocolNotSupported(protocol string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i, statusCode := range c.statusCodes {
+		if statusCode == http.StatusUnsupportedMediaType {
+			return true
+		}
+		if responseProtocol := protocolForContentType(c.contentTypes[i]); responseProtocol != "" && responseProtocol != protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// protocolForContentType returns the protocol that uses the content type
+// exclusively, or empty if the content type is not exclusive to one of
+// the gRPC and gRPC-Web protocols.
+//
+// The content types of the Connect protocol are not exclusive, as errors of
+// other protocols may be written as JSON.
+func protocolForContentType(contentType string) string {
+	contentType = strings.ToLower(strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0]))
+	switch {
+	case contentType == "application/grpc-web", strings.HasPrefix(contentType, "application/grpc-web+"),
+		contentType == "application/grpc-web-text", strings.HasPrefix(contentType, "application/grpc-web-text+"):
+		return connect.ProtocolGRPCWeb
+	case contentType == "application/grpc", strings.HasPrefix(contentType, "application/grpc+"):
+		return connect.ProtocolGRPC
+	default:
+		return ""
+	}
+}
+
+// replayReader records the data that is read from the reader, so that it
+// can be read again.
+type replayReader struct {
+	reader io.Reader
+	read   bytes.Buffer
+}
+
+func (r *replayReader) Read(data []byte) (int, error) {
+	n, err := r.reader.Read(data)
+	r.read.Write(data[:n])
+	return n, err
+}
+
+// replay returns a reader that reads the data that was read from r, followed
+// by the rest of the data of r.
+func (r *replayReader) replay() *replayReader {
+	return &replayReader{
+		reader: io.MultiReader(bytes.NewReader(r.read.Bytes()), r.reader),
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcurl
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bufbuild/buf/private/buf/bufcurl/bufcurltesting"
+	"github.com/bufbuild/buf/private/pkg/verbose"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolNegotiatingInvokerFallback(t *testing.T) {
+	t.Parallel()
+	var lock sync.Mutex
+	var contentTypes []string
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		contentTypes = append(contentTypes, request.Header.Get("Content-Type"))
+		lock.Unlock()
+		if request.Header.Get("Content-Type") != "application/grpc-web+proto" {
+			responseWriter.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		// Echo the request message, which is the only frame of the request.
+		body, err := io.ReadAll(request.Body)
+		if err != nil || len(body) < 5 {
+			responseWriter.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		responseWriter.Header().Set("Content-Type", "application/grpc-web+proto")
+		_, _ = responseWriter.Write(body)
+		_, _ = responseWriter.Write(newGRPCWebFrame(0x80, []byte("grpc-status: 0\r\n")))
+	}))
+	t.Cleanup(server.Close)
+	methodDescriptor, res := bufcurltesting.NewEchoMethod(t)
+	output := bytes.NewBuffer(nil)
+	errOutput := bytes.NewBuffer(nil)
+
+	err := newProtocolNegotiatingInvoker(
+		methodDescriptor,
+		res,
+		server.Client(),
+		[]string{connect.ProtocolConnect, connect.ProtocolGRPCWeb},
+		testProtocolOptions,
+		server.URL+"/test.v1.EchoService/Echo",
+		output,
+		errOutput,
+		verbose.NopPrinter,
+	).Invoke(context.Background(), "(argument)", strings.NewReader(`"hello"`), http.Header{})
+	require.NoError(t, err)
+	assert.Equal(t, `"hello"`+"\n", output.String())
+	assert.Empty(t, errOutput.String())
+	assert.Equal(t, []string{"application/proto", "application/grpc-web+proto"}, contentTypes)
+}
+
+func TestProtocolNegotiatingInvokerNoFallback(t *testing.T) {
+	t.Parallel()
+	var lock sync.Mutex
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		requests++
+		lock.Unlock()
+		responseWriter.Header().Set("Content-Type", "application/json")
+		responseWriter.WriteHeader(http.StatusNotFound)
+		_, _ = responseWriter.Write([]byte(`{"code": "unimplemented", "message": "not found"}`))
+	}))
+	t.Cleanup(server.Close)
+	methodDescriptor, res := bufcurltesting.NewEchoMethod(t)
+	output := bytes.NewBuffer(nil)
+	errOutput := bytes.NewBuffer(nil)
+
+	err := newProtocolNegotiatingInvoker(
+		methodDescriptor,
+		res,
+		server.Client(),
+		[]string{connect.ProtocolConnect, connect.ProtocolGRPCWeb},
+		testProtocolOptions,
+		server.URL+"/test.v1.EchoService/Echo",
+		output,
+		errOutput,
+		verbose.NopPrinter,
+	).Invoke(context.Background(), "(argument)", nil, http.Header{})
+	require.Error(t, err)
+	assert.Empty(t, output.String())
+	assert.Contains(t, errOutput.String(), `"unimplemented"`)
+	assert.Equal(t, 1, requests)
+}
+
+func TestProtocolForContentType(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, connect.ProtocolGRPC, protocolForContentType("application/grpc"))
+	assert.Equal(t, connect.ProtocolGRPC, protocolForContentType("application/grpc+proto"))
+	assert.Equal(t, connect.ProtocolGRPCWeb, protocolForContentType("application/grpc-web+json; charset=utf-8"))
+	assert.Equal(t, connect.ProtocolGRPCWeb, protocolForContentType("Application/gRPC-Web-Text"))
+	assert.Equal(t, "", protocolForContentType("application/json"))
+	assert.Equal(t, "", protocolForContentType("application/connect+proto"))
+	assert.Equal(t, "", protocolForContentType(""))
+}
+
+func testProtocolOptions(protocol string) []connect.ClientOption {
+	if protocol == connect.ProtocolGRPCWeb {
+		return []connect.ClientOption{connect.WithGRPCWeb()}
+	}
+	return nil
+}
+
+func newGRPCWebFrame(flags byte, data []byte) []byte {
+	frame := make([]byte, 5, 5+len(data))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	return append(frame, data...)
+}
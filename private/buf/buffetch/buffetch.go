@@ -34,6 +34,12 @@ const (
 	ImageEncodingBin ImageEncoding = iota + 1
 	// ImageEncodingJSON is the JSON image encoding.
 	ImageEncodingJSON
+	// ImageEncodingProtoset is the binary FileDescriptorSet encoding used by tools
+	// such as grpcurl.
+	//
+	// Images are always written with their imports and without the Buf-specific
+	// fields of Images.
+	ImageEncodingProtoset
 )
 
 var (
@@ -112,6 +118,15 @@ type SourceOrModuleRef interface {
 // SourceRef is a source bucket reference.
 type SourceRef interface {
 	SourceOrModuleRef
+	// DirPath returns the path of the local directory.
+	//
+	// This will be empty if the source is not a local directory.
+	DirPath() string
+	// ChangedGitRef returns the git ref that the target files of the local
+	// directory are restricted to the changes of.
+	//
+	// This will be empty if the target files are not restricted.
+	ChangedGitRef() string
 	internalBucketRef() internal.BucketRef
 }
 
@@ -357,6 +372,12 @@ func NewWriter(
 	)
 }
 
+// IsAuthError returns true if the error was returned because a remote input
+// requires credentials that were not provided, or rejects the provided credentials.
+func IsAuthError(err error) bool {
+	return internal.IsAuthError(err)
+}
+
 type getSourceBucketOptions struct {
 	workspacesDisabled bool
 }
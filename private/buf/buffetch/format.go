@@ -27,6 +27,8 @@ const (
 	formatJSON = "json"
 	// formatJSONGZ is the JSON gzipped format.
 	formatJSONGZ = "jsongz"
+	// formatProtoset is the binary FileDescriptorSet format used by tools such as grpcurl.
+	formatProtoset = "protoset"
 	// formatMod is the module format.
 	formatMod = "mod"
 	// formatTar is the tar format.
@@ -46,11 +48,13 @@ var (
 		formatBingz,
 		formatJSON,
 		formatJSONGZ,
+		formatProtoset,
 	}
 	// sorted
 	imageFormatsNotDeprecated = []string{
 		formatBin,
 		formatJSON,
+		formatProtoset,
 	}
 	// sorted
 	sourceFormats = []string{
@@ -112,6 +116,7 @@ var (
 		formatJSONGZ,
 		formatMod,
 		formatProtoFile,
+		formatProtoset,
 		formatTar,
 		formatTargz,
 		formatZip,
@@ -124,6 +129,7 @@ var (
 		formatJSON,
 		formatMod,
 		formatProtoFile,
+		formatProtoset,
 		formatTar,
 		formatZip,
 	}
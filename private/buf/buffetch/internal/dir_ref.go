@@ -27,13 +27,15 @@ var (
 )
 
 type dirRef struct {
-	format string
-	path   string
+	format        string
+	path          string
+	changedGitRef string
 }
 
 func newDirRef(
 	format string,
 	path string,
+	changedGitRef string,
 ) (*dirRef, error) {
 	if path == "" {
 		return nil, NewNoPathError()
@@ -54,16 +56,19 @@ func newDirRef(
 	return newDirectDirRef(
 		format,
 		normalpath.Normalize(path),
+		changedGitRef,
 	), nil
 }
 
 func newDirectDirRef(
 	format string,
 	path string,
+	changedGitRef string,
 ) *dirRef {
 	return &dirRef{
-		format: format,
-		path:   path,
+		format:        format,
+		path:          path,
+		changedGitRef: changedGitRef,
 	}
 }
 
@@ -75,6 +80,10 @@ func (r *dirRef) Path() string {
 	return r.path
 }
 
+func (r *dirRef) ChangedGitRef() string {
+	return r.changedGitRef
+}
+
 func (*dirRef) ref()       {}
 func (*dirRef) bucketRef() {}
 func (*dirRef) dirRef()    {}
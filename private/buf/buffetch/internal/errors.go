@@ -28,6 +28,42 @@ var (
 	}
 )
 
+// AuthError is a fetch error returned when a remote input requires credentials
+// that were not provided, or rejects the provided credentials.
+type AuthError struct {
+	remote string
+	cause  error
+}
+
+// NewAuthError returns a new AuthError for the remote.
+func NewAuthError(remote string, cause error) error {
+	return &AuthError{
+		remote: remote,
+		cause:  cause,
+	}
+}
+
+// Remote returns the remote that requires credentials.
+func (e *AuthError) Remote() string {
+	return e.remote
+}
+
+// Error implements error.
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("%s requires authentication: %v", e.remote, e.cause)
+}
+
+// Unwrap returns the cause of the AuthError.
+func (e *AuthError) Unwrap() error {
+	return e.cause
+}
+
+// IsAuthError returns true if the error is or wraps an AuthError.
+func IsAuthError(err error) bool {
+	var authErr *AuthError
+	return errors.As(err, &authErr)
+}
+
 // NewFormatNotAllowedError is a fetch error.
 func NewFormatNotAllowedError(format string, allowedFormats map[string]struct{}) error {
 	return fmt.Errorf("format was %q but must be one of %s", format, formatsToString(allowedFormats))
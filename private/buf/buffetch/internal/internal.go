@@ -152,13 +152,18 @@ type DirRef interface {
 	//
 	// This will be the non-empty normalized directory path for directories.
 	Path() string
+	// ChangedGitRef is the git ref that the files of the directory are
+	// restricted to the changes of.
+	//
+	// This will be empty if the files are not restricted.
+	ChangedGitRef() string
 	BucketRef
 	dirRef()
 }
 
 // NewDirRef returns a new DirRef.
 func NewDirRef(path string) (DirRef, error) {
-	return newDirRef("", path)
+	return newDirRef("", path, "")
 }
 
 // ProtoFileRef is a file reference that incorporates a BucketRef.
@@ -299,8 +304,8 @@ type ParsedDirRef interface {
 // NewDirectParsedDirRef returns a new ParsedDirRef with no validation checks.
 //
 // This should only be used for testing.
-func NewDirectParsedDirRef(format string, path string) ParsedDirRef {
-	return newDirectDirRef(format, path)
+func NewDirectParsedDirRef(format string, path string, changedGitRef string) ParsedDirRef {
+	return newDirectDirRef(format, path, changedGitRef)
 }
 
 // ParsedProtoFileRef is a parsed ProtoFileRef.
@@ -531,6 +536,10 @@ type RawRef struct {
 	// in the image for the ProtoFileRef.
 	// This defaults to false.
 	IncludePackageFiles bool
+	// Only set for dir formats.
+	// The git ref that the files of the directory are restricted to the
+	// changes of, as computed by git diff against this ref.
+	ChangedGitRef string
 }
 
 // RefParserOption is an RefParser option.
@@ -15,6 +15,8 @@
 package internal
 
 import (
+	"io"
+
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/storage"
 )
@@ -55,3 +57,17 @@ func (r *readBucketCloser) SubDirPath() string {
 func (r *readBucketCloser) SetSubDirPath(subDirPath string) {
 	r.subDirPath = subDirPath
 }
+
+type storageReadBucketCloser struct {
+	storage.ReadBucket
+	io.Closer
+}
+
+// newStorageReadBucketCloser returns a new storage.ReadBucketCloser that calls
+// the closer on Close.
+func newStorageReadBucketCloser(readBucket storage.ReadBucket, closer io.Closer) storage.ReadBucketCloser {
+	return storageReadBucketCloser{
+		ReadBucket: readBucket,
+		Closer:     closer,
+	}
+}
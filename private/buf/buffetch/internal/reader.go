@@ -15,7 +15,6 @@
 package internal
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -35,6 +34,7 @@ import (
 	"github.com/bufbuild/buf/private/pkg/storage/storagearchive"
 	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/tmp"
 	"github.com/klauspost/compress/zstd"
 	"github.com/klauspost/pgzip"
 	"go.opentelemetry.io/otel"
@@ -44,6 +44,11 @@ import (
 	"go.uber.org/zap"
 )
 
+// maxInMemoryArchiveSize is the maximum size of an uncompressed tarball of
+// known size that will be unarchived in memory. Larger archives are unarchived
+// to disk.
+const maxInMemoryArchiveSize = 32 << 20
+
 type reader struct {
 	logger            *zap.Logger
 	storageosProvider storageos.Provider
@@ -62,6 +67,10 @@ type reader struct {
 	moduleReader   bufmodule.ModuleReader
 	moduleResolver bufmodule.ModuleResolver
 	tracer         trace.Tracer
+
+	// maxInMemoryArchiveSize and tmpDirBasePath are only changed in tests.
+	maxInMemoryArchiveSize int64
+	tmpDirBasePath         string
 }
 
 func newReader(
@@ -70,9 +79,10 @@ func newReader(
 	options ...ReaderOption,
 ) *reader {
 	reader := &reader{
-		logger:            logger,
-		storageosProvider: storageosProvider,
-		tracer:            otel.GetTracerProvider().Tracer("bufbuild/buf"),
+		logger:                 logger,
+		storageosProvider:      storageosProvider,
+		tracer:                 otel.GetTracerProvider().Tracer("bufbuild/buf"),
+		maxInMemoryArchiveSize: maxInMemoryArchiveSize,
 	}
 	for _, option := range options {
 		option(reader)
@@ -206,12 +216,20 @@ func (r *reader) getArchiveBucket(
 	if err != nil {
 		return nil, err
 	}
-	readWriteBucket := storagemem.NewReadWriteBucket()
+	readWriteBucket, tmpDir, err := r.newArchiveReadWriteBucket(archiveRef, size)
+	if err != nil {
+		return nil, multierr.Append(err, readCloser.Close())
+	}
+	var closer io.Closer = ioextended.NopCloser
+	if tmpDir != nil {
+		closer = tmpDir
+	}
 	ctx, span := r.tracer.Start(ctx, "unarchive")
 	defer span.End()
 	defer func() {
 		retErr = multierr.Append(retErr, readCloser.Close())
 		if retErr != nil {
+			retErr = multierr.Append(retErr, closer.Close())
 			span.RecordError(retErr)
 			span.SetStatus(codes.Error, retErr.Error())
 		}
@@ -228,23 +246,9 @@ func (r *reader) getArchiveBucket(
 			return nil, err
 		}
 	case ArchiveTypeZip:
-		var readerAt io.ReaderAt
-		if size < 0 {
-			data, err := io.ReadAll(readCloser)
-			if err != nil {
-				return
-			}
-			readerAt = bytes.NewReader(data)
-			size = int64(len(data))
-		} else {
-			readerAt, err = ioextended.ReaderAtForReader(readCloser)
-			if err != nil {
-				return nil, err
-			}
-		}
-		if err := storagearchive.Unzip(
+		if err := storagearchive.UnzipReader(
 			ctx,
-			readerAt,
+			readCloser,
 			size,
 			readWriteBucket,
 			nil,
@@ -255,7 +259,14 @@ func (r *reader) getArchiveBucket(
 	default:
 		return nil, fmt.Errorf("unknown ArchiveType: %v", archiveType)
 	}
-	terminateFileProvider, err := getTerminateFileProviderForBucket(ctx, readWriteBucket, subDirPath, terminateFileNames)
+	var archiveReadBucket storage.ReadBucket = readWriteBucket
+	if tmpDir != nil {
+		archiveReadBucket, err = newTmpDirArchiveReadBucket(ctx, readWriteBucket)
+		if err != nil {
+			return nil, err
+		}
+	}
+	terminateFileProvider, err := getTerminateFileProviderForBucket(ctx, archiveReadBucket, subDirPath, terminateFileNames)
 	if err != nil {
 		return nil, err
 	}
@@ -271,7 +282,7 @@ func (r *reader) getArchiveBucket(
 			return nil, err
 		}
 		readBucketCloser, err := newReadBucketCloser(
-			storage.NopReadBucketCloser(storage.MapReadBucket(readWriteBucket, storage.MapOnPrefix(terminateFileDirectoryPath))),
+			newStorageReadBucketCloser(storage.MapReadBucket(archiveReadBucket, storage.MapOnPrefix(terminateFileDirectoryPath)), closer),
 			terminateFileDirectoryPath,
 			relativeSubDirPath,
 		)
@@ -283,12 +294,12 @@ func (r *reader) getArchiveBucket(
 			nil,
 		), nil
 	}
-	var readBucket storage.ReadBucket = readWriteBucket
+	readBucket := archiveReadBucket
 	if subDirPath != "." {
-		readBucket = storage.MapReadBucket(readWriteBucket, storage.MapOnPrefix(subDirPath))
+		readBucket = storage.MapReadBucket(archiveReadBucket, storage.MapOnPrefix(subDirPath))
 	}
 	readBucketCloser, err := newReadBucketCloser(
-		storage.NopReadBucketCloser(readBucket),
+		newStorageReadBucketCloser(readBucket, closer),
 		"",
 		"",
 	)
@@ -301,6 +312,71 @@ func (r *reader) getArchiveBucket(
 	), nil
 }
 
+// newArchiveReadWriteBucket returns a new ReadWriteBucket to unarchive the
+// archive into, and the temporary directory of the ReadWriteBucket to close when
+// done with it, or nil if the ReadWriteBucket is in memory. The size is the size
+// of the archive, or -1 if unknown.
+//
+// Only uncompressed tarballs are unarchived in memory, as the size of a tarball
+// bounds the size of its files. The files of zip archives and of compressed
+// tarballs can be arbitrarily larger than the archive, so these are unarchived
+// to a temporary directory, as are tarballs of unknown size or that are larger
+// than maxInMemoryArchiveSize, so that only their .proto files are kept in
+// memory.
+func (r *reader) newArchiveReadWriteBucket(
+	archiveRef ArchiveRef,
+	size int64,
+) (storage.ReadWriteBucket, io.Closer, error) {
+	if archiveRef.ArchiveType() == ArchiveTypeTar &&
+		archiveRef.CompressionType() == CompressionTypeNone &&
+		size >= 0 &&
+		size <= r.maxInMemoryArchiveSize {
+		return storagemem.NewReadWriteBucket(), nil, nil
+	}
+	var tmpDirOptions []tmp.DirOption
+	if r.tmpDirBasePath != "" {
+		tmpDirOptions = append(tmpDirOptions, tmp.DirWithBasePath(r.tmpDirBasePath))
+	}
+	tmpDir, err := tmp.NewDir(tmpDirOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+	readWriteBucket, err := r.storageosProvider.NewReadWriteBucket(tmpDir.AbsPath())
+	if err != nil {
+		return nil, nil, multierr.Append(err, tmpDir.Close())
+	}
+	return readWriteBucket, tmpDir, nil
+}
+
+// newTmpDirArchiveReadBucket returns the ReadBucket to read the files of an
+// archive that was unarchived to a temporary directory from.
+//
+// The Modules built from the ReadBucket read their .proto files after the
+// ReadBucketCloser is closed and the temporary directory is removed, so the
+// .proto files are copied to memory. The other files, such as configuration and
+// documentation files, are only read from the temporary directory while it
+// exists. We also do not want the temporary directory to show up in external
+// paths.
+func newTmpDirArchiveReadBucket(
+	ctx context.Context,
+	tmpDirReadWriteBucket storage.ReadWriteBucket,
+) (storage.ReadBucket, error) {
+	protoReadWriteBucket := storagemem.NewReadWriteBucket()
+	if _, err := storage.Copy(
+		ctx,
+		storage.MapReadBucket(tmpDirReadWriteBucket, storage.MatchPathExt(".proto")),
+		protoReadWriteBucket,
+	); err != nil {
+		return nil, err
+	}
+	return storage.MultiReadBucket(
+		protoReadWriteBucket,
+		storage.NoExternalPathReadBucket(
+			storage.MapReadBucket(tmpDirReadWriteBucket, storage.MatchNot(storage.MatchPathExt(".proto"))),
+		),
+	), nil
+}
+
 func (r *reader) getDirBucket(
 	ctx context.Context,
 	container app.EnvStdinContainer,
@@ -483,6 +559,9 @@ func (r *reader) getGitBucket(
 			RecurseSubmodules: gitRef.RecurseSubmodules(),
 		},
 	); err != nil {
+		if errors.Is(err, git.ErrAuthenticationFailed) {
+			return nil, NewAuthError(gitURL, err)
+		}
 		return nil, fmt.Errorf("could not clone %s: %v", gitURL, err)
 	}
 	terminateFileProvider, err := getTerminateFileProviderForBucket(ctx, readWriteBucket, subDirPath, terminateFileNames)
@@ -670,6 +749,30 @@ func (r *reader) getFileReadCloserAndSizePotentiallyCompressedHTTP(
 	if err != nil {
 		return nil, -1, err
 	}
+	if response.StatusCode == http.StatusUnauthorized {
+		if challengeAuthenticator, ok := r.httpAuthenticator.(httpauth.ChallengeAuthenticator); ok {
+			if err := response.Body.Close(); err != nil {
+				return nil, -1, err
+			}
+			ok, err := challengeAuthenticator.SetChallengeAuth(container, request)
+			if err != nil {
+				return nil, -1, err
+			}
+			if !ok {
+				return nil, -1, NewAuthError(request.URL.Host, errors.New("got HTTP status code 401"))
+			}
+			response, err = r.httpClient.Do(request)
+			if err != nil {
+				return nil, -1, err
+			}
+		}
+		if response.StatusCode == http.StatusUnauthorized {
+			return nil, -1, multierr.Append(
+				NewAuthError(request.URL.Host, errors.New("got HTTP status code 401")),
+				response.Body.Close(),
+			)
+		}
+	}
 	if response.StatusCode != http.StatusOK {
 		err := fmt.Errorf("got HTTP status code %d", response.StatusCode)
 		if response.Body != nil {
@@ -0,0 +1,153 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storagearchive"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetArchiveBucketTarInMemory(t *testing.T) {
+	t.Parallel()
+	testGetArchiveBucket(t, "archive.tar", ArchiveTypeTar, CompressionTypeNone, 0, false)
+}
+
+func TestGetArchiveBucketTarLargerThanMaxInMemoryArchiveSize(t *testing.T) {
+	t.Parallel()
+	testGetArchiveBucket(t, "archive.tar", ArchiveTypeTar, CompressionTypeNone, 16, true)
+}
+
+func TestGetArchiveBucketTarGz(t *testing.T) {
+	t.Parallel()
+	testGetArchiveBucket(t, "archive.tar.gz", ArchiveTypeTar, CompressionTypeGzip, 0, true)
+}
+
+func TestGetArchiveBucketZip(t *testing.T) {
+	t.Parallel()
+	testGetArchiveBucket(t, "archive.zip", ArchiveTypeZip, CompressionTypeNone, 0, true)
+}
+
+func testGetArchiveBucket(
+	t *testing.T,
+	fileName string,
+	archiveType ArchiveType,
+	compressionType CompressionType,
+	maxInMemoryArchiveSize int64,
+	expectTmpDir bool,
+) {
+	ctx := context.Background()
+	files := map[string][]byte{
+		"a.proto":     []byte(`syntax = "proto3";`),
+		"foo/b.proto": []byte(`syntax = "proto3"; package foo;`),
+		"buf.yaml":    []byte(`version: v1`),
+	}
+	archiveFilePath := filepath.Join(t.TempDir(), fileName)
+	require.NoError(t, os.WriteFile(archiveFilePath, testNewArchive(t, files, archiveType, compressionType), 0600))
+	archiveRef, err := NewArchiveRef(archiveFilePath, archiveType, compressionType, 0, "")
+	require.NoError(t, err)
+	reader := newReader(zap.NewNop(), storageos.NewProvider(), WithReaderLocal())
+	if maxInMemoryArchiveSize > 0 {
+		reader.maxInMemoryArchiveSize = maxInMemoryArchiveSize
+	}
+	reader.tmpDirBasePath = t.TempDir()
+	readBucketCloser, err := reader.GetBucket(ctx, app.NewContainer(nil, nil, nil, nil), archiveRef)
+	require.NoError(t, err)
+	tmpDirEntries, err := os.ReadDir(reader.tmpDirBasePath)
+	require.NoError(t, err)
+	if expectTmpDir {
+		require.Len(t, tmpDirEntries, 1)
+	} else {
+		require.Empty(t, tmpDirEntries)
+	}
+	for path, expectedData := range files {
+		data, err := storage.ReadPath(ctx, readBucketCloser, path)
+		require.NoError(t, err)
+		require.Equal(t, expectedData, data)
+	}
+	require.NoError(t, readBucketCloser.Close())
+	tmpDirEntries, err = os.ReadDir(reader.tmpDirBasePath)
+	require.NoError(t, err)
+	require.Empty(t, tmpDirEntries)
+	// Modules read their .proto files after the bucket is closed.
+	for _, path := range []string{"a.proto", "foo/b.proto"} {
+		data, err := storage.ReadPath(ctx, readBucketCloser, path)
+		require.NoError(t, err)
+		require.Equal(t, files[path], data)
+	}
+	var walkedPaths []string
+	require.NoError(
+		t,
+		readBucketCloser.Walk(
+			ctx,
+			"",
+			func(objectInfo storage.ObjectInfo) error {
+				require.Equal(t, objectInfo.Path(), objectInfo.ExternalPath())
+				walkedPaths = append(walkedPaths, objectInfo.Path())
+				return nil
+			},
+		),
+	)
+	if expectTmpDir {
+		require.ElementsMatch(t, []string{"a.proto", "foo/b.proto"}, walkedPaths)
+	} else {
+		require.ElementsMatch(t, []string{"a.proto", "foo/b.proto", "buf.yaml"}, walkedPaths)
+	}
+}
+
+func testNewArchive(
+	t *testing.T,
+	files map[string][]byte,
+	archiveType ArchiveType,
+	compressionType CompressionType,
+) []byte {
+	ctx := context.Background()
+	readBucket, err := storagemem.NewReadBucket(files)
+	require.NoError(t, err)
+	buffer := bytes.NewBuffer(nil)
+	switch archiveType {
+	case ArchiveTypeTar:
+		require.NoError(t, storagearchive.Tar(ctx, readBucket, buffer))
+	case ArchiveTypeZip:
+		require.NoError(t, storagearchive.Zip(ctx, readBucket, buffer, true))
+	default:
+		t.Fatalf("unknown ArchiveType: %v", archiveType)
+	}
+	switch compressionType {
+	case CompressionTypeNone:
+		return buffer.Bytes()
+	case CompressionTypeGzip:
+		gzipBuffer := bytes.NewBuffer(nil)
+		gzipWriter := gzip.NewWriter(gzipBuffer)
+		_, err := gzipWriter.Write(buffer.Bytes())
+		require.NoError(t, err)
+		require.NoError(t, gzipWriter.Close())
+		return gzipBuffer.Bytes()
+	default:
+		t.Fatalf("unknown CompressionType: %v", compressionType)
+		return nil
+	}
+}
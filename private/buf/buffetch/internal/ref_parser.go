@@ -197,6 +197,8 @@ func (a *refParser) getRawRef(value string) (*RawRef, error) {
 			default:
 				return nil, NewOptionsInvalidKeyError(key)
 			}
+		case "changed":
+			rawRef.ChangedGitRef = value
 		default:
 			return nil, NewOptionsInvalidKeyError(key)
 		}
@@ -209,6 +211,7 @@ func (a *refParser) getRawRef(value string) (*RawRef, error) {
 	_, gitOK := a.gitFormatToInfo[rawRef.Format]
 	archiveFormatInfo, archiveOK := a.archiveFormatToInfo[rawRef.Format]
 	_, singleOK := a.singleFormatToInfo[rawRef.Format]
+	_, dirOK := a.dirFormatToInfo[rawRef.Format]
 	if gitOK {
 		if rawRef.GitRef != "" && rawRef.GitTag != "" {
 			return nil, NewCannotSpecifyTagWithRefError()
@@ -246,6 +249,11 @@ func (a *refParser) getRawRef(value string) (*RawRef, error) {
 			return nil, NewOptionsInvalidForFormatError(rawRef.Format, value)
 		}
 	}
+	if !dirOK {
+		if rawRef.ChangedGitRef != "" {
+			return nil, NewOptionsInvalidForFormatError(rawRef.Format, value)
+		}
+	}
 	return rawRef, nil
 }
 
@@ -289,6 +297,7 @@ func getDirRef(
 	return newDirRef(
 		rawRef.Format,
 		rawRef.Path,
+		rawRef.ChangedGitRef,
 	)
 }
 
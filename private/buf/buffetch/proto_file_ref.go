@@ -53,6 +53,14 @@ func (r *protoFileRef) PathForExternalPath(externalPath string) (string, error)
 	return ".", nil
 }
 
+func (*protoFileRef) DirPath() string {
+	return ""
+}
+
+func (*protoFileRef) ChangedGitRef() string {
+	return ""
+}
+
 func (r *protoFileRef) IncludePackageFiles() bool {
 	return r.protoFileRef.IncludePackageFiles()
 }
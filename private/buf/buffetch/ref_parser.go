@@ -51,6 +51,7 @@ func newRefParser(logger *zap.Logger) *refParser {
 			internal.WithRawRefProcessor(newRawRefProcessor()),
 			internal.WithSingleFormat(formatBin),
 			internal.WithSingleFormat(formatJSON),
+			internal.WithSingleFormat(formatProtoset),
 			internal.WithSingleFormat(
 				formatBingz,
 				internal.WithSingleDefaultCompressionType(
@@ -94,6 +95,7 @@ func newImageRefParser(logger *zap.Logger) *refParser {
 			internal.WithRawRefProcessor(processRawRefImage),
 			internal.WithSingleFormat(formatBin),
 			internal.WithSingleFormat(formatJSON),
+			internal.WithSingleFormat(formatProtoset),
 			internal.WithSingleFormat(
 				formatBingz,
 				internal.WithSingleDefaultCompressionType(
@@ -368,6 +370,8 @@ func newRawRefProcessor() func(*internal.RawRef) error {
 				format = formatBin
 			case ".json":
 				format = formatJSON
+			case ".protoset":
+				format = formatProtoset
 			case ".tar":
 				format = formatTar
 			case ".zip":
@@ -379,6 +383,8 @@ func newRawRefProcessor() func(*internal.RawRef) error {
 					format = formatBin
 				case ".json":
 					format = formatJSON
+				case ".protoset":
+					format = formatProtoset
 				case ".tar":
 					format = formatTar
 				default:
@@ -391,6 +397,8 @@ func newRawRefProcessor() func(*internal.RawRef) error {
 					format = formatBin
 				case ".json":
 					format = formatJSON
+				case ".protoset":
+					format = formatProtoset
 				case ".tar":
 					format = formatTar
 				default:
@@ -518,6 +526,8 @@ func processRawRefImage(rawRef *internal.RawRef) error {
 			format = formatBin
 		case ".json":
 			format = formatJSON
+		case ".protoset":
+			format = formatProtoset
 		case ".gz":
 			compressionType = internal.CompressionTypeGzip
 			switch filepath.Ext(strings.TrimSuffix(rawRef.Path, filepath.Ext(rawRef.Path))) {
@@ -525,6 +535,8 @@ func processRawRefImage(rawRef *internal.RawRef) error {
 				format = formatBin
 			case ".json":
 				format = formatJSON
+			case ".protoset":
+				format = formatProtoset
 			default:
 				return fmt.Errorf("path %q had .gz extension with unknown format", rawRef.Path)
 			}
@@ -535,6 +547,8 @@ func processRawRefImage(rawRef *internal.RawRef) error {
 				format = formatBin
 			case ".json":
 				format = formatJSON
+			case ".protoset":
+				format = formatProtoset
 			default:
 				return fmt.Errorf("path %q had .zst extension with unknown format", rawRef.Path)
 			}
@@ -558,6 +572,8 @@ func parseImageEncoding(format string) (ImageEncoding, error) {
 		return ImageEncodingBin, nil
 	case formatJSON, formatJSONGZ:
 		return ImageEncodingJSON, nil
+	case formatProtoset:
+		return ImageEncodingProtoset, nil
 	default:
 		return 0, fmt.Errorf("invalid format for image: %q", format)
 	}
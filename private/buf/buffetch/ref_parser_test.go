@@ -47,6 +47,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 		internal.NewDirectParsedDirRef(
 			formatDir,
 			"path/to/some/dir",
+			"",
 		),
 		"path/to/some/dir",
 	)
@@ -55,6 +56,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 		internal.NewDirectParsedDirRef(
 			formatDir,
 			".",
+			"",
 		),
 		".",
 	)
@@ -63,6 +65,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 		internal.NewDirectParsedDirRef(
 			formatDir,
 			normalpath.Normalize(root),
+			"",
 		),
 		root,
 	)
@@ -71,6 +74,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 		internal.NewDirectParsedDirRef(
 			formatDir,
 			".",
+			"",
 		),
 		"foo/..",
 	)
@@ -79,6 +83,7 @@ func TestGetParsedRefSuccess(t *testing.T) {
 		internal.NewDirectParsedDirRef(
 			formatDir,
 			"../foo",
+			"",
 		),
 		"../foo",
 	)
@@ -87,9 +92,19 @@ func TestGetParsedRefSuccess(t *testing.T) {
 		internal.NewDirectParsedDirRef(
 			formatDir,
 			normalpath.Normalize(expectedAbsDir),
+			"",
 		),
 		absPath,
 	)
+	testGetParsedRefSuccess(
+		t,
+		internal.NewDirectParsedDirRef(
+			formatDir,
+			"path/to/some/dir",
+			"origin/main",
+		),
+		"path/to/some/dir#changed=origin/main",
+	)
 	testGetParsedRefSuccess(
 		t,
 		internal.NewDirectParsedArchiveRef(
@@ -487,6 +502,26 @@ func TestGetParsedRefSuccess(t *testing.T) {
 		),
 		"path/to/file.bin.gz",
 	)
+	testGetParsedRefSuccess(
+		t,
+		internal.NewDirectParsedSingleRef(
+			formatProtoset,
+			"path/to/file.protoset",
+			internal.FileSchemeLocal,
+			internal.CompressionTypeNone,
+		),
+		"path/to/file.protoset",
+	)
+	testGetParsedRefSuccess(
+		t,
+		internal.NewDirectParsedSingleRef(
+			formatProtoset,
+			"path/to/file.protoset.gz",
+			internal.FileSchemeLocal,
+			internal.CompressionTypeGzip,
+		),
+		"path/to/file.protoset.gz",
+	)
 	testGetParsedRefSuccess(
 		t,
 		internal.NewDirectParsedSingleRef(
@@ -987,6 +1022,11 @@ func TestGetParsedRefError(t *testing.T) {
 		internal.NewOptionsInvalidForFormatError(formatDir, "path/to/some/foo#compression=none"),
 		"path/to/some/foo#compression=none",
 	)
+	testGetParsedRefError(
+		t,
+		internal.NewOptionsInvalidForFormatError(formatTar, "path/to/foo.tar#changed=main"),
+		"path/to/foo.tar#changed=main",
+	)
 	testGetParsedRefError(
 		t,
 		internal.NewCannotSpecifyCompressionForZipError(),
@@ -24,21 +24,33 @@ import (
 var _ SourceRef = &sourceRef{}
 
 type sourceRef struct {
-	bucketRef internal.BucketRef
-	dirPath   string
+	bucketRef     internal.BucketRef
+	dirPath       string
+	changedGitRef string
 }
 
 func newSourceRef(bucketRef internal.BucketRef) *sourceRef {
 	var dirPath string
+	var changedGitRef string
 	if dirRef, ok := bucketRef.(internal.DirRef); ok {
 		dirPath = dirRef.Path()
+		changedGitRef = dirRef.ChangedGitRef()
 	}
 	return &sourceRef{
-		bucketRef: bucketRef,
-		dirPath:   dirPath,
+		bucketRef:     bucketRef,
+		dirPath:       dirPath,
+		changedGitRef: changedGitRef,
 	}
 }
 
+func (r *sourceRef) DirPath() string {
+	return r.dirPath
+}
+
+func (r *sourceRef) ChangedGitRef() string {
+	return r.changedGitRef
+}
+
 func (r *sourceRef) PathForExternalPath(externalPath string) (string, error) {
 	if r.dirPath == "" {
 		return normalpath.NormalizeAndValidate(externalPath)
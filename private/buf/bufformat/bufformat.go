@@ -15,7 +15,10 @@
 package bufformat
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/pkg/storage"
@@ -26,8 +29,59 @@ import (
 	"go.uber.org/multierr"
 )
 
+const (
+	// CommentStylePreserve preserves the style of comments.
+	CommentStylePreserve CommentStyle = iota + 1
+	// CommentStyleLine normalizes comments to line comments, i.e. "// comment".
+	CommentStyleLine
+	// CommentStyleBlock normalizes comments to block comments, i.e. "/* comment */".
+	CommentStyleBlock
+)
+
+var (
+	commentStyleToString = map[CommentStyle]string{
+		CommentStylePreserve: "preserve",
+		CommentStyleLine:     "line",
+		CommentStyleBlock:    "block",
+	}
+	stringToCommentStyle = map[string]CommentStyle{
+		"preserve": CommentStylePreserve,
+		"line":     CommentStyleLine,
+		"block":    CommentStyleBlock,
+	}
+)
+
+// CommentStyle is the style that comments are normalized to.
+type CommentStyle int
+
+// String implements fmt.Stringer.
+func (c CommentStyle) String() string {
+	if s, ok := commentStyleToString[c]; ok {
+		return s
+	}
+	return strconv.Itoa(int(c))
+}
+
+// ParseCommentStyle parses the CommentStyle.
+//
+// If the empty string is provided, this is interpreted as CommentStylePreserve.
+func ParseCommentStyle(s string) (CommentStyle, error) {
+	if s == "" {
+		return CommentStylePreserve, nil
+	}
+	c, ok := stringToCommentStyle[s]
+	if !ok {
+		return 0, fmt.Errorf("unknown comment style: %q", s)
+	}
+	return c, nil
+}
+
 // Format formats and writes the target module files into a read bucket.
-func Format(ctx context.Context, module bufmodule.Module) (_ storage.ReadBucket, retErr error) {
+func Format(ctx context.Context, module bufmodule.Module, options ...FormatOption) (_ storage.ReadBucket, retErr error) {
+	formatOptions := newFormatOptions()
+	for _, option := range options {
+		option(formatOptions)
+	}
 	fileInfos, err := module.TargetFileInfos(ctx)
 	if err != nil {
 		return nil, err
@@ -55,7 +109,19 @@ func Format(ctx context.Context, module bufmodule.Module) (_ storage.ReadBucket,
 			defer func() {
 				retErr = multierr.Append(retErr, writeObjectCloser.Close())
 			}()
-			if err := newFormatter(writeObjectCloser, fileNode).Run(); err != nil {
+			buffer := bytes.NewBuffer(nil)
+			formatter := newFormatter(buffer, fileNode)
+			formatter.commentColumnLimit = formatOptions.commentColumnLimit
+			formatter.commentStyle = formatOptions.commentStyle
+			formatter.preserveBlankLines = formatOptions.preserveBlankLines
+			if err := formatter.Run(); err != nil {
+				return err
+			}
+			data := buffer.Bytes()
+			if formatOptions.alignTrailingComments {
+				data = alignTrailingComments(data)
+			}
+			if _, err := writeObjectCloser.Write(data); err != nil {
 				return err
 			}
 			return writeObjectCloser.SetExternalPath(moduleFile.ExternalPath())
@@ -66,3 +132,72 @@ func Format(ctx context.Context, module bufmodule.Module) (_ storage.ReadBucket,
 	}
 	return readWriteBucket, nil
 }
+
+// FormatOption is an option for Format.
+type FormatOption func(*formatOptions)
+
+// FormatWithCommentColumnLimit returns a new FormatOption that reflows the
+// paragraphs of line comments that exceed the given column limit, so that they
+// fit within the column limit where possible.
+//
+// Paragraphs that fit within the column limit are left as-is, as are lines that
+// are indented further than the paragraph, such as code samples, and lines with
+// buf directives, such as buf:lint:ignore.
+//
+// The default is to not reflow comments.
+func FormatWithCommentColumnLimit(commentColumnLimit int) FormatOption {
+	return func(formatOptions *formatOptions) {
+		formatOptions.commentColumnLimit = commentColumnLimit
+	}
+}
+
+// FormatWithCommentStyle returns a new FormatOption that normalizes the leading
+// and trailing comments of declarations to the given style.
+//
+// Comments written in-line between the tokens of a declaration are always block
+// comments. Line comments are not converted to block comments if they contain
+// "*/" or buf directives, such as buf:lint:ignore.
+//
+// The default is CommentStylePreserve.
+func FormatWithCommentStyle(commentStyle CommentStyle) FormatOption {
+	return func(formatOptions *formatOptions) {
+		formatOptions.commentStyle = commentStyle
+	}
+}
+
+// FormatWithAlignedTrailingComments returns a new FormatOption that aligns the
+// trailing line comments of consecutive lines at the same indentation level.
+//
+// The default is to separate trailing comments from the declaration with a
+// single space.
+func FormatWithAlignedTrailingComments() FormatOption {
+	return func(formatOptions *formatOptions) {
+		formatOptions.alignTrailingComments = true
+	}
+}
+
+// FormatWithPreservedBlankLines returns a new FormatOption that preserves the
+// blank lines between declarations as written, including the groups of imports
+// and file options that are separated by blank lines. Imports and file options
+// are sorted within each group.
+//
+// The default is to collapse consecutive blank lines into a single blank line,
+// and to write all imports and all file options as a single sorted group each.
+func FormatWithPreservedBlankLines() FormatOption {
+	return func(formatOptions *formatOptions) {
+		formatOptions.preserveBlankLines = true
+	}
+}
+
+type formatOptions struct {
+	commentColumnLimit    int
+	commentStyle          CommentStyle
+	alignTrailingComments bool
+	preserveBlankLines    bool
+}
+
+func newFormatOptions() *formatOptions {
+	return &formatOptions{
+		commentStyle: CommentStylePreserve,
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufformat
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bufbuild/protocompile/ast"
+)
+
+// commentDirectivePrefix is the prefix of comment lines that are read by buf,
+// such as "buf:lint:ignore". These lines are never reflowed, and comments that
+// contain them are never converted to block comments so that they are still
+// recognized.
+const commentDirectivePrefix = "buf:"
+
+// commentGroup is a group of leading comments that are written together. A
+// group either consists of consecutive line comments, or of a single block
+// comment.
+type commentGroup struct {
+	// newlinesBefore is the number of newlines that separate the group from
+	// the previous group or node.
+	newlinesBefore int
+	block          bool
+	rawTexts       []string
+}
+
+// newCommentGroups groups the given comments. The first group is never
+// preceded by newlines if compact is true.
+func newCommentGroups(comments ast.Comments, compact bool) []*commentGroup {
+	var commentGroups []*commentGroup
+	for i := 0; i < comments.Len(); i++ {
+		comment := comments.Index(i)
+		var newlinesBefore int
+		if !compact {
+			newlinesBefore = newlineCount(comment.LeadingWhitespace())
+		}
+		compact = false
+		rawText := strings.TrimSpace(comment.RawText())
+		block := strings.HasPrefix(rawText, "/*")
+		if !block && newlinesBefore <= 1 && len(commentGroups) > 0 && !commentGroups[len(commentGroups)-1].block {
+			lastCommentGroup := commentGroups[len(commentGroups)-1]
+			lastCommentGroup.rawTexts = append(lastCommentGroup.rawTexts, rawText)
+			continue
+		}
+		commentGroups = append(
+			commentGroups,
+			&commentGroup{
+				newlinesBefore: newlinesBefore,
+				block:          block,
+				rawTexts:       []string{rawText},
+			},
+		)
+	}
+	return commentGroups
+}
+
+// lineCommentText returns the text of the given line comment without the
+// comment marker and the single space that usually follows it.
+func lineCommentText(rawText string) string {
+	text := strings.TrimRightFunc(strings.TrimPrefix(rawText, "//"), unicode.IsSpace)
+	return strings.TrimPrefix(text, " ")
+}
+
+// blockCommentLines returns the lines of text of the given block comment
+// without the comment markers, the leading asterisks of each line (if all
+// lines have them), and the leading and trailing blank lines.
+func blockCommentLines(rawText string) []string {
+	text := strings.TrimSuffix(strings.TrimPrefix(rawText, "/*"), "*/")
+	// Documentation comments start with "/**".
+	text = strings.TrimPrefix(text, "*")
+	lines := strings.Split(text, "\n")
+	hasAsteriskPrefix := len(lines) > 1
+	minIndent := -1
+	for _, line := range lines[1:] {
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine == "" {
+			continue
+		}
+		if !strings.HasPrefix(trimmedLine, "*") {
+			hasAsteriskPrefix = false
+		}
+		if indent, ok := computeIndent(line); ok && (minIndent == -1 || indent < minIndent) {
+			minIndent = indent
+		}
+	}
+	if minIndent < 0 {
+		minIndent = 0
+	}
+	for i, line := range lines {
+		switch {
+		case i == 0:
+			line = strings.TrimSpace(line)
+		case hasAsteriskPrefix:
+			line = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(line), "*"), " ")
+		default:
+			line = unindent(line, minIndent)
+		}
+		lines[i] = strings.TrimRightFunc(line, unicode.IsSpace)
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// canWriteBlockComment returns true if the given lines of text can be written
+// as a block comment.
+func canWriteBlockComment(lines []string) bool {
+	for _, line := range lines {
+		if strings.Contains(line, "*/") || strings.HasPrefix(line, commentDirectivePrefix) {
+			return false
+		}
+	}
+	return len(lines) > 0
+}
+
+// reflowCommentLines reflows the paragraphs of the given lines of comment text
+// that have a line wider than width, so that each line fits within width unless
+// it consists of a single word.
+//
+// A paragraph is a sequence of lines that are not blank, not indented, and
+// not buf directives. A list item, such as "- item", always starts a new
+// paragraph. Returns true if any paragraph was reflowed.
+func reflowCommentLines(lines []string, width int) ([]string, bool) {
+	if width < 1 {
+		width = 1
+	}
+	var reflowed bool
+	result := make([]string, 0, len(lines))
+	for i := 0; i < len(lines); {
+		if !isReflowableCommentLine(lines[i]) {
+			result = append(result, lines[i])
+			i++
+			continue
+		}
+		end := i + 1
+		for end < len(lines) && isReflowableCommentLine(lines[end]) && listItemMarkerWidth(lines[end]) == 0 {
+			end++
+		}
+		paragraph := lines[i:end]
+		if isWiderThan(paragraph, width) {
+			result = append(result, reflowParagraph(paragraph, width)...)
+			reflowed = true
+		} else {
+			result = append(result, paragraph...)
+		}
+		i = end
+	}
+	return result, reflowed
+}
+
+// reflowParagraph greedily fills the lines of the given paragraph with its words.
+func reflowParagraph(paragraph []string, width int) []string {
+	// The lines that continue a list item are indented to the start of the
+	// text of the item.
+	continuationIndent := strings.Repeat(" ", listItemMarkerWidth(paragraph[0]))
+	words := strings.Fields(strings.Join(paragraph, " "))
+	var lines []string
+	line := words[0]
+	for _, word := range words[1:] {
+		if utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, line)
+			line = continuationIndent + word
+			continue
+		}
+		line += " " + word
+	}
+	return append(lines, line)
+}
+
+func isReflowableCommentLine(line string) bool {
+	if line == "" || strings.HasPrefix(line, commentDirectivePrefix) {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(line)
+	return !unicode.IsSpace(r)
+}
+
+// listItemMarkerWidth returns the width of the list item marker at the start of
+// the line, including the space that follows it, such as "- " or "1. ". Returns
+// 0 if the line is not a list item.
+func listItemMarkerWidth(line string) int {
+	for _, marker := range []string{"- ", "* ", "+ "} {
+		if strings.HasPrefix(line, marker) {
+			return len(marker)
+		}
+	}
+	digits := strings.IndexFunc(line, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if digits > 0 && (strings.HasPrefix(line[digits:], ". ") || strings.HasPrefix(line[digits:], ") ")) {
+		return digits + 2
+	}
+	return 0
+}
+
+func isWiderThan(lines []string, width int) bool {
+	for _, line := range lines {
+		if utf8.RuneCountInString(line) > width {
+			return true
+		}
+	}
+	return false
+}
+
+// alignTrailingComments aligns the trailing comments of consecutive lines with
+// the same indentation, so that the comments start at the same column.
+//
+// For example,
+//
+//	message Foo {
+//	  string id = 1; // The ID.
+//	  repeated string display_names = 2; // The display names.
+//	}
+//
+// Is formatted into the following:
+//
+//	message Foo {
+//	  string id = 1;                      // The ID.
+//	  repeated string display_names = 2; // The display names.
+//	}
+func alignTrailingComments(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	commentStarts := make([]int, len(lines))
+	var inBlockComment bool
+	for i, line := range lines {
+		commentStarts[i], inBlockComment = trailingCommentStart(line, inBlockComment)
+	}
+	for start := 0; start < len(lines); {
+		if commentStarts[start] < 0 {
+			start++
+			continue
+		}
+		indent := lineIndent(lines[start])
+		end := start + 1
+		for end < len(lines) && commentStarts[end] >= 0 && lineIndent(lines[end]) == indent {
+			end++
+		}
+		var column int
+		for i := start; i < end; i++ {
+			if width := utf8.RuneCountInString(strings.TrimRight(lines[i][:commentStarts[i]], " ")); width > column {
+				column = width
+			}
+		}
+		for i := start; i < end; i++ {
+			code := strings.TrimRight(lines[i][:commentStarts[i]], " ")
+			padding := strings.Repeat(" ", column-utf8.RuneCountInString(code)+1)
+			lines[i] = code + padding + lines[i][commentStarts[i]:]
+		}
+		start = end
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// trailingCommentStart returns the byte offset of the comments at the end of
+// the given line that follow code, or -1 if there are none. The line is in a
+// block comment at its start if inBlockComment is true.
+//
+// Also returns whether the line ends in a block comment. A line that ends in a
+// block comment has no trailing comments, as they continue on the next line.
+func trailingCommentStart(line string, inBlockComment bool) (int, bool) {
+	var (
+		codeSeen     bool
+		commentStart = -1
+		quote        byte
+	)
+	for i := 0; i < len(line); i++ {
+		switch {
+		case inBlockComment:
+			if strings.HasPrefix(line[i:], "*/") {
+				inBlockComment = false
+				i++
+			}
+		case quote != 0:
+			if line[i] == '\\' {
+				i++
+			} else if line[i] == quote {
+				quote = 0
+			}
+		case strings.HasPrefix(line[i:], "//"):
+			if commentStart < 0 {
+				commentStart = i
+			}
+			if !codeSeen {
+				return -1, false
+			}
+			return commentStart, false
+		case strings.HasPrefix(line[i:], "/*"):
+			if commentStart < 0 {
+				commentStart = i
+			}
+			inBlockComment = true
+			i++
+		case line[i] == ' ' || line[i] == '\t':
+		default:
+			codeSeen = true
+			commentStart = -1
+			if line[i] == '"' || line[i] == '\'' {
+				quote = line[i]
+			}
+		}
+	}
+	if inBlockComment || !codeSeen {
+		return -1, inBlockComment
+	}
+	return commentStart, false
+}
+
+func lineIndent(line string) string {
+	return line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+}
@@ -61,6 +61,17 @@ type formatter struct {
 	// lines. So this flag informs the logic that makes those whitespace decisions.
 	inline bool
 
+	// If positive, the paragraphs of line comments that exceed this column are
+	// reflowed.
+	commentColumnLimit int
+	// The style that comments are normalized to. The zero value preserves the
+	// style of comments.
+	commentStyle CommentStyle
+	// If true, the number of blank lines between declarations and the groups
+	// of imports and file options are preserved. Otherwise, blank lines are
+	// collapsed into a single blank line.
+	preserveBlankLines bool
+
 	// Records all errors that occur during the formatting process. Nearly any
 	// non-nil error represents a bug in the implementation.
 	err error
@@ -252,6 +263,16 @@ func (f *formatter) writeFileHeader() {
 	if packageNode != nil {
 		f.writePackage(packageNode)
 	}
+	for _, importGroup := range nodeGroups(f, importNodes) {
+		f.writeImportGroup(importGroup)
+	}
+	for _, optionGroup := range nodeGroups(f, optionNodes) {
+		f.writeFileOptionGroup(optionGroup)
+	}
+}
+
+// writeImportGroup sorts and writes the given group of imports.
+func (f *formatter) writeImportGroup(importNodes []*ast.ImportNode) {
 	sort.Slice(importNodes, func(i, j int) bool {
 		return importNodes[i].Name.AsString() < importNodes[j].Name.AsString()
 	})
@@ -261,6 +282,10 @@ func (f *formatter) writeFileHeader() {
 		}
 		f.writeImport(importNode, i > 0)
 	}
+}
+
+// writeFileOptionGroup sorts and writes the given group of file options.
+func (f *formatter) writeFileOptionGroup(optionNodes []*ast.OptionNode) {
 	sort.Slice(optionNodes, func(i, j int) bool {
 		// The default options (e.g. cc_enable_arenas) should always
 		// be sorted above custom options (which are identified by a
@@ -286,6 +311,24 @@ func (f *formatter) writeFileHeader() {
 	}
 }
 
+// nodeGroups returns the groups of the given nodes that are separated by blank
+// lines, in their original order, if the formatter preserves blank lines.
+// Otherwise, all of the nodes are returned as a single group.
+func nodeGroups[T ast.Node](f *formatter, nodes []T) [][]T {
+	if !f.preserveBlankLines || len(nodes) == 0 {
+		return [][]T{nodes}
+	}
+	var groups [][]T
+	start := 0
+	for i := 1; i < len(nodes); i++ {
+		if f.leadingCommentsContainBlankLine(nodes[i]) {
+			groups = append(groups, nodes[start:i])
+			start = i
+		}
+	}
+	return append(groups, nodes[start:])
+}
+
 // writeFileTypes writes the types defined in a .proto file. This includes the messages, enums,
 // services, etc. All other elements are ignored since they are handled by f.writeFileHeader.
 func (f *formatter) writeFileTypes() {
@@ -1715,7 +1758,7 @@ func (f *formatter) writeStartMaybeCompact(node ast.Node, forceCompact bool) {
 		// If leading comments are defined, the whitespace we care about
 		// is attached to the first comment.
 		f.writeMultilineCommentsMaybeCompact(info.LeadingComments(), forceCompact)
-		if !forceCompact && nodeNewlineCount > 1 {
+		if !forceCompact {
 			// At this point, we're looking at the lines between
 			// a comment and the node its attached to.
 			//
@@ -1726,9 +1769,9 @@ func (f *formatter) writeStartMaybeCompact(node ast.Node, forceCompact bool) {
 			// If the last comment is a C-style comment, multiple newline
 			// characters are required because C-style comments don't consume
 			// a newline.
-			f.P("")
+			f.writeBlankLines(nodeNewlineCount)
 		}
-	} else if !compact && nodeNewlineCount > 1 {
+	} else if !compact {
 		// If the previous node is an open brace, this is the first element
 		// in the body of a composite type, so we don't want to write a
 		// newline. This makes it so that trailing newlines are removed.
@@ -1745,7 +1788,7 @@ func (f *formatter) writeStartMaybeCompact(node ast.Node, forceCompact bool) {
 		//  message Foo {
 		//    string bar = 1;
 		//  }
-		f.P("")
+		f.writeBlankLines(nodeNewlineCount)
 	}
 	f.Indent(node)
 	f.writeNode(node)
@@ -1925,6 +1968,19 @@ func (f *formatter) writeLineEnd(node ast.Node) {
 	f.writeTrailingEndComments(info.TrailingComments())
 }
 
+// writeBlankLines writes the blank lines for the given number of newlines in
+// the whitespace that precedes a node or comment in the original source. At most
+// a single blank line is written unless the formatter preserves blank lines.
+func (f *formatter) writeBlankLines(newlines int) {
+	blankLines := newlines - 1
+	if blankLines > 1 && !f.preserveBlankLines {
+		blankLines = 1
+	}
+	for i := 0; i < blankLines; i++ {
+		f.P("")
+	}
+}
+
 // writeMultilineComments writes the given comments as a newline-delimited block.
 // This is useful for both the beginning of a type (e.g. message, field, etc), as
 // well as the trailing comments attached to the beginning of a body block (e.g.
@@ -1941,9 +1997,16 @@ func (f *formatter) writeMultilineComments(comments ast.Comments) {
 
 func (f *formatter) writeMultilineCommentsMaybeCompact(comments ast.Comments, forceCompact bool) {
 	compact := forceCompact || isOpenBrace(f.previousNode)
+	if f.commentColumnLimit > 0 || f.normalizeCommentStyle() {
+		for _, commentGroup := range newCommentGroups(comments, compact) {
+			f.writeBlankLines(commentGroup.newlinesBefore)
+			f.writeCommentGroup(commentGroup)
+		}
+		return
+	}
 	for i := 0; i < comments.Len(); i++ {
 		comment := comments.Index(i)
-		if !compact && newlineCount(comment.LeadingWhitespace()) > 1 {
+		if !compact {
 			// Newlines between blocks of comments should be preserved.
 			//
 			// For example,
@@ -1954,7 +2017,7 @@ func (f *formatter) writeMultilineCommentsMaybeCompact(comments ast.Comments, fo
 			//  // Package pet.v1 defines a PetStore API.
 			//  package pet.v1;
 			//
-			f.P("")
+			f.writeBlankLines(newlineCount(comment.LeadingWhitespace()))
 		}
 		compact = false
 		f.writeComment(comment.RawText())
@@ -2023,11 +2086,132 @@ func (f *formatter) writeTrailingEndComments(comments ast.Comments) {
 		if i > 0 || comment.LeadingWhitespace() != "" {
 			f.Space()
 		}
-		f.writeComment(comment.RawText())
+		rawText := comment.RawText()
+		if i == comments.Len()-1 {
+			// Only the last comment is at the end of the line, so only its
+			// style can be normalized.
+			rawText = f.normalizeTrailingEndComment(rawText)
+		}
+		f.writeComment(rawText)
 	}
 	f.P("")
 }
 
+// normalizeTrailingEndComment converts the given single-line comment at the end
+// of a line to the comment style of the formatter, if possible.
+//
+// For example, with CommentStyleLine,
+//
+//	string id = 1; /* The ID. */
+//
+// Is formatted into the following:
+//
+//	string id = 1; // The ID.
+func (f *formatter) normalizeTrailingEndComment(rawText string) string {
+	rawText = strings.TrimSpace(rawText)
+	switch {
+	case f.commentStyle == CommentStyleLine && strings.HasPrefix(rawText, "/*") && newlineCount(rawText) == 0:
+		if lines := blockCommentLines(rawText); len(lines) == 1 {
+			return "// " + lines[0]
+		}
+	case f.commentStyle == CommentStyleBlock && strings.HasPrefix(rawText, "//"):
+		if text := lineCommentText(rawText); text != "" && canWriteBlockComment([]string{text}) {
+			return "/* " + text + " */"
+		}
+	}
+	return rawText
+}
+
+// normalizeCommentStyle returns true if the formatter normalizes the style of
+// comments.
+func (f *formatter) normalizeCommentStyle() bool {
+	return f.commentStyle == CommentStyleLine || f.commentStyle == CommentStyleBlock
+}
+
+// writeCommentGroup writes the given group of leading comments, reflowing and
+// normalizing the style of the comments as configured.
+func (f *formatter) writeCommentGroup(commentGroup *commentGroup) {
+	var lines []string
+	if commentGroup.block {
+		if f.commentStyle != CommentStyleLine {
+			// Block comments are only reflowed if they are converted
+			// to line comments.
+			f.writeComment(commentGroup.rawTexts[0])
+			f.WriteString("\n")
+			return
+		}
+		lines = blockCommentLines(commentGroup.rawTexts[0])
+	} else {
+		lines = make([]string, len(commentGroup.rawTexts))
+		for i, rawText := range commentGroup.rawTexts {
+			lines[i] = lineCommentText(rawText)
+		}
+	}
+	reflowed := false
+	if f.commentColumnLimit > 0 {
+		// Each line is prefixed with the indentation and either "// " or " * ".
+		lines, reflowed = reflowCommentLines(lines, f.commentColumnLimit-2*f.indent-3)
+	}
+	switch {
+	case f.commentStyle == CommentStyleBlock && canWriteBlockComment(lines):
+		f.writeBlockCommentLines(lines)
+	case commentGroup.block || reflowed:
+		f.writeLineCommentLines(lines)
+	default:
+		// Line comments that are unchanged are written as-is.
+		for _, rawText := range commentGroup.rawTexts {
+			f.writeComment(rawText)
+			f.WriteString("\n")
+		}
+	}
+}
+
+// writeLineCommentLines writes the given lines of text as line comments.
+func (f *formatter) writeLineCommentLines(lines []string) {
+	if len(lines) == 0 {
+		// An empty block comment.
+		lines = []string{""}
+	}
+	for _, line := range lines {
+		f.Indent(nil)
+		if line == "" {
+			f.WriteString("//")
+		} else {
+			f.WriteString("// " + line)
+		}
+		f.WriteString("\n")
+	}
+}
+
+// writeBlockCommentLines writes the given lines of text as a block comment.
+//
+// For example,
+//
+//	/*
+//	 * This is a comment spread across
+//	 * multiple lines.
+//	 */
+func (f *formatter) writeBlockCommentLines(lines []string) {
+	if len(lines) == 1 {
+		f.Indent(nil)
+		f.WriteString("/* " + lines[0] + " */\n")
+		return
+	}
+	f.Indent(nil)
+	f.WriteString("/*\n")
+	for _, line := range lines {
+		f.Indent(nil)
+		if line == "" {
+			f.WriteString(" *")
+		} else {
+			f.WriteString(" * " + line)
+		}
+		f.WriteString("\n")
+	}
+	f.Indent(nil)
+	f.WriteString(" */\n")
+}
+
 func (f *formatter) writeComment(comment string) {
 	if strings.HasPrefix(comment, "/*") && newlineCount(comment) > 0 {
 		lines := strings.Split(comment, "\n")
@@ -32,6 +32,8 @@ func TestFormatter(t *testing.T) {
 	testFormatCustomOptions(t)
 	testFormatProto2(t)
 	testFormatProto3(t)
+	testFormatComments(t)
+	testFormatBlankLines(t)
 }
 
 func testFormatCustomOptions(t *testing.T) {
@@ -62,7 +64,18 @@ func testFormatProto3(t *testing.T) {
 	testFormatNoDiff(t, "testdata/proto3/block/v1")
 }
 
-func testFormatNoDiff(t *testing.T, path string) {
+func testFormatComments(t *testing.T) {
+	testFormatNoDiff(t, "testdata/comments/reflow", FormatWithCommentColumnLimit(60))
+	testFormatNoDiff(t, "testdata/comments/line", FormatWithCommentStyle(CommentStyleLine))
+	testFormatNoDiff(t, "testdata/comments/block", FormatWithCommentStyle(CommentStyleBlock))
+	testFormatNoDiff(t, "testdata/comments/align", FormatWithAlignedTrailingComments())
+}
+
+func testFormatBlankLines(t *testing.T) {
+	testFormatNoDiff(t, "testdata/blanklines/preserve", FormatWithPreservedBlankLines())
+}
+
+func testFormatNoDiff(t *testing.T, path string, options ...FormatOption) {
 	t.Run(path, func(t *testing.T) {
 		ctx := context.Background()
 		runner := command.NewRunner()
@@ -70,7 +83,7 @@ func testFormatNoDiff(t *testing.T, path string) {
 		require.NoError(t, err)
 		module, err := bufmodule.NewModuleForBucket(ctx, moduleBucket)
 		require.NoError(t, err)
-		readBucket, err := Format(ctx, module)
+		readBucket, err := Format(ctx, module, options...)
 		require.NoError(t, err)
 		require.NoError(
 			t,
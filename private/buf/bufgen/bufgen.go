@@ -22,8 +22,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagemodify"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/bufpkg/bufplugin/bufpluginref"
 	"github.com/bufbuild/buf/private/bufpkg/bufremoteplugin"
@@ -84,6 +86,87 @@ func (s Strategy) String() string {
 	}
 }
 
+const (
+	// SandboxNone is the sandbox that runs local plugins with the environment
+	// and working directory of buf.
+	//
+	// This is the default value.
+	SandboxNone Sandbox = 0
+	// SandboxStrict is the sandbox that runs local plugins with only the PATH
+	// and the configured environment variables, in a new temporary working
+	// directory, and without network access on Linux.
+	SandboxStrict Sandbox = 1
+)
+
+// Sandbox is the sandbox that local plugins are run in.
+type Sandbox int
+
+// ParseSandbox parses the Sandbox.
+//
+// If the empty string is provided, this is interpreted as SandboxNone.
+func ParseSandbox(s string) (Sandbox, error) {
+	switch s {
+	case "", "none":
+		return SandboxNone, nil
+	case "strict":
+		return SandboxStrict, nil
+	default:
+		return 0, fmt.Errorf("unknown sandbox: %s", s)
+	}
+}
+
+// String implements fmt.Stringer.
+func (s Sandbox) String() string {
+	switch s {
+	case SandboxNone:
+		return "none"
+	case SandboxStrict:
+		return "strict"
+	default:
+		return strconv.Itoa(int(s))
+	}
+}
+
+const (
+	// PackageTypeGo is the package type that generates a go.mod file.
+	PackageTypeGo PackageType = 1
+	// PackageTypeNPM is the package type that generates a package.json file.
+	PackageTypeNPM PackageType = 2
+	// PackageTypePython is the package type that generates a pyproject.toml file.
+	PackageTypePython PackageType = 3
+)
+
+// PackageType is the type of packaging file generated alongside generated code.
+type PackageType int
+
+// ParsePackageType parses the PackageType.
+func ParsePackageType(s string) (PackageType, error) {
+	switch s {
+	case "go":
+		return PackageTypeGo, nil
+	case "npm":
+		return PackageTypeNPM, nil
+	case "python":
+		return PackageTypePython, nil
+	default:
+		return 0, fmt.Errorf("unknown package type: %s", s)
+	}
+}
+
+// String implements fmt.Stringer.
+func (p PackageType) String() string {
+	switch p {
+	case PackageTypeGo:
+		return "go"
+	case PackageTypeNPM:
+		return "npm"
+	case PackageTypePython:
+		return "python"
+	default:
+		return strconv.Itoa(int(p))
+	}
+}
+
 // Provider is a provider.
 type Provider interface {
 	// GetConfig gets the Config for the YAML data at ExternalConfigFilePath.
@@ -110,6 +193,43 @@ type Generator interface {
 		image bufimage.Image,
 		options ...GenerateOption,
 	) error
+	// GetGeneratedSizes executes the plugins specified by the given Config in memory,
+	// once for the files of each package in the Image, and returns the size of the
+	// generated code for each plugin in the order that the plugins are listed.
+	//
+	// Nothing is written out. The GenerateWithBaseOutDirPath option has no effect.
+	//
+	// The config is assumed to be valid. If created by ReadConfig, it will
+	// always be valid.
+	GetGeneratedSizes(
+		ctx context.Context,
+		container app.EnvStdioContainer,
+		config *Config,
+		image bufimage.Image,
+		options ...GenerateOption,
+	) ([]*PluginGeneratedSize, error)
+}
+
+// PluginGeneratedSize is the size of the code generated by a plugin.
+type PluginGeneratedSize struct {
+	// Plugin is the name of the plugin, as returned by PluginConfig.PluginName.
+	Plugin string `json:"plugin" yaml:"plugin"`
+	// NumFiles is the number of generated files.
+	//
+	// Insertion points are not counted as files.
+	NumFiles int `json:"num_files" yaml:"num_files"`
+	// NumBytes is the number of bytes of generated code, including insertion points.
+	NumBytes int `json:"num_bytes" yaml:"num_bytes"`
+	// Packages are the sizes of the code generated for each package, sorted by package.
+	Packages []*PackageGeneratedSize `json:"packages" yaml:"packages"`
+}
+
+// PackageGeneratedSize is the size of the code generated by a plugin for a package.
+type PackageGeneratedSize struct {
+	// Package is the name of the package. This is empty for files without a package.
+	Package  string `json:"package" yaml:"package"`
+	NumFiles int    `json:"num_files" yaml:"num_files"`
+	NumBytes int    `json:"num_bytes" yaml:"num_bytes"`
 }
 
 // NewGenerator returns a new Generator.
@@ -129,6 +249,14 @@ func NewGenerator(
 	)
 }
 
+// NewManagedModifier returns a new Modifier that sets the file options of
+// an Image as generation does with the given managed mode configuration.
+//
+// The source code info of the modified options is not updated.
+func NewManagedModifier(logger *zap.Logger, managedConfig *ManagedConfig) (bufimagemodify.Modifier, error) {
+	return newModifier(logger, managedConfig, nil)
+}
+
 // GenerateOption is an option for Generate.
 type GenerateOption func(*generateOptions)
 
@@ -168,6 +296,30 @@ func GenerateWithWASMEnabled() GenerateOption {
 	}
 }
 
+// GenerateWithPluginTimeout returns a new GenerateOption that limits the
+// execution of each plugin, or each batch of remote plugins for a remote, to
+// the given duration. Local plugins with a timeout in their PluginConfig use
+// that timeout instead.
+//
+// The default is to not time out beyond the deadline of the context.
+func GenerateWithPluginTimeout(pluginTimeout time.Duration) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.pluginTimeout = pluginTimeout
+	}
+}
+
+// GenerateWithOnlyChanged says to only generate the outputs that are affected
+// by the files that changed since the last generation with this option.
+//
+// The outputs of each generation are recorded in a manifest file in the base
+// output directory, and everything is generated if there is no manifest or
+// the plugins changed. Outputs of files that were removed are not deleted.
+func GenerateWithOnlyChanged() GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.onlyChanged = true
+	}
+}
+
 // Config is a configuration.
 type Config struct {
 	// Required
@@ -176,6 +328,12 @@ type Config struct {
 	ManagedConfig *ManagedConfig
 	// Optional
 	TypesConfig *TypesConfig
+	// Optional
+	PackageConfigs []*PackageConfig
+	// Optional
+	HeaderConfig *HeaderConfig
+	// Optional
+	LimitsConfig *LimitsConfig
 }
 
 // PluginConfig is a plugin configuration.
@@ -196,6 +354,43 @@ type PluginConfig struct {
 	Strategy Strategy
 	// Optional
 	ProtocPath string
+	// Optional, exclusive with Remote
+	WorkingDir string
+	// Optional, exclusive with Remote
+	Env map[string]string
+	// Optional, exclusive with Remote and WorkingDir
+	Sandbox Sandbox
+	// Optional, exclusive with Remote
+	//
+	// Takes precedence over the timeout of GenerateWithPluginTimeout.
+	Timeout time.Duration
+	// Optional, exclusive with Remote
+	//
+	// The maximum address space of the plugin binary in bytes.
+	MaxMemory uint64
+}
+
+// PackageConfig is the configuration for a packaging file, such as a go.mod,
+// that is generated alongside the generated code in an output directory.
+type PackageConfig struct {
+	// Required
+	Out string
+	// Required
+	Type PackageType
+	// Required
+	//
+	// This is the module path for PackageTypeGo.
+	Name string
+	// Optional
+	//
+	// Not used for PackageTypeGo, as Go modules are versioned by VCS tags.
+	Version string
+	// Optional
+	//
+	// Dependency name -> version. The version is a module version for
+	// PackageTypeGo, a version range for PackageTypeNPM, and a version
+	// specifier such as ">=4.21" for PackageTypePython.
+	Deps map[string]string
 }
 
 // PluginName returns this PluginConfig's plugin name.
@@ -305,6 +500,34 @@ type TypesConfig struct {
 	Include []string
 }
 
+// LimitsConfig is the configuration of the limits of the response of each plugin.
+type LimitsConfig struct {
+	// Optional, the maximum number of files of the response of a plugin.
+	//
+	// Zero means no limit.
+	MaxFiles int
+	// Optional, the maximum total size in bytes of the content of the files
+	// of the response of a plugin.
+	//
+	// Zero means no limit.
+	MaxSize uint64
+	// Optional, the normalized paths, relative to the current directory, that
+	// plugins cannot write to, either directly or within.
+	ForbiddenPaths []string
+}
+
+// HeaderConfig is the configuration of the header added to the beginning
+// of all generated files.
+type HeaderConfig struct {
+	// Optional
+	Text string
+	// Optional
+	DoNotEdit bool
+	// Optional, the hex-encoded SHA256 digest of the template, set if
+	// generation metadata is included in the header.
+	TemplateDigest string
+}
+
 // ReadConfig reads the configuration from the OS or an override, if any.
 //
 // Only use in CLI tools.
@@ -347,23 +570,40 @@ func ConfigExists(ctx context.Context, readBucket storage.ReadBucket) (bool, err
 
 // ExternalConfigV1 is an external configuration.
 type ExternalConfigV1 struct {
-	Version string                   `json:"version,omitempty" yaml:"version,omitempty"`
-	Plugins []ExternalPluginConfigV1 `json:"plugins,omitempty" yaml:"plugins,omitempty"`
-	Managed ExternalManagedConfigV1  `json:"managed,omitempty" yaml:"managed,omitempty"`
-	Types   ExternalTypesConfigV1    `json:"types,omitempty" yaml:"types,omitempty"`
+	Version  string                    `json:"version,omitempty" yaml:"version,omitempty"`
+	Plugins  []ExternalPluginConfigV1  `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	Managed  ExternalManagedConfigV1   `json:"managed,omitempty" yaml:"managed,omitempty"`
+	Types    ExternalTypesConfigV1     `json:"types,omitempty" yaml:"types,omitempty"`
+	Packages []ExternalPackageConfigV1 `json:"packages,omitempty" yaml:"packages,omitempty"`
+	Header   ExternalHeaderConfigV1    `json:"header,omitempty" yaml:"header,omitempty"`
+	Limits   ExternalLimitsConfigV1    `json:"limits,omitempty" yaml:"limits,omitempty"`
 }
 
 // ExternalPluginConfigV1 is an external plugin configuration.
 type ExternalPluginConfigV1 struct {
-	Plugin     string      `json:"plugin,omitempty" yaml:"plugin,omitempty"`
-	Revision   int         `json:"revision,omitempty" yaml:"revision,omitempty"`
-	Name       string      `json:"name,omitempty" yaml:"name,omitempty"`
-	Remote     string      `json:"remote,omitempty" yaml:"remote,omitempty"`
-	Out        string      `json:"out,omitempty" yaml:"out,omitempty"`
-	Opt        interface{} `json:"opt,omitempty" yaml:"opt,omitempty"`
-	Path       interface{} `json:"path,omitempty" yaml:"path,omitempty"`
-	ProtocPath string      `json:"protoc_path,omitempty" yaml:"protoc_path,omitempty"`
-	Strategy   string      `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	Plugin     string            `json:"plugin,omitempty" yaml:"plugin,omitempty"`
+	Revision   int               `json:"revision,omitempty" yaml:"revision,omitempty"`
+	Name       string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Remote     string            `json:"remote,omitempty" yaml:"remote,omitempty"`
+	Out        string            `json:"out,omitempty" yaml:"out,omitempty"`
+	Opt        interface{}       `json:"opt,omitempty" yaml:"opt,omitempty"`
+	Path       interface{}       `json:"path,omitempty" yaml:"path,omitempty"`
+	ProtocPath string            `json:"protoc_path,omitempty" yaml:"protoc_path,omitempty"`
+	Strategy   string            `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	WorkingDir string            `json:"working_dir,omitempty" yaml:"working_dir,omitempty"`
+	Env        map[string]string `json:"env,omitempty" yaml:"env,omitempty"`
+	Sandbox    string            `json:"sandbox,omitempty" yaml:"sandbox,omitempty"`
+	Timeout    string            `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	MaxMemory  string            `json:"max_memory,omitempty" yaml:"max_memory,omitempty"`
+}
+
+// ExternalPackageConfigV1 is an external package configuration.
+type ExternalPackageConfigV1 struct {
+	Out     string            `json:"out,omitempty" yaml:"out,omitempty"`
+	Type    string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Name    string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Version string            `json:"version,omitempty" yaml:"version,omitempty"`
+	Deps    map[string]string `json:"deps,omitempty" yaml:"deps,omitempty"`
 }
 
 // ExternalManagedConfigV1 is an external managed mode configuration.
@@ -579,3 +819,27 @@ type ExternalTypesConfigV1 struct {
 func (e ExternalTypesConfigV1) IsEmpty() bool {
 	return len(e.Include) == 0
 }
+
+// ExternalLimitsConfigV1 is an external limits configuration.
+type ExternalLimitsConfigV1 struct {
+	MaxFiles       int      `json:"max_files,omitempty" yaml:"max_files,omitempty"`
+	MaxSize        string   `json:"max_size,omitempty" yaml:"max_size,omitempty"`
+	ForbiddenPaths []string `json:"forbidden_paths,omitempty" yaml:"forbidden_paths,omitempty"`
+}
+
+// IsEmpty returns true if e is empty.
+func (e ExternalLimitsConfigV1) IsEmpty() bool {
+	return e.MaxFiles == 0 && e.MaxSize == "" && len(e.ForbiddenPaths) == 0
+}
+
+// ExternalHeaderConfigV1 is an external header configuration.
+type ExternalHeaderConfigV1 struct {
+	Text      string `json:"text,omitempty" yaml:"text,omitempty"`
+	DoNotEdit bool   `json:"do_not_edit,omitempty" yaml:"do_not_edit,omitempty"`
+	Metadata  bool   `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+// IsEmpty returns true if e is empty.
+func (e ExternalHeaderConfigV1) IsEmpty() bool {
+	return e == ExternalHeaderConfigV1{}
+}
@@ -18,6 +18,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 func TestPluginConfig_GetRemoteHostname(t *testing.T) {
@@ -30,3 +32,29 @@ func TestPluginConfig_GetRemoteHostname(t *testing.T) {
 	assertPluginConfigRemoteHostname(&PluginConfig{Remote: "buf.build/protocolbuffers/plugins/go:v1.28.1-1"}, "buf.build")
 	assertPluginConfigRemoteHostname(&PluginConfig{Remote: "buf.build/protocolbuffers/plugins/go"}, "buf.build")
 }
+
+func TestGetPackageGeneratedSize(t *testing.T) {
+	t.Parallel()
+	response := &pluginpb.CodeGeneratorResponse{
+		File: []*pluginpb.CodeGeneratorResponse_File{
+			{
+				Name:    proto.String("acme/weather/v1/weather.pb.go"),
+				Content: proto.String("package weatherv1"),
+			},
+			{
+				Name:           proto.String("acme/weather/v1/weather.pb.go"),
+				InsertionPoint: proto.String("imports"),
+				Content:        proto.String("import \"fmt\""),
+			},
+		},
+	}
+	assert.Equal(
+		t,
+		&PackageGeneratedSize{
+			Package:  "acme.weather.v1",
+			NumFiles: 1,
+			NumBytes: len("package weatherv1") + len("import \"fmt\""),
+		},
+		getPackageGeneratedSize("acme.weather.v1", response),
+	)
+}
@@ -16,10 +16,16 @@ package bufgen
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/bufpkg/bufplugin/bufpluginref"
@@ -122,13 +128,13 @@ func getConfig(
 		if err := validateExternalConfigV1(externalConfigV1, id); err != nil {
 			return nil, err
 		}
-		return newConfigV1(logger, externalConfigV1, id)
+		return newConfigV1(logger, externalConfigV1, id, data)
 	default:
 		return nil, fmt.Errorf(`%s has no version set. Please add "version: %s"`, id, V1Version)
 	}
 }
 
-func newConfigV1(logger *zap.Logger, externalConfig ExternalConfigV1, id string) (*Config, error) {
+func newConfigV1(logger *zap.Logger, externalConfig ExternalConfigV1, id string, data []byte) (*Config, error) {
 	managedConfig, err := newManagedConfigV1(logger, externalConfig.Managed)
 	if err != nil {
 		return nil, err
@@ -147,6 +153,24 @@ func newConfigV1(logger *zap.Logger, externalConfig ExternalConfigV1, id string)
 		if err != nil {
 			return nil, err
 		}
+		sandbox, err := ParseSandbox(plugin.Sandbox)
+		if err != nil {
+			return nil, err
+		}
+		var timeout time.Duration
+		if plugin.Timeout != "" {
+			timeout, err = time.ParseDuration(plugin.Timeout)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var maxMemory uint64
+		if plugin.MaxMemory != "" {
+			maxMemory, err = parseByteSize(plugin.MaxMemory)
+			if err != nil {
+				return nil, err
+			}
+		}
 		pluginConfig := &PluginConfig{
 			Plugin:     plugin.Plugin,
 			Revision:   plugin.Revision,
@@ -157,6 +181,11 @@ func newConfigV1(logger *zap.Logger, externalConfig ExternalConfigV1, id string)
 			Path:       path,
 			ProtocPath: plugin.ProtocPath,
 			Strategy:   strategy,
+			WorkingDir: plugin.WorkingDir,
+			Env:        plugin.Env,
+			Sandbox:    sandbox,
+			Timeout:    timeout,
+			MaxMemory:  maxMemory,
 		}
 		if pluginConfig.IsRemote() {
 			// Always use StrategyAll for remote plugins
@@ -165,10 +194,21 @@ func newConfigV1(logger *zap.Logger, externalConfig ExternalConfigV1, id string)
 		pluginConfigs = append(pluginConfigs, pluginConfig)
 	}
 	typesConfig := newTypesConfigV1(externalConfig.Types)
+	limitsConfig, err := newLimitsConfigV1(externalConfig.Limits)
+	if err != nil {
+		return nil, err
+	}
+	packageConfigs, err := newPackageConfigsV1(externalConfig.Packages, managedConfig, id)
+	if err != nil {
+		return nil, err
+	}
 	return &Config{
-		PluginConfigs: pluginConfigs,
-		ManagedConfig: managedConfig,
-		TypesConfig:   typesConfig,
+		PluginConfigs:  pluginConfigs,
+		ManagedConfig:  managedConfig,
+		TypesConfig:    typesConfig,
+		PackageConfigs: packageConfigs,
+		HeaderConfig:   newHeaderConfigV1(externalConfig.Header, data),
+		LimitsConfig:   limitsConfig,
 	}, nil
 }
 
@@ -195,6 +235,28 @@ func validateExternalConfigV1(externalConfig ExternalConfigV1, id string) error
 		if plugin.Out == "" {
 			return fmt.Errorf("%s: plugin %s out is required", id, pluginIdentifier)
 		}
+		for key := range plugin.Env {
+			if key == "" || strings.Contains(key, "=") {
+				return fmt.Errorf("%s: plugin %s has invalid env variable name %q", id, pluginIdentifier, key)
+			}
+		}
+		if plugin.Sandbox != "" && plugin.WorkingDir != "" {
+			return fmt.Errorf("%s: plugin %s cannot specify both a sandbox and a working directory", id, pluginIdentifier)
+		}
+		if plugin.Timeout != "" {
+			timeout, err := time.ParseDuration(plugin.Timeout)
+			if err != nil {
+				return fmt.Errorf("%s: plugin %s has invalid timeout %q: %w", id, pluginIdentifier, plugin.Timeout, err)
+			}
+			if timeout <= 0 {
+				return fmt.Errorf("%s: plugin %s timeout must be positive", id, pluginIdentifier)
+			}
+		}
+		if plugin.MaxMemory != "" {
+			if _, err := parseByteSize(plugin.MaxMemory); err != nil {
+				return fmt.Errorf("%s: plugin %s has invalid max_memory %q: %w", id, pluginIdentifier, plugin.MaxMemory, err)
+			}
+		}
 		switch {
 		case plugin.Plugin != "":
 			if bufpluginref.IsPluginReferenceOrIdentity(pluginIdentifier) {
@@ -229,9 +291,78 @@ func validateExternalConfigV1(externalConfig ExternalConfigV1, id string) error
 			return errors.New("one of plugin, name, or remote is required")
 		}
 	}
+	if externalConfig.Limits.MaxFiles < 0 {
+		return fmt.Errorf("%s: limits max_files must be positive", id)
+	}
+	if externalConfig.Limits.MaxSize != "" {
+		if _, err := parseByteSize(externalConfig.Limits.MaxSize); err != nil {
+			return fmt.Errorf("%s: limits has invalid max_size %q: %w", id, externalConfig.Limits.MaxSize, err)
+		}
+	}
+	for _, forbiddenPath := range externalConfig.Limits.ForbiddenPaths {
+		if _, err := normalpath.NormalizeAndValidate(forbiddenPath); err != nil {
+			return fmt.Errorf("%s: limits has invalid forbidden path: %w", id, err)
+		}
+	}
 	return nil
 }
 
+func newPackageConfigsV1(
+	externalPackageConfigs []ExternalPackageConfigV1,
+	managedConfig *ManagedConfig,
+	id string,
+) ([]*PackageConfig, error) {
+	if len(externalPackageConfigs) == 0 {
+		return nil, nil
+	}
+	packageConfigs := make([]*PackageConfig, 0, len(externalPackageConfigs))
+	seenOuts := make(map[string]map[PackageType]struct{})
+	for _, externalPackageConfig := range externalPackageConfigs {
+		if externalPackageConfig.Out == "" {
+			return nil, fmt.Errorf("%s: package out is required", id)
+		}
+		if externalPackageConfig.Type == "" {
+			return nil, fmt.Errorf("%s: package %s type is required", id, externalPackageConfig.Out)
+		}
+		packageType, err := ParsePackageType(externalPackageConfig.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s: package %s: %w", id, externalPackageConfig.Out, err)
+		}
+		name := externalPackageConfig.Name
+		if name == "" && packageType == PackageTypeGo && managedConfig != nil && managedConfig.GoPackagePrefixConfig != nil {
+			// The go_package prefix is the module path of the generated code.
+			name = managedConfig.GoPackagePrefixConfig.Default
+		}
+		if name == "" {
+			return nil, fmt.Errorf("%s: package %s name is required", id, externalPackageConfig.Out)
+		}
+		if packageType == PackageTypeGo && externalPackageConfig.Version != "" {
+			return nil, fmt.Errorf("%s: package %s of type %s cannot specify a version", id, externalPackageConfig.Out, packageType)
+		}
+		for depName := range externalPackageConfig.Deps {
+			if depName == "" {
+				return nil, fmt.Errorf("%s: package %s has an empty dependency name", id, externalPackageConfig.Out)
+			}
+		}
+		out := filepath.Clean(externalPackageConfig.Out)
+		if _, ok := seenOuts[out][packageType]; ok {
+			return nil, fmt.Errorf("%s: duplicate package of type %s for out %s", id, packageType, externalPackageConfig.Out)
+		}
+		if seenOuts[out] == nil {
+			seenOuts[out] = make(map[PackageType]struct{})
+		}
+		seenOuts[out][packageType] = struct{}{}
+		packageConfigs = append(packageConfigs, &PackageConfig{
+			Out:     externalPackageConfig.Out,
+			Type:    packageType,
+			Name:    name,
+			Version: externalPackageConfig.Version,
+			Deps:    externalPackageConfig.Deps,
+		})
+	}
+	return packageConfigs, nil
+}
+
 func checkPathAndStrategyUnset(id string, plugin ExternalPluginConfigV1, pluginIdentifier string) error {
 	if plugin.Path != nil {
 		return fmt.Errorf("%s: remote plugin %s cannot specify a path", id, pluginIdentifier)
@@ -242,9 +373,63 @@ func checkPathAndStrategyUnset(id string, plugin ExternalPluginConfigV1, pluginI
 	if plugin.ProtocPath != "" {
 		return fmt.Errorf("%s: remote plugin %s cannot specify a protoc path", id, pluginIdentifier)
 	}
+	if plugin.WorkingDir != "" {
+		return fmt.Errorf("%s: remote plugin %s cannot specify a working directory", id, pluginIdentifier)
+	}
+	if len(plugin.Env) > 0 {
+		return fmt.Errorf("%s: remote plugin %s cannot specify env variables", id, pluginIdentifier)
+	}
+	if plugin.Sandbox != "" {
+		return fmt.Errorf("%s: remote plugin %s cannot specify a sandbox", id, pluginIdentifier)
+	}
+	if plugin.Timeout != "" {
+		return fmt.Errorf("%s: remote plugin %s cannot specify a timeout", id, pluginIdentifier)
+	}
+	if plugin.MaxMemory != "" {
+		return fmt.Errorf("%s: remote plugin %s cannot specify max_memory", id, pluginIdentifier)
+	}
 	return nil
 }
 
+// byteSizeUnitToMultiplier maps the units accepted by max_memory and
+// max_size to the number of bytes in the unit.
+var byteSizeUnitToMultiplier = map[string]uint64{
+	"":    1,
+	"B":   1,
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+}
+
+// parseByteSize parses a number of bytes with an optional unit, such as
+// "512MiB" or "2GB".
+func parseByteSize(s string) (uint64, error) {
+	numberEnd := strings.IndexFunc(s, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if numberEnd == -1 {
+		numberEnd = len(s)
+	}
+	number, err := strconv.ParseUint(s[:numberEnd], 10, 64)
+	if err != nil {
+		return 0, errors.New("must be a number of bytes with an optional unit such as MiB or GB")
+	}
+	multiplier, ok := byteSizeUnitToMultiplier[strings.TrimSpace(s[numberEnd:])]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %q, must be one of B, KB, MB, GB, KiB, MiB or GiB", strings.TrimSpace(s[numberEnd:]))
+	}
+	if number == 0 {
+		return 0, errors.New("must be positive")
+	}
+	if number > math.MaxUint64/multiplier {
+		return 0, errors.New("too large")
+	}
+	return number * multiplier, nil
+}
+
 func newManagedConfigV1(logger *zap.Logger, externalManagedConfig ExternalManagedConfigV1) (*ManagedConfig, error) {
 	if !externalManagedConfig.Enabled {
 		if !externalManagedConfig.IsEmpty() && logger != nil {
@@ -656,6 +841,45 @@ func newReadConfigOptions() *readConfigOptions {
 	return &readConfigOptions{}
 }
 
+func newLimitsConfigV1(externalConfig ExternalLimitsConfigV1) (*LimitsConfig, error) {
+	if externalConfig.IsEmpty() {
+		return nil, nil
+	}
+	limitsConfig := &LimitsConfig{
+		MaxFiles: externalConfig.MaxFiles,
+	}
+	if externalConfig.MaxSize != "" {
+		maxSize, err := parseByteSize(externalConfig.MaxSize)
+		if err != nil {
+			return nil, err
+		}
+		limitsConfig.MaxSize = maxSize
+	}
+	for _, forbiddenPath := range externalConfig.ForbiddenPaths {
+		normalizedForbiddenPath, err := normalpath.NormalizeAndValidate(forbiddenPath)
+		if err != nil {
+			return nil, err
+		}
+		limitsConfig.ForbiddenPaths = append(limitsConfig.ForbiddenPaths, normalizedForbiddenPath)
+	}
+	return limitsConfig, nil
+}
+
+func newHeaderConfigV1(externalConfig ExternalHeaderConfigV1, data []byte) *HeaderConfig {
+	if externalConfig.IsEmpty() {
+		return nil
+	}
+	headerConfig := &HeaderConfig{
+		Text:      externalConfig.Text,
+		DoNotEdit: externalConfig.DoNotEdit,
+	}
+	if externalConfig.Metadata {
+		digest := sha256.Sum256(data)
+		headerConfig.TemplateDigest = hex.EncodeToString(digest[:])
+	}
+	return headerConfig
+}
+
 func newTypesConfigV1(externalConfig ExternalTypesConfigV1) *TypesConfig {
 	if externalConfig.IsEmpty() {
 		return nil
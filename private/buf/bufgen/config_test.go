@@ -16,9 +16,12 @@ package bufgen
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagemodify"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
@@ -646,6 +649,225 @@ func TestReadConfigV1(t *testing.T) {
 	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "go_gen_error6.yaml"))
 }
 
+func TestReadConfigV1WorkingDirAndEnv(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	nopLogger := zap.NewNop()
+	provider := NewProvider(zap.NewNop())
+	readBucket, err := storagemem.NewReadBucket(nil)
+	require.NoError(t, err)
+	config, err := ReadConfig(ctx, nopLogger, provider, readBucket, ReadConfigWithOverride(filepath.Join("testdata", "v1", "gen_success10.yaml")))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*PluginConfig{
+			{
+				Name:       "go",
+				Out:        "gen/go",
+				Strategy:   StrategyDirectory,
+				WorkingDir: "tools",
+				Env: map[string]string{
+					"GOFLAGS": "-mod=mod",
+					"FOO":     "bar",
+				},
+			},
+		},
+		config.PluginConfigs,
+	)
+	// remote plugins cannot specify env variables
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error15.yaml"))
+	// env variable names cannot contain "="
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error16.yaml"))
+}
+
+func TestReadConfigV1Packages(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	nopLogger := zap.NewNop()
+	provider := NewProvider(zap.NewNop())
+	readBucket, err := storagemem.NewReadBucket(nil)
+	require.NoError(t, err)
+	config, err := ReadConfig(ctx, nopLogger, provider, readBucket, ReadConfigWithOverride(filepath.Join("testdata", "v1", "gen_success11.yaml")))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*PackageConfig{
+			{
+				Out:  "gen/go",
+				Type: PackageTypeGo,
+				// defaults to the go_package prefix
+				Name: "github.com/acme/weather/gen/go",
+				Deps: map[string]string{
+					"google.golang.org/protobuf": "v1.30.0",
+				},
+			},
+			{
+				Out:     "gen/es",
+				Type:    PackageTypeNPM,
+				Name:    "@acme/weather",
+				Version: "1.2.3",
+				Deps: map[string]string{
+					"@bufbuild/protobuf": "^1.2.0",
+				},
+			},
+			{
+				Out:  "gen/python",
+				Type: PackageTypePython,
+				Name: "acme-weather",
+				Deps: map[string]string{
+					"protobuf": ">=4.21",
+				},
+			},
+		},
+		config.PackageConfigs,
+	)
+	// unknown package type
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error17.yaml"))
+	// go packages cannot specify a version
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error18.yaml"))
+	// name is required for non-go packages
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error19.yaml"))
+}
+
+func TestReadConfigV1Sandbox(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	nopLogger := zap.NewNop()
+	provider := NewProvider(zap.NewNop())
+	readBucket, err := storagemem.NewReadBucket(nil)
+	require.NoError(t, err)
+	config, err := ReadConfig(ctx, nopLogger, provider, readBucket, ReadConfigWithOverride(filepath.Join("testdata", "v1", "gen_success12.yaml")))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*PluginConfig{
+			{
+				Name:     "go",
+				Out:      "gen/go",
+				Strategy: StrategyDirectory,
+				Env: map[string]string{
+					"GOFLAGS": "-mod=mod",
+				},
+				Sandbox: SandboxStrict,
+			},
+			{
+				Name:     "es",
+				Out:      "gen/es",
+				Strategy: StrategyDirectory,
+				Sandbox:  SandboxNone,
+			},
+		},
+		config.PluginConfigs,
+	)
+	// remote plugins cannot specify a sandbox
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error20.yaml"))
+	// sandbox is exclusive with working_dir
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error21.yaml"))
+	// unknown sandbox
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error22.yaml"))
+}
+
+func TestReadConfigV1TimeoutAndMaxMemory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	nopLogger := zap.NewNop()
+	provider := NewProvider(zap.NewNop())
+	readBucket, err := storagemem.NewReadBucket(nil)
+	require.NoError(t, err)
+	config, err := ReadConfig(ctx, nopLogger, provider, readBucket, ReadConfigWithOverride(filepath.Join("testdata", "v1", "gen_success13.yaml")))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*PluginConfig{
+			{
+				Name:      "go",
+				Out:       "gen/go",
+				Strategy:  StrategyDirectory,
+				Timeout:   30 * time.Second,
+				MaxMemory: 512 * 1024 * 1024,
+			},
+			{
+				Name:      "es",
+				Out:       "gen/es",
+				Strategy:  StrategyDirectory,
+				Timeout:   90 * time.Second,
+				MaxMemory: 2000 * 1000 * 1000,
+			},
+			{
+				Name:     "java",
+				Out:      "gen/java",
+				Strategy: StrategyDirectory,
+			},
+		},
+		config.PluginConfigs,
+	)
+	// remote plugins cannot specify a timeout
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error23.yaml"))
+	// remote plugins cannot specify max_memory
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error24.yaml"))
+	// invalid timeout
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error25.yaml"))
+	// negative timeout
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error26.yaml"))
+	// unknown max_memory unit
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error27.yaml"))
+	// zero max_memory
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error28.yaml"))
+}
+
+func TestReadConfigV1Header(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	nopLogger := zap.NewNop()
+	provider := NewProvider(zap.NewNop())
+	readBucket, err := storagemem.NewReadBucket(nil)
+	require.NoError(t, err)
+	testFilePath := filepath.Join("testdata", "v1", "gen_success14.yaml")
+	data, err := os.ReadFile(testFilePath)
+	require.NoError(t, err)
+	digest := sha256.Sum256(data)
+	config, err := ReadConfig(ctx, nopLogger, provider, readBucket, ReadConfigWithOverride(testFilePath))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&HeaderConfig{
+			Text:           "Copyright 2023 Acme, Inc.\nSPDX-License-Identifier: Apache-2.0\n",
+			DoNotEdit:      true,
+			TemplateDigest: hex.EncodeToString(digest[:]),
+		},
+		config.HeaderConfig,
+	)
+	config, err = ReadConfig(ctx, nopLogger, provider, readBucket, ReadConfigWithOverride(filepath.Join("testdata", "v1", "gen_success1.yaml")))
+	require.NoError(t, err)
+	require.Nil(t, config.HeaderConfig)
+}
+
+func TestReadConfigV1Limits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	nopLogger := zap.NewNop()
+	provider := NewProvider(zap.NewNop())
+	readBucket, err := storagemem.NewReadBucket(nil)
+	require.NoError(t, err)
+	config, err := ReadConfig(ctx, nopLogger, provider, readBucket, ReadConfigWithOverride(filepath.Join("testdata", "v1", "gen_success15.yaml")))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&LimitsConfig{
+			MaxFiles:       1000,
+			MaxSize:        64 * 1024 * 1024,
+			ForbiddenPaths: []string{".git", "buf.yaml"},
+		},
+		config.LimitsConfig,
+	)
+	// negative max_files
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error29.yaml"))
+	// unknown max_size unit
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error30.yaml"))
+	// forbidden path outside of the output directory
+	testReadConfigError(t, nopLogger, provider, readBucket, filepath.Join("testdata", "v1", "gen_error31.yaml"))
+}
+
 func testReadConfigError(t *testing.T, logger *zap.Logger, provider Provider, readBucket storage.ReadBucket, testFilePath string) {
 	ctx := context.Background()
 	_, err := ReadConfig(ctx, logger, provider, readBucket, ReadConfigWithOverride(testFilePath))
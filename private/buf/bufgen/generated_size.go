@@ -0,0 +1,116 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/app"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func (g *generator) GetGeneratedSizes(
+	ctx context.Context,
+	container app.EnvStdioContainer,
+	config *Config,
+	image bufimage.Image,
+	options ...GenerateOption,
+) ([]*PluginGeneratedSize, error) {
+	generateOptions := newGenerateOptions()
+	for _, option := range options {
+		option(generateOptions)
+	}
+	if err := modifyImage(ctx, g.logger, config, image); err != nil {
+		return nil, err
+	}
+	packages, packageImages, err := imagesByPackage(image)
+	if err != nil {
+		return nil, err
+	}
+	pluginGeneratedSizes := make([]*PluginGeneratedSize, len(config.PluginConfigs))
+	for i, pluginConfig := range config.PluginConfigs {
+		pluginGeneratedSizes[i] = &PluginGeneratedSize{
+			Plugin: pluginConfig.PluginName(),
+		}
+	}
+	// Each package is generated separately so that the generated code can be
+	// attributed to it. The plugins are still executed in parallel for each package.
+	for i, packageImage := range packageImages {
+		responses, err := g.execPlugins(
+			ctx,
+			container,
+			config,
+			packageImage,
+			generateOptions.includeImports,
+			generateOptions.includeWellKnownTypes,
+			generateOptions.wasmEnabled,
+			generateOptions.pluginTimeout,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for j, response := range responses {
+			packageGeneratedSize := getPackageGeneratedSize(packages[i], response)
+			pluginGeneratedSize := pluginGeneratedSizes[j]
+			pluginGeneratedSize.NumFiles += packageGeneratedSize.NumFiles
+			pluginGeneratedSize.NumBytes += packageGeneratedSize.NumBytes
+			pluginGeneratedSize.Packages = append(pluginGeneratedSize.Packages, packageGeneratedSize)
+		}
+	}
+	return pluginGeneratedSizes, nil
+}
+
+// imagesByPackage returns the sorted packages of the non-import files of the
+// Image, and an Image for each package that only has that package's files as
+// non-imports.
+func imagesByPackage(image bufimage.Image) ([]string, []bufimage.Image, error) {
+	packageToPaths := make(map[string][]string)
+	for _, imageFile := range image.Files() {
+		if imageFile.IsImport() {
+			continue
+		}
+		pkg := imageFile.Proto().GetPackage()
+		packageToPaths[pkg] = append(packageToPaths[pkg], imageFile.Path())
+	}
+	packages := make([]string, 0, len(packageToPaths))
+	for pkg := range packageToPaths {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+	packageImages := make([]bufimage.Image, len(packages))
+	for i, pkg := range packages {
+		packageImage, err := bufimage.ImageWithOnlyPaths(image, packageToPaths[pkg], nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		packageImages[i] = packageImage
+	}
+	return packages, packageImages, nil
+}
+
+func getPackageGeneratedSize(pkg string, response *pluginpb.CodeGeneratorResponse) *PackageGeneratedSize {
+	packageGeneratedSize := &PackageGeneratedSize{
+		Package: pkg,
+	}
+	for _, file := range response.GetFile() {
+		if file.GetInsertionPoint() == "" {
+			packageGeneratedSize.NumFiles++
+		}
+		packageGeneratedSize.NumBytes += len(file.GetContent())
+	}
+	return packageGeneratedSize
+}
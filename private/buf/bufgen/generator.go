@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagemodify"
@@ -37,8 +38,8 @@ import (
 	"github.com/bufbuild/buf/private/pkg/connectclient"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
 	"github.com/bufbuild/buf/private/pkg/thread"
+	"github.com/bufbuild/buf/private/pkg/timing"
 	connect "github.com/bufbuild/connect-go"
-	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/pluginpb"
 )
@@ -72,7 +73,9 @@ func newGenerator(
 //
 // Note that insertion points will only have access to files that are written
 // in the same protoc invocation; plugins will not be able to insert code into
-// other files that already exist on disk (just like protoc).
+// other files that already exist on disk (just like protoc). Unlike protoc,
+// a plugin can insert code into files written by an earlier plugin to a parent
+// or child output directory, for example "gen" and "gen/go".
 //
 // All of the plugins, both local and remote, are called concurrently. Each
 // plugin returns a single CodeGeneratorResponse, which are cached in-memory in
@@ -102,6 +105,8 @@ func (g *generator) Generate(
 		generateOptions.includeImports,
 		generateOptions.includeWellKnownTypes,
 		generateOptions.wasmEnabled,
+		generateOptions.pluginTimeout,
+		generateOptions.onlyChanged,
 	)
 }
 
@@ -114,27 +119,65 @@ func (g *generator) generate(
 	includeImports bool,
 	includeWellKnownTypes bool,
 	wasmEnabled bool,
+	pluginTimeout time.Duration,
+	onlyChanged bool,
 ) error {
 	if err := modifyImage(ctx, g.logger, config, image); err != nil {
 		return err
 	}
-	responses, err := g.execPlugins(
-		ctx,
-		container,
-		config,
-		image,
-		includeImports,
-		includeWellKnownTypes,
-		wasmEnabled,
-	)
-	if err != nil {
-		return err
+	var plan *onlyChangedPlan
+	if onlyChanged {
+		var err error
+		plan, err = newOnlyChangedPlan(
+			config,
+			image,
+			baseOutDirPath,
+			includeImports,
+			includeWellKnownTypes,
+		)
+		if err != nil {
+			return err
+		}
+		image = plan.image
+	}
+	var responses []*pluginpb.CodeGeneratorResponse
+	if image != nil {
+		var err error
+		responses, err = g.execPlugins(
+			ctx,
+			container,
+			config,
+			image,
+			includeImports,
+			includeWellKnownTypes,
+			wasmEnabled,
+			pluginTimeout,
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		g.logger.Debug("no_changed_files")
+		responses = make([]*pluginpb.CodeGeneratorResponse, len(config.PluginConfigs))
+		for i := range responses {
+			responses[i] = &pluginpb.CodeGeneratorResponse{}
+		}
 	}
 	// Apply the CodeGeneratorResponses in the order they were specified.
+	responseWriterOptions := []appprotoos.ResponseWriterOption{
+		appprotoos.ResponseWriterWithCreateOutDirIfNotExists(),
+		appprotoos.ResponseWriterWithInsertionPointsAcrossOutDirs(),
+	}
+	if config.HeaderConfig != nil {
+		responseWriterOptions = append(
+			responseWriterOptions,
+			appprotoos.ResponseWriterWithFileHeader(config.HeaderConfig.fileHeader),
+		)
+	}
 	responseWriter := appprotoos.NewResponseWriter(
 		g.logger,
 		g.storageosProvider,
-		appprotoos.ResponseWriterWithCreateOutDirIfNotExists(),
+		responseWriterOptions...,
 	)
 	for i, pluginConfig := range config.PluginConfigs {
 		out := pluginConfig.Out
@@ -145,6 +188,9 @@ func (g *generator) generate(
 		if response == nil {
 			return fmt.Errorf("failed to get plugin response for %s", pluginConfig.PluginName())
 		}
+		if err := checkResponse(response, out, baseOutDirPath, config.LimitsConfig); err != nil {
+			return fmt.Errorf("plugin %s: %v", pluginConfig.PluginName(), err)
+		}
 		if err := responseWriter.AddResponse(
 			ctx,
 			response,
@@ -153,9 +199,31 @@ func (g *generator) generate(
 			return fmt.Errorf("plugin %s: %v", pluginConfig.PluginName(), err)
 		}
 	}
+	// Packaging files are written after all plugins so that they can be added
+	// to the output directories of any plugin.
+	for _, packageConfig := range config.PackageConfigs {
+		out := packageConfig.Out
+		if baseOutDirPath != "" && baseOutDirPath != "." {
+			out = filepath.Join(baseOutDirPath, out)
+		}
+		response, err := newPackageResponse(packageConfig)
+		if err != nil {
+			return err
+		}
+		if err := responseWriter.AddResponse(
+			ctx,
+			response,
+			out,
+		); err != nil {
+			return fmt.Errorf("package %s: %v", packageConfig.Out, err)
+		}
+	}
 	if err := responseWriter.Close(); err != nil {
 		return err
 	}
+	if plan != nil {
+		return writeGenerationManifest(baseOutDirPath, plan.newManifest(config, responses))
+	}
 	return nil
 }
 
@@ -167,11 +235,16 @@ func (g *generator) execPlugins(
 	includeImports bool,
 	includeWellKnownTypes bool,
 	wasmEnabled bool,
+	pluginTimeout time.Duration,
 ) ([]*pluginpb.CodeGeneratorResponse, error) {
+	defer timing.Start(ctx, timing.PhaseGenerate)()
 	imageProvider := newImageProvider(image)
 	// Collect all of the plugin jobs so that they can be executed in parallel.
 	jobs := make([]func(context.Context) error, 0, len(config.PluginConfigs))
 	responses := make([]*pluginpb.CodeGeneratorResponse, len(config.PluginConfigs))
+	// The error of each plugin, so that the result of every plugin can be
+	// reported if any of them fail.
+	pluginErrs := make([]error, len(config.PluginConfigs))
 	requiredFeatures := computeRequiredFeatures(image)
 	remotePluginConfigTable := make(map[string][]*remotePluginExecArgs, len(config.PluginConfigs))
 	for i, pluginConfig := range config.PluginConfigs {
@@ -187,7 +260,7 @@ func (g *generator) execPlugins(
 				},
 			)
 		} else {
-			jobs = append(jobs, func(ctx context.Context) error {
+			job := func(ctx context.Context) error {
 				response, err := g.execLocalPlugin(
 					ctx,
 					container,
@@ -202,7 +275,15 @@ func (g *generator) execPlugins(
 				}
 				responses[index] = response
 				return nil
-			})
+			}
+			jobTimeout := pluginTimeout
+			if currentPluginConfig.Timeout > 0 {
+				jobTimeout = currentPluginConfig.Timeout
+			}
+			if jobTimeout > 0 {
+				job = newPluginTimeoutJob(job, jobTimeout)
+			}
+			jobs = append(jobs, newPluginErrorJob(job, pluginErrs, index))
 		}
 	}
 	// Batch for each remote.
@@ -219,7 +300,7 @@ func (g *generator) execPlugins(
 			}
 		}
 		if len(v1Args) > 0 {
-			jobs = append(jobs, func(ctx context.Context) error {
+			job := func(ctx context.Context) error {
 				results, err := g.executeRemotePlugins(
 					ctx,
 					container,
@@ -236,10 +317,14 @@ func (g *generator) execPlugins(
 					responses[result.Index] = result.CodeGeneratorResponse
 				}
 				return nil
-			})
+			}
+			if pluginTimeout > 0 {
+				job = newPluginTimeoutJob(job, pluginTimeout)
+			}
+			jobs = append(jobs, newPluginErrorJob(job, pluginErrs, remotePluginExecArgsIndexes(v1Args)...))
 		}
 		if len(v2Args) > 0 {
-			jobs = append(jobs, func(ctx context.Context) error {
+			job := func(ctx context.Context) error {
 				results, err := g.execRemotePluginsV2(
 					ctx,
 					container,
@@ -256,7 +341,11 @@ func (g *generator) execPlugins(
 					responses[result.Index] = result.CodeGeneratorResponse
 				}
 				return nil
-			})
+			}
+			if pluginTimeout > 0 {
+				job = newPluginTimeoutJob(job, pluginTimeout)
+			}
+			jobs = append(jobs, newPluginErrorJob(job, pluginErrs, remotePluginExecArgsIndexes(v2Args)...))
 		}
 	}
 	// We execute all of the jobs in parallel, but apply them in order so that any
@@ -271,15 +360,17 @@ func (g *generator) execPlugins(
 	//      out: gen/proto
 	//    - name: insertion-point-writer
 	//      out: gen/proto
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	if err := thread.Parallelize(
-		ctx,
-		jobs,
-		thread.ParallelizeWithCancel(cancel),
-	); err != nil {
-		if errs := multierr.Errors(err); len(errs) > 0 {
-			return nil, errs[0]
+	//
+	// A failing plugin does not cancel the other plugins, so that the result of
+	// every plugin can be reported.
+	if err := thread.Parallelize(ctx, jobs); err != nil {
+		if len(config.PluginConfigs) > 1 {
+			printPluginResults(container, config.PluginConfigs, responses, pluginErrs)
+		}
+		for _, pluginErr := range pluginErrs {
+			if pluginErr != nil {
+				return nil, pluginErr
+			}
 		}
 		return nil, err
 	}
@@ -290,6 +381,75 @@ func (g *generator) execPlugins(
 	return responses, nil
 }
 
+// newPluginTimeoutJob wraps the plugin job so that it fails if it does not
+// complete within pluginTimeout.
+func newPluginTimeoutJob(job func(context.Context) error, pluginTimeout time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		jobCtx, cancel := context.WithTimeout(ctx, pluginTimeout)
+		defer cancel()
+		if err := job(jobCtx); err != nil {
+			// Only attribute the error to the plugin timeout if the parent
+			// context is still valid.
+			if ctx.Err() == nil && errors.Is(jobCtx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("plugin execution timed out after %v: %w", pluginTimeout, err)
+			}
+			return err
+		}
+		return nil
+	}
+}
+
+// newPluginErrorJob wraps the plugin job so that its error is recorded in
+// pluginErrs for each of the plugins at the given indexes.
+func newPluginErrorJob(job func(context.Context) error, pluginErrs []error, indexes ...int) func(context.Context) error {
+	return func(ctx context.Context) error {
+		err := job(ctx)
+		if err != nil {
+			for _, index := range indexes {
+				pluginErrs[index] = err
+			}
+		}
+		return err
+	}
+}
+
+// printPluginResults prints the result of each plugin after at least one of
+// them failed.
+//
+// No files are written if any plugin fails, as the outputs of the plugins may
+// depend on each other through insertion points.
+func printPluginResults(
+	container app.StderrContainer,
+	pluginConfigs []*PluginConfig,
+	responses []*pluginpb.CodeGeneratorResponse,
+	pluginErrs []error,
+) {
+	var numFailed int
+	for _, pluginErr := range pluginErrs {
+		if pluginErr != nil {
+			numFailed++
+		}
+	}
+	_, _ = fmt.Fprintf(
+		container.Stderr(),
+		"Warning: %d of %d plugins failed, no generated files were written.\n",
+		numFailed,
+		len(pluginConfigs),
+	)
+	for i, pluginConfig := range pluginConfigs {
+		var result string
+		switch {
+		case pluginErrs[i] != nil:
+			result = "failed: " + pluginErrs[i].Error()
+		case responses[i] != nil:
+			result = "succeeded"
+		default:
+			result = "did not run"
+		}
+		_, _ = fmt.Fprintf(container.Stderr(), "  %s: %s\n", pluginConfig.PluginName(), result)
+	}
+}
+
 func (g *generator) execLocalPlugin(
 	ctx context.Context,
 	container app.EnvStdioContainer,
@@ -313,6 +473,30 @@ func (g *generator) execLocalPlugin(
 			bufpluginexec.GenerateWithWASMEnabled(),
 		)
 	}
+	if pluginConfig.WorkingDir != "" {
+		generateOptions = append(
+			generateOptions,
+			bufpluginexec.GenerateWithDir(pluginConfig.WorkingDir),
+		)
+	}
+	if len(pluginConfig.Env) > 0 {
+		generateOptions = append(
+			generateOptions,
+			bufpluginexec.GenerateWithEnv(pluginConfig.Env),
+		)
+	}
+	if pluginConfig.Sandbox == SandboxStrict {
+		generateOptions = append(
+			generateOptions,
+			bufpluginexec.GenerateWithSandbox(),
+		)
+	}
+	if pluginConfig.MaxMemory > 0 {
+		generateOptions = append(
+			generateOptions,
+			bufpluginexec.GenerateWithMaxMemory(pluginConfig.MaxMemory),
+		)
+	}
 	response, err := g.pluginexecGenerator.Generate(
 		ctx,
 		container,
@@ -337,6 +521,14 @@ type remotePluginExecArgs struct {
 	PluginConfig *PluginConfig
 }
 
+func remotePluginExecArgsIndexes(remotePluginExecArgs []*remotePluginExecArgs) []int {
+	indexes := make([]int, len(remotePluginExecArgs))
+	for i, args := range remotePluginExecArgs {
+		indexes[i] = args.Index
+	}
+	return indexes
+}
+
 type remotePluginExecutionResult struct {
 	CodeGeneratorResponse *pluginpb.CodeGeneratorResponse
 	Index                 int
@@ -727,6 +919,8 @@ type generateOptions struct {
 	includeImports        bool
 	includeWellKnownTypes bool
 	wasmEnabled           bool
+	pluginTimeout         time.Duration
+	onlyChanged           bool
 }
 
 func newGenerateOptions() *generateOptions {
@@ -0,0 +1,115 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// headerCommentPrefixes is a map from file extension to the prefix of a line
+// comment in the files with that extension.
+//
+// Headers are not added to files with other extensions, as the header could
+// make the file invalid.
+var headerCommentPrefixes = map[string]string{
+	".c":     "//",
+	".cc":    "//",
+	".cjs":   "//",
+	".cpp":   "//",
+	".cs":    "//",
+	".cts":   "//",
+	".cxx":   "//",
+	".dart":  "//",
+	".ex":    "#",
+	".exs":   "#",
+	".go":    "//",
+	".h":     "//",
+	".hh":    "//",
+	".hpp":   "//",
+	".hs":    "--",
+	".hxx":   "//",
+	".java":  "//",
+	".js":    "//",
+	".jsx":   "//",
+	".kt":    "//",
+	".kts":   "//",
+	".lua":   "--",
+	".m":     "//",
+	".mjs":   "//",
+	".mm":    "//",
+	".mts":   "//",
+	".php":   "//",
+	".proto": "//",
+	".py":    "#",
+	".pyi":   "#",
+	".r":     "#",
+	".rb":    "#",
+	".rbi":   "#",
+	".rbs":   "#",
+	".rs":    "//",
+	".scala": "//",
+	".sh":    "#",
+	".sql":   "--",
+	".swift": "//",
+	".toml":  "#",
+	".ts":    "//",
+	".tsx":   "//",
+	".yaml":  "#",
+	".yml":   "#",
+}
+
+// lines returns the lines of the header, without comment prefixes.
+func (c *HeaderConfig) lines() []string {
+	var lines []string
+	if c.Text != "" {
+		lines = append(lines, strings.Split(strings.TrimRight(c.Text, "\n"), "\n")...)
+	}
+	if c.DoNotEdit || c.TemplateDigest != "" {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		if c.DoNotEdit {
+			lines = append(lines, "Code generated by buf. DO NOT EDIT.")
+		}
+		if c.TemplateDigest != "" {
+			lines = append(lines, "Template digest: sha256:"+c.TemplateDigest)
+		}
+	}
+	return lines
+}
+
+// fileHeader returns the header for the file with the given name, or the empty
+// string if the file should not have a header.
+func (c *HeaderConfig) fileHeader(fileName string) string {
+	commentPrefix, ok := headerCommentPrefixes[strings.ToLower(filepath.Ext(fileName))]
+	if !ok {
+		return ""
+	}
+	lines := c.lines()
+	if len(lines) == 0 {
+		return ""
+	}
+	var builder strings.Builder
+	for _, line := range lines {
+		builder.WriteString(commentPrefix)
+		if line != "" {
+			builder.WriteString(" ")
+			builder.WriteString(line)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
@@ -0,0 +1,56 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderConfigFileHeader(t *testing.T) {
+	t.Parallel()
+	headerConfig := &HeaderConfig{
+		Text:           "Copyright 2023 Acme, Inc.\n",
+		DoNotEdit:      true,
+		TemplateDigest: "abc",
+	}
+	assert.Equal(
+		t,
+		`// Copyright 2023 Acme, Inc.
+//
+// Code generated by buf. DO NOT EDIT.
+// Template digest: sha256:abc
+`,
+		headerConfig.fileHeader("foo/v1/foo.pb.go"),
+	)
+	assert.Equal(
+		t,
+		`# Copyright 2023 Acme, Inc.
+#
+# Code generated by buf. DO NOT EDIT.
+# Template digest: sha256:abc
+`,
+		headerConfig.fileHeader("foo/v1/foo_pb2.PY"),
+	)
+	assert.Equal(t, "", headerConfig.fileHeader("foo/v1/foo.json"))
+	assert.Equal(t, "", headerConfig.fileHeader("Makefile"))
+	assert.Equal(
+		t,
+		"-- Code generated by buf. DO NOT EDIT.\n",
+		(&HeaderConfig{DoNotEdit: true}).fileHeader("foo.lua"),
+	)
+	assert.Equal(t, "", (&HeaderConfig{}).fileHeader("foo.go"))
+}
@@ -0,0 +1,87 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"fmt"
+
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// checkResponse checks that the files of the response of a plugin are within
+// its output directory, and that the response is within the limits of the
+// limitsConfig, if any.
+//
+// The outDirPath is the output directory of the plugin, and the forbidden paths
+// of the limitsConfig are relative to the baseOutDirPath.
+func checkResponse(
+	response *pluginpb.CodeGeneratorResponse,
+	outDirPath string,
+	baseOutDirPath string,
+	limitsConfig *LimitsConfig,
+) error {
+	var forbiddenPaths []string
+	if limitsConfig != nil {
+		forbiddenPaths = make([]string, len(limitsConfig.ForbiddenPaths))
+		for i, forbiddenPath := range limitsConfig.ForbiddenPaths {
+			absForbiddenPath, err := normalpath.NormalizeAndAbsolute(normalpath.Join(baseOutDirPath, forbiddenPath))
+			if err != nil {
+				return err
+			}
+			forbiddenPaths[i] = absForbiddenPath
+		}
+	}
+	var numFiles int
+	var numBytes uint64
+	for _, file := range response.GetFile() {
+		// Insertion points can target files in the parent output directory of
+		// another plugin, and cannot create files.
+		if file.GetInsertionPoint() == "" {
+			if _, err := normalpath.NormalizeAndValidate(file.GetName()); err != nil {
+				return fmt.Errorf("file %q is not within the output directory %q", file.GetName(), outDirPath)
+			}
+			numFiles++
+		}
+		numBytes += uint64(len(file.GetContent()))
+		if len(forbiddenPaths) == 0 {
+			continue
+		}
+		absFilePath, err := normalpath.NormalizeAndAbsolute(normalpath.Join(outDirPath, file.GetName()))
+		if err != nil {
+			return err
+		}
+		for i, forbiddenPath := range forbiddenPaths {
+			if normalpath.EqualsOrContainsPath(forbiddenPath, absFilePath, normalpath.Absolute) {
+				return fmt.Errorf(
+					"file %q in output directory %q is within the forbidden path %q",
+					file.GetName(),
+					outDirPath,
+					limitsConfig.ForbiddenPaths[i],
+				)
+			}
+		}
+	}
+	if limitsConfig == nil {
+		return nil
+	}
+	if limitsConfig.MaxFiles > 0 && numFiles > limitsConfig.MaxFiles {
+		return fmt.Errorf("generated %d files, which exceeds the limit of %d files", numFiles, limitsConfig.MaxFiles)
+	}
+	if limitsConfig.MaxSize > 0 && numBytes > limitsConfig.MaxSize {
+		return fmt.Errorf("generated %d bytes, which exceeds the limit of %d bytes", numBytes, limitsConfig.MaxSize)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestCheckResponse(t *testing.T) {
+	t.Parallel()
+	response := &pluginpb.CodeGeneratorResponse{
+		File: []*pluginpb.CodeGeneratorResponse_File{
+			{
+				Name:    proto.String("foo/v1/foo.pb.go"),
+				Content: proto.String("package foov1\n"),
+			},
+			{
+				Name:    proto.String("foo/v1/bar.pb.go"),
+				Content: proto.String("package foov1\n"),
+			},
+			{
+				Name:           proto.String("../foo/v1/foo.pb.go"),
+				InsertionPoint: proto.String("imports"),
+				Content:        proto.String("import \"fmt\"\n"),
+			},
+		},
+	}
+	require.NoError(t, checkResponse(response, "gen/go", "", nil))
+	require.NoError(
+		t,
+		checkResponse(
+			response,
+			"gen/go",
+			"",
+			&LimitsConfig{
+				MaxFiles:       2,
+				MaxSize:        41,
+				ForbiddenPaths: []string{"gen/go/bar", "gen/go/foo/v2"},
+			},
+		),
+	)
+	err := checkResponse(response, "gen/go", "", &LimitsConfig{MaxFiles: 1})
+	assert.EqualError(t, err, "generated 2 files, which exceeds the limit of 1 files")
+	err = checkResponse(response, "gen/go", "", &LimitsConfig{MaxSize: 40})
+	assert.EqualError(t, err, "generated 41 bytes, which exceeds the limit of 40 bytes")
+	err = checkResponse(response, "out/gen/go", "out", &LimitsConfig{ForbiddenPaths: []string{"gen/go/foo"}})
+	assert.EqualError(t, err, `file "foo/v1/foo.pb.go" in output directory "out/gen/go" is within the forbidden path "gen/go/foo"`)
+	err = checkResponse(
+		&pluginpb.CodeGeneratorResponse{
+			File: []*pluginpb.CodeGeneratorResponse_File{
+				{
+					Name:    proto.String("../../.github/workflows/ci.yaml"),
+					Content: proto.String(""),
+				},
+			},
+		},
+		"gen/go",
+		"",
+		nil,
+	)
+	assert.EqualError(t, err, `file "../../.github/workflows/ci.yaml" is not within the output directory "gen/go"`)
+}
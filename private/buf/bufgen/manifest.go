@@ -0,0 +1,400 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/gen/data/datawkt"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	// generationManifestFileName is the name of the file, relative to the base
+	// output directory, that records which outputs were derived from which inputs.
+	generationManifestFileName = ".buf.gen.manifest.json"
+	generationManifestVersion  = 1
+)
+
+// generationManifest records the state of the last generation with
+// GenerateWithOnlyChanged, so that the next generation can determine which
+// outputs are affected by the inputs that changed since.
+type generationManifest struct {
+	Version int `json:"version"`
+	// Files is a map from the path of every file in the image to the hex-encoded
+	// SHA256 digest of its FileDescriptorProto after managed mode was applied.
+	Files   map[string]string           `json:"files"`
+	Plugins []*generationManifestPlugin `json:"plugins"`
+	// Header is the lines of the header added to the generated files, if any.
+	Header []string `json:"header,omitempty"`
+}
+
+type generationManifestPlugin struct {
+	Name     string `json:"name"`
+	Out      string `json:"out"`
+	Opt      string `json:"opt,omitempty"`
+	Strategy int    `json:"strategy"`
+	// InsertionPoints is set if the plugin wrote to an insertion point, in which
+	// case its outputs cannot be attributed to individual inputs.
+	InsertionPoints bool `json:"insertion_points,omitempty"`
+	// Outputs is a map from the path of each output, relative to Out, to the
+	// paths of the inputs that it was derived from.
+	Outputs map[string][]string `json:"outputs"`
+}
+
+// onlyChangedPlan is the result of comparing the image against the manifest
+// of the last generation.
+type onlyChangedPlan struct {
+	// image only contains the files to generate as non-imports.
+	//
+	// This is nil if nothing needs to be generated.
+	image bufimage.Image
+	// paths are the paths of the inputs that are regenerated, including the
+	// paths of inputs that no longer exist.
+	paths    map[string]struct{}
+	previous *generationManifest
+	files    map[string]string
+	full     bool
+}
+
+// newOnlyChangedPlan reads the manifest in the base output directory, and
+// determines which of the files of the image need to be generated.
+//
+// A file needs to be generated if it is new or it, or any of its transitive
+// dependencies, changed. Files are regenerated a directory at a time, as
+// plugins commonly generate code per package, and all the inputs that share
+// an output with a regenerated file are regenerated as well.
+//
+// Everything is generated if there is no manifest, if the plugins changed
+// since the last generation, or if a plugin wrote to an insertion point.
+func newOnlyChangedPlan(
+	config *Config,
+	image bufimage.Image,
+	baseOutDirPath string,
+	includeImports bool,
+	includeWellKnownTypes bool,
+) (*onlyChangedPlan, error) {
+	files, err := getImageFileDigests(image)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := readGenerationManifest(baseOutDirPath)
+	if err != nil {
+		return nil, err
+	}
+	var candidatePaths []string
+	for _, imageFile := range image.Files() {
+		if imageFile.IsImport() {
+			if !includeImports || (!includeWellKnownTypes && datawkt.Exists(imageFile.Path())) {
+				continue
+			}
+		}
+		candidatePaths = append(candidatePaths, imageFile.Path())
+	}
+	plan := &onlyChangedPlan{
+		paths:    make(map[string]struct{}),
+		previous: previous,
+		files:    files,
+	}
+	if !previous.matches(config) {
+		plan.image = image
+		plan.full = true
+		for _, path := range candidatePaths {
+			plan.paths[path] = struct{}{}
+		}
+		return plan, nil
+	}
+	changed := make(map[string]bool, len(files))
+	var isChanged func(string) bool
+	isChanged = func(path string) bool {
+		if value, ok := changed[path]; ok {
+			return value
+		}
+		// Guard against import cycles, which are invalid but should not recurse forever.
+		changed[path] = false
+		value := previous.Files[path] != files[path]
+		if !value {
+			if imageFile := image.GetFile(path); imageFile != nil {
+				for _, dependency := range imageFile.Proto().GetDependency() {
+					if isChanged(dependency) {
+						value = true
+						break
+					}
+				}
+			}
+		}
+		changed[path] = value
+		return value
+	}
+	candidateDirPaths := make(map[string][]string)
+	for _, path := range candidatePaths {
+		dirPath := normalpath.Dir(path)
+		candidateDirPaths[dirPath] = append(candidateDirPaths[dirPath], path)
+		if isChanged(path) {
+			plan.paths[path] = struct{}{}
+		}
+	}
+	// Inputs that were removed since the last generation are treated as
+	// changed, so that the inputs that shared an output with them are
+	// regenerated.
+	for path := range previous.Files {
+		if _, ok := files[path]; !ok {
+			plan.paths[path] = struct{}{}
+		}
+	}
+	for {
+		numPaths := len(plan.paths)
+		for path := range plan.paths {
+			for _, dirPath := range candidateDirPaths[normalpath.Dir(path)] {
+				plan.paths[dirPath] = struct{}{}
+			}
+		}
+		for _, plugin := range previous.Plugins {
+			for _, inputPaths := range plugin.Outputs {
+				if !plan.containsAny(inputPaths) {
+					continue
+				}
+				for _, inputPath := range inputPaths {
+					plan.paths[inputPath] = struct{}{}
+				}
+			}
+		}
+		if len(plan.paths) == numPaths {
+			break
+		}
+	}
+	var generatePaths []string
+	for _, path := range candidatePaths {
+		if _, ok := plan.paths[path]; ok {
+			generatePaths = append(generatePaths, path)
+		}
+	}
+	switch len(generatePaths) {
+	case 0:
+	case len(candidatePaths):
+		plan.image = image
+	default:
+		plan.image, err = bufimage.ImageWithOnlyPaths(image, generatePaths, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+func (p *onlyChangedPlan) containsAny(paths []string) bool {
+	for _, path := range paths {
+		if _, ok := p.paths[path]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// newManifest returns the manifest that results from writing the given
+// responses, which are in the same order as the PluginConfigs of the config.
+//
+// Outputs of the previous manifest that were not derived from any of the
+// regenerated inputs are kept, as they were not rewritten.
+func (p *onlyChangedPlan) newManifest(
+	config *Config,
+	responses []*pluginpb.CodeGeneratorResponse,
+) *generationManifest {
+	manifest := &generationManifest{
+		Version: generationManifestVersion,
+		Files:   p.files,
+		Plugins: make([]*generationManifestPlugin, len(config.PluginConfigs)),
+		Header:  getGenerationManifestHeader(config),
+	}
+	var generatedPaths []string
+	for path := range p.paths {
+		if _, ok := p.files[path]; ok {
+			generatedPaths = append(generatedPaths, path)
+		}
+	}
+	sort.Strings(generatedPaths)
+	for i, pluginConfig := range config.PluginConfigs {
+		plugin := newGenerationManifestPlugin(pluginConfig)
+		if !p.full {
+			for outputPath, inputPaths := range p.previous.Plugins[i].Outputs {
+				if !p.containsAny(inputPaths) {
+					plugin.Outputs[outputPath] = inputPaths
+				}
+			}
+		}
+		for _, file := range responses[i].GetFile() {
+			if file.GetInsertionPoint() != "" {
+				plugin.InsertionPoints = true
+				continue
+			}
+			plugin.Outputs[file.GetName()] = getOutputInputPaths(file, generatedPaths)
+		}
+		manifest.Plugins[i] = plugin
+	}
+	return manifest
+}
+
+func (m *generationManifest) matches(config *Config) bool {
+	if m == nil || m.Version != generationManifestVersion || len(m.Plugins) != len(config.PluginConfigs) {
+		return false
+	}
+	// All of the outputs are rewritten if the header changed.
+	if !stringutil.SliceElementsEqual(m.Header, getGenerationManifestHeader(config)) {
+		return false
+	}
+	for i, pluginConfig := range config.PluginConfigs {
+		plugin := newGenerationManifestPlugin(pluginConfig)
+		previous := m.Plugins[i]
+		if previous.InsertionPoints ||
+			previous.Name != plugin.Name ||
+			previous.Out != plugin.Out ||
+			previous.Opt != plugin.Opt ||
+			previous.Strategy != plugin.Strategy {
+			return false
+		}
+	}
+	return true
+}
+
+func getGenerationManifestHeader(config *Config) []string {
+	if config.HeaderConfig == nil {
+		return nil
+	}
+	return config.HeaderConfig.lines()
+}
+
+func newGenerationManifestPlugin(pluginConfig *PluginConfig) *generationManifestPlugin {
+	return &generationManifestPlugin{
+		Name:     pluginConfig.PluginName(),
+		Out:      pluginConfig.Out,
+		Opt:      pluginConfig.Opt,
+		Strategy: int(pluginConfig.Strategy),
+		Outputs:  make(map[string][]string),
+	}
+}
+
+// getOutputInputPaths returns the paths of the inputs that the given output
+// was derived from.
+//
+// The source files of the GeneratedCodeInfo of the output are used if
+// present. Otherwise, the output is attributed to the input with the longest
+// name that the base name of the output starts with, such as "foo.pb.go" or
+// "foo_pb2.py" for "foo.proto", preferring inputs in the same directory. If
+// no input matches, the output is attributed to all of the generated inputs.
+func getOutputInputPaths(file *pluginpb.CodeGeneratorResponse_File, generatedPaths []string) []string {
+	sourceFiles := make(map[string]struct{})
+	for _, annotation := range file.GetGeneratedCodeInfo().GetAnnotation() {
+		if sourceFile := annotation.GetSourceFile(); sourceFile != "" {
+			sourceFiles[sourceFile] = struct{}{}
+		}
+	}
+	if len(sourceFiles) > 0 {
+		inputPaths := make([]string, 0, len(sourceFiles))
+		for sourceFile := range sourceFiles {
+			inputPaths = append(inputPaths, sourceFile)
+		}
+		sort.Strings(inputPaths)
+		return inputPaths
+	}
+	outputPath := normalpath.Normalize(file.GetName())
+	outputBase := strings.ToLower(normalpath.Base(outputPath))
+	var match string
+	var matchInDir bool
+	for _, path := range generatedPaths {
+		stem := strings.ToLower(strings.TrimSuffix(normalpath.Base(path), ".proto"))
+		if !strings.HasPrefix(outputBase, stem+".") && !strings.HasPrefix(outputBase, stem+"_") {
+			continue
+		}
+		inDir := normalpath.EqualsOrContainsPath(normalpath.Dir(path), normalpath.Dir(outputPath), normalpath.Relative)
+		if match == "" ||
+			(inDir && !matchInDir) ||
+			(inDir == matchInDir && len(stem) > len(normalpath.Base(match))-len(".proto")) {
+			match = path
+			matchInDir = inDir
+		}
+	}
+	if match != "" {
+		return []string{match}
+	}
+	return generatedPaths
+}
+
+// getImageFileDigests returns a map from the path of each file in the image
+// to the hex-encoded SHA256 digest of its FileDescriptorProto.
+func getImageFileDigests(image bufimage.Image) (map[string]string, error) {
+	digests := make(map[string]string, len(image.Files()))
+	for _, imageFile := range image.Files() {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(imageFile.Proto())
+		if err != nil {
+			return nil, err
+		}
+		digest := sha256.Sum256(data)
+		digests[imageFile.Path()] = hex.EncodeToString(digest[:])
+	}
+	return digests, nil
+}
+
+// readGenerationManifest reads the manifest in the base output directory.
+//
+// Returns nil if there is no manifest.
+func readGenerationManifest(baseOutDirPath string) (*generationManifest, error) {
+	data, err := os.ReadFile(getGenerationManifestFilePath(baseOutDirPath))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	manifest := &generationManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		// An unreadable manifest results in everything being generated, and
+		// the manifest being rewritten.
+		return nil, nil
+	}
+	return manifest, nil
+}
+
+// writeGenerationManifest writes the manifest to the base output directory.
+func writeGenerationManifest(baseOutDirPath string, manifest *generationManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if baseOutDirPath != "" {
+		if err := os.MkdirAll(baseOutDirPath, 0755); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(getGenerationManifestFilePath(baseOutDirPath), append(data, '\n'), 0644)
+}
+
+func getGenerationManifestFilePath(baseOutDirPath string) string {
+	if baseOutDirPath == "" {
+		return generationManifestFileName
+	}
+	return filepath.Join(baseOutDirPath, generationManifestFileName)
+}
@@ -0,0 +1,170 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagetesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestOnlyChangedPlan(t *testing.T) {
+	t.Parallel()
+	baseOutDirPath := t.TempDir()
+	config := &Config{
+		PluginConfigs: []*PluginConfig{
+			{
+				Name:     "go",
+				Out:      "gen/go",
+				Opt:      "paths=source_relative",
+				Strategy: StrategyDirectory,
+			},
+		},
+	}
+	generate := func(image bufimage.Image) []string {
+		plan, err := newOnlyChangedPlan(config, image, baseOutDirPath, false, false)
+		require.NoError(t, err)
+		var generatedPaths []string
+		response := &pluginpb.CodeGeneratorResponse{}
+		if plan.image != nil {
+			for _, imageFile := range plan.image.Files() {
+				if imageFile.IsImport() {
+					continue
+				}
+				generatedPaths = append(generatedPaths, imageFile.Path())
+				response.File = append(
+					response.File,
+					&pluginpb.CodeGeneratorResponse_File{
+						Name: proto.String(imageFile.Path()[:len(imageFile.Path())-len(".proto")] + ".pb.go"),
+					},
+				)
+			}
+		}
+		require.NoError(t, writeGenerationManifest(baseOutDirPath, plan.newManifest(config, []*pluginpb.CodeGeneratorResponse{response})))
+		return generatedPaths
+	}
+	assert.Equal(
+		t,
+		[]string{"a/v1/a.proto", "b/v1/b.proto", "c/v1/c.proto"},
+		generate(newTestOnlyChangedImage(t, false)),
+	)
+	assert.Empty(t, generate(newTestOnlyChangedImage(t, false)))
+	// b/v1/b.proto imports a/v1/a.proto, but c/v1/c.proto does not.
+	assert.Equal(
+		t,
+		[]string{"a/v1/a.proto", "b/v1/b.proto"},
+		generate(newTestOnlyChangedImage(t, true)),
+	)
+	assert.Empty(t, generate(newTestOnlyChangedImage(t, true)))
+	config.PluginConfigs[0].Opt = ""
+	assert.Equal(
+		t,
+		[]string{"a/v1/a.proto", "b/v1/b.proto", "c/v1/c.proto"},
+		generate(newTestOnlyChangedImage(t, true)),
+	)
+}
+
+func TestGetOutputInputPaths(t *testing.T) {
+	t.Parallel()
+	generatedPaths := []string{
+		"acme/pet/v1/pet.proto",
+		"acme/pet/v1/pet_store.proto",
+		"acme/store/v1/pet.proto",
+	}
+	assert.Equal(
+		t,
+		[]string{"acme/pet/v1/pet_store.proto"},
+		getOutputInputPaths(
+			&pluginpb.CodeGeneratorResponse_File{Name: proto.String("acme/pet/v1/pet_store.pb.go")},
+			generatedPaths,
+		),
+	)
+	assert.Equal(
+		t,
+		[]string{"acme/pet/v1/pet.proto"},
+		getOutputInputPaths(
+			&pluginpb.CodeGeneratorResponse_File{Name: proto.String("acme/pet/v1/pet_pb2.py")},
+			generatedPaths,
+		),
+	)
+	assert.Equal(
+		t,
+		[]string{"acme/store/v1/pet.proto"},
+		getOutputInputPaths(
+			&pluginpb.CodeGeneratorResponse_File{Name: proto.String("acme/store/v1/pet.pb.go")},
+			generatedPaths,
+		),
+	)
+	assert.Equal(
+		t,
+		[]string{"acme/store/v1/pet.proto"},
+		getOutputInputPaths(
+			&pluginpb.CodeGeneratorResponse_File{
+				Name: proto.String("com/acme/store/v1/PetProto.java"),
+				GeneratedCodeInfo: &descriptorpb.GeneratedCodeInfo{
+					Annotation: []*descriptorpb.GeneratedCodeInfo_Annotation{
+						{SourceFile: proto.String("acme/store/v1/pet.proto")},
+					},
+				},
+			},
+			generatedPaths,
+		),
+	)
+	assert.Equal(
+		t,
+		generatedPaths,
+		getOutputInputPaths(
+			&pluginpb.CodeGeneratorResponse_File{Name: proto.String("index.ts")},
+			generatedPaths,
+		),
+	)
+}
+
+// newTestOnlyChangedImage returns an Image where b/v1/b.proto imports
+// a/v1/a.proto. If changeA is set, a message is added to a/v1/a.proto.
+func newTestOnlyChangedImage(t *testing.T, changeA bool) bufimage.Image {
+	newFileDescriptorProto := func(path string, pkg string, dependencies ...string) *descriptorpb.FileDescriptorProto {
+		return &descriptorpb.FileDescriptorProto{
+			Name:       proto.String(path),
+			Package:    proto.String(pkg),
+			Dependency: dependencies,
+			Syntax:     proto.String("proto3"),
+		}
+	}
+	a := newFileDescriptorProto("a/v1/a.proto", "acme.a.v1")
+	if changeA {
+		a.MessageType = []*descriptorpb.DescriptorProto{{Name: proto.String("Changed")}}
+	}
+	var imageFiles []bufimage.ImageFile
+	for _, fileDescriptorProto := range []*descriptorpb.FileDescriptorProto{
+		a,
+		newFileDescriptorProto("b/v1/b.proto", "acme.b.v1", "a/v1/a.proto"),
+		newFileDescriptorProto("c/v1/c.proto", "acme.c.v1"),
+	} {
+		imageFiles = append(
+			imageFiles,
+			bufimagetesting.NewImageFile(t, fileDescriptorProto, nil, "", "", false, false, nil),
+		)
+	}
+	image, err := bufimage.NewImage(imageFiles)
+	require.NoError(t, err)
+	return image
+}
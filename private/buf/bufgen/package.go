@@ -0,0 +1,149 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	goModFileName         = "go.mod"
+	packageJSONFileName   = "package.json"
+	pyprojectTOMLFileName = "pyproject.toml"
+	// defaultPackageVersion is the version used for npm and Python packages
+	// if no version is specified, as both require one.
+	defaultPackageVersion = "0.0.0"
+)
+
+// newPackageResponse returns a CodeGeneratorResponse that contains the packaging
+// file for the PackageConfig.
+//
+// The packaging file is written relative to the PackageConfig's out directory.
+func newPackageResponse(packageConfig *PackageConfig) (*pluginpb.CodeGeneratorResponse, error) {
+	var fileName string
+	var content []byte
+	var err error
+	switch packageConfig.Type {
+	case PackageTypeGo:
+		fileName = goModFileName
+		content = getGoModContent(packageConfig)
+	case PackageTypeNPM:
+		fileName = packageJSONFileName
+		content, err = getPackageJSONContent(packageConfig)
+	case PackageTypePython:
+		fileName = pyprojectTOMLFileName
+		content = getPyprojectTOMLContent(packageConfig)
+	default:
+		return nil, fmt.Errorf("unknown package type: %v", packageConfig.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pluginpb.CodeGeneratorResponse{
+		File: []*pluginpb.CodeGeneratorResponse_File{
+			{
+				Name:    proto.String(fileName),
+				Content: proto.String(string(content)),
+			},
+		},
+	}, nil
+}
+
+func getGoModContent(packageConfig *PackageConfig) []byte {
+	buffer := bytes.NewBuffer(nil)
+	_, _ = fmt.Fprintf(buffer, "module %s\n", packageConfig.Name)
+	depNames := getSortedDepNames(packageConfig.Deps)
+	if len(depNames) > 0 {
+		_, _ = buffer.WriteString("\nrequire (\n")
+		for _, depName := range depNames {
+			_, _ = fmt.Fprintf(buffer, "\t%s %s\n", depName, packageConfig.Deps[depName])
+		}
+		_, _ = buffer.WriteString(")\n")
+	}
+	return buffer.Bytes()
+}
+
+func getPackageJSONContent(packageConfig *PackageConfig) ([]byte, error) {
+	packageJSON := struct {
+		Name         string            `json:"name"`
+		Version      string            `json:"version"`
+		Dependencies map[string]string `json:"dependencies,omitempty"`
+	}{
+		Name:         packageConfig.Name,
+		Version:      getPackageVersion(packageConfig),
+		Dependencies: packageConfig.Deps,
+	}
+	// json.Marshal sorts map keys, so the output is deterministic.
+	data, err := json.MarshalIndent(packageJSON, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func getPyprojectTOMLContent(packageConfig *PackageConfig) []byte {
+	buffer := bytes.NewBuffer(nil)
+	_, _ = buffer.WriteString("[build-system]\n")
+	_, _ = buffer.WriteString("requires = [\"setuptools\"]\n")
+	_, _ = buffer.WriteString("build-backend = \"setuptools.build_meta\"\n")
+	_, _ = buffer.WriteString("\n[project]\n")
+	_, _ = fmt.Fprintf(buffer, "name = %s\n", strconv.Quote(packageConfig.Name))
+	_, _ = fmt.Fprintf(buffer, "version = %s\n", strconv.Quote(getPackageVersion(packageConfig)))
+	depNames := getSortedDepNames(packageConfig.Deps)
+	if len(depNames) > 0 {
+		_, _ = buffer.WriteString("dependencies = [\n")
+		for _, depName := range depNames {
+			requirement := depName + getPythonVersionSpecifier(packageConfig.Deps[depName])
+			_, _ = fmt.Fprintf(buffer, "  %s,\n", strconv.Quote(requirement))
+		}
+		_, _ = buffer.WriteString("]\n")
+	}
+	return buffer.Bytes()
+}
+
+// getPythonVersionSpecifier returns the version specifier for the version.
+//
+// Bare versions such as "4.21.0" are pinned with "==".
+func getPythonVersionSpecifier(version string) string {
+	version = strings.TrimSpace(version)
+	if version != "" && version[0] >= '0' && version[0] <= '9' {
+		return "==" + version
+	}
+	return version
+}
+
+func getPackageVersion(packageConfig *PackageConfig) string {
+	if packageConfig.Version == "" {
+		return defaultPackageVersion
+	}
+	return packageConfig.Version
+}
+
+func getSortedDepNames(deps map[string]string) []string {
+	depNames := make([]string, 0, len(deps))
+	for depName := range deps {
+		depNames = append(depNames, depName)
+	}
+	sort.Strings(depNames)
+	return depNames
+}
@@ -0,0 +1,114 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPackageResponse(t *testing.T) {
+	t.Parallel()
+	testNewPackageResponse(
+		t,
+		&PackageConfig{
+			Out:  "gen/go",
+			Type: PackageTypeGo,
+			Name: "github.com/acme/weather/gen/go",
+			Deps: map[string]string{
+				"google.golang.org/protobuf":     "v1.30.0",
+				"github.com/bufbuild/connect-go": "v1.5.2",
+			},
+		},
+		"go.mod",
+		`module github.com/acme/weather/gen/go
+
+require (
+	github.com/bufbuild/connect-go v1.5.2
+	google.golang.org/protobuf v1.30.0
+)
+`,
+	)
+	testNewPackageResponse(
+		t,
+		&PackageConfig{
+			Out:  "gen/go",
+			Type: PackageTypeGo,
+			Name: "github.com/acme/weather/gen/go",
+		},
+		"go.mod",
+		"module github.com/acme/weather/gen/go\n",
+	)
+	testNewPackageResponse(
+		t,
+		&PackageConfig{
+			Out:     "gen/es",
+			Type:    PackageTypeNPM,
+			Name:    "@acme/weather",
+			Version: "1.2.3",
+			Deps: map[string]string{
+				"@bufbuild/protobuf": "^1.2.0",
+			},
+		},
+		"package.json",
+		`{
+  "name": "@acme/weather",
+  "version": "1.2.3",
+  "dependencies": {
+    "@bufbuild/protobuf": "^1.2.0"
+  }
+}
+`,
+	)
+	testNewPackageResponse(
+		t,
+		&PackageConfig{
+			Out:  "gen/python",
+			Type: PackageTypePython,
+			Name: "acme-weather",
+			Deps: map[string]string{
+				"protobuf": ">=4.21",
+				"grpcio":   "1.54.0",
+			},
+		},
+		"pyproject.toml",
+		`[build-system]
+requires = ["setuptools"]
+build-backend = "setuptools.build_meta"
+
+[project]
+name = "acme-weather"
+version = "0.0.0"
+dependencies = [
+  "grpcio==1.54.0",
+  "protobuf>=4.21",
+]
+`,
+	)
+}
+
+func testNewPackageResponse(
+	t *testing.T,
+	packageConfig *PackageConfig,
+	expectedFileName string,
+	expectedContent string,
+) {
+	response, err := newPackageResponse(packageConfig)
+	require.NoError(t, err)
+	require.Len(t, response.File, 1)
+	require.Equal(t, expectedFileName, response.File[0].GetName())
+	require.Equal(t, expectedContent, response.File[0].GetContent())
+}
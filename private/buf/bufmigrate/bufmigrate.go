@@ -40,3 +40,26 @@ func V1Beta1MigratorWithNotifier(notifier func(message string) error) V1Beta1Mig
 		migrateOptions.notifier = notifier
 	}
 }
+
+// LatestMigrateOption defines the type used
+// to configure the latest migrator.
+type LatestMigrateOption func(*latestMigrator)
+
+// NewLatestMigrator creates a new migrator that migrates the buf.yaml,
+// buf.work.yaml, buf.gen.yaml, and buf.lock files in a directory to the
+// latest version.
+//
+// Files are rewritten in place, preserving comments where possible, and the
+// semantic differences between the versions are sent to the notifier.
+func NewLatestMigrator(commandName string, options ...LatestMigrateOption) Migrator {
+	return newLatestMigrator(commandName, options...)
+}
+
+// LatestMigratorWithNotifier instruments the migrator with
+// a callback to call whenever an event that should notify the
+// user occurs during the migration.
+func LatestMigratorWithNotifier(notifier func(message string) error) LatestMigrateOption {
+	return func(migrateOptions *latestMigrator) {
+		migrateOptions.notifier = notifier
+	}
+}
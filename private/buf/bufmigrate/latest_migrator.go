@@ -0,0 +1,428 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmigrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/bufbuild/buf/private/buf/bufgen"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/buflock"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleconfig"
+	"github.com/bufbuild/buf/private/pkg/encoding"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
+)
+
+type latestMigrator struct {
+	notifier        func(string) error
+	commandName     string
+	v1beta1Migrator *v1beta1Migrator
+}
+
+func newLatestMigrator(commandName string, options ...LatestMigrateOption) *latestMigrator {
+	migrator := latestMigrator{
+		commandName: commandName,
+		notifier:    func(string) error { return nil },
+	}
+	for _, option := range options {
+		option(&migrator)
+	}
+	migrator.v1beta1Migrator = newV1Beta1Migrator(
+		commandName,
+		V1Beta1MigratorWithNotifier(migrator.notifier),
+	)
+	return &migrator
+}
+
+func (m *latestMigrator) Migrate(dirPath string) error {
+	var differences []string
+	configDifferences, err := m.maybeMigrateConfig(dirPath)
+	if err != nil {
+		return fmt.Errorf("failed to migrate config: %w", err)
+	}
+	differences = append(differences, configDifferences...)
+	if err := m.checkWorkspaceConfig(dirPath); err != nil {
+		return fmt.Errorf("failed to migrate workspace config: %w", err)
+	}
+	genTemplateDifferences, err := m.maybeMigrateGenTemplate(dirPath)
+	if err != nil {
+		return fmt.Errorf("failed to migrate generation template: %w", err)
+	}
+	differences = append(differences, genTemplateDifferences...)
+	migratedLockFile, err := m.v1beta1Migrator.maybeMigrateLockFile(dirPath)
+	if err != nil {
+		return fmt.Errorf("failed to migrate lock file: %w", err)
+	}
+	if migratedLockFile {
+		differences = append(
+			differences,
+			fmt.Sprintf("%s: version changed from %s to %s.", buflock.ExternalConfigFilePath, buflock.V1Beta1Version, buflock.V1Version),
+		)
+	}
+	if len(differences) == 0 {
+		return m.notify(fmt.Sprintf("All configuration files are already at the latest version %s.\n", bufconfig.V1Version))
+	}
+	for _, difference := range differences {
+		if err := m.notify(difference + "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maybeMigrateConfig migrates the buf.yaml in place if it only has the "." root.
+//
+// If the buf.yaml has multiple roots, it is split into one buf.yaml per root and
+// a buf.work.yaml by the v1beta1Migrator, and comments cannot be preserved.
+func (m *latestMigrator) maybeMigrateConfig(dirPath string) ([]string, error) {
+	configPath := filepath.Join(dirPath, bufconfig.ExternalConfigV1Beta1FilePath)
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// OK, no config file
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	var versionedConfig bufconfig.ExternalConfigVersion
+	if err := encoding.UnmarshalYAMLNonStrict(data, &versionedConfig); err != nil {
+		return nil, fmt.Errorf("failed to read %s version: %w", configPath, err)
+	}
+	switch versionedConfig.Version {
+	case bufconfig.V1Version:
+		// OK, file is already at the latest version
+		return nil, nil
+	case bufconfig.V1Beta1Version, "":
+		// Continue to migrate
+	default:
+		return nil, fmt.Errorf("unknown config file version: %s", versionedConfig.Version)
+	}
+	var v1beta1Config bufconfig.ExternalConfigV1Beta1
+	if err := encoding.UnmarshalYAMLStrict(data, &v1beta1Config); err != nil {
+		return nil, fmt.Errorf(
+			"failed to unmarshal %s as %s version v1beta1: %w",
+			configPath,
+			bufconfig.ExternalConfigV1Beta1FilePath,
+			err,
+		)
+	}
+	buildConfig, err := bufmoduleconfig.NewConfigV1Beta1(v1beta1Config.Build, v1beta1Config.Deps...)
+	if err != nil {
+		return nil, err
+	}
+	differences := []string{
+		fmt.Sprintf("%s: version changed from %s to %s.", bufconfig.ExternalConfigV1FilePath, bufconfig.V1Beta1Version, bufconfig.V1Version),
+	}
+	if _, ok := buildConfig.RootToExcludes["."]; !ok || len(buildConfig.RootToExcludes) != 1 {
+		if _, err := m.v1beta1Migrator.maybeMigrateConfig(dirPath); err != nil {
+			return nil, err
+		}
+		roots := make([]string, 0, len(buildConfig.RootToExcludes))
+		for root := range buildConfig.RootToExcludes {
+			roots = append(roots, root)
+		}
+		sort.Strings(roots)
+		differences = append(
+			differences,
+			fmt.Sprintf(
+				"%s: build.roots %v were split into one module per root, listed in %s. Comments could not be preserved.",
+				bufconfig.ExternalConfigV1FilePath,
+				roots,
+				bufwork.ExternalConfigV1FilePath,
+			),
+		)
+		return append(differences, getConfigRuleDifferences(v1beta1Config)...), nil
+	}
+	document, err := unmarshalYAMLDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	setYAMLMappingScalar(document, "version", bufconfig.V1Version)
+	if buildNode := getYAMLMappingValue(document, "build"); buildNode != nil {
+		if deleteYAMLMappingKey(buildNode, "roots") {
+			differences = append(
+				differences,
+				fmt.Sprintf(`%s: build.roots was removed, the "." root is now the module root.`, bufconfig.ExternalConfigV1FilePath),
+			)
+		}
+		if buildNode.Kind == yaml.MappingNode && len(buildNode.Content) == 0 {
+			deleteYAMLMappingKey(document, "build")
+		}
+	}
+	if err := writeYAMLDocument(configPath, document); err != nil {
+		return nil, err
+	}
+	return append(differences, getConfigRuleDifferences(v1beta1Config)...), nil
+}
+
+// checkWorkspaceConfig validates the version of the buf.work.yaml.
+//
+// There is only one version of buf.work.yaml, so there is nothing to migrate.
+func (m *latestMigrator) checkWorkspaceConfig(dirPath string) error {
+	data, err := os.ReadFile(filepath.Join(dirPath, bufwork.ExternalConfigV1FilePath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// OK, no workspace file
+			return nil
+		}
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	var externalConfig bufwork.ExternalConfigV1
+	if err := encoding.UnmarshalYAMLNonStrict(data, &externalConfig); err != nil {
+		return fmt.Errorf("failed to read %s version: %w", bufwork.ExternalConfigV1FilePath, err)
+	}
+	if externalConfig.Version != bufwork.V1Version {
+		return fmt.Errorf("unknown workspace file version: %s", externalConfig.Version)
+	}
+	return nil
+}
+
+// maybeMigrateGenTemplate migrates the buf.gen.yaml in place.
+func (m *latestMigrator) maybeMigrateGenTemplate(dirPath string) ([]string, error) {
+	configPath := filepath.Join(dirPath, bufgen.ExternalConfigFilePath)
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// OK, no generation template
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	var versionedConfig bufgen.ExternalConfigVersion
+	if err := encoding.UnmarshalYAMLNonStrict(data, &versionedConfig); err != nil {
+		return nil, fmt.Errorf("failed to read %s version: %w", configPath, err)
+	}
+	switch versionedConfig.Version {
+	case bufgen.V1Version:
+		// OK, file is already at the latest version
+		return nil, nil
+	case bufgen.V1Beta1Version, "":
+		// Continue to migrate
+	default:
+		return nil, fmt.Errorf("unknown config file version: %s", versionedConfig.Version)
+	}
+	var v1beta1GenTemplate bufgen.ExternalConfigV1Beta1
+	if err := encoding.UnmarshalYAMLStrict(data, &v1beta1GenTemplate); err != nil {
+		return nil, fmt.Errorf(
+			"failed to unmarshal %s as %s version v1beta1: %w",
+			configPath,
+			bufgen.ExternalConfigFilePath,
+			err,
+		)
+	}
+	document, err := unmarshalYAMLDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	differences := []string{
+		fmt.Sprintf("%s: version changed from %s to %s.", bufgen.ExternalConfigFilePath, bufgen.V1Beta1Version, bufgen.V1Version),
+	}
+	setYAMLMappingScalar(document, "version", bufgen.V1Version)
+	// In v1beta1, managed is a boolean and the managed mode options are set in options.
+	// In v1, all of these are set within the managed mapping.
+	managedNode := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+	}
+	if enabledNode := getYAMLMappingValue(document, "managed"); enabledNode != nil {
+		managedNode.Content = append(managedNode.Content, newYAMLScalarNode("enabled"), enabledNode)
+		differences = append(
+			differences,
+			fmt.Sprintf("%s: managed was moved to managed.enabled.", bufgen.ExternalConfigFilePath),
+		)
+	}
+	if optionsNode := getYAMLMappingValue(document, "options"); optionsNode != nil && optionsNode.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(optionsNode.Content); i += 2 {
+			differences = append(
+				differences,
+				fmt.Sprintf(
+					"%s: options.%s was moved to managed.%s.",
+					bufgen.ExternalConfigFilePath,
+					optionsNode.Content[i].Value,
+					optionsNode.Content[i].Value,
+				),
+			)
+		}
+		managedNode.Content = append(managedNode.Content, optionsNode.Content...)
+	}
+	deleteYAMLMappingKey(document, "options")
+	if len(managedNode.Content) > 0 {
+		setYAMLMappingValue(document, "managed", managedNode)
+	}
+	if err := writeYAMLDocument(configPath, document); err != nil {
+		return nil, err
+	}
+	return differences, nil
+}
+
+func (m *latestMigrator) notify(message string) error {
+	if err := m.notifier(message); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	return nil
+}
+
+// getConfigRuleDifferences returns the lint and breaking rules that are checked
+// differently after migrating the given config from v1beta1 to v1.
+func getConfigRuleDifferences(v1beta1Config bufconfig.ExternalConfigV1Beta1) []string {
+	var differences []string
+	v1beta1LintRules, err := buflint.RulesForConfig(buflintconfig.NewConfigV1Beta1(v1beta1Config.Lint))
+	if err != nil {
+		return nil
+	}
+	v1LintRules, err := buflint.RulesForConfig(buflintconfig.NewConfigV1(buflintconfig.ExternalConfigV1ForConfig(buflintconfig.NewConfigV1Beta1(v1beta1Config.Lint))))
+	if err != nil {
+		differences = append(differences, fmt.Sprintf("%s: lint configuration is invalid at %s: %v.", bufconfig.ExternalConfigV1FilePath, bufconfig.V1Version, err))
+	} else {
+		differences = append(differences, getRuleDifferences("lint", v1beta1LintRules, v1LintRules)...)
+	}
+	v1beta1BreakingRules, err := bufbreaking.RulesForConfig(bufbreakingconfig.NewConfigV1Beta1(v1beta1Config.Breaking))
+	if err != nil {
+		return differences
+	}
+	v1BreakingRules, err := bufbreaking.RulesForConfig(bufbreakingconfig.NewConfigV1(bufbreakingconfig.ExternalConfigV1ForConfig(bufbreakingconfig.NewConfigV1Beta1(v1beta1Config.Breaking))))
+	if err != nil {
+		differences = append(differences, fmt.Sprintf("%s: breaking configuration is invalid at %s: %v.", bufconfig.ExternalConfigV1FilePath, bufconfig.V1Version, err))
+	} else {
+		differences = append(differences, getRuleDifferences("breaking", v1beta1BreakingRules, v1BreakingRules)...)
+	}
+	return differences
+}
+
+func getRuleDifferences(kind string, oldRules []bufcheck.Rule, newRules []bufcheck.Rule) []string {
+	oldIDs := make(map[string]struct{}, len(oldRules))
+	for _, rule := range oldRules {
+		oldIDs[rule.ID()] = struct{}{}
+	}
+	newIDs := make(map[string]struct{}, len(newRules))
+	for _, rule := range newRules {
+		newIDs[rule.ID()] = struct{}{}
+	}
+	var differences []string
+	for _, rule := range newRules {
+		if _, ok := oldIDs[rule.ID()]; !ok {
+			differences = append(differences, fmt.Sprintf("%s: %s rule %s is now checked.", bufconfig.ExternalConfigV1FilePath, kind, rule.ID()))
+		}
+	}
+	for _, rule := range oldRules {
+		if _, ok := newIDs[rule.ID()]; !ok {
+			differences = append(differences, fmt.Sprintf("%s: %s rule %s is no longer checked.", bufconfig.ExternalConfigV1FilePath, kind, rule.ID()))
+		}
+	}
+	return differences
+}
+
+// unmarshalYAMLDocument unmarshals the data into the top-level mapping node of the
+// YAML document, retaining comments.
+func unmarshalYAMLDocument(data []byte) (*yaml.Node, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	if document.Kind != yaml.DocumentNode || len(document.Content) != 1 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("expected a YAML mapping")
+	}
+	// The document node is needed to retain the head comment of the file.
+	return &document, nil
+}
+
+// writeYAMLDocument writes the document to the path, retaining the permissions
+// of the existing file.
+func writeYAMLDocument(path string, document *yaml.Node) (retErr error) {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	buffer := bytes.NewBuffer(nil)
+	yamlEncoder := encoding.NewYAMLEncoder(buffer)
+	defer func() {
+		retErr = multierr.Append(retErr, yamlEncoder.Close())
+	}()
+	if err := yamlEncoder.Encode(document); err != nil {
+		return fmt.Errorf("failed to marshal new config: %w", err)
+	}
+	return os.WriteFile(path, buffer.Bytes(), fileInfo.Mode().Perm())
+}
+
+func getYAMLMappingValue(document *yaml.Node, key string) *yaml.Node {
+	mapping := yamlMapping(document)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func setYAMLMappingScalar(document *yaml.Node, key string, value string) {
+	if valueNode := getYAMLMappingValue(document, key); valueNode != nil && valueNode.Kind == yaml.ScalarNode {
+		valueNode.Value = value
+		valueNode.Tag = "!!str"
+		valueNode.Style = 0
+		return
+	}
+	setYAMLMappingValue(document, key, newYAMLScalarNode(value))
+}
+
+// setYAMLMappingValue sets the value of the key, adding the key to the start
+// of the mapping if it does not exist.
+func setYAMLMappingValue(document *yaml.Node, key string, valueNode *yaml.Node) {
+	mapping := yamlMapping(document)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = valueNode
+			return
+		}
+	}
+	mapping.Content = append([]*yaml.Node{newYAMLScalarNode(key), valueNode}, mapping.Content...)
+}
+
+func deleteYAMLMappingKey(document *yaml.Node, key string) bool {
+	mapping := yamlMapping(document)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func yamlMapping(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) == 1 {
+		return node.Content[0]
+	}
+	return node
+}
+
+func newYAMLScalarNode(value string) *yaml.Node {
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Value: value,
+	}
+}
@@ -157,8 +157,8 @@ func (m *v1beta1Migrator) maybeMigrateConfig(dirPath string) (bool, error) {
 			Build: bufmoduleconfig.ExternalConfigV1{
 				Excludes: excludes,
 			},
-			Breaking: bufbreakingconfig.ExternalConfigV1(v1beta1Config.Breaking),
-			Lint:     buflintconfig.ExternalConfigV1(v1beta1Config.Lint),
+			Breaking: bufbreakingconfig.ExternalConfigV1ForConfig(bufbreakingconfig.NewConfigV1Beta1(v1beta1Config.Breaking)),
+			Lint:     buflintconfig.ExternalConfigV1ForConfig(buflintconfig.NewConfigV1Beta1(v1beta1Config.Lint)),
 		}
 		newConfigPath := filepath.Join(dirPath, bufconfig.ExternalConfigV1FilePath)
 		if err := m.writeV1Config(newConfigPath, v1Config, ".", v1beta1Config.Name); err != nil {
@@ -19,9 +19,19 @@ import (
 	"fmt"
 	"io"
 	"strconv"
-
+	"time"
+
+	"github.com/bufbuild/buf/private/buf/bufgen"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufdeprecation"
+	"github.com/bufbuild/buf/private/bufpkg/buffieldnumber"
+	"github.com/bufbuild/buf/private/bufpkg/bufhttprule"
+	"github.com/bufbuild/buf/private/bufpkg/bufimport"
+	"github.com/bufbuild/buf/private/bufpkg/bufowner"
+	"github.com/bufbuild/buf/private/bufpkg/bufremotepackage"
 	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	webhookv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/webhook/v1alpha1"
 	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/buf/private/pkg/manifest"
 	"github.com/bufbuild/buf/private/pkg/protoencoding"
 	"github.com/bufbuild/buf/private/pkg/protostat"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
@@ -81,6 +91,16 @@ func NewCuratedPluginPrinter(writer io.Writer) CuratedPluginPrinter {
 	return newCuratedPluginPrinter(writer)
 }
 
+// RemotePackagePrinter is a printer for remote packages.
+type RemotePackagePrinter interface {
+	PrintRemotePackage(ctx context.Context, format Format, remotePackage bufremotepackage.RemotePackage) error
+}
+
+// NewRemotePackagePrinter returns a new RemotePackagePrinter.
+func NewRemotePackagePrinter(writer io.Writer) RemotePackagePrinter {
+	return newRemotePackagePrinter(writer)
+}
+
 // OrganizationPrinter is an organization printer.
 type OrganizationPrinter interface {
 	PrintOrganization(ctx context.Context, format Format, organization *registryv1alpha1.Organization) error
@@ -106,6 +126,66 @@ func NewRepositoryPrinter(
 	return newRepositoryPrinter(clientConfig, address, writer)
 }
 
+// UserPrinter is a user printer.
+type UserPrinter interface {
+	PrintUser(ctx context.Context, format Format, user *registryv1alpha1.User) error
+	PrintUsers(ctx context.Context, format Format, nextPageToken string, users ...*registryv1alpha1.User) error
+}
+
+// NewUserPrinter returns a new UserPrinter.
+func NewUserPrinter(address string, writer io.Writer) UserPrinter {
+	return newUserPrinter(address, writer)
+}
+
+// CurrentUserPrinter is a printer of the user that is authenticated with a remote.
+type CurrentUserPrinter interface {
+	// PrintCurrentUser prints the user along with where the token of the user
+	// is read from, as returned by bufcli.GetTokenSource.
+	PrintCurrentUser(ctx context.Context, format Format, user *registryv1alpha1.User, tokenSource string) error
+}
+
+// NewCurrentUserPrinter returns a new CurrentUserPrinter.
+func NewCurrentUserPrinter(address string, writer io.Writer) CurrentUserPrinter {
+	return newCurrentUserPrinter(address, writer)
+}
+
+// RepositoryContributorPrinter is a printer of the contributors of a repository.
+type RepositoryContributorPrinter interface {
+	// PrintRepositoryContributors prints the users with a role in the repository
+	// with the given full name, in the form owner/repository.
+	PrintRepositoryContributors(
+		ctx context.Context,
+		format Format,
+		repositoryFullName string,
+		contributors ...*registryv1alpha1.RepositoryContributor,
+	) error
+}
+
+// NewRepositoryContributorPrinter returns a new RepositoryContributorPrinter.
+func NewRepositoryContributorPrinter(address string, writer io.Writer) RepositoryContributorPrinter {
+	return newRepositoryContributorPrinter(address, writer)
+}
+
+// MachineAccountTokenPrinter is a printer of the tokens of machine accounts.
+type MachineAccountTokenPrinter interface {
+	// PrintMachineAccountToken prints the plaintext token created for the
+	// machine account, along with the IDs of the tokens that were revoked.
+	//
+	// The text format only prints the token.
+	PrintMachineAccountToken(
+		ctx context.Context,
+		format Format,
+		username string,
+		token string,
+		revokedTokenIDs []string,
+	) error
+}
+
+// NewMachineAccountTokenPrinter returns a new MachineAccountTokenPrinter.
+func NewMachineAccountTokenPrinter(address string, writer io.Writer) MachineAccountTokenPrinter {
+	return newMachineAccountTokenPrinter(address, writer)
+}
+
 // RepositoryTagPrinter is a repository tag printer.
 type RepositoryTagPrinter interface {
 	PrintRepositoryTag(ctx context.Context, format Format, repositoryTag *registryv1alpha1.RepositoryTag) error
@@ -117,6 +197,16 @@ func NewRepositoryTagPrinter(writer io.Writer) RepositoryTagPrinter {
 	return newRepositoryTagPrinter(writer)
 }
 
+// LabelPrinter is a label printer.
+type LabelPrinter interface {
+	PrintLabels(ctx context.Context, format Format, labels ...*registryv1alpha1.Label) error
+}
+
+// NewLabelPrinter returns a new LabelPrinter.
+func NewLabelPrinter(writer io.Writer) LabelPrinter {
+	return newLabelPrinter(writer)
+}
+
 // RepositoryCommitPrinter is a repository commit printer.
 type RepositoryCommitPrinter interface {
 	PrintRepositoryCommit(ctx context.Context, format Format, repositoryCommit *registryv1alpha1.RepositoryCommit) error
@@ -206,6 +296,8 @@ func NewTokenPrinter(writer io.Writer, format Format) (TokenPrinter, error) {
 // StatsPrinter is a printer of Stats.
 type StatsPrinter interface {
 	PrintStats(ctx context.Context, format Format, stats *protostat.Stats) error
+	// PrintStatsPrometheus prints the Stats as gauges in the Prometheus text exposition format.
+	PrintStatsPrometheus(ctx context.Context, stats *protostat.Stats) error
 }
 
 // NewStatsPrinter returns a new StatsPrinter.
@@ -213,6 +305,89 @@ func NewStatsPrinter(writer io.Writer) StatsPrinter {
 	return newStatsPrinter(writer)
 }
 
+// DeprecationPrinter is a printer of Deprecations.
+type DeprecationPrinter interface {
+	// PrintDeprecations prints the Deprecations, along with their status as of now.
+	PrintDeprecations(ctx context.Context, format Format, now time.Time, deprecations ...bufdeprecation.Deprecation) error
+}
+
+// NewDeprecationPrinter returns a new DeprecationPrinter.
+func NewDeprecationPrinter(writer io.Writer) DeprecationPrinter {
+	return newDeprecationPrinter(writer)
+}
+
+// FieldNumberReportPrinter is a printer of field number Reports.
+type FieldNumberReportPrinter interface {
+	PrintFieldNumberReports(ctx context.Context, format Format, reports ...buffieldnumber.Report) error
+}
+
+// NewFieldNumberReportPrinter returns a new FieldNumberReportPrinter.
+func NewFieldNumberReportPrinter(writer io.Writer) FieldNumberReportPrinter {
+	return newFieldNumberReportPrinter(writer)
+}
+
+// ImportDeclarationPrinter is a printer of public and weak import Declarations.
+type ImportDeclarationPrinter interface {
+	PrintImportDeclarations(ctx context.Context, format Format, declarations ...*bufimport.Declaration) error
+}
+
+// NewImportDeclarationPrinter returns a new ImportDeclarationPrinter.
+func NewImportDeclarationPrinter(writer io.Writer) ImportDeclarationPrinter {
+	return newImportDeclarationPrinter(writer)
+}
+
+// RoutePrinter is a printer of HTTP Routes.
+type RoutePrinter interface {
+	PrintRoutes(ctx context.Context, format Format, routes ...*bufhttprule.Route) error
+	// PrintRoutesCSV prints the Routes as CSV with a header row.
+	PrintRoutesCSV(ctx context.Context, routes ...*bufhttprule.Route) error
+}
+
+// NewRoutePrinter returns a new RoutePrinter.
+func NewRoutePrinter(writer io.Writer) RoutePrinter {
+	return newRoutePrinter(writer)
+}
+
+// OwnershipPrinter is a printer of the Ownership of a file.
+type OwnershipPrinter interface {
+	PrintOwnership(ctx context.Context, format Format, ownership *bufowner.Ownership) error
+}
+
+// NewOwnershipPrinter returns a new OwnershipPrinter.
+func NewOwnershipPrinter(writer io.Writer) OwnershipPrinter {
+	return newOwnershipPrinter(writer)
+}
+
+// RepositoryEventPrinter is a printer of RepositoryEvents.
+type RepositoryEventPrinter interface {
+	PrintRepositoryEvent(ctx context.Context, format Format, event *webhookv1alpha1.RepositoryEvent) error
+}
+
+// NewRepositoryEventPrinter returns a new RepositoryEventPrinter.
+func NewRepositoryEventPrinter(writer io.Writer) RepositoryEventPrinter {
+	return newRepositoryEventPrinter(writer)
+}
+
+// ManifestDiffPrinter is a printer of the differences between two manifests.
+type ManifestDiffPrinter interface {
+	PrintManifestDiff(ctx context.Context, format Format, pathDiffs ...manifest.PathDiff) error
+}
+
+// NewManifestDiffPrinter returns a new ManifestDiffPrinter.
+func NewManifestDiffPrinter(writer io.Writer) ManifestDiffPrinter {
+	return newManifestDiffPrinter(writer)
+}
+
+// GeneratedSizePrinter is a printer of the sizes of generated code.
+type GeneratedSizePrinter interface {
+	PrintGeneratedSizes(ctx context.Context, format Format, pluginGeneratedSizes ...*bufgen.PluginGeneratedSize) error
+}
+
+// NewGeneratedSizePrinter returns a new GeneratedSizePrinter.
+func NewGeneratedSizePrinter(writer io.Writer) GeneratedSizePrinter {
+	return newGeneratedSizePrinter(writer)
+}
+
 // TabWriter is a tab writer.
 type TabWriter interface {
 	Write(values ...string) error
@@ -0,0 +1,88 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type currentUserPrinter struct {
+	address string
+	writer  io.Writer
+}
+
+func newCurrentUserPrinter(
+	address string,
+	writer io.Writer,
+) *currentUserPrinter {
+	return &currentUserPrinter{
+		address: address,
+		writer:  writer,
+	}
+}
+
+func (p *currentUserPrinter) PrintCurrentUser(
+	ctx context.Context,
+	format Format,
+	user *registryv1alpha1.User,
+	tokenSource string,
+) error {
+	outCurrentUser := outputCurrentUser{
+		outputUser:  registryUserToOutputUser(p.address, user),
+		TokenSource: tokenSource,
+	}
+	switch format {
+	case FormatText:
+		return p.printCurrentUserText(outCurrentUser)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(outCurrentUser)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+func (p *currentUserPrinter) printCurrentUserText(outCurrentUser outputCurrentUser) error {
+	tokenSource := outCurrentUser.TokenSource
+	if tokenSource == "" {
+		tokenSource = "unknown"
+	}
+	return WithTabWriter(
+		p.writer,
+		[]string{
+			"Full name",
+			"Type",
+			"Deactivated",
+			"Token source",
+		},
+		func(tabWriter TabWriter) error {
+			return tabWriter.Write(
+				outCurrentUser.Remote+"/"+outCurrentUser.Username,
+				outCurrentUser.Type,
+				fmt.Sprintf("%t", outCurrentUser.Deactivated),
+				tokenSource,
+			)
+		},
+	)
+}
+
+type outputCurrentUser struct {
+	outputUser
+	TokenSource string `json:"token_source,omitempty"`
+}
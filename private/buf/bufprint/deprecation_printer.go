@@ -0,0 +1,123 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufdeprecation"
+)
+
+type deprecationPrinter struct {
+	writer io.Writer
+}
+
+func newDeprecationPrinter(writer io.Writer) *deprecationPrinter {
+	return &deprecationPrinter{
+		writer: writer,
+	}
+}
+
+func (p *deprecationPrinter) PrintDeprecations(
+	ctx context.Context,
+	format Format,
+	now time.Time,
+	deprecations ...bufdeprecation.Deprecation,
+) error {
+	externalDeprecations := make([]externalDeprecation, len(deprecations))
+	for i, deprecation := range deprecations {
+		externalDeprecations[i] = newExternalDeprecation(deprecation, now)
+	}
+	switch format {
+	case FormatText:
+		return WithTabWriter(
+			p.writer,
+			[]string{
+				"Name",
+				"Kind",
+				"Location",
+				"Replacement",
+				"Remove By",
+				"Status",
+			},
+			func(tabWriter TabWriter) error {
+				for _, externalDeprecation := range externalDeprecations {
+					if err := tabWriter.Write(
+						externalDeprecation.Name,
+						externalDeprecation.Kind,
+						externalDeprecation.Path+":"+strconv.Itoa(externalDeprecation.Line),
+						dashIfEmpty(externalDeprecation.Replacement),
+						dashIfEmpty(externalDeprecation.RemoveBy),
+						externalDeprecation.Status,
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(externalDeprecations)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+type externalDeprecation struct {
+	Name        string `json:"name,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Line        int    `json:"line,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+	RemoveBy    string `json:"remove_by,omitempty"`
+	Status      string `json:"status,omitempty"`
+}
+
+func newExternalDeprecation(deprecation bufdeprecation.Deprecation, now time.Time) externalDeprecation {
+	descriptor := deprecation.Descriptor()
+	externalDeprecation := externalDeprecation{
+		Name:        descriptor.FullName(),
+		Kind:        deprecation.Kind(),
+		Path:        descriptor.File().ExternalPath(),
+		Replacement: deprecation.Replacement(),
+		Status:      "ok",
+	}
+	if location := descriptor.Location(); location != nil {
+		externalDeprecation.Line = location.StartLine()
+	}
+	switch {
+	case deprecation.AnnotationErr() != nil:
+		externalDeprecation.Status = "invalid_annotation"
+	case bufdeprecation.IsPastDue(deprecation, now):
+		externalDeprecation.Status = "past_due"
+	}
+	if !deprecation.RemoveBy().IsZero() {
+		externalDeprecation.RemoveBy = deprecation.RemoveBy().Format(bufdeprecation.RemoveByLayout)
+	}
+	return externalDeprecation
+}
+
+// dashIfEmpty returns "-" if the value is empty.
+func dashIfEmpty(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
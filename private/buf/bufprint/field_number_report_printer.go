@@ -0,0 +1,137 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/buffieldnumber"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+)
+
+type fieldNumberReportPrinter struct {
+	writer io.Writer
+}
+
+func newFieldNumberReportPrinter(writer io.Writer) *fieldNumberReportPrinter {
+	return &fieldNumberReportPrinter{
+		writer: writer,
+	}
+}
+
+func (p *fieldNumberReportPrinter) PrintFieldNumberReports(
+	ctx context.Context,
+	format Format,
+	reports ...buffieldnumber.Report,
+) error {
+	externalReports := make([]externalFieldNumberReport, len(reports))
+	for i, report := range reports {
+		externalReports[i] = newExternalFieldNumberReport(report)
+	}
+	switch format {
+	case FormatText:
+		return WithTabWriter(
+			p.writer,
+			[]string{
+				"Message",
+				"Location",
+				"Fields",
+				"Highest",
+				"Reserved",
+				"Remaining 1-15",
+				"Remaining 16-2047",
+				"Suggested",
+				"Status",
+			},
+			func(tabWriter TabWriter) error {
+				for _, externalReport := range externalReports {
+					suggestedNumbers := make([]string, len(externalReport.SuggestedNumbers))
+					for i, suggestedNumber := range externalReport.SuggestedNumbers {
+						suggestedNumbers[i] = strconv.Itoa(suggestedNumber)
+					}
+					if err := tabWriter.Write(
+						externalReport.Name,
+						externalReport.Path+":"+strconv.Itoa(externalReport.Line),
+						strconv.Itoa(externalReport.Fields),
+						strconv.Itoa(externalReport.HighestNumber),
+						dashIfEmpty(strings.Join(externalReport.Reserved, ", ")),
+						strconv.Itoa(externalReport.OneByteRemaining),
+						strconv.Itoa(externalReport.TwoByteRemaining),
+						dashIfEmpty(strings.Join(suggestedNumbers, ", ")),
+						externalReport.Status,
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(externalReports)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+type externalFieldNumberReport struct {
+	Name             string   `json:"name,omitempty"`
+	Path             string   `json:"path,omitempty"`
+	Line             int      `json:"line,omitempty"`
+	Fields           int      `json:"fields"`
+	HighestNumber    int      `json:"highest_number"`
+	Reserved         []string `json:"reserved,omitempty"`
+	OneByteRemaining int      `json:"one_byte_remaining"`
+	TwoByteRemaining int      `json:"two_byte_remaining"`
+	SuggestedNumbers []int    `json:"suggested_numbers,omitempty"`
+	Status           string   `json:"status,omitempty"`
+}
+
+func newExternalFieldNumberReport(report buffieldnumber.Report) externalFieldNumberReport {
+	message := report.Message()
+	externalReport := externalFieldNumberReport{
+		Name:             message.FullName(),
+		Path:             message.File().ExternalPath(),
+		Fields:           report.FieldCount(),
+		HighestNumber:    report.HighestNumber(),
+		OneByteRemaining: report.OneByteRemaining(),
+		TwoByteRemaining: report.TwoByteRemaining(),
+		SuggestedNumbers: report.SuggestedNumbers(),
+		Status:           report.Status().String(),
+	}
+	if location := message.Location(); location != nil {
+		externalReport.Line = location.StartLine()
+	}
+	for _, reservedRange := range report.ReservedRanges() {
+		externalReport.Reserved = append(externalReport.Reserved, fieldNumberRangeString(reservedRange))
+	}
+	return externalReport
+}
+
+// fieldNumberRangeString returns the range as it is written in a reserved statement.
+func fieldNumberRangeString(messageRange protosource.MessageRange) string {
+	switch {
+	case messageRange.Start() == messageRange.End():
+		return strconv.Itoa(messageRange.Start())
+	case messageRange.Max():
+		return strconv.Itoa(messageRange.Start()) + " to max"
+	default:
+		return strconv.Itoa(messageRange.Start()) + " to " + strconv.Itoa(messageRange.End())
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/bufbuild/buf/private/buf/bufgen"
+)
+
+const (
+	// noPackageName is printed in place of the empty package name.
+	noPackageName = "<none>"
+	// totalPackageName is printed in place of the package name for the
+	// total size of the code generated by a plugin.
+	totalPackageName = "<total>"
+)
+
+type generatedSizePrinter struct {
+	writer io.Writer
+}
+
+func newGeneratedSizePrinter(writer io.Writer) *generatedSizePrinter {
+	return &generatedSizePrinter{
+		writer: writer,
+	}
+}
+
+func (p *generatedSizePrinter) PrintGeneratedSizes(
+	ctx context.Context,
+	format Format,
+	pluginGeneratedSizes ...*bufgen.PluginGeneratedSize,
+) error {
+	switch format {
+	case FormatText:
+		return WithTabWriter(
+			p.writer,
+			[]string{
+				"Plugin",
+				"Package",
+				"Files",
+				"Bytes",
+			},
+			func(tabWriter TabWriter) error {
+				for _, pluginGeneratedSize := range pluginGeneratedSizes {
+					for _, packageGeneratedSize := range pluginGeneratedSize.Packages {
+						packageName := packageGeneratedSize.Package
+						if packageName == "" {
+							packageName = noPackageName
+						}
+						if err := tabWriter.Write(
+							pluginGeneratedSize.Plugin,
+							packageName,
+							strconv.Itoa(packageGeneratedSize.NumFiles),
+							strconv.Itoa(packageGeneratedSize.NumBytes),
+						); err != nil {
+							return err
+						}
+					}
+					if err := tabWriter.Write(
+						pluginGeneratedSize.Plugin,
+						totalPackageName,
+						strconv.Itoa(pluginGeneratedSize.NumFiles),
+						strconv.Itoa(pluginGeneratedSize.NumBytes),
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+	case FormatJSON:
+		if pluginGeneratedSizes == nil {
+			pluginGeneratedSizes = []*bufgen.PluginGeneratedSize{}
+		}
+		return json.NewEncoder(p.writer).Encode(pluginGeneratedSizes)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimport"
+)
+
+type importDeclarationPrinter struct {
+	writer io.Writer
+}
+
+func newImportDeclarationPrinter(writer io.Writer) *importDeclarationPrinter {
+	return &importDeclarationPrinter{
+		writer: writer,
+	}
+}
+
+func (p *importDeclarationPrinter) PrintImportDeclarations(
+	ctx context.Context,
+	format Format,
+	declarations ...*bufimport.Declaration,
+) error {
+	externalDeclarations := make([]externalImportDeclaration, len(declarations))
+	for i, declaration := range declarations {
+		externalDeclarations[i] = newExternalImportDeclaration(declaration)
+	}
+	switch format {
+	case FormatText:
+		return WithTabWriter(
+			p.writer,
+			[]string{
+				"Location",
+				"Kind",
+				"Import",
+				"Dependents",
+			},
+			func(tabWriter TabWriter) error {
+				for _, externalDeclaration := range externalDeclarations {
+					if err := tabWriter.Write(
+						externalDeclaration.File+":"+strconv.Itoa(externalDeclaration.Line),
+						externalDeclaration.Kind,
+						externalDeclaration.Import,
+						dashIfEmpty(strings.Join(externalDeclaration.Dependents, ", ")),
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(externalDeclarations)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+type externalImportDeclaration struct {
+	File       string   `json:"file,omitempty"`
+	Line       int      `json:"line,omitempty"`
+	Kind       string   `json:"kind,omitempty"`
+	Import     string   `json:"import,omitempty"`
+	Dependents []string `json:"dependents,omitempty"`
+}
+
+func newExternalImportDeclaration(declaration *bufimport.Declaration) externalImportDeclaration {
+	externalDeclaration := externalImportDeclaration{
+		File:       declaration.File.ExternalPath(),
+		Kind:       declaration.Kind.String(),
+		Import:     declaration.FileImport.Import(),
+		Dependents: declaration.DependentFilePaths,
+	}
+	if location := declaration.FileImport.Location(); location != nil {
+		externalDeclaration.Line = location.StartLine()
+	}
+	return externalDeclaration
+}
@@ -0,0 +1,71 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type machineAccountTokenPrinter struct {
+	address string
+	writer  io.Writer
+}
+
+func newMachineAccountTokenPrinter(
+	address string,
+	writer io.Writer,
+) *machineAccountTokenPrinter {
+	return &machineAccountTokenPrinter{
+		address: address,
+		writer:  writer,
+	}
+}
+
+func (p *machineAccountTokenPrinter) PrintMachineAccountToken(
+	ctx context.Context,
+	format Format,
+	username string,
+	token string,
+	revokedTokenIDs []string,
+) error {
+	switch format {
+	case FormatText:
+		// Only the token is printed so that it can be piped into a secret store.
+		_, err := fmt.Fprintln(p.writer, token)
+		return err
+	case FormatJSON:
+		if revokedTokenIDs == nil {
+			revokedTokenIDs = []string{}
+		}
+		return json.NewEncoder(p.writer).Encode(outputMachineAccountToken{
+			Remote:          p.address,
+			Username:        username,
+			Token:           token,
+			RevokedTokenIDs: revokedTokenIDs,
+		})
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+type outputMachineAccountToken struct {
+	Remote          string   `json:"remote,omitempty"`
+	Username        string   `json:"username,omitempty"`
+	Token           string   `json:"token"`
+	RevokedTokenIDs []string `json:"revoked_token_ids"`
+}
@@ -0,0 +1,98 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/bufbuild/buf/private/pkg/manifest"
+)
+
+type manifestDiffPrinter struct {
+	writer io.Writer
+}
+
+func newManifestDiffPrinter(writer io.Writer) *manifestDiffPrinter {
+	return &manifestDiffPrinter{
+		writer: writer,
+	}
+}
+
+func (p *manifestDiffPrinter) PrintManifestDiff(
+	ctx context.Context,
+	format Format,
+	pathDiffs ...manifest.PathDiff,
+) error {
+	switch format {
+	case FormatText:
+		return WithTabWriter(
+			p.writer,
+			[]string{
+				"Path",
+				"Change",
+				"From",
+				"To",
+			},
+			func(tabWriter TabWriter) error {
+				for _, pathDiff := range pathDiffs {
+					if err := tabWriter.Write(
+						pathDiff.Path,
+						pathDiff.Type.String(),
+						digestString(pathDiff.From),
+						digestString(pathDiff.To),
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+	case FormatJSON:
+		externalPathDiffs := make([]externalPathDiff, len(pathDiffs))
+		for i, pathDiff := range pathDiffs {
+			externalPathDiffs[i] = externalPathDiff{
+				Path:   pathDiff.Path,
+				Change: pathDiff.Type.String(),
+			}
+			if pathDiff.From != nil {
+				externalPathDiffs[i].From = pathDiff.From.String()
+			}
+			if pathDiff.To != nil {
+				externalPathDiffs[i].To = pathDiff.To.String()
+			}
+		}
+		return json.NewEncoder(p.writer).Encode(externalPathDiffs)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+type externalPathDiff struct {
+	Path   string `json:"path,omitempty"`
+	Change string `json:"change,omitempty"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+}
+
+// digestString returns the string of the digest, or "-" if it is nil.
+func digestString(digest *manifest.Digest) string {
+	if digest == nil {
+		return "-"
+	}
+	return digest.String()
+}
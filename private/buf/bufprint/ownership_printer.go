@@ -0,0 +1,72 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufowner"
+)
+
+type ownershipPrinter struct {
+	writer io.Writer
+}
+
+func newOwnershipPrinter(writer io.Writer) *ownershipPrinter {
+	return &ownershipPrinter{
+		writer: writer,
+	}
+}
+
+func (p *ownershipPrinter) PrintOwnership(ctx context.Context, format Format, ownership *bufowner.Ownership) error {
+	switch format {
+	case FormatText:
+		return WithTabWriter(
+			p.writer,
+			[]string{
+				"Path",
+				"Package",
+				"Owners",
+			},
+			func(tabWriter TabWriter) error {
+				return tabWriter.Write(
+					ownership.Path,
+					dashIfEmpty(ownership.Package),
+					dashIfEmpty(strings.Join(ownership.Owners, ",")),
+				)
+			},
+		)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(
+			externalOwnership{
+				Path:    ownership.Path,
+				Package: ownership.Package,
+				Owners:  ownership.Owners,
+			},
+		)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+type externalOwnership struct {
+	Path    string   `json:"path,omitempty"`
+	Package string   `json:"package,omitempty"`
+	Owners  []string `json:"owners"`
+}
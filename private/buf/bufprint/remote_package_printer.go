@@ -0,0 +1,76 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufremotepackage"
+)
+
+type remotePackagePrinter struct {
+	writer io.Writer
+}
+
+func newRemotePackagePrinter(writer io.Writer) *remotePackagePrinter {
+	return &remotePackagePrinter{
+		writer: writer,
+	}
+}
+
+func (p *remotePackagePrinter) PrintRemotePackage(ctx context.Context, format Format, remotePackage bufremotepackage.RemotePackage) error {
+	outputRemotePackage := remotePackageToOutputRemotePackage(remotePackage)
+	switch format {
+	case FormatText:
+		return WithTabWriter(
+			p.writer,
+			[]string{
+				"Name",
+				"Version",
+				"Registry",
+			},
+			func(tabWriter TabWriter) error {
+				return tabWriter.Write(
+					outputRemotePackage.Name,
+					outputRemotePackage.Version,
+					outputRemotePackage.RegistryURL,
+				)
+			},
+		)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(outputRemotePackage)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+type outputRemotePackage struct {
+	RegistryType string `json:"registry_type,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Version      string `json:"version,omitempty"`
+	RegistryURL  string `json:"registry_url,omitempty"`
+}
+
+func remotePackageToOutputRemotePackage(remotePackage bufremotepackage.RemotePackage) outputRemotePackage {
+	return outputRemotePackage{
+		RegistryType: bufremotepackage.RegistryTypeString(remotePackage.RegistryType()),
+		Name:         remotePackage.Name(),
+		Version:      remotePackage.Version(),
+		RegistryURL:  remotePackage.RegistryURL(),
+	}
+}
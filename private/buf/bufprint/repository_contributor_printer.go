@@ -0,0 +1,133 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type repositoryContributorPrinter struct {
+	address string
+	writer  io.Writer
+}
+
+func newRepositoryContributorPrinter(
+	address string,
+	writer io.Writer,
+) *repositoryContributorPrinter {
+	return &repositoryContributorPrinter{
+		address: address,
+		writer:  writer,
+	}
+}
+
+func (p *repositoryContributorPrinter) PrintRepositoryContributors(
+	ctx context.Context,
+	format Format,
+	repositoryFullName string,
+	messages ...*registryv1alpha1.RepositoryContributor,
+) error {
+	outputContributors := make([]outputRepositoryContributor, 0, len(messages))
+	for _, contributor := range messages {
+		outputContributors = append(outputContributors, registryRepositoryContributorToOutputRepositoryContributor(p.address, contributor))
+	}
+	switch format {
+	case FormatText:
+		return p.printRepositoryContributorsText(outputContributors)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(outputRepositoryAccess{
+			Repository:   p.address + "/" + repositoryFullName,
+			Contributors: outputContributors,
+		})
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+func (p *repositoryContributorPrinter) printRepositoryContributorsText(outputContributors []outputRepositoryContributor) error {
+	if len(outputContributors) == 0 {
+		return nil
+	}
+	return WithTabWriter(
+		p.writer,
+		[]string{
+			"Full name",
+			"Type",
+			"Role",
+			"Implicit role",
+		},
+		func(tabWriter TabWriter) error {
+			for _, outputContributor := range outputContributors {
+				if err := tabWriter.Write(
+					outputContributor.Remote+"/"+outputContributor.Username,
+					outputContributor.Type,
+					dashIfEmpty(outputContributor.Role),
+					dashIfEmpty(outputContributor.ImplicitRole),
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+type outputRepositoryAccess struct {
+	Repository   string                        `json:"repository,omitempty"`
+	Contributors []outputRepositoryContributor `json:"contributors"`
+}
+
+type outputRepositoryContributor struct {
+	UserID       string `json:"user_id,omitempty"`
+	Remote       string `json:"remote,omitempty"`
+	Username     string `json:"username,omitempty"`
+	Type         string `json:"type,omitempty"`
+	Role         string `json:"role,omitempty"`
+	ImplicitRole string `json:"implicit_role,omitempty"`
+}
+
+func registryRepositoryContributorToOutputRepositoryContributor(
+	address string,
+	contributor *registryv1alpha1.RepositoryContributor,
+) outputRepositoryContributor {
+	return outputRepositoryContributor{
+		UserID:       contributor.GetUser().GetId(),
+		Remote:       address,
+		Username:     contributor.GetUser().GetUsername(),
+		Type:         userTypeToString(contributor.GetUser().GetUserType()),
+		Role:         repositoryRoleToString(contributor.ExplicitRole),
+		ImplicitRole: repositoryRoleToString(contributor.ImplicitRole),
+	}
+}
+
+// repositoryRoleToString returns the lowercase name of the repository role
+// without its prefix, for example "limited-write" for
+// REPOSITORY_ROLE_LIMITED_WRITE.
+func repositoryRoleToString(repositoryRole registryv1alpha1.RepositoryRole) string {
+	if repositoryRole == registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ReplaceAll(
+		strings.ToLower(strings.TrimPrefix(repositoryRole.String(), "REPOSITORY_ROLE_")),
+		"_",
+		"-",
+	)
+}
@@ -0,0 +1,71 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	webhookv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/webhook/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+)
+
+type repositoryEventPrinter struct {
+	writer io.Writer
+}
+
+func newRepositoryEventPrinter(writer io.Writer) *repositoryEventPrinter {
+	return &repositoryEventPrinter{
+		writer: writer,
+	}
+}
+
+func (p *repositoryEventPrinter) PrintRepositoryEvent(ctx context.Context, format Format, event *webhookv1alpha1.RepositoryEvent) error {
+	switch format {
+	case FormatText:
+		fields := []string{
+			event.EventTime.AsTime().Format(time.RFC3339),
+			event.Event.String(),
+		}
+		if repositoryPush := event.GetPayload().GetRepositoryPush(); repositoryPush != nil {
+			fields = append(
+				fields,
+				fmt.Sprintf(
+					"%s/%s:%s",
+					repositoryPush.GetRepository().GetOwnerName(),
+					repositoryPush.GetRepository().GetName(),
+					repositoryPush.GetRepositoryCommit().GetName(),
+				),
+				dashIfEmpty(repositoryPush.GetRepositoryCommit().GetAuthor()),
+			)
+		}
+		_, err := fmt.Fprintln(p.writer, strings.Join(fields, "  "))
+		return err
+	case FormatJSON:
+		// Every event is printed on its own line, so that the output can be
+		// processed while events are printed.
+		data, err := protoencoding.NewJSONMarshaler(nil).Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = p.writer.Write(append(data, '\n'))
+		return err
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
@@ -65,6 +65,14 @@ func (p *repositoryPrinter) PrintRepository(ctx context.Context, format Format,
 
 func (p *repositoryPrinter) PrintRepositories(ctx context.Context, format Format, nextPageToken string, messages ...*registryv1alpha1.Repository) error {
 	if len(messages) == 0 {
+		if format == FormatJSON && nextPageToken != "" {
+			// The page is empty, for example because all of its repositories
+			// were filtered out, but more results are available.
+			return json.NewEncoder(p.writer).Encode(paginationWrapper{
+				NextPage: nextPageToken,
+				Results:  []outputRepository{},
+			})
+		}
 		return nil
 	}
 	outputRepositories, err := p.registryRepositoriesToOutRepositories(ctx, messages...)
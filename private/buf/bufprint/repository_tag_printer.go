@@ -93,6 +93,7 @@ type outputRepositoryTag struct {
 	ID         string    `json:"id,omitempty"`
 	Name       string    `json:"name,omitempty"`
 	Commit     string    `json:"commit,omitempty"`
+	Message    string    `json:"message,omitempty"`
 	CreateTime time.Time `json:"create_time,omitempty"`
 }
 
@@ -100,6 +101,7 @@ func registryTagToOutputTag(repositoryTag *registryv1alpha1.RepositoryTag) outpu
 	return outputRepositoryTag{
 		Name:       repositoryTag.Name,
 		Commit:     repositoryTag.CommitName,
+		Message:    repositoryTag.Message,
 		CreateTime: repositoryTag.CreateTime.AsTime(),
 	}
 }
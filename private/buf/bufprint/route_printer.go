@@ -0,0 +1,143 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufhttprule"
+)
+
+// routeCSVHeader is the header row of the CSV format, which matches the JSON field names.
+var routeCSVHeader = []string{
+	"http_method",
+	"path",
+	"rpc",
+	"kind",
+	"body",
+	"response_body",
+	"file",
+	"line",
+}
+
+type routePrinter struct {
+	writer io.Writer
+}
+
+func newRoutePrinter(writer io.Writer) *routePrinter {
+	return &routePrinter{
+		writer: writer,
+	}
+}
+
+func (p *routePrinter) PrintRoutes(ctx context.Context, format Format, routes ...*bufhttprule.Route) error {
+	externalRoutes := make([]externalRoute, len(routes))
+	for i, route := range routes {
+		externalRoutes[i] = newExternalRoute(route)
+	}
+	switch format {
+	case FormatText:
+		return WithTabWriter(
+			p.writer,
+			[]string{
+				"HTTP Method",
+				"Path",
+				"RPC",
+				"Kind",
+				"Body",
+				"Response Body",
+				"Location",
+			},
+			func(tabWriter TabWriter) error {
+				for _, externalRoute := range externalRoutes {
+					if err := tabWriter.Write(
+						externalRoute.HTTPMethod,
+						externalRoute.Path,
+						externalRoute.RPC,
+						externalRoute.Kind,
+						dashIfEmpty(externalRoute.Body),
+						dashIfEmpty(externalRoute.ResponseBody),
+						externalRoute.File+":"+strconv.Itoa(externalRoute.Line),
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(externalRoutes)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+func (p *routePrinter) PrintRoutesCSV(ctx context.Context, routes ...*bufhttprule.Route) error {
+	csvWriter := csv.NewWriter(p.writer)
+	if err := csvWriter.Write(routeCSVHeader); err != nil {
+		return err
+	}
+	for _, route := range routes {
+		externalRoute := newExternalRoute(route)
+		if err := csvWriter.Write(
+			[]string{
+				externalRoute.HTTPMethod,
+				externalRoute.Path,
+				externalRoute.RPC,
+				externalRoute.Kind,
+				externalRoute.Body,
+				externalRoute.ResponseBody,
+				externalRoute.File,
+				strconv.Itoa(externalRoute.Line),
+			},
+		); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+type externalRoute struct {
+	HTTPMethod   string `json:"http_method,omitempty"`
+	Path         string `json:"path,omitempty"`
+	RPC          string `json:"rpc,omitempty"`
+	Kind         string `json:"kind,omitempty"`
+	Body         string `json:"body,omitempty"`
+	ResponseBody string `json:"response_body,omitempty"`
+	File         string `json:"file,omitempty"`
+	Line         int    `json:"line,omitempty"`
+}
+
+func newExternalRoute(route *bufhttprule.Route) externalRoute {
+	externalRoute := externalRoute{
+		HTTPMethod:   route.HTTPMethod,
+		Path:         route.Path,
+		RPC:          route.Method.FullName(),
+		Kind:         route.Kind,
+		Body:         route.Body,
+		ResponseBody: route.ResponseBody,
+		File:         route.Method.File().ExternalPath(),
+	}
+	if location := route.Method.Location(); location != nil {
+		externalRoute.Line = location.StartLine()
+	}
+	return externalRoute
+}
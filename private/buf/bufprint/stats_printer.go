@@ -50,6 +50,9 @@ func (p *statsPrinter) PrintStats(ctx context.Context, format Format, stats *pro
 				"Services",
 				"Methods",
 				"Files With Errors",
+				"Deprecated Fields",
+				"Lint Ignores",
+				"Breaking Exceptions",
 			},
 			func(tabWriter TabWriter) error {
 				return tabWriter.Write(
@@ -63,6 +66,9 @@ func (p *statsPrinter) PrintStats(ctx context.Context, format Format, stats *pro
 					strconv.Itoa(stats.NumServices),
 					strconv.Itoa(stats.NumMethods),
 					strconv.Itoa(stats.NumFilesWithSyntaxErrors),
+					strconv.Itoa(stats.NumDeprecatedFields),
+					strconv.Itoa(stats.NumLintIgnoreComments+stats.NumLintConfigIgnores),
+					strconv.Itoa(stats.NumBreakingIgnoreComments+stats.NumBreakingConfigExceptions),
 				)
 			},
 		)
@@ -72,3 +78,41 @@ func (p *statsPrinter) PrintStats(ctx context.Context, format Format, stats *pro
 		return fmt.Errorf("unknown format: %v", format)
 	}
 }
+
+func (p *statsPrinter) PrintStatsPrometheus(ctx context.Context, stats *protostat.Stats) error {
+	for _, metric := range []struct {
+		name  string
+		help  string
+		value int
+	}{
+		{"files", "Number of files.", stats.NumFiles},
+		{"packages", "Number of packages.", stats.NumPackages},
+		{"files_with_syntax_errors", "Number of files with syntax errors.", stats.NumFilesWithSyntaxErrors},
+		{"messages", "Number of messages.", stats.NumMessages},
+		{"fields", "Number of fields.", stats.NumFields},
+		{"enums", "Number of enums.", stats.NumEnums},
+		{"enum_values", "Number of enum values.", stats.NumEnumValues},
+		{"extensions", "Number of extensions.", stats.NumExtensions},
+		{"services", "Number of services.", stats.NumServices},
+		{"methods", "Number of methods.", stats.NumMethods},
+		{"deprecated_fields", "Number of fields marked as deprecated.", stats.NumDeprecatedFields},
+		{"lint_ignore_comments", "Number of buf:lint:ignore comments.", stats.NumLintIgnoreComments},
+		{"breaking_ignore_comments", "Number of buf:breaking:ignore comments.", stats.NumBreakingIgnoreComments},
+		{"lint_config_ignores", "Number of lint ignore paths in configuration.", stats.NumLintConfigIgnores},
+		{"breaking_config_exceptions", "Number of breaking except rules and ignore paths in configuration.", stats.NumBreakingConfigExceptions},
+	} {
+		name := "buf_stats_" + metric.name
+		if _, err := fmt.Fprintf(
+			p.writer,
+			"# HELP %s %s\n# TYPE %s gauge\n%s %d\n",
+			name,
+			metric.help,
+			name,
+			name,
+			metric.value,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
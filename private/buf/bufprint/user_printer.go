@@ -0,0 +1,135 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+type userPrinter struct {
+	address string
+	writer  io.Writer
+}
+
+func newUserPrinter(
+	address string,
+	writer io.Writer,
+) *userPrinter {
+	return &userPrinter{
+		address: address,
+		writer:  writer,
+	}
+}
+
+func (p *userPrinter) PrintUser(ctx context.Context, format Format, message *registryv1alpha1.User) error {
+	outUser := registryUserToOutputUser(p.address, message)
+	switch format {
+	case FormatText:
+		return p.printUsersText([]outputUser{outUser})
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(outUser)
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+func (p *userPrinter) PrintUsers(ctx context.Context, format Format, nextPageToken string, messages ...*registryv1alpha1.User) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	var outputUsers []outputUser
+	for _, user := range messages {
+		outputUser := registryUserToOutputUser(p.address, user)
+		outputUsers = append(outputUsers, outputUser)
+	}
+	switch format {
+	case FormatText:
+		return p.printUsersText(outputUsers)
+	case FormatJSON:
+		return json.NewEncoder(p.writer).Encode(paginationWrapper{
+			NextPage: nextPageToken,
+			Results:  outputUsers,
+		})
+	default:
+		return fmt.Errorf("unknown format: %v", format)
+	}
+}
+
+func (p *userPrinter) printUsersText(outputUsers []outputUser) error {
+	return WithTabWriter(
+		p.writer,
+		[]string{
+			"Full name",
+			"Type",
+			"Deactivated",
+			"Created",
+		},
+		func(tabWriter TabWriter) error {
+			for _, outputUser := range outputUsers {
+				if err := tabWriter.Write(
+					outputUser.Remote+"/"+outputUser.Username,
+					outputUser.Type,
+					fmt.Sprintf("%t", outputUser.Deactivated),
+					outputUser.CreateTime.Format(time.RFC3339),
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+type outputUser struct {
+	ID          string    `json:"id,omitempty"`
+	Remote      string    `json:"remote,omitempty"`
+	Username    string    `json:"username,omitempty"`
+	Type        string    `json:"type,omitempty"`
+	Deactivated bool      `json:"deactivated"`
+	Description string    `json:"description,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	CreateTime  time.Time `json:"create_time,omitempty"`
+	UpdateTime  time.Time `json:"update_time,omitempty"`
+}
+
+func registryUserToOutputUser(address string, user *registryv1alpha1.User) outputUser {
+	return outputUser{
+		ID:          user.Id,
+		Remote:      address,
+		Username:    user.Username,
+		Type:        userTypeToString(user.UserType),
+		Deactivated: user.Deactivated,
+		Description: user.Description,
+		URL:         user.Url,
+		CreateTime:  user.CreateTime.AsTime(),
+		UpdateTime:  user.UpdateTime.AsTime(),
+	}
+}
+
+// userTypeToString returns the lowercase name of the user type without its
+// prefix, for example "machine" for USER_TYPE_MACHINE.
+func userTypeToString(userType registryv1alpha1.UserType) string {
+	if userType == registryv1alpha1.UserType_USER_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(userType.String(), "USER_TYPE_"))
+}
@@ -0,0 +1,193 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufrpc records RPCs into archives, replays them against servers,
+// and serves mocks of services.
+package bufrpc
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/connect-go"
+	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Entry is a recorded RPC.
+//
+// Entries are written to archives as JSON, one entry per line. The request and
+// the response are the JSON representations of the messages, so that archives
+// can be reviewed and edited, and are decoded with the schema of the service
+// when they are replayed.
+type Entry struct {
+	// Method is the full name of the method, such as "acme.weather.v1.WeatherService.GetWeather".
+	Method string `json:"method,omitempty"`
+	// Request is the request message.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Response is the response message, if the RPC succeeded.
+	Response json.RawMessage `json:"response,omitempty"`
+	// Error is the error, if the RPC failed.
+	Error *EntryError `json:"error,omitempty"`
+}
+
+// EntryError is the error of a recorded RPC.
+type EntryError struct {
+	// Code is the name of the code, such as "not_found".
+	Code string `json:"code,omitempty"`
+	// Message is the message of the error.
+	Message string `json:"message,omitempty"`
+}
+
+// ArchiveWriter writes entries to an archive.
+type ArchiveWriter interface {
+	// Write writes the entry to the archive.
+	//
+	// Write is safe to call concurrently.
+	Write(entry *Entry) error
+}
+
+// NewArchiveWriter returns a new ArchiveWriter that writes to the writer.
+func NewArchiveWriter(writer io.Writer) ArchiveWriter {
+	return newArchiveWriter(writer)
+}
+
+// ReadArchive reads the entries of the archive.
+func ReadArchive(reader io.Reader) ([]*Entry, error) {
+	var entries []*Entry
+	scanner := bufio.NewScanner(reader)
+	// Messages may be larger than the default maximum line size.
+	scanner.Buffer(nil, 64*1024*1024)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		entry := &Entry{}
+		if err := json.Unmarshal([]byte(line), entry); err != nil {
+			return nil, fmt.Errorf("invalid entry on line %d: %w", lineNumber, err)
+		}
+		if entry.Method == "" {
+			return nil, fmt.Errorf("invalid entry on line %d: method is empty", lineNumber)
+		}
+		if (entry.Response == nil) == (entry.Error == nil) {
+			return nil, fmt.Errorf("invalid entry on line %d: exactly one of response and error must be set", lineNumber)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ClientOptions returns the options of the Connect client for the protocol,
+// which is one of "connect", "grpc", and "grpcweb".
+func ClientOptions(protocol string) ([]connect.ClientOption, error) {
+	switch protocol {
+	case connect.ProtocolConnect:
+		return nil, nil
+	case connect.ProtocolGRPC:
+		return []connect.ClientOption{connect.WithGRPC()}, nil
+	case connect.ProtocolGRPCWeb:
+		return []connect.ClientOption{connect.WithGRPCWeb()}, nil
+	default:
+		return nil, fmt.Errorf("unknown protocol %q, must be one of %q, %q, or %q", protocol, connect.ProtocolConnect, connect.ProtocolGRPC, connect.ProtocolGRPCWeb)
+	}
+}
+
+// NewHTTPClient returns a new HTTP client for the server at the base URL.
+//
+// If http2PriorKnowledge is true and the base URL does not use https, HTTP/2
+// is used without TLS, which the gRPC protocol requires.
+func NewHTTPClient(baseURL string, http2PriorKnowledge bool) connect.HTTPClient {
+	if http2PriorKnowledge && !strings.HasPrefix(baseURL, "https://") {
+		return &http.Client{
+			Transport: &http2.Transport{
+				AllowHTTP: true,
+				DialTLSContext: func(ctx context.Context, network string, address string, _ *tls.Config) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, network, address)
+				},
+			},
+		}
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:             http.ProxyFromEnvironment,
+			ForceAttemptHTTP2: true,
+		},
+	}
+}
+
+// ImageMethodDescriptors returns the methods of the services of the
+// non-import files of the image, which must be in the resolver.
+func ImageMethodDescriptors(image bufimage.Image, res protoencoding.Resolver) ([]protoreflect.MethodDescriptor, error) {
+	var methodDescriptors []protoreflect.MethodDescriptor
+	for _, imageFile := range image.Files() {
+		if imageFile.IsImport() {
+			continue
+		}
+		fileDescriptor, err := res.FindFileByPath(imageFile.Path())
+		if err != nil {
+			return nil, err
+		}
+		services := fileDescriptor.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				methodDescriptors = append(methodDescriptors, methods.Get(j))
+			}
+		}
+	}
+	return methodDescriptors, nil
+}
+
+// procedure returns the procedure of the method, such as
+// "/acme.weather.v1.WeatherService/GetWeather".
+func procedure(methodDescriptor protoreflect.MethodDescriptor) string {
+	return "/" + string(methodDescriptor.Parent().FullName()) + "/" + string(methodDescriptor.Name())
+}
+
+type archiveWriter struct {
+	writer io.Writer
+	lock   sync.Mutex
+}
+
+func newArchiveWriter(writer io.Writer) *archiveWriter {
+	return &archiveWriter{
+		writer: writer,
+	}
+}
+
+func (a *archiveWriter) Write(entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, err = a.writer.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,210 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/buf/private/buf/bufcurl/bufcurltesting"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	methodDescriptor, res := bufcurltesting.NewEchoMethod(t)
+	server := newTestEchoServer(t, methodDescriptor, res, "hello ")
+	archive := bytes.NewBuffer(nil)
+	recorder := httptest.NewServer(
+		NewRecorder(
+			zap.NewNop(),
+			[]protoreflect.MethodDescriptor{methodDescriptor},
+			res,
+			server.Client(),
+			server.URL,
+			nil,
+			NewArchiveWriter(archive),
+		),
+	)
+	t.Cleanup(recorder.Close)
+
+	for _, clientOptions := range [][]connect.ClientOption{
+		nil,
+		{connect.WithGRPCWeb()},
+	} {
+		client := newClient(methodDescriptor, res, recorder.Client(), recorder.URL, clientOptions)
+		request := connect.NewRequest(newTestStringValue(methodDescriptor.Input(), "foo"))
+		request.Header().Set("X-Name", "bar")
+		response, err := client.CallUnary(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, "hello foo", response.Msg.message.Get(response.Msg.message.Descriptor().Fields().ByName("value")).String())
+		assert.Equal(t, "bar", response.Header().Get("X-Name"))
+		_, err = client.CallUnary(context.Background(), connect.NewRequest(newTestStringValue(methodDescriptor.Input(), "missing")))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	}
+
+	entries, err := ReadArchive(archive)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	for i := 0; i < 4; i += 2 {
+		assert.Equal(t, "test.v1.EchoService.Echo", entries[i].Method)
+		assert.JSONEq(t, `"foo"`, string(entries[i].Request))
+		assert.JSONEq(t, `"hello foo"`, string(entries[i].Response))
+		assert.Nil(t, entries[i].Error)
+		assert.JSONEq(t, `"missing"`, string(entries[i+1].Request))
+		assert.Nil(t, entries[i+1].Response)
+		assert.Equal(t, &EntryError{Code: "not_found", Message: "missing not found"}, entries[i+1].Error)
+	}
+
+	differences, err := NewReplayer(res, server.Client(), server.URL, nil, nil).Replay(context.Background(), entries[0])
+	require.NoError(t, err)
+	assert.Empty(t, differences)
+	differences, err = NewReplayer(res, server.Client(), server.URL, nil, nil).Replay(context.Background(), entries[1])
+	require.NoError(t, err)
+	assert.Empty(t, differences)
+
+	otherServer := newTestEchoServer(t, methodDescriptor, res, "hi ")
+	otherReplayer := NewReplayer(res, otherServer.Client(), otherServer.URL, []connect.ClientOption{connect.WithGRPCWeb()}, nil)
+	differences, err = otherReplayer.Replay(context.Background(), entries[0])
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		[]*Difference{{Path: "response.value", Expected: `"hello foo"`, Actual: `"hi foo"`}},
+		differences,
+	)
+	entries[1].Error.Code = "internal"
+	differences, err = otherReplayer.Replay(context.Background(), entries[1])
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		[]*Difference{{Path: "error.code", Expected: `"internal"`, Actual: `"not_found"`}},
+		differences,
+	)
+}
+
+func TestReadArchive(t *testing.T) {
+	t.Parallel()
+	entries, err := ReadArchive(strings.NewReader(`{"method": "foo.v1.FooService.GetFoo", "request": {}, "response": {"name": "foo"}}
+
+{"method": "foo.v1.FooService.GetFoo", "request": {}, "error": {"code": "not_found"}}
+`))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, &EntryError{Code: "not_found"}, entries[1].Error)
+
+	_, err = ReadArchive(strings.NewReader(`{"method": "foo.v1.FooService.GetFoo", "request": {}}`))
+	assert.EqualError(t, err, "invalid entry on line 1: exactly one of response and error must be set")
+	_, err = ReadArchive(strings.NewReader(`{"request": {}, "response": {}}`))
+	assert.EqualError(t, err, "invalid entry on line 1: method is empty")
+	_, err = ReadArchive(strings.NewReader(`{`))
+	assert.Error(t, err)
+}
+
+func TestDiffMessages(t *testing.T) {
+	t.Parallel()
+	expected := &descriptorpb.DescriptorProto{
+		Name: proto.String("Foo"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:   proto.String("bar"),
+				Number: proto.Int32(1),
+				Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			},
+			{
+				Name:   proto.String("baz"),
+				Number: proto.Int32(2),
+			},
+		},
+		ReservedName: []string{"qux"},
+	}
+	actual := &descriptorpb.DescriptorProto{
+		Name: proto.String("Foo"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:   proto.String("bar"),
+				Number: proto.Int32(3),
+				Type:   descriptorpb.FieldDescriptorProto_TYPE_BYTES.Enum(),
+			},
+		},
+		Options: &descriptorpb.MessageOptions{
+			Deprecated: proto.Bool(true),
+		},
+	}
+	assert.Empty(t, DiffMessages("", expected.ProtoReflect(), expected.ProtoReflect()))
+	assert.Equal(
+		t,
+		[]string{
+			`field[0].number: expected 1, got 3`,
+			`field[0].type: expected "TYPE_STRING", got "TYPE_BYTES"`,
+			`field[1]: expected {"name":"baz","number":2}, got (none)`,
+			`options: expected (none), got {"deprecated":true}`,
+			`reservedName[0]: expected "qux", got (none)`,
+		},
+		differenceStrings(DiffMessages("", expected.ProtoReflect(), actual.ProtoReflect())),
+	)
+}
+
+func newTestEchoServer(
+	t *testing.T,
+	methodDescriptor protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	prefix string,
+) *httptest.Server {
+	valueField := methodDescriptor.Input().Fields().ByName("value")
+	server := httptest.NewServer(
+		connect.NewUnaryHandler(
+			procedure(methodDescriptor),
+			func(_ context.Context, request *connect.Request[dynamicMessage]) (*connect.Response[dynamicMessage], error) {
+				value := request.Msg.message.Get(valueField).String()
+				if value == "missing" {
+					return nil, connect.NewError(connect.CodeNotFound, errors.New(value+" not found"))
+				}
+				response := connect.NewResponse(newTestStringValue(methodDescriptor.Output(), prefix+value))
+				response.Header().Set("X-Name", request.Header().Get("X-Name"))
+				return response, nil
+			},
+			connect.WithCodec(newProtoCodec(methodDescriptor.Input(), res)),
+			connect.WithCodec(newJSONCodec(methodDescriptor.Input(), res)),
+		),
+	)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestStringValue(messageDescriptor protoreflect.MessageDescriptor, value string) *dynamicMessage {
+	message := dynamicpb.NewMessage(messageDescriptor)
+	message.Set(messageDescriptor.Fields().ByName("value"), protoreflect.ValueOfString(value))
+	return &dynamicMessage{message: message}
+}
+
+func differenceStrings(differences []*Difference) []string {
+	differenceStrings := make([]string, len(differences))
+	for i, difference := range differences {
+		differenceStrings[i] = difference.String()
+	}
+	return differenceStrings
+}
@@ -0,0 +1,139 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"fmt"
+
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// dynamicMessage is a message of a type that is only known at runtime.
+//
+// Connect allocates the zero value of the message type before a message is
+// unmarshaled, so the message is created by the codec, which knows the type.
+type dynamicMessage struct {
+	message *dynamicpb.Message
+	// discardedErr is the error of unmarshaling the JSON representation of the
+	// message without discarding unknown fields, if the message had unknown
+	// fields or enum values that were discarded.
+	discardedErr error
+}
+
+// protoCodec is a Connect codec for the binary encoding of dynamic messages.
+//
+// Dynamic messages are unmarshaled as the type of the messageDescriptor, which
+// is the request type for handlers and the response type for clients. Other
+// messages, such as the status details of errors, are encoded as usual.
+type protoCodec struct {
+	messageDescriptor protoreflect.MessageDescriptor
+	res               protoencoding.Resolver
+}
+
+func newProtoCodec(messageDescriptor protoreflect.MessageDescriptor, res protoencoding.Resolver) *protoCodec {
+	return &protoCodec{
+		messageDescriptor: messageDescriptor,
+		res:               res,
+	}
+}
+
+func (c *protoCodec) Name() string {
+	return "proto"
+}
+
+func (c *protoCodec) Marshal(value interface{}) ([]byte, error) {
+	if protoMessage, ok := value.(proto.Message); ok {
+		return protoencoding.NewWireMarshaler().Marshal(protoMessage)
+	}
+	message, err := toDynamicMessage(value)
+	if err != nil {
+		return nil, err
+	}
+	return protoencoding.NewWireMarshaler().Marshal(message.message)
+}
+
+func (c *protoCodec) Unmarshal(data []byte, value interface{}) error {
+	if protoMessage, ok := value.(proto.Message); ok {
+		return protoencoding.NewWireUnmarshaler(c.res).Unmarshal(data, protoMessage)
+	}
+	message, err := toDynamicMessage(value)
+	if err != nil {
+		return err
+	}
+	message.message = dynamicpb.NewMessage(c.messageDescriptor)
+	return protoencoding.NewWireUnmarshaler(c.res).Unmarshal(data, message.message)
+}
+
+// jsonCodec is a Connect codec for the JSON encoding of dynamic messages.
+type jsonCodec struct {
+	messageDescriptor protoreflect.MessageDescriptor
+	res               protoencoding.Resolver
+}
+
+func newJSONCodec(messageDescriptor protoreflect.MessageDescriptor, res protoencoding.Resolver) *jsonCodec {
+	return &jsonCodec{
+		messageDescriptor: messageDescriptor,
+		res:               res,
+	}
+}
+
+func (c *jsonCodec) Name() string {
+	return "json"
+}
+
+func (c *jsonCodec) Marshal(value interface{}) ([]byte, error) {
+	if protoMessage, ok := value.(proto.Message); ok {
+		return protoencoding.NewJSONMarshaler(c.res).Marshal(protoMessage)
+	}
+	message, err := toDynamicMessage(value)
+	if err != nil {
+		return nil, err
+	}
+	return protoencoding.NewJSONMarshaler(c.res).Marshal(message.message)
+}
+
+func (c *jsonCodec) Unmarshal(data []byte, value interface{}) error {
+	if protoMessage, ok := value.(proto.Message); ok {
+		return protoencoding.NewJSONUnmarshaler(c.res).Unmarshal(data, protoMessage)
+	}
+	message, err := toDynamicMessage(value)
+	if err != nil {
+		return err
+	}
+	message.message = dynamicpb.NewMessage(c.messageDescriptor)
+	// Unknown fields of the JSON representation cannot be kept as they are
+	// for the binary encoding, so they are discarded, and the error of a
+	// strict unmarshal is recorded for validation.
+	if err := (protojson.UnmarshalOptions{Resolver: c.res}).Unmarshal(data, message.message); err != nil {
+		message.message = dynamicpb.NewMessage(c.messageDescriptor)
+		if err := protoencoding.NewJSONUnmarshaler(c.res).Unmarshal(data, message.message); err != nil {
+			return err
+		}
+		message.discardedErr = err
+	}
+	return nil
+}
+
+func toDynamicMessage(value interface{}) (*dynamicMessage, error) {
+	message, ok := value.(*dynamicMessage)
+	if !ok {
+		return nil, fmt.Errorf("expected *dynamicMessage, got %T", value)
+	}
+	return message, nil
+}
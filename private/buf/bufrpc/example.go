@@ -0,0 +1,362 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// maxExampleDepth is the maximum depth of nested messages in examples.
+const maxExampleDepth = 8
+
+// wellKnownStringExamples are the examples of the well-known formats of the
+// protovalidate string rules.
+var wellKnownStringExamples = []struct {
+	name  protoreflect.Name
+	value string
+}{
+	{"email", "user@example.com"},
+	{"hostname", "example.com"},
+	{"ip", "192.0.2.1"},
+	{"ipv4", "192.0.2.1"},
+	{"ipv6", "2001:db8::1"},
+	{"uri", "https://example.com"},
+	{"uri_ref", "https://example.com"},
+	{"address", "example.com"},
+	{"uuid", "00000000-0000-4000-8000-000000000000"},
+	{"ip_with_prefixlen", "192.0.2.0/24"},
+	{"ipv4_with_prefixlen", "192.0.2.0/24"},
+	{"ipv6_with_prefixlen", "2001:db8::/32"},
+	{"ip_prefix", "192.0.2.0/24"},
+	{"ipv4_prefix", "192.0.2.0/24"},
+	{"ipv6_prefix", "2001:db8::/32"},
+}
+
+// NewExampleMessage returns a new example message of the type.
+//
+// Every field is set to an example value, which is derived from the name of
+// the field and from its protovalidate constraints, if the resolver contains
+// the buf.validate.field extension. Only the first field of each oneof is set,
+// and recursive and deeply nested messages are not set.
+func NewExampleMessage(messageDescriptor protoreflect.MessageDescriptor, res protoencoding.Resolver) *dynamicpb.Message {
+	builder := &exampleBuilder{
+		constraintsResolver: newConstraintsResolver(res),
+		ancestors:           make(map[protoreflect.FullName]struct{}),
+	}
+	return builder.newMessage(messageDescriptor)
+}
+
+type exampleBuilder struct {
+	constraintsResolver *constraintsResolver
+	// ancestors are the messages that are being built, to not recurse into them.
+	ancestors map[protoreflect.FullName]struct{}
+}
+
+func (b *exampleBuilder) newMessage(messageDescriptor protoreflect.MessageDescriptor) *dynamicpb.Message {
+	message := dynamicpb.NewMessage(messageDescriptor)
+	switch messageDescriptor.FullName() {
+	case "google.protobuf.Timestamp":
+		// 2023-01-01T00:00:00Z
+		message.Set(messageDescriptor.Fields().ByName("seconds"), protoreflect.ValueOfInt64(1672531200))
+		return message
+	case "google.protobuf.Duration":
+		message.Set(messageDescriptor.Fields().ByName("seconds"), protoreflect.ValueOfInt64(1))
+		return message
+	case "google.protobuf.Any", "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue",
+		"google.protobuf.FieldMask":
+		// These messages have no useful example without more information.
+		return message
+	}
+	b.ancestors[messageDescriptor.FullName()] = struct{}{}
+	defer delete(b.ancestors, messageDescriptor.FullName())
+	fields := messageDescriptor.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() && oneof.Fields().Get(0) != field {
+			continue
+		}
+		if b.isRecursive(field) {
+			continue
+		}
+		constraints := b.constraintsResolver.getFieldConstraints(field)
+		switch {
+		case field.IsList():
+			list := message.Mutable(field).List()
+			length := exampleLength(getMessageField(constraints, "repeated"), "min_items", "max_items")
+			itemConstraints := getMessageField(getMessageField(constraints, "repeated"), "items")
+			for j := 0; j < length; j++ {
+				list.Append(b.newValue(field, itemConstraints, j))
+			}
+		case field.IsMap():
+			mapValue := message.Mutable(field).Map()
+			mapRules := getMessageField(constraints, "map")
+			length := exampleLength(mapRules, "min_pairs", "max_pairs")
+			valueField := field.MapValue()
+			for j := 0; j < length; j++ {
+				key := exampleMapKey(field.MapKey(), j)
+				if key.IsValid() {
+					mapValue.Set(
+						key.MapKey(),
+						b.newValue(valueField, getMessageField(mapRules, "values"), 0),
+					)
+				}
+			}
+		default:
+			value := b.newValue(field, constraints, 0)
+			if value.IsValid() {
+				message.Set(field, value)
+			}
+		}
+	}
+	return message
+}
+
+// newValue returns an example of a singular value of the field, or of an
+// element of the list field. The index is the index of the element.
+func (b *exampleBuilder) newValue(
+	field protoreflect.FieldDescriptor,
+	constraints protoreflect.Message,
+	index int,
+) protoreflect.Value {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoreflect.ValueOfMessage(b.newMessage(field.Message()))
+	case protoreflect.BoolKind:
+		rules := getMessageField(constraints, "bool")
+		if value, ok := getField(rules, "const"); ok {
+			return value
+		}
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		return exampleEnum(field.Enum(), getMessageField(constraints, "enum"))
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(exampleString(field, getMessageField(constraints, "string"), index))
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(exampleBytes(field, getMessageField(constraints, "bytes"), index))
+	default:
+		return exampleNumber(field.Kind(), getMessageField(constraints, protoreflect.Name(field.Kind().String())), index)
+	}
+}
+
+// isRecursive returns true if the field is a message field, or a map field
+// with message values, of a message that is being built, or if the message
+// is nested too deeply.
+func (b *exampleBuilder) isRecursive(field protoreflect.FieldDescriptor) bool {
+	if field.IsMap() {
+		field = field.MapValue()
+	}
+	if field.Message() == nil {
+		return false
+	}
+	if _, ok := b.ancestors[field.Message().FullName()]; ok {
+		return true
+	}
+	return len(b.ancestors) >= maxExampleDepth
+}
+
+func exampleEnum(enumDescriptor protoreflect.EnumDescriptor, rules protoreflect.Message) protoreflect.Value {
+	if value, ok := getField(rules, "const"); ok {
+		return value
+	}
+	if in := getListField(rules, "in"); in != nil && in.Len() > 0 {
+		return in.Get(0)
+	}
+	values := enumDescriptor.Values()
+	for i := 0; i < values.Len(); i++ {
+		// The zero value is usually unspecified.
+		if values.Get(i).Number() != 0 {
+			return protoreflect.ValueOfEnum(values.Get(i).Number())
+		}
+	}
+	return protoreflect.ValueOfEnum(values.Get(0).Number())
+}
+
+func exampleString(field protoreflect.FieldDescriptor, rules protoreflect.Message, index int) string {
+	if value, ok := getField(rules, "const"); ok {
+		return value.String()
+	}
+	if in := getListField(rules, "in"); in != nil && in.Len() > 0 {
+		return in.Get(index % in.Len()).String()
+	}
+	for _, wellKnownStringExample := range wellKnownStringExamples {
+		if value, ok := getField(rules, wellKnownStringExample.name); ok && value.Bool() {
+			return wellKnownStringExample.value
+		}
+	}
+	value := string(field.Name())
+	if index > 0 {
+		value += string(rune('0' + index%10))
+	}
+	if contains, ok := getField(rules, "contains"); ok && !strings.Contains(value, contains.String()) {
+		value += contains.String()
+	}
+	if prefix, ok := getField(rules, "prefix"); ok {
+		value = prefix.String() + value
+	}
+	if suffix, ok := getField(rules, "suffix"); ok {
+		value += suffix.String()
+	}
+	minLength, maxLength := -1, -1
+	if length, ok := getField(rules, "len"); ok {
+		minLength, maxLength = int(length.Uint()), int(length.Uint())
+	}
+	if length, ok := getField(rules, "min_len"); ok {
+		minLength = int(length.Uint())
+	}
+	if length, ok := getField(rules, "max_len"); ok {
+		maxLength = int(length.Uint())
+	}
+	for utf8.RuneCountInString(value) < minLength {
+		value += "x"
+	}
+	if maxLength >= 0 && utf8.RuneCountInString(value) > maxLength {
+		value = string([]rune(value)[:maxLength])
+	}
+	return value
+}
+
+func exampleBytes(field protoreflect.FieldDescriptor, rules protoreflect.Message, index int) []byte {
+	if value, ok := getField(rules, "const"); ok {
+		return value.Bytes()
+	}
+	if in := getListField(rules, "in"); in != nil && in.Len() > 0 {
+		return in.Get(index % in.Len()).Bytes()
+	}
+	value := []byte(field.Name())
+	if prefix, ok := getField(rules, "prefix"); ok {
+		value = append(append([]byte{}, prefix.Bytes()...), value...)
+	}
+	if suffix, ok := getField(rules, "suffix"); ok {
+		value = append(value, suffix.Bytes()...)
+	}
+	minLength, maxLength := -1, -1
+	if length, ok := getField(rules, "len"); ok {
+		minLength, maxLength = int(length.Uint()), int(length.Uint())
+	}
+	if length, ok := getField(rules, "min_len"); ok {
+		minLength = int(length.Uint())
+	}
+	if length, ok := getField(rules, "max_len"); ok {
+		maxLength = int(length.Uint())
+	}
+	for len(value) < minLength {
+		value = append(value, 'x')
+	}
+	if maxLength >= 0 && len(value) > maxLength {
+		value = value[:maxLength]
+	}
+	return value
+}
+
+// exampleNumber returns an example of a number of the kind, which is 1 plus
+// the index unless the rules require another value, or an invalid value if
+// the kind is not a number.
+func exampleNumber(kind protoreflect.Kind, rules protoreflect.Message, index int) protoreflect.Value {
+	if value, ok := getField(rules, "const"); ok {
+		return value
+	}
+	if in := getListField(rules, "in"); in != nil && in.Len() > 0 {
+		return in.Get(index % in.Len())
+	}
+	isFloat := kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind
+	value := float64(1 + index)
+	lower, hasLower := getNumberField(rules, "gte")
+	lowerExclusive := false
+	if !hasLower {
+		lower, hasLower = getNumberField(rules, "gt")
+		lowerExclusive = hasLower
+	}
+	upper, hasUpper := getNumberField(rules, "lte")
+	upperExclusive := false
+	if !hasUpper {
+		upper, hasUpper = getNumberField(rules, "lt")
+		upperExclusive = hasUpper
+	}
+	if hasLower && (value < lower || (lowerExclusive && value == lower)) {
+		value = lower
+		if lowerExclusive {
+			value = nextNumber(value, 1, isFloat, upper, hasUpper)
+		}
+	}
+	if hasUpper && (value > upper || (upperExclusive && value == upper)) {
+		value = upper
+		if upperExclusive {
+			value = nextNumber(value, -1, isFloat, lower, hasLower)
+		}
+	}
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(value))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(value))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(value))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(value))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(value))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(value)
+	default:
+		return protoreflect.Value{}
+	}
+}
+
+// nextNumber returns the number after the exclusive bound in the direction.
+// For floats, this is the midpoint to the other bound if there is one.
+func nextNumber(bound float64, direction float64, isFloat bool, otherBound float64, hasOtherBound bool) float64 {
+	if isFloat && hasOtherBound {
+		return (bound + otherBound) / 2
+	}
+	return bound + direction
+}
+
+// exampleLength returns the number of elements of a list or map, which is 1
+// unless the rules require another number.
+func exampleLength(rules protoreflect.Message, minName protoreflect.Name, maxName protoreflect.Name) int {
+	length := 1
+	if minValue, ok := getField(rules, minName); ok && int(minValue.Uint()) > length {
+		length = int(minValue.Uint())
+	}
+	if maxValue, ok := getField(rules, maxName); ok && int(maxValue.Uint()) < length {
+		length = int(maxValue.Uint())
+	}
+	return length
+}
+
+// exampleMapKey returns the key of the entry of the map at the index, or an
+// invalid value if the keys of the kind are exhausted.
+func exampleMapKey(keyField protoreflect.FieldDescriptor, index int) protoreflect.Value {
+	switch keyField.Kind() {
+	case protoreflect.StringKind:
+		key := "key"
+		if index > 0 {
+			key += string(rune('0' + index%10))
+		}
+		return protoreflect.ValueOfString(key)
+	case protoreflect.BoolKind:
+		if index > 1 {
+			return protoreflect.Value{}
+		}
+		return protoreflect.ValueOfBool(index == 0)
+	default:
+		return exampleNumber(keyField.Kind(), nil, index)
+	}
+}
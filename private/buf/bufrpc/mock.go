@@ -0,0 +1,175 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/connect-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// MockOption is an option for a new mock.
+type MockOption func(*mock)
+
+// MockWithFixtures returns a new MockOption that responds with the fixtures
+// of the bucket.
+//
+// The fixture of a method is at the path of the full name of the service,
+// followed by the name of the method with the .json extension, such as
+// "acme.weather.v1.WeatherService/GetWeather.json", and contains the JSON
+// representation of the response message. Fixtures are read for each RPC,
+// so that they can be edited while the mock is served.
+func MockWithFixtures(readBucket storage.ReadBucket) MockOption {
+	return func(mock *mock) {
+		mock.fixtures = readBucket
+	}
+}
+
+// NewMock returns a new handler that serves the methods with example
+// responses, as built by NewExampleMessage, or with fixtures.
+//
+// The handler accepts the Connect, gRPC, and gRPC-Web protocols. Server
+// streaming methods respond with one message, and bidirectional streaming
+// methods respond to each request message with one message.
+func NewMock(
+	logger *zap.Logger,
+	methodDescriptors []protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	options ...MockOption,
+) http.Handler {
+	mock := &mock{
+		logger: logger,
+		res:    res,
+	}
+	for _, option := range options {
+		option(mock)
+	}
+	mux := http.NewServeMux()
+	for _, methodDescriptor := range methodDescriptors {
+		mux.Handle(procedure(methodDescriptor), mock.newHandler(methodDescriptor))
+	}
+	return mux
+}
+
+type mock struct {
+	logger   *zap.Logger
+	res      protoencoding.Resolver
+	fixtures storage.ReadBucket
+}
+
+func (m *mock) newHandler(methodDescriptor protoreflect.MethodDescriptor) http.Handler {
+	handlerOptions := []connect.HandlerOption{
+		connect.WithCodec(newProtoCodec(methodDescriptor.Input(), m.res)),
+		connect.WithCodec(newJSONCodec(methodDescriptor.Input(), m.res)),
+	}
+	procedure := procedure(methodDescriptor)
+	switch {
+	case methodDescriptor.IsStreamingClient() && methodDescriptor.IsStreamingServer():
+		return connect.NewBidiStreamHandler(
+			procedure,
+			func(ctx context.Context, stream *connect.BidiStream[dynamicMessage, dynamicMessage]) error {
+				for {
+					if _, err := stream.Receive(); err != nil {
+						if errors.Is(err, io.EOF) {
+							return nil
+						}
+						return err
+					}
+					response, err := m.newResponse(ctx, methodDescriptor)
+					if err != nil {
+						return err
+					}
+					if err := stream.Send(response); err != nil {
+						return err
+					}
+				}
+			},
+			handlerOptions...,
+		)
+	case methodDescriptor.IsStreamingClient():
+		return connect.NewClientStreamHandler(
+			procedure,
+			func(ctx context.Context, stream *connect.ClientStream[dynamicMessage]) (*connect.Response[dynamicMessage], error) {
+				for stream.Receive() {
+					// The request messages are not used.
+				}
+				if err := stream.Err(); err != nil {
+					return nil, err
+				}
+				response, err := m.newResponse(ctx, methodDescriptor)
+				if err != nil {
+					return nil, err
+				}
+				return connect.NewResponse(response), nil
+			},
+			handlerOptions...,
+		)
+	case methodDescriptor.IsStreamingServer():
+		return connect.NewServerStreamHandler(
+			procedure,
+			func(ctx context.Context, _ *connect.Request[dynamicMessage], stream *connect.ServerStream[dynamicMessage]) error {
+				response, err := m.newResponse(ctx, methodDescriptor)
+				if err != nil {
+					return err
+				}
+				return stream.Send(response)
+			},
+			handlerOptions...,
+		)
+	default:
+		return connect.NewUnaryHandler(
+			procedure,
+			func(ctx context.Context, _ *connect.Request[dynamicMessage]) (*connect.Response[dynamicMessage], error) {
+				response, err := m.newResponse(ctx, methodDescriptor)
+				if err != nil {
+					return nil, err
+				}
+				return connect.NewResponse(response), nil
+			},
+			handlerOptions...,
+		)
+	}
+}
+
+// newResponse returns the fixture of the method if there is one, and an
+// example response otherwise.
+func (m *mock) newResponse(ctx context.Context, methodDescriptor protoreflect.MethodDescriptor) (*dynamicMessage, error) {
+	if m.fixtures != nil {
+		path := string(methodDescriptor.Parent().FullName()) + "/" + string(methodDescriptor.Name()) + ".json"
+		data, err := storage.ReadPath(ctx, m.fixtures, path)
+		if err == nil {
+			message := dynamicpb.NewMessage(methodDescriptor.Output())
+			if err := protoencoding.NewJSONUnmarshaler(m.res).Unmarshal(data, message); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid fixture %s: %w", path, err))
+			}
+			m.logger.Debug("fixture", zap.String("method", string(methodDescriptor.FullName())), zap.String("path", path))
+			return &dynamicMessage{message: message}, nil
+		}
+		if !storage.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	m.logger.Debug("example", zap.String("method", string(methodDescriptor.FullName())))
+	return &dynamicMessage{message: NewExampleMessage(methodDescriptor.Output(), m.res)}, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bufbuild/buf/private/buf/bufcurl/bufcurltesting"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestNewExampleMessage(t *testing.T) {
+	t.Parallel()
+	res := newTestUserResolver(t)
+	descriptor, err := res.FindDescriptorByName("test.v1.User")
+	require.NoError(t, err)
+	message := NewExampleMessage(descriptor.(protoreflect.MessageDescriptor), res)
+	data, err := protoencoding.NewJSONMarshaler(res).Marshal(message)
+	require.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{
+			"name": "n-namexx",
+			"email": "user@example.com",
+			"age": 18,
+			"tags": ["tags", "tags1"],
+			"status": "STATUS_ACTIVE",
+			"id": "1",
+			"score": 1
+		}`,
+		string(data),
+	)
+}
+
+func TestMock(t *testing.T) {
+	t.Parallel()
+	methodDescriptor, res := bufcurltesting.NewEchoMethod(t)
+	fixtures, err := storagemem.NewReadBucket(nil)
+	require.NoError(t, err)
+	server := httptest.NewServer(NewMock(zap.NewNop(), []protoreflect.MethodDescriptor{methodDescriptor}, res, MockWithFixtures(fixtures)))
+	t.Cleanup(server.Close)
+	response, err := newClient(methodDescriptor, res, server.Client(), server.URL, []connect.ClientOption{connect.WithGRPCWeb()}).
+		CallUnary(context.Background(), connect.NewRequest(newTestStringValue(methodDescriptor.Input(), "foo")))
+	require.NoError(t, err)
+	assert.Equal(t, "value", response.Msg.message.Get(methodDescriptor.Output().Fields().ByName("value")).String())
+
+	fixtures, err = storagemem.NewReadBucket(map[string][]byte{
+		"test.v1.EchoService/Echo.json": []byte(`"fixture"`),
+	})
+	require.NoError(t, err)
+	server = httptest.NewServer(NewMock(zap.NewNop(), []protoreflect.MethodDescriptor{methodDescriptor}, res, MockWithFixtures(fixtures)))
+	t.Cleanup(server.Close)
+	response, err = newClient(methodDescriptor, res, server.Client(), server.URL, nil).
+		CallUnary(context.Background(), connect.NewRequest(newTestStringValue(methodDescriptor.Input(), "foo")))
+	require.NoError(t, err)
+	assert.Equal(t, "fixture", response.Msg.message.Get(methodDescriptor.Output().Fields().ByName("value")).String())
+}
+
+// newTestProtovalidateFileDescriptorProto returns the subset of the
+// protovalidate file that is used by the tests.
+// newTestUserResolver returns a resolver for the test.v1.User message, the
+// fields of which have protovalidate constraints.
+func newTestUserResolver(t *testing.T) protoencoding.Resolver {
+	descriptorFileDescriptorProto := protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto)
+	res, err := protoencoding.NewResolver(
+		descriptorFileDescriptorProto,
+		newTestProtovalidateFileDescriptorProto(),
+		&descriptorpb.FileDescriptorProto{
+			Name:       proto.String("test/v1/user.proto"),
+			Package:    proto.String("test.v1"),
+			Dependency: []string{"buf/validate/validate.proto"},
+			Syntax:     proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("User"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						newTestField("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", nil,
+							// string: {min_len: 8, prefix: "n-"}
+							newTestConstraints(14,
+								protowire.AppendVarint(protowire.AppendTag(nil, 2, protowire.VarintType), 8),
+								protowire.AppendString(protowire.AppendTag(nil, 7, protowire.BytesType), "n-"),
+							),
+						),
+						newTestField("email", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", nil,
+							// string: {email: true}
+							newTestConstraints(14, protowire.AppendVarint(protowire.AppendTag(nil, 12, protowire.VarintType), 1)),
+						),
+						newTestField("age", 3, descriptorpb.FieldDescriptorProto_TYPE_INT32, "", nil,
+							// int32: {gt: 17, lt: 200}
+							newTestConstraints(3,
+								protowire.AppendVarint(protowire.AppendTag(nil, 4, protowire.VarintType), 17),
+								protowire.AppendVarint(protowire.AppendTag(nil, 2, protowire.VarintType), 200),
+							),
+						),
+						newTestField("tags", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", nil,
+							// repeated: {min_items: 2}
+							newTestConstraints(18, protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 2)),
+						),
+						newTestField("status", 5, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.v1.Status", nil, nil),
+						newTestField("parent", 6, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.v1.User", nil, nil),
+						newTestField("id", 7, descriptorpb.FieldDescriptorProto_TYPE_INT64, "", proto.Int32(0), nil),
+						newTestField("handle", 8, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", proto.Int32(0), nil),
+						newTestField("score", 9, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, "", nil, nil),
+					},
+					OneofDecl: []*descriptorpb.OneofDescriptorProto{
+						{Name: proto.String("key")},
+					},
+				},
+			},
+			EnumType: []*descriptorpb.EnumDescriptorProto{
+				{
+					Name: proto.String("Status"),
+					Value: []*descriptorpb.EnumValueDescriptorProto{
+						{Name: proto.String("STATUS_UNSPECIFIED"), Number: proto.Int32(0)},
+						{Name: proto.String("STATUS_ACTIVE"), Number: proto.Int32(1)},
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	return res
+}
+
+func newTestProtovalidateFileDescriptorProto() *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("buf/validate/validate.proto"),
+		Package:    proto.String("buf.validate"),
+		Dependency: []string{"google/protobuf/descriptor.proto"},
+		Syntax:     proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("FieldConstraints"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					newTestField("int32", 3, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".buf.validate.Int32Rules", nil, nil),
+					newTestField("string", 14, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".buf.validate.StringRules", nil, nil),
+					newTestField("repeated", 18, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".buf.validate.RepeatedRules", nil, nil),
+				},
+			},
+			{
+				Name: proto.String("Int32Rules"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					newTestField("lt", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, "", nil, nil),
+					newTestField("gt", 4, descriptorpb.FieldDescriptorProto_TYPE_INT32, "", nil, nil),
+				},
+			},
+			{
+				Name: proto.String("StringRules"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					newTestField("min_len", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT64, "", nil, nil),
+					newTestField("prefix", 7, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", nil, nil),
+					newTestField("email", 12, descriptorpb.FieldDescriptorProto_TYPE_BOOL, "", nil, nil),
+				},
+			},
+			{
+				Name: proto.String("RepeatedRules"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					newTestField("min_items", 1, descriptorpb.FieldDescriptorProto_TYPE_UINT64, "", nil, nil),
+				},
+			},
+		},
+		Extension: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     proto.String("field"),
+				Number:   proto.Int32(1159),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".buf.validate.FieldConstraints"),
+				Extendee: proto.String(".google.protobuf.FieldOptions"),
+			},
+		},
+	}
+}
+
+func newTestField(
+	name string,
+	number int32,
+	fieldType descriptorpb.FieldDescriptorProto_Type,
+	typeName string,
+	oneofIndex *int32,
+	constraints []byte,
+) *descriptorpb.FieldDescriptorProto {
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:       proto.String(name),
+		Number:     proto.Int32(number),
+		Label:      descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:       fieldType.Enum(),
+		OneofIndex: oneofIndex,
+	}
+	if name == "tags" {
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	}
+	if typeName != "" {
+		field.TypeName = proto.String(typeName)
+	}
+	if constraints != nil {
+		field.Options = &descriptorpb.FieldOptions{}
+		field.Options.ProtoReflect().SetUnknown(
+			protowire.AppendBytes(protowire.AppendTag(nil, 1159, protowire.BytesType), constraints),
+		)
+	}
+	return field
+}
+
+// newTestConstraints returns the encoded buf.validate.FieldConstraints with
+// the encoded rules in the field with the number.
+func newTestConstraints(number protowire.Number, rules ...[]byte) []byte {
+	var data []byte
+	for _, rule := range rules {
+		data = append(data, rule...)
+	}
+	return protowire.AppendBytes(protowire.AppendTag(nil, number, protowire.BytesType), data)
+}
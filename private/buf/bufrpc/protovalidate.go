@@ -0,0 +1,139 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"sync"
+
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// protovalidateFieldExtensionName is the name of the extension of field
+// options that contains the protovalidate constraints of the field.
+const protovalidateFieldExtensionName = "buf.validate.field"
+
+// constraintsResolver resolves the protovalidate constraints of fields.
+//
+// The constraints are read reflectively by name, as the protovalidate types
+// are only known to the resolver.
+type constraintsResolver struct {
+	res                       protoencoding.Resolver
+	fieldConstraintsExtension protoreflect.ExtensionType
+	lock                      sync.RWMutex
+	fieldToConstraints        map[protoreflect.FullName]protoreflect.Message
+}
+
+func newConstraintsResolver(res protoencoding.Resolver) *constraintsResolver {
+	constraintsResolver := &constraintsResolver{
+		res:                res,
+		fieldToConstraints: make(map[protoreflect.FullName]protoreflect.Message),
+	}
+	if extensionType, err := res.FindExtensionByName(protovalidateFieldExtensionName); err == nil {
+		constraintsResolver.fieldConstraintsExtension = extensionType
+	}
+	return constraintsResolver
+}
+
+// getFieldConstraints returns the protovalidate constraints of the field,
+// or nil if the field has none.
+func (c *constraintsResolver) getFieldConstraints(field protoreflect.FieldDescriptor) protoreflect.Message {
+	if c.fieldConstraintsExtension == nil {
+		return nil
+	}
+	c.lock.RLock()
+	constraints, ok := c.fieldToConstraints[field.FullName()]
+	c.lock.RUnlock()
+	if ok {
+		return constraints
+	}
+	constraints = c.parseFieldConstraints(field)
+	c.lock.Lock()
+	c.fieldToConstraints[field.FullName()] = constraints
+	c.lock.Unlock()
+	return constraints
+}
+
+func (c *constraintsResolver) parseFieldConstraints(field protoreflect.FieldDescriptor) protoreflect.Message {
+	if field.Options() == nil {
+		return nil
+	}
+	// The options are parsed again, as the extension is only known to the resolver.
+	data, err := proto.Marshal(field.Options())
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+	extensionDescriptor := c.fieldConstraintsExtension.TypeDescriptor()
+	options := dynamicpb.NewMessage(extensionDescriptor.ContainingMessage())
+	if err := (proto.UnmarshalOptions{Resolver: c.res}).Unmarshal(data, options); err != nil {
+		return nil
+	}
+	if !options.Has(extensionDescriptor) {
+		return nil
+	}
+	return options.Get(extensionDescriptor).Message()
+}
+
+// getMessageField returns the value of the message field with the name, or
+// nil if the message is nil or the field is not set.
+func getMessageField(message protoreflect.Message, name protoreflect.Name) protoreflect.Message {
+	value, ok := getField(message, name)
+	if !ok {
+		return nil
+	}
+	fieldMessage, ok := value.Interface().(protoreflect.Message)
+	if !ok {
+		return nil
+	}
+	return fieldMessage
+}
+
+// getListField returns the value of the list field with the name, or nil if
+// the message is nil or the field is not set.
+func getListField(message protoreflect.Message, name protoreflect.Name) protoreflect.List {
+	value, ok := getField(message, name)
+	if !ok {
+		return nil
+	}
+	list, ok := value.Interface().(protoreflect.List)
+	if !ok {
+		return nil
+	}
+	return list
+}
+
+// getNumberField returns the value of the number field with the name.
+func getNumberField(message protoreflect.Message, name protoreflect.Name) (float64, bool) {
+	value, ok := getField(message, name)
+	if !ok {
+		return 0, false
+	}
+	return valueToFloat(value)
+}
+
+// getField returns the value of the field with the name, and false if the
+// message is nil, has no such field, or the field is not set.
+func getField(message protoreflect.Message, name protoreflect.Name) (protoreflect.Value, bool) {
+	if message == nil {
+		return protoreflect.Value{}, false
+	}
+	field := message.Descriptor().Fields().ByName(name)
+	if field == nil || !message.Has(field) {
+		return protoreflect.Value{}, false
+	}
+	return message.Get(field), true
+}
@@ -0,0 +1,345 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/connect-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ProxyOption is an option for a new proxy.
+type ProxyOption func(*proxy)
+
+// ProxyWithRejectInvalidRequests returns a new ProxyOption that rejects the
+// requests that do not conform to the schema with the invalid_argument code,
+// instead of forwarding them.
+func ProxyWithRejectInvalidRequests() ProxyOption {
+	return func(proxy *proxy) {
+		proxy.rejectInvalidRequests = true
+	}
+}
+
+// ProxyWithViolationFunc returns a new ProxyOption that calls the function
+// with the violations of each request or response message that does not
+// conform to the schema.
+//
+// The function may be called concurrently.
+func ProxyWithViolationFunc(
+	violationFunc func(methodDescriptor protoreflect.MethodDescriptor, isResponse bool, violations []*Violation),
+) ProxyOption {
+	return func(proxy *proxy) {
+		proxy.violationFunc = violationFunc
+	}
+}
+
+// NewProxy returns a new handler that forwards the RPCs of the methods to the
+// server at the base URL, and validates the request and response messages
+// against the schema.
+//
+// A message does not conform to the schema if it has unknown fields, which
+// usually means that the client or server uses a different version of the
+// schema, or if it does not satisfy the standard protovalidate constraints of
+// its fields. CEL expressions are not evaluated. Violations are logged as
+// warnings.
+//
+// The handler accepts the Connect, gRPC, and gRPC-Web protocols, and
+// the client options determine the protocol used for the server. Request and
+// response headers are forwarded, except for the headers of the protocols.
+// Unknown fields of the binary encoding are forwarded, but unknown fields of
+// the JSON encoding are discarded.
+func NewProxy(
+	logger *zap.Logger,
+	methodDescriptors []protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	httpClient connect.HTTPClient,
+	baseURL string,
+	clientOptions []connect.ClientOption,
+	options ...ProxyOption,
+) http.Handler {
+	proxy := &proxy{
+		logger:    logger,
+		res:       res,
+		validator: newValidator(res),
+	}
+	for _, option := range options {
+		option(proxy)
+	}
+	mux := http.NewServeMux()
+	for _, methodDescriptor := range methodDescriptors {
+		mux.Handle(
+			procedure(methodDescriptor),
+			proxy.newHandler(methodDescriptor, newClient(methodDescriptor, res, httpClient, baseURL, clientOptions)),
+		)
+	}
+	return mux
+}
+
+type proxy struct {
+	logger                *zap.Logger
+	res                   protoencoding.Resolver
+	validator             *validator
+	rejectInvalidRequests bool
+	violationFunc         func(protoreflect.MethodDescriptor, bool, []*Violation)
+}
+
+func (p *proxy) newHandler(
+	methodDescriptor protoreflect.MethodDescriptor,
+	client *connect.Client[dynamicMessage, dynamicMessage],
+) http.Handler {
+	handlerOptions := []connect.HandlerOption{
+		connect.WithCodec(newProtoCodec(methodDescriptor.Input(), p.res)),
+		connect.WithCodec(newJSONCodec(methodDescriptor.Input(), p.res)),
+	}
+	procedure := procedure(methodDescriptor)
+	switch {
+	case methodDescriptor.IsStreamingClient() && methodDescriptor.IsStreamingServer():
+		return connect.NewBidiStreamHandler(
+			procedure,
+			func(ctx context.Context, stream *connect.BidiStream[dynamicMessage, dynamicMessage]) error {
+				return p.forwardBidiStream(ctx, methodDescriptor, client, stream)
+			},
+			handlerOptions...,
+		)
+	case methodDescriptor.IsStreamingClient():
+		return connect.NewClientStreamHandler(
+			procedure,
+			func(ctx context.Context, stream *connect.ClientStream[dynamicMessage]) (*connect.Response[dynamicMessage], error) {
+				ctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				upstream := client.CallClientStream(ctx)
+				copyHeaders(upstream.RequestHeader(), stream.RequestHeader())
+				for stream.Receive() {
+					if err := p.checkRequest(methodDescriptor, stream.Msg()); err != nil {
+						return nil, err
+					}
+					if err := upstream.Send(stream.Msg()); err != nil {
+						if errors.Is(err, io.EOF) {
+							// The server closed the stream, and CloseAndReceive
+							// returns its error.
+							break
+						}
+						return nil, err
+					}
+				}
+				if err := stream.Err(); err != nil {
+					return nil, err
+				}
+				upstreamResponse, err := upstream.CloseAndReceive()
+				if err != nil {
+					return nil, err
+				}
+				return p.newResponse(methodDescriptor, upstreamResponse), nil
+			},
+			handlerOptions...,
+		)
+	case methodDescriptor.IsStreamingServer():
+		return connect.NewServerStreamHandler(
+			procedure,
+			func(ctx context.Context, request *connect.Request[dynamicMessage], stream *connect.ServerStream[dynamicMessage]) error {
+				if err := p.checkRequest(methodDescriptor, request.Msg); err != nil {
+					return err
+				}
+				upstreamRequest := connect.NewRequest(request.Msg)
+				copyHeaders(upstreamRequest.Header(), request.Header())
+				upstream, err := client.CallServerStream(ctx, upstreamRequest)
+				if err != nil {
+					return err
+				}
+				defer upstream.Close()
+				ok := upstream.Receive()
+				copyHeaders(stream.ResponseHeader(), upstream.ResponseHeader())
+				for ; ok; ok = upstream.Receive() {
+					p.checkResponse(methodDescriptor, upstream.Msg())
+					if err := stream.Send(upstream.Msg()); err != nil {
+						return err
+					}
+				}
+				if err := upstream.Err(); err != nil {
+					return err
+				}
+				copyHeaders(stream.ResponseTrailer(), upstream.ResponseTrailer())
+				return nil
+			},
+			handlerOptions...,
+		)
+	default:
+		return connect.NewUnaryHandler(
+			procedure,
+			func(ctx context.Context, request *connect.Request[dynamicMessage]) (*connect.Response[dynamicMessage], error) {
+				if err := p.checkRequest(methodDescriptor, request.Msg); err != nil {
+					return nil, err
+				}
+				upstreamRequest := connect.NewRequest(request.Msg)
+				copyHeaders(upstreamRequest.Header(), request.Header())
+				upstreamResponse, err := client.CallUnary(ctx, upstreamRequest)
+				if err != nil {
+					return nil, err
+				}
+				return p.newResponse(methodDescriptor, upstreamResponse), nil
+			},
+			handlerOptions...,
+		)
+	}
+}
+
+// forwardBidiStream forwards the request messages of the stream to the
+// server in a separate goroutine, and the response messages of the server
+// to the stream.
+func (p *proxy) forwardBidiStream(
+	ctx context.Context,
+	methodDescriptor protoreflect.MethodDescriptor,
+	client *connect.Client[dynamicMessage, dynamicMessage],
+	stream *connect.BidiStream[dynamicMessage, dynamicMessage],
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	upstream := client.CallBidiStream(ctx)
+	copyHeaders(upstream.RequestHeader(), stream.RequestHeader())
+	requestErrs := make(chan error, 1)
+	go func() {
+		err := p.forwardRequests(methodDescriptor, stream, upstream)
+		if err != nil {
+			// Stops the receiving of responses below.
+			cancel()
+		}
+		requestErrs <- err
+	}()
+	for i := 0; ; i++ {
+		message, err := upstream.Receive()
+		if i == 0 {
+			copyHeaders(stream.ResponseHeader(), upstream.ResponseHeader())
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			select {
+			case requestErr := <-requestErrs:
+				if requestErr != nil {
+					return requestErr
+				}
+			default:
+			}
+			return err
+		}
+		p.checkResponse(methodDescriptor, message)
+		if err := stream.Send(message); err != nil {
+			return err
+		}
+	}
+	if err := upstream.CloseResponse(); err != nil {
+		return err
+	}
+	copyHeaders(stream.ResponseTrailer(), upstream.ResponseTrailer())
+	return nil
+}
+
+func (p *proxy) forwardRequests(
+	methodDescriptor protoreflect.MethodDescriptor,
+	stream *connect.BidiStream[dynamicMessage, dynamicMessage],
+	upstream *connect.BidiStreamForClient[dynamicMessage, dynamicMessage],
+) error {
+	for {
+		message, err := stream.Receive()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return upstream.CloseRequest()
+			}
+			return err
+		}
+		if err := p.checkRequest(methodDescriptor, message); err != nil {
+			return err
+		}
+		if err := upstream.Send(message); err != nil {
+			if errors.Is(err, io.EOF) {
+				// The server closed the stream, and Receive returns its error.
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func (p *proxy) newResponse(
+	methodDescriptor protoreflect.MethodDescriptor,
+	upstreamResponse *connect.Response[dynamicMessage],
+) *connect.Response[dynamicMessage] {
+	p.checkResponse(methodDescriptor, upstreamResponse.Msg)
+	response := connect.NewResponse(upstreamResponse.Msg)
+	copyHeaders(response.Header(), upstreamResponse.Header())
+	copyHeaders(response.Trailer(), upstreamResponse.Trailer())
+	return response
+}
+
+// checkRequest validates the request message, and returns an error if the
+// message is invalid and invalid requests are rejected.
+func (p *proxy) checkRequest(methodDescriptor protoreflect.MethodDescriptor, message *dynamicMessage) error {
+	violations := p.check(methodDescriptor, false, message)
+	if len(violations) == 0 || !p.rejectInvalidRequests {
+		return nil
+	}
+	return connect.NewError(
+		connect.CodeInvalidArgument,
+		fmt.Errorf("request does not conform to the schema: %s", strings.Join(violationStrings(violations), "; ")),
+	)
+}
+
+func (p *proxy) checkResponse(methodDescriptor protoreflect.MethodDescriptor, message *dynamicMessage) {
+	p.check(methodDescriptor, true, message)
+}
+
+func (p *proxy) check(methodDescriptor protoreflect.MethodDescriptor, isResponse bool, message *dynamicMessage) []*Violation {
+	var violations []*Violation
+	if message.discardedErr != nil {
+		violations = append(violations, &Violation{
+			Description: strings.TrimPrefix(message.discardedErr.Error(), "proto: "),
+		})
+	}
+	violations = append(violations, p.validator.validate(message.message)...)
+	if len(violations) == 0 {
+		return nil
+	}
+	messageType := "request"
+	if isResponse {
+		messageType = "response"
+	}
+	p.logger.Warn(
+		"schema violation",
+		zap.String("method", string(methodDescriptor.FullName())),
+		zap.String("message", messageType),
+		zap.Strings("violations", violationStrings(violations)),
+	)
+	if p.violationFunc != nil {
+		p.violationFunc(methodDescriptor, isResponse, violations)
+	}
+	return violations
+}
+
+func violationStrings(violations []*Violation) []string {
+	violationStrings := make([]string, len(violations))
+	for i, violation := range violations {
+		violationStrings[i] = violation.String()
+	}
+	return violationStrings
+}
@@ -0,0 +1,236 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/bufbuild/buf/private/buf/bufcurl"
+	"github.com/bufbuild/buf/private/buf/bufcurl/bufcurltesting"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProxy(t *testing.T) {
+	t.Parallel()
+	methodDescriptor, res := bufcurltesting.NewEchoMethod(t)
+	server := newTestEchoServer(t, methodDescriptor, res, "hello ")
+	var lock sync.Mutex
+	var violations []string
+	proxy := httptest.NewServer(
+		NewProxy(
+			zap.NewNop(),
+			[]protoreflect.MethodDescriptor{methodDescriptor},
+			res,
+			server.Client(),
+			server.URL,
+			nil,
+			ProxyWithViolationFunc(func(_ protoreflect.MethodDescriptor, isResponse bool, messageViolations []*Violation) {
+				lock.Lock()
+				defer lock.Unlock()
+				assert.False(t, isResponse)
+				violations = append(violations, violationStrings(messageViolations)...)
+			}),
+		),
+	)
+	t.Cleanup(proxy.Close)
+	rejectingProxy := httptest.NewServer(
+		NewProxy(
+			zap.NewNop(),
+			[]protoreflect.MethodDescriptor{methodDescriptor},
+			res,
+			server.Client(),
+			server.URL,
+			nil,
+			ProxyWithRejectInvalidRequests(),
+		),
+	)
+	t.Cleanup(rejectingProxy.Close)
+
+	request := newTestStringValue(methodDescriptor.Input(), "foo")
+	request.message.SetUnknown(protowire.AppendVarint(protowire.AppendTag(nil, 2, protowire.VarintType), 1))
+	client := newClient(methodDescriptor, res, proxy.Client(), proxy.URL, []connect.ClientOption{connect.WithGRPCWeb()})
+	response, err := client.CallUnary(context.Background(), connect.NewRequest(request))
+	require.NoError(t, err)
+	assert.Equal(t, "hello foo", response.Msg.message.Get(response.Msg.message.Descriptor().Fields().ByName("value")).String())
+	_, err = client.CallUnary(context.Background(), connect.NewRequest(newTestStringValue(methodDescriptor.Input(), "bar")))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unknown fields 2"}, violations)
+
+	rejectingClient := newClient(methodDescriptor, res, rejectingProxy.Client(), rejectingProxy.URL, nil)
+	_, err = rejectingClient.CallUnary(context.Background(), connect.NewRequest(request))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Contains(t, errorMessage(err), "unknown fields 2")
+	_, err = rejectingClient.CallUnary(context.Background(), connect.NewRequest(newTestStringValue(methodDescriptor.Input(), "bar")))
+	assert.NoError(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	res := newTestUserResolver(t)
+	descriptor, err := res.FindDescriptorByName("test.v1.User")
+	require.NoError(t, err)
+	messageDescriptor := descriptor.(protoreflect.MessageDescriptor)
+	validator := newValidator(res)
+
+	message := &dynamicMessage{}
+	require.NoError(
+		t,
+		newJSONCodec(messageDescriptor, res).Unmarshal(
+			[]byte(`{"name": "n-name-ok", "email": "user@example.com", "age": 30, "tags": ["a", "b"]}`),
+			message,
+		),
+	)
+	assert.NoError(t, message.discardedErr)
+	assert.Empty(t, validator.validate(message.message))
+
+	message = &dynamicMessage{}
+	require.NoError(
+		t,
+		newJSONCodec(messageDescriptor, res).Unmarshal(
+			[]byte(`{"name": "x", "email": "user", "age": 17, "tags": ["a"], "parent": {"age": 300}, "nickname": "foo"}`),
+			message,
+		),
+	)
+	assert.ErrorContains(t, message.discardedErr, "nickname")
+	message.message.Mutable(messageDescriptor.Fields().ByName("parent")).Message().SetUnknown(
+		protowire.AppendVarint(protowire.AppendTag(nil, 100, protowire.VarintType), 1),
+	)
+	assert.Equal(
+		t,
+		[]string{
+			"name: value length must be at least 8 characters",
+			`name: value does not have prefix "n-"`,
+			"email: value must be a valid email address",
+			"age: value must be greater than 17 and less than 200",
+			"tags: value must contain at least 2 items",
+			"parent: unknown fields 100",
+			"parent.name: value length must be at least 8 characters",
+			`parent.name: value does not have prefix "n-"`,
+			"parent.email: value must be a valid email address",
+			"parent.age: value must be greater than 17 and less than 200",
+			"parent.tags: value must contain at least 2 items",
+		},
+		violationStrings(validator.validate(message.message)),
+	)
+}
+
+func TestProxyBidiStream(t *testing.T) {
+	t.Parallel()
+	wrappersFileDescriptorProto := protodesc.ToFileDescriptorProto(wrapperspb.File_google_protobuf_wrappers_proto)
+	res, err := protoencoding.NewResolver(
+		wrappersFileDescriptorProto,
+		&descriptorpb.FileDescriptorProto{
+			Name:       proto.String("test/v1/chat.proto"),
+			Package:    proto.String("test.v1"),
+			Dependency: []string{wrappersFileDescriptorProto.GetName()},
+			Syntax:     proto.String("proto3"),
+			Service: []*descriptorpb.ServiceDescriptorProto{
+				{
+					Name: proto.String("ChatService"),
+					Method: []*descriptorpb.MethodDescriptorProto{
+						{
+							Name:            proto.String("Chat"),
+							InputType:       proto.String(".google.protobuf.StringValue"),
+							OutputType:      proto.String(".google.protobuf.StringValue"),
+							ClientStreaming: proto.Bool(true),
+							ServerStreaming: proto.Bool(true),
+						},
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	methodDescriptor, err := bufcurl.ResolveMethodDescriptor(res, "test.v1.ChatService", "Chat")
+	require.NoError(t, err)
+	valueField := methodDescriptor.Input().Fields().ByName("value")
+	server := httptest.NewUnstartedServer(
+		connect.NewBidiStreamHandler(
+			procedure(methodDescriptor),
+			func(_ context.Context, stream *connect.BidiStream[dynamicMessage, dynamicMessage]) error {
+				for {
+					request, err := stream.Receive()
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+					response := newTestStringValue(methodDescriptor.Output(), "hello "+request.message.Get(valueField).String())
+					response.message.SetUnknown(protowire.AppendVarint(protowire.AppendTag(nil, 3, protowire.VarintType), 1))
+					if err := stream.Send(response); err != nil {
+						return err
+					}
+				}
+			},
+			connect.WithCodec(newProtoCodec(methodDescriptor.Input(), res)),
+		),
+	)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	var lock sync.Mutex
+	var responseViolations int
+	proxy := httptest.NewUnstartedServer(
+		NewProxy(
+			zap.NewNop(),
+			[]protoreflect.MethodDescriptor{methodDescriptor},
+			res,
+			server.Client(),
+			server.URL,
+			nil,
+			ProxyWithViolationFunc(func(_ protoreflect.MethodDescriptor, isResponse bool, _ []*Violation) {
+				lock.Lock()
+				defer lock.Unlock()
+				if isResponse {
+					responseViolations++
+				}
+			}),
+		),
+	)
+	proxy.EnableHTTP2 = true
+	proxy.StartTLS()
+	t.Cleanup(proxy.Close)
+
+	stream := newClient(methodDescriptor, res, proxy.Client(), proxy.URL, nil).CallBidiStream(context.Background())
+	for _, name := range []string{"foo", "bar"} {
+		require.NoError(t, stream.Send(newTestStringValue(methodDescriptor.Input(), name)))
+		response, err := stream.Receive()
+		require.NoError(t, err)
+		assert.Equal(t, "hello "+name, response.message.Get(valueField).String())
+	}
+	require.NoError(t, stream.CloseRequest())
+	_, err = stream.Receive()
+	assert.ErrorIs(t, err, io.EOF)
+	require.NoError(t, stream.CloseResponse())
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, 2, responseViolations)
+}
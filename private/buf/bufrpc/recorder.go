@@ -0,0 +1,175 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcurl"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/connect-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NewRecorder returns a new handler that proxies the RPCs of the unary
+// methods to the server at the base URL, and writes each RPC to the archive.
+//
+// The handler accepts the Connect, gRPC, and gRPC-Web protocols, and
+// the client options determine the protocol used for the server. Streaming
+// methods are not recorded, and requests for them are rejected as not found.
+//
+// Request and response headers are forwarded, except for the headers of the
+// protocols, but are not recorded, as they may contain credentials.
+func NewRecorder(
+	logger *zap.Logger,
+	methodDescriptors []protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	httpClient connect.HTTPClient,
+	baseURL string,
+	clientOptions []connect.ClientOption,
+	archiveWriter ArchiveWriter,
+) http.Handler {
+	mux := http.NewServeMux()
+	for _, methodDescriptor := range methodDescriptors {
+		if methodDescriptor.IsStreamingClient() || methodDescriptor.IsStreamingServer() {
+			continue
+		}
+		mux.Handle(
+			procedure(methodDescriptor),
+			newRecordingHandler(logger, methodDescriptor, res, httpClient, baseURL, clientOptions, archiveWriter),
+		)
+	}
+	return mux
+}
+
+func newRecordingHandler(
+	logger *zap.Logger,
+	methodDescriptor protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	httpClient connect.HTTPClient,
+	baseURL string,
+	clientOptions []connect.ClientOption,
+	archiveWriter ArchiveWriter,
+) http.Handler {
+	client := newClient(methodDescriptor, res, httpClient, baseURL, clientOptions)
+	return connect.NewUnaryHandler(
+		procedure(methodDescriptor),
+		func(ctx context.Context, request *connect.Request[dynamicMessage]) (*connect.Response[dynamicMessage], error) {
+			upstreamRequest := connect.NewRequest(request.Msg)
+			copyHeaders(upstreamRequest.Header(), request.Header())
+			upstreamResponse, err := client.CallUnary(ctx, upstreamRequest)
+			entry, entryErr := newEntry(methodDescriptor, res, request.Msg, upstreamResponse, err)
+			if entryErr != nil {
+				return nil, entryErr
+			}
+			if err := archiveWriter.Write(entry); err != nil {
+				return nil, err
+			}
+			code := "ok"
+			if entry.Error != nil {
+				code = entry.Error.Code
+			}
+			logger.Info("recorded", zap.String("method", entry.Method), zap.String("code", code))
+			if err != nil {
+				return nil, err
+			}
+			response := connect.NewResponse(upstreamResponse.Msg)
+			copyHeaders(response.Header(), upstreamResponse.Header())
+			copyHeaders(response.Trailer(), upstreamResponse.Trailer())
+			return response, nil
+		},
+		connect.WithCodec(newProtoCodec(methodDescriptor.Input(), res)),
+		connect.WithCodec(newJSONCodec(methodDescriptor.Input(), res)),
+	)
+}
+
+func newClient(
+	methodDescriptor protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	httpClient connect.HTTPClient,
+	baseURL string,
+	clientOptions []connect.ClientOption,
+) *connect.Client[dynamicMessage, dynamicMessage] {
+	options := make([]connect.ClientOption, 0, len(clientOptions)+1)
+	options = append(options, clientOptions...)
+	options = append(options, connect.WithCodec(newProtoCodec(methodDescriptor.Output(), res)))
+	return connect.NewClient[dynamicMessage, dynamicMessage](
+		httpClient,
+		strings.TrimSuffix(baseURL, "/")+procedure(methodDescriptor),
+		options...,
+	)
+}
+
+func newEntry(
+	methodDescriptor protoreflect.MethodDescriptor,
+	res protoencoding.Resolver,
+	request *dynamicMessage,
+	response *connect.Response[dynamicMessage],
+	err error,
+) (*Entry, error) {
+	requestData, marshalErr := protoencoding.NewJSONMarshaler(res).Marshal(request.message)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	entry := &Entry{
+		Method:  string(methodDescriptor.FullName()),
+		Request: requestData,
+	}
+	if err != nil {
+		entry.Error = &EntryError{
+			Code:    bufcurl.CodeString(connect.CodeOf(err)),
+			Message: errorMessage(err),
+		}
+		return entry, nil
+	}
+	entry.Response, err = protoencoding.NewJSONMarshaler(res).Marshal(response.Msg.message)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func errorMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
+	}
+	return err.Error()
+}
+
+// copyHeaders adds the headers of source to destination, except for the
+// headers of the protocols, which are set by Connect.
+func copyHeaders(destination http.Header, source http.Header) {
+	for key, values := range source {
+		if isProtocolHeader(key) {
+			continue
+		}
+		destination[key] = append(destination[key], values...)
+	}
+}
+
+func isProtocolHeader(key string) bool {
+	key = strings.ToLower(key)
+	switch key {
+	case "accept-encoding", "connection", "content-encoding", "content-length", "content-type",
+		"date", "host", "keep-alive", "te", "trailer", "transfer-encoding", "upgrade", "user-agent":
+		return true
+	}
+	return strings.HasPrefix(key, "connect-") || strings.HasPrefix(key, "grpc-") || strings.HasPrefix(key, "trailer-")
+}
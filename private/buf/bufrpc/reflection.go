@@ -0,0 +1,235 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	reflectionv1 "github.com/bufbuild/buf/private/gen/proto/go/grpc/reflection/v1"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// reflectionServiceNames are the names of the versions of the gRPC server
+// reflection service, which have the same messages.
+var reflectionServiceNames = []string{
+	"grpc.reflection.v1.ServerReflection",
+	"grpc.reflection.v1alpha.ServerReflection",
+}
+
+// NewReflectionHandler returns a new handler that serves the gRPC server
+// reflection API, versions v1 and v1alpha, for the files of the image.
+//
+// The services of the non-import files of the image are listed.
+func NewReflectionHandler(image bufimage.Image) (http.Handler, error) {
+	reflector, err := newReflector(image)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	for _, reflectionServiceName := range reflectionServiceNames {
+		procedure := "/" + reflectionServiceName + "/ServerReflectionInfo"
+		mux.Handle(procedure, connect.NewBidiStreamHandler(procedure, reflector.serverReflectionInfo))
+	}
+	return mux, nil
+}
+
+type reflector struct {
+	files *protoregistry.Files
+	// fileData are the encoded file descriptors by path.
+	fileData map[string][]byte
+	// extensions are the files of the extensions by extended message and number.
+	extensions   map[protoreflect.FullName]map[protoreflect.FieldNumber]protoreflect.FileDescriptor
+	serviceNames []string
+}
+
+func newReflector(image bufimage.Image) (*reflector, error) {
+	files, err := protodesc.NewFiles(bufimage.ImageToFileDescriptorSet(image))
+	if err != nil {
+		return nil, err
+	}
+	reflector := &reflector{
+		files:      files,
+		fileData:   make(map[string][]byte),
+		extensions: make(map[protoreflect.FullName]map[protoreflect.FieldNumber]protoreflect.FileDescriptor),
+	}
+	for _, imageFile := range image.Files() {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(imageFile.Proto())
+		if err != nil {
+			return nil, err
+		}
+		reflector.fileData[imageFile.Path()] = data
+		fileDescriptor, err := files.FindFileByPath(imageFile.Path())
+		if err != nil {
+			return nil, err
+		}
+		reflector.addExtensions(fileDescriptor, fileDescriptor.Extensions(), fileDescriptor.Messages())
+		if imageFile.IsImport() {
+			continue
+		}
+		services := fileDescriptor.Services()
+		for i := 0; i < services.Len(); i++ {
+			reflector.serviceNames = append(reflector.serviceNames, string(services.Get(i).FullName()))
+		}
+	}
+	sort.Strings(reflector.serviceNames)
+	return reflector, nil
+}
+
+func (r *reflector) addExtensions(
+	fileDescriptor protoreflect.FileDescriptor,
+	extensions protoreflect.ExtensionDescriptors,
+	messages protoreflect.MessageDescriptors,
+) {
+	for i := 0; i < extensions.Len(); i++ {
+		extension := extensions.Get(i)
+		extendee := extension.ContainingMessage().FullName()
+		if r.extensions[extendee] == nil {
+			r.extensions[extendee] = make(map[protoreflect.FieldNumber]protoreflect.FileDescriptor)
+		}
+		r.extensions[extendee][extension.Number()] = fileDescriptor
+	}
+	for i := 0; i < messages.Len(); i++ {
+		r.addExtensions(fileDescriptor, messages.Get(i).Extensions(), messages.Get(i).Messages())
+	}
+}
+
+func (r *reflector) serverReflectionInfo(
+	_ context.Context,
+	stream *connect.BidiStream[reflectionv1.ServerReflectionRequest, reflectionv1.ServerReflectionResponse],
+) error {
+	// Files that were sent on the stream are not sent again as dependencies.
+	sentFilePaths := make(map[string]struct{})
+	for {
+		request, err := stream.Receive()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		response := &reflectionv1.ServerReflectionResponse{
+			ValidHost:       request.GetHost(),
+			OriginalRequest: request,
+		}
+		if err := r.setMessageResponse(response, request, sentFilePaths); err != nil {
+			response.MessageResponse = &reflectionv1.ServerReflectionResponse_ErrorResponse{
+				ErrorResponse: &reflectionv1.ErrorResponse{
+					ErrorCode:    int32(connect.CodeOf(err)),
+					ErrorMessage: errorMessage(err),
+				},
+			}
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *reflector) setMessageResponse(
+	response *reflectionv1.ServerReflectionResponse,
+	request *reflectionv1.ServerReflectionRequest,
+	sentFilePaths map[string]struct{},
+) error {
+	switch messageRequest := request.GetMessageRequest().(type) {
+	case *reflectionv1.ServerReflectionRequest_FileByFilename:
+		fileDescriptor, err := r.files.FindFileByPath(messageRequest.FileByFilename)
+		if err != nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("file %q not found", messageRequest.FileByFilename))
+		}
+		response.MessageResponse = r.newFileDescriptorResponse(fileDescriptor, sentFilePaths)
+	case *reflectionv1.ServerReflectionRequest_FileContainingSymbol:
+		descriptor, err := r.files.FindDescriptorByName(protoreflect.FullName(messageRequest.FileContainingSymbol))
+		if err != nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("symbol %q not found", messageRequest.FileContainingSymbol))
+		}
+		response.MessageResponse = r.newFileDescriptorResponse(descriptor.ParentFile(), sentFilePaths)
+	case *reflectionv1.ServerReflectionRequest_FileContainingExtension:
+		extendee := protoreflect.FullName(messageRequest.FileContainingExtension.GetContainingType())
+		number := protoreflect.FieldNumber(messageRequest.FileContainingExtension.GetExtensionNumber())
+		fileDescriptor, ok := r.extensions[extendee][number]
+		if !ok {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("extension %d of %q not found", number, extendee))
+		}
+		response.MessageResponse = r.newFileDescriptorResponse(fileDescriptor, sentFilePaths)
+	case *reflectionv1.ServerReflectionRequest_AllExtensionNumbersOfType:
+		extendee := protoreflect.FullName(messageRequest.AllExtensionNumbersOfType)
+		if _, err := r.files.FindDescriptorByName(extendee); err != nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("type %q not found", extendee))
+		}
+		numbers := make([]int32, 0, len(r.extensions[extendee]))
+		for number := range r.extensions[extendee] {
+			numbers = append(numbers, int32(number))
+		}
+		sort.Slice(numbers, func(i int, j int) bool { return numbers[i] < numbers[j] })
+		response.MessageResponse = &reflectionv1.ServerReflectionResponse_AllExtensionNumbersResponse{
+			AllExtensionNumbersResponse: &reflectionv1.ExtensionNumberResponse{
+				BaseTypeName:    string(extendee),
+				ExtensionNumber: numbers,
+			},
+		}
+	case *reflectionv1.ServerReflectionRequest_ListServices:
+		serviceResponses := make([]*reflectionv1.ServiceResponse, len(r.serviceNames))
+		for i, serviceName := range r.serviceNames {
+			serviceResponses[i] = &reflectionv1.ServiceResponse{Name: serviceName}
+		}
+		response.MessageResponse = &reflectionv1.ServerReflectionResponse_ListServicesResponse{
+			ListServicesResponse: &reflectionv1.ListServiceResponse{
+				Service: serviceResponses,
+			},
+		}
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("unknown request"))
+	}
+	return nil
+}
+
+// newFileDescriptorResponse returns a response with the file, and with its
+// transitive dependencies that were not sent yet.
+func (r *reflector) newFileDescriptorResponse(
+	fileDescriptor protoreflect.FileDescriptor,
+	sentFilePaths map[string]struct{},
+) *reflectionv1.ServerReflectionResponse_FileDescriptorResponse {
+	fileDescriptorProtos := [][]byte{r.fileData[fileDescriptor.Path()]}
+	sentFilePaths[fileDescriptor.Path()] = struct{}{}
+	queue := []protoreflect.FileDescriptor{fileDescriptor}
+	for len(queue) > 0 {
+		imports := queue[0].Imports()
+		queue = queue[1:]
+		for i := 0; i < imports.Len(); i++ {
+			importPath := imports.Get(i).Path()
+			if _, ok := sentFilePaths[importPath]; ok {
+				continue
+			}
+			sentFilePaths[importPath] = struct{}{}
+			fileDescriptorProtos = append(fileDescriptorProtos, r.fileData[importPath])
+			queue = append(queue, imports.Get(i).FileDescriptor)
+		}
+	}
+	return &reflectionv1.ServerReflectionResponse_FileDescriptorResponse{
+		FileDescriptorResponse: &reflectionv1.FileDescriptorResponse{
+			FileDescriptorProto: fileDescriptorProtos,
+		},
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bufbuild/buf/private/buf/bufcurl"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	reflectionv1 "github.com/bufbuild/buf/private/gen/proto/go/grpc/reflection/v1"
+	"github.com/bufbuild/buf/private/pkg/verbose"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestReflectionHandler(t *testing.T) {
+	t.Parallel()
+	handler, err := NewReflectionHandler(newTestReflectionImage(t))
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(handler)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	for _, reflectProtocol := range []bufcurl.ReflectProtocol{
+		bufcurl.ReflectProtocolGRPCV1,
+		bufcurl.ReflectProtocolGRPCV1Alpha,
+	} {
+		res, closeRes := bufcurl.NewServerReflectionResolver(
+			context.Background(),
+			server.Client(),
+			[]connect.ClientOption{connect.WithGRPC()},
+			server.URL,
+			reflectProtocol,
+			nil,
+			verbose.NopPrinter,
+		)
+		methodDescriptor, err := bufcurl.ResolveMethodDescriptor(res, "test.v1.EchoService", "Echo")
+		require.NoError(t, err, reflectProtocol)
+		assert.Equal(t, protoreflect.FullName("google.protobuf.StringValue"), methodDescriptor.Input().FullName())
+		_, err = res.FindDescriptorByName("test.v1.Unknown")
+		assert.Error(t, err)
+		closeRes()
+	}
+
+	client := connect.NewClient[reflectionv1.ServerReflectionRequest, reflectionv1.ServerReflectionResponse](
+		server.Client(),
+		server.URL+"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+		connect.WithGRPC(),
+	)
+	stream := client.CallBidiStream(context.Background())
+	for _, testCase := range []struct {
+		request  *reflectionv1.ServerReflectionRequest
+		expected *reflectionv1.ServerReflectionResponse
+	}{
+		{
+			request: &reflectionv1.ServerReflectionRequest{
+				MessageRequest: &reflectionv1.ServerReflectionRequest_ListServices{},
+			},
+			expected: &reflectionv1.ServerReflectionResponse{
+				MessageResponse: &reflectionv1.ServerReflectionResponse_ListServicesResponse{
+					ListServicesResponse: &reflectionv1.ListServiceResponse{
+						Service: []*reflectionv1.ServiceResponse{{Name: "test.v1.EchoService"}},
+					},
+				},
+			},
+		},
+		{
+			request: &reflectionv1.ServerReflectionRequest{
+				MessageRequest: &reflectionv1.ServerReflectionRequest_AllExtensionNumbersOfType{
+					AllExtensionNumbersOfType: "google.protobuf.MethodOptions",
+				},
+			},
+			expected: &reflectionv1.ServerReflectionResponse{
+				MessageResponse: &reflectionv1.ServerReflectionResponse_AllExtensionNumbersResponse{
+					AllExtensionNumbersResponse: &reflectionv1.ExtensionNumberResponse{
+						BaseTypeName:    "google.protobuf.MethodOptions",
+						ExtensionNumber: []int32{50000},
+					},
+				},
+			},
+		},
+		{
+			request: &reflectionv1.ServerReflectionRequest{
+				MessageRequest: &reflectionv1.ServerReflectionRequest_FileByFilename{
+					FileByFilename: "test/v1/unknown.proto",
+				},
+			},
+			expected: &reflectionv1.ServerReflectionResponse{
+				MessageResponse: &reflectionv1.ServerReflectionResponse_ErrorResponse{
+					ErrorResponse: &reflectionv1.ErrorResponse{
+						ErrorCode:    int32(connect.CodeNotFound),
+						ErrorMessage: `file "test/v1/unknown.proto" not found`,
+					},
+				},
+			},
+		},
+	} {
+		require.NoError(t, stream.Send(testCase.request))
+		response, err := stream.Receive()
+		require.NoError(t, err)
+		testCase.expected.OriginalRequest = testCase.request
+		assert.True(t, proto.Equal(testCase.expected, response), response.String())
+	}
+
+	// The file of the extension and its dependencies are sent, except for the
+	// dependencies that were sent by the previous request.
+	require.NoError(t, stream.Send(&reflectionv1.ServerReflectionRequest{
+		MessageRequest: &reflectionv1.ServerReflectionRequest_FileByFilename{
+			FileByFilename: "google/protobuf/wrappers.proto",
+		},
+	}))
+	response, err := stream.Receive()
+	require.NoError(t, err)
+	assert.Len(t, response.GetFileDescriptorResponse().GetFileDescriptorProto(), 1)
+	require.NoError(t, stream.Send(&reflectionv1.ServerReflectionRequest{
+		MessageRequest: &reflectionv1.ServerReflectionRequest_FileContainingExtension{
+			FileContainingExtension: &reflectionv1.ExtensionRequest{
+				ContainingType:  "google.protobuf.MethodOptions",
+				ExtensionNumber: 50000,
+			},
+		},
+	}))
+	response, err = stream.Receive()
+	require.NoError(t, err)
+	var paths []string
+	for _, data := range response.GetFileDescriptorResponse().GetFileDescriptorProto() {
+		fileDescriptorProto := &descriptorpb.FileDescriptorProto{}
+		require.NoError(t, proto.Unmarshal(data, fileDescriptorProto))
+		paths = append(paths, fileDescriptorProto.GetName())
+	}
+	assert.Equal(t, []string{"test/v1/echo.proto", "google/protobuf/descriptor.proto"}, paths)
+	require.NoError(t, stream.CloseRequest())
+	require.NoError(t, stream.CloseResponse())
+}
+
+func newTestReflectionImage(t *testing.T) bufimage.Image {
+	var imageFiles []bufimage.ImageFile
+	for _, fileDescriptorProto := range []*descriptorpb.FileDescriptorProto{
+		protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+		protodesc.ToFileDescriptorProto(wrapperspb.File_google_protobuf_wrappers_proto),
+		{
+			Name:       proto.String("test/v1/echo.proto"),
+			Package:    proto.String("test.v1"),
+			Dependency: []string{"google/protobuf/descriptor.proto", "google/protobuf/wrappers.proto"},
+			Syntax:     proto.String("proto3"),
+			Service: []*descriptorpb.ServiceDescriptorProto{
+				{
+					Name: proto.String("EchoService"),
+					Method: []*descriptorpb.MethodDescriptorProto{
+						{
+							Name:       proto.String("Echo"),
+							InputType:  proto.String(".google.protobuf.StringValue"),
+							OutputType: proto.String(".google.protobuf.StringValue"),
+						},
+					},
+				},
+			},
+			Extension: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     proto.String("cache"),
+					Number:   proto.Int32(50000),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+					Extendee: proto.String(".google.protobuf.MethodOptions"),
+					JsonName: proto.String("cache"),
+				},
+			},
+		},
+	} {
+		isImport := fileDescriptorProto.GetPackage() == "google.protobuf"
+		imageFile, err := bufimage.NewImageFile(fileDescriptorProto, nil, "", "", isImport, false, nil)
+		require.NoError(t, err)
+		imageFiles = append(imageFiles, imageFile)
+	}
+	image, err := bufimage.NewImage(imageFiles)
+	require.NoError(t, err)
+	return image
+}
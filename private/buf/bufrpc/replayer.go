@@ -0,0 +1,297 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/bufbuild/buf/private/buf/bufcurl"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Difference is a difference between the result of a recorded RPC and the
+// result of its replay.
+type Difference struct {
+	// Path is the path of the value that differs: "error.code" for the code,
+	// which is "ok" if the RPC succeeded, "error.message" for the message of
+	// the error, and "response." followed by the path of the field, such as
+	// "response.items[0].name", for the fields of the response.
+	Path string
+	// Expected is the recorded value, in JSON, or "(none)" if the value is
+	// not present, such as an element of a list that was shorter.
+	Expected string
+	// Actual is the value of the replay, in JSON, or "(none)" if the value
+	// is not present.
+	Actual string
+}
+
+// String returns a description of the difference.
+func (d *Difference) String() string {
+	return fmt.Sprintf("%s: expected %s, got %s", d.Path, d.Expected, d.Actual)
+}
+
+// Replayer replays recorded RPCs against a server.
+type Replayer interface {
+	// Replay invokes the method of the entry with the recorded request, and
+	// returns the differences between the recorded and the actual results.
+	Replay(ctx context.Context, entry *Entry) ([]*Difference, error)
+}
+
+// NewReplayer returns a new Replayer for the server at the base URL.
+//
+// The resolver must contain the methods of the entries. The headers are
+// added to each request.
+func NewReplayer(
+	res protoencoding.Resolver,
+	httpClient connect.HTTPClient,
+	baseURL string,
+	clientOptions []connect.ClientOption,
+	headers http.Header,
+) Replayer {
+	return newReplayer(res, httpClient, baseURL, clientOptions, headers)
+}
+
+// DiffMessages returns the differences between the fields of the messages,
+// which must be of the same type. The path of each difference is the prefix
+// followed by the path of the field.
+func DiffMessages(prefix string, expected protoreflect.Message, actual protoreflect.Message) []*Difference {
+	var differences []*Difference
+	fields := expected.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		path := prefix + field.JSONName()
+		switch {
+		case field.IsList():
+			differences = append(differences, diffLists(path, field, expected.Get(field).List(), actual.Get(field).List())...)
+		case field.IsMap():
+			differences = append(differences, diffMaps(path, field, expected.Get(field).Map(), actual.Get(field).Map())...)
+		case field.Message() != nil:
+			expectedHas, actualHas := expected.Has(field), actual.Has(field)
+			switch {
+			case expectedHas && actualHas:
+				differences = append(differences, DiffMessages(path+".", expected.Get(field).Message(), actual.Get(field).Message())...)
+			case expectedHas != actualHas:
+				differences = append(differences, &Difference{
+					Path:     path,
+					Expected: formatOptionalValue(field, expected.Get(field), expectedHas),
+					Actual:   formatOptionalValue(field, actual.Get(field), actualHas),
+				})
+			}
+		default:
+			differences = append(differences, diffValues(path, field, expected.Get(field), actual.Get(field))...)
+		}
+	}
+	return differences
+}
+
+type replayer struct {
+	res           protoencoding.Resolver
+	httpClient    connect.HTTPClient
+	baseURL       string
+	clientOptions []connect.ClientOption
+	headers       http.Header
+}
+
+func newReplayer(
+	res protoencoding.Resolver,
+	httpClient connect.HTTPClient,
+	baseURL string,
+	clientOptions []connect.ClientOption,
+	headers http.Header,
+) *replayer {
+	return &replayer{
+		res:           res,
+		httpClient:    httpClient,
+		baseURL:       baseURL,
+		clientOptions: clientOptions,
+		headers:       headers,
+	}
+}
+
+func (r *replayer) Replay(ctx context.Context, entry *Entry) ([]*Difference, error) {
+	descriptor, err := r.res.FindDescriptorByName(protoreflect.FullName(entry.Method))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find method %q in schema: %w", entry.Method, err)
+	}
+	methodDescriptor, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a method", entry.Method)
+	}
+	if methodDescriptor.IsStreamingClient() || methodDescriptor.IsStreamingServer() {
+		return nil, fmt.Errorf("method %q is not a unary method", entry.Method)
+	}
+	requestMessage := dynamicpb.NewMessage(methodDescriptor.Input())
+	if err := protoencoding.NewJSONUnmarshaler(r.res).Unmarshal(entry.Request, requestMessage); err != nil {
+		return nil, fmt.Errorf("invalid request for method %q: %w", entry.Method, err)
+	}
+	request := connect.NewRequest(&dynamicMessage{message: requestMessage})
+	for key, values := range r.headers {
+		request.Header()[key] = append(request.Header()[key], values...)
+	}
+	response, err := newClient(methodDescriptor, r.res, r.httpClient, r.baseURL, r.clientOptions).CallUnary(ctx, request)
+	expectedCode := "ok"
+	if entry.Error != nil {
+		expectedCode = entry.Error.Code
+	}
+	actualCode := "ok"
+	if err != nil {
+		actualCode = bufcurl.CodeString(connect.CodeOf(err))
+	}
+	if expectedCode != actualCode {
+		return []*Difference{
+			{
+				Path:     "error.code",
+				Expected: strconv.Quote(expectedCode),
+				Actual:   strconv.Quote(actualCode),
+			},
+		}, nil
+	}
+	if err != nil {
+		if actualMessage := errorMessage(err); actualMessage != entry.Error.Message {
+			return []*Difference{
+				{
+					Path:     "error.message",
+					Expected: strconv.Quote(entry.Error.Message),
+					Actual:   strconv.Quote(actualMessage),
+				},
+			}, nil
+		}
+		return nil, nil
+	}
+	expectedResponseMessage := dynamicpb.NewMessage(methodDescriptor.Output())
+	if err := protoencoding.NewJSONUnmarshaler(r.res).Unmarshal(entry.Response, expectedResponseMessage); err != nil {
+		return nil, fmt.Errorf("invalid response for method %q: %w", entry.Method, err)
+	}
+	return DiffMessages("response.", expectedResponseMessage, response.Msg.message), nil
+}
+
+func diffLists(path string, field protoreflect.FieldDescriptor, expected protoreflect.List, actual protoreflect.List) []*Difference {
+	var differences []*Difference
+	length := expected.Len()
+	if actual.Len() > length {
+		length = actual.Len()
+	}
+	for i := 0; i < length; i++ {
+		elementPath := path + "[" + strconv.Itoa(i) + "]"
+		if i >= expected.Len() || i >= actual.Len() {
+			differences = append(differences, &Difference{
+				Path:     elementPath,
+				Expected: formatListElement(field, expected, i),
+				Actual:   formatListElement(field, actual, i),
+			})
+			continue
+		}
+		if field.Message() != nil {
+			differences = append(differences, DiffMessages(elementPath+".", expected.Get(i).Message(), actual.Get(i).Message())...)
+			continue
+		}
+		differences = append(differences, diffValues(elementPath, field, expected.Get(i), actual.Get(i))...)
+	}
+	return differences
+}
+
+func diffMaps(path string, field protoreflect.FieldDescriptor, expected protoreflect.Map, actual protoreflect.Map) []*Difference {
+	keys := make(map[string]protoreflect.MapKey)
+	for _, m := range []protoreflect.Map{expected, actual} {
+		m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys[key.String()] = key
+			return true
+		})
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+	valueField := field.MapValue()
+	var differences []*Difference
+	for _, keyString := range sortedKeys {
+		key := keys[keyString]
+		entryPath := path + "[" + strconv.Quote(keyString) + "]"
+		expectedHas, actualHas := expected.Has(key), actual.Has(key)
+		if !expectedHas || !actualHas {
+			differences = append(differences, &Difference{
+				Path:     entryPath,
+				Expected: formatOptionalValue(valueField, expected.Get(key), expectedHas),
+				Actual:   formatOptionalValue(valueField, actual.Get(key), actualHas),
+			})
+			continue
+		}
+		if valueField.Message() != nil {
+			differences = append(differences, DiffMessages(entryPath+".", expected.Get(key).Message(), actual.Get(key).Message())...)
+			continue
+		}
+		differences = append(differences, diffValues(entryPath, valueField, expected.Get(key), actual.Get(key))...)
+	}
+	return differences
+}
+
+func diffValues(path string, field protoreflect.FieldDescriptor, expected protoreflect.Value, actual protoreflect.Value) []*Difference {
+	if expected.Equal(actual) {
+		return nil
+	}
+	return []*Difference{
+		{
+			Path:     path,
+			Expected: formatValue(field, expected),
+			Actual:   formatValue(field, actual),
+		},
+	}
+}
+
+func formatListElement(field protoreflect.FieldDescriptor, list protoreflect.List, i int) string {
+	if i >= list.Len() {
+		return formatOptionalValue(field, protoreflect.Value{}, false)
+	}
+	return formatValue(field, list.Get(i))
+}
+
+func formatOptionalValue(field protoreflect.FieldDescriptor, value protoreflect.Value, has bool) string {
+	if !has {
+		return "(none)"
+	}
+	return formatValue(field, value)
+}
+
+// formatValue returns the JSON representation of a singular value of the field.
+func formatValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		data, err := protoencoding.NewJSONMarshaler(nil).Marshal(value.Message().Interface())
+		if err != nil {
+			return fmt.Sprintf("(%v)", err)
+		}
+		return string(data)
+	case protoreflect.StringKind:
+		return strconv.Quote(value.String())
+	case protoreflect.BytesKind:
+		return strconv.Quote(base64.StdEncoding.EncodeToString(value.Bytes()))
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return strconv.Quote(string(enumValue.Name()))
+		}
+		return strconv.Itoa(int(value.Enum()))
+	default:
+		return value.String()
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrpc
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Violation is a violation of the schema by a message.
+type Violation struct {
+	// Path is the path of the field, such as "items[0].name", or empty for
+	// the message itself.
+	Path string
+	// Description is the description of the violation.
+	Description string
+}
+
+// String returns a description of the violation.
+func (v *Violation) String() string {
+	if v.Path == "" {
+		return v.Description
+	}
+	return v.Path + ": " + v.Description
+}
+
+// validator validates messages against their schema: messages must not have
+// unknown fields, and fields must satisfy their protovalidate constraints.
+//
+// The standard constraints are checked, but CEL expressions are not evaluated.
+type validator struct {
+	constraintsResolver *constraintsResolver
+	lock                sync.Mutex
+	patterns            map[string]*regexp.Regexp
+}
+
+func newValidator(res protoencoding.Resolver) *validator {
+	return &validator{
+		constraintsResolver: newConstraintsResolver(res),
+		patterns:            make(map[string]*regexp.Regexp),
+	}
+}
+
+// validate returns the violations of the message.
+func (v *validator) validate(message protoreflect.Message) []*Violation {
+	return v.validateMessage("", message)
+}
+
+func (v *validator) validateMessage(path string, message protoreflect.Message) []*Violation {
+	var violations []*Violation
+	if unknown := message.GetUnknown(); len(unknown) > 0 {
+		violations = append(violations, &Violation{
+			Path:        path,
+			Description: "unknown fields " + unknownFieldNumbers(unknown),
+		})
+	}
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fieldPath := joinPath(path, field.JSONName())
+		constraints := v.constraintsResolver.getFieldConstraints(field)
+		if value, ok := getField(constraints, "required"); ok && value.Bool() && !message.Has(field) {
+			violations = append(violations, &Violation{Path: fieldPath, Description: "value is required"})
+			continue
+		}
+		switch {
+		case field.IsList():
+			list := message.Get(field).List()
+			rules := getMessageField(constraints, "repeated")
+			violations = append(violations, checkCount(fieldPath, list.Len(), rules, "min_items", "max_items", "items")...)
+			itemConstraints := getMessageField(rules, "items")
+			for j := 0; j < list.Len(); j++ {
+				violations = append(violations, v.validateValue(fieldPath+"["+strconv.Itoa(j)+"]", field, itemConstraints, list.Get(j))...)
+			}
+		case field.IsMap():
+			mapValue := message.Get(field).Map()
+			rules := getMessageField(constraints, "map")
+			violations = append(violations, checkCount(fieldPath, mapValue.Len(), rules, "min_pairs", "max_pairs", "pairs")...)
+			keyConstraints := getMessageField(rules, "keys")
+			valueConstraints := getMessageField(rules, "values")
+			var entryViolations []*Violation
+			mapValue.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				entryPath := fieldPath + "[" + strconv.Quote(key.String()) + "]"
+				entryViolations = append(entryViolations, v.validateValue(entryPath, field.MapKey(), keyConstraints, key.Value())...)
+				entryViolations = append(entryViolations, v.validateValue(entryPath, field.MapValue(), valueConstraints, value)...)
+				return true
+			})
+			// Map iteration order is random.
+			sort.SliceStable(entryViolations, func(i int, j int) bool { return entryViolations[i].Path < entryViolations[j].Path })
+			violations = append(violations, entryViolations...)
+		default:
+			if field.HasPresence() && !message.Has(field) {
+				continue
+			}
+			violations = append(violations, v.validateValue(fieldPath, field, constraints, message.Get(field))...)
+		}
+	}
+	return violations
+}
+
+// validateValue returns the violations of a singular value of the field, or
+// of an element of the list field.
+func (v *validator) validateValue(
+	path string,
+	field protoreflect.FieldDescriptor,
+	constraints protoreflect.Message,
+	value protoreflect.Value,
+) []*Violation {
+	var descriptions []string
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return v.validateMessage(path, value.Message())
+	case protoreflect.BoolKind:
+		if constValue, ok := getField(getMessageField(constraints, "bool"), "const"); ok && constValue.Bool() != value.Bool() {
+			descriptions = append(descriptions, fmt.Sprintf("value must equal %v", constValue.Bool()))
+		}
+	case protoreflect.EnumKind:
+		descriptions = checkEnum(field.Enum(), getMessageField(constraints, "enum"), value.Enum())
+	case protoreflect.StringKind:
+		descriptions = v.checkString(getMessageField(constraints, "string"), value.String())
+	case protoreflect.BytesKind:
+		descriptions = v.checkBytes(getMessageField(constraints, "bytes"), value.Bytes())
+	default:
+		descriptions = checkNumber(getMessageField(constraints, protoreflect.Name(field.Kind().String())), value)
+	}
+	violations := make([]*Violation, len(descriptions))
+	for i, description := range descriptions {
+		violations[i] = &Violation{Path: path, Description: description}
+	}
+	return violations
+}
+
+func (v *validator) checkString(rules protoreflect.Message, value string) []string {
+	if rules == nil {
+		return nil
+	}
+	var descriptions []string
+	if constValue, ok := getField(rules, "const"); ok && constValue.String() != value {
+		descriptions = append(descriptions, fmt.Sprintf("value must equal %q", constValue.String()))
+	}
+	length := uint64(utf8.RuneCountInString(value))
+	if expectedLength, ok := getField(rules, "len"); ok && length != expectedLength.Uint() {
+		descriptions = append(descriptions, fmt.Sprintf("value length must be %d characters", expectedLength.Uint()))
+	}
+	if minLength, ok := getField(rules, "min_len"); ok && length < minLength.Uint() {
+		descriptions = append(descriptions, fmt.Sprintf("value length must be at least %d characters", minLength.Uint()))
+	}
+	if maxLength, ok := getField(rules, "max_len"); ok && length > maxLength.Uint() {
+		descriptions = append(descriptions, fmt.Sprintf("value length must be at most %d characters", maxLength.Uint()))
+	}
+	if minBytes, ok := getField(rules, "min_bytes"); ok && uint64(len(value)) < minBytes.Uint() {
+		descriptions = append(descriptions, fmt.Sprintf("value length must be at least %d bytes", minBytes.Uint()))
+	}
+	if maxBytes, ok := getField(rules, "max_bytes"); ok && uint64(len(value)) > maxBytes.Uint() {
+		descriptions = append(descriptions, fmt.Sprintf("value length must be at most %d bytes", maxBytes.Uint()))
+	}
+	if prefix, ok := getField(rules, "prefix"); ok && !strings.HasPrefix(value, prefix.String()) {
+		descriptions = append(descriptions, fmt.Sprintf("value does not have prefix %q", prefix.String()))
+	}
+	if suffix, ok := getField(rules, "suffix"); ok && !strings.HasSuffix(value, suffix.String()) {
+		descriptions = append(descriptions, fmt.Sprintf("value does not have suffix %q", suffix.String()))
+	}
+	if contains, ok := getField(rules, "contains"); ok && !strings.Contains(value, contains.String()) {
+		descriptions = append(descriptions, fmt.Sprintf("value does not contain substring %q", contains.String()))
+	}
+	if notContains, ok := getField(rules, "not_contains"); ok && strings.Contains(value, notContains.String()) {
+		descriptions = append(descriptions, fmt.Sprintf("value contains substring %q", notContains.String()))
+	}
+	if pattern, ok := getField(rules, "pattern"); ok {
+		if patternRegexp := v.getPattern(pattern.String()); patternRegexp != nil && !patternRegexp.MatchString(value) {
+			descriptions = append(descriptions, fmt.Sprintf("value does not match regex pattern %q", pattern.String()))
+		}
+	}
+	if in := getListField(rules, "in"); in != nil && in.Len() > 0 && !listContains(in, protoreflect.ValueOfString(value)) {
+		descriptions = append(descriptions, "value must be in list "+formatList(in))
+	}
+	if notIn := getListField(rules, "not_in"); notIn != nil && listContains(notIn, protoreflect.ValueOfString(value)) {
+		descriptions = append(descriptions, "value must not be in list "+formatList(notIn))
+	}
+	for _, wellKnown := range []struct {
+		name        protoreflect.Name
+		isValid     func(string) bool
+		description string
+	}{
+		{"email", isEmail, "value must be a valid email address"},
+		{"hostname", isHostname, "value must be a valid hostname"},
+		{"ip", func(s string) bool { return net.ParseIP(s) != nil }, "value must be a valid IP address"},
+		{"ipv4", func(s string) bool { ip := net.ParseIP(s); return ip != nil && ip.To4() != nil }, "value must be a valid IPv4 address"},
+		{"ipv6", func(s string) bool { ip := net.ParseIP(s); return ip != nil && ip.To4() == nil }, "value must be a valid IPv6 address"},
+		{"uri", isURI, "value must be a valid URI"},
+		{"uri_ref", func(s string) bool { _, err := url.Parse(s); return err == nil }, "value must be a valid URI reference"},
+		{"address", func(s string) bool { return net.ParseIP(s) != nil || isHostname(s) }, "value must be a valid hostname or IP address"},
+		{"uuid", uuidRegexp.MatchString, "value must be a valid UUID"},
+	} {
+		if enabled, ok := getField(rules, wellKnown.name); ok && enabled.Bool() && !wellKnown.isValid(value) {
+			descriptions = append(descriptions, wellKnown.description)
+		}
+	}
+	return descriptions
+}
+
+func (v *validator) checkBytes(rules protoreflect.Message, value []byte) []string {
+	if rules == nil {
+		return nil
+	}
+	var descriptions []string
+	if constValue, ok := getField(rules, "const"); ok && !bytes.Equal(constValue.Bytes(), value) {
+		descriptions = append(descriptions, fmt.Sprintf("value must equal %q", constValue.Bytes()))
+	}
+	length := uint64(len(value))
+	if expectedLength, ok := getField(rules, "len"); ok && length != expectedLength.Uint() {
+		descriptions = append(descriptions, fmt.Sprintf("value length must be %d bytes", expectedLength.Uint()))
+	}
+	if minLength, ok := getField(rules, "min_len"); ok && length < minLength.Uint() {
+		descriptions = append(descriptions, fmt.Sprintf("value length must be at least %d bytes", minLength.Uint()))
+	}
+	if maxLength, ok := getField(rules, "max_len"); ok && length > maxLength.Uint() {
+		descriptions = append(descriptions, fmt.Sprintf("value length must be at most %d bytes", maxLength.Uint()))
+	}
+	if prefix, ok := getField(rules, "prefix"); ok && !bytes.HasPrefix(value, prefix.Bytes()) {
+		descriptions = append(descriptions, fmt.Sprintf("value does not have prefix %q", prefix.Bytes()))
+	}
+	if suffix, ok := getField(rules, "suffix"); ok && !bytes.HasSuffix(value, suffix.Bytes()) {
+		descriptions = append(descriptions, fmt.Sprintf("value does not have suffix %q", suffix.Bytes()))
+	}
+	if contains, ok := getField(rules, "contains"); ok && !bytes.Contains(value, contains.Bytes()) {
+		descriptions = append(descriptions, fmt.Sprintf("value does not contain %q", contains.Bytes()))
+	}
+	if pattern, ok := getField(rules, "pattern"); ok {
+		if patternRegexp := v.getPattern(pattern.String()); patternRegexp != nil && !patternRegexp.Match(value) {
+			descriptions = append(descriptions, fmt.Sprintf("value does not match regex pattern %q", pattern.String()))
+		}
+	}
+	if in := getListField(rules, "in"); in != nil && in.Len() > 0 && !listContains(in, protoreflect.ValueOfBytes(value)) {
+		descriptions = append(descriptions, "value must be in list "+formatList(in))
+	}
+	if notIn := getListField(rules, "not_in"); notIn != nil && listContains(notIn, protoreflect.ValueOfBytes(value)) {
+		descriptions = append(descriptions, "value must not be in list "+formatList(notIn))
+	}
+	return descriptions
+}
+
+// getPattern returns the compiled pattern, or nil if the pattern is invalid.
+func (v *validator) getPattern(pattern string) *regexp.Regexp {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	patternRegexp, ok := v.patterns[pattern]
+	if !ok {
+		// An invalid pattern is stored as nil, and not checked.
+		patternRegexp, _ = regexp.Compile(pattern)
+		v.patterns[pattern] = patternRegexp
+	}
+	return patternRegexp
+}
+
+func checkEnum(enumDescriptor protoreflect.EnumDescriptor, rules protoreflect.Message, value protoreflect.EnumNumber) []string {
+	if rules == nil {
+		return nil
+	}
+	var descriptions []string
+	if constValue, ok := getField(rules, "const"); ok && constValue.Enum() != value {
+		descriptions = append(descriptions, fmt.Sprintf("value must equal %d", constValue.Enum()))
+	}
+	if definedOnly, ok := getField(rules, "defined_only"); ok && definedOnly.Bool() && enumDescriptor.Values().ByNumber(value) == nil {
+		descriptions = append(descriptions, "value must be one of the defined enum values")
+	}
+	if in := getListField(rules, "in"); in != nil && in.Len() > 0 && !listContains(in, protoreflect.ValueOfInt32(int32(value))) {
+		descriptions = append(descriptions, "value must be in list "+formatList(in))
+	}
+	if notIn := getListField(rules, "not_in"); notIn != nil && listContains(notIn, protoreflect.ValueOfInt32(int32(value))) {
+		descriptions = append(descriptions, "value must not be in list "+formatList(notIn))
+	}
+	return descriptions
+}
+
+func checkNumber(rules protoreflect.Message, value protoreflect.Value) []string {
+	if rules == nil {
+		return nil
+	}
+	var descriptions []string
+	if constValue, ok := getField(rules, "const"); ok && !constValue.Equal(value) {
+		descriptions = append(descriptions, fmt.Sprintf("value must equal %v", constValue))
+	}
+	number, ok := valueToFloat(value)
+	if !ok {
+		return descriptions
+	}
+	var lowerDescription, upperDescription string
+	lowerOK, upperOK := true, true
+	lower, hasLower := getNumberField(rules, "gt")
+	if hasLower {
+		lowerOK = number > lower
+		lowerDescription = fmt.Sprintf("greater than %v", lower)
+	} else if lower, hasLower = getNumberField(rules, "gte"); hasLower {
+		lowerOK = number >= lower
+		lowerDescription = fmt.Sprintf("greater than or equal to %v", lower)
+	}
+	upper, hasUpper := getNumberField(rules, "lt")
+	if hasUpper {
+		upperOK = number < upper
+		upperDescription = fmt.Sprintf("less than %v", upper)
+	} else if upper, hasUpper = getNumberField(rules, "lte"); hasUpper {
+		upperOK = number <= upper
+		upperDescription = fmt.Sprintf("less than or equal to %v", upper)
+	}
+	switch {
+	case hasLower && hasUpper && lower > upper:
+		// An exclusive range: the value must be outside of the range.
+		if !lowerOK && !upperOK {
+			descriptions = append(descriptions, fmt.Sprintf("value must be %s or %s", lowerDescription, upperDescription))
+		}
+	case hasLower && hasUpper:
+		if !lowerOK || !upperOK {
+			descriptions = append(descriptions, fmt.Sprintf("value must be %s and %s", lowerDescription, upperDescription))
+		}
+	case hasLower && !lowerOK:
+		descriptions = append(descriptions, "value must be "+lowerDescription)
+	case hasUpper && !upperOK:
+		descriptions = append(descriptions, "value must be "+upperDescription)
+	}
+	if in := getListField(rules, "in"); in != nil && in.Len() > 0 && !listContains(in, value) {
+		descriptions = append(descriptions, "value must be in list "+formatList(in))
+	}
+	if notIn := getListField(rules, "not_in"); notIn != nil && listContains(notIn, value) {
+		descriptions = append(descriptions, "value must not be in list "+formatList(notIn))
+	}
+	return descriptions
+}
+
+// checkCount checks the number of elements of a list or of entries of a map.
+func checkCount(path string, count int, rules protoreflect.Message, minName protoreflect.Name, maxName protoreflect.Name, noun string) []*Violation {
+	var violations []*Violation
+	if minCount, ok := getField(rules, minName); ok && uint64(count) < minCount.Uint() {
+		violations = append(violations, &Violation{Path: path, Description: fmt.Sprintf("value must contain at least %d %s", minCount.Uint(), noun)})
+	}
+	if maxCount, ok := getField(rules, maxName); ok && uint64(count) > maxCount.Uint() {
+		violations = append(violations, &Violation{Path: path, Description: fmt.Sprintf("value must contain at most %d %s", maxCount.Uint(), noun)})
+	}
+	return violations
+}
+
+func isEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+	return err == nil && address.Name == "" && address.Address == value
+}
+
+func isHostname(value string) bool {
+	value = strings.TrimSuffix(value, ".")
+	if value == "" || len(value) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(value, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+		for _, r := range label {
+			if !(r == '-' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isURI(value string) bool {
+	parsedURL, err := url.Parse(value)
+	return err == nil && parsedURL.Scheme != ""
+}
+
+func listContains(list protoreflect.List, value protoreflect.Value) bool {
+	for i := 0; i < list.Len(); i++ {
+		if list.Get(i).Equal(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatList(list protoreflect.List) string {
+	elements := make([]string, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		switch element := list.Get(i).Interface().(type) {
+		case string:
+			elements[i] = strconv.Quote(element)
+		case []byte:
+			elements[i] = strconv.Quote(string(element))
+		default:
+			elements[i] = fmt.Sprint(element)
+		}
+	}
+	return "[" + strings.Join(elements, ", ") + "]"
+}
+
+func valueToFloat(value protoreflect.Value) (float64, bool) {
+	switch number := value.Interface().(type) {
+	case int32:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case uint32:
+		return float64(number), true
+	case uint64:
+		return float64(number), true
+	case float32:
+		return float64(number), true
+	case float64:
+		return number, true
+	default:
+		return 0, false
+	}
+}
+
+// unknownFieldNumbers returns the numbers of the unknown fields.
+func unknownFieldNumbers(unknown protoreflect.RawFields) string {
+	var numbers []string
+	seen := make(map[protowire.Number]struct{})
+	for len(unknown) > 0 {
+		number, _, length := protowire.ConsumeField(unknown)
+		if length < 0 {
+			break
+		}
+		unknown = unknown[length:]
+		if _, ok := seen[number]; ok {
+			continue
+		}
+		seen[number] = struct{}{}
+		numbers = append(numbers, strconv.Itoa(int(number)))
+	}
+	return strings.Join(numbers, ", ")
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,170 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufshard partitions the files of an input across shards, so that
+// checks on a large input can be split across multiple CI jobs.
+//
+// Files are partitioned by Protobuf package, so that the files of a package
+// are always in the same shard, and rules that look at all files of a package
+// still see all of them.
+package bufshard
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+)
+
+// Shard is a shard of an input, such as the third of eight shards.
+type Shard struct {
+	// Index is the 1-based index of the shard.
+	Index int
+	// Count is the total number of shards.
+	Count int
+}
+
+// ParseShard parses a Shard of the form "index/count", such as "3/8".
+func ParseShard(value string) (*Shard, error) {
+	indexString, countString, ok := strings.Cut(value, "/")
+	if !ok {
+		return nil, fmt.Errorf("shard %q must be of the form index/count, such as 3/8", value)
+	}
+	index, err := strconv.Atoi(indexString)
+	if err != nil {
+		return nil, fmt.Errorf("shard %q has an invalid index: %w", value, err)
+	}
+	count, err := strconv.Atoi(countString)
+	if err != nil {
+		return nil, fmt.Errorf("shard %q has an invalid count: %w", value, err)
+	}
+	if count < 1 {
+		return nil, fmt.Errorf("shard %q must have a count of at least 1", value)
+	}
+	if index < 1 || index > count {
+		return nil, fmt.Errorf("shard %q must have an index between 1 and %d", value, count)
+	}
+	return &Shard{
+		Index: index,
+		Count: count,
+	}, nil
+}
+
+// String returns the Shard in the form "index/count".
+func (s *Shard) String() string {
+	return fmt.Sprintf("%d/%d", s.Index, s.Count)
+}
+
+// ContainsPackage returns true if the files of the given Protobuf package are
+// in the Shard.
+//
+// The assignment only depends on the package and the number of shards.
+func (s *Shard) ContainsPackage(pkg string) bool {
+	digest := sha256.Sum256([]byte(pkg))
+	return int(binary.BigEndian.Uint64(digest[:8])%uint64(s.Count)) == s.Index-1
+}
+
+// ImageForShard returns a copy of the Image that only has the non-import
+// files that are in the Shard as non-imports.
+//
+// Returns nil if none of the non-import files are in the Shard.
+func (s *Shard) ImageForShard(image bufimage.Image) (bufimage.Image, error) {
+	var paths []string
+	for _, imageFile := range image.Files() {
+		if !imageFile.IsImport() && s.ContainsPackage(imageFile.Proto().GetPackage()) {
+			paths = append(paths, imageFile.Path())
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	return bufimage.ImageWithOnlyPaths(image, paths, nil)
+}
+
+// Fingerprint describes the part of an input that a Shard covers.
+//
+// Every shard of the same input has the same InputDigest, so a missing shard
+// can be detected by checking that each of the shards reported a Fingerprint
+// with the same InputDigest, and that their NumShardPackages add up to
+// NumPackages.
+type Fingerprint struct {
+	Shard *Shard
+	// NumPackages is the number of packages of the input.
+	NumPackages int
+	// NumShardPackages is the number of packages of the input in the Shard.
+	NumShardPackages int
+	// InputDigest is the hex-encoded SHA256 digest of the packages of the input.
+	InputDigest string
+	// ShardDigest is the hex-encoded SHA256 digest of the packages in the Shard.
+	ShardDigest string
+}
+
+// NewFingerprint returns a new Fingerprint for the packages of the non-import
+// files of the Images.
+func NewFingerprint(shard *Shard, images ...bufimage.Image) (*Fingerprint, error) {
+	if shard == nil {
+		return nil, errors.New("shard is required")
+	}
+	packages := make(map[string]struct{})
+	for _, image := range images {
+		if image == nil {
+			continue
+		}
+		for _, imageFile := range image.Files() {
+			if !imageFile.IsImport() {
+				packages[imageFile.Proto().GetPackage()] = struct{}{}
+			}
+		}
+	}
+	sortedPackages := make([]string, 0, len(packages))
+	for pkg := range packages {
+		sortedPackages = append(sortedPackages, pkg)
+	}
+	sort.Strings(sortedPackages)
+	inputHash := sha256.New()
+	shardHash := sha256.New()
+	fingerprint := &Fingerprint{
+		Shard:       shard,
+		NumPackages: len(sortedPackages),
+	}
+	for _, pkg := range sortedPackages {
+		// Packages cannot contain newlines, so this is unambiguous.
+		_, _ = inputHash.Write([]byte(pkg + "\n"))
+		if shard.ContainsPackage(pkg) {
+			fingerprint.NumShardPackages++
+			_, _ = shardHash.Write([]byte(pkg + "\n"))
+		}
+	}
+	fingerprint.InputDigest = hex.EncodeToString(inputHash.Sum(nil))
+	fingerprint.ShardDigest = hex.EncodeToString(shardHash.Sum(nil))
+	return fingerprint, nil
+}
+
+// String returns the Fingerprint as a single line.
+func (f *Fingerprint) String() string {
+	return fmt.Sprintf(
+		"Shard %s: %d of %d packages, input fingerprint %s, shard fingerprint %s",
+		f.Shard.String(),
+		f.NumShardPackages,
+		f.NumPackages,
+		f.InputDigest,
+		f.ShardDigest,
+	)
+}
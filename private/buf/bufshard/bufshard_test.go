@@ -0,0 +1,104 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufshard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagetesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestParseShard(t *testing.T) {
+	t.Parallel()
+	shard, err := ParseShard("3/8")
+	require.NoError(t, err)
+	assert.Equal(t, &Shard{Index: 3, Count: 8}, shard)
+	assert.Equal(t, "3/8", shard.String())
+	for _, value := range []string{
+		"",
+		"3",
+		"a/8",
+		"3/a",
+		"0/8",
+		"9/8",
+		"1/0",
+		"-1/8",
+	} {
+		_, err := ParseShard(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestContainsPackage(t *testing.T) {
+	t.Parallel()
+	const count = 8
+	for i := 0; i < 100; i++ {
+		pkg := fmt.Sprintf("acme.pkg%d.v1", i)
+		var numShards int
+		for index := 1; index <= count; index++ {
+			if (&Shard{Index: index, Count: count}).ContainsPackage(pkg) {
+				numShards++
+			}
+		}
+		assert.Equal(t, 1, numShards, pkg)
+	}
+}
+
+func TestImageForShard(t *testing.T) {
+	t.Parallel()
+	image := testNewImage(t)
+	var paths []string
+	var numShardPackages int
+	var inputDigest string
+	for index := 1; index <= 2; index++ {
+		shard := &Shard{Index: index, Count: 2}
+		shardImage, err := shard.ImageForShard(image)
+		require.NoError(t, err)
+		if shardImage != nil {
+			for _, imageFile := range shardImage.Files() {
+				if !imageFile.IsImport() {
+					assert.True(t, shard.ContainsPackage(imageFile.Proto().GetPackage()))
+					paths = append(paths, imageFile.Path())
+				}
+			}
+		}
+		fingerprint, err := NewFingerprint(shard, image)
+		require.NoError(t, err)
+		assert.Equal(t, 3, fingerprint.NumPackages)
+		numShardPackages += fingerprint.NumShardPackages
+		if inputDigest != "" {
+			assert.Equal(t, inputDigest, fingerprint.InputDigest)
+		}
+		inputDigest = fingerprint.InputDigest
+	}
+	assert.ElementsMatch(t, []string{"a/v1/a.proto", "a/v1/a2.proto", "b/v1/b.proto", "c/v1/c.proto"}, paths)
+	assert.Equal(t, 3, numShardPackages)
+}
+
+func testNewImage(t *testing.T) bufimage.Image {
+	return bufimagetesting.NewImage(
+		t,
+		&descriptorpb.FileDescriptorProto{Name: proto.String("a/v1/a.proto"), Package: proto.String("acme.a.v1")},
+		&descriptorpb.FileDescriptorProto{Name: proto.String("a/v1/a2.proto"), Package: proto.String("acme.a.v1")},
+		&descriptorpb.FileDescriptorProto{Name: proto.String("b/v1/b.proto"), Package: proto.String("acme.b.v1")},
+		&descriptorpb.FileDescriptorProto{Name: proto.String("c/v1/c.proto"), Package: proto.String("acme.c.v1")},
+	)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/git"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -46,6 +47,9 @@ type ImageConfigReader interface {
 	// GetImageConfigs gets the ImageConfig for the fetch value.
 	//
 	// If externalDirOrFilePaths is empty, this builds all files under Buf control.
+	//
+	// This returns no ImageConfigs if ref is a directory with the changed option
+	// and no .proto files changed relative to the git ref.
 	GetImageConfigs(
 		ctx context.Context,
 		container app.EnvStdinContainer,
@@ -63,20 +67,73 @@ func NewImageConfigReader(
 	logger *zap.Logger,
 	storageosProvider storageos.Provider,
 	fetchReader buffetch.Reader,
+	gitLister git.Lister,
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder,
 	moduleFileSetBuilder bufmodulebuild.ModuleFileSetBuilder,
 	imageBuilder bufimagebuild.Builder,
+	options ...ImageConfigReaderOption,
 ) ImageConfigReader {
 	return newImageConfigReader(
 		logger,
 		storageosProvider,
 		fetchReader,
+		gitLister,
 		moduleBucketBuilder,
 		moduleFileSetBuilder,
 		imageBuilder,
+		options...,
 	)
 }
 
+// ImageConfigReaderOption is an option for a new ImageConfigReader.
+type ImageConfigReaderOption func(*imageConfigReader)
+
+// ImageConfigReaderWithLazySourceCodeInfo returns a new ImageConfigReaderOption that
+// builds Images with lazy source code info, see bufimagebuild.WithLazySourceCodeInfo.
+//
+// Callers must call bufimage.LoadSourceCodeInfo for the files that they need the
+// source code info of. This has no effect on Images that are read instead of built.
+func ImageConfigReaderWithLazySourceCodeInfo() ImageConfigReaderOption {
+	return func(imageConfigReader *imageConfigReader) {
+		imageConfigReader.lazySourceCodeInfo = true
+	}
+}
+
+// ImageConfigReaderWithWarnings returns a new ImageConfigReaderOption that passes
+// the compiler warnings of each built module to warningsFunc, see
+// bufimagebuild.WithWarnings.
+func ImageConfigReaderWithWarnings(warningsFunc func([]bufanalysis.FileAnnotation)) ImageConfigReaderOption {
+	return func(imageConfigReader *imageConfigReader) {
+		imageConfigReader.warningsFunc = warningsFunc
+	}
+}
+
+// ImageConfigReaderWithKeepGoing returns a new ImageConfigReaderOption that
+// builds every module of a workspace even if some of the modules fail.
+//
+// The ImageConfigs of the modules that were built are returned along with the
+// FileAnnotations of the modules that were not, instead of only the
+// FileAnnotations. The ImageConfig of each module that was not built is nil,
+// so that the ImageConfigs of two inputs still line up by index. Errors other
+// than FileAnnotations that occur while building a module are returned as
+// FileAnnotations without a FileInfo.
+func ImageConfigReaderWithKeepGoing() ImageConfigReaderOption {
+	return func(imageConfigReader *imageConfigReader) {
+		imageConfigReader.keepGoing = true
+	}
+}
+
+// ImageConfigReaderWithParallelism returns a new ImageConfigReaderOption that
+// builds up to the given number of modules of a workspace at once.
+//
+// The ImageConfigs and FileAnnotations are the same as if the modules were
+// built one at a time. The default is to build one module at a time.
+func ImageConfigReaderWithParallelism(parallelism int) ImageConfigReaderOption {
+	return func(imageConfigReader *imageConfigReader) {
+		imageConfigReader.parallelism = parallelism
+	}
+}
+
 // ModuleConfig is an module and configuration.
 type ModuleConfig interface {
 	Module() bufmodule.Module
@@ -92,6 +149,9 @@ type ModuleConfigReader interface {
 	//
 	// Note that as opposed to ModuleReader, this will return a Module for either
 	// a source or module reference, not just a module reference.
+	//
+	// This returns no ModuleConfigs if sourceOrModuleRef is a directory with the
+	// changed option and no .proto files changed relative to the git ref.
 	GetModuleConfigs(
 		ctx context.Context,
 		container app.EnvStdinContainer,
@@ -108,12 +168,14 @@ func NewModuleConfigReader(
 	logger *zap.Logger,
 	storageosProvider storageos.Provider,
 	fetchReader buffetch.Reader,
+	gitLister git.Lister,
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder,
 ) ModuleConfigReader {
 	return newModuleConfigReader(
 		logger,
 		storageosProvider,
 		fetchReader,
+		gitLister,
 		moduleBucketBuilder,
 	)
 }
@@ -139,6 +201,7 @@ func NewFileLister(
 	logger *zap.Logger,
 	storageosProvider storageos.Provider,
 	fetchReader buffetch.Reader,
+	gitLister git.Lister,
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder,
 	moduleFileSetBuilder bufmodulebuild.ModuleFileSetBuilder,
 	imageBuilder bufimagebuild.Builder,
@@ -147,6 +210,7 @@ func NewFileLister(
 		logger,
 		storageosProvider,
 		fetchReader,
+		gitLister,
 		moduleBucketBuilder,
 		moduleFileSetBuilder,
 		imageBuilder,
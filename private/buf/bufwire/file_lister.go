@@ -17,6 +17,7 @@ package bufwire
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/bufbuild/buf/private/buf/buffetch"
 	"github.com/bufbuild/buf/private/buf/bufwork"
@@ -27,6 +28,7 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/git"
 	"github.com/bufbuild/buf/private/pkg/storage"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
 	"go.uber.org/multierr"
@@ -48,6 +50,7 @@ func newFileLister(
 	logger *zap.Logger,
 	storageosProvider storageos.Provider,
 	fetchReader buffetch.Reader,
+	gitLister git.Lister,
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder,
 	moduleFileSetBuilder bufmodulebuild.ModuleFileSetBuilder,
 	imageBuilder bufimagebuild.Builder,
@@ -65,6 +68,7 @@ func newFileLister(
 			logger,
 			storageosProvider,
 			fetchReader,
+			gitLister,
 			moduleBucketBuilder,
 			moduleFileSetBuilder,
 			imageBuilder,
@@ -262,6 +266,7 @@ func (e *fileLister) sourceFileInfosForDirectory(
 		ctx,
 		mappedReadBucket,
 		config.Build,
+		bufmodulebuild.WithLookupEnv(os.LookupEnv),
 	)
 	if err != nil {
 		return nil, err
@@ -18,6 +18,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/bufbuild/buf/private/buf/buffetch"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
@@ -27,7 +31,11 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
 	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/git"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/thread"
+	"github.com/bufbuild/buf/private/pkg/timing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.uber.org/zap"
@@ -42,17 +50,30 @@ type imageConfigReader struct {
 	imageBuilder         bufimagebuild.Builder
 	moduleConfigReader   *moduleConfigReader
 	imageReader          *imageReader
+	lazySourceCodeInfo   bool
+	keepGoing            bool
+	warningsFunc         func([]bufanalysis.FileAnnotation)
+	warningsLock         sync.Mutex
+	parallelism          int
+}
+
+type moduleImageConfigResult struct {
+	imageConfig     ImageConfig
+	fileAnnotations []bufanalysis.FileAnnotation
+	err             error
 }
 
 func newImageConfigReader(
 	logger *zap.Logger,
 	storageosProvider storageos.Provider,
 	fetchReader buffetch.Reader,
+	gitLister git.Lister,
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder,
 	moduleFileSetBuilder bufmodulebuild.ModuleFileSetBuilder,
 	imageBuilder bufimagebuild.Builder,
+	options ...ImageConfigReaderOption,
 ) *imageConfigReader {
-	return &imageConfigReader{
+	imageConfigReader := &imageConfigReader{
 		logger:               logger.Named("bufwire"),
 		storageosProvider:    storageosProvider,
 		fetchReader:          fetchReader,
@@ -63,6 +84,7 @@ func newImageConfigReader(
 			logger,
 			storageosProvider,
 			fetchReader,
+			gitLister,
 			moduleBucketBuilder,
 		),
 		imageReader: newImageReader(
@@ -70,6 +92,10 @@ func newImageConfigReader(
 			fetchReader,
 		),
 	}
+	for _, option := range options {
+		option(imageConfigReader)
+	}
+	return imageConfigReader
 }
 
 func (i *imageConfigReader) GetImageConfigs(
@@ -132,6 +158,7 @@ func (i *imageConfigReader) getSourceOrModuleImageConfigs(
 	externalDirOrFilePathsAllowNotExist bool,
 	excludeSourceCodeInfo bool,
 ) ([]ImageConfig, []bufanalysis.FileAnnotation, error) {
+	stopTiming := timing.Start(ctx, timing.PhaseFetch)
 	moduleConfigs, err := i.moduleConfigReader.GetModuleConfigs(
 		ctx,
 		container,
@@ -141,49 +168,61 @@ func (i *imageConfigReader) getSourceOrModuleImageConfigs(
 		externalExcludeDirOrFilePaths,
 		externalDirOrFilePathsAllowNotExist,
 	)
+	stopTiming()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(moduleConfigs) == 0 {
+		// The input has the changed option and no .proto files changed.
+		return nil, nil, nil
+	}
+	moduleImageConfigResults, err := i.getModuleImageConfigResults(
+		ctx,
+		moduleConfigs,
+		excludeSourceCodeInfo,
+	)
 	if err != nil {
 		return nil, nil, err
 	}
 	imageConfigs := make([]ImageConfig, 0, len(moduleConfigs))
 	var allFileAnnotations []bufanalysis.FileAnnotation
-	for _, moduleConfig := range moduleConfigs {
-		moduleFileSet, err := i.moduleFileSetBuilder.Build(
-			ctx,
-			moduleConfig.Module(),
-			bufmodulebuild.WithWorkspace(moduleConfig.Workspace()),
-		)
-		if err != nil {
-			return nil, nil, err
-		}
-		targetFileInfos, err := moduleFileSet.TargetFileInfos(ctx)
-		if err != nil {
-			return nil, nil, err
+	var numImageConfigs int
+	for j, moduleImageConfigResult := range moduleImageConfigResults {
+		imageConfig := moduleImageConfigResult.imageConfig
+		fileAnnotations := moduleImageConfigResult.fileAnnotations
+		if err := moduleImageConfigResult.err; err != nil {
+			// This is only set if keepGoing is set.
+			fileAnnotations = []bufanalysis.FileAnnotation{
+				newModuleErrorFileAnnotation(ctx, moduleConfigs[j], err),
+			}
 		}
-		if len(targetFileInfos) == 0 {
-			// This ModuleFileSet doesn't have any targets, so we shouldn't build
-			// an image for it.
+		if imageConfig == nil && len(fileAnnotations) == 0 {
+			// This ModuleFileSet doesn't have any targets, so we didn't
+			// build an image for it.
 			continue
 		}
-		imageConfig, fileAnnotations, err := i.buildModule(
-			ctx,
-			moduleConfig.Config(),
-			moduleFileSet,
-			excludeSourceCodeInfo,
-		)
-		if err != nil {
-			return nil, nil, err
-		}
 		if imageConfig != nil {
+			numImageConfigs++
 			imageConfigs = append(imageConfigs, imageConfig)
+		} else if i.keepGoing {
+			// Keep a placeholder for the module so that the ImageConfigs
+			// line up with those of another input.
+			imageConfigs = append(imageConfigs, nil)
 		}
 		allFileAnnotations = append(allFileAnnotations, fileAnnotations...)
 	}
 	if len(allFileAnnotations) > 0 {
 		// Deduplicate and sort the file annotations again now that we've
 		// consolidated them across multiple images.
-		return nil, bufanalysis.DeduplicateAndSortFileAnnotations(allFileAnnotations), nil
+		allFileAnnotations = bufanalysis.DeduplicateAndSortFileAnnotations(allFileAnnotations)
+		if !i.keepGoing {
+			return nil, allFileAnnotations, nil
+		}
+		if numImageConfigs == 0 {
+			return imageConfigs, allFileAnnotations, nil
+		}
 	}
-	if len(imageConfigs) == 0 {
+	if numImageConfigs == 0 {
 		return nil, nil, errors.New("no .proto target files found")
 	}
 	if protoFileRef, ok := sourceOrModuleRef.(buffetch.ProtoFileRef); ok {
@@ -192,7 +231,131 @@ func (i *imageConfigReader) getSourceOrModuleImageConfigs(
 			return nil, nil, err
 		}
 	}
-	return imageConfigs, nil, nil
+	return imageConfigs, allFileAnnotations, nil
+}
+
+// getModuleImageConfigResults builds the ImageConfig of each module, building
+// up to parallelism modules at once.
+//
+// The results are in the same order as the ModuleConfigs. If keepGoing is not
+// set, the first error is returned instead.
+func (i *imageConfigReader) getModuleImageConfigResults(
+	ctx context.Context,
+	moduleConfigs []ModuleConfig,
+	excludeSourceCodeInfo bool,
+) ([]*moduleImageConfigResult, error) {
+	moduleImageConfigResults := make([]*moduleImageConfigResult, len(moduleConfigs))
+	getModuleImageConfigResult := func(ctx context.Context, index int) *moduleImageConfigResult {
+		start := time.Now()
+		imageConfig, fileAnnotations, err := i.getModuleImageConfig(
+			ctx,
+			moduleConfigs[index],
+			excludeSourceCodeInfo,
+		)
+		if checkedEntry := i.logger.Check(zap.DebugLevel, "module_built"); checkedEntry != nil {
+			checkedEntry.Write(
+				zap.Int("index", index),
+				zap.String("module", getModuleName(ctx, moduleConfigs[index])),
+				zap.Duration("duration", time.Since(start)),
+				zap.Int("file_annotations", len(fileAnnotations)),
+				zap.Error(err),
+			)
+		}
+		return &moduleImageConfigResult{
+			imageConfig:     imageConfig,
+			fileAnnotations: fileAnnotations,
+			err:             err,
+		}
+	}
+	if i.parallelism <= 1 {
+		for index := range moduleConfigs {
+			moduleImageConfigResult := getModuleImageConfigResult(ctx, index)
+			if moduleImageConfigResult.err != nil && !i.keepGoing {
+				return nil, moduleImageConfigResult.err
+			}
+			moduleImageConfigResults[index] = moduleImageConfigResult
+		}
+		return moduleImageConfigResults, nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var firstErr error
+	var lock sync.Mutex
+	jobs := make([]func(context.Context) error, len(moduleConfigs))
+	for index := range moduleConfigs {
+		index := index
+		jobs[index] = func(ctx context.Context) error {
+			moduleImageConfigResult := getModuleImageConfigResult(ctx, index)
+			moduleImageConfigResults[index] = moduleImageConfigResult
+			if moduleImageConfigResult.err != nil && !i.keepGoing {
+				lock.Lock()
+				if firstErr == nil {
+					firstErr = moduleImageConfigResult.err
+				}
+				lock.Unlock()
+				// Do not start building any other module.
+				cancel()
+			}
+			return nil
+		}
+	}
+	if err := thread.Parallelize(
+		ctx,
+		jobs,
+		thread.ParallelizeWithParallelism(i.parallelism),
+	); err != nil {
+		return nil, err
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return moduleImageConfigResults, nil
+}
+
+// getModuleImageConfig builds the ImageConfig for the module.
+//
+// Returns a nil ImageConfig and no FileAnnotations if the module does not
+// have any target files.
+func (i *imageConfigReader) getModuleImageConfig(
+	ctx context.Context,
+	moduleConfig ModuleConfig,
+	excludeSourceCodeInfo bool,
+) (ImageConfig, []bufanalysis.FileAnnotation, error) {
+	buildModuleFileSetOptions := []bufmodulebuild.BuildModuleFileSetOption{
+		bufmodulebuild.WithWorkspace(moduleConfig.Workspace()),
+	}
+	if config := moduleConfig.Config(); config != nil {
+		buildModuleFileSetOptions = append(
+			buildModuleFileSetOptions,
+			bufmodulebuild.WithLicenseConfig(config.Licenses),
+		)
+	}
+	stopTiming := timing.Start(ctx, timing.PhaseFetch)
+	moduleFileSet, err := i.moduleFileSetBuilder.Build(
+		ctx,
+		moduleConfig.Module(),
+		buildModuleFileSetOptions...,
+	)
+	stopTiming()
+	if err != nil {
+		return nil, nil, err
+	}
+	targetFileInfos, err := moduleFileSet.TargetFileInfos(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(targetFileInfos) == 0 {
+		return nil, nil, nil
+	}
+	return i.buildModule(
+		ctx,
+		moduleConfig.Config(),
+		moduleFileSet,
+		excludeSourceCodeInfo,
+	)
 }
 
 func (i *imageConfigReader) getImageImageConfig(
@@ -205,6 +368,7 @@ func (i *imageConfigReader) getImageImageConfig(
 	externalDirOrFilePathsAllowNotExist bool,
 	excludeSourceCodeInfo bool,
 ) (_ ImageConfig, retErr error) {
+	stopTiming := timing.Start(ctx, timing.PhaseFetch)
 	image, err := i.imageReader.GetImage(
 		ctx,
 		container,
@@ -214,6 +378,7 @@ func (i *imageConfigReader) getImageImageConfig(
 		externalDirOrFilePathsAllowNotExist,
 		excludeSourceCodeInfo,
 	)
+	stopTiming()
 	if err != nil {
 		return nil, err
 	}
@@ -246,6 +411,21 @@ func (i *imageConfigReader) buildModule(
 	var options []bufimagebuild.BuildOption
 	if excludeSourceCodeInfo {
 		options = append(options, bufimagebuild.WithExcludeSourceCodeInfo())
+	} else if i.lazySourceCodeInfo {
+		options = append(options, bufimagebuild.WithLazySourceCodeInfo())
+	}
+	if i.warningsFunc != nil {
+		options = append(
+			options,
+			bufimagebuild.WithWarnings(
+				func(fileAnnotations []bufanalysis.FileAnnotation) {
+					// Modules may be built in parallel.
+					i.warningsLock.Lock()
+					defer i.warningsLock.Unlock()
+					i.warningsFunc(fileAnnotations)
+				},
+			),
+		)
 	}
 	image, fileAnnotations, err := i.imageBuilder.Build(
 		ctx,
@@ -263,6 +443,37 @@ func (i *imageConfigReader) buildModule(
 	return newImageConfig(image, config), nil, nil
 }
 
+// getModuleName returns the name of the module for logging and errors, which
+// is its module identity if it has one, and otherwise the directory that
+// contains its files.
+func getModuleName(ctx context.Context, moduleConfig ModuleConfig) string {
+	if config := moduleConfig.Config(); config != nil && config.ModuleIdentity != nil {
+		return config.ModuleIdentity.IdentityString()
+	}
+	sourceFileInfos, err := moduleConfig.Module().SourceFileInfos(ctx)
+	if err != nil || len(sourceFileInfos) == 0 {
+		return ""
+	}
+	// The external path of a file is the directory of the module joined with
+	// the path of the file.
+	externalPath := sourceFileInfos[0].ExternalPath()
+	path := normalpath.Unnormalize(sourceFileInfos[0].Path())
+	if externalPath == path || !strings.HasSuffix(externalPath, path) {
+		return ""
+	}
+	return filepath.Clean(strings.TrimSuffix(externalPath, path))
+}
+
+// newModuleErrorFileAnnotation returns a FileAnnotation without a FileInfo
+// for an error that occurred while building the module.
+func newModuleErrorFileAnnotation(ctx context.Context, moduleConfig ModuleConfig, err error) bufanalysis.FileAnnotation {
+	message := err.Error()
+	if moduleName := getModuleName(ctx, moduleConfig); moduleName != "" {
+		message = fmt.Sprintf("%s: %s", moduleName, message)
+	}
+	return bufanalysis.NewFileAnnotation(nil, 0, 0, 0, 0, "MODULE", message)
+}
+
 // filterImageConfigs takes in image configs and filters them based on the proto file ref.
 // First, we get the package, path, and config for the file ref. And then we merge the images
 // across the ImageConfigs, then filter them based on the paths for the package.
@@ -276,6 +487,10 @@ func filterImageConfigs(imageConfigs []ImageConfig, protoFileRef buffetch.ProtoF
 	var config *bufconfig.Config
 	var images []bufimage.Image
 	for _, imageConfig := range imageConfigs {
+		if imageConfig == nil {
+			// The module failed to build, see ImageConfigReaderWithKeepGoing.
+			continue
+		}
 		for _, imageFile := range imageConfig.Image().Files() {
 			// TODO: Ideally, we have the path returned from PathForExternalPath, however for a protoFileRef,
 			// PathForExternalPath returns only ".", <nil> when matched on the exact path of the proto file
@@ -16,6 +16,7 @@ package bufwire
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/bufbuild/buf/private/buf/buffetch"
@@ -27,6 +28,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 type imageWriter struct {
@@ -66,12 +68,18 @@ func (i *imageWriter) PutImage(
 	}
 	writeImage := image
 	if excludeImports {
+		if imageRef.ImageEncoding() == buffetch.ImageEncodingProtoset {
+			return errors.New("imports cannot be excluded from a protoset, as tools that read protosets require the imports of every file")
+		}
 		writeImage = bufimage.ImageWithoutImports(image)
 	}
 	var message proto.Message
-	if asFileDescriptorSet {
+	switch {
+	case imageRef.ImageEncoding() == buffetch.ImageEncodingProtoset:
+		message = imageToProtoset(writeImage)
+	case asFileDescriptorSet:
 		message = bufimage.ImageToFileDescriptorSet(writeImage)
-	} else {
+	default:
 		message = bufimage.ImageToProtoImage(writeImage)
 	}
 	data, err := i.imageMarshal(ctx, message, image, imageRef.ImageEncoding())
@@ -104,7 +112,7 @@ func (i *imageWriter) imageMarshal(
 		}
 	}()
 	switch imageEncoding {
-	case buffetch.ImageEncodingBin:
+	case buffetch.ImageEncodingBin, buffetch.ImageEncodingProtoset:
 		return protoencoding.NewWireMarshaler().Marshal(message)
 	case buffetch.ImageEncodingJSON:
 		// TODO: verify that image is complete
@@ -121,3 +129,24 @@ func (i *imageWriter) imageMarshal(
 		return nil, fmt.Errorf("unknown image encoding: %v", imageEncoding)
 	}
 }
+
+// imageToProtoset returns the FileDescriptorSet of the Image, as written by
+// protoc with --include_imports.
+//
+// Unknown fields of the FileDescriptorProtos, such as the Buf-specific fields
+// of Images that were read as FileDescriptorProtos, are dropped. Unknown fields
+// within the FileDescriptorProtos, such as unresolved custom options, are kept.
+func imageToProtoset(image bufimage.Image) *descriptorpb.FileDescriptorSet {
+	fileDescriptorProtos := bufimage.ImageToFileDescriptorProtos(image)
+	fileDescriptorSet := &descriptorpb.FileDescriptorSet{
+		File: make([]*descriptorpb.FileDescriptorProto, len(fileDescriptorProtos)),
+	}
+	for i, fileDescriptorProto := range fileDescriptorProtos {
+		if len(fileDescriptorProto.ProtoReflect().GetUnknown()) > 0 {
+			fileDescriptorProto = proto.Clone(fileDescriptorProto).(*descriptorpb.FileDescriptorProto)
+			fileDescriptorProto.ProtoReflect().SetUnknown(nil)
+		}
+		fileDescriptorSet.File[i] = fileDescriptorProto
+	}
+	return fileDescriptorSet
+}
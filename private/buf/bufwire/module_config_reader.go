@@ -18,15 +18,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/bufbuild/buf/private/buf/buffetch"
 	"github.com/bufbuild/buf/private/buf/bufwork"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/buflock"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/git"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/storage"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
@@ -42,6 +45,7 @@ type moduleConfigReader struct {
 	logger              *zap.Logger
 	storageosProvider   storageos.Provider
 	fetchReader         buffetch.Reader
+	gitLister           git.Lister
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder
 	tracer              trace.Tracer
 }
@@ -50,12 +54,14 @@ func newModuleConfigReader(
 	logger *zap.Logger,
 	storageosProvider storageos.Provider,
 	fetchReader buffetch.Reader,
+	gitLister git.Lister,
 	moduleBucketBuilder bufmodulebuild.ModuleBucketBuilder,
 ) *moduleConfigReader {
 	return &moduleConfigReader{
 		logger:              logger,
 		storageosProvider:   storageosProvider,
 		fetchReader:         fetchReader,
+		gitLister:           gitLister,
 		moduleBucketBuilder: moduleBucketBuilder,
 		tracer:              otel.GetTracerProvider().Tracer("bufbuild/buf"),
 	}
@@ -134,6 +140,31 @@ func (m *moduleConfigReader) getSourceModuleConfigs(
 	externalExcludeDirOrFilePaths []string,
 	externalDirOrFilePathsAllowNotExist bool,
 ) (_ []ModuleConfig, retErr error) {
+	if changedGitRef := sourceRef.ChangedGitRef(); changedGitRef != "" {
+		changedExternalDirOrFilePaths, err := m.getChangedExternalDirOrFilePaths(
+			ctx,
+			container,
+			sourceRef,
+			changedGitRef,
+			externalDirOrFilePaths,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if changedExternalDirOrFilePaths != nil {
+			if len(changedExternalDirOrFilePaths) == 0 {
+				m.logger.Debug(
+					"no_proto_files_changed",
+					zap.String("ref", changedGitRef),
+				)
+				return nil, nil
+			}
+			externalDirOrFilePaths = changedExternalDirOrFilePaths
+			// Deleted files are not listed, but the given paths may contain
+			// files that did not change.
+			externalDirOrFilePathsAllowNotExist = true
+		}
+	}
 	readBucketCloser, err := m.fetchReader.GetSourceBucket(ctx, container, sourceRef)
 	if err != nil {
 		return nil, err
@@ -181,6 +212,67 @@ func (m *moduleConfigReader) getSourceModuleConfigs(
 	}, nil
 }
 
+// getChangedExternalDirOrFilePaths returns the external paths of the .proto files
+// of the local directory of the SourceRef that changed relative to the git ref,
+// restricted to the given external paths if any.
+//
+// This returns nil if a configuration file changed, in which case all files
+// are checked, as the changed configuration can affect any file. This returns
+// an empty, non-nil slice if no .proto files changed.
+func (m *moduleConfigReader) getChangedExternalDirOrFilePaths(
+	ctx context.Context,
+	container app.EnvStdinContainer,
+	sourceRef buffetch.SourceRef,
+	changedGitRef string,
+	externalDirOrFilePaths []string,
+) ([]string, error) {
+	dirPath := sourceRef.DirPath()
+	changedPaths, err := m.gitLister.ListChangedFiles(
+		ctx,
+		container,
+		normalpath.Unnormalize(dirPath),
+		changedGitRef,
+	)
+	if err != nil {
+		return nil, err
+	}
+	targetPaths := make(map[string]struct{}, len(externalDirOrFilePaths))
+	for _, externalDirOrFilePath := range externalDirOrFilePaths {
+		targetPath, err := sourceRef.PathForExternalPath(externalDirOrFilePath)
+		if err != nil {
+			return nil, err
+		}
+		targetPaths[targetPath] = struct{}{}
+	}
+	configFileNames := stringutil.SliceToMap(bufconfig.AllConfigFilePaths)
+	for _, configFileName := range bufwork.AllConfigFilePaths {
+		configFileNames[configFileName] = struct{}{}
+	}
+	configFileNames[buflock.ExternalConfigFilePath] = struct{}{}
+	changedExternalDirOrFilePaths := make([]string, 0, len(changedPaths))
+	for _, changedPath := range changedPaths {
+		if _, ok := configFileNames[normalpath.Base(changedPath)]; ok {
+			m.logger.Debug(
+				"configuration_changed",
+				zap.String("path", changedPath),
+				zap.String("ref", changedGitRef),
+			)
+			return nil, nil
+		}
+		if normalpath.Ext(changedPath) != ".proto" {
+			continue
+		}
+		if len(targetPaths) > 0 && !normalpath.MapHasEqualOrContainingPath(targetPaths, changedPath, normalpath.Relative) {
+			continue
+		}
+		changedExternalDirOrFilePaths = append(
+			changedExternalDirOrFilePaths,
+			normalpath.Join(dirPath, changedPath),
+		)
+	}
+	return changedExternalDirOrFilePaths, nil
+}
+
 func (m *moduleConfigReader) getModuleModuleConfig(
 	ctx context.Context,
 	container app.EnvStdinContainer,
@@ -667,6 +759,7 @@ func (m *moduleConfigReader) getModuleConfig(
 		}
 		buildOptions = append(buildOptions, bufmodulebuild.WithExcludePaths(bucketRelPaths))
 	}
+	buildOptions = append(buildOptions, bufmodulebuild.WithLookupEnv(os.LookupEnv))
 	module, err := bufmodulebuild.BuildForBucket(
 		ctx,
 		mappedReadBucket,
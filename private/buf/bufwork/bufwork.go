@@ -67,6 +67,7 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/storage"
 )
@@ -322,11 +323,19 @@ type Config struct {
 	//
 	// Must be non-empty to be a valid configuration.
 	Directories []string
+	// DuplicateStrategy determines how files that exist in both a workspace
+	// module and a dependency are handled.
+	DuplicateStrategy bufmodule.DuplicateStrategy
+	// WellKnownTypesStrategy determines where the well-known types are read from.
+	WellKnownTypesStrategy bufmodule.WellKnownTypesStrategy
 }
 
 // GetConfigForBucket gets the Config for the YAML data at ConfigFilePath.
 //
 // This function expects that there is a valid non-empty configuration in the bucket. Otherwise, this errors.
+//
+// References to environment variables of the form ${KEY} are expanded, see
+// bufconfig.ReadConfigOS for details.
 func GetConfigForBucket(ctx context.Context, readBucket storage.ReadBucket, relativeRootPath string) (*Config, error) {
 	return getConfigForBucket(ctx, readBucket, relativeRootPath)
 }
@@ -355,11 +364,84 @@ func ExistingConfigFilePath(ctx context.Context, readBucket storage.ReadBucket)
 	return "", nil
 }
 
+// WorkspaceModule is a module defined by one of the directories
+// listed in a workspace configuration.
+type WorkspaceModule struct {
+	// Directory is the normalized directory listed in the workspace configuration.
+	Directory string
+	// Config is the module configuration found in the directory.
+	Config *bufconfig.Config
+	// Module is the module built from the directory.
+	Module bufmodule.Module
+}
+
+// GetWorkspaceModules builds the WorkspaceModules for every directory listed in the
+// workspace configuration.
+//
+// The returned WorkspaceModules are sorted by directory.
+func GetWorkspaceModules(
+	ctx context.Context,
+	readBucket storage.ReadBucket,
+	relativeRootPath string,
+	workspaceConfig *Config,
+) ([]*WorkspaceModule, error) {
+	return getWorkspaceModules(ctx, readBucket, relativeRootPath, workspaceConfig)
+}
+
+// WorkspaceImport is an import statement in one workspace module that refers
+// to a file defined in the same or another workspace module.
+type WorkspaceImport struct {
+	// FileInfo is the file that contains the import statement.
+	FileInfo bufmoduleref.FileInfo
+	// Line is the line of the import statement.
+	Line int
+	// Column is the column of the import statement.
+	Column int
+	// FromDirectory is the workspace directory of the importing file.
+	FromDirectory string
+	// ToDirectory is the workspace directory of the imported file.
+	ToDirectory string
+	// ImportPath is the path of the imported file.
+	ImportPath string
+}
+
+// GetWorkspaceImports gets all of the imports between the given WorkspaceModules.
+//
+// Imports of files within the same module, or of files that are not defined
+// in the workspace, are not included.
+func GetWorkspaceImports(
+	ctx context.Context,
+	workspaceModules []*WorkspaceModule,
+) ([]*WorkspaceImport, error) {
+	return getWorkspaceImports(ctx, workspaceModules)
+}
+
+// GetWorkspaceFileImports gets all of the imports between files defined in the given
+// WorkspaceModules, including imports of files within the same module.
+//
+// Imports of files that are not defined in the workspace are not included.
+func GetWorkspaceFileImports(
+	ctx context.Context,
+	workspaceModules []*WorkspaceModule,
+) ([]*WorkspaceImport, error) {
+	return getWorkspaceFileImports(ctx, workspaceModules)
+}
+
+// GetWorkspaceGraph returns a map from each workspace directory to the sorted
+// workspace directories that it imports from.
+//
+// Directories that do not import from any other workspace directory are not included.
+func GetWorkspaceGraph(workspaceImports []*WorkspaceImport) map[string][]string {
+	return getWorkspaceGraph(workspaceImports)
+}
+
 // ExternalConfigV1 represents the on-disk representation
 // of the workspace configuration at version v1.
 type ExternalConfigV1 struct {
-	Version     string   `json:"version,omitempty" yaml:"version,omitempty"`
-	Directories []string `json:"directories,omitempty" yaml:"directories,omitempty"`
+	Version                string   `json:"version,omitempty" yaml:"version,omitempty"`
+	Directories            []string `json:"directories,omitempty" yaml:"directories,omitempty"`
+	DuplicateStrategy      string   `json:"duplicate_strategy,omitempty" yaml:"duplicate_strategy,omitempty"`
+	WellKnownTypesStrategy string   `json:"well_known_types,omitempty" yaml:"well_known_types,omitempty"`
 }
 
 type externalConfigVersion struct {
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
 )
@@ -53,8 +54,18 @@ func newConfigV1(externalConfig ExternalConfigV1, workspaceID string) (*Config,
 	if err := validateConfigurationOverlap(directories, workspaceID); err != nil {
 		return nil, err
 	}
+	duplicateStrategy, err := bufmodule.ParseDuplicateStrategy(externalConfig.DuplicateStrategy)
+	if err != nil {
+		return nil, fmt.Errorf("duplicate_strategy listed in %s is invalid: %w", workspaceID, err)
+	}
+	wellKnownTypesStrategy, err := bufmodule.ParseWellKnownTypesStrategy(externalConfig.WellKnownTypesStrategy)
+	if err != nil {
+		return nil, fmt.Errorf("well_known_types listed in %s is invalid: %w", workspaceID, err)
+	}
 	return &Config{
-		Directories: directories,
+		Directories:            directories,
+		DuplicateStrategy:      duplicateStrategy,
+		WellKnownTypesStrategy: wellKnownTypesStrategy,
 	}, nil
 }
 
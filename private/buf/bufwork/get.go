@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 
 	"github.com/bufbuild/buf/private/pkg/encoding"
@@ -77,6 +78,7 @@ func getConfigForBucket(ctx context.Context, readBucket storage.ReadBucket, rela
 			workspaceID,
 			data,
 			readObjectCloser.ExternalPath(),
+			os.LookupEnv,
 		)
 	default:
 		return nil, fmt.Errorf("only one workspace file can exist but found multiple workspace files: %s", stringutil.SliceToString(foundConfigFilePaths))
@@ -93,6 +95,7 @@ func getConfigForData(ctx context.Context, data []byte) (*Config, error) {
 		"configuration data",
 		data,
 		"Configuration data",
+		nil,
 	)
 	if err != nil {
 		span.RecordError(err)
@@ -108,7 +111,15 @@ func getConfigForDataInternal(
 	workspaceID string,
 	data []byte,
 	id string,
+	lookupEnv func(string) (string, bool),
 ) (*Config, error) {
+	if lookupEnv != nil {
+		var err error
+		data, err = encoding.ExpandYAMLEnv(data, lookupEnv)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+	}
 	var externalConfigVersion externalConfigVersion
 	if err := unmarshalNonStrict(data, &externalConfigVersion); err != nil {
 		return nil, err
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
@@ -147,7 +148,7 @@ func (w *workspaceBuilder) BuildWorkspace(
 			ctx,
 			readBucketForDirectory,
 			moduleConfig.Build,
-			buildOptions...,
+			append(buildOptions, bufmodulebuild.WithLookupEnv(os.LookupEnv))...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf(
@@ -182,6 +183,8 @@ func (w *workspaceBuilder) BuildWorkspace(
 	return bufmodule.NewWorkspace(
 		namedModules,
 		allModules,
+		bufmodule.WorkspaceWithDuplicateStrategy(workspaceConfig.DuplicateStrategy),
+		bufmodule.WorkspaceWithWellKnownTypesStrategy(workspaceConfig.WellKnownTypesStrategy),
 	), nil
 }
 
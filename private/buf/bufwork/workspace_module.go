@@ -0,0 +1,203 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufwork
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"go.uber.org/multierr"
+)
+
+func getWorkspaceModules(
+	ctx context.Context,
+	readBucket storage.ReadBucket,
+	relativeRootPath string,
+	workspaceConfig *Config,
+) ([]*WorkspaceModule, error) {
+	workspaceID := filepath.Join(normalpath.Unnormalize(relativeRootPath), ExternalConfigV1FilePath)
+	workspaceModules := make([]*WorkspaceModule, 0, len(workspaceConfig.Directories))
+	for _, directory := range workspaceConfig.Directories {
+		readBucketForDirectory := storage.MapReadBucket(readBucket, storage.MapOnPrefix(directory))
+		if err := validateWorkspaceDirectoryNonEmpty(ctx, readBucketForDirectory, directory, workspaceID); err != nil {
+			return nil, err
+		}
+		moduleConfig, err := bufconfig.ReadConfigOS(
+			ctx,
+			readBucketForDirectory,
+			bufconfig.ReadConfigOSWithOverride(""),
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				`failed to get module config for directory "%s" listed in %s: %w`,
+				normalpath.Unnormalize(directory),
+				workspaceID,
+				err,
+			)
+		}
+		module, err := bufmodulebuild.BuildForBucket(
+			ctx,
+			readBucketForDirectory,
+			moduleConfig.Build,
+			bufmodulebuild.WithModuleIdentity(moduleConfig.ModuleIdentity),
+			bufmodulebuild.WithLookupEnv(os.LookupEnv),
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				`failed to initialize module for directory "%s" listed in %s: %w`,
+				normalpath.Unnormalize(directory),
+				workspaceID,
+				err,
+			)
+		}
+		workspaceModules = append(
+			workspaceModules,
+			&WorkspaceModule{
+				Directory: directory,
+				Config:    moduleConfig,
+				Module:    module,
+			},
+		)
+	}
+	return workspaceModules, nil
+}
+
+func getWorkspaceImports(
+	ctx context.Context,
+	workspaceModules []*WorkspaceModule,
+) ([]*WorkspaceImport, error) {
+	fileImports, err := getWorkspaceFileImports(ctx, workspaceModules)
+	if err != nil {
+		return nil, err
+	}
+	var workspaceImports []*WorkspaceImport
+	for _, fileImport := range fileImports {
+		// Imports within the same module are not inter-module imports.
+		if fileImport.FromDirectory != fileImport.ToDirectory {
+			workspaceImports = append(workspaceImports, fileImport)
+		}
+	}
+	return workspaceImports, nil
+}
+
+func getWorkspaceFileImports(
+	ctx context.Context,
+	workspaceModules []*WorkspaceModule,
+) ([]*WorkspaceImport, error) {
+	pathToDirectory := make(map[string]string)
+	for _, workspaceModule := range workspaceModules {
+		fileInfos, err := workspaceModule.Module.SourceFileInfos(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileInfo := range fileInfos {
+			pathToDirectory[fileInfo.Path()] = workspaceModule.Directory
+		}
+	}
+	var workspaceImports []*WorkspaceImport
+	for _, workspaceModule := range workspaceModules {
+		fileInfos, err := workspaceModule.Module.SourceFileInfos(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileInfo := range fileInfos {
+			fileImports, err := getFileImports(ctx, workspaceModule.Module, fileInfo)
+			if err != nil {
+				return nil, err
+			}
+			for _, fileImport := range fileImports {
+				toDirectory, ok := pathToDirectory[fileImport.ImportPath]
+				if !ok {
+					// Imports of files outside of the workspace.
+					continue
+				}
+				fileImport.FromDirectory = workspaceModule.Directory
+				fileImport.ToDirectory = toDirectory
+				workspaceImports = append(workspaceImports, fileImport)
+			}
+		}
+	}
+	return workspaceImports, nil
+}
+
+func getFileImports(
+	ctx context.Context,
+	module bufmodule.Module,
+	fileInfo bufmoduleref.FileInfo,
+) (_ []*WorkspaceImport, retErr error) {
+	moduleFile, err := module.GetModuleFile(ctx, fileInfo.Path())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, moduleFile.Close())
+	}()
+	fileNode, err := parser.Parse(moduleFile.ExternalPath(), moduleFile, reporter.NewHandler(nil))
+	if err != nil {
+		return nil, err
+	}
+	var workspaceImports []*WorkspaceImport
+	for _, decl := range fileNode.Decls {
+		importNode, ok := decl.(*ast.ImportNode)
+		if !ok {
+			continue
+		}
+		start := fileNode.NodeInfo(importNode).Start()
+		workspaceImports = append(
+			workspaceImports,
+			&WorkspaceImport{
+				FileInfo:   fileInfo,
+				Line:       start.Line,
+				Column:     start.Col,
+				ImportPath: importNode.Name.AsString(),
+			},
+		)
+	}
+	return workspaceImports, nil
+}
+
+func getWorkspaceGraph(workspaceImports []*WorkspaceImport) map[string][]string {
+	directoryToDependencySet := make(map[string]map[string]struct{})
+	for _, workspaceImport := range workspaceImports {
+		dependencySet, ok := directoryToDependencySet[workspaceImport.FromDirectory]
+		if !ok {
+			dependencySet = make(map[string]struct{})
+			directoryToDependencySet[workspaceImport.FromDirectory] = dependencySet
+		}
+		dependencySet[workspaceImport.ToDirectory] = struct{}{}
+	}
+	directoryToDependencies := make(map[string][]string, len(directoryToDependencySet))
+	for directory, dependencySet := range directoryToDependencySet {
+		dependencies := make([]string, 0, len(dependencySet))
+		for dependency := range dependencySet {
+			dependencies = append(dependencies, dependency)
+		}
+		sort.Strings(dependencies)
+		directoryToDependencies[directory] = dependencies
+	}
+	return directoryToDependencies
+}
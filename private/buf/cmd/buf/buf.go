@@ -26,8 +26,29 @@ import (
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/alpha/registry/token/tokenget"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/alpha/registry/token/tokenlist"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/alpha/workspace/workspacepush"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/deprecations/deprecationsreport"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/docs/docsrules"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/events/eventstail"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/extensions/extensionsverify"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/fieldnumbers/fieldnumbersreport"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/generatesize"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/githook/githookinstall"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/graph"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/image/imagemerge"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/imports/importsinlinepublic"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/imports/importsreport"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/manifest/manifestdiff"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/migratev1beta1"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/mirror/mirrorsync"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/mock/mockserve"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/new/newservice"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/owners"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/price"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/proxy"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/query"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/refactor/refactormovepackage"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/refactor/refactorrename"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/reflect/reflectserve"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/commit/commitget"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/commit/commitlist"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/draft/draftdelete"
@@ -44,8 +65,10 @@ import (
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/repository/repositorydeprecate"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/repository/repositoryget"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/repository/repositorylist"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/repository/repositorymove"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/repository/repositoryundeprecate"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/repository/repositoryupdate"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/sdk/sdkresolve"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/tag/tagcreate"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/tag/taglist"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/template/templatecreate"
@@ -56,10 +79,20 @@ import (
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/webhook/webhookcreate"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/webhook/webhookdelete"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/registry/webhook/webhooklist"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/routes"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/rpc/rpcrecord"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/rpc/rpcreplay"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/sbom"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/stats"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/studioagent"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/verify"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/beta/verifyoptions"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/breaking"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/build"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/config/configexplain"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/config/configlint"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/config/configmigrate"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/config/configset"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/convert"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/curl"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/export"
@@ -73,10 +106,28 @@ import (
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/mod/modlslintrules"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/mod/modopen"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/mod/modprune"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/mod/modtidy"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/mod/modupdate"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/push"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/doc/docdownload"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/label/labelcreate"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/label/labellist"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/label/labelmove"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/machineaccount/machineaccountcreate"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/machineaccount/machineaccountrotate"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/module/moduledownload"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/registrylogin"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/registrylogout"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/registrywhoami"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/repository/repositoryaccess/repositoryaccessadd"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/repository/repositoryaccess/repositoryaccesslist"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/repository/repositoryaccess/repositoryaccessremove"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/tag/tagannotate"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/user/userinfo"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/registry/user/userlist"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/workspace/workspacegraph"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/workspace/workspacels"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/workspace/workspaceverify"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
 )
@@ -90,14 +141,28 @@ func Main(name string) {
 //
 // This is public for use in testing.
 func NewRootCommand(name string) *appcmd.Command {
+	debugHTTPBuilderOption := appflag.BuilderWithBoolEnvFlag(
+		bufcli.DebugHTTPFlagName,
+		bufcli.DebugHTTPEnvKey,
+		"Log the metadata and durations of the requests to the BSR and their retries. Credentials are redacted",
+	)
+	noPromptBuilderOption := appflag.BuilderWithBoolEnvFlag(
+		bufcli.NoPromptFlagName,
+		bufcli.NoPromptEnvKey,
+		"Never prompt for credentials when a remote input or the BSR requires authentication, and fail with an authentication error instead. Credentials are only prompted for if stdin is a terminal",
+	)
 	builder := appflag.NewBuilder(
 		name,
 		appflag.BuilderWithTimeout(120*time.Second),
 		appflag.BuilderWithTracing(),
+		debugHTTPBuilderOption,
+		noPromptBuilderOption,
 	)
 	noTimeoutBuilder := appflag.NewBuilder(
 		name,
 		appflag.BuilderWithTracing(),
+		debugHTTPBuilderOption,
+		noPromptBuilderOption,
 	)
 	globalFlags := bufcli.NewGlobalFlags()
 	return &appcmd.Command{
@@ -105,6 +170,7 @@ func NewRootCommand(name string) *appcmd.Command {
 		Short:               "The Buf CLI",
 		Long:                "A tool for working with Protocol Buffers and managing resources on the Buf Schema Registry (BSR)",
 		Version:             bufcli.Version,
+		VersionInfo:         bufcli.GetVersionInfo,
 		BindPersistentFlags: appcmd.BindMultiple(builder.BindRoot, globalFlags.BindRoot),
 		SubCommands: []*appcmd.Command{
 			build.NewCommand("build", builder),
@@ -123,6 +189,7 @@ func NewRootCommand(name string) *appcmd.Command {
 				SubCommands: []*appcmd.Command{
 					modinit.NewCommand("init", builder),
 					modprune.NewCommand("prune", builder),
+					modtidy.NewCommand("tidy", builder),
 					modupdate.NewCommand("update", builder),
 					modopen.NewCommand("open", builder),
 					modclearcache.NewCommand("clear-cache", builder, "cc"),
@@ -130,12 +197,93 @@ func NewRootCommand(name string) *appcmd.Command {
 					modlsbreakingrules.NewCommand("ls-breaking-rules", builder),
 				},
 			},
+			{
+				Use:   "config",
+				Short: "Work with configuration files",
+				SubCommands: []*appcmd.Command{
+					configmigrate.NewCommand("migrate", builder),
+					configlint.NewCommand("lint", builder),
+					configexplain.NewCommand("explain", builder),
+					configset.NewCommand("set", builder),
+				},
+			},
+			{
+				Use:   "workspace",
+				Short: "Manage workspaces",
+				SubCommands: []*appcmd.Command{
+					workspacels.NewCommand("ls", builder),
+					workspaceverify.NewCommand("verify", builder),
+					workspacegraph.NewCommand("graph", builder),
+				},
+			},
 			{
 				Use:   "registry",
 				Short: "Manage assets on the Buf Schema Registry",
 				SubCommands: []*appcmd.Command{
 					registrylogin.NewCommand("login", builder),
 					registrylogout.NewCommand("logout", builder),
+					registrywhoami.NewCommand("whoami", builder),
+					{
+						Use:   "doc",
+						Short: "Manage module documentation",
+						SubCommands: []*appcmd.Command{
+							docdownload.NewCommand("download", builder),
+						},
+					},
+					{
+						Use:   "label",
+						Short: "Manage a repository's labels",
+						SubCommands: []*appcmd.Command{
+							labelcreate.NewCommand("create", builder),
+							labelmove.NewCommand("move", builder),
+							labellist.NewCommand("list", builder),
+						},
+					},
+					{
+						Use:   "tag",
+						Short: "Manage a repository's tags",
+						SubCommands: []*appcmd.Command{
+							tagannotate.NewCommand("annotate", builder),
+						},
+					},
+					{
+						Use:   "module",
+						Short: "Manage modules",
+						SubCommands: []*appcmd.Command{
+							moduledownload.NewCommand("download", builder),
+						},
+					},
+					{
+						Use:   "user",
+						Short: "Manage users",
+						SubCommands: []*appcmd.Command{
+							userinfo.NewCommand("info", builder),
+							userlist.NewCommand("list", builder),
+						},
+					},
+					{
+						Use:   "machine-account",
+						Short: "Manage machine accounts",
+						SubCommands: []*appcmd.Command{
+							machineaccountcreate.NewCommand("create", builder),
+							machineaccountrotate.NewCommand("rotate", builder),
+						},
+					},
+					{
+						Use:   "repository",
+						Short: "Manage repositories",
+						SubCommands: []*appcmd.Command{
+							{
+								Use:   "access",
+								Short: "Manage the roles of users in a repository",
+								SubCommands: []*appcmd.Command{
+									repositoryaccessadd.NewCommand("add", builder),
+									repositoryaccessremove.NewCommand("remove", builder),
+									repositoryaccesslist.NewCommand("list", builder),
+								},
+							},
+						},
+					},
 				},
 			},
 			{
@@ -144,8 +292,117 @@ func NewRootCommand(name string) *appcmd.Command {
 				SubCommands: []*appcmd.Command{
 					price.NewCommand("price", builder),
 					stats.NewCommand("stats", builder),
+					sbom.NewCommand("sbom", builder),
+					verify.NewCommand("verify", builder),
+					verifyoptions.NewCommand("verify-options", builder),
+					generatesize.NewCommand("generate-size", builder),
+					graph.NewCommand("graph", builder),
 					migratev1beta1.NewCommand("migrate-v1beta1", builder),
 					studioagent.NewCommand("studio-agent", noTimeoutBuilder),
+					proxy.NewCommand("proxy", noTimeoutBuilder),
+					owners.NewCommand("owners", builder),
+					query.NewCommand("query", builder),
+					routes.NewCommand("routes", builder),
+					{
+						Use:   "deprecations",
+						Short: "Track deprecated fields and RPCs",
+						SubCommands: []*appcmd.Command{
+							deprecationsreport.NewCommand("report", builder),
+						},
+					},
+					{
+						Use:   "docs",
+						Short: "Generate documentation",
+						SubCommands: []*appcmd.Command{
+							docsrules.NewCommand("rules", builder),
+						},
+					},
+					{
+						Use:   "events",
+						Short: "Follow the events of BSR repositories",
+						SubCommands: []*appcmd.Command{
+							eventstail.NewCommand("tail", noTimeoutBuilder),
+						},
+					},
+					{
+						Use:   "extensions",
+						Short: "Manage extension declarations",
+						SubCommands: []*appcmd.Command{
+							extensionsverify.NewCommand("verify", builder),
+						},
+					},
+					{
+						Use:   "field-numbers",
+						Short: "Work with field numbers",
+						SubCommands: []*appcmd.Command{
+							fieldnumbersreport.NewCommand("report", builder),
+						},
+					},
+					{
+						Use:   "githook",
+						Short: "Manage git hooks that run buf",
+						SubCommands: []*appcmd.Command{
+							githookinstall.NewCommand("install", builder),
+						},
+					},
+					{
+						Use:   "image",
+						Short: "Work with Buf images",
+						SubCommands: []*appcmd.Command{
+							imagemerge.NewCommand("merge", builder),
+						},
+					},
+					{
+						Use:   "imports",
+						Short: "Audit public and weak imports",
+						SubCommands: []*appcmd.Command{
+							importsinlinepublic.NewCommand("inline-public", builder),
+							importsreport.NewCommand("report", builder),
+						},
+					},
+					{
+						Use:   "manifest",
+						Short: "Work with module manifests",
+						SubCommands: []*appcmd.Command{
+							manifestdiff.NewCommand("diff", builder),
+						},
+					},
+					{
+						Use:   "mirror",
+						Short: "Manage local mirrors of modules",
+						SubCommands: []*appcmd.Command{
+							mirrorsync.NewCommand("sync", builder),
+						},
+					},
+					{
+						Use:   "mock",
+						Short: "Serve mocks of services",
+						SubCommands: []*appcmd.Command{
+							mockserve.NewCommand("serve", noTimeoutBuilder),
+						},
+					},
+					{
+						Use:   "new",
+						Short: "Create new modules from templates",
+						SubCommands: []*appcmd.Command{
+							newservice.NewCommand("service", builder),
+						},
+					},
+					{
+						Use:   "refactor",
+						Short: "Refactor modules and workspaces",
+						SubCommands: []*appcmd.Command{
+							refactormovepackage.NewCommand("move-package", builder),
+							refactorrename.NewCommand("rename", builder),
+						},
+					},
+					{
+						Use:   "reflect",
+						Short: "Serve the gRPC server reflection API",
+						SubCommands: []*appcmd.Command{
+							reflectserve.NewCommand("serve", noTimeoutBuilder),
+						},
+					},
 					{
 						Use:   "registry",
 						Short: "Manage assets on the Buf Schema Registry",
@@ -170,6 +427,7 @@ func NewRootCommand(name string) *appcmd.Command {
 									repositorydeprecate.NewCommand("deprecate", builder),
 									repositoryundeprecate.NewCommand("undeprecate", builder),
 									repositoryupdate.NewCommand("update", builder),
+									repositorymove.NewCommand("move", builder),
 								},
 							},
 							{
@@ -212,6 +470,13 @@ func NewRootCommand(name string) *appcmd.Command {
 									},
 								},
 							},
+							{
+								Use:   "sdk",
+								Short: "Resolve generated SDKs on the Buf Schema Registry",
+								SubCommands: []*appcmd.Command{
+									sdkresolve.NewCommand("resolve", builder),
+								},
+							},
 							{
 								Use:   "template",
 								Short: "Manage Protobuf templates on the Buf Schema Registry",
@@ -240,6 +505,14 @@ func NewRootCommand(name string) *appcmd.Command {
 							},
 						},
 					},
+					{
+						Use:   "rpc",
+						Short: "Record and replay RPCs",
+						SubCommands: []*appcmd.Command{
+							rpcrecord.NewCommand("record", noTimeoutBuilder),
+							rpcreplay.NewCommand("replay", builder),
+						},
+					},
 				},
 			},
 			{
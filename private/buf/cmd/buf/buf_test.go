@@ -17,16 +17,22 @@ package buf
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/internal/internaltesting"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd/appcmdtesting"
 	"github.com/bufbuild/buf/private/pkg/command"
@@ -35,6 +41,8 @@ import (
 	"github.com/bufbuild/buf/private/pkg/storage/storagetesting"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var convertTestDataDir = filepath.Join("command", "convert", "testdata", "convert")
@@ -242,6 +250,683 @@ func TestFail6(t *testing.T) {
 	)
 }
 
+func TestLintReportUnused(t *testing.T) {
+	t.Parallel()
+	// testdata/fail has lint failures, but no unused imports or dependencies
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"lint",
+		filepath.Join("testdata", "fail"),
+		"--report-unused",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"lint",
+		filepath.Join("testdata", "fail"),
+		"--report-unused",
+		"--report-ignores",
+	)
+}
+
+func TestLintExcept(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`testdata/fail/buf/buf.proto:3:1:Files with package "other" must be within a directory "other" relative to root but were in directory "buf".`),
+		"lint",
+		filepath.Join("testdata", "fail"),
+		"--except",
+		"FIELD_LOWER_SNAKE_CASE",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"lint",
+		filepath.Join("testdata", "fail"),
+		"--except",
+		"FIELD_LOWER_SNAKE_CASE",
+		"--except",
+		"PACKAGE_DIRECTORY_MATCH",
+	)
+}
+
+func TestLintChanged(t *testing.T) {
+	t.Parallel()
+	dirPath := t.TempDir()
+	testRunGit(t, dirPath, "init", "--quiet")
+	testRunGit(t, dirPath, "config", "user.email", "tests@buf.build")
+	testRunGit(t, dirPath, "config", "user.name", "Buf go tests")
+	writeFile := func(path string, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dirPath, path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dirPath, path), []byte(content), 0600))
+	}
+	writeFile("buf.yaml", "version: v1\nlint:\n  use:\n    - FIELD_LOWER_SNAKE_CASE\n")
+	writeFile("a.proto", "syntax = \"proto3\";\n\nmessage A {\n  string fooBar = 1;\n}\n")
+	writeFile("foo/b.proto", "syntax = \"proto3\";\n\nmessage B {\n  string fooBar = 1;\n}\n")
+	testRunGit(t, dirPath, "add", ".")
+	testRunGit(t, dirPath, "commit", "--quiet", "-m", "initial")
+	aAnnotation := filepath.Join(dirPath, "a.proto") + `:4:10:Field name "fooBar" should be lower_snake_case, such as "foo_bar".`
+	bAnnotation := filepath.Join(dirPath, "foo", "b.proto") + `:4:10:Field name "fooBar" should be lower_snake_case, such as "foo_bar".`
+	cAnnotation := filepath.Join(dirPath, "foo", "c.proto") + `:4:10:Field name "fooBar" should be lower_snake_case, such as "foo_bar".`
+
+	// Nothing changed, so there is nothing to check.
+	testRunStdoutStderr(
+		t,
+		nil,
+		0,
+		"",
+		"",
+		"lint",
+		dirPath+"#changed=HEAD",
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		0,
+		"",
+		"",
+		"build",
+		dirPath+"#changed=HEAD",
+	)
+
+	// A modified file and an untracked file.
+	writeFile("foo/b.proto", "syntax = \"proto3\";\n\nmessage B {\n  string fooBar = 1;\n  string bar = 2;\n}\n")
+	writeFile("foo/c.proto", "syntax = \"proto3\";\n\nmessage C {\n  string fooBar = 1;\n}\n")
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		bAnnotation+"\n"+cAnnotation,
+		"lint",
+		dirPath+"#changed=HEAD",
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		cAnnotation,
+		"lint",
+		dirPath+"#changed=HEAD",
+		"--path",
+		filepath.Join(dirPath, "foo", "c.proto"),
+	)
+
+	// A changed configuration file checks all files.
+	writeFile("buf.yaml", "version: v1\nlint:\n  use:\n    - FIELD_LOWER_SNAKE_CASE\n  allow_comment_ignores: true\n")
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		aAnnotation+"\n"+bAnnotation+"\n"+cAnnotation,
+		"lint",
+		dirPath+"#changed=HEAD",
+	)
+}
+
+func TestLintShard(t *testing.T) {
+	t.Parallel()
+	annotation := func(name string) string {
+		return filepath.FromSlash(`testdata/shard/acme/`+name+`/v1/`+name+`.proto`) +
+			`:6:10:Field name "` + name + `Name" should be lower_snake_case, such as "` + name + `_name".`
+	}
+	const inputFingerprint = "8c571628536a3a4899a76cee2bbc89993744c2622581c0fd4ba23e619c17763d"
+	testRunStdoutStderr(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		annotation("pet"),
+		"Shard 1/2: 1 of 4 packages, input fingerprint "+inputFingerprint+", shard fingerprint 9bfe4da42b78cc545cb672d38b134f9f92354b9536491ada1af3f5a58681553d",
+		"lint",
+		filepath.Join("testdata", "shard"),
+		"--shard",
+		"1/2",
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		annotation("order")+"\n"+annotation("store")+"\n"+annotation("user"),
+		"Shard 2/2: 3 of 4 packages, input fingerprint "+inputFingerprint+", shard fingerprint 175f2553c15908f794b79a715841e43d22f2517ccfccaf3c43630ccbe6303741",
+		"lint",
+		filepath.Join("testdata", "shard"),
+		"--shard",
+		"2/2",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"breaking",
+		filepath.Join("testdata", "shard"),
+		"--against",
+		filepath.Join("testdata", "shard"),
+		"--shard",
+		"2/2",
+	)
+}
+
+func TestRemoteInputNoPrompt(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseWriter.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+		responseWriter.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+	// The netrc is read from $HOME.
+	homeDirPath := t.TempDir()
+	appcmdtesting.RunCommandExitCodeStdoutStderr(
+		t,
+		func(use string) *appcmd.Command { return NewRootCommand(use) },
+		1,
+		``,
+		fmt.Sprintf(
+			`Failure: %s requires authentication: got HTTP status code 401. Create a new entry in your netrc for the remote, or set BUF_INPUT_HTTPS_USERNAME and BUF_INPUT_HTTPS_PASSWORD. Credentials are only prompted for if stdin is a terminal and --no-prompt is not set`,
+			strings.TrimPrefix(server.URL, "http://"),
+		),
+		func(use string) map[string]string {
+			env := internaltesting.NewEnvFunc(t)(use)
+			env["HOME"] = homeDirPath
+			return env
+		},
+		nil,
+		"build",
+		server.URL+"/image.bin",
+		"--no-prompt",
+	)
+}
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`{"num_files":1,"num_packages":1,"num_files_with_syntax_errors":0,"num_messages":1,"num_fields":3,"num_enums":0,"num_enum_values":0,"num_extensions":0,"num_services":0,"num_methods":0,"num_deprecated_fields":2,"num_lint_ignore_comments":1,"num_breaking_ignore_comments":1,"num_lint_config_ignores":2,"num_breaking_config_exceptions":1}`,
+		"beta",
+		"stats",
+		filepath.Join("testdata", "stats"),
+		"--format",
+		"json",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"beta",
+		"stats",
+		filepath.Join("testdata", "stats"),
+		"--format",
+		"yaml",
+	)
+}
+
+func TestStatsPushGateway(t *testing.T) {
+	t.Parallel()
+	var (
+		method      string
+		path        string
+		contentType string
+		body        []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		method = request.Method
+		path = request.URL.Path
+		contentType = request.Header.Get("Content-Type")
+		var err error
+		body, err = io.ReadAll(request.Body)
+		if err != nil {
+			responseWriter.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		responseWriter.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	stdout := bytes.NewBuffer(nil)
+	testRun(
+		t,
+		0,
+		nil,
+		stdout,
+		"beta",
+		"stats",
+		filepath.Join("testdata", "stats"),
+		"--format",
+		"prometheus",
+		"--push-gateway",
+		server.URL,
+	)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/metrics/job/buf_stats", path)
+	assert.Equal(t, "text/plain; version=0.0.4", contentType)
+	assert.Equal(t, stdout.String(), string(body))
+	assert.Contains(t, string(body), "# TYPE buf_stats_deprecated_fields gauge\nbuf_stats_deprecated_fields 2\n")
+	assert.Contains(t, string(body), "buf_stats_lint_config_ignores 2\n")
+	assert.Contains(t, string(body), "buf_stats_breaking_config_exceptions 1\n")
+}
+
+func TestRoutes(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`[{"http_method":"POST","path":"/v1/books","rpc":"a.BookService.CreateBook","kind":"google.api.http","body":"book","file":"testdata/routes/a/a.proto","line":15},{"http_method":"GET","path":"/v1/{name=books/*}","rpc":"a.BookService.GetBook","kind":"google.api.http","file":"testdata/routes/a/a.proto","line":8},{"http_method":"GET","path":"/v1/{name=shelves/*/books/*}","rpc":"a.BookService.GetBook","kind":"google.api.http","file":"testdata/routes/a/a.proto","line":8}]`,
+		"beta",
+		"routes",
+		filepath.Join("testdata", "routes"),
+		"--format",
+		"json",
+		"--connect=false",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`
+		http_method,path,rpc,kind,body,response_body,file,line
+		POST,/a.BookService/CreateBook,a.BookService.CreateBook,connect,,,testdata/routes/a/a.proto,15
+		GET,/a.BookService/GetBook,a.BookService.GetBook,connect,,,testdata/routes/a/a.proto,8
+		POST,/a.BookService/GetBook,a.BookService.GetBook,connect,,,testdata/routes/a/a.proto,8
+		POST,/a.BookService/WatchBooks,a.BookService.WatchBooks,connect,,,testdata/routes/a/a.proto,21
+		POST,/v1/books,a.BookService.CreateBook,google.api.http,book,,testdata/routes/a/a.proto,15
+		GET,/v1/{name=books/*},a.BookService.GetBook,google.api.http,,,testdata/routes/a/a.proto,8
+		GET,/v1/{name=shelves/*/books/*},a.BookService.GetBook,google.api.http,,,testdata/routes/a/a.proto,8
+		`,
+		"beta",
+		"routes",
+		filepath.Join("testdata", "routes"),
+		"--format",
+		"csv",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"beta",
+		"routes",
+		filepath.Join("testdata", "routes"),
+		"--format",
+		"yaml",
+	)
+}
+
+func TestImports(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`[{"file":"testdata/imports/b/b.proto","line":5,"kind":"public","import":"a/a.proto","dependents":["c/c.proto"]},{"file":"testdata/imports/w/w.proto","line":5,"kind":"weak","import":"a/a.proto"}]`,
+		"beta",
+		"imports",
+		"report",
+		filepath.Join("testdata", "imports"),
+		"--format",
+		"json",
+	)
+	dirPath := copyTestdataDir(t, filepath.Join("testdata", "imports"))
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"beta",
+		"imports",
+		"inline-public",
+		dirPath,
+		"-w",
+	)
+	data, err := os.ReadFile(filepath.Join(dirPath, "c", "c.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "import \"b/b.proto\";\nimport \"a/a.proto\";\n")
+	testRunStdout(
+		t,
+		nil,
+		0,
+		fmt.Sprintf(
+			`[{"file":%q,"line":5,"kind":"public","import":"a/a.proto"},{"file":%q,"line":5,"kind":"weak","import":"a/a.proto"}]`,
+			filepath.Join(dirPath, "b", "b.proto"),
+			filepath.Join(dirPath, "w", "w.proto"),
+		),
+		"beta",
+		"imports",
+		"report",
+		dirPath,
+		"--format",
+		"json",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"beta",
+		"imports",
+		"inline-public",
+		filepath.Join("testdata", "imports", "c", "c.proto"),
+	)
+}
+
+func TestRefactorMovePackage(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`Move package acme.billing.v1 from buf.build/acme/platform to buf.build/acme/billing.
+
+Files to move:
+  acme/billing/v1/billing.proto
+  acme/billing/v1/money.proto
+
+Imports that resolve to buf.build/acme/billing after the move:
+  acme/order/v1/order.proto:5: acme/billing/v1/billing.proto
+
+Steps:
+  1. Create the module buf.build/acme/billing with the moved files, keeping their paths.
+  2. Push buf.build/acme/billing.
+  3. Add buf.build/acme/billing to the deps of buf.build/acme/platform.
+  4. Run "buf mod update" in buf.build/acme/platform.
+  5. Remove the moved files from buf.build/acme/platform.
+  6. Push buf.build/acme/platform.
+  7. Add buf.build/acme/billing to the deps of the modules that import the moved files from buf.build/acme/platform.`,
+		"beta",
+		"refactor",
+		"move-package",
+		"acme.billing.v1",
+		filepath.Join("testdata", "refactormovepackage"),
+		"--to",
+		"buf.build/acme/billing",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"beta",
+		"refactor",
+		"move-package",
+		"acme.unknown.v1",
+		filepath.Join("testdata", "refactormovepackage"),
+		"--to",
+		"buf.build/acme/billing",
+	)
+	dirPath := copyTestdataDir(t, filepath.Join("testdata", "refactormovepackage"))
+	outputDirPath := filepath.Join(t.TempDir(), "billing")
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`Move package acme.order.v1 from buf.build/acme/platform to buf.build/acme/order.
+
+Files to move:
+  acme/order/v1/order.proto
+
+Imports that resolve to buf.build/acme/platform after the move:
+  acme/order/v1/order.proto:5: acme/billing/v1/billing.proto
+
+Steps:
+  1. Create the module buf.build/acme/order with the moved files, keeping their paths.
+  2. Remove the moved files from buf.build/acme/platform.
+  3. Push buf.build/acme/platform.
+  4. Add buf.build/acme/platform to the deps of buf.build/acme/order.
+  5. Run "buf mod update" in buf.build/acme/order.
+  6. Push buf.build/acme/order.
+  7. Add buf.build/acme/order to the deps of the modules that import the moved files from buf.build/acme/platform.`,
+		"beta",
+		"refactor",
+		"move-package",
+		"acme.order.v1",
+		dirPath,
+		"--to",
+		"buf.build/acme/order",
+		"--apply",
+		"--output",
+		outputDirPath,
+	)
+	assert.NoFileExists(t, filepath.Join(dirPath, "acme", "order", "v1", "order.proto"))
+	assert.FileExists(t, filepath.Join(outputDirPath, "acme", "order", "v1", "order.proto"))
+	data, err := os.ReadFile(filepath.Join(outputDirPath, "buf.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "version: v1\nname: buf.build/acme/order\ndeps:\n  - buf.build/acme/platform\n", string(data))
+	data, err = os.ReadFile(filepath.Join(dirPath, "buf.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "version: v1\nname: buf.build/acme/platform\n", string(data))
+}
+
+func TestRefactorRename(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`Rename field acme.shop.v1.Cart.order to acme.shop.v1.Cart.current_order.
+
+No breaking changes are reported by the configured breaking rules.
+diff -u testdata/refactorrename/shop/acme/shop/v1/shop.proto.orig testdata/refactorrename/shop/acme/shop/v1/shop.proto
+--- testdata/refactorrename/shop/acme/shop/v1/shop.proto.orig
++++ testdata/refactorrename/shop/acme/shop/v1/shop.proto
+@@ -5,7 +5,8 @@
+ import "acme/v1/order.proto";
+ 
+ message Cart {
+-  acme.v1.Order order = 1;
++  acme.v1.Order current_order = 1;
++  reserved "order";
+   .acme.v1.Order.Item last_item = 2;
+   map<string, acme.v1.Order> orders = 3;
+ }`,
+		"beta",
+		"refactor",
+		"rename",
+		"acme.shop.v1.Cart.order",
+		filepath.Join("testdata", "refactorrename"),
+		"--to",
+		"current_order",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		``,
+		"beta",
+		"refactor",
+		"rename",
+		"acme.v1.Order",
+		filepath.Join("testdata", "refactorrename"),
+		"--to",
+		"Status",
+	)
+	dirPath := copyTestdataDir(t, filepath.Join("testdata", "refactorrename"))
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`Rename message acme.v1.Order to acme.v1.Purchase.
+
+Breaking changes reported by the configured breaking rules:
+  acme/shop/v1/shop.proto: FIELD_SAME_TYPE: Field "1" on message "Cart" changes type from "acme.v1.Order" to "acme.v1.Purchase".
+  acme/shop/v1/shop.proto: FIELD_SAME_TYPE: Field "3" on message "Cart" changes type from "acme.v1.Order" to "acme.v1.Purchase".
+  acme/v1/order.proto: MESSAGE_NO_DELETE: Previously present message "Order" is deleted from file "acme/v1/order.proto".
+  acme/shop/v1/shop.proto: RPC_SAME_RESPONSE_TYPE: RPC "Checkout" on service "ShopService" changes response type from "acme.v1.Order" to "acme.v1.Purchase".`,
+		"beta",
+		"refactor",
+		"rename",
+		"acme.v1.Order",
+		dirPath,
+		"--to",
+		"Purchase",
+		"-w",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`Rename field acme.v1.Purchase.amount_off to acme.v1.Purchase.fixed_off.
+
+Breaking changes reported by the configured breaking rules:
+  acme/v1/order.proto: FIELD_SAME_JSON_NAME: Field "4" on message "Purchase" changes option "json_name" from "amountOff" to "fixedOff".
+  acme/v1/order.proto: FIELD_SAME_NAME: Field "4" on message "Purchase" changes name from "amount_off" to "fixed_off".`,
+		"beta",
+		"refactor",
+		"rename",
+		"acme.v1.Purchase.amount_off",
+		dirPath,
+		"--to",
+		"fixed_off",
+		"-w",
+	)
+	data, err := os.ReadFile(filepath.Join(dirPath, "order", "acme", "v1", "order.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "message Purchase {\n")
+	assert.Contains(t, string(data), "  map<string, Purchase.Item> items_by_sku = 3;\n")
+	assert.Contains(t, string(data), "    int64 fixed_off = 4;\n    int32 percent_off = 5;\n  }\n  reserved \"amount_off\";\n")
+	data, err = os.ReadFile(filepath.Join(dirPath, "shop", "acme", "shop", "v1", "shop.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "  .acme.v1.Purchase.Item last_item = 2;\n")
+	assert.Contains(t, string(data), "  rpc Checkout(Cart) returns (acme.v1.Purchase);\n")
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"build",
+		dirPath,
+	)
+}
+
+func TestNewService(t *testing.T) {
+	t.Parallel()
+	outputDirPath := filepath.Join(t.TempDir(), "payments")
+	testRunStdoutStderr(
+		t,
+		nil,
+		0,
+		``,
+		fmt.Sprintf(`Created buf.example.com/acme/payment-gateway in %s`, outputDirPath),
+		"beta",
+		"new",
+		"service",
+		"acme/payment-gateway",
+		"--template",
+		filepath.Join("testdata", "new", "template"),
+		"--output",
+		outputDirPath,
+	)
+	data, err := os.ReadFile(filepath.Join(outputDirPath, "buf.yaml"))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`version: v1
+name: buf.example.com/acme/payment-gateway
+breaking:
+  use:
+    - WIRE_JSON
+lint:
+  use:
+    - DEFAULT
+`,
+		string(data),
+	)
+	data, err = os.ReadFile(filepath.Join(outputDirPath, "acme", "payment_gateway", "v1", "payment_gateway.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "package acme.payment_gateway.v1;")
+	assert.Contains(t, string(data), `option go_package = "github.com/acme/apis/gen/go/acme/payment_gateway/v1;payment_gatewayv1";`)
+	assert.Contains(t, string(data), "service PaymentGatewayService {")
+	data, err = os.ReadFile(filepath.Join(outputDirPath, "buf.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# PaymentGateway\n\nThe acme.payment_gateway.v1 API.\n", string(data))
+	_, err = os.Stat(filepath.Join(outputDirPath, "buf.lock"))
+	assert.True(t, os.IsNotExist(err))
+	testRunStdout(t, nil, 0, ``, "lint", outputDirPath)
+	// The output directory must be empty.
+	testRunStdoutStderr(
+		t,
+		nil,
+		1,
+		``,
+		fmt.Sprintf(`Failure: output directory %q is not empty`, outputDirPath),
+		"beta",
+		"new",
+		"service",
+		"acme/payment-gateway",
+		"--template",
+		filepath.Join("testdata", "new", "template"),
+		"--output",
+		outputDirPath,
+	)
+}
+
+func TestBuildWarnings(t *testing.T) {
+	t.Parallel()
+	// testdata/warnings has an unused import
+	testRunStdoutStderr(
+		t,
+		nil,
+		0,
+		"",
+		"",
+		"build",
+		filepath.Join("testdata", "warnings"),
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		0,
+		"",
+		filepath.FromSlash(`testdata/warnings/a.proto:5:1:warning: import "b.proto" not used`),
+		"build",
+		filepath.Join("testdata", "warnings"),
+		"--warnings",
+		"print",
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		"",
+		filepath.FromSlash(`testdata/warnings/a.proto:5:1:warning: import "b.proto" not used`),
+		"build",
+		filepath.Join("testdata", "warnings"),
+		"--warnings",
+		"error",
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		0,
+		"",
+		"",
+		"build",
+		filepath.Join("testdata", "warnings"),
+		"--warnings",
+		"ignore",
+	)
+	testRunStdout(
+		t,
+		nil,
+		1,
+		"",
+		"build",
+		filepath.Join("testdata", "warnings"),
+		"--warnings",
+		"fail",
+	)
+}
+
 func TestFail7(t *testing.T) {
 	t.Parallel()
 	testRunStdout(
@@ -531,6 +1216,13 @@ COMMENT_RPC                       COMMENTS                 Checks that RPCs have
 COMMENT_SERVICE                   COMMENTS                 Checks that services have non-empty comments.
 RPC_NO_CLIENT_STREAMING           UNARY_RPC                Checks that RPCs are not client streaming.
 RPC_NO_SERVER_STREAMING           UNARY_RPC                Checks that RPCs are not server streaming.
+HTTP_BODY                         HTTP                     Checks that google.api.http body and response_body refer to top-level fields of the request and response.
+HTTP_PATH_SYNTAX                  HTTP                     Checks that google.api.http annotations have valid path templates.
+HTTP_PATH_VARIABLES               HTTP                     Checks that google.api.http path variables refer to singular fields of the request.
+HTTP_ROUTE_UNIQUE                 HTTP                     Checks that google.api.http routes are unique across all services.
+DEPENDENCY_USED                                            Checks that all dependencies declared in buf.yaml are imported.
+EXTENSION_DECLARATION_VALID                                Checks that extension declarations are valid and extensions match the declarations of their extendee.
+IMPORT_NO_PUBLIC_FORWARD                                   Checks that types are imported from the files that define them rather than forwarded by public imports.
 PACKAGE_NO_IMPORT_CYCLE                                    Checks that packages do not have import cycles.
 		`
 	testRunStdout(
@@ -623,64 +1315,71 @@ ENUM_FIRST_VALUE_ZERO             OTHER                                       Ch
 func TestCheckLsBreakingRules1(t *testing.T) {
 	t.Parallel()
 	expectedStdout := `
-ID                                              CATEGORIES                      PURPOSE
-ENUM_NO_DELETE                                  FILE                            Checks that enums are not deleted from a given file.
-FILE_NO_DELETE                                  FILE                            Checks that files are not deleted.
-MESSAGE_NO_DELETE                               FILE                            Checks that messages are not deleted from a given file.
-SERVICE_NO_DELETE                               FILE                            Checks that services are not deleted from a given file.
-ENUM_VALUE_NO_DELETE                            FILE, PACKAGE                   Checks that enum values are not deleted from a given enum.
-EXTENSION_MESSAGE_NO_DELETE                     FILE, PACKAGE                   Checks that extension ranges are not deleted from a given message.
-FIELD_NO_DELETE                                 FILE, PACKAGE                   Checks that fields are not deleted from a given message.
-FIELD_SAME_CTYPE                                FILE, PACKAGE                   Checks that fields have the same value for the ctype option.
-FIELD_SAME_JSTYPE                               FILE, PACKAGE                   Checks that fields have the same value for the jstype option.
-FIELD_SAME_TYPE                                 FILE, PACKAGE                   Checks that fields have the same types in a given message.
-FILE_SAME_CC_ENABLE_ARENAS                      FILE, PACKAGE                   Checks that files have the same value for the cc_enable_arenas option.
-FILE_SAME_CC_GENERIC_SERVICES                   FILE, PACKAGE                   Checks that files have the same value for the cc_generic_services option.
-FILE_SAME_CSHARP_NAMESPACE                      FILE, PACKAGE                   Checks that files have the same value for the csharp_namespace option.
-FILE_SAME_GO_PACKAGE                            FILE, PACKAGE                   Checks that files have the same value for the go_package option.
-FILE_SAME_JAVA_GENERIC_SERVICES                 FILE, PACKAGE                   Checks that files have the same value for the java_generic_services option.
-FILE_SAME_JAVA_MULTIPLE_FILES                   FILE, PACKAGE                   Checks that files have the same value for the java_multiple_files option.
-FILE_SAME_JAVA_OUTER_CLASSNAME                  FILE, PACKAGE                   Checks that files have the same value for the java_outer_classname option.
-FILE_SAME_JAVA_PACKAGE                          FILE, PACKAGE                   Checks that files have the same value for the java_package option.
-FILE_SAME_JAVA_STRING_CHECK_UTF8                FILE, PACKAGE                   Checks that files have the same value for the java_string_check_utf8 option.
-FILE_SAME_OBJC_CLASS_PREFIX                     FILE, PACKAGE                   Checks that files have the same value for the objc_class_prefix option.
-FILE_SAME_OPTIMIZE_FOR                          FILE, PACKAGE                   Checks that files have the same value for the optimize_for option.
-FILE_SAME_PHP_CLASS_PREFIX                      FILE, PACKAGE                   Checks that files have the same value for the php_class_prefix option.
-FILE_SAME_PHP_GENERIC_SERVICES                  FILE, PACKAGE                   Checks that files have the same value for the php_generic_services option.
-FILE_SAME_PHP_METADATA_NAMESPACE                FILE, PACKAGE                   Checks that files have the same value for the php_metadata_namespace option.
-FILE_SAME_PHP_NAMESPACE                         FILE, PACKAGE                   Checks that files have the same value for the php_namespace option.
-FILE_SAME_PY_GENERIC_SERVICES                   FILE, PACKAGE                   Checks that files have the same value for the py_generic_services option.
-FILE_SAME_RUBY_PACKAGE                          FILE, PACKAGE                   Checks that files have the same value for the ruby_package option.
-FILE_SAME_SWIFT_PREFIX                          FILE, PACKAGE                   Checks that files have the same value for the swift_prefix option.
-FILE_SAME_SYNTAX                                FILE, PACKAGE                   Checks that files have the same syntax.
-MESSAGE_NO_REMOVE_STANDARD_DESCRIPTOR_ACCESSOR  FILE, PACKAGE                   Checks that messages do not change the no_standard_descriptor_accessor option from false or unset to true.
-ONEOF_NO_DELETE                                 FILE, PACKAGE                   Checks that oneofs are not deleted from a given message.
-RPC_NO_DELETE                                   FILE, PACKAGE                   Checks that rpcs are not deleted from a given service.
-ENUM_VALUE_SAME_NAME                            FILE, PACKAGE, WIRE_JSON        Checks that enum values have the same name.
-FIELD_SAME_JSON_NAME                            FILE, PACKAGE, WIRE_JSON        Checks that fields have the same value for the json_name option.
-FIELD_SAME_NAME                                 FILE, PACKAGE, WIRE_JSON        Checks that fields have the same names in a given message.
-FIELD_SAME_LABEL                                FILE, PACKAGE, WIRE_JSON, WIRE  Checks that fields have the same labels in a given message.
-FIELD_SAME_ONEOF                                FILE, PACKAGE, WIRE_JSON, WIRE  Checks that fields have the same oneofs in a given message.
-FILE_SAME_PACKAGE                               FILE, PACKAGE, WIRE_JSON, WIRE  Checks that files have the same package.
-MESSAGE_SAME_MESSAGE_SET_WIRE_FORMAT            FILE, PACKAGE, WIRE_JSON, WIRE  Checks that messages have the same value for the message_set_wire_format option.
-MESSAGE_SAME_REQUIRED_FIELDS                    FILE, PACKAGE, WIRE_JSON, WIRE  Checks that messages have no added or deleted required fields.
-RESERVED_ENUM_NO_DELETE                         FILE, PACKAGE, WIRE_JSON, WIRE  Checks that reserved ranges and names are not deleted from a given enum.
-RESERVED_MESSAGE_NO_DELETE                      FILE, PACKAGE, WIRE_JSON, WIRE  Checks that reserved ranges and names are not deleted from a given message.
-RPC_SAME_CLIENT_STREAMING                       FILE, PACKAGE, WIRE_JSON, WIRE  Checks that rpcs have the same client streaming value.
-RPC_SAME_IDEMPOTENCY_LEVEL                      FILE, PACKAGE, WIRE_JSON, WIRE  Checks that rpcs have the same value for the idempotency_level option.
-RPC_SAME_REQUEST_TYPE                           FILE, PACKAGE, WIRE_JSON, WIRE  Checks that rpcs are have the same request type.
-RPC_SAME_RESPONSE_TYPE                          FILE, PACKAGE, WIRE_JSON, WIRE  Checks that rpcs are have the same response type.
-RPC_SAME_SERVER_STREAMING                       FILE, PACKAGE, WIRE_JSON, WIRE  Checks that rpcs have the same server streaming value.
-PACKAGE_ENUM_NO_DELETE                          PACKAGE                         Checks that enums are not deleted from a given package.
-PACKAGE_MESSAGE_NO_DELETE                       PACKAGE                         Checks that messages are not deleted from a given package.
-PACKAGE_NO_DELETE                               PACKAGE                         Checks that packages are not deleted.
-PACKAGE_SERVICE_NO_DELETE                       PACKAGE                         Checks that services are not deleted from a given package.
-ENUM_VALUE_NO_DELETE_UNLESS_NAME_RESERVED       WIRE_JSON                       Checks that enum values are not deleted from a given enum unless the name is reserved.
-FIELD_NO_DELETE_UNLESS_NAME_RESERVED            WIRE_JSON                       Checks that fields are not deleted from a given message unless the name is reserved.
-FIELD_WIRE_JSON_COMPATIBLE_TYPE                 WIRE_JSON                       Checks that fields have wire and JSON compatible types in a given message.
-ENUM_VALUE_NO_DELETE_UNLESS_NUMBER_RESERVED     WIRE_JSON, WIRE                 Checks that enum values are not deleted from a given enum unless the number is reserved.
-FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED          WIRE_JSON, WIRE                 Checks that fields are not deleted from a given message unless the number is reserved.
-FIELD_WIRE_COMPATIBLE_TYPE                      WIRE                            Checks that fields have wire-compatible types in a given message.
+ID                                              CATEGORIES                            PURPOSE
+ENUM_NO_DELETE                                  FILE                                  Checks that enums are not deleted from a given file.
+FILE_NO_DELETE                                  FILE                                  Checks that files are not deleted.
+MESSAGE_NO_DELETE                               FILE                                  Checks that messages are not deleted from a given file.
+SERVICE_NO_DELETE                               FILE                                  Checks that services are not deleted from a given file.
+ENUM_VALUE_NO_DELETE                            FILE, PACKAGE                         Checks that enum values are not deleted from a given enum.
+EXTENSION_MESSAGE_NO_DELETE                     FILE, PACKAGE                         Checks that extension ranges are not deleted from a given message.
+FIELD_NO_DELETE                                 FILE, PACKAGE                         Checks that fields are not deleted from a given message.
+FIELD_SAME_CTYPE                                FILE, PACKAGE                         Checks that fields have the same value for the ctype option.
+FIELD_SAME_JSTYPE                               FILE, PACKAGE                         Checks that fields have the same value for the jstype option.
+FIELD_SAME_TYPE                                 FILE, PACKAGE                         Checks that fields have the same types in a given message.
+FILE_SAME_CC_ENABLE_ARENAS                      FILE, PACKAGE                         Checks that files have the same value for the cc_enable_arenas option.
+FILE_SAME_CC_GENERIC_SERVICES                   FILE, PACKAGE                         Checks that files have the same value for the cc_generic_services option.
+FILE_SAME_CSHARP_NAMESPACE                      FILE, PACKAGE                         Checks that files have the same value for the csharp_namespace option.
+FILE_SAME_GO_PACKAGE                            FILE, PACKAGE                         Checks that files have the same value for the go_package option.
+FILE_SAME_JAVA_GENERIC_SERVICES                 FILE, PACKAGE                         Checks that files have the same value for the java_generic_services option.
+FILE_SAME_JAVA_MULTIPLE_FILES                   FILE, PACKAGE                         Checks that files have the same value for the java_multiple_files option.
+FILE_SAME_JAVA_OUTER_CLASSNAME                  FILE, PACKAGE                         Checks that files have the same value for the java_outer_classname option.
+FILE_SAME_JAVA_PACKAGE                          FILE, PACKAGE                         Checks that files have the same value for the java_package option.
+FILE_SAME_JAVA_STRING_CHECK_UTF8                FILE, PACKAGE                         Checks that files have the same value for the java_string_check_utf8 option.
+FILE_SAME_OBJC_CLASS_PREFIX                     FILE, PACKAGE                         Checks that files have the same value for the objc_class_prefix option.
+FILE_SAME_OPTIMIZE_FOR                          FILE, PACKAGE                         Checks that files have the same value for the optimize_for option.
+FILE_SAME_PHP_CLASS_PREFIX                      FILE, PACKAGE                         Checks that files have the same value for the php_class_prefix option.
+FILE_SAME_PHP_GENERIC_SERVICES                  FILE, PACKAGE                         Checks that files have the same value for the php_generic_services option.
+FILE_SAME_PHP_METADATA_NAMESPACE                FILE, PACKAGE                         Checks that files have the same value for the php_metadata_namespace option.
+FILE_SAME_PHP_NAMESPACE                         FILE, PACKAGE                         Checks that files have the same value for the php_namespace option.
+FILE_SAME_PY_GENERIC_SERVICES                   FILE, PACKAGE                         Checks that files have the same value for the py_generic_services option.
+FILE_SAME_RUBY_PACKAGE                          FILE, PACKAGE                         Checks that files have the same value for the ruby_package option.
+FILE_SAME_SWIFT_PREFIX                          FILE, PACKAGE                         Checks that files have the same value for the swift_prefix option.
+FILE_SAME_SYNTAX                                FILE, PACKAGE                         Checks that files have the same syntax.
+MESSAGE_NO_REMOVE_STANDARD_DESCRIPTOR_ACCESSOR  FILE, PACKAGE                         Checks that messages do not change the no_standard_descriptor_accessor option from false or unset to true.
+ONEOF_NO_DELETE                                 FILE, PACKAGE                         Checks that oneofs are not deleted from a given message.
+RPC_NO_DELETE                                   FILE, PACKAGE                         Checks that rpcs are not deleted from a given service.
+FIELD_SAME_NAME                                 FILE, PACKAGE, WIRE_JSON              Checks that fields have the same names in a given message.
+ENUM_VALUE_SAME_NAME                            FILE, PACKAGE, WIRE_JSON, JSON        Checks that enum values have the same name.
+FIELD_SAME_JSON_NAME                            FILE, PACKAGE, WIRE_JSON, JSON        Checks that fields have the same value for the json_name option.
+MESSAGE_SAME_MESSAGE_SET_WIRE_FORMAT            FILE, PACKAGE, WIRE_JSON, WIRE        Checks that messages have the same value for the message_set_wire_format option.
+APPEND_ONLY                                     FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that messages and enums marked append-only by an option only have fields and values added (options are configurable).
+FIELD_SAME_LABEL                                FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that fields have the same labels in a given message.
+FIELD_SAME_ONEOF                                FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that fields have the same oneofs in a given message.
+FILE_SAME_PACKAGE                               FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that files have the same package.
+FROZEN                                          FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that messages and enums marked frozen by an option are not changed (options are configurable).
+MESSAGE_SAME_REQUIRED_FIELDS                    FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that messages have no added or deleted required fields.
+RESERVED_ENUM_NO_DELETE                         FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that reserved ranges and names are not deleted from a given enum.
+RESERVED_MESSAGE_NO_DELETE                      FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that reserved ranges and names are not deleted from a given message.
+RPC_SAME_CLIENT_STREAMING                       FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that rpcs have the same client streaming value.
+RPC_SAME_IDEMPOTENCY_LEVEL                      FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that rpcs have the same value for the idempotency_level option.
+RPC_SAME_REQUEST_TYPE                           FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that rpcs are have the same request type.
+RPC_SAME_RESPONSE_TYPE                          FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that rpcs are have the same response type.
+RPC_SAME_SERVER_STREAMING                       FILE, PACKAGE, WIRE_JSON, WIRE, JSON  Checks that rpcs have the same server streaming value.
+PACKAGE_ENUM_NO_DELETE                          PACKAGE                               Checks that enums are not deleted from a given package.
+PACKAGE_MESSAGE_NO_DELETE                       PACKAGE                               Checks that messages are not deleted from a given package.
+PACKAGE_NO_DELETE                               PACKAGE                               Checks that packages are not deleted.
+PACKAGE_SERVICE_NO_DELETE                       PACKAGE                               Checks that services are not deleted from a given package.
+FIELD_WIRE_JSON_COMPATIBLE_TYPE                 WIRE_JSON                             Checks that fields have wire and JSON compatible types in a given message.
+ENUM_VALUE_NO_DELETE_UNLESS_NAME_RESERVED       WIRE_JSON, JSON                       Checks that enum values are not deleted from a given enum unless the name is reserved.
+FIELD_NO_DELETE_UNLESS_NAME_RESERVED            WIRE_JSON, JSON                       Checks that fields are not deleted from a given message unless the name is reserved.
+ENUM_VALUE_NO_DELETE_UNLESS_NUMBER_RESERVED     WIRE_JSON, WIRE                       Checks that enum values are not deleted from a given enum unless the number is reserved.
+FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED          WIRE_JSON, WIRE                       Checks that fields are not deleted from a given message unless the number is reserved.
+FIELD_WIRE_COMPATIBLE_TYPE                      WIRE                                  Checks that fields have wire-compatible types in a given message.
+FIELD_JSON_COMPATIBLE_TYPE                      JSON                                  Checks that fields have JSON compatible types in a given message.
+FIELD_NO_NEW_PROTOVALIDATE_CONSTRAINTS          PROTOVALIDATE                         Checks that fields do not have new protovalidate constraints.
+FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS      PROTOVALIDATE                         Checks that fields do not have tighter protovalidate constraints.
+EXTENSION_DECLARATION_NO_DELETE                                                       Checks that extension declarations are not deleted from a given message.
+EXTENSION_DECLARATION_SAME_EXTENSION                                                  Checks that extension declarations are not changed to a different extension, and reserved extension declarations are not reused.
 		`
 	testRunStdout(
 		t,
@@ -1036,6 +1735,96 @@ func TestLsFilesImage5(t *testing.T) {
 	)
 }
 
+func TestBuildDebugImports(t *testing.T) {
+	t.Parallel()
+	dirPath := filepath.Join("testdata", "workspace", "success", "wkt")
+	aPath := filepath.Join(dirPath, "proto", "a", "a.proto")
+	bPath := filepath.Join(dirPath, "proto", "b", "b.proto")
+	cPath := filepath.Join(dirPath, "other", "proto", "c", "c.proto")
+	wktLine := func(path string) string {
+		return path + `: import "google/protobuf/empty.proto" resolved to google/protobuf/empty.proto (well-known type bundled with buf)`
+	}
+	testRunStdoutStderr(
+		t,
+		nil,
+		0,
+		"",
+		strings.Join(
+			[]string{
+				wktLine(bPath),
+				aPath + `: import "b/b.proto" resolved to ` + bPath + ` (local directory)`,
+				wktLine(aPath),
+				cPath + `: import "a/a.proto" resolved to ` + aPath + ` (local directory)`,
+				wktLine(cPath),
+			},
+			"\n",
+		),
+		"build",
+		dirPath,
+		"--debug-imports",
+	)
+}
+
+func TestBuildProtoset(t *testing.T) {
+	t.Parallel()
+	dirPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dirPath, "buf.yaml"), []byte("version: v1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dirPath, "a.proto"), []byte(`syntax = "proto3";
+
+package a;
+
+import "b.proto";
+import "google/protobuf/timestamp.proto";
+
+message A {
+  b.B b = 1;
+  google.protobuf.Timestamp time = 2;
+}
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dirPath, "b.proto"), []byte(`syntax = "proto3";
+
+package b;
+
+message B {}
+`), 0600))
+	// The protoset is the same as a FileDescriptorSet with imports, regardless
+	// of the other flags.
+	for _, flags := range [][]string{
+		nil,
+		{"--as-file-descriptor-set"},
+		{"--exclude-source-info"},
+		{"--as-file-descriptor-set", "--exclude-source-info"},
+	} {
+		protosetStdout := bytes.NewBuffer(nil)
+		testRun(t, 0, nil, protosetStdout, append([]string{"build", dirPath, "-o", "-#format=protoset"}, flags...)...)
+		fileDescriptorSetStdout := bytes.NewBuffer(nil)
+		testRun(t, 0, nil, fileDescriptorSetStdout, append([]string{"build", dirPath, "-o", "-", "--as-file-descriptor-set"}, flags...)...)
+		assert.Equal(t, fileDescriptorSetStdout.Bytes(), protosetStdout.Bytes(), flags)
+		fileDescriptorSet := &descriptorpb.FileDescriptorSet{}
+		require.NoError(t, proto.Unmarshal(protosetStdout.Bytes(), fileDescriptorSet))
+		var fileNames []string
+		for _, fileDescriptorProto := range fileDescriptorSet.File {
+			assert.Empty(t, fileDescriptorProto.ProtoReflect().GetUnknown())
+			fileNames = append(fileNames, fileDescriptorProto.GetName())
+		}
+		assert.Equal(t, []string{"b.proto", "google/protobuf/timestamp.proto", "a.proto"}, fileNames)
+	}
+	// Images read as input are written without their Buf-specific fields.
+	imageStdout := bytes.NewBuffer(nil)
+	testRun(t, 0, nil, imageStdout, "build", dirPath, "-o", "-")
+	protosetStdout := bytes.NewBuffer(nil)
+	testRun(t, 0, imageStdout, protosetStdout, "build", "-", "-o", "-#format=protoset")
+	fileDescriptorSet := &descriptorpb.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(protosetStdout.Bytes(), fileDescriptorSet))
+	require.Len(t, fileDescriptorSet.File, 3)
+	for _, fileDescriptorProto := range fileDescriptorSet.File {
+		assert.Empty(t, fileDescriptorProto.ProtoReflect().GetUnknown())
+	}
+	// Protosets can be read as input.
+	testRunStdout(t, protosetStdout, 0, "a.proto\nb.proto\ngoogle/protobuf/timestamp.proto", "ls-files", "-#format=protoset")
+	testRun(t, 1, nil, nil, "build", dirPath, "-o", "-#format=protoset", "--exclude-imports")
+}
+
 func TestBuildFailProtoFileRefWithPathFlag(t *testing.T) {
 	t.Parallel()
 	testRunStdoutStderr(
@@ -1157,11 +1946,165 @@ breaking:
     - FILE
 lint:
   use:
-    - DEFAULT
+    - DEFAULT
+`,
+		false,
+		"",
+	)
+}
+
+func TestDeprecationsReport(t *testing.T) {
+	t.Parallel()
+	testRunStdoutStderr(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`[{"name":"a.Foo.one","kind":"field","path":"testdata/deprecations/a.proto","line":7,"replacement":"a.Foo.two","remove_by":"2020-01-01","status":"past_due"},{"name":"a.Foo.three","kind":"field","path":"testdata/deprecations/a.proto","line":10,"replacement":"a.Foo.two","remove_by":"2999-12-31","status":"ok"}]`),
+		filepath.FromSlash(`testdata/deprecations/a.proto:7:3:Deprecated field "a.Foo.one" was due to be removed by 2020-01-01, use "a.Foo.two" instead.`),
+		"beta",
+		"deprecations",
+		"report",
+		filepath.Join("testdata", "deprecations"),
+		"--format",
+		"json",
+	)
+}
+
+func TestDocsRules(t *testing.T) {
+	t.Parallel()
+	outputFilePath := filepath.Join(t.TempDir(), "rules.md")
+	testRun(t, 0, nil, bytes.NewBuffer(nil), "beta", "docs", "rules", "--config", `{"version":"v1"}`, "-o", outputFilePath)
+	data, err := os.ReadFile(outputFilePath)
+	require.NoError(t, err)
+	content := string(data)
+	require.True(t, strings.HasPrefix(content, "# Rules\n"))
+	require.Contains(t, content, "## Lint rules\n")
+	require.Contains(t, content, "## Breaking rules\n")
+	require.Contains(
+		t,
+		content,
+		`### ENUM_NO_ALLOW_ALIAS
+
+Checks that enums do not have the allow_alias option set.
+
+Categories: `+"`BASIC`, `DEFAULT`"+`
+
+Example:
+
+`+"```"+`yaml
+version: v1
+lint:
+  use:
+    - ENUM_NO_ALLOW_ALIAS
+`+"```"+`
+`,
+	)
+	require.Contains(t, content, "### FIELD_SAME_JSTYPE\n")
+	require.Contains(t, content, "| `WIRE_JSON` |")
+}
+
+func TestModInitTemplate(t *testing.T) {
+	t.Parallel()
+	tempDir := t.TempDir()
+	testRun(t, 0, nil, bytes.NewBuffer(nil), "mod", "init", "buf.build/acme/weather", "--template", "go", "-o", tempDir)
+	data, err := os.ReadFile(filepath.Join(tempDir, bufconfig.ExternalConfigV1FilePath))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`version: v1
+name: buf.build/acme/weather
+breaking:
+  use:
+    - FILE
+lint:
+  use:
+    - DEFAULT
+`,
+		string(data),
+	)
+	data, err = os.ReadFile(filepath.Join(tempDir, "buf.gen.yaml"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`version: v1
+managed:
+  enabled: true
+  go_package_prefix:
+    default: github.com/acme/weather/gen/go
+plugins:
+  - plugin: buf.build/protocolbuffers/go
+    out: gen/go
+    opt: paths=source_relative
+`,
+		string(data),
+	)
+	require.FileExists(t, filepath.Join(tempDir, "acme", "weather", "v1", "weather.proto"))
+	// The example file passes lint.
+	testRunStdout(t, nil, 0, ``, "lint", tempDir)
+	// Nothing is overwritten.
+	testRunStdoutStderr(
+		t,
+		nil,
+		1,
+		``,
+		`Failure: buf.yaml already exists, not overwriting`,
+		"mod",
+		"init",
+		"--template",
+		"es",
+		"-o",
+		tempDir,
+	)
+	testRun(t, 1, nil, nil, "mod", "init", "--template", "java", "-o", t.TempDir())
+}
+
+func TestModInitInteractive(t *testing.T) {
+	t.Parallel()
+	tempDir := t.TempDir()
+	testRun(
+		t,
+		0,
+		// name, lint profile, breaking profile, layout, template.
+		strings.NewReader("buf.build/acme/pets\nbasic\n\nproto\nes\n"),
+		bytes.NewBuffer(nil),
+		"mod",
+		"init",
+		"--interactive",
+		"-o",
+		tempDir,
+	)
+	data, err := os.ReadFile(filepath.Join(tempDir, "proto", bufconfig.ExternalConfigV1FilePath))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`version: v1
+name: buf.build/acme/pets
+breaking:
+  use:
+    - FILE
+lint:
+  use:
+    - BASIC
 `,
-		false,
-		"",
+		string(data),
+	)
+	data, err = os.ReadFile(filepath.Join(tempDir, "buf.work.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "version: v1\ndirectories:\n  - proto\n", string(data))
+	data, err = os.ReadFile(filepath.Join(tempDir, "buf.gen.yaml"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`version: v1
+plugins:
+  - plugin: buf.build/bufbuild/es
+    out: gen/es
+    opt: target=ts
+`,
+		string(data),
 	)
+	require.FileExists(t, filepath.Join(tempDir, "proto", "acme", "pets", "v1", "pets.proto"))
+	testRunStdout(t, nil, 0, ``, "lint", tempDir)
 }
 
 func TestExportProto(t *testing.T) {
@@ -1515,6 +2458,18 @@ a/v3/a.proto:7:10:Field "2" on message "Foo" changed name from "value" to "Value
 func TestVersion(t *testing.T) {
 	t.Parallel()
 	testRunStdout(t, nil, 0, bufcli.Version, "--version")
+	testRunStdout(t, nil, 0, bufcli.Version, "--version", "--format", "text")
+}
+
+func TestVersionJSON(t *testing.T) {
+	t.Parallel()
+	stdout := bytes.NewBuffer(nil)
+	testRun(t, 0, nil, stdout, "--version", "--format", "json")
+	var externalVersionInfo bufcli.ExternalVersionInfo
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &externalVersionInfo))
+	assert.Equal(t, bufcli.Version, externalVersionInfo.Version)
+	assert.Equal(t, runtime.Version(), externalVersionInfo.GoVersion)
+	assert.Empty(t, externalVersionInfo.ExperimentalFeatures)
 }
 
 func TestMigrateV1Beta1(t *testing.T) {
@@ -1716,6 +2671,98 @@ Successfully migrated your buf.yaml and buf.gen.yaml to v1.`,
 	})
 }
 
+func TestConfigMigrate(t *testing.T) {
+	t.Parallel()
+	storageosProvider := storageos.NewProvider()
+	runner := command.NewRunner()
+
+	t.Run("buf-gen-yaml", func(t *testing.T) {
+		t.Parallel()
+		testConfigMigrateDiff(
+			t,
+			storageosProvider,
+			runner,
+			"buf-gen-yaml",
+			`buf.gen.yaml: version changed from v1beta1 to v1.
+buf.gen.yaml: managed was moved to managed.enabled.
+buf.gen.yaml: options.java_multiple_files was moved to managed.java_multiple_files.
+buf.gen.yaml: options.optimize_for was moved to managed.optimize_for.`,
+		)
+	})
+	t.Run("comments", func(t *testing.T) {
+		t.Parallel()
+		testConfigMigrateDiff(
+			t,
+			storageosProvider,
+			runner,
+			"comments",
+			"",
+		)
+	})
+	t.Run("noop", func(t *testing.T) {
+		t.Parallel()
+		testConfigMigrateDiff(
+			t,
+			storageosProvider,
+			runner,
+			"noop",
+			"All configuration files are already at the latest version v1.",
+		)
+	})
+}
+
+func TestConfigLint(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"config",
+		"lint",
+		filepath.Join("testdata", "config-lint", "success"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`testdata/config-lint/unknown-key/buf.yaml:3:1:Unknown key "lnit".`),
+		"config",
+		"lint",
+		filepath.Join("testdata", "config-lint", "unknown-key"),
+	)
+}
+
+func TestConfigExplain(t *testing.T) {
+	t.Parallel()
+	stdout := bytes.NewBuffer(nil)
+	testRun(
+		t,
+		0,
+		nil,
+		stdout,
+		"config",
+		"explain",
+		filepath.Join("testdata", "config-lint", "success"),
+		"--format",
+		"json",
+	)
+	var effectiveConfig struct {
+		Name string `json:"name"`
+		Lint struct {
+			Use                 []string `json:"use"`
+			EnumZeroValueSuffix string   `json:"enum_zero_value_suffix"`
+			ServiceSuffix       string   `json:"service_suffix"`
+		} `json:"lint"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &effectiveConfig))
+	assert.Equal(t, "buf.build/acme/weather", effectiveConfig.Name)
+	assert.Contains(t, effectiveConfig.Lint.Use, "PACKAGE_DEFINED")
+	assert.NotContains(t, effectiveConfig.Lint.Use, "MINIMAL")
+	assert.Equal(t, "_UNSPECIFIED", effectiveConfig.Lint.EnumZeroValueSuffix)
+	assert.Equal(t, "Service", effectiveConfig.Lint.ServiceSuffix)
+}
+
 func TestConvertWithImage(t *testing.T) {
 	tempDir := t.TempDir()
 	testRunStdout(
@@ -2391,6 +3438,50 @@ func testMigrateV1Beta1Failure(t *testing.T, storageosProvider storageos.Provide
 	)
 }
 
+// testConfigMigrateDiff runs buf config migrate on the input of the scenario and compares
+// the result to the output of the scenario. If expectedStdout is empty, stdout is not checked.
+func testConfigMigrateDiff(
+	t *testing.T,
+	storageosProvider storageos.Provider,
+	runner command.Runner,
+	scenario string,
+	expectedStdout string,
+) {
+	// Copy test setup to temporary directory to avoid writing to filesystem
+	inputBucket, err := storageosProvider.NewReadWriteBucket(filepath.Join("testdata", "config-migrate", "success", scenario, "input"))
+	require.NoError(t, err)
+	tempDir, readWriteBucket := internaltesting.CopyReadBucketToTempDir(context.Background(), t, storageosProvider, inputBucket)
+
+	if expectedStdout != "" {
+		testRunStdout(
+			t,
+			nil,
+			0,
+			expectedStdout,
+			"config",
+			"migrate",
+			tempDir,
+		)
+	} else {
+		testRun(
+			t,
+			0,
+			nil,
+			bytes.NewBuffer(nil),
+			"config",
+			"migrate",
+			tempDir,
+		)
+	}
+
+	expectedOutputBucket, err := storageosProvider.NewReadWriteBucket(filepath.Join("testdata", "config-migrate", "success", scenario, "output"))
+	require.NoError(t, err)
+
+	diff, err := storage.DiffBytes(context.Background(), runner, expectedOutputBucket, readWriteBucket)
+	require.NoError(t, err)
+	require.Empty(t, string(diff))
+}
+
 func testModInit(t *testing.T, expectedData string, document bool, name string, deps ...string) {
 	tempDir := t.TempDir()
 	baseArgs := []string{"mod", "init"}
@@ -2486,3 +3577,418 @@ func testRun(
 		args...,
 	)
 }
+
+func TestBetaExtensionsVerifyWarnsForSources(t *testing.T) {
+	t.Parallel()
+	dirPath := t.TempDir()
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(dirPath, "a.proto"),
+			[]byte(`syntax = "proto2";
+package a;
+message One {
+  extensions 100 to 199;
+}
+extend One {
+  optional int32 foo = 100;
+}
+`),
+			0600,
+		),
+	)
+	imagePath := filepath.Join(t.TempDir(), "image.bin")
+	testRun(t, 0, nil, nil, "build", dirPath, "-o", imagePath)
+	for _, input := range []string{dirPath, imagePath} {
+		stderr := bytes.NewBuffer(nil)
+		appcmdtesting.RunCommandExitCode(
+			t,
+			func(use string) *appcmd.Command { return NewRootCommand(use) },
+			0,
+			internaltesting.NewEnvFunc(t),
+			nil,
+			io.Discard,
+			stderr,
+			"beta",
+			"extensions",
+			"verify",
+			input,
+		)
+		// Declarations can only be read from Images built by other compilers.
+		if input == imagePath {
+			assert.NotContains(t, stderr.String(), "Extension declarations cannot be compiled from sources")
+		} else {
+			assert.Contains(t, stderr.String(), "Extension declarations cannot be compiled from sources")
+		}
+	}
+}
+
+func TestImageMerge(t *testing.T) {
+	t.Parallel()
+	tempDir := t.TempDir()
+	firstImagePath := filepath.Join(tempDir, "first.bin")
+	secondImagePath := filepath.Join(tempDir, "second.bin")
+	mergedImagePath := filepath.Join(tempDir, "merged.bin")
+	testRun(t, 0, nil, nil, "build", filepath.Join("testdata", "paths"), "--path", filepath.Join("testdata", "paths", "a", "v1"), "-o", firstImagePath)
+	testRun(t, 0, nil, nil, "build", filepath.Join("testdata", "paths"), "--path", filepath.Join("testdata", "paths", "a", "v1"), "--path", filepath.Join("testdata", "paths", "b", "v1"), "-o", secondImagePath)
+	// Files defined identically in multiple images are not conflicts.
+	testRun(t, 0, nil, nil, "beta", "image", "merge", firstImagePath, secondImagePath, "-o", mergedImagePath)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		filepath.FromSlash(`
+			a/v1/a.proto
+			b/v1/b.proto
+		`),
+		"ls-files",
+		mergedImagePath,
+	)
+}
+
+func TestImageMergeConflict(t *testing.T) {
+	t.Parallel()
+	tempDir := t.TempDir()
+	var imagePaths []string
+	for _, pkg := range []string{"first", "second"} {
+		moduleDir := filepath.Join(tempDir, pkg)
+		require.NoError(t, os.MkdirAll(moduleDir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "buf.yaml"), []byte("version: v1\n"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "conflict.proto"), []byte(`syntax = "proto3"; package `+pkg+`;`), 0600))
+		imagePath := filepath.Join(tempDir, pkg+".bin")
+		testRun(t, 0, nil, nil, "build", moduleDir, "-o", imagePath)
+		imagePaths = append(imagePaths, imagePath)
+	}
+	mergedImagePath := filepath.Join(tempDir, "merged.bin")
+	testRunStdoutStderr(
+		t,
+		nil,
+		1,
+		"",
+		fmt.Sprintf(
+			`Failure: 1 file(s) defined differently in multiple images, use --on-conflict=first or --on-conflict=last to resolve:
+  conflict.proto: defined differently in %s, %s`,
+			imagePaths[0],
+			imagePaths[1],
+		),
+		"beta", "image", "merge", imagePaths[0], imagePaths[1], "-o", mergedImagePath,
+	)
+	testRun(t, 0, nil, nil, "beta", "image", "merge", imagePaths[0], imagePaths[1], "--on-conflict", "last", "-o", mergedImagePath)
+	stdout := bytes.NewBuffer(nil)
+	testRun(t, 0, nil, stdout, "build", mergedImagePath, "-o", "-#format=json")
+	assert.Contains(t, stdout.String(), `"package":"second"`)
+	testRun(t, 1, nil, nil, "beta", "image", "merge", imagePaths[0], imagePaths[1], "--on-conflict", "other", "-o", mergedImagePath)
+}
+
+func TestSBOM(t *testing.T) {
+	t.Parallel()
+	tempDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "buf.yaml"), []byte("version: v1\nname: buf.build/acme/weather\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "LICENSE"), []byte("SPDX-License-Identifier: Apache-2.0\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "weather.proto"), []byte(`syntax = "proto3"; package weather;`), 0600))
+	stdout := bytes.NewBuffer(nil)
+	testRun(t, 0, nil, stdout, "beta", "sbom", tempDir)
+	var spdxDocument struct {
+		SPDXVersion string `json:"spdxVersion"`
+		Packages    []struct {
+			Name            string `json:"name"`
+			LicenseDeclared string `json:"licenseDeclared"`
+		} `json:"packages"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &spdxDocument))
+	assert.Equal(t, "SPDX-2.3", spdxDocument.SPDXVersion)
+	require.Len(t, spdxDocument.Packages, 1)
+	assert.Equal(t, "buf.build/acme/weather", spdxDocument.Packages[0].Name)
+	assert.Equal(t, "Apache-2.0", spdxDocument.Packages[0].LicenseDeclared)
+	outputPath := filepath.Join(tempDir, "sbom.cdx.json")
+	testRun(t, 0, nil, nil, "beta", "sbom", tempDir, "--format", "cyclonedx", "-o", outputPath)
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"bomFormat": "CycloneDX"`)
+	testRun(t, 1, nil, nil, "beta", "sbom", tempDir, "--format", "swid")
+}
+
+func TestBuildLicensePolicy(t *testing.T) {
+	t.Parallel()
+	tempDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "weather.proto"), []byte(`syntax = "proto3"; package weather;`), 0600))
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(tempDir, "buf.yaml"),
+			[]byte("version: v1\nlicenses:\n  allow:\n    - apache-2.0\n  deny:\n    - GPL-3.0-only\n"),
+			0600,
+		),
+	)
+	// No dependencies, so there is nothing that can violate the policy.
+	testRun(t, 0, nil, nil, "build", tempDir)
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(tempDir, "buf.yaml"),
+			[]byte("version: v1\nlicenses:\n  allow:\n    - not-a-license\n"),
+			0600,
+		),
+	)
+	testRun(t, 1, nil, nil, "build", tempDir)
+}
+
+func TestLintProfileFlags(t *testing.T) {
+	// Not parallel, as CPU profiling and tracing are global to the process
+	// and would conflict with testRunStdoutProfile.
+	tempDir := t.TempDir()
+	testRun(
+		t,
+		0,
+		nil,
+		nil,
+		"lint",
+		filepath.Join("testdata", "success"),
+		"--timing",
+		"--profile-cpu",
+		filepath.Join(tempDir, "cpu.pprof"),
+		"--profile-mem",
+		filepath.Join(tempDir, "mem.pprof"),
+		"--profile-trace",
+		filepath.Join(tempDir, "trace.out"),
+	)
+	for _, name := range []string{"cpu.pprof", "mem.pprof", "trace.out"} {
+		fileInfo, err := os.Stat(filepath.Join(tempDir, name))
+		require.NoError(t, err)
+		assert.NotZero(t, fileInfo.Size(), name)
+	}
+	testRun(
+		t,
+		1,
+		nil,
+		nil,
+		"lint",
+		filepath.Join("testdata", "success"),
+		"--profile",
+		"--profile-cpu",
+		filepath.Join(tempDir, "cpu.pprof"),
+	)
+}
+
+func TestBetaVerifyInvalidFlags(t *testing.T) {
+	t.Parallel()
+	testRunStdoutStderr(
+		t,
+		nil,
+		1,
+		"",
+		"Failure: exactly one of --key or --certificate-identity must be set",
+		"beta",
+		"verify",
+		filepath.Join("testdata", "success"),
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		1,
+		"",
+		"Failure: exactly one of --key or --certificate-identity must be set",
+		"beta",
+		"verify",
+		filepath.Join("testdata", "success"),
+		"--key",
+		"key.pem",
+		"--certificate-identity",
+		"releaser@example.com",
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		1,
+		"",
+		"Failure: --certificate-roots requires --certificate-identity",
+		"beta",
+		"verify",
+		filepath.Join("testdata", "success"),
+		"--key",
+		"key.pem",
+		"--certificate-roots",
+		"roots.pem",
+	)
+}
+
+func TestBetaManifestDiff(t *testing.T) {
+	t.Parallel()
+	fromDirPath := t.TempDir()
+	toDirPath := t.TempDir()
+	for dirPath, files := range map[string]map[string]string{
+		fromDirPath: {
+			"buf.yaml": "version: v1\nname: buf.build/foo/bar\n",
+			"a.proto":  `syntax = "proto3"; package a;`,
+			"b.proto":  `syntax = "proto3"; package b;`,
+		},
+		toDirPath: {
+			"buf.yaml": "version: v1\nname: buf.build/foo/bar\n",
+			"a.proto":  `syntax = "proto3"; package a;`,
+			"b.proto":  `syntax = "proto3"; package b.v2;`,
+			"c.proto":  `syntax = "proto3"; package c;`,
+		},
+	} {
+		for path, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(dirPath, path), []byte(content), 0600))
+		}
+	}
+	stdout := bytes.NewBuffer(nil)
+	testRun(
+		t,
+		0,
+		nil,
+		stdout,
+		"beta",
+		"manifest",
+		"diff",
+		fromDirPath,
+		toDirPath,
+		"--format",
+		"json",
+	)
+	var pathDiffs []struct {
+		Path   string `json:"path"`
+		Change string `json:"change"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &pathDiffs))
+	require.Len(t, pathDiffs, 2)
+	assert.Equal(t, "b.proto", pathDiffs[0].Path)
+	assert.Equal(t, "changed", pathDiffs[0].Change)
+	assert.Equal(t, "c.proto", pathDiffs[1].Path)
+	assert.Equal(t, "added", pathDiffs[1].Change)
+}
+
+func TestBetaGraphDetectCycles(t *testing.T) {
+	t.Parallel()
+	dirPath := t.TempDir()
+	for path, content := range map[string]string{
+		"a.proto": "syntax = \"proto3\";\npackage a;\nimport \"b.proto\";\n",
+		"b.proto": "syntax = \"proto3\";\npackage b;\nimport \"c.proto\";\n",
+		"c.proto": "syntax = \"proto3\";\npackage c;\nimport \"a.proto\";\n",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dirPath, path), []byte(content), 0600))
+	}
+	testRunStdout(
+		t,
+		nil,
+		0,
+		`
+		a.proto -> b.proto
+		b.proto -> c.proto
+		c.proto -> a.proto
+		`,
+		"beta",
+		"graph",
+		dirPath,
+	)
+	stdout := bytes.NewBuffer(nil)
+	testRun(
+		t,
+		bufcli.ExitCodeFileAnnotation,
+		nil,
+		stdout,
+		"beta",
+		"graph",
+		dirPath,
+		"--detect-cycles",
+	)
+	assert.Equal(
+		t,
+		fmt.Sprintf(
+			`File import cycle: a.proto -> b.proto -> c.proto -> a.proto
+  %s:3:1: a.proto imports "b.proto"
+  %s:3:1: b.proto imports "c.proto"
+  %s:3:1: c.proto imports "a.proto"
+  suggested break point: remove the import from a.proto to b.proto
+`,
+			filepath.Join(dirPath, "a.proto"),
+			filepath.Join(dirPath, "b.proto"),
+			filepath.Join(dirPath, "c.proto"),
+		),
+		stdout.String(),
+	)
+}
+
+func TestModTidyRemovesUnusedDependencies(t *testing.T) {
+	t.Parallel()
+	tempDirPath := t.TempDir()
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(tempDirPath, "buf.yaml"),
+			[]byte(`version: v1
+# Dependencies are tidied by buf mod tidy.
+deps:
+  - buf.build/acme/unused
+lint:
+  use:
+    - DEFAULT
+`),
+			0600,
+		),
+	)
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(tempDirPath, "a.proto"),
+			[]byte(`syntax = "proto3"; package a; import "google/protobuf/empty.proto";`),
+			0600,
+		),
+	)
+	testRun(t, 0, nil, nil, "mod", "tidy", tempDirPath)
+	data, err := os.ReadFile(filepath.Join(tempDirPath, "buf.yaml"))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`version: v1
+lint:
+  use:
+    - DEFAULT
+`,
+		string(data),
+	)
+}
+
+func testRunGit(t *testing.T, dirPath string, args ...string) {
+	t.Helper()
+	container, err := app.NewContainerForOS()
+	require.NoError(t, err)
+	_, err = command.RunStdout(
+		context.Background(),
+		container,
+		command.NewRunner(),
+		"git",
+		append([]string{"-C", dirPath}, args...)...,
+	)
+	require.NoError(t, err)
+}
+
+func copyTestdataDir(t *testing.T, dirPath string) string {
+	t.Helper()
+	tempDirPath := t.TempDir()
+	require.NoError(
+		t,
+		filepath.WalkDir(
+			dirPath,
+			func(path string, dirEntry fs.DirEntry, err error) error {
+				if err != nil || dirEntry.IsDir() {
+					return err
+				}
+				relPath, err := filepath.Rel(dirPath, path)
+				if err != nil {
+					return err
+				}
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				if err := os.MkdirAll(filepath.Join(tempDirPath, filepath.Dir(relPath)), 0755); err != nil {
+					return err
+				}
+				return os.WriteFile(filepath.Join(tempDirPath, relPath), data, 0600)
+			},
+		),
+	)
+	return tempDirPath
+}
@@ -16,6 +16,7 @@ package main
 
 import (
 	"context"
+	"path"
 
 	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/app/appproto"
@@ -33,9 +34,12 @@ func handle(
 	responseWriter appproto.ResponseBuilder,
 	request *pluginpb.CodeGeneratorRequest,
 ) error {
+	// The parameter is an optional directory that test.txt is written to, so that
+	// insertion points into a different output directory can be tested.
+	name := path.Join(request.GetParameter(), "test.txt")
 	if err := responseWriter.AddFile(
 		&pluginpb.CodeGeneratorResponse_File{
-			Name:           proto.String("test.txt"),
+			Name:           proto.String(name),
 			InsertionPoint: proto.String("example"),
 			Content: proto.String(`
 			// Include this comment on the 'example' insertion point.
@@ -49,7 +53,7 @@ func handle(
 	}
 	if err := responseWriter.AddFile(
 		&pluginpb.CodeGeneratorResponse_File{
-			Name:           proto.String("test.txt"),
+			Name:           proto.String(name),
 			InsertionPoint: proto.String("other"),
 			Content: proto.String(`
 			// Include this comment on the 'other' insertion point.
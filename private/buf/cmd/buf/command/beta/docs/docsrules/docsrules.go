@@ -0,0 +1,238 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docsrules
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufcheckplugin"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	outputFlagName      = "output"
+	outputFlagShortName = "o"
+	configFlagName      = "config"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name,
+		Short: "Generate Markdown documentation for all lint and breaking rules",
+		Long: `Generate Markdown documentation for all lint and breaking rules.
+
+The documentation includes every rule for the version of the buf.yaml, not just the rules
+in use, along with the rules of any breaking plugins configured in the buf.yaml.
+
+Write the documentation for the rules of the buf.yaml in the current directory to rules.md:
+
+    $ buf beta docs rules -o rules.md
+`,
+		Args: cobra.NoArgs,
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Output string
+	Config string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"",
+		`The file to write the documentation to. Defaults to stdout`,
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The buf.yaml file or data to use for configuration`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		".",
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return err
+	}
+	config, err := bufconfig.ReadConfigOS(
+		ctx,
+		readWriteBucket,
+		bufconfig.ReadConfigOSWithOverride(flags.Config),
+	)
+	if err != nil {
+		return err
+	}
+	var lintRules []bufcheck.Rule
+	var breakingRules []bufcheck.Rule
+	switch config.Version {
+	case bufconfig.V1Beta1Version:
+		lintRules, err = buflint.GetAllRulesV1Beta1()
+		if err != nil {
+			return err
+		}
+		breakingRules, err = bufbreaking.GetAllRulesV1Beta1()
+		if err != nil {
+			return err
+		}
+	case bufconfig.V1Version:
+		lintRules, err = buflint.GetAllRulesV1()
+		if err != nil {
+			return err
+		}
+		breakingRules, err = bufbreaking.GetAllRulesV1()
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown config version: %q", config.Version)
+	}
+	var breakingPluginRules []bufcheckplugin.PluginRule
+	if config.Breaking != nil && len(config.Breaking.Plugins) > 0 {
+		pluginRunner := bufcheckplugin.NewRunner(container.Logger(), command.NewRunner(), container)
+		for _, pluginConfig := range config.Breaking.Plugins {
+			pluginRules, err := pluginRunner.ListRules(ctx, pluginConfig.Plugin, pluginConfig.Args)
+			if err != nil {
+				return err
+			}
+			breakingPluginRules = append(breakingPluginRules, pluginRules...)
+		}
+	}
+	buffer := bytes.NewBuffer(nil)
+	writeRulesMarkdown(buffer, config.Version, lintRules, breakingRules, breakingPluginRules)
+	if flags.Output == "" {
+		_, err := container.Stdout().Write(buffer.Bytes())
+		return err
+	}
+	return os.WriteFile(flags.Output, buffer.Bytes(), 0644)
+}
+
+func writeRulesMarkdown(
+	writer io.Writer,
+	version string,
+	lintRules []bufcheck.Rule,
+	breakingRules []bufcheck.Rule,
+	breakingPluginRules []bufcheckplugin.PluginRule,
+) {
+	p := func(format string, args ...interface{}) {
+		_, _ = fmt.Fprintf(writer, format, args...)
+	}
+	p("# Rules\n\n")
+	p("<!-- Generated by buf beta docs rules. DO NOT EDIT. -->\n\n")
+	p("These are the lint and breaking rules for buf.yaml version %s.\n\n", version)
+	p("## Lint rules\n\n")
+	writeCategoriesMarkdown(writer, lintRules)
+	for _, rule := range lintRules {
+		writeRuleMarkdown(writer, rule)
+		writeRuleConfigExample(writer, version, "lint", rule.ID())
+	}
+	p("## Breaking rules\n\n")
+	allBreakingRules := breakingRules
+	for _, pluginRule := range breakingPluginRules {
+		allBreakingRules = append(allBreakingRules, pluginRule)
+	}
+	writeCategoriesMarkdown(writer, allBreakingRules)
+	for _, rule := range breakingRules {
+		writeRuleMarkdown(writer, rule)
+		writeRuleConfigExample(writer, version, "breaking", rule.ID())
+	}
+	for _, pluginRule := range breakingPluginRules {
+		writeRuleMarkdown(writer, pluginRule)
+		p("Implemented by the plugin `%s`.\n\n", pluginRule.Plugin())
+		if example := strings.TrimSpace(pluginRule.Example()); example != "" {
+			p("Example:\n\n%s\n\n", example)
+		}
+	}
+}
+
+func writeCategoriesMarkdown(writer io.Writer, rules []bufcheck.Rule) {
+	// Categories are listed in the order they first appear.
+	var categories []string
+	categoryToIDs := make(map[string][]string)
+	for _, rule := range rules {
+		for _, category := range rule.Categories() {
+			if _, ok := categoryToIDs[category]; !ok {
+				categories = append(categories, category)
+			}
+			categoryToIDs[category] = append(categoryToIDs[category], rule.ID())
+		}
+	}
+	if len(categories) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(writer, "| Category | Rules |\n| --- | --- |\n")
+	for _, category := range categories {
+		_, _ = fmt.Fprintf(writer, "| `%s` | %d |\n", category, len(categoryToIDs[category]))
+	}
+	_, _ = fmt.Fprintln(writer)
+}
+
+func writeRuleMarkdown(writer io.Writer, rule bufcheck.Rule) {
+	_, _ = fmt.Fprintf(writer, "### %s\n\n", rule.ID())
+	_, _ = fmt.Fprintf(writer, "%s\n\n", rule.Purpose())
+	if categories := rule.Categories(); len(categories) > 0 {
+		_, _ = fmt.Fprintf(writer, "Categories: `%s`\n\n", strings.Join(categories, "`, `"))
+	}
+}
+
+func writeRuleConfigExample(writer io.Writer, version string, section string, id string) {
+	_, _ = fmt.Fprintf(
+		writer,
+		"Example:\n\n```yaml\nversion: %s\n%s:\n  use:\n    - %s\n```\n\n",
+		version,
+		section,
+		id,
+	)
+}
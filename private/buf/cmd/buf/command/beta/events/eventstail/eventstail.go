@@ -0,0 +1,164 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventstail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/webhook/v1alpha1/webhookv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	webhookv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/webhook/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	eventFlagName       = "event"
+	formatFlagName      = "format"
+	waitTimeoutFlagName = "wait-timeout"
+
+	defaultWaitTimeout = 30 * time.Second
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository>",
+		Short: "Print the events of a BSR repository as they occur",
+		Long: `This command polls the BSR for the events of the repository that webhooks are notified of, ` +
+			`and prints each event as it occurs until it is interrupted. ` +
+			`With --format=json, each event is printed as a JSON object on its own line.`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Events      []string
+	Format      string
+	WaitTimeout time.Duration
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringSliceVar(
+		&f.Events,
+		eventFlagName,
+		nil,
+		"The event types to print. The proto enum string value is used for this input (e.g. 'WEBHOOK_EVENT_REPOSITORY_PUSH'). "+
+			"May be provided multiple times. If not set, all events are printed",
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+	flagSet.DurationVar(
+		&f.WaitTimeout,
+		waitTimeoutFlagName,
+		defaultWaitTimeout,
+		"The maximum time each poll for events waits on the BSR before polling again",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if flags.WaitTimeout <= 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must be positive", waitTimeoutFlagName)
+	}
+	events := make([]registryv1alpha1.WebhookEvent, 0, len(flags.Events))
+	for _, eventString := range flags.Events {
+		event, ok := registryv1alpha1.WebhookEvent_value[eventString]
+		if !ok || event == int32(registryv1alpha1.WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED) {
+			return appcmd.NewInvalidArgumentErrorf("unknown --%s %q", eventFlagName, eventString)
+		}
+		events = append(events, registryv1alpha1.WebhookEvent(event))
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	service := connectclient.Make(
+		clientConfig,
+		moduleIdentity.Remote(),
+		webhookv1alpha1connect.NewRepositoryEventServiceClient,
+	)
+	printer := bufprint.NewRepositoryEventPrinter(container.Stdout())
+	var cursor string
+	for {
+		resp, err := service.PollRepositoryEvents(
+			ctx,
+			connect.NewRequest(&webhookv1alpha1.PollRepositoryEventsRequest{
+				OwnerName:      moduleIdentity.Owner(),
+				RepositoryName: moduleIdentity.Repository(),
+				Cursor:         cursor,
+				WaitTimeout:    durationpb.New(flags.WaitTimeout),
+				Events:         events,
+			}),
+		)
+		if err != nil {
+			if ctx.Err() != nil {
+				// The command was interrupted, which is how tailing ends.
+				return nil
+			}
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+			}
+			return err
+		}
+		for _, repositoryEvent := range resp.Msg.RepositoryEvents {
+			if err := printer.PrintRepositoryEvent(ctx, format, repositoryEvent); err != nil {
+				return err
+			}
+		}
+		cursor = resp.Msg.NextCursor
+	}
+}
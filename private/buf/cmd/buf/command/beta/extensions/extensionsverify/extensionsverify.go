@@ -0,0 +1,207 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extensionsverify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufextension"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	errorFormatFlagName     = "error-format"
+	configFlagName          = "config"
+	pathsFlagName           = "path"
+	excludePathsFlagName    = "exclude-path"
+	disableSymlinksFlagName = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <input>",
+		Short: "Verify extension declarations and the extensions that use them",
+		Long: bufcli.GetInputLong(`the source, module, or Image to verify`) + `
+
+Extension declarations are declared on the extension ranges of messages, and coordinate
+the numbers of the extensions of the messages:
+
+    extensions 1000 to 1999 [
+      declaration = {
+        number: 1000,
+        full_name: ".foo.v1.bar",
+        type: ".foo.v1.Bar"
+      },
+      declaration = {
+        number: 1001,
+        reserved: true
+      }
+    ];
+
+The declarations are verified to have numbers within their extension range, and to not
+declare the same number or extension twice. Every extension of a message in the input or
+its dependencies is verified to match the declaration for its number, if its extension
+range has declarations or a verification state of DECLARATION, and extension numbers are
+verified to not be used twice across the input and its dependencies.
+
+The descriptor.proto that buf compiles sources against predates extension declarations,
+so sources that set the declaration option cannot be compiled by buf. Declarations are
+read from the encoded options of the extension ranges instead, so they are only verified
+for Images built by compilers that support them, such as protoc 23 or later:
+
+    $ protoc --include_imports --include_source_info -o image.bin foo/v1/foo.proto
+    $ buf beta extensions verify image.bin
+
+For sources and modules, only the extension numbers are verified to not be used twice.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
+	}
+}
+
+type flags struct {
+	ErrorFormat     string
+	Config          string
+	Paths           []string
+	ExcludePaths    []string
+	DisableSymlinks bool
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
+	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors or verification violations printed to stdout. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The buf.yaml file or data to use for configuration`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	if _, ok := ref.(buffetch.ImageRef); !ok {
+		container.Logger().Warn(
+			"Extension declarations cannot be compiled from sources by buf, so only the extension numbers of this input are verified. " +
+				"Build an Image with a compiler that supports declarations, such as protoc 23 or later, to verify the declarations.",
+		)
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	imageConfigReader, err := bufcli.NewWireImageConfigReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+	)
+	if err != nil {
+		return err
+	}
+	imageConfigs, fileAnnotations, err := imageConfigReader.GetImageConfigs(
+		ctx,
+		container,
+		ref,
+		flags.Config,
+		flags.Paths,
+		flags.ExcludePaths,
+		false, // input files must exist
+		false, // we need source info for the locations of violations
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, flags.ErrorFormat); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	var violations []bufanalysis.FileAnnotation
+	for _, imageConfig := range imageConfigs {
+		// The Image includes imports, so that extensions are verified against the
+		// declarations of the dependencies.
+		imageViolations, err := bufextension.VerifyImage(ctx, imageConfig.Image())
+		if err != nil {
+			return err
+		}
+		violations = append(violations, imageViolations...)
+	}
+	if len(violations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(
+			container.Stdout(),
+			bufanalysis.DeduplicateAndSortFileAnnotations(violations),
+			flags.ErrorFormat,
+		); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	return nil
+}
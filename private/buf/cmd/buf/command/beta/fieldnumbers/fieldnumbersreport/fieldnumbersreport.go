@@ -0,0 +1,215 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldnumbersreport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/buffieldnumber"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	formatFlagName          = "format"
+	errorFormatFlagName     = "error-format"
+	configFlagName          = "config"
+	pathsFlagName           = "path"
+	excludePathsFlagName    = "exclude-path"
+	disableSymlinksFlagName = "disable-symlinks"
+	thresholdFlagName       = "threshold"
+	suggestFlagName         = "suggest"
+
+	defaultThreshold = 3
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <input>",
+		Short: "Report the field number usage of messages",
+		Long: bufcli.GetInputLong(`the source, module, or Image to report field numbers for`) + `
+
+Fields with numbers from 1 to 15 are encoded with a one-byte tag, and fields with numbers
+from 16 to 2047 are encoded with a two-byte tag. For every message, the report shows the
+number of fields, the highest field number, the reserved ranges, and the numbers remaining
+in these ranges for new fields.
+
+Numbers are remaining if they are larger than the highest field number, and are not
+reserved, in an extension range, or in the range 19000 to 19999 that is reserved for the
+implementation of Protocol Buffers. Numbers below the highest field number are never
+considered remaining, as they may be the numbers of deleted fields that were not reserved.
+
+The status of a message is one of:
+
+    ok                  More than --threshold one-byte numbers remain.
+    one_byte_low        At most --threshold one-byte numbers remain.
+    one_byte_exhausted  No one-byte numbers remain.
+    two_byte_low        No one-byte numbers and at most --threshold two-byte numbers remain.
+    two_byte_exhausted  No one-byte or two-byte numbers remain.
+
+Use --suggest to suggest the next numbers to use for new fields of every message.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
+	}
+}
+
+type flags struct {
+	Format          string
+	ErrorFormat     string
+	Config          string
+	Paths           []string
+	ExcludePaths    []string
+	DisableSymlinks bool
+	Threshold       int
+	Suggest         int
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
+	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format of the report. Must be one of %s`, bufprint.AllFormatsString),
+	)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The buf.yaml file or data to use for configuration`,
+	)
+	flagSet.IntVar(
+		&f.Threshold,
+		thresholdFlagName,
+		defaultThreshold,
+		`The number of remaining one-byte or two-byte numbers at or below which a message is flagged as low`,
+	)
+	flagSet.IntVar(
+		&f.Suggest,
+		suggestFlagName,
+		0,
+		`The number of next field numbers to suggest for every message`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	if flags.Threshold < 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must be non-negative", thresholdFlagName)
+	}
+	if flags.Suggest < 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must be non-negative", suggestFlagName)
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	imageConfigReader, err := bufcli.NewWireImageConfigReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+	)
+	if err != nil {
+		return err
+	}
+	imageConfigs, fileAnnotations, err := imageConfigReader.GetImageConfigs(
+		ctx,
+		container,
+		ref,
+		flags.Config,
+		flags.Paths,
+		flags.ExcludePaths,
+		false, // input files must exist
+		false, // we need source info for the locations of messages
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, flags.ErrorFormat); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	var reports []buffieldnumber.Report
+	for _, imageConfig := range imageConfigs {
+		imageReports, err := buffieldnumber.GetReports(ctx, imageConfig.Image(), flags.Threshold, flags.Suggest)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, imageReports...)
+	}
+	return bufprint.NewFieldNumberReportPrinter(container.Stdout()).PrintFieldNumberReports(ctx, format, reports...)
+}
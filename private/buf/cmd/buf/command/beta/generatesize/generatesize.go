@@ -0,0 +1,314 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generatesize
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/buf/bufgen"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/bufpkg/bufwasm"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	templateFlagName        = "template"
+	formatFlagName          = "format"
+	errorFormatFlagName     = "error-format"
+	configFlagName          = "config"
+	pathsFlagName           = "path"
+	excludePathsFlagName    = "exclude-path"
+	includeImportsFlagName  = "include-imports"
+	includeWKTFlagName      = "include-wkt"
+	typeFlagName            = "type"
+	disableSymlinksFlagName = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <input>",
+		Short: "Estimate the size of the code generated for an input",
+		Long: `This command runs the plugins in a generation template in memory and reports
+the number of files and bytes of code that each plugin generates, in total and for each
+package. Nothing is written to disk.
+
+The plugins are run separately for the files of each package, so that the generated code
+can be attributed to the package. Use this to track the impact of schema changes on the
+size of generated code, for example on the binary size of a mobile application.
+
+The first argument is the source, module, or image to generate from.
+Defaults to "." if no argument is specified.
+
+The template is read the same way as for buf generate:
+
+    $ buf beta generate-size --template buf.gen.yaml .
+`,
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
+		CompleteFlags: map[string]appcmd.CompletionFunc{
+			templateFlagName: builder.NewCompletionFunc(bufcli.CompleteTemplate),
+		},
+	}
+}
+
+type flags struct {
+	Template        string
+	Format          string
+	ErrorFormat     string
+	Config          string
+	Paths           []string
+	ExcludePaths    []string
+	IncludeImports  bool
+	IncludeWKT      bool
+	Types           []string
+	DisableSymlinks bool
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
+	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
+	flagSet.BoolVar(
+		&f.IncludeImports,
+		includeImportsFlagName,
+		false,
+		"Also generate all imports except for Well-Known Types",
+	)
+	flagSet.BoolVar(
+		&f.IncludeWKT,
+		includeWKTFlagName,
+		false,
+		fmt.Sprintf(
+			"Also generate Well-Known Types. Cannot be set without --%s",
+			includeImportsFlagName,
+		),
+	)
+	flagSet.StringVar(
+		&f.Template,
+		templateFlagName,
+		"",
+		`The generation template file or data to use. Must be in either YAML or JSON format`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors, printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The buf.yaml file or data to use for configuration`,
+	)
+	flagSet.StringSliceVar(
+		&f.Types,
+		typeFlagName,
+		nil,
+		"The types (package, message, enum, extension, service, method) to generate code for. Flag usage overrides buf.gen.yaml",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	logger := container.Logger()
+	if flags.IncludeWKT && !flags.IncludeImports {
+		return appcmd.NewInvalidArgumentErrorf("Cannot set --%s without --%s", includeWKTFlagName, includeImportsFlagName)
+	}
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	ref, err := buffetch.NewRefParser(logger).GetRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		".",
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return err
+	}
+	genConfig, err := bufgen.ReadConfig(
+		ctx,
+		logger,
+		bufgen.NewProvider(logger),
+		readWriteBucket,
+		bufgen.ReadConfigWithOverride(flags.Template),
+	)
+	if err != nil {
+		return err
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	imageConfigReader, err := bufcli.NewWireImageConfigReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+	)
+	if err != nil {
+		return err
+	}
+	imageConfigs, fileAnnotations, err := imageConfigReader.GetImageConfigs(
+		ctx,
+		container,
+		ref,
+		flags.Config,
+		flags.Paths,        // we filter on files
+		flags.ExcludePaths, // we exclude these paths
+		false,              // input files must exist
+		false,              // we must include source info for generation
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(container.Stderr(), fileAnnotations, flags.ErrorFormat); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	images := make([]bufimage.Image, 0, len(imageConfigs))
+	for _, imageConfig := range imageConfigs {
+		images = append(images, imageConfig.Image())
+	}
+	image, err := bufimage.MergeImages(images...)
+	if err != nil {
+		return err
+	}
+	var generateOptions []bufgen.GenerateOption
+	if flags.IncludeImports {
+		generateOptions = append(
+			generateOptions,
+			bufgen.GenerateWithIncludeImports(),
+		)
+	}
+	if flags.IncludeWKT {
+		generateOptions = append(
+			generateOptions,
+			bufgen.GenerateWithIncludeWellKnownTypes(),
+		)
+	}
+	pluginTimeout, err := bufcli.GetPluginTimeout(container)
+	if err != nil {
+		return err
+	}
+	if pluginTimeout > 0 {
+		generateOptions = append(
+			generateOptions,
+			bufgen.GenerateWithPluginTimeout(pluginTimeout),
+		)
+	}
+	wasmEnabled, err := bufcli.IsAlphaWASMEnabled(container)
+	if err != nil {
+		return err
+	}
+	if wasmEnabled {
+		generateOptions = append(
+			generateOptions,
+			bufgen.GenerateWithWASMEnabled(),
+		)
+	}
+	includedTypes := flags.Types
+	if len(includedTypes) == 0 && genConfig.TypesConfig != nil {
+		includedTypes = genConfig.TypesConfig.Include
+	}
+	if len(includedTypes) > 0 {
+		image, err = bufimageutil.ImageFilteredByTypes(image, includedTypes...)
+		if err != nil {
+			return err
+		}
+	}
+	wasmPluginExecutor, err := bufwasm.NewPluginExecutor(
+		filepath.Join(container.CacheDirPath(), bufcli.WASMCompilationCacheDir))
+	if err != nil {
+		return err
+	}
+	pluginGeneratedSizes, err := bufgen.NewGenerator(
+		logger,
+		storageosProvider,
+		runner,
+		wasmPluginExecutor,
+		clientConfig,
+	).GetGeneratedSizes(
+		ctx,
+		container,
+		genConfig,
+		image,
+		generateOptions...,
+	)
+	if err != nil {
+		return err
+	}
+	return bufprint.NewGeneratedSizePrinter(container.Stdout()).
+		PrintGeneratedSizes(ctx, format, pluginGeneratedSizes...)
+}
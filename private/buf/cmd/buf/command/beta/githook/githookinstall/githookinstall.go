@@ -0,0 +1,279 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githookinstall
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/git"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	hookFlagName  = "hook"
+	checkFlagName = "check"
+	forceFlagName = "force"
+
+	preCommitHook = "pre-commit"
+	prePushHook   = "pre-push"
+
+	formatCheck   = "format"
+	lintCheck     = "lint"
+	breakingCheck = "breaking"
+
+	// installedMarker is the line that identifies the hooks installed by this command.
+	installedMarker = `# Installed by "buf beta githook install".`
+	// cacheGitPath is the path in the git directory where the hooks cache their results.
+	cacheGitPath = "buf-githook"
+)
+
+var (
+	allHooks  = []string{preCommitHook, prePushHook}
+	allChecks = []string{formatCheck, lintCheck, breakingCheck}
+
+	hookTemplate = template.Must(
+		template.New("hook").Funcs(template.FuncMap{"quote": shellQuote}).Parse(hookTemplateText),
+	)
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name,
+		Short: "Install git hooks that run buf on the changed files",
+		Long: `Install git hooks in the git repository of the current directory that run buf format,
+lint and breaking on the .proto files changed by a commit or push.
+
+The current directory must contain the buf.yaml or buf.work.yaml of the input to check, and
+paths in the hooks are relative to it.
+
+The pre-commit hook checks the staged files only, so changes that are not staged do not
+affect the result. Breaking changes are checked against HEAD.
+
+The pre-push hook checks the files changed by the pushed commits. Breaking changes are
+checked against the commit of the remote branch that is being updated. The files of new
+remote branches are all checked, but not for breaking changes.
+
+Only the .proto files changed relative to the commit that breaking changes are checked
+against are checked, unless buf.yaml, buf.lock or buf.work.yaml changed, in which case all
+files are checked. The hooks remember the last content that passed the checks, so running
+them again on the same content, for example when a commit is amended without changes, is
+instant.
+
+The hooks run the buf binary on the $PATH. Existing hooks that were not installed by this
+command are not overwritten unless --force is set.`,
+		Args: cobra.NoArgs,
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Hooks  []string
+	Checks []string
+	Force  bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringSliceVar(
+		&f.Hooks,
+		hookFlagName,
+		[]string{preCommitHook},
+		fmt.Sprintf(
+			"The git hooks to install. Must be one of %s",
+			stringutil.SliceToString(allHooks),
+		),
+	)
+	flagSet.StringSliceVar(
+		&f.Checks,
+		checkFlagName,
+		allChecks,
+		fmt.Sprintf(
+			"The checks the hooks run. Must be one of %s",
+			stringutil.SliceToString(allChecks),
+		),
+	)
+	flagSet.BoolVar(
+		&f.Force,
+		forceFlagName,
+		false,
+		"Overwrite existing hooks that were not installed by this command",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	if err := validateFlags(flags); err != nil {
+		return err
+	}
+	return install(ctx, container, command.NewRunner(), "", flags)
+}
+
+// install installs the hooks in the git repository of the directory.
+//
+// If dirPath is empty, the hooks are installed in the git repository of the
+// current directory.
+func install(
+	ctx context.Context,
+	container app.EnvStdoutContainer,
+	runner command.Runner,
+	dirPath string,
+	flags *flags,
+) error {
+	prefix, err := git.RevParse(ctx, runner, container, dirPath, "--show-prefix")
+	if err != nil {
+		return err
+	}
+	hooksDirPath, err := revParseGitPath(ctx, container, runner, dirPath, "hooks")
+	if err != nil {
+		return err
+	}
+	cacheDirPath, err := revParseGitPath(ctx, container, runner, dirPath, cacheGitPath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(hooksDirPath, 0755); err != nil {
+		return err
+	}
+	for _, hook := range flags.Hooks {
+		hookFilePath := filepath.Join(hooksDirPath, hook)
+		if !flags.Force {
+			if err := checkNotOverwritingHook(hookFilePath); err != nil {
+				return err
+			}
+		}
+		buffer := bytes.NewBuffer(nil)
+		if err := hookTemplate.Execute(
+			buffer,
+			struct {
+				Hook         string
+				Prefix       string
+				Checks       string
+				CacheGitPath string
+			}{
+				Hook:         hook,
+				Prefix:       prefix,
+				Checks:       strings.Join(flags.Checks, " "),
+				CacheGitPath: cacheGitPath,
+			},
+		); err != nil {
+			return err
+		}
+		if err := os.WriteFile(hookFilePath, buffer.Bytes(), 0755); err != nil {
+			return err
+		}
+		// os.WriteFile does not change the permissions of existing files.
+		if err := os.Chmod(hookFilePath, 0755); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(container.Stdout(), "Installed %s hook at %s\n", hook, hookFilePath); err != nil {
+			return err
+		}
+	}
+	// The cached results of previously installed hooks may have been for other checks.
+	return os.RemoveAll(cacheDirPath)
+}
+
+func validateFlags(flags *flags) error {
+	if len(flags.Hooks) == 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", hookFlagName)
+	}
+	for _, hook := range flags.Hooks {
+		if !stringutil.SliceElementsContained(allHooks, []string{hook}) {
+			return appcmd.NewInvalidArgumentErrorf("--%s: unknown hook %q, must be one of %s", hookFlagName, hook, stringutil.SliceToString(allHooks))
+		}
+	}
+	if len(flags.Checks) == 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", checkFlagName)
+	}
+	for _, check := range flags.Checks {
+		if !stringutil.SliceElementsContained(allChecks, []string{check}) {
+			return appcmd.NewInvalidArgumentErrorf("--%s: unknown check %q, must be one of %s", checkFlagName, check, stringutil.SliceToString(allChecks))
+		}
+	}
+	return nil
+}
+
+// checkNotOverwritingHook returns an error if a hook that was not installed
+// by this command exists at the path.
+func checkNotOverwritingHook(hookFilePath string) error {
+	data, err := os.ReadFile(hookFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if bytes.Contains(data, []byte(installedMarker)) {
+		return nil
+	}
+	return fmt.Errorf("a hook that was not installed by buf already exists at %s, use --%s to overwrite it", hookFilePath, forceFlagName)
+}
+
+// revParseGitPath returns the path of the path in the git directory of the
+// git repository of the directory.
+//
+// git returns paths relative to the directory, so they are joined to it.
+func revParseGitPath(
+	ctx context.Context,
+	container app.EnvContainer,
+	runner command.Runner,
+	dirPath string,
+	path string,
+) (string, error) {
+	gitPath, err := git.RevParse(ctx, runner, container, dirPath, "--git-path", path)
+	if err != nil {
+		return "", err
+	}
+	if filepath.IsAbs(gitPath) {
+		return gitPath, nil
+	}
+	return filepath.Join(dirPath, gitPath), nil
+}
+
+// shellQuote quotes the value for POSIX shells.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,154 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githookinstall
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstall(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	container, runner, repositoryDirPath := newTestGitRepository(ctx, t)
+	dirPath := filepath.Join(repositoryDirPath, "proto")
+	require.NoError(t, os.MkdirAll(dirPath, 0755))
+
+	err := install(
+		ctx,
+		container,
+		runner,
+		dirPath,
+		&flags{
+			Hooks:  []string{preCommitHook, prePushHook},
+			Checks: []string{lintCheck, breakingCheck},
+		},
+	)
+	require.NoError(t, err)
+	for _, hook := range []string{preCommitHook, prePushHook} {
+		hookFilePath := filepath.Join(repositoryDirPath, ".git", "hooks", hook)
+		fileInfo, err := os.Stat(hookFilePath)
+		require.NoError(t, err)
+		assert.NotZero(t, fileInfo.Mode()&0100, "expected %s hook to be executable", hook)
+		data, err := os.ReadFile(hookFilePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), installedMarker)
+		assert.Contains(t, string(data), "prefix='proto/'")
+		assert.Contains(t, string(data), "checks='lint breaking'")
+		// The hook must be a valid shell script.
+		_, err = command.RunStdout(ctx, container, runner, "sh", "-n", hookFilePath)
+		assert.NoError(t, err, "expected %s hook to be a valid shell script", hook)
+	}
+}
+
+func TestInstallDoesNotOverwriteHook(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	container, runner, repositoryDirPath := newTestGitRepository(ctx, t)
+	hookFilePath := filepath.Join(repositoryDirPath, ".git", "hooks", preCommitHook)
+	require.NoError(t, os.MkdirAll(filepath.Dir(hookFilePath), 0755))
+	require.NoError(t, os.WriteFile(hookFilePath, []byte("#!/bin/sh\nexit 0\n"), 0755))
+	installFlags := &flags{
+		Hooks:  []string{preCommitHook},
+		Checks: allChecks,
+	}
+
+	err := install(ctx, container, runner, repositoryDirPath, installFlags)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--"+forceFlagName)
+	data, err := os.ReadFile(hookFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\nexit 0\n", string(data))
+
+	installFlags.Force = true
+	require.NoError(t, install(ctx, container, runner, repositoryDirPath, installFlags))
+	data, err = os.ReadFile(hookFilePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), installedMarker)
+
+	// Hooks installed by this command are overwritten without --force.
+	installFlags.Force = false
+	installFlags.Checks = []string{formatCheck}
+	require.NoError(t, install(ctx, container, runner, repositoryDirPath, installFlags))
+	data, err = os.ReadFile(hookFilePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "checks='format'")
+}
+
+func TestInstallRemovesCache(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	container, runner, repositoryDirPath := newTestGitRepository(ctx, t)
+	cacheFilePath := filepath.Join(repositoryDirPath, ".git", cacheGitPath, preCommitHook)
+	require.NoError(t, os.MkdirAll(filepath.Dir(cacheFilePath), 0755))
+	require.NoError(t, os.WriteFile(cacheFilePath, []byte("key"), 0600))
+
+	err := install(
+		ctx,
+		container,
+		runner,
+		repositoryDirPath,
+		&flags{
+			Hooks:  []string{preCommitHook},
+			Checks: allChecks,
+		},
+	)
+	require.NoError(t, err)
+	_, err = os.Stat(cacheFilePath)
+	assert.True(t, os.IsNotExist(err), "expected the cached results to be removed")
+}
+
+func TestValidateFlags(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, validateFlags(&flags{Hooks: allHooks, Checks: allChecks}))
+	assert.Error(t, validateFlags(&flags{Checks: allChecks}))
+	assert.Error(t, validateFlags(&flags{Hooks: allHooks}))
+	assert.Error(t, validateFlags(&flags{Hooks: []string{"post-commit"}, Checks: allChecks}))
+	assert.Error(t, validateFlags(&flags{Hooks: allHooks, Checks: []string{"build"}}))
+}
+
+func TestShellQuote(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "''", shellQuote(""))
+	assert.Equal(t, "'proto/'", shellQuote("proto/"))
+	assert.Equal(t, `'it'\''s'`, shellQuote("it's"))
+}
+
+func newTestGitRepository(
+	ctx context.Context,
+	t *testing.T,
+) (app.Container, command.Runner, string) {
+	envContainer, err := app.NewEnvContainerForOS()
+	require.NoError(t, err)
+	container := app.NewContainer(
+		app.EnvironMap(envContainer),
+		nil,
+		bytes.NewBuffer(nil),
+		bytes.NewBuffer(nil),
+	)
+	runner := command.NewRunner()
+	repositoryDirPath := t.TempDir()
+	_, err = command.RunStdout(ctx, container, runner, "git", "-C", repositoryDirPath, "init", "--quiet")
+	require.NoError(t, err)
+	return container, runner, repositoryDirPath
+}
@@ -0,0 +1,131 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githookinstall
+
+// hookTemplateText is the template of the installed hooks.
+//
+// The content to check is extracted from the tree of the index or the pushed
+// commit with git archive, so that files that are not staged or not pushed do
+// not affect the result. The key of the last content that passed the checks
+// is stored in the cache directory.
+const hookTemplateText = `#!/bin/sh
+` + installedMarker + `
+#
+# Runs "buf {{.Checks}}" on the .proto files changed by the {{.Hook}}.
+# Run "buf beta githook install" again to change the checks.
+
+set -e
+
+prefix={{quote .Prefix}}
+checks={{quote .Checks}}
+
+cache_dir="$(git rev-parse --git-path {{quote .CacheGitPath}})"
+case "${cache_dir}" in
+  /*) ;;
+  *) cache_dir="$(pwd)/${cache_dir}" ;;
+esac
+cache_file="${cache_dir}/{{.Hook}}"
+mkdir -p "${cache_dir}"
+
+tmp_dir="$(mktemp -d)"
+trap 'rm -rf "${tmp_dir}"' EXIT
+
+# check runs the checks on the tree $1, checking breaking changes against
+# the commit $2 if it is not empty.
+check() {
+  tree="$1"
+  against="$2"
+  key="${tree} ${against} ${checks}"
+  if [ -f "${cache_file}" ] && [ "$(cat "${cache_file}")" = "${key}" ]; then
+    return 0
+  fi
+
+  all=""
+  paths_file="${tmp_dir}/paths"
+  if [ -n "${against}" ]; then
+    git -c core.quotePath=false diff-tree -r --name-only --diff-filter=ACMR "${against}" "${tree}" -- ${prefix:+"${prefix}"} >"${paths_file}"
+  else
+    all=1
+    : >"${paths_file}"
+  fi
+  set --
+  while IFS= read -r file; do
+    file="${file#"${prefix}"}"
+    case "${file}" in
+      buf.yaml | */buf.yaml | buf.lock | */buf.lock | buf.work.yaml | */buf.work.yaml) all=1 ;;
+      *.proto) set -- "$@" --path "${file}" ;;
+    esac
+  done <"${paths_file}"
+  if [ -n "${all}" ]; then
+    set --
+  elif [ "$#" -eq 0 ]; then
+    echo "${key}" >"${cache_file}"
+    return 0
+  fi
+
+  rm -rf "${tmp_dir}/content" "${tmp_dir}/against"
+  mkdir "${tmp_dir}/content" "${tmp_dir}/against"
+  git archive "${tree}" ${prefix:+"${prefix}"} | tar -x -C "${tmp_dir}/content"
+  against_dir=""
+  if [ -n "${against}" ] && git cat-file -e "${against}:${prefix%/}" 2>/dev/null; then
+    git archive "${against}" ${prefix:+"${prefix}"} | tar -x -C "${tmp_dir}/against"
+    against_dir="${tmp_dir}/against/${prefix}"
+  fi
+
+  status=0
+  for check_name in ${checks}; do
+    case "${check_name}" in
+      format)
+        (cd "${tmp_dir}/content/${prefix}" && buf format --diff --exit-code "$@" </dev/null) || status=1
+        ;;
+      lint)
+        (cd "${tmp_dir}/content/${prefix}" && buf lint "$@" </dev/null) || status=1
+        ;;
+      breaking)
+        if [ -n "${against_dir}" ]; then
+          (cd "${tmp_dir}/content/${prefix}" && buf breaking --against "${against_dir}" "$@" </dev/null) || status=1
+        fi
+        ;;
+    esac
+  done
+  if [ "${status}" -ne 0 ]; then
+    echo "buf {{.Hook}} hook failed, use --no-verify to skip it" >&2
+    return 1
+  fi
+  echo "${key}" >"${cache_file}"
+}
+{{if eq .Hook "pre-commit"}}
+tree="$(git write-tree)"
+against="$(git rev-parse --verify --quiet HEAD)" || against=""
+check "${tree}" "${against}"
+{{- else}}
+while read -r local_ref local_sha remote_ref remote_sha; do
+  # A local sha of zeros means the remote branch is being deleted.
+  case "${local_sha}" in
+    *[!0]*) ;;
+    *) continue ;;
+  esac
+  against=""
+  case "${remote_sha}" in
+    *[!0]*)
+      if git cat-file -e "${remote_sha}^{commit}" 2>/dev/null; then
+        against="${remote_sha}"
+      fi
+      ;;
+  esac
+  check "$(git rev-parse "${local_sha}^{tree}")" "${against}"
+done
+{{- end}}
+`
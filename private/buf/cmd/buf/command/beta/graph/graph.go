@@ -0,0 +1,266 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/importcycle"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	formatFlagName          = "format"
+	detectCyclesFlagName    = "detect-cycles"
+	disableSymlinksFlagName = "disable-symlinks"
+
+	formatText = "text"
+	formatDOT  = "dot"
+)
+
+var allFormats = []string{
+	formatText,
+	formatDOT,
+}
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <directory>",
+		Short: "Print the import graph of the files in a module or workspace",
+		Long: fmt.Sprintf(
+			"Prints the imports between the files of the module or %s in the given directory. "+
+				"Imports are read from the import statements of each file, so the graph can be printed "+
+				"even if the files do not compile. "+
+				"If --%s is set, the import cycles between modules and between files are printed instead, "+
+				"along with the location of every import in each cycle and a suggested import to remove to "+
+				"break it, and the command exits with a non-zero exit code if any cycles are found. "+
+				"The first argument is the directory. Defaults to \".\" if no argument is specified.",
+			bufwork.ExternalConfigV1FilePath,
+			detectCyclesFlagName,
+		),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Format          string
+	DetectCycles    bool
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		formatText,
+		fmt.Sprintf(
+			"The format to print the graph as. Must be one of %s. Cycles are always printed as text",
+			stringutil.SliceToString(allFormats),
+		),
+	)
+	flagSet.BoolVar(
+		&f.DetectCycles,
+		detectCyclesFlagName,
+		false,
+		"Print the import cycles between modules and between files instead of the graph",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	dirPath := "."
+	if container.NumArgs() > 0 {
+		dirPath = container.Arg(0)
+	}
+	if flags.Format != formatText && flags.Format != formatDOT {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s: unknown format %q, must be one of %s",
+			formatFlagName,
+			flags.Format,
+			stringutil.SliceToString(allFormats),
+		)
+	}
+	workspaceModules, err := getWorkspaceModules(
+		ctx,
+		bufcli.NewStorageosProvider(flags.DisableSymlinks),
+		dirPath,
+	)
+	if err != nil {
+		return err
+	}
+	fileImports, err := bufwork.GetWorkspaceFileImports(ctx, workspaceModules)
+	if err != nil {
+		return err
+	}
+	if flags.DetectCycles {
+		return printCycles(
+			container,
+			bufcli.WorkspaceModuleImportCycles(fileImports),
+			bufcli.WorkspaceFileImportCycles(fileImports),
+		)
+	}
+	var paths []string
+	pathToImportPathSet := make(map[string]map[string]struct{})
+	for _, workspaceModule := range workspaceModules {
+		fileInfos, err := workspaceModule.Module.SourceFileInfos(ctx)
+		if err != nil {
+			return err
+		}
+		for _, fileInfo := range fileInfos {
+			paths = append(paths, fileInfo.Path())
+			pathToImportPathSet[fileInfo.Path()] = make(map[string]struct{})
+		}
+	}
+	sort.Strings(paths)
+	for _, fileImport := range fileImports {
+		pathToImportPathSet[fileImport.FileInfo.Path()][fileImport.ImportPath] = struct{}{}
+	}
+	var builder strings.Builder
+	if flags.Format == formatDOT {
+		builder.WriteString("digraph {\n")
+	}
+	for _, path := range paths {
+		importPaths := stringutil.MapToSortedSlice(pathToImportPathSet[path])
+		if len(importPaths) == 0 {
+			writeNode(&builder, flags.Format, path)
+			continue
+		}
+		for _, importPath := range importPaths {
+			writeEdge(&builder, flags.Format, path, importPath)
+		}
+	}
+	if flags.Format == formatDOT {
+		builder.WriteString("}\n")
+	}
+	_, err = container.Stdout().Write([]byte(builder.String()))
+	return err
+}
+
+// getWorkspaceModules returns the WorkspaceModules of the workspace in the directory,
+// or a single WorkspaceModule for the module in the directory if there is no workspace.
+func getWorkspaceModules(
+	ctx context.Context,
+	storageosProvider storageos.Provider,
+	dirPath string,
+) ([]*bufwork.WorkspaceModule, error) {
+	readBucket, err := storageosProvider.NewReadWriteBucket(
+		dirPath,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	existingConfigFilePath, err := bufwork.ExistingConfigFilePath(ctx, readBucket)
+	if err != nil {
+		return nil, err
+	}
+	if existingConfigFilePath != "" {
+		return bufcli.WorkspaceModulesForDir(ctx, storageosProvider, dirPath)
+	}
+	moduleConfig, err := bufconfig.ReadConfigOS(ctx, readBucket)
+	if err != nil {
+		return nil, err
+	}
+	module, err := bufmodulebuild.BuildForBucket(
+		ctx,
+		readBucket,
+		moduleConfig.Build,
+		bufmodulebuild.WithModuleIdentity(moduleConfig.ModuleIdentity),
+		bufmodulebuild.WithLookupEnv(os.LookupEnv),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return []*bufwork.WorkspaceModule{
+		{
+			Directory: ".",
+			Config:    moduleConfig,
+			Module:    module,
+		},
+	}, nil
+}
+
+func printCycles(
+	container appflag.Container,
+	moduleCycles []*importcycle.Cycle,
+	fileCycles []*importcycle.Cycle,
+) error {
+	if len(moduleCycles) == 0 && len(fileCycles) == 0 {
+		return nil
+	}
+	descriptions := make([]string, 0, len(moduleCycles)+len(fileCycles))
+	for _, moduleCycle := range moduleCycles {
+		descriptions = append(descriptions, "Module import cycle: "+moduleCycle.Description())
+	}
+	for _, fileCycle := range fileCycles {
+		descriptions = append(descriptions, "File import cycle: "+fileCycle.Description())
+	}
+	if _, err := container.Stdout().Write([]byte(strings.Join(descriptions, "\n"))); err != nil {
+		return err
+	}
+	return bufcli.ErrFileAnnotation
+}
+
+func writeNode(builder *strings.Builder, format string, path string) {
+	switch format {
+	case formatText:
+		fmt.Fprintf(builder, "%s\n", path)
+	case formatDOT:
+		fmt.Fprintf(builder, "  %q\n", path)
+	}
+}
+
+func writeEdge(builder *strings.Builder, format string, from string, to string) {
+	switch format {
+	case formatText:
+		fmt.Fprintf(builder, "%s -> %s\n", from, to)
+	case formatDOT:
+		fmt.Fprintf(builder, "  %q -> %q\n", from, to)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagemerge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	asFileDescriptorSetFlagName = "as-file-descriptor-set"
+	excludeImportsFlagName      = "exclude-imports"
+	onConflictFlagName          = "on-conflict"
+	outputFlagName              = "output"
+	outputFlagShortName         = "o"
+
+	onConflictFail  = "fail"
+	onConflictFirst = "first"
+	onConflictLast  = "last"
+)
+
+var onConflictToMergeConflictResolution = map[string]bufimage.MergeConflictResolution{
+	onConflictFail:  bufimage.MergeConflictResolutionFail,
+	onConflictFirst: bufimage.MergeConflictResolutionPreferFirst,
+	onConflictLast:  bufimage.MergeConflictResolutionPreferLast,
+}
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <image> <image>... -o <output>",
+		Short: "Merge multiple Buf images into a single image",
+		Long: `Merge multiple Buf images into a single image.
+
+Each <image> is a Buf image or FileDescriptorSet, in any of the image formats
+accepted by other commands. A file that is defined identically in multiple images
+is included once. A file that is defined differently in multiple images is a
+conflict. By default, all conflicts are reported and the command fails. Use
+--` + onConflictFlagName + `=first or --` + onConflictFlagName + `=last to instead use the definition from the
+first or last image that defines the file.
+
+    $ buf beta image merge a.binpb b.binpb -o merged.binpb`,
+		Args: cobra.MinimumNArgs(2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	AsFileDescriptorSet bool
+	ExcludeImports      bool
+	OnConflict          string
+	Output              string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindAsFileDescriptorSet(flagSet, &f.AsFileDescriptorSet, asFileDescriptorSetFlagName)
+	bufcli.BindExcludeImports(flagSet, &f.ExcludeImports, excludeImportsFlagName)
+	flagSet.StringVar(
+		&f.OnConflict,
+		onConflictFlagName,
+		onConflictFail,
+		fmt.Sprintf(
+			`How to handle files that are defined differently in multiple images. Must be one of %s`,
+			stringutil.SliceToString([]string{onConflictFail, onConflictFirst, onConflictLast}),
+		),
+	)
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"",
+		fmt.Sprintf(
+			`The output location for the merged image. Must be one of format %s`,
+			buffetch.ImageFormatsString,
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if flags.Output == "" {
+		return appcmd.NewInvalidArgumentErrorf("required flag %q not set", outputFlagName)
+	}
+	mergeConflictResolution, ok := onConflictToMergeConflictResolution[flags.OnConflict]
+	if !ok {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s must be one of %s, got %q",
+			onConflictFlagName,
+			stringutil.SliceToString([]string{onConflictFail, onConflictFirst, onConflictLast}),
+			flags.OnConflict,
+		)
+	}
+	imageRefParser := buffetch.NewImageRefParser(container.Logger())
+	outputImageRef, err := imageRefParser.GetImageRef(ctx, flags.Output)
+	if err != nil {
+		return fmt.Errorf("--%s: %v", outputFlagName, err)
+	}
+	imageReader := bufcli.NewWireImageReader(
+		container.Logger(),
+		bufcli.NewStorageosProvider(false),
+		command.NewRunner(),
+	)
+	inputs := make([]string, container.NumArgs())
+	images := make([]bufimage.Image, container.NumArgs())
+	for i := 0; i < container.NumArgs(); i++ {
+		inputs[i] = container.Arg(i)
+		imageRef, err := imageRefParser.GetImageRef(ctx, inputs[i])
+		if err != nil {
+			return err
+		}
+		images[i], err = imageReader.GetImage(
+			ctx,
+			container,
+			imageRef,
+			nil,   // externalDirOrFilePaths
+			nil,   // externalExcludeDirOrFilePaths
+			false, // externalDirOrFilePathsAllowNotExist
+			false, // excludeSourceCodeInfo
+		)
+		if err != nil {
+			return err
+		}
+	}
+	image, err := bufimage.MergeImagesWithConflictResolution(mergeConflictResolution, images...)
+	if err != nil {
+		conflictErr := &bufimage.ImageMergeConflictError{}
+		if errors.As(err, &conflictErr) {
+			return newConflictError(conflictErr, inputs)
+		}
+		return err
+	}
+	return bufcli.NewWireImageWriter(
+		container.Logger(),
+	).PutImage(
+		ctx,
+		container,
+		outputImageRef,
+		image,
+		flags.AsFileDescriptorSet,
+		flags.ExcludeImports,
+	)
+}
+
+func newConflictError(conflictErr *bufimage.ImageMergeConflictError, inputs []string) error {
+	lines := make([]string, 0, len(conflictErr.Conflicts))
+	for _, conflict := range conflictErr.Conflicts {
+		conflictInputs := make([]string, len(conflict.ImageIndexes))
+		for i, imageIndex := range conflict.ImageIndexes {
+			conflictInputs[i] = inputs[imageIndex]
+		}
+		lines = append(lines, fmt.Sprintf("  %s: defined differently in %s", conflict.Path, strings.Join(conflictInputs, ", ")))
+	}
+	return fmt.Errorf(
+		"%d file(s) defined differently in multiple images, use --%s=%s or --%s=%s to resolve:\n%s",
+		len(conflictErr.Conflicts),
+		onConflictFlagName,
+		onConflictFirst,
+		onConflictFlagName,
+		onConflictLast,
+		strings.Join(lines, "\n"),
+	)
+}
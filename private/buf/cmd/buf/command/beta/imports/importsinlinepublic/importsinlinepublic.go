@@ -0,0 +1,244 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importsinlinepublic
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimport"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/diff"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	writeFlagName           = "write"
+	writeFlagShortName      = "w"
+	errorFormatFlagName     = "error-format"
+	configFlagName          = "config"
+	pathsFlagName           = "path"
+	excludePathsFlagName    = "exclude-path"
+	disableSymlinksFlagName = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <directory>",
+		Short: "Import the files of types forwarded by public imports directly",
+		Long: `The first argument is the local directory of the source to fix.
+Defaults to "." if no argument is specified.
+
+Files that use types that are forwarded by public imports, instead of importing
+the files that define these types, get import statements for these files added
+after their last import statement. The public imports themselves are not changed,
+as files outside of the directory may depend on them. Once no files depend on a
+public import, as shown by "buf beta imports report", it can be removed.
+
+The imports that the files no longer need after the fix are reported by the
+IMPORT_USED lint rule.
+
+By default, the diff of the fix is printed. Use -w to rewrite the files in-place.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Write           bool
+	ErrorFormat     string
+	Config          string
+	Paths           []string
+	ExcludePaths    []string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
+	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.BoolVarP(
+		&f.Write,
+		writeFlagName,
+		writeFlagShortName,
+		false,
+		"Rewrite files in-place instead of printing the diff",
+	)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The buf.yaml file or data to use for configuration`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	input, err := bufcli.GetInputValue(container, "", ".")
+	if err != nil {
+		return err
+	}
+	sourceRef, err := buffetch.NewRefParser(container.Logger()).GetSourceRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	if sourceRef.DirPath() == "" {
+		return appcmd.NewInvalidArgumentErrorf("input must be a local directory: %q", input)
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	imageConfigReader, err := bufcli.NewWireImageConfigReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+	)
+	if err != nil {
+		return err
+	}
+	imageConfigs, fileAnnotations, err := imageConfigReader.GetImageConfigs(
+		ctx,
+		container,
+		sourceRef,
+		flags.Config,
+		flags.Paths,
+		flags.ExcludePaths,
+		false, // input files must exist
+		false, // we need source info for the locations of imports
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, flags.ErrorFormat); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	for _, imageConfig := range imageConfigs {
+		forwards, err := bufimport.GetImageForwards(ctx, imageConfig.Image())
+		if err != nil {
+			return err
+		}
+		filePathToFile := make(map[string]protosource.File)
+		for _, forward := range forwards {
+			filePathToFile[forward.File.Path()] = forward.File
+		}
+		filePathToMissingImportPaths := bufimport.GetMissingImports(forwards)
+		filePaths := make([]string, 0, len(filePathToMissingImportPaths))
+		for filePath := range filePathToMissingImportPaths {
+			filePaths = append(filePaths, filePath)
+		}
+		sort.Strings(filePaths)
+		for _, filePath := range filePaths {
+			if err := fixFile(
+				ctx,
+				container,
+				runner,
+				filePathToFile[filePath],
+				filePathToMissingImportPaths[filePath],
+				flags.Write,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fixFile adds the imports to the file, and either rewrites the file or prints the diff.
+func fixFile(
+	ctx context.Context,
+	container appflag.Container,
+	runner command.Runner,
+	file protosource.File,
+	importPaths []string,
+	write bool,
+) error {
+	externalPath := file.ExternalPath()
+	fileInfo, err := os.Stat(externalPath)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(externalPath)
+	if err != nil {
+		return err
+	}
+	fixedData, err := bufimport.AddImports(file, data, importPaths)
+	if err != nil {
+		return err
+	}
+	if write {
+		return os.WriteFile(externalPath, fixedData, fileInfo.Mode().Perm())
+	}
+	fileDiff, err := diff.Diff(
+		ctx,
+		runner,
+		data,
+		fixedData,
+		externalPath,
+		externalPath,
+		diff.DiffWithSuppressTimestamps(),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = container.Stdout().Write(fileDiff)
+	return err
+}
@@ -0,0 +1,140 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifestdiff
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	formatFlagName          = "format"
+	disableSymlinksFlagName = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <from-dir-or-module> <to-dir-or-module>",
+		Short: "Compare the manifests of two directories or modules",
+		Long: `The paths that were added, removed, or changed from the first to the second input are printed with their digests.
+
+Modules are compared by the manifests stored in the registry, and directories by the manifests of the modules built from them. Nothing is compiled.
+When a directory is compared with a module, the directory is digested with the digest type of the module's manifest.`,
+		Args: cobra.ExactArgs(2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Format          string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	refParser := buffetch.NewRefParser(container.Logger())
+	inputs := []string{container.Arg(0), container.Arg(1)}
+	manifests := make([]*manifest.Manifest, len(inputs))
+	var sourceInputIndexes []int
+	// Modules are fetched first, so that directories can be digested with the
+	// same digest type.
+	digestType := manifest.DigestTypeShake256
+	for i, input := range inputs {
+		sourceOrModuleRef, err := refParser.GetSourceOrModuleRef(ctx, input)
+		if err != nil {
+			return err
+		}
+		if _, ok := sourceOrModuleRef.(buffetch.ModuleRef); !ok {
+			sourceInputIndexes = append(sourceInputIndexes, i)
+			continue
+		}
+		moduleReference, err := bufmoduleref.ModuleReferenceForString(input)
+		if err != nil {
+			return appcmd.NewInvalidArgumentError(err.Error())
+		}
+		clientConfig, err := bufcli.NewConnectClientConfig(container)
+		if err != nil {
+			return err
+		}
+		manifests[i], err = bufcli.ManifestForModuleReference(ctx, clientConfig, moduleReference)
+		if err != nil {
+			return err
+		}
+		if digests := manifests[i].Digests(); len(digests) > 0 {
+			digestType = digests[0].Type()
+		}
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	for _, i := range sourceInputIndexes {
+		manifests[i], err = bufcli.ManifestForSource(
+			ctx,
+			container,
+			storageosProvider,
+			runner,
+			inputs[i],
+			digestType,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return bufprint.NewManifestDiffPrinter(container.Stdout()).
+		PrintManifestDiff(ctx, format, manifest.Diff(manifests[0], manifests[1])...)
+}
@@ -0,0 +1,301 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorsync
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulecache"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	modulesFileFlagName = "modules-file"
+	dirFlagName         = "dir"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name,
+		Short: "Download modules into a verified local mirror",
+		Long: `Download the modules listed in the modules file into the mirror directory, and keep them up to date.
+
+The modules file lists one module reference per line, such as buf.build/acme/weather or
+buf.build/acme/weather:v1.2.0. Empty lines and lines starting with # are ignored. References
+without a commit, such as tags and branches, are resolved again on every sync, and their
+new commits are added to the mirror.
+
+Every module is verified as with "buf registry module download --verify" before it is
+added to the mirror. Modules already in the mirror are verified against their digests
+instead of being downloaded again.
+
+The mirror has the layout of the module cache, so it can be used offline, for example in
+air-gapped environments, by setting ` + bufcli.BetaEnableTamperProofingEnvKey + `=true and BUF_CACHE_DIR to the
+mirror directory.`,
+		Args: cobra.NoArgs,
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	ModulesFile string
+	Dir         string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.ModulesFile,
+		modulesFileFlagName,
+		"",
+		`The file that lists the module references to mirror, one per line`,
+	)
+	_ = cobra.MarkFlagRequired(flagSet, modulesFileFlagName)
+	flagSet.StringVar(
+		&f.Dir,
+		dirFlagName,
+		"",
+		`The mirror directory`,
+	)
+	_ = cobra.MarkFlagRequired(flagSet, dirFlagName)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	moduleReferences, err := readModulesFile(flags.ModulesFile)
+	if err != nil {
+		return err
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	storeDirPath := bufcli.CASModuleCacheDirPath(flags.Dir)
+	if err := os.MkdirAll(storeDirPath, 0755); err != nil {
+		return err
+	}
+	// do NOT want to enable symlinks for the mirror, as for the module cache
+	storeBucket, err := storageos.NewProvider().NewReadWriteBucket(storeDirPath)
+	if err != nil {
+		return err
+	}
+	store := bufmodulecache.NewCASModuleStore(container.Logger(), storeBucket)
+	for _, moduleReference := range moduleReferences {
+		modulePin, err := resolveModulePin(ctx, clientConfig, moduleReference)
+		if err != nil {
+			return err
+		}
+		status, err := syncModule(ctx, container, clientConfig, store, modulePin)
+		if err != nil {
+			return fmt.Errorf("%s: %w", moduleReference.String(), err)
+		}
+		if _, err := fmt.Fprintf(container.Stdout(), "%s\t%s\n", modulePin.String(), status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncModule adds the module of the pin to the store if it is not already stored,
+// and returns a description of what was done.
+func syncModule(
+	ctx context.Context,
+	container appflag.Container,
+	clientConfig *connectclient.Config,
+	store bufmodulecache.CASModuleStore,
+	modulePin bufmoduleref.ModulePin,
+) (string, error) {
+	upToDate, err := isStored(ctx, store, modulePin)
+	if err != nil {
+		// The stored module does not match its digests, download it again.
+		container.Logger().Warn(
+			"replacing invalid mirrored module",
+			zap.String("module", modulePin.String()),
+			zap.Error(err),
+		)
+	}
+	if upToDate {
+		return "up to date", nil
+	}
+	moduleReference, err := bufmoduleref.NewModuleReference(
+		modulePin.Remote(),
+		modulePin.Owner(),
+		modulePin.Repository(),
+		modulePin.Commit(),
+	)
+	if err != nil {
+		return "", err
+	}
+	moduleManifest, blobSet, err := bufcli.DownloadModule(ctx, container, clientConfig, moduleReference, true)
+	if err != nil {
+		return "", err
+	}
+	module, err := bufmodule.NewModuleForManifestAndBlobSet(ctx, moduleManifest, blobSet)
+	if err != nil {
+		return "", err
+	}
+	if err := store.PutModule(ctx, modulePin, module); err != nil {
+		return "", err
+	}
+	return "downloaded", nil
+}
+
+// isStored returns true if the commit of the pin is stored with the manifest digest
+// of the pin, and the stored blobs match their digests.
+func isStored(
+	ctx context.Context,
+	store bufmodulecache.CASModuleStore,
+	modulePin bufmoduleref.ModulePin,
+) (bool, error) {
+	// The pin without a digest is looked up by its commit.
+	commitModulePin, err := bufmoduleref.NewModulePin(
+		modulePin.Remote(),
+		modulePin.Owner(),
+		modulePin.Repository(),
+		"",
+		modulePin.Commit(),
+		"",
+		modulePin.CreateTime(),
+	)
+	if err != nil {
+		return false, err
+	}
+	module, err := store.GetModule(ctx, commitModulePin)
+	if err != nil {
+		if storage.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	manifestBlob, err := module.Manifest().Blob()
+	if err != nil {
+		return false, err
+	}
+	if manifestBlob.Digest().String() != modulePin.Digest() {
+		return false, fmt.Errorf(
+			"manifest digest mismatch - expected: %q, found: %q",
+			modulePin.Digest(),
+			manifestBlob.Digest().String(),
+		)
+	}
+	return true, nil
+}
+
+// resolveModulePin resolves the module reference to the commit it currently points to.
+func resolveModulePin(
+	ctx context.Context,
+	clientConfig *connectclient.Config,
+	moduleReference bufmoduleref.ModuleReference,
+) (bufmoduleref.ModulePin, error) {
+	service := connectclient.Make(
+		clientConfig,
+		moduleReference.Remote(),
+		registryv1alpha1connect.NewRepositoryCommitServiceClient,
+	)
+	resp, err := service.GetRepositoryCommitByReference(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetRepositoryCommitByReferenceRequest{
+			RepositoryOwner: moduleReference.Owner(),
+			RepositoryName:  moduleReference.Repository(),
+			Reference:       moduleReference.Reference(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return nil, err
+	}
+	repositoryCommit := resp.Msg.RepositoryCommit
+	if repositoryCommit.ManifestDigest == "" {
+		return nil, fmt.Errorf("the registry has no manifest digest for %s", moduleReference.String())
+	}
+	return bufmoduleref.NewModulePin(
+		moduleReference.Remote(),
+		moduleReference.Owner(),
+		moduleReference.Repository(),
+		"",
+		repositoryCommit.Name,
+		repositoryCommit.ManifestDigest,
+		repositoryCommit.CreateTime.AsTime(),
+	)
+}
+
+// readModulesFile reads the module references of the modules file.
+func readModulesFile(modulesFilePath string) (_ []bufmoduleref.ModuleReference, retErr error) {
+	file, err := os.Open(modulesFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, file.Close())
+	}()
+	var moduleReferences []bufmoduleref.ModuleReference
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		moduleReference, err := bufmoduleref.ModuleReferenceForString(line)
+		if err != nil {
+			return nil, appcmd.NewInvalidArgumentErrorf("%s:%d: %v", modulesFilePath, lineNumber, err)
+		}
+		moduleReferences = append(moduleReferences, moduleReference)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(moduleReferences) == 0 {
+		return nil, appcmd.NewInvalidArgumentErrorf("%s lists no modules", modulesFilePath)
+	}
+	return moduleReferences, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockserve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufrpc"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/bufbuild/buf/private/pkg/transport/http/httpserver"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	errorFormatFlagName     = "error-format"
+	configFlagName          = "config"
+	disableSymlinksFlagName = "disable-symlinks"
+	bindFlagName            = "bind"
+	portFlagName            = "port"
+	fixturesFlagName        = "fixtures"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <input>",
+		Short: "Serve mocks of the services of the input",
+		Long: `Serve mocks of the services of the input.
+
+Every method of the services of the input responds with an example response, over the
+Connect, gRPC, and gRPC-Web protocols, with HTTP/1.1 or HTTP/2 without TLS. Every field
+of an example response is set to a value that is derived from the name of the field and
+that satisfies its protovalidate constraints, such as min_len, gt, email, or in. Only the
+first field of each oneof is set, and recursive messages are not set.
+
+Responses can be seeded from a directory of fixtures with --` + fixturesFlagName + `. The fixture of a
+method is the JSON representation of its response, at the full name of the service followed
+by the name of the method, such as fixtures/acme.weather.v1.WeatherService/GetWeather.json.
+Fixtures are read for each RPC, so that they can be edited while the mocks are served.
+
+For example:
+
+    $ buf beta mock serve --port 9000 --fixtures fixtures
+    $ buf curl --schema . http://localhost:9000/acme.weather.v1.WeatherService/GetWeather
+
+` + bufcli.GetInputLong(`the source, module, or image to get the services from`),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	ErrorFormat     string
+	Config          string
+	DisableSymlinks bool
+	BindAddress     string
+	Port            string
+	Fixtures        string
+
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The file or data to use for configuration`,
+	)
+	flagSet.StringVar(
+		&f.BindAddress,
+		bindFlagName,
+		"127.0.0.1",
+		`The address to listen on`,
+	)
+	flagSet.StringVar(
+		&f.Port,
+		portFlagName,
+		"9000",
+		`The port to listen on`,
+	)
+	flagSet.StringVar(
+		&f.Fixtures,
+		fixturesFlagName,
+		"",
+		`The directory of fixtures to respond with`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	image, err := bufcli.NewImageForSource(
+		ctx,
+		container,
+		input,
+		flags.ErrorFormat,
+		flags.DisableSymlinks,
+		flags.Config,
+		nil,
+		nil,
+		false,
+		true, // source code info is not needed
+		"",
+		false,
+		0,
+		1,
+	)
+	if err != nil {
+		return err
+	}
+	res, err := protoencoding.NewResolver(bufimage.ImageToFileDescriptors(image)...)
+	if err != nil {
+		return err
+	}
+	methodDescriptors, err := bufrpc.ImageMethodDescriptors(image, res)
+	if err != nil {
+		return err
+	}
+	if len(methodDescriptors) == 0 {
+		return errors.New("input contains no methods")
+	}
+	var mockOptions []bufrpc.MockOption
+	if flags.Fixtures != "" {
+		fixturesBucket, err := bufcli.NewStorageosProvider(flags.DisableSymlinks).NewReadWriteBucket(
+			flags.Fixtures,
+			storageos.ReadWriteBucketWithSymlinksIfSupported(),
+		)
+		if err != nil {
+			return err
+		}
+		mockOptions = append(mockOptions, bufrpc.MockWithFixtures(fixturesBucket))
+	}
+	var listenConfig net.ListenConfig
+	listener, err := listenConfig.Listen(ctx, "tcp", net.JoinHostPort(flags.BindAddress, flags.Port))
+	if err != nil {
+		return err
+	}
+	return httpserver.Run(
+		ctx,
+		container.Logger(),
+		listener,
+		bufrpc.NewMock(container.Logger(), methodDescriptors, res, mockOptions...),
+	)
+}
@@ -0,0 +1,329 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufconnect"
+	"github.com/bufbuild/buf/private/bufpkg/buflock"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	templateFlagName        = "template"
+	outputFlagName          = "output"
+	outputFlagShortName     = "o"
+	disableSymlinksFlagName = "disable-symlinks"
+
+	ownerPlaceholder      = "__owner__"
+	namePlaceholder       = "__name__"
+	pascalNamePlaceholder = "__Name__"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository>",
+		Short: "Create a new module from a template module",
+		Long: `Create a new module directory from a template module.
+
+The template is a source or module, typically a module on the Buf Schema Registry that
+is shared across an organization. All files of the template module are copied into the
+output directory, and the following placeholders are replaced in both the file paths and
+the file contents, such as in package names and file options:
+
+    ` + ownerPlaceholder + `  The owner of the new module, in lower_snake_case.
+    ` + namePlaceholder + `   The repository of the new module, in lower_snake_case.
+    ` + pascalNamePlaceholder + `   The repository of the new module, in PascalCase.
+
+For example, a template file at ` + ownerPlaceholder + `/` + namePlaceholder + `/v1/` + namePlaceholder + `.proto containing:
+
+    package ` + ownerPlaceholder + `.` + namePlaceholder + `.v1;
+
+    service ` + pascalNamePlaceholder + `Service {}
+
+is written to acme/payments/v1/payments.proto, with package acme.payments.v1 and service
+PaymentsService, when creating buf.build/acme/payments.
+
+The buf.yaml of the new module has the name of the new module, and the dependencies, lint
+and breaking configuration of the template. The buf.lock of the template is copied as is.
+
+If the remote is omitted from the module name, the remote of the template is used, or
+` + bufconnect.DefaultRemote + ` if the template is not a remote module.
+
+The output directory defaults to the repository name, and must not exist or be empty.
+
+    $ buf beta new service acme/payments --template buf.build/acme/proto-template`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Template        string
+	Output          string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Template,
+		templateFlagName,
+		"",
+		`The source or module to use as the template, such as buf.build/acme/proto-template`,
+	)
+	_ = cobra.MarkFlagRequired(flagSet, templateFlagName)
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"",
+		`The output directory for the new module. Defaults to the repository name`,
+	)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	templateRef, err := buffetch.NewRefParser(container.Logger()).GetSourceOrModuleRef(ctx, flags.Template)
+	if err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", templateFlagName, err)
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	moduleReader, err := bufcli.NewModuleReaderAndCreateCacheDirs(container, clientConfig)
+	if err != nil {
+		return err
+	}
+	moduleConfigReader, err := bufcli.NewWireModuleConfigReaderForModuleReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+		moduleReader,
+	)
+	if err != nil {
+		return err
+	}
+	moduleConfigs, err := moduleConfigReader.GetModuleConfigs(
+		ctx,
+		container,
+		templateRef,
+		"",
+		nil,
+		nil,
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	if len(moduleConfigs) != 1 {
+		return fmt.Errorf("template %q must contain exactly one module, but found %d", flags.Template, len(moduleConfigs))
+	}
+	templateModule := moduleConfigs[0].Module()
+	templateRemote, err := getTemplateRemote(templateRef, flags.Template, moduleConfigs[0].Config())
+	if err != nil {
+		return err
+	}
+	moduleIdentity, err := newModuleIdentity(container.Arg(0), templateRemote)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	output := flags.Output
+	if output == "" {
+		output = moduleIdentity.Repository()
+	}
+	if err := checkOutputEmpty(output); err != nil {
+		return err
+	}
+	templateBucket := storagemem.NewReadWriteBucket()
+	if err := bufmodule.ModuleToBucket(ctx, templateModule, templateBucket); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(output, 0755); err != nil {
+		return err
+	}
+	outputBucket, err := storageosProvider.NewReadWriteBucket(output)
+	if err != nil {
+		return err
+	}
+	replacer := newReplacer(moduleIdentity)
+	if err := storage.WalkReadObjects(
+		ctx,
+		templateBucket,
+		"",
+		func(readObject storage.ReadObject) error {
+			switch path := readObject.Path(); path {
+			case bufconfig.ExternalConfigV1FilePath:
+				// The configuration is written below with the new module name.
+				return nil
+			case buflock.ExternalConfigFilePath:
+				if len(templateModule.DependencyModulePins()) == 0 {
+					return nil
+				}
+				return storage.CopyReadObject(ctx, outputBucket, readObject)
+			default:
+				data, err := io.ReadAll(readObject)
+				if err != nil {
+					return err
+				}
+				return storage.PutPath(
+					ctx,
+					outputBucket,
+					replacer.Replace(path),
+					[]byte(replacer.Replace(string(data))),
+				)
+			}
+		},
+	); err != nil {
+		return err
+	}
+	if err := writeConfig(ctx, outputBucket, templateModule, moduleIdentity); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(container.Stderr(), "Created %s in %s\n", moduleIdentity.IdentityString(), output)
+	return err
+}
+
+// getTemplateRemote returns the remote of the template, or empty if the template
+// is not a remote module and its configuration does not have a name.
+func getTemplateRemote(templateRef buffetch.SourceOrModuleRef, template string, templateConfig *bufconfig.Config) (string, error) {
+	if _, ok := templateRef.(buffetch.ModuleRef); ok {
+		// The configuration of a ModuleConfig for a ModuleRef is read from the current
+		// directory, so we get the remote from the reference itself.
+		templateModuleReference, err := bufmoduleref.ModuleReferenceForString(template)
+		if err != nil {
+			return "", err
+		}
+		return templateModuleReference.Remote(), nil
+	}
+	if templateConfig != nil && templateConfig.ModuleIdentity != nil {
+		return templateConfig.ModuleIdentity.Remote(), nil
+	}
+	return "", nil
+}
+
+// newModuleIdentity returns the ModuleIdentity for the given module name.
+//
+// If the module name does not have a remote, the remote of the template is used
+// if it has one, otherwise the default remote.
+func newModuleIdentity(moduleName string, templateRemote string) (bufmoduleref.ModuleIdentity, error) {
+	if strings.Count(moduleName, "/") == 1 {
+		remote := templateRemote
+		if remote == "" {
+			remote = bufconnect.DefaultRemote
+		}
+		moduleName = remote + "/" + moduleName
+	}
+	return bufmoduleref.ModuleIdentityForString(moduleName)
+}
+
+// newReplacer returns a new Replacer for the placeholders of the given ModuleIdentity.
+func newReplacer(moduleIdentity bufmoduleref.ModuleIdentity) *strings.Replacer {
+	return strings.NewReplacer(
+		ownerPlaceholder, stringutil.ToLowerSnakeCase(moduleIdentity.Owner()),
+		namePlaceholder, stringutil.ToLowerSnakeCase(moduleIdentity.Repository()),
+		pascalNamePlaceholder, stringutil.ToPascalCase(moduleIdentity.Repository()),
+	)
+}
+
+// checkOutputEmpty returns an error if the output directory exists and is not empty.
+func checkOutputEmpty(output string) error {
+	entries, err := os.ReadDir(output)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("output directory %q is not empty", filepath.Clean(output))
+	}
+	return nil
+}
+
+// writeConfig writes the configuration of the new module, with the dependencies,
+// lint and breaking configuration of the template module.
+func writeConfig(
+	ctx context.Context,
+	writeBucket storage.WriteBucket,
+	templateModule bufmodule.Module,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+) error {
+	version := bufconfig.V1Version
+	if lintConfig := templateModule.LintConfig(); lintConfig != nil && lintConfig.Version != "" {
+		version = lintConfig.Version
+	}
+	dependencyModulePins := templateModule.DependencyModulePins()
+	dependencyModuleReferences := make([]bufmoduleref.ModuleReference, len(dependencyModulePins))
+	for i, dependencyModulePin := range dependencyModulePins {
+		dependencyModuleReference, err := bufmoduleref.ModuleReferenceForString(dependencyModulePin.IdentityString())
+		if err != nil {
+			return err
+		}
+		dependencyModuleReferences[i] = dependencyModuleReference
+	}
+	return bufconfig.WriteConfig(
+		ctx,
+		writeBucket,
+		bufconfig.WriteConfigWithModuleIdentity(moduleIdentity),
+		bufconfig.WriteConfigWithDependencyModuleReferences(dependencyModuleReferences...),
+		bufconfig.WriteConfigWithBreakingConfig(templateModule.BreakingConfig()),
+		bufconfig.WriteConfigWithLintConfig(templateModule.LintConfig()),
+		bufconfig.WriteConfigWithVersion(version),
+		bufconfig.WriteConfigWithDocsDirectory(templateModule.DocsDirectory()),
+	)
+}
@@ -0,0 +1,194 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owners
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufowner"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	inputFlagName           = "input"
+	formatFlagName          = "format"
+	errorFormatFlagName     = "error-format"
+	configFlagName          = "config"
+	disableSymlinksFlagName = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <file|symbol>",
+		Short: "Show the owners of a file or symbol",
+		Long: `Show the teams that own a file, or the file that defines a symbol.
+
+The argument is either the path of a .proto file, such as acme/payments/v1/payments.proto,
+or the fully-qualified name of a message, enum, service, or other symbol, such as
+acme.payments.v1.Payment. The file or symbol is looked up in the input given by --input,
+which defaults to the current directory.
+
+Owners are configured with rules in the owners section of buf.yaml:
+
+    version: v1
+    owners:
+      - paths:
+          - acme/payments
+        teams:
+          - "@acme/payments"
+      - packages:
+          - acme.billing
+        teams:
+          - "@acme/billing"
+
+A rule matches a file if the file is at or below one of its paths, or if the package of
+the file is one of its packages or a sub-package of one of them. As for CODEOWNERS files,
+the last rule that matches a file determines its owners.
+
+The owners of files are also included in the JSON output of buf lint and buf breaking,
+so that failures can be routed to the teams that own the files.`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Input           string
+	Format          string
+	ErrorFormat     string
+	Config          string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.Input,
+		inputFlagName,
+		".",
+		`The source, module, or Image to look up the file or symbol in`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The buf.yaml file or data to use for configuration`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	fileOrSymbol := container.Arg(0)
+	if fileOrSymbol == "" {
+		return appcmd.NewInvalidArgumentError("file or symbol is required")
+	}
+	ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, flags.Input)
+	if err != nil {
+		return err
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	imageConfigReader, err := bufcli.NewWireImageConfigReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+	)
+	if err != nil {
+		return err
+	}
+	imageConfigs, fileAnnotations, err := imageConfigReader.GetImageConfigs(
+		ctx,
+		container,
+		ref,
+		flags.Config,
+		nil,
+		nil,
+		false,
+		true, // source code info is not needed
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, flags.ErrorFormat); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	for _, imageConfig := range imageConfigs {
+		ownership, err := bufowner.GetOwnership(imageConfig.Config().Owners, imageConfig.Image(), fileOrSymbol)
+		if err != nil {
+			// The file or symbol may be in another module of the workspace.
+			continue
+		}
+		return bufprint.NewOwnershipPrinter(container.Stdout()).PrintOwnership(ctx, format, ownership)
+	}
+	return fmt.Errorf("%q was not found in %s", fileOrSymbol, flags.Input)
+}
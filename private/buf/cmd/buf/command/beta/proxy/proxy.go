@@ -0,0 +1,311 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufrpc"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/bufbuild/buf/private/pkg/transport/http/httpserver"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	errorFormatFlagName           = "error-format"
+	configFlagName                = "config"
+	disableSymlinksFlagName       = "disable-symlinks"
+	targetFlagName                = "target"
+	bindFlagName                  = "bind"
+	portFlagName                  = "port"
+	protocolFlagName              = "protocol"
+	http2PriorKnowledgeFlagName   = "http2-prior-knowledge"
+	rejectInvalidRequestsFlagName = "reject-invalid-requests"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <input>",
+		Short: "Validate RPCs against the schema with a proxy to a server",
+		Long: `Validate RPCs against the schema with a proxy to a server.
+
+The proxy serves the methods of the services of the input, forwards each RPC to the server at
+--` + targetFlagName + `, and validates the request and response messages against the schema. Clients can
+use the Connect, gRPC, and gRPC-Web protocols, over HTTP/1.1 or HTTP/2 without TLS.
+
+A message violates the schema if it has unknown fields, which usually means that the client
+or the server uses a stale version of the schema, or if its fields do not satisfy their
+protovalidate constraints, such as min_len, gt, email, or in. CEL expressions are not
+evaluated. Violations are logged as warnings, and a summary of the violations of each method
+is printed when the proxy is stopped. With --` + rejectInvalidRequestsFlagName + `, requests that violate the
+schema are rejected with the invalid_argument code instead of being forwarded.
+
+For example:
+
+    $ buf beta proxy --target https://api.example.com --port 8080
+    $ buf curl --schema . --data '{"name": "foo"}' http://localhost:8080/foo.v1.FooService/GetFoo
+
+` + bufcli.GetInputLong(`the source, module, or image to get the services from`),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	ErrorFormat           string
+	Config                string
+	DisableSymlinks       bool
+	Target                string
+	BindAddress           string
+	Port                  string
+	Protocol              string
+	HTTP2PriorKnowledge   bool
+	RejectInvalidRequests bool
+
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The file or data to use for configuration`,
+	)
+	flagSet.StringVar(
+		&f.Target,
+		targetFlagName,
+		"",
+		`The base URL of the server to forward RPCs to, such as https://api.example.com`,
+	)
+	flagSet.StringVar(
+		&f.BindAddress,
+		bindFlagName,
+		"127.0.0.1",
+		`The address to listen on`,
+	)
+	flagSet.StringVar(
+		&f.Port,
+		portFlagName,
+		"8080",
+		`The port to listen on`,
+	)
+	flagSet.StringVar(
+		&f.Protocol,
+		protocolFlagName,
+		connect.ProtocolConnect,
+		fmt.Sprintf(
+			`The protocol to use for the server. Must be one of %q, %q, or %q`,
+			connect.ProtocolConnect,
+			connect.ProtocolGRPC,
+			connect.ProtocolGRPCWeb,
+		),
+	)
+	flagSet.BoolVar(
+		&f.HTTP2PriorKnowledge,
+		http2PriorKnowledgeFlagName,
+		false,
+		`Use HTTP/2 without TLS for the server, which the grpc protocol requires for http URLs`,
+	)
+	flagSet.BoolVar(
+		&f.RejectInvalidRequests,
+		rejectInvalidRequestsFlagName,
+		false,
+		`Reject requests that violate the schema instead of forwarding them`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	if flags.Target == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", targetFlagName)
+	}
+	clientOptions, err := bufrpc.ClientOptions(flags.Protocol)
+	if err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", protocolFlagName, err)
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	image, err := bufcli.NewImageForSource(
+		ctx,
+		container,
+		input,
+		flags.ErrorFormat,
+		flags.DisableSymlinks,
+		flags.Config,
+		nil,
+		nil,
+		false,
+		true, // source code info is not needed
+		"",
+		false,
+		0,
+		1,
+	)
+	if err != nil {
+		return err
+	}
+	res, err := protoencoding.NewResolver(bufimage.ImageToFileDescriptors(image)...)
+	if err != nil {
+		return err
+	}
+	methodDescriptors, err := bufrpc.ImageMethodDescriptors(image, res)
+	if err != nil {
+		return err
+	}
+	if len(methodDescriptors) == 0 {
+		return errors.New("input contains no methods")
+	}
+	summary := newSummary()
+	options := []bufrpc.ProxyOption{
+		bufrpc.ProxyWithViolationFunc(summary.add),
+	}
+	if flags.RejectInvalidRequests {
+		options = append(options, bufrpc.ProxyWithRejectInvalidRequests())
+	}
+	handler := bufrpc.NewProxy(
+		container.Logger(),
+		methodDescriptors,
+		res,
+		bufrpc.NewHTTPClient(flags.Target, flags.HTTP2PriorKnowledge),
+		flags.Target,
+		clientOptions,
+		options...,
+	)
+	var listenConfig net.ListenConfig
+	listener, err := listenConfig.Listen(ctx, "tcp", net.JoinHostPort(flags.BindAddress, flags.Port))
+	if err != nil {
+		return err
+	}
+	if err := httpserver.Run(
+		ctx,
+		container.Logger(),
+		listener,
+		handler,
+	); err != nil {
+		return err
+	}
+	return summary.print(container.Stdout())
+}
+
+// summary counts the messages that violate the schema, by method.
+type summary struct {
+	lock   sync.Mutex
+	counts map[string]*summaryCount
+}
+
+type summaryCount struct {
+	requests  int
+	responses int
+}
+
+func newSummary() *summary {
+	return &summary{
+		counts: make(map[string]*summaryCount),
+	}
+}
+
+func (s *summary) add(methodDescriptor protoreflect.MethodDescriptor, isResponse bool, _ []*bufrpc.Violation) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	method := string(methodDescriptor.FullName())
+	count, ok := s.counts[method]
+	if !ok {
+		count = &summaryCount{}
+		s.counts[method] = count
+	}
+	if isResponse {
+		count.responses++
+	} else {
+		count.requests++
+	}
+}
+
+func (s *summary) print(writer io.Writer) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.counts) == 0 {
+		_, err := fmt.Fprintln(writer, "No schema violations.")
+		return err
+	}
+	methods := make([]string, 0, len(s.counts))
+	for method := range s.counts {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	for _, method := range methods {
+		count := s.counts[method]
+		if _, err := fmt.Fprintf(
+			writer,
+			"%s: %d invalid requests, %d invalid responses\n",
+			method,
+			count.requests,
+			count.responses,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
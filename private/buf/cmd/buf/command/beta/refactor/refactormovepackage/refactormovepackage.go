@@ -0,0 +1,444 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package refactormovepackage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/bufrefactor"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+)
+
+const (
+	toFlagName          = "to"
+	formatFlagName      = "format"
+	applyFlagName       = "apply"
+	outputFlagName      = "output"
+	outputFlagShortName = "o"
+
+	formatText = "text"
+	formatJSON = "json"
+)
+
+var allFormats = []string{formatText, formatJSON}
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <package> <directory> --to <buf.build/owner/repository>",
+		Short: "Plan the move of a package to another module",
+		Long: `The first argument is the package to move. The second argument is the directory of the
+local module that contains the package. Defaults to "." if no second argument is specified.
+
+The files of the package move to the module given by --` + toFlagName + `, keeping their paths, so that
+the import statements that refer to them do not change. The plan lists the files to move, the
+imports of the moved files by the files that stay, which then resolve to the new module, and the
+imports of the files that stay by the moved files, which then resolve to the current module.
+These are computed from the import statements of the files, without compiling them.
+
+If only one of the modules imports files of the other after the move, the plan lists the steps
+to do the move in an order in which every push resolves its dependencies. If both modules import
+files of each other, the move would create a dependency cycle between them, and no steps are
+listed: the imports that cause the cycle must be removed first.
+
+If --` + applyFlagName + ` is set, the local edits of the plan are done: the moved files are moved
+to the directory given by --` + outputFlagName + ` with a new ` + bufconfig.ExternalConfigV1FilePath + ` for the new module, and
+the new module is added to the deps of the current module if it imports the moved files.
+Pushing the modules and updating their lock files is left to the steps of the plan.`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	To     string
+	Format string
+	Apply  bool
+	Output string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.To,
+		toFlagName,
+		"",
+		"The module to move the package to, such as buf.build/owner/repository",
+	)
+	_ = cobra.MarkFlagRequired(flagSet, toFlagName)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		formatText,
+		fmt.Sprintf(
+			"The output format to use. Must be one of %s",
+			stringutil.SliceToString(allFormats),
+		),
+	)
+	flagSet.BoolVar(
+		&f.Apply,
+		applyFlagName,
+		false,
+		fmt.Sprintf(
+			"Move the files of the package to the directory given by --%s and update the %s files",
+			outputFlagName,
+			bufconfig.ExternalConfigV1FilePath,
+		),
+	)
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"",
+		fmt.Sprintf(
+			"The directory to create the new module in. Required if --%s is set",
+			applyFlagName,
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	switch flags.Format {
+	case formatText, formatJSON:
+	default:
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s must be one of %s",
+			formatFlagName,
+			stringutil.SliceToString(allFormats),
+		)
+	}
+	if flags.Apply && flags.Output == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required if --%s is set", outputFlagName, applyFlagName)
+	}
+	pkg := container.Arg(0)
+	if pkg == "" {
+		return appcmd.NewInvalidArgumentError("package is required")
+	}
+	targetModuleIdentity, err := bufmoduleref.ModuleIdentityForString(flags.To)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	directoryInput := "."
+	if container.NumArgs() > 1 {
+		directoryInput = container.Arg(1)
+	}
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		directoryInput,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return err
+	}
+	existingConfigFilePath, err := bufconfig.ExistingConfigFilePath(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	if existingConfigFilePath == "" {
+		return bufcli.ErrNoConfigFile
+	}
+	config, err := bufconfig.ReadConfigOS(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	builtModule, err := bufmodulebuild.BuildForBucket(ctx, readWriteBucket, config.Build)
+	if err != nil {
+		return err
+	}
+	files, err := bufrefactor.GetFiles(ctx, builtModule.Module)
+	if err != nil {
+		return err
+	}
+	plan, err := bufrefactor.NewMovePackagePlan(files, pkg, config.ModuleIdentity, targetModuleIdentity)
+	if err != nil {
+		return err
+	}
+	if flags.Apply {
+		if err := apply(ctx, container.Logger(), storageosProvider, readWriteBucket, existingConfigFilePath, config, plan, flags.Output); err != nil {
+			return err
+		}
+	}
+	if flags.Format == formatJSON {
+		return printPlanJSON(container.Stdout(), plan)
+	}
+	return printPlanText(container.Stdout(), plan)
+}
+
+// apply moves the files of the plan from the source module in readWriteBucket
+// to outputDirPath, writes the configuration file of the target module, and
+// adds the target module to the deps of the source module if it imports the
+// moved files.
+func apply(
+	ctx context.Context,
+	logger *zap.Logger,
+	storageosProvider storageos.Provider,
+	readWriteBucket storage.ReadWriteBucket,
+	configFilePath string,
+	config *bufconfig.Config,
+	plan *bufrefactor.MovePackagePlan,
+	outputDirPath string,
+) error {
+	if plan.Direction == bufrefactor.DirectionCycle {
+		return fmt.Errorf(
+			"cannot apply the move of package %q, as the modules would import files of each other",
+			plan.Package,
+		)
+	}
+	if plan.Direction == bufrefactor.DirectionTargetToSource && plan.SourceModuleIdentity == nil {
+		return fmt.Errorf(
+			"cannot apply the move of package %q, as %s imports files of the source module, which has no name",
+			plan.Package,
+			plan.TargetModuleIdentity.IdentityString(),
+		)
+	}
+	for root := range config.Build.RootToExcludes {
+		if root != "." {
+			return fmt.Errorf("cannot apply the move of package %q, as the source module has the root %q", plan.Package, root)
+		}
+	}
+	if err := os.MkdirAll(outputDirPath, 0755); err != nil {
+		return err
+	}
+	targetReadWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		outputDirPath,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return err
+	}
+	targetConfigFilePath, err := bufconfig.ExistingConfigFilePath(ctx, targetReadWriteBucket)
+	if err != nil {
+		return err
+	}
+	if targetConfigFilePath != "" {
+		return fmt.Errorf("%s already contains the configuration file %s", outputDirPath, targetConfigFilePath)
+	}
+	var dependencyModuleReferences []bufmoduleref.ModuleReference
+	if len(plan.ExternalImportPaths) > 0 {
+		dependencyModuleReferences = append(dependencyModuleReferences, config.Build.DependencyModuleReferences...)
+	}
+	if plan.Direction == bufrefactor.DirectionTargetToSource {
+		sourceModuleReference, err := bufmoduleref.ModuleReferenceForString(plan.SourceModuleIdentity.IdentityString())
+		if err != nil {
+			return err
+		}
+		dependencyModuleReferences = append(dependencyModuleReferences, sourceModuleReference)
+	}
+	if err := bufconfig.WriteConfig(
+		ctx,
+		targetReadWriteBucket,
+		bufconfig.WriteConfigWithVersion(config.Version),
+		bufconfig.WriteConfigWithModuleIdentity(plan.TargetModuleIdentity),
+		bufconfig.WriteConfigWithDependencyModuleReferences(dependencyModuleReferences...),
+		bufconfig.WriteConfigWithBreakingConfig(config.Breaking),
+		bufconfig.WriteConfigWithLintConfig(config.Lint),
+	); err != nil {
+		return err
+	}
+	for _, path := range plan.MovedFilePaths {
+		data, err := storage.ReadPath(ctx, readWriteBucket, path)
+		if err != nil {
+			return err
+		}
+		if err := storage.PutPath(ctx, targetReadWriteBucket, path, data); err != nil {
+			return err
+		}
+		if err := readWriteBucket.Delete(ctx, path); err != nil {
+			return err
+		}
+		logger.Info("moved file", zap.String("path", path))
+	}
+	if plan.Direction != bufrefactor.DirectionSourceToTarget {
+		return nil
+	}
+	updated, err := bufconfig.UpdateDependencies(
+		ctx,
+		readWriteBucket,
+		configFilePath,
+		os.LookupEnv,
+		func(dependencies []bufconfig.Dependency) ([]string, error) {
+			values := make([]string, 0, len(dependencies)+1)
+			configured := false
+			for _, dependency := range dependencies {
+				if dependency.ModuleReference.IdentityString() == plan.TargetModuleIdentity.IdentityString() {
+					configured = true
+				}
+				values = append(values, dependency.Value)
+			}
+			if !configured {
+				values = append(values, plan.TargetModuleIdentity.IdentityString())
+			}
+			return values, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if updated {
+		logger.Info("adding dependency", zap.String("dependency", plan.TargetModuleIdentity.IdentityString()))
+	}
+	return nil
+}
+
+type externalPlan struct {
+	Package             string            `json:"package"`
+	Source              string            `json:"source"`
+	Target              string            `json:"target"`
+	Direction           string            `json:"direction"`
+	MovedFiles          []string          `json:"moved_files"`
+	SourceImports       []*externalImport `json:"source_imports"`
+	TargetImports       []*externalImport `json:"target_imports"`
+	ExternalImportPaths []string          `json:"external_imports"`
+	Steps               []string          `json:"steps"`
+}
+
+type externalImport struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Import string `json:"import"`
+}
+
+func newExternalImports(imports []*bufrefactor.Import) []*externalImport {
+	externalImports := make([]*externalImport, len(imports))
+	for i, fileImport := range imports {
+		externalImports[i] = &externalImport{
+			File:   fileImport.FilePath,
+			Line:   fileImport.Line,
+			Import: fileImport.ImportPath,
+		}
+	}
+	return externalImports
+}
+
+func printPlanJSON(writer io.Writer, plan *bufrefactor.MovePackagePlan) error {
+	var source string
+	if plan.SourceModuleIdentity != nil {
+		source = plan.SourceModuleIdentity.IdentityString()
+	}
+	steps := plan.Steps()
+	if steps == nil {
+		steps = []string{}
+	}
+	externalImportPaths := plan.ExternalImportPaths
+	if externalImportPaths == nil {
+		externalImportPaths = []string{}
+	}
+	data, err := json.MarshalIndent(
+		&externalPlan{
+			Package:             plan.Package,
+			Source:              source,
+			Target:              plan.TargetModuleIdentity.IdentityString(),
+			Direction:           plan.Direction.String(),
+			MovedFiles:          plan.MovedFilePaths,
+			SourceImports:       newExternalImports(plan.SourceImports),
+			TargetImports:       newExternalImports(plan.TargetImports),
+			ExternalImportPaths: externalImportPaths,
+			Steps:               steps,
+		},
+		"",
+		"  ",
+	)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(append(data, '\n'))
+	return err
+}
+
+func printPlanText(writer io.Writer, plan *bufrefactor.MovePackagePlan) error {
+	source := "the source module"
+	if plan.SourceModuleIdentity != nil {
+		source = plan.SourceModuleIdentity.IdentityString()
+	}
+	target := plan.TargetModuleIdentity.IdentityString()
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Move package %s from %s to %s.\n", plan.Package, source, target)
+	fmt.Fprintf(&builder, "\nFiles to move:\n")
+	for _, path := range plan.MovedFilePaths {
+		fmt.Fprintf(&builder, "  %s\n", path)
+	}
+	if len(plan.SourceImports) > 0 {
+		fmt.Fprintf(&builder, "\nImports that resolve to %s after the move:\n", target)
+		printImportsText(&builder, plan.SourceImports)
+	}
+	if len(plan.TargetImports) > 0 {
+		fmt.Fprintf(&builder, "\nImports that resolve to %s after the move:\n", source)
+		printImportsText(&builder, plan.TargetImports)
+	}
+	if len(plan.ExternalImportPaths) > 0 {
+		fmt.Fprintf(&builder, "\nImports of the moved files from the deps of %s:\n", source)
+		for _, externalImportPath := range plan.ExternalImportPaths {
+			fmt.Fprintf(&builder, "  %s\n", externalImportPath)
+		}
+	}
+	steps := plan.Steps()
+	if steps == nil {
+		fmt.Fprintf(
+			&builder,
+			"\nThe move would create a dependency cycle between %s and %s. Remove the imports that resolve to %s or to %s first.\n",
+			source,
+			target,
+			source,
+			target,
+		)
+	} else {
+		fmt.Fprintf(&builder, "\nSteps:\n")
+		for i, step := range steps {
+			fmt.Fprintf(&builder, "  %d. %s\n", i+1, step)
+		}
+	}
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
+
+func printImportsText(builder *strings.Builder, imports []*bufrefactor.Import) {
+	for _, fileImport := range imports {
+		fmt.Fprintf(builder, "  %s:%d: %s\n", fileImport.FilePath, fileImport.Line, fileImport.ImportPath)
+	}
+}
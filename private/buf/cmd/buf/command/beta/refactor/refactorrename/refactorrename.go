@@ -0,0 +1,312 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package refactorrename
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/bufpkg/bufrefactor"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/diff"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	toFlagName              = "to"
+	writeFlagName           = "write"
+	writeFlagShortName      = "w"
+	errorFormatFlagName     = "error-format"
+	configFlagName          = "config"
+	disableSymlinksFlagName = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <symbol> <directory> --to <name>",
+		Short: "Rename a message, enum, or field along with its references",
+		Long: `The first argument is the fully-qualified name of the message, enum, or field to rename,
+such as acme.v1.Order.total. The second argument is the local directory of the source that
+contains it. Defaults to "." if no second argument is specified. If the directory is a workspace,
+the references in all of its modules are renamed.
+
+Renamed fields keep their number, and their previous name is added to the reserved names of
+their message, so that it is not reused for another field. The references to renamed messages
+and enums by fields, extensions, and RPCs are changed to the new name.
+
+The changes that the breaking rules of the module that defines the symbol report for the rename
+are printed first. By default, the diff of the rename is printed after them. Use -w to rewrite
+the files in-place.`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	To              string
+	Write           bool
+	ErrorFormat     string
+	Config          string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.To,
+		toFlagName,
+		"",
+		"The new name of the symbol, without its package or parent message",
+	)
+	_ = cobra.MarkFlagRequired(flagSet, toFlagName)
+	flagSet.BoolVarP(
+		&f.Write,
+		writeFlagName,
+		writeFlagShortName,
+		false,
+		"Rewrite files in-place instead of printing the diff",
+	)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The buf.yaml file or data to use for configuration`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	symbol := container.Arg(0)
+	if symbol == "" {
+		return appcmd.NewInvalidArgumentError("symbol is required")
+	}
+	input := "."
+	if container.NumArgs() > 1 {
+		input = container.Arg(1)
+	}
+	sourceRef, err := buffetch.NewRefParser(container.Logger()).GetSourceRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	if sourceRef.DirPath() == "" {
+		return appcmd.NewInvalidArgumentErrorf("input must be a local directory: %q", input)
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	imageConfigReader, err := bufcli.NewWireImageConfigReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+	)
+	if err != nil {
+		return err
+	}
+	imageConfigs, fileAnnotations, err := imageConfigReader.GetImageConfigs(
+		ctx,
+		container,
+		sourceRef,
+		flags.Config,
+		nil,
+		nil,
+		false,
+		false, // we need source info for the locations of symbols
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, flags.ErrorFormat); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	// The files of a workspace are in the images of all the modules that
+	// import them, but are only targeted by the image of their module.
+	filePathToFile := make(map[string]protosource.File)
+	filePathToBreakingConfig := make(map[string]*bufbreakingconfig.Config)
+	for _, imageConfig := range imageConfigs {
+		files, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(imageConfig.Image().Files())...)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if imageConfig.Image().GetFile(file.Path()).IsImport() {
+				if _, ok := filePathToFile[file.Path()]; !ok {
+					filePathToFile[file.Path()] = file
+				}
+				continue
+			}
+			filePathToFile[file.Path()] = file
+			filePathToBreakingConfig[file.Path()] = imageConfig.Config().Breaking
+		}
+	}
+	files := make([]protosource.File, 0, len(filePathToFile))
+	for _, file := range filePathToFile {
+		files = append(files, file)
+	}
+	plan, err := bufrefactor.NewRenamePlan(files, symbol, flags.To)
+	if err != nil {
+		return err
+	}
+	breakingConfig, ok := filePathToBreakingConfig[plan.DefiningFile.Path()]
+	if !ok {
+		return fmt.Errorf("%q is defined in %q, which is not a file of %s", plan.FullName, plan.DefiningFile.Path(), input)
+	}
+	for _, file := range plan.Files {
+		if _, ok := filePathToBreakingConfig[file.Path()]; !ok {
+			return fmt.Errorf("%q references %q, but is not a file of %s", file.Path(), plan.FullName, input)
+		}
+	}
+	if err := printSummary(container.Stdout(), plan, breakingConfig); err != nil {
+		return err
+	}
+	for _, file := range plan.Files {
+		if err := renameFile(ctx, container, runner, plan, file, flags.Write); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printSummary prints the rename and the breaking changes that the configured
+// breaking rules report for it.
+func printSummary(writer io.Writer, plan *bufrefactor.RenamePlan, breakingConfig *bufbreakingconfig.Config) error {
+	rules, err := bufbreaking.RulesForConfig(breakingConfig)
+	if err != nil {
+		return err
+	}
+	configuredIDs := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		configuredIDs[rule.ID()] = struct{}{}
+	}
+	var breakingChanges []*bufrefactor.BreakingChange
+	for _, breakingChange := range plan.BreakingChanges {
+		if _, ok := configuredIDs[breakingChange.ID]; ok {
+			breakingChanges = append(breakingChanges, breakingChange)
+		}
+	}
+	if _, err := fmt.Fprintf(writer, "Rename %s %s to %s.\n\n", plan.Kind.String(), plan.FullName, plan.NewFullName); err != nil {
+		return err
+	}
+	if len(breakingChanges) == 0 {
+		_, err := fmt.Fprintf(writer, "No breaking changes are reported by the configured breaking rules.\n")
+		return err
+	}
+	if _, err := fmt.Fprintf(writer, "Breaking changes reported by the configured breaking rules:\n"); err != nil {
+		return err
+	}
+	for _, breakingChange := range breakingChanges {
+		if _, err := fmt.Fprintf(
+			writer,
+			"  %s: %s: %s\n",
+			breakingChange.File.Path(),
+			breakingChange.ID,
+			breakingChange.Message,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameFile applies the plan to the file, and either rewrites the file or prints the diff.
+func renameFile(
+	ctx context.Context,
+	container appflag.Container,
+	runner command.Runner,
+	plan *bufrefactor.RenamePlan,
+	file protosource.File,
+	write bool,
+) error {
+	externalPath := file.ExternalPath()
+	fileInfo, err := os.Stat(externalPath)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(externalPath)
+	if err != nil {
+		return err
+	}
+	renamedData, err := plan.Apply(file, data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", externalPath, err)
+	}
+	if write {
+		return os.WriteFile(externalPath, renamedData, fileInfo.Mode().Perm())
+	}
+	fileDiff, err := diff.Diff(
+		ctx,
+		runner,
+		data,
+		renamedData,
+		externalPath,
+		externalPath,
+		diff.DiffWithSuppressTimestamps(),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = container.Stdout().Write(fileDiff)
+	return err
+}
@@ -0,0 +1,117 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorylist
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/connect-go"
+)
+
+// repositoryFilter filters the repositories of a page on the client side,
+// as the list RPCs do not support filters.
+type repositoryFilter struct {
+	// VISIBILITY_UNSPECIFIED if not filtering on visibility.
+	visibility registryv1alpha1.Visibility
+	// nil if not filtering on deprecation.
+	deprecated *bool
+	// zero if not filtering on the last push.
+	lastPushAfter time.Time
+}
+
+func newRepositoryFilter(flags *flags) (*repositoryFilter, error) {
+	visibility, err := bufcli.VisibilityFlagToVisibilityAllowUnspecified(flags.Visibility)
+	if err != nil {
+		return nil, appcmd.NewInvalidArgumentError(err.Error())
+	}
+	filter := &repositoryFilter{
+		visibility: visibility,
+	}
+	if flags.Deprecated != "" {
+		deprecated, err := strconv.ParseBool(flags.Deprecated)
+		if err != nil {
+			return nil, appcmd.NewInvalidArgumentErrorf("--%s must be \"true\" or \"false\": %q", deprecatedFlagName, flags.Deprecated)
+		}
+		filter.deprecated = &deprecated
+	}
+	if flags.LastPushAfter != "" {
+		lastPushAfter, err := time.Parse(time.RFC3339, flags.LastPushAfter)
+		if err != nil {
+			lastPushAfter, err = time.Parse(lastPushAfterDateLayout, flags.LastPushAfter)
+			if err != nil {
+				return nil, appcmd.NewInvalidArgumentErrorf(
+					"--%s must be in RFC 3339 or YYYY-MM-DD format: %q",
+					lastPushAfterFlagName,
+					flags.LastPushAfter,
+				)
+			}
+		}
+		filter.lastPushAfter = lastPushAfter
+	}
+	return filter, nil
+}
+
+func (f *repositoryFilter) filter(
+	ctx context.Context,
+	service registryv1alpha1connect.RepositoryServiceClient,
+	repositories []*registryv1alpha1.Repository,
+) ([]*registryv1alpha1.Repository, error) {
+	var filteredRepositories []*registryv1alpha1.Repository
+	for _, repository := range repositories {
+		if f.visibility != registryv1alpha1.Visibility_VISIBILITY_UNSPECIFIED && repository.Visibility != f.visibility {
+			continue
+		}
+		if f.deprecated != nil && repository.Deprecated != *f.deprecated {
+			continue
+		}
+		filteredRepositories = append(filteredRepositories, repository)
+	}
+	if f.lastPushAfter.IsZero() || len(filteredRepositories) == 0 {
+		return filteredRepositories, nil
+	}
+	ids := make([]string, len(filteredRepositories))
+	for i, repository := range filteredRepositories {
+		ids[i] = repository.Id
+	}
+	resp, err := service.GetRepositoriesMetadata(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetRepositoriesMetadataRequest{
+			Ids: ids,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	idToLatestCommitTime := make(map[string]time.Time, len(resp.Msg.Metadata))
+	for _, metadata := range resp.Msg.Metadata {
+		if metadata.LatestCommitTime != nil {
+			idToLatestCommitTime[metadata.Id] = metadata.LatestCommitTime.AsTime()
+		}
+	}
+	var pushedRepositories []*registryv1alpha1.Repository
+	for _, repository := range filteredRepositories {
+		// Repositories without commits have never been pushed to.
+		if latestCommitTime, ok := idToLatestCommitTime[repository.Id]; ok && latestCommitTime.After(f.lastPushAfter) {
+			pushedRepositories = append(pushedRepositories, repository)
+		}
+	}
+	return pushedRepositories, nil
+}
@@ -32,10 +32,16 @@ import (
 )
 
 const (
-	pageSizeFlagName  = "page-size"
-	pageTokenFlagName = "page-token"
-	reverseFlagName   = "reverse"
-	formatFlagName    = "format"
+	pageSizeFlagName      = "page-size"
+	pageTokenFlagName     = "page-token"
+	reverseFlagName       = "reverse"
+	formatFlagName        = "format"
+	ownerFlagName         = "owner"
+	visibilityFlagName    = "visibility"
+	deprecatedFlagName    = "deprecated"
+	lastPushAfterFlagName = "last-push-after"
+
+	lastPushAfterDateLayout = "2006-01-02"
 )
 
 // NewCommand returns a new Command
@@ -47,7 +53,12 @@ func NewCommand(
 	return &appcmd.Command{
 		Use:   name + " <buf.build>",
 		Short: "List BSR repositories",
-		Args:  cobra.ExactArgs(1),
+		Long: `Lists all repositories, or the repositories of a user or organization if --` + ownerFlagName + ` is set.
+
+The --` + visibilityFlagName + `, --` + deprecatedFlagName + `, and --` + lastPushAfterFlagName + ` filters are applied to each page of results, so a page
+may contain fewer repositories than --` + pageSizeFlagName + `. Follow the "next_page" key of the --format=json output
+until it is absent to list all matching repositories.`,
+		Args: cobra.ExactArgs(1),
 		Run: builder.NewRunFunc(
 			func(ctx context.Context, container appflag.Container) error {
 				return run(ctx, container, flags)
@@ -59,10 +70,14 @@ func NewCommand(
 }
 
 type flags struct {
-	PageSize  uint32
-	PageToken string
-	Reverse   bool
-	Format    string
+	PageSize      uint32
+	PageToken     string
+	Reverse       bool
+	Format        string
+	Owner         string
+	Visibility    string
+	Deprecated    string
+	LastPushAfter string
 }
 
 func newFlags() *flags {
@@ -91,6 +106,30 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		bufprint.FormatText.String(),
 		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
 	)
+	flagSet.StringVar(
+		&f.Owner,
+		ownerFlagName,
+		"",
+		`Only list the repositories of this user or organization`,
+	)
+	flagSet.StringVar(
+		&f.Visibility,
+		visibilityFlagName,
+		"",
+		`Only list repositories with this visibility. Must be one of "public" or "private"`,
+	)
+	flagSet.StringVar(
+		&f.Deprecated,
+		deprecatedFlagName,
+		"",
+		`Only list deprecated repositories if "true", or repositories that are not deprecated if "false"`,
+	)
+	flagSet.StringVar(
+		&f.LastPushAfter,
+		lastPushAfterFlagName,
+		"",
+		`Only list repositories with a commit pushed after this time, in RFC 3339 or YYYY-MM-DD format`,
+	)
 }
 
 func run(
@@ -110,6 +149,10 @@ func run(
 	if err != nil {
 		return appcmd.NewInvalidArgumentError(err.Error())
 	}
+	filter, err := newRepositoryFilter(flags)
+	if err != nil {
+		return err
+	}
 
 	clientConfig, err := bufcli.NewConnectClientConfig(container)
 	if err != nil {
@@ -120,21 +163,97 @@ func run(
 		remote,
 		registryv1alpha1connect.NewRepositoryServiceClient,
 	)
-	resp, err := service.ListRepositories(
-		ctx,
-		connect.NewRequest(&registryv1alpha1.ListRepositoriesRequest{
-			PageSize:  flags.PageSize,
-			PageToken: flags.PageToken,
-			Reverse:   flags.Reverse,
-		}),
-	)
+	var repositories []*registryv1alpha1.Repository
+	var nextPageToken string
+	if flags.Owner != "" {
+		repositories, nextPageToken, err = listOwnerRepositories(ctx, clientConfig, remote, service, flags)
+	} else {
+		var resp *connect.Response[registryv1alpha1.ListRepositoriesResponse]
+		resp, err = service.ListRepositories(
+			ctx,
+			connect.NewRequest(&registryv1alpha1.ListRepositoriesRequest{
+				PageSize:  flags.PageSize,
+				PageToken: flags.PageToken,
+				Reverse:   flags.Reverse,
+			}),
+		)
+		if err == nil {
+			repositories, nextPageToken = resp.Msg.Repositories, resp.Msg.NextPageToken
+		}
+	}
+	if err != nil {
+		return err
+	}
+	repositories, err = filter.filter(ctx, service, repositories)
 	if err != nil {
 		return err
 	}
-	repositories, nextPageToken := resp.Msg.Repositories, resp.Msg.NextPageToken
 	return bufprint.NewRepositoryPrinter(
 		clientConfig,
 		remote,
 		container.Stdout(),
 	).PrintRepositories(ctx, format, nextPageToken, repositories...)
 }
+
+// listOwnerRepositories lists a page of the repositories of the user or
+// organization named by the owner flag.
+func listOwnerRepositories(
+	ctx context.Context,
+	clientConfig *connectclient.Config,
+	remote string,
+	service registryv1alpha1connect.RepositoryServiceClient,
+	flags *flags,
+) ([]*registryv1alpha1.Repository, string, error) {
+	organizationService := connectclient.Make(clientConfig, remote, registryv1alpha1connect.NewOrganizationServiceClient)
+	organizationResp, err := organizationService.GetOrganizationByName(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetOrganizationByNameRequest{
+			Name: flags.Owner,
+		}),
+	)
+	if err == nil {
+		resp, err := service.ListOrganizationRepositories(
+			ctx,
+			connect.NewRequest(&registryv1alpha1.ListOrganizationRepositoriesRequest{
+				OrganizationId: organizationResp.Msg.Organization.Id,
+				PageSize:       flags.PageSize,
+				PageToken:      flags.PageToken,
+				Reverse:        flags.Reverse,
+			}),
+		)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Msg.Repositories, resp.Msg.NextPageToken, nil
+	}
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		return nil, "", err
+	}
+	// Not an organization, the owner may be a user.
+	userService := connectclient.Make(clientConfig, remote, registryv1alpha1connect.NewUserServiceClient)
+	userResp, err := userService.GetUserByUsername(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetUserByUsernameRequest{
+			Username: flags.Owner,
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, "", fmt.Errorf("no user or organization named %q exists on %s", flags.Owner, remote)
+		}
+		return nil, "", err
+	}
+	resp, err := service.ListUserRepositories(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.ListUserRepositoriesRequest{
+			UserId:    userResp.Msg.User.Id,
+			PageSize:  flags.PageSize,
+			PageToken: flags.PageToken,
+			Reverse:   flags.Reverse,
+		}),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Msg.Repositories, resp.Msg.NextPageToken, nil
+}
@@ -0,0 +1,332 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorymove
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/buflock"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+)
+
+const (
+	formatFlagName             = "format"
+	updateLocalConfigsFlagName = "update-local-configs"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository> <buf.build/new-owner/new-repository>",
+		Short: "Rename a BSR repository or move it to another owner",
+		Long: `The repository keeps its commits, tags, and settings. The previous name redirects to the repository
+after the move, so existing references to it keep resolving.
+
+Both names must be on the same remote.
+
+If --` + updateLocalConfigsFlagName + ` is set, the ` + bufconfig.ExternalConfigV1FilePath + ` and ` + buflock.ExternalConfigFilePath + ` files in the current directory,
+or in the directories of the ` + bufwork.ExternalConfigV1FilePath + ` file if it exists, are rewritten to refer to the new name:
+the name of the module if it is the repository, its deps, and its pinned dependencies. The pinned
+commits are kept, as the repository keeps its commits.`,
+		Args: cobra.ExactArgs(2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Format             string
+	UpdateLocalConfigs bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+	flagSet.BoolVar(
+		&f.UpdateLocalConfigs,
+		updateLocalConfigsFlagName,
+		false,
+		fmt.Sprintf(
+			`Rewrite the names, deps, and pins of the local %s and %s files that refer to the repository to its new name`,
+			bufconfig.ExternalConfigV1FilePath,
+			buflock.ExternalConfigFilePath,
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	newModuleIdentity, err := bufmoduleref.ModuleIdentityForString(container.Arg(1))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if moduleIdentity.Remote() != newModuleIdentity.Remote() {
+		return appcmd.NewInvalidArgumentErrorf(
+			"cannot move repository %q to a different remote %q",
+			moduleIdentity.IdentityString(),
+			newModuleIdentity.Remote(),
+		)
+	}
+	if moduleIdentity.IdentityString() == newModuleIdentity.IdentityString() {
+		return appcmd.NewInvalidArgumentErrorf("repository %q is already named %q", container.Arg(0), container.Arg(1))
+	}
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	service := connectclient.Make(
+		clientConfig,
+		moduleIdentity.Remote(),
+		registryv1alpha1connect.NewRepositoryServiceClient,
+	)
+	resp, err := service.MoveRepositoryByFullName(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.MoveRepositoryByFullNameRequest{
+			FullName:    moduleIdentity.Owner() + "/" + moduleIdentity.Repository(),
+			NewFullName: newModuleIdentity.Owner() + "/" + newModuleIdentity.Repository(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		if connect.CodeOf(err) == connect.CodeAlreadyExists {
+			return bufcli.NewRepositoryNameAlreadyExistsError(container.Arg(1))
+		}
+		return err
+	}
+	if flags.UpdateLocalConfigs {
+		storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+		readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+			".",
+			storageos.ReadWriteBucketWithSymlinksIfSupported(),
+		)
+		if err != nil {
+			return err
+		}
+		if err := updateLocalConfigs(ctx, container.Logger(), readWriteBucket, moduleIdentity, newModuleIdentity); err != nil {
+			return err
+		}
+	}
+	return bufprint.NewRepositoryPrinter(
+		clientConfig,
+		newModuleIdentity.Remote(),
+		container.Stdout(),
+	).PrintRepository(ctx, format, resp.Msg.Repository)
+}
+
+// updateLocalConfigs rewrites the name, deps, and pins that refer to moduleIdentity
+// in the configuration and lock files of the root of the bucket, or of the directories
+// of the workspace if the root of the bucket has a workspace configuration file.
+func updateLocalConfigs(
+	ctx context.Context,
+	logger *zap.Logger,
+	readWriteBucket storage.ReadWriteBucket,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	newModuleIdentity bufmoduleref.ModuleIdentity,
+) error {
+	directories := []string{"."}
+	workspaceConfigFilePath, err := bufwork.ExistingConfigFilePath(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	if workspaceConfigFilePath != "" {
+		workspaceConfig, err := bufwork.GetConfigForBucket(ctx, readWriteBucket, ".")
+		if err != nil {
+			return err
+		}
+		directories = workspaceConfig.Directories
+	}
+	for _, directory := range directories {
+		moduleReadWriteBucket := storage.MapReadWriteBucket(readWriteBucket, storage.MapOnPrefix(directory))
+		configFilePath, err := bufconfig.ExistingConfigFilePath(ctx, moduleReadWriteBucket)
+		if err != nil {
+			return err
+		}
+		if configFilePath == "" {
+			continue
+		}
+		renamed, err := bufconfig.RenameModuleIdentity(
+			ctx,
+			moduleReadWriteBucket,
+			configFilePath,
+			os.LookupEnv,
+			moduleIdentity,
+			newModuleIdentity,
+		)
+		if err != nil {
+			return err
+		}
+		if renamed {
+			logger.Info(
+				"updated name",
+				zap.String("path", normalpath.Join(directory, configFilePath)),
+				zap.String("name", newModuleIdentity.IdentityString()),
+			)
+		}
+		updated, err := updateDependencies(ctx, moduleReadWriteBucket, configFilePath, moduleIdentity, newModuleIdentity)
+		if err != nil {
+			return err
+		}
+		if updated {
+			logger.Info(
+				"updated dependency",
+				zap.String("path", normalpath.Join(directory, configFilePath)),
+				zap.String("dependency", newModuleIdentity.IdentityString()),
+			)
+		}
+		updated, err = updateDependencyModulePins(ctx, moduleReadWriteBucket, moduleIdentity, newModuleIdentity)
+		if err != nil {
+			return err
+		}
+		if updated {
+			logger.Info(
+				"updated pinned dependency",
+				zap.String("path", normalpath.Join(directory, buflock.ExternalConfigFilePath)),
+				zap.String("dependency", newModuleIdentity.IdentityString()),
+			)
+		}
+	}
+	return nil
+}
+
+// updateDependencies rewrites the deps of the configuration file that refer
+// to moduleIdentity to refer to newModuleIdentity, keeping their references.
+// The other deps are kept as written.
+//
+// Returns true if the configuration file was changed.
+func updateDependencies(
+	ctx context.Context,
+	readWriteBucket storage.ReadWriteBucket,
+	configFilePath string,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	newModuleIdentity bufmoduleref.ModuleIdentity,
+) (bool, error) {
+	return bufconfig.UpdateDependencies(
+		ctx,
+		readWriteBucket,
+		configFilePath,
+		os.LookupEnv,
+		func(dependencies []bufconfig.Dependency) ([]string, error) {
+			values := make([]string, len(dependencies))
+			for i, dependency := range dependencies {
+				moduleReference := dependency.ModuleReference
+				if moduleReference.IdentityString() != moduleIdentity.IdentityString() {
+					values[i] = dependency.Value
+					continue
+				}
+				newModuleReference, err := bufmoduleref.NewModuleReference(
+					newModuleIdentity.Remote(),
+					newModuleIdentity.Owner(),
+					newModuleIdentity.Repository(),
+					moduleReference.Reference(),
+				)
+				if err != nil {
+					return nil, err
+				}
+				values[i] = newModuleReference.String()
+			}
+			return values, nil
+		},
+	)
+}
+
+// updateDependencyModulePins rewrites the pins of the lock file that refer to
+// moduleIdentity to refer to newModuleIdentity, keeping their commits and digests.
+//
+// Returns true if the lock file was changed.
+func updateDependencyModulePins(
+	ctx context.Context,
+	readWriteBucket storage.ReadWriteBucket,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	newModuleIdentity bufmoduleref.ModuleIdentity,
+) (bool, error) {
+	modulePins, err := bufmoduleref.DependencyModulePinsForBucket(ctx, readWriteBucket)
+	if err != nil {
+		return false, err
+	}
+	var updated bool
+	for i, modulePin := range modulePins {
+		if modulePin.IdentityString() != moduleIdentity.IdentityString() {
+			continue
+		}
+		newModulePin, err := bufmoduleref.NewModulePin(
+			newModuleIdentity.Remote(),
+			newModuleIdentity.Owner(),
+			newModuleIdentity.Repository(),
+			"",
+			modulePin.Commit(),
+			modulePin.Digest(),
+			time.Time{},
+		)
+		if err != nil {
+			return false, err
+		}
+		modulePins[i] = newModulePin
+		updated = true
+	}
+	if !updated {
+		return false, nil
+	}
+	return true, bufmoduleref.PutDependencyModulePinsToBucket(ctx, readWriteBucket, modulePins)
+}
@@ -0,0 +1,180 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositorymove
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestUpdateLocalConfigs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	readWriteBucket, err := storagemem.NewReadWriteBucketWithOptions(
+		storagemem.WithFiles(
+			map[string][]byte{
+				"buf.work.yaml": []byte(`version: v1
+directories:
+  - a
+  - b
+`),
+				"a/buf.yaml": []byte(`version: v1
+# the module that is moved
+name: buf.build/acme/old
+deps:
+  - buf.build/acme/petapis
+`),
+				"a/buf.lock": []byte(`# Generated by buf. DO NOT EDIT.
+version: v1
+deps:
+  - remote: buf.build
+    owner: acme
+    repository: petapis
+    commit: e9191fcdc2294e2f8f3b82c528fc90a8
+    digest: b1-gLO3B_5ClhdU52w1gMOxk4GokvCoM1OqjarxMfjStGQ=
+`),
+				"b/buf.yaml": []byte(`version: v1
+name: buf.build/acme/other
+deps:
+  - buf.build/acme/old:v1
+  - buf.build/acme/petapis
+`),
+				"b/buf.lock": []byte(`# Generated by buf. DO NOT EDIT.
+version: v1
+deps:
+  - remote: buf.build
+    owner: acme
+    repository: old
+    commit: 7e8d4d9c4f2d4f0b8ad4f4ab16d4f2b0
+    digest: shake256:bfbf2dc5e8ed8ae4c1f2cb61d1ab9b4f0d2a8b1f2c1df7f3d9c02f2bd7c3b2df85a6d2bd79b6a6b4f3c1cfb4a0b7b2e5e7bba2c2a6b1e5f0c1a3ccce4a7b1d0f
+  - remote: buf.build
+    owner: acme
+    repository: petapis
+    commit: e9191fcdc2294e2f8f3b82c528fc90a8
+    digest: b1-gLO3B_5ClhdU52w1gMOxk4GokvCoM1OqjarxMfjStGQ=
+`),
+			},
+		),
+	)
+	require.NoError(t, err)
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.build/acme/old")
+	require.NoError(t, err)
+	newModuleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.build/zeta/new")
+	require.NoError(t, err)
+
+	err = updateLocalConfigs(ctx, zap.NewNop(), readWriteBucket, moduleIdentity, newModuleIdentity)
+	require.NoError(t, err)
+	testAssertPathData(
+		ctx,
+		t,
+		readWriteBucket,
+		"a/buf.yaml",
+		`version: v1
+# the module that is moved
+name: buf.build/zeta/new
+deps:
+  - buf.build/acme/petapis
+`,
+	)
+	testAssertPathData(
+		ctx,
+		t,
+		readWriteBucket,
+		"a/buf.lock",
+		`# Generated by buf. DO NOT EDIT.
+version: v1
+deps:
+  - remote: buf.build
+    owner: acme
+    repository: petapis
+    commit: e9191fcdc2294e2f8f3b82c528fc90a8
+    digest: b1-gLO3B_5ClhdU52w1gMOxk4GokvCoM1OqjarxMfjStGQ=
+`,
+	)
+	testAssertPathData(
+		ctx,
+		t,
+		readWriteBucket,
+		"b/buf.yaml",
+		`version: v1
+name: buf.build/acme/other
+deps:
+  - buf.build/zeta/new:v1
+  - buf.build/acme/petapis
+`,
+	)
+	modulePins, err := bufmoduleref.DependencyModulePinsForBucket(
+		ctx,
+		storage.MapReadBucket(readWriteBucket, storage.MapOnPrefix("b")),
+	)
+	require.NoError(t, err)
+	require.Len(t, modulePins, 2)
+	assert.Equal(t, "buf.build/acme/petapis", modulePins[0].IdentityString())
+	assert.Equal(t, "buf.build/zeta/new", modulePins[1].IdentityString())
+	assert.Equal(t, "7e8d4d9c4f2d4f0b8ad4f4ab16d4f2b0", modulePins[1].Commit())
+}
+
+func TestUpdateLocalConfigsWithoutWorkspace(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	readWriteBucket, err := storagemem.NewReadWriteBucketWithOptions(
+		storagemem.WithFiles(
+			map[string][]byte{
+				"buf.yaml": []byte(`version: v1
+name: buf.build/acme/other
+`),
+			},
+		),
+	)
+	require.NoError(t, err)
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.build/acme/old")
+	require.NoError(t, err)
+	newModuleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.build/acme/new")
+	require.NoError(t, err)
+
+	err = updateLocalConfigs(ctx, zap.NewNop(), readWriteBucket, moduleIdentity, newModuleIdentity)
+	require.NoError(t, err)
+	testAssertPathData(
+		ctx,
+		t,
+		readWriteBucket,
+		"buf.yaml",
+		`version: v1
+name: buf.build/acme/other
+`,
+	)
+	// No lock file is written if there was none.
+	_, err = readWriteBucket.Stat(ctx, "buf.lock")
+	assert.True(t, storage.IsNotExist(err))
+}
+
+func testAssertPathData(
+	ctx context.Context,
+	t *testing.T,
+	readBucket storage.ReadBucket,
+	path string,
+	expectedData string,
+) {
+	data, err := storage.ReadPath(ctx, readBucket, path)
+	require.NoError(t, err)
+	assert.Equal(t, expectedData, string(data), path)
+}
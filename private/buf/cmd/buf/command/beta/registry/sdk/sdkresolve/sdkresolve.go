@@ -0,0 +1,203 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkresolve
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/bufplugin/bufpluginref"
+	"github.com/bufbuild/buf/private/bufpkg/bufremotepackage"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	pluginFlagName = "plugin"
+	formatFlagName = "format"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository[:ref]>",
+		Short: "Resolve the name and version of a generated SDK",
+		Long: `Resolve the name, version, and registry URL of the generated SDK (remote package)
+for a module commit and a plugin.
+
+The reference defaults to the latest commit on the main branch, and the plugin version
+defaults to the latest version of the plugin that supports remote packages. The printed
+version can be used to pin the generated SDK in a go.mod, package.json, or other build file.
+
+Resolve the Go module version for the latest commit with the latest version of connect-go:
+
+    $ buf beta registry sdk resolve buf.build/acme/weather --plugin buf.build/bufbuild/connect-go
+
+Resolve the npm package version for a tag with a specific version of connect-es:
+
+    $ buf beta registry sdk resolve buf.build/acme/weather:v1.0.0 --plugin buf.build/bufbuild/connect-es:v0.8.1 --format json
+`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Plugin string
+	Format string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Plugin,
+		pluginFlagName,
+		"",
+		`The plugin to generate the SDK with, in the form remote/owner/plugin[:version].`,
+	)
+	_ = cobra.MarkFlagRequired(flagSet, pluginFlagName)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	moduleReference, err := bufmoduleref.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	pluginIdentity, pluginVersion, err := parsePlugin(flags.Plugin)
+	if err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", pluginFlagName, err)
+	}
+	if pluginIdentity.Remote() != moduleReference.Remote() {
+		return appcmd.NewInvalidArgumentErrorf(
+			"plugin %s must be on the same remote as module %s",
+			pluginIdentity.IdentityString(),
+			moduleReference.IdentityString(),
+		)
+	}
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	repositoryCommitService := connectclient.Make(
+		clientConfig,
+		moduleReference.Remote(),
+		registryv1alpha1connect.NewRepositoryCommitServiceClient,
+	)
+	repositoryCommitResponse, err := repositoryCommitService.GetRepositoryCommitByReference(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetRepositoryCommitByReferenceRequest{
+			RepositoryOwner: moduleReference.Owner(),
+			RepositoryName:  moduleReference.Repository(),
+			Reference:       moduleReference.Reference(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	pluginCurationService := connectclient.Make(
+		clientConfig,
+		pluginIdentity.Remote(),
+		registryv1alpha1connect.NewPluginCurationServiceClient,
+	)
+	latestCuratedPluginResponse, err := pluginCurationService.GetLatestCuratedPlugin(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetLatestCuratedPluginRequest{
+			Owner:                  pluginIdentity.Owner(),
+			Name:                   pluginIdentity.Plugin(),
+			Version:                pluginVersion,
+			SupportsRemotePackages: true,
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return bufcli.NewPluginNotFoundError(pluginIdentity.Owner(), pluginIdentity.Plugin())
+		}
+		return err
+	}
+	repositoryCommit := repositoryCommitResponse.Msg.RepositoryCommit
+	curatedPlugin := latestCuratedPluginResponse.Msg.Plugin
+	remotePackage, err := bufremotepackage.NewRemotePackage(
+		moduleReference,
+		repositoryCommit.Name,
+		repositoryCommit.CreateTime.AsTime(),
+		pluginIdentity,
+		curatedPlugin.Version,
+		curatedPlugin.Revision,
+		curatedPlugin.RegistryType,
+	)
+	if err != nil {
+		return err
+	}
+	return bufprint.NewRemotePackagePrinter(container.Stdout()).PrintRemotePackage(ctx, format, remotePackage)
+}
+
+// parsePlugin parses the plugin in the form remote/owner/plugin[:version].
+//
+// The returned version is empty if no version was specified.
+func parsePlugin(plugin string) (bufpluginref.PluginIdentity, string, error) {
+	if strings.Contains(plugin, ":") {
+		pluginReference, err := bufpluginref.PluginReferenceForString(plugin, 0)
+		if err != nil {
+			return nil, "", err
+		}
+		return pluginReference, pluginReference.Version(), nil
+	}
+	pluginIdentity, err := bufpluginref.PluginIdentityForString(plugin)
+	if err != nil {
+		return nil, "", err
+	}
+	return pluginIdentity, "", nil
+}
@@ -31,7 +31,11 @@ import (
 	"github.com/spf13/pflag"
 )
 
-const formatFlagName = "format"
+const (
+	formatFlagName       = "format"
+	messageFlagName      = "message"
+	messageFlagShortName = "m"
+)
 
 // NewCommand returns a new Command
 func NewCommand(
@@ -54,7 +58,8 @@ func NewCommand(
 }
 
 type flags struct {
-	Format string
+	Format  string
+	Message string
 }
 
 func newFlags() *flags {
@@ -68,6 +73,13 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		bufprint.FormatText.String(),
 		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
 	)
+	flagSet.StringVarP(
+		&f.Message,
+		messageFlagName,
+		messageFlagShortName,
+		"",
+		"The message to annotate the tag with",
+	)
 }
 
 func run(
@@ -123,6 +135,7 @@ func run(
 			RepositoryId: resp.Msg.Repository.Id,
 			Name:         tag,
 			CommitName:   commit,
+			Message:      flags.Message,
 		}),
 	)
 	if err != nil {
@@ -18,16 +18,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/mail"
+	"time"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
 	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	webhookv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/webhook/v1alpha1"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
 	"github.com/bufbuild/buf/private/pkg/connectclient"
 	"github.com/bufbuild/connect-go"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 const (
@@ -36,6 +42,20 @@ const (
 	callbackURLFlagName  = "callback-url"
 	webhookEventFlagName = "event"
 	remoteFlagName       = "remote"
+
+	maxAttemptsFlagName              = "max-attempts"
+	initialBackoffFlagName           = "initial-backoff"
+	maxBackoffFlagName               = "max-backoff"
+	attemptTimeoutFlagName           = "attempt-timeout"
+	failureNotificationEmailFlagName = "failure-notification-email"
+	payloadVersionFlagName           = "payload-version"
+	payloadFieldFlagName             = "payload-field"
+	deliveryFormatFlagName           = "delivery-format"
+	sqsQueueURLFlagName              = "sqs-queue-url"
+	sqsRoleARNFlagName               = "sqs-role-arn"
+	pubSubTopicFlagName              = "pubsub-topic"
+	kafkaBootstrapServerFlagName     = "kafka-bootstrap-server"
+	kafkaTopicFlagName               = "kafka-topic"
 )
 
 // NewCommand returns a new Command
@@ -64,6 +84,20 @@ type flags struct {
 	RepositoryName string
 	CallbackURL    string
 	Remote         string
+
+	MaxAttempts              uint32
+	InitialBackoff           time.Duration
+	MaxBackoff               time.Duration
+	AttemptTimeout           time.Duration
+	FailureNotificationEmail string
+	PayloadVersion           string
+	PayloadFields            []string
+	DeliveryFormat           string
+	SQSQueueURL              string
+	SQSRoleARN               string
+	PubSubTopic              string
+	KafkaBootstrapServers    []string
+	KafkaTopic               string
 }
 
 func newFlags() *flags {
@@ -96,9 +130,13 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		&f.CallbackURL,
 		callbackURLFlagName,
 		"",
-		"The url for the webhook to callback to on a given event",
+		fmt.Sprintf(
+			"The url for the webhook to callback to on a given event. Either this or a sink, such as --%s, --%s, or --%s, must be set",
+			sqsQueueURLFlagName,
+			pubSubTopicFlagName,
+			kafkaTopicFlagName,
+		),
 	)
-	_ = cobra.MarkFlagRequired(flagSet, callbackURLFlagName)
 	flagSet.StringVar(
 		&f.Remote,
 		remoteFlagName,
@@ -106,6 +144,84 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		"The remote of the repository the created webhook will belong to",
 	)
 	_ = cobra.MarkFlagRequired(flagSet, remoteFlagName)
+	flagSet.Uint32Var(
+		&f.MaxAttempts,
+		maxAttemptsFlagName,
+		0,
+		"The maximum number of attempts to deliver an event, including the first attempt. If not set, the server default is used",
+	)
+	flagSet.DurationVar(
+		&f.InitialBackoff,
+		initialBackoffFlagName,
+		0,
+		"The time to wait before the first retry of a delivery, doubled for every following retry. If not set, the server default is used",
+	)
+	flagSet.DurationVar(
+		&f.MaxBackoff,
+		maxBackoffFlagName,
+		0,
+		"The maximum time to wait between two attempts to deliver an event. If not set, the server default is used",
+	)
+	flagSet.DurationVar(
+		&f.AttemptTimeout,
+		attemptTimeoutFlagName,
+		0,
+		"The timeout of every attempt to deliver an event. If not set, the server default is used",
+	)
+	flagSet.StringVar(
+		&f.FailureNotificationEmail,
+		failureNotificationEmailFlagName,
+		"",
+		"The email address to notify when an event could not be delivered after all attempts",
+	)
+	flagSet.StringVar(
+		&f.PayloadVersion,
+		payloadVersionFlagName,
+		"",
+		"The version of the payload messages to deliver. The proto enum string value is used for this input (e.g. 'WEBHOOK_PAYLOAD_VERSION_V1ALPHA1'). If not set, the latest version is used",
+	)
+	flagSet.StringSliceVar(
+		&f.PayloadFields,
+		payloadFieldFlagName,
+		nil,
+		`The path of a field of the payload message of the event to deliver, such as "repository_commit.name". May be provided multiple times. If not set, all fields are delivered`,
+	)
+	flagSet.StringVar(
+		&f.DeliveryFormat,
+		deliveryFormatFlagName,
+		"",
+		"The format to deliver events in. The proto enum string value is used for this input (e.g. 'WEBHOOK_DELIVERY_FORMAT_CLOUDEVENTS_JSON'). If not set, events are delivered as Connect requests",
+	)
+	flagSet.StringVar(
+		&f.SQSQueueURL,
+		sqsQueueURLFlagName,
+		"",
+		"The URL of an Amazon SQS queue to deliver events to, instead of a callback URL",
+	)
+	flagSet.StringVar(
+		&f.SQSRoleARN,
+		sqsRoleARNFlagName,
+		"",
+		fmt.Sprintf("The ARN of the IAM role that is assumed to send messages to the queue of --%s", sqsQueueURLFlagName),
+	)
+	flagSet.StringVar(
+		&f.PubSubTopic,
+		pubSubTopicFlagName,
+		"",
+		`The name of a Google Cloud Pub/Sub topic to deliver events to, instead of a callback URL, such as "projects/acme/topics/buf-events"`,
+	)
+	flagSet.StringSliceVar(
+		&f.KafkaBootstrapServers,
+		kafkaBootstrapServerFlagName,
+		nil,
+		fmt.Sprintf("The address of a bootstrap server of the Kafka cluster of --%s. May be provided multiple times", kafkaTopicFlagName),
+	)
+	flagSet.StringVar(
+		&f.KafkaTopic,
+		kafkaTopicFlagName,
+		"",
+		"The name of a Kafka topic to deliver events to, instead of a callback URL",
+	)
 }
 
 func run(
@@ -123,13 +239,46 @@ func run(
 	if !ok || event == int32(registryv1alpha1.WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED) {
 		return fmt.Errorf("webhook event must be specified")
 	}
+	retryPolicy, err := newRetryPolicy(flags)
+	if err != nil {
+		return err
+	}
+	var payloadVersion registryv1alpha1.WebhookPayloadVersion
+	if flags.PayloadVersion != "" {
+		value, ok := registryv1alpha1.WebhookPayloadVersion_value[flags.PayloadVersion]
+		if !ok {
+			return appcmd.NewInvalidArgumentErrorf("unknown --%s %q", payloadVersionFlagName, flags.PayloadVersion)
+		}
+		payloadVersion = registryv1alpha1.WebhookPayloadVersion(value)
+	}
+	sink, err := newSink(flags)
+	if err != nil {
+		return err
+	}
+	var deliveryFormat registryv1alpha1.WebhookDeliveryFormat
+	if flags.DeliveryFormat != "" {
+		value, ok := registryv1alpha1.WebhookDeliveryFormat_value[flags.DeliveryFormat]
+		if !ok {
+			return appcmd.NewInvalidArgumentErrorf("unknown --%s %q", deliveryFormatFlagName, flags.DeliveryFormat)
+		}
+		deliveryFormat = registryv1alpha1.WebhookDeliveryFormat(value)
+	}
+	payloadFieldMask, err := newPayloadFieldMask(registryv1alpha1.WebhookEvent(event), flags.PayloadFields)
+	if err != nil {
+		return err
+	}
 	resp, err := service.CreateWebhook(
 		ctx,
 		connect.NewRequest(&registryv1alpha1.CreateWebhookRequest{
-			WebhookEvent:   registryv1alpha1.WebhookEvent(event),
-			OwnerName:      flags.OwnerName,
-			RepositoryName: flags.RepositoryName,
-			CallbackUrl:    flags.CallbackURL,
+			WebhookEvent:     registryv1alpha1.WebhookEvent(event),
+			OwnerName:        flags.OwnerName,
+			RepositoryName:   flags.RepositoryName,
+			CallbackUrl:      flags.CallbackURL,
+			RetryPolicy:      retryPolicy,
+			PayloadVersion:   payloadVersion,
+			PayloadFieldMask: payloadFieldMask,
+			DeliveryFormat:   deliveryFormat,
+			Sink:             sink,
 		}),
 	)
 	if err != nil {
@@ -143,3 +292,122 @@ func run(
 	_, _ = container.Stdout().Write(webhookJSON)
 	return nil
 }
+
+// newRetryPolicy returns the retry policy for the flags, or nil if no retry
+// policy flag is set.
+func newRetryPolicy(flags *flags) (*registryv1alpha1.WebhookRetryPolicy, error) {
+	if flags.MaxAttempts == 0 &&
+		flags.InitialBackoff == 0 &&
+		flags.MaxBackoff == 0 &&
+		flags.AttemptTimeout == 0 &&
+		flags.FailureNotificationEmail == "" {
+		return nil, nil
+	}
+	if flags.InitialBackoff < 0 {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s must not be negative", initialBackoffFlagName)
+	}
+	if flags.MaxBackoff < 0 {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s must not be negative", maxBackoffFlagName)
+	}
+	if flags.AttemptTimeout < 0 {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s must not be negative", attemptTimeoutFlagName)
+	}
+	if flags.InitialBackoff != 0 && flags.MaxBackoff != 0 && flags.MaxBackoff < flags.InitialBackoff {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s must not be less than --%s", maxBackoffFlagName, initialBackoffFlagName)
+	}
+	if flags.FailureNotificationEmail != "" {
+		if _, err := mail.ParseAddress(flags.FailureNotificationEmail); err != nil {
+			return nil, appcmd.NewInvalidArgumentErrorf("--%s is not a valid email address: %v", failureNotificationEmailFlagName, err)
+		}
+	}
+	retryPolicy := &registryv1alpha1.WebhookRetryPolicy{
+		MaxAttempts:              flags.MaxAttempts,
+		FailureNotificationEmail: flags.FailureNotificationEmail,
+	}
+	if flags.InitialBackoff != 0 {
+		retryPolicy.InitialBackoff = durationpb.New(flags.InitialBackoff)
+	}
+	if flags.MaxBackoff != 0 {
+		retryPolicy.MaxBackoff = durationpb.New(flags.MaxBackoff)
+	}
+	if flags.AttemptTimeout != 0 {
+		retryPolicy.AttemptTimeout = durationpb.New(flags.AttemptTimeout)
+	}
+	return retryPolicy, nil
+}
+
+// newSink returns the sink for the flags, or nil if events are delivered to
+// the callback URL.
+//
+// Exactly one of the callback URL and the sinks must be set.
+func newSink(flags *flags) (*registryv1alpha1.WebhookSink, error) {
+	var sinks []*registryv1alpha1.WebhookSink
+	if flags.SQSQueueURL != "" {
+		sinks = append(sinks, &registryv1alpha1.WebhookSink{
+			Sink: &registryv1alpha1.WebhookSink_AmazonSqs{
+				AmazonSqs: &registryv1alpha1.WebhookAmazonSqsSink{
+					QueueUrl: flags.SQSQueueURL,
+					RoleArn:  flags.SQSRoleARN,
+				},
+			},
+		})
+	} else if flags.SQSRoleARN != "" {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s requires --%s", sqsRoleARNFlagName, sqsQueueURLFlagName)
+	}
+	if flags.PubSubTopic != "" {
+		sinks = append(sinks, &registryv1alpha1.WebhookSink{
+			Sink: &registryv1alpha1.WebhookSink_GooglePubSub{
+				GooglePubSub: &registryv1alpha1.WebhookGooglePubSubSink{
+					Topic: flags.PubSubTopic,
+				},
+			},
+		})
+	}
+	if flags.KafkaTopic != "" {
+		if len(flags.KafkaBootstrapServers) == 0 {
+			return nil, appcmd.NewInvalidArgumentErrorf("--%s requires --%s", kafkaTopicFlagName, kafkaBootstrapServerFlagName)
+		}
+		sinks = append(sinks, &registryv1alpha1.WebhookSink{
+			Sink: &registryv1alpha1.WebhookSink_Kafka{
+				Kafka: &registryv1alpha1.WebhookKafkaSink{
+					BootstrapServers: flags.KafkaBootstrapServers,
+					Topic:            flags.KafkaTopic,
+				},
+			},
+		})
+	} else if len(flags.KafkaBootstrapServers) > 0 {
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s requires --%s", kafkaBootstrapServerFlagName, kafkaTopicFlagName)
+	}
+	switch {
+	case len(sinks) > 1:
+		return nil, appcmd.NewInvalidArgumentError("only one sink can be set")
+	case len(sinks) == 1 && flags.CallbackURL != "":
+		return nil, appcmd.NewInvalidArgumentErrorf("--%s cannot be set with a sink", callbackURLFlagName)
+	case len(sinks) == 0 && flags.CallbackURL == "":
+		return nil, appcmd.NewInvalidArgumentErrorf("either --%s or a sink must be set", callbackURLFlagName)
+	case len(sinks) == 1:
+		return sinks[0], nil
+	default:
+		return nil, nil
+	}
+}
+
+// newPayloadFieldMask returns the field mask for the paths of the fields of the
+// payload message of the event, or nil if there are no paths.
+func newPayloadFieldMask(event registryv1alpha1.WebhookEvent, paths []string) (*fieldmaskpb.FieldMask, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	var payload proto.Message
+	switch event {
+	case registryv1alpha1.WebhookEvent_WEBHOOK_EVENT_REPOSITORY_PUSH:
+		payload = &webhookv1alpha1.RepositoryPushEvent{}
+	default:
+		return nil, fmt.Errorf("--%s is not supported for event %v", payloadFieldFlagName, event)
+	}
+	fieldMask, err := fieldmaskpb.New(payload, paths...)
+	if err != nil {
+		return nil, appcmd.NewInvalidArgumentErrorf("invalid --%s: %v", payloadFieldFlagName, err)
+	}
+	return fieldMask, nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcrecord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufrpc"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/bufbuild/buf/private/pkg/transport/http/httpserver"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/multierr"
+)
+
+const (
+	errorFormatFlagName         = "error-format"
+	configFlagName              = "config"
+	disableSymlinksFlagName     = "disable-symlinks"
+	targetFlagName              = "target"
+	archiveFlagName             = "archive"
+	bindFlagName                = "bind"
+	portFlagName                = "port"
+	protocolFlagName            = "protocol"
+	http2PriorKnowledgeFlagName = "http2-prior-knowledge"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <input>",
+		Short: "Record RPCs with a proxy to a server",
+		Long: `Record RPCs with a proxy to a server.
+
+The proxy serves the unary methods of the services of the input, forwards each RPC to the
+server at --` + targetFlagName + `, and appends the request and the response or error to the
+archive at --` + archiveFlagName + `. Clients can use the Connect, gRPC, and gRPC-Web protocols,
+over HTTP/1.1 or HTTP/2 without TLS. The archive can be replayed against a server with
+"buf beta rpc replay".
+
+The archive contains one JSON object per line, with the full name of the method, and the
+JSON representations of the request and of the response or error. Headers are not recorded,
+as they may contain credentials.
+
+For example:
+
+    $ buf beta rpc record --target https://api.example.com --archive rpcs.jsonl
+    $ buf curl --schema . --data '{"name": "foo"}' http://localhost:8080/foo.v1.FooService/GetFoo
+
+` + bufcli.GetInputLong(`the source, module, or image to get the services from`),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	ErrorFormat         string
+	Config              string
+	DisableSymlinks     bool
+	Target              string
+	Archive             string
+	BindAddress         string
+	Port                string
+	Protocol            string
+	HTTP2PriorKnowledge bool
+
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The file or data to use for configuration`,
+	)
+	flagSet.StringVar(
+		&f.Target,
+		targetFlagName,
+		"",
+		`The base URL of the server to forward RPCs to, such as https://api.example.com`,
+	)
+	flagSet.StringVar(
+		&f.Archive,
+		archiveFlagName,
+		"",
+		`The archive to append RPCs to`,
+	)
+	flagSet.StringVar(
+		&f.BindAddress,
+		bindFlagName,
+		"127.0.0.1",
+		`The address to listen on`,
+	)
+	flagSet.StringVar(
+		&f.Port,
+		portFlagName,
+		"8080",
+		`The port to listen on`,
+	)
+	flagSet.StringVar(
+		&f.Protocol,
+		protocolFlagName,
+		connect.ProtocolConnect,
+		fmt.Sprintf(
+			`The protocol to use for the server. Must be one of %q, %q, or %q`,
+			connect.ProtocolConnect,
+			connect.ProtocolGRPC,
+			connect.ProtocolGRPCWeb,
+		),
+	)
+	flagSet.BoolVar(
+		&f.HTTP2PriorKnowledge,
+		http2PriorKnowledgeFlagName,
+		false,
+		`Use HTTP/2 without TLS for the server, which the grpc protocol requires for http URLs`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) (retErr error) {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	if flags.Target == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", targetFlagName)
+	}
+	if flags.Archive == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", archiveFlagName)
+	}
+	clientOptions, err := bufrpc.ClientOptions(flags.Protocol)
+	if err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", protocolFlagName, err)
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	image, err := bufcli.NewImageForSource(
+		ctx,
+		container,
+		input,
+		flags.ErrorFormat,
+		flags.DisableSymlinks,
+		flags.Config,
+		nil,
+		nil,
+		false,
+		true, // source code info is not needed
+		"",
+		false,
+		0,
+		1,
+	)
+	if err != nil {
+		return err
+	}
+	res, err := protoencoding.NewResolver(bufimage.ImageToFileDescriptors(image)...)
+	if err != nil {
+		return err
+	}
+	methodDescriptors, err := bufrpc.ImageMethodDescriptors(image, res)
+	if err != nil {
+		return err
+	}
+	if len(methodDescriptors) == 0 {
+		return errors.New("input contains no methods")
+	}
+	archiveFile, err := os.OpenFile(flags.Archive, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, archiveFile.Close())
+	}()
+	handler := bufrpc.NewRecorder(
+		container.Logger(),
+		methodDescriptors,
+		res,
+		bufrpc.NewHTTPClient(flags.Target, flags.HTTP2PriorKnowledge),
+		flags.Target,
+		clientOptions,
+		bufrpc.NewArchiveWriter(archiveFile),
+	)
+	var listenConfig net.ListenConfig
+	listener, err := listenConfig.Listen(ctx, "tcp", net.JoinHostPort(flags.BindAddress, flags.Port))
+	if err != nil {
+		return err
+	}
+	return httpserver.Run(
+		ctx,
+		container.Logger(),
+		listener,
+		handler,
+	)
+}
@@ -0,0 +1,249 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcreplay
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufcurl"
+	"github.com/bufbuild/buf/private/buf/bufrpc"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/multierr"
+)
+
+const (
+	errorFormatFlagName         = "error-format"
+	configFlagName              = "config"
+	disableSymlinksFlagName     = "disable-symlinks"
+	targetFlagName              = "target"
+	archiveFlagName             = "archive"
+	protocolFlagName            = "protocol"
+	http2PriorKnowledgeFlagName = "http2-prior-knowledge"
+	headerFlagName              = "header"
+	headerFlagShortName         = "H"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <input>",
+		Short: "Replay recorded RPCs against a server and compare the responses",
+		Long: `Replay recorded RPCs against a server and compare the responses.
+
+Each RPC of the archive at --` + archiveFlagName + `, as recorded by "buf beta rpc record", is sent
+to the server at --` + targetFlagName + `, and the code and the response are compared with the
+recorded ones, field by field, using the schema of the input. Every difference is printed
+with the path of the field, and the command fails if any RPC differs. Fields that are not
+set are equal to fields that are set to their default values.
+
+For example:
+
+    $ buf beta rpc replay --target http://localhost:8080 --archive rpcs.jsonl
+    foo.v1.FooService.GetFoo (RPC 2):
+      response.foo.name: expected "foo", got "bar"
+    Failure: 1 of 3 RPCs differ
+
+` + bufcli.GetInputLong(`the source, module, or image to get the services from`),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	ErrorFormat         string
+	Config              string
+	DisableSymlinks     bool
+	Target              string
+	Archive             string
+	Protocol            string
+	HTTP2PriorKnowledge bool
+	Headers             []string
+
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors printed to stderr. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The file or data to use for configuration`,
+	)
+	flagSet.StringVar(
+		&f.Target,
+		targetFlagName,
+		"",
+		`The base URL of the server to replay RPCs against, such as http://localhost:8080`,
+	)
+	flagSet.StringVar(
+		&f.Archive,
+		archiveFlagName,
+		"",
+		`The archive of RPCs to replay`,
+	)
+	flagSet.StringVar(
+		&f.Protocol,
+		protocolFlagName,
+		connect.ProtocolConnect,
+		fmt.Sprintf(
+			`The protocol to use for the server. Must be one of %q, %q, or %q`,
+			connect.ProtocolConnect,
+			connect.ProtocolGRPC,
+			connect.ProtocolGRPCWeb,
+		),
+	)
+	flagSet.BoolVar(
+		&f.HTTP2PriorKnowledge,
+		http2PriorKnowledgeFlagName,
+		false,
+		`Use HTTP/2 without TLS for the server, which the grpc protocol requires for http URLs`,
+	)
+	flagSet.StringSliceVarP(
+		&f.Headers,
+		headerFlagName,
+		headerFlagShortName,
+		nil,
+		`Request headers to include with each RPC, in the same format as the --header flag of buf curl`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) (retErr error) {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	if flags.Target == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", targetFlagName)
+	}
+	if flags.Archive == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required", archiveFlagName)
+	}
+	clientOptions, err := bufrpc.ClientOptions(flags.Protocol)
+	if err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", protocolFlagName, err)
+	}
+	headers, _, err := bufcurl.LoadHeaders(flags.Headers, "", nil)
+	if err != nil {
+		return err
+	}
+	archiveFile, err := os.Open(flags.Archive)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, archiveFile.Close())
+	}()
+	entries, err := bufrpc.ReadArchive(archiveFile)
+	if err != nil {
+		return fmt.Errorf("%s: %w", flags.Archive, err)
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	image, err := bufcli.NewImageForSource(
+		ctx,
+		container,
+		input,
+		flags.ErrorFormat,
+		flags.DisableSymlinks,
+		flags.Config,
+		nil,
+		nil,
+		false,
+		true, // source code info is not needed
+		"",
+		false,
+		0,
+		1,
+	)
+	if err != nil {
+		return err
+	}
+	res, err := protoencoding.NewResolver(bufimage.ImageToFileDescriptors(image)...)
+	if err != nil {
+		return err
+	}
+	replayer := bufrpc.NewReplayer(
+		res,
+		bufrpc.NewHTTPClient(flags.Target, flags.HTTP2PriorKnowledge),
+		flags.Target,
+		clientOptions,
+		headers,
+	)
+	var numDiffering int
+	for i, entry := range entries {
+		differences, err := replayer.Replay(ctx, entry)
+		if err != nil {
+			return err
+		}
+		if len(differences) == 0 {
+			continue
+		}
+		numDiffering++
+		if _, err := fmt.Fprintf(container.Stdout(), "%s (RPC %d):\n", entry.Method, i+1); err != nil {
+			return err
+		}
+		for _, difference := range differences {
+			if _, err := fmt.Fprintf(container.Stdout(), "  %s\n", difference.String()); err != nil {
+				return err
+			}
+		}
+	}
+	if numDiffering > 0 {
+		return fmt.Errorf("%d of %d RPCs differ", numDiffering, len(entries))
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulesbom"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	outputFlagName          = "output"
+	outputFlagShortName     = "o"
+	formatFlagName          = "format"
+	disableSymlinksFlagName = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <source>",
+		Short: "Produce a software bill of materials for a source or module",
+		Long: "The SBOM lists the module, and all of its dependencies with their commits, digests, " +
+			"and the licenses detected from their LICENSE files.\n\n" +
+			bufcli.GetSourceOrModuleLong(`the source or module to produce a SBOM for`),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Output          string
+	Format          string
+	DisableSymlinks bool
+
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		"-",
+		`The file to write the SBOM to. Defaults to stdout`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufmodulesbom.FormatSPDX.String(),
+		fmt.Sprintf(
+			"The SBOM format. Must be one of %s",
+			stringutil.SliceToString(bufmodulesbom.AllFormatStrings),
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	format, err := bufmodulesbom.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", formatFlagName, err)
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	sourceOrModuleRef, err := buffetch.NewRefParser(container.Logger()).GetSourceOrModuleRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	runner := command.NewRunner()
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	moduleReader, err := bufcli.NewModuleReaderAndCreateCacheDirs(container, clientConfig)
+	if err != nil {
+		return err
+	}
+	moduleConfigReader, err := bufcli.NewWireModuleConfigReaderForModuleReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+		moduleReader,
+	)
+	if err != nil {
+		return err
+	}
+	moduleConfigs, err := moduleConfigReader.GetModuleConfigs(
+		ctx,
+		container,
+		sourceOrModuleRef,
+		"",
+		nil,
+		nil,
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	subjects := make([]*bufmodulesbom.Subject, len(moduleConfigs))
+	for i, moduleConfig := range moduleConfigs {
+		subjects[i] = &bufmodulesbom.Subject{
+			Module: moduleConfig.Module(),
+		}
+		// The config of a module reference is read from the current directory,
+		// so the name of the module is taken from the reference instead.
+		if _, ok := sourceOrModuleRef.(buffetch.ModuleRef); ok {
+			moduleReference, err := bufmoduleref.ModuleReferenceForString(input)
+			if err != nil {
+				return err
+			}
+			subjects[i].ModuleIdentity = moduleReference
+		} else if moduleConfig.Config() != nil {
+			subjects[i].ModuleIdentity = moduleConfig.Config().ModuleIdentity
+		}
+	}
+	sbom, err := bufmodulesbom.NewSBOM(ctx, moduleReader, time.Now(), subjects...)
+	if err != nil {
+		return err
+	}
+	data, err := bufmodulesbom.Marshal(sbom, format)
+	if err != nil {
+		return err
+	}
+	if flags.Output == "-" {
+		_, err := container.Stdout().Write(data)
+		return err
+	}
+	return os.WriteFile(flags.Output, data, 0600)
+}
@@ -15,17 +15,23 @@
 package stats
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/buf/buffetch"
 	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulestat"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
 	"github.com/bufbuild/buf/private/pkg/command"
 	"github.com/bufbuild/buf/private/pkg/protostat"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -33,6 +39,22 @@ import (
 const (
 	formatFlagName          = "format"
 	disableSymlinksFlagName = "disable-symlinks"
+	pushGatewayFlagName     = "push-gateway"
+
+	formatPrometheus = "prometheus"
+
+	// defaultPushGatewayJobPath is appended to the push gateway URL if the URL
+	// does not already specify a job.
+	defaultPushGatewayJobPath = "/metrics/job/buf_stats"
+	pushGatewayContentType    = "text/plain; version=0.0.4"
+)
+
+var allFormatsString = stringutil.SliceToString(
+	[]string{
+		bufprint.FormatText.String(),
+		bufprint.FormatJSON.String(),
+		formatPrometheus,
+	},
 )
 
 // NewCommand returns a new Command.
@@ -44,8 +66,20 @@ func NewCommand(
 	return &appcmd.Command{
 		Use:   name + " <source>",
 		Short: "Get statistics for a given source or module",
-		Long:  bufcli.GetSourceOrModuleLong(`the source or module to get statistics for`),
-		Args:  cobra.MaximumNArgs(1),
+		Long: bufcli.GetSourceOrModuleLong(`the source or module to get statistics for`) + `
+
+In addition to counts of Protobuf elements, this reports the number of deprecated fields,
+the number of lint ignores (buf:lint:ignore comments plus ignore and ignore_only paths),
+and the number of breaking exceptions (buf:breaking:ignore comments plus except rules and
+ignore and ignore_only paths), so that these can be tracked over time.
+
+Use --format=prometheus to print the statistics in the Prometheus text exposition format,
+and --push-gateway to push them to a Prometheus Pushgateway, for example from a nightly job:
+
+    $ buf beta stats --push-gateway https://pushgateway.example.com/metrics/job/acme_apis
+
+If the push gateway URL does not contain a job, "` + defaultPushGatewayJobPath + `" is appended.`,
+		Args: cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
 			func(ctx context.Context, container appflag.Container) error {
 				return run(ctx, container, flags)
@@ -59,6 +93,7 @@ func NewCommand(
 type flags struct {
 	Format          string
 	DisableSymlinks bool
+	PushGateway     string
 
 	// special
 	InputHashtag string
@@ -73,7 +108,13 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		&f.Format,
 		formatFlagName,
 		bufprint.FormatText.String(),
-		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, allFormatsString),
+	)
+	flagSet.StringVar(
+		&f.PushGateway,
+		pushGatewayFlagName,
+		"",
+		`The URL of a Prometheus Pushgateway to push the statistics to, in addition to printing them`,
 	)
 	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
 	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
@@ -84,9 +125,16 @@ func run(
 	container appflag.Container,
 	flags *flags,
 ) error {
-	format, err := bufprint.ParseFormat(flags.Format)
-	if err != nil {
-		return appcmd.NewInvalidArgumentError(err.Error())
+	var format bufprint.Format
+	if flags.Format != formatPrometheus {
+		var err error
+		format, err = bufprint.ParseFormat(flags.Format)
+		if err != nil {
+			return appcmd.NewInvalidArgumentError(err.Error())
+		}
+	}
+	if flags.PushGateway != "" && !strings.HasPrefix(flags.PushGateway, "http://") && !strings.HasPrefix(flags.PushGateway, "https://") {
+		return appcmd.NewInvalidArgumentErrorf("--%s must be an http or https URL: %q", pushGatewayFlagName, flags.PushGateway)
 	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
 	if err != nil {
@@ -134,8 +182,67 @@ func run(
 		if err != nil {
 			return err
 		}
+		addConfigStats(stats, moduleConfig.Config())
 		statsSlice[i] = stats
 	}
-	return bufprint.NewStatsPrinter(container.Stdout()).
-		PrintStats(ctx, format, protostat.MergeStats(statsSlice...))
+	stats := protostat.MergeStats(statsSlice...)
+	if flags.PushGateway != "" {
+		if err := push(ctx, flags.PushGateway, stats); err != nil {
+			return err
+		}
+	}
+	statsPrinter := bufprint.NewStatsPrinter(container.Stdout())
+	if flags.Format == formatPrometheus {
+		return statsPrinter.PrintStatsPrometheus(ctx, stats)
+	}
+	return statsPrinter.PrintStats(ctx, format, stats)
+}
+
+// addConfigStats sets the statistics on stats that are derived from configuration.
+func addConfigStats(stats *protostat.Stats, config *bufconfig.Config) {
+	if config == nil {
+		return
+	}
+	if lintConfig := config.Lint; lintConfig != nil {
+		stats.NumLintConfigIgnores += len(lintConfig.IgnoreRootPaths)
+		for _, rootPaths := range lintConfig.IgnoreIDOrCategoryToRootPaths {
+			stats.NumLintConfigIgnores += len(rootPaths)
+		}
+	}
+	if breakingConfig := config.Breaking; breakingConfig != nil {
+		stats.NumBreakingConfigExceptions += len(breakingConfig.Except)
+		stats.NumBreakingConfigExceptions += len(breakingConfig.IgnoreRootPaths)
+		for _, rootPaths := range breakingConfig.IgnoreIDOrCategoryToRootPaths {
+			stats.NumBreakingConfigExceptions += len(rootPaths)
+		}
+	}
+}
+
+// push pushes the stats to the Prometheus Pushgateway at the given URL.
+//
+// This uses PUT, so that all metrics previously pushed for the job are replaced.
+func push(ctx context.Context, pushGatewayURL string, stats *protostat.Stats) error {
+	pushGatewayURL = strings.TrimSuffix(pushGatewayURL, "/")
+	if !strings.Contains(pushGatewayURL, "/metrics/job/") {
+		pushGatewayURL += defaultPushGatewayJobPath
+	}
+	buffer := bytes.NewBuffer(nil)
+	if err := bufprint.NewStatsPrinter(buffer).PrintStatsPrometheus(ctx, stats); err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, pushGatewayURL, buffer)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", pushGatewayContentType)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("push to %s failed with status %s: %s", pushGatewayURL, response.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
 }
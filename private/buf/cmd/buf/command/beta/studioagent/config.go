@@ -0,0 +1,87 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package studioagent
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bufbuild/buf/private/pkg/encoding"
+)
+
+// externalConfig is the YAML configuration file for the agent.
+type externalConfig struct {
+	Bind               string            `json:"bind,omitempty" yaml:"bind,omitempty"`
+	Port               string            `json:"port,omitempty" yaml:"port,omitempty"`
+	Origins            []string          `json:"origins,omitempty" yaml:"origins,omitempty"`
+	PrivateNetwork     bool              `json:"private_network,omitempty" yaml:"private_network,omitempty"`
+	AllowedTargetHosts []string          `json:"allowed_target_hosts,omitempty" yaml:"allowed_target_hosts,omitempty"`
+	DisallowedHeaders  []string          `json:"disallowed_headers,omitempty" yaml:"disallowed_headers,omitempty"`
+	StrippedHeaders    []string          `json:"stripped_headers,omitempty" yaml:"stripped_headers,omitempty"`
+	ForwardHeaders     map[string]string `json:"forward_headers,omitempty" yaml:"forward_headers,omitempty"`
+	CACert             string            `json:"ca_cert,omitempty" yaml:"ca_cert,omitempty"`
+	ClientCert         string            `json:"client_cert,omitempty" yaml:"client_cert,omitempty"`
+	ClientKey          string            `json:"client_key,omitempty" yaml:"client_key,omitempty"`
+	ServerCert         string            `json:"server_cert,omitempty" yaml:"server_cert,omitempty"`
+	ServerKey          string            `json:"server_key,omitempty" yaml:"server_key,omitempty"`
+	Metrics            bool              `json:"metrics,omitempty" yaml:"metrics,omitempty"`
+}
+
+// applyConfigFile reads the config file and applies its values to the flags
+// that were not set explicitly.
+func applyConfigFile(flags *flags, configFilePath string) error {
+	data, err := os.ReadFile(configFilePath)
+	if err != nil {
+		return fmt.Errorf("could not read --%s: %w", configFlagName, err)
+	}
+	var externalConfig externalConfig
+	if err := encoding.UnmarshalYAMLStrict(data, &externalConfig); err != nil {
+		return fmt.Errorf("could not parse --%s %s: %w", configFlagName, configFilePath, err)
+	}
+	applyString := func(flagName string, value string, target *string) {
+		if value != "" && !flags.flagSet.Changed(flagName) {
+			*target = value
+		}
+	}
+	applyStringSlice := func(flagName string, value []string, target *[]string) {
+		if len(value) > 0 && !flags.flagSet.Changed(flagName) {
+			*target = value
+		}
+	}
+	applyString(bindFlagName, externalConfig.Bind, &flags.BindAddress)
+	applyString(portFlagName, externalConfig.Port, &flags.Port)
+	applyString(caCertFlagName, externalConfig.CACert, &flags.CACert)
+	applyString(clientCertFlagName, externalConfig.ClientCert, &flags.ClientCert)
+	applyString(clientKeyFlagName, externalConfig.ClientKey, &flags.ClientKey)
+	applyString(serverCertFlagName, externalConfig.ServerCert, &flags.ServerCert)
+	applyString(serverKeyFlagName, externalConfig.ServerKey, &flags.ServerKey)
+	applyStringSlice(allowedTargetHostFlagName, externalConfig.AllowedTargetHosts, &flags.AllowedTargetHosts)
+	applyStringSlice(disallowedHeadersFlagName, externalConfig.DisallowedHeaders, &flags.DisallowedHeaders)
+	applyStringSlice(strippedHeadersFlagName, externalConfig.StrippedHeaders, &flags.StrippedHeaders)
+	if len(externalConfig.Origins) > 0 && !flags.flagSet.Changed(originFlagName) {
+		flags.Origin = externalConfig.Origins[0]
+		flags.AllowedOrigins = externalConfig.Origins[1:]
+	}
+	if externalConfig.PrivateNetwork && !flags.flagSet.Changed(privateNetworkFlagName) {
+		flags.PrivateNetwork = true
+	}
+	if externalConfig.Metrics && !flags.flagSet.Changed(metricsFlagName) {
+		flags.Metrics = true
+	}
+	if len(externalConfig.ForwardHeaders) > 0 && !flags.flagSet.Changed(forwardHeadersFlagName) {
+		flags.ForwardHeaders = externalConfig.ForwardHeaders
+	}
+	return nil
+}
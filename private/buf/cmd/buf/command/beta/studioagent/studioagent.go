@@ -43,6 +43,10 @@ const (
 	serverCertFlagName        = "server-cert"
 	serverKeyFlagName         = "server-key"
 	privateNetworkFlagName    = "private-network"
+	allowedTargetHostFlagName = "allowed-target-host"
+	strippedHeadersFlagName   = "stripped-header"
+	configFlagName            = "config"
+	metricsFlagName           = "metrics"
 )
 
 // NewCommand returns a new Command.
@@ -54,7 +58,36 @@ func NewCommand(
 	return &appcmd.Command{
 		Use:   name,
 		Short: "Run an HTTP(S) server as the Studio agent",
-		Args:  cobra.ExactArgs(0),
+		Long: `Run an HTTP(S) server as the Studio agent.
+
+Every request is written to the access log at the info level. Use --log-format=json
+for structured logs. If --` + metricsFlagName + ` is set, request counts, latencies, and upstream
+errors by target are served on /metrics in the Prometheus text format.
+
+The agent can also be configured with a YAML file passed with --` + configFlagName + `. Flags
+that are set explicitly take precedence over the values in the file. For example:
+
+    bind: 0.0.0.0
+    port: 8443
+    origins:
+      - https://studio.buf.build
+    private_network: true
+    allowed_target_hosts:
+      - api.example.com
+      - "*.internal.example.com:443"
+    disallowed_headers:
+      - Cookie
+    stripped_headers:
+      - X-Debug
+    forward_headers:
+      Authorization: Authorization
+    ca_cert: ca.crt
+    client_cert: client.crt
+    client_key: client.key
+    server_cert: server.crt
+    server_key: server.key
+    metrics: true`,
+		Args: cobra.ExactArgs(0),
 		Run: builder.NewRunFunc(
 			func(ctx context.Context, container appflag.Container) error {
 				return run(ctx, container, flags)
@@ -77,6 +110,15 @@ type flags struct {
 	ServerCert        string
 	ServerKey         string
 	PrivateNetwork    bool
+	// AllowedOrigins are the origins allowed in addition to Origin, only set from the config file.
+	AllowedOrigins     []string
+	AllowedTargetHosts []string
+	StrippedHeaders    []string
+	Config             string
+	Metrics            bool
+
+	// special
+	flagSet *pflag.FlagSet
 }
 
 func newFlags() *flags {
@@ -84,6 +126,7 @@ func newFlags() *flags {
 }
 
 func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	f.flagSet = flagSet
 	flagSet.StringVar(
 		&f.BindAddress,
 		bindFlagName,
@@ -150,6 +193,30 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		false,
 		`Use the agent with private network CORS`,
 	)
+	flagSet.StringSliceVar(
+		&f.AllowedTargetHosts,
+		allowedTargetHostFlagName,
+		nil,
+		`The hosts that requests may be forwarded to, optionally with a port (like --allowed-target-host=api.example.com:443). A leading "*." matches any subdomain. All hosts are allowed if not set. Multiple hosts are appended if specified multiple times`,
+	)
+	flagSet.StringSliceVar(
+		&f.StrippedHeaders,
+		strippedHeadersFlagName,
+		nil,
+		`The header names that are removed from enveloped requests before they are forwarded to the target server. Multiple headers are appended if specified multiple times`,
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The YAML file to read the agent configuration from`,
+	)
+	flagSet.BoolVar(
+		&f.Metrics,
+		metricsFlagName,
+		false,
+		`Serve Prometheus metrics on /metrics`,
+	)
 }
 
 func run(
@@ -157,6 +224,11 @@ func run(
 	container appflag.Container,
 	flags *flags,
 ) error {
+	if flags.Config != "" {
+		if err := applyConfigFile(flags, flags.Config); err != nil {
+			return err
+		}
+	}
 	// CA cert pool is optional. If it is nil, TLS uses the host's root CA set.
 	var rootCAConfig *tls.Config
 	var err error
@@ -182,6 +254,14 @@ func run(
 			return fmt.Errorf("cannot create new server TLS config: %w", err)
 		}
 	}
+	handlerOptions := []bufstudioagent.HandlerOption{
+		bufstudioagent.HandlerWithAllowedOrigins(flags.AllowedOrigins),
+		bufstudioagent.HandlerWithAllowedTargetHosts(flags.AllowedTargetHosts),
+		bufstudioagent.HandlerWithStrippedHeaders(flags.StrippedHeaders),
+	}
+	if flags.Metrics {
+		handlerOptions = append(handlerOptions, bufstudioagent.HandlerWithMetrics())
+	}
 	mux := bufstudioagent.NewHandler(
 		container.Logger(),
 		flags.Origin,
@@ -189,6 +269,7 @@ func run(
 		stringutil.SliceToMap(flags.DisallowedHeaders),
 		flags.ForwardHeaders,
 		flags.PrivateNetwork,
+		handlerOptions...,
 	)
 	var httpListenConfig net.ListenConfig
 	httpListener, err := httpListenConfig.Listen(ctx, "tcp", fmt.Sprintf("%s:%s", flags.BindAddress, flags.Port))
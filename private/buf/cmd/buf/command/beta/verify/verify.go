@@ -0,0 +1,211 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/bufpkg/bufapimodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	keyFlagName                 = "key"
+	certificateIdentityFlagName = "certificate-identity"
+	certificateRootsFlagName    = "certificate-roots"
+	signatureFlagName           = "signature"
+	disableSymlinksFlagName     = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <module-ref-or-dir>",
+		Short: "Verify the manifest signature of a module or directory",
+		Long: `The manifest of the module must be signed by the key passed with --` + keyFlagName +
+			`, or by a certificate with the identity passed with --` + certificateIdentityFlagName + `.
+
+For a module reference, the module and its manifest signature are downloaded from the registry.
+For a directory, the manifest is computed from its files and the signature envelope is read from --` +
+			signatureFlagName + `.`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Key                 string
+	CertificateIdentity string
+	CertificateRoots    string
+	Signature           string
+	DisableSymlinks     bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.Key,
+		keyFlagName,
+		"",
+		"The path to the PEM-encoded public key the manifest must be signed by",
+	)
+	flagSet.StringVar(
+		&f.CertificateIdentity,
+		certificateIdentityFlagName,
+		"",
+		"The email or URI identity of the certificate the manifest must be signed by",
+	)
+	flagSet.StringVar(
+		&f.CertificateRoots,
+		certificateRootsFlagName,
+		"",
+		"The path to the PEM-encoded root certificates to verify signing certificates with. Defaults to the system roots",
+	)
+	flagSet.StringVar(
+		&f.Signature,
+		signatureFlagName,
+		"",
+		"The path to the signature envelope of a directory",
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	bufcli.WarnBetaCommand(ctx, container)
+	if (flags.Key == "") == (flags.CertificateIdentity == "") {
+		return fmt.Errorf("exactly one of --%s or --%s must be set", keyFlagName, certificateIdentityFlagName)
+	}
+	if flags.CertificateRoots != "" && flags.CertificateIdentity == "" {
+		return fmt.Errorf("--%s requires --%s", certificateRootsFlagName, certificateIdentityFlagName)
+	}
+	verifier, err := bufcli.NewManifestSignatureVerifier(flags.Key, flags.CertificateIdentity, flags.CertificateRoots)
+	if err != nil {
+		return err
+	}
+	input := container.Arg(0)
+	sourceOrModuleRef, err := buffetch.NewRefParser(container.Logger()).GetSourceOrModuleRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	if _, ok := sourceOrModuleRef.(buffetch.ModuleRef); ok {
+		if flags.Signature != "" {
+			return appcmd.NewInvalidArgumentErrorf("--%s can only be set for directories", signatureFlagName)
+		}
+		return verifyModule(ctx, container, input, verifier)
+	}
+	if flags.Signature == "" {
+		return appcmd.NewInvalidArgumentErrorf("--%s is required for directories", signatureFlagName)
+	}
+	return verifyDir(ctx, container, input, flags.Signature, flags.DisableSymlinks, verifier)
+}
+
+func verifyModule(
+	ctx context.Context,
+	container appflag.Container,
+	input string,
+	verifier bufmanifest.SignatureVerifier,
+) error {
+	moduleReference, err := bufmoduleref.ModuleReferenceForString(input)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	modulePin, err := bufapimodule.NewModuleResolver(
+		container.Logger(),
+		bufapimodule.NewRepositoryCommitServiceClientFactory(clientConfig),
+	).GetModulePin(ctx, moduleReference)
+	if err != nil {
+		return err
+	}
+	// The module is always downloaded so that the signature is verified, even
+	// if the module is already in the cache.
+	_, err = bufapimodule.NewModuleReader(
+		bufapimodule.NewDownloadServiceClientFactory(clientConfig),
+		bufapimodule.WithManifestSignatureVerifier(verifier),
+	).GetModule(ctx, modulePin)
+	return err
+}
+
+func verifyDir(
+	ctx context.Context,
+	container appflag.Container,
+	input string,
+	signatureFilePath string,
+	disableSymlinks bool,
+	verifier bufmanifest.SignatureVerifier,
+) error {
+	envelopeData, err := os.ReadFile(signatureFilePath)
+	if err != nil {
+		return err
+	}
+	envelope, err := bufmanifest.ParseEnvelope(envelopeData)
+	if err != nil {
+		return err
+	}
+	// Compute the manifest with the digest type of the signed manifest, so
+	// that they can be compared.
+	digestType := manifest.DigestTypeShake256
+	signedManifest, err := manifest.NewFromReader(bytes.NewReader(envelope.Payload))
+	if err != nil {
+		return err
+	}
+	if digests := signedManifest.Digests(); len(digests) > 0 {
+		digestType = digests[0].Type()
+	}
+	m, err := bufcli.ManifestForSource(
+		ctx,
+		container,
+		bufcli.NewStorageosProvider(disableSymlinks),
+		command.NewRunner(),
+		input,
+		digestType,
+	)
+	if err != nil {
+		return err
+	}
+	return bufmanifest.VerifyManifestSignature(m, envelopeData, verifier)
+}
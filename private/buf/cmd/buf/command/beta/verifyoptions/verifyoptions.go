@@ -0,0 +1,227 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifyoptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/buf/bufgen"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufoption"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	errorFormatFlagName     = "error-format"
+	configFlagName          = "config"
+	templateFlagName        = "template"
+	ruleFlagName            = "rule"
+	pathsFlagName           = "path"
+	excludePathsFlagName    = "exclude-path"
+	disableSymlinksFlagName = "disable-symlinks"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <input>",
+		Short: "Verify that handwritten file options match managed mode",
+		Long: bufcli.GetInputLong(`the source, module, or Image to verify`) + `
+
+The managed mode configuration of the generation template is applied to the input, and
+every file option that is set in a file but has a different value than managed mode would
+set is reported. This catches options that drifted from the configuration, for example a
+go_package that was copied from another directory:
+
+    $ buf beta verify-options --rule go_package_matches_module
+    acme/weather/v1/weather.proto:5:1:Option go_package is "github.com/acme/gen/acme/billing/v1;billingv1" but managed mode sets it to "github.com/acme/gen/acme/weather/v1;weatherv1".
+
+Options that are not set are not reported, as managed mode sets these on generation.
+Every rule verifies one file option and is named after it. The rules are:
+
+    ` + strings.Join(bufoption.AllRuleIDs, "\n    ") + `
+
+Rules are case-insensitive. All rules are used if --` + ruleFlagName + ` is not set.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
+	}
+}
+
+type flags struct {
+	ErrorFormat     string
+	Config          string
+	Template        string
+	Rules           []string
+	Paths           []string
+	ExcludePaths    []string
+	DisableSymlinks bool
+	// special
+	InputHashtag string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
+	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
+	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for build errors or verification violations printed to stdout. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The buf.yaml file or data to use for configuration`,
+	)
+	flagSet.StringVar(
+		&f.Template,
+		templateFlagName,
+		"",
+		`The buf.gen.yaml file or data that contains the managed mode configuration. Defaults to buf.gen.yaml in the current directory`,
+	)
+	flagSet.StringSliceVar(
+		&f.Rules,
+		ruleFlagName,
+		nil,
+		`The rules to verify. May be provided multiple times. Defaults to all rules`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
+	if err != nil {
+		return err
+	}
+	ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, input)
+	if err != nil {
+		return err
+	}
+	storageosProvider := bufcli.NewStorageosProvider(flags.DisableSymlinks)
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		".",
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return err
+	}
+	genConfig, err := bufgen.ReadConfig(
+		ctx,
+		container.Logger(),
+		bufgen.NewProvider(container.Logger()),
+		readWriteBucket,
+		bufgen.ReadConfigWithOverride(flags.Template),
+	)
+	if err != nil {
+		return err
+	}
+	if genConfig.ManagedConfig == nil {
+		return errors.New("managed mode is not enabled in the generation template, set managed.enabled to true")
+	}
+	modifier, err := bufgen.NewManagedModifier(container.Logger(), genConfig.ManagedConfig)
+	if err != nil {
+		return err
+	}
+	runner := command.NewRunner()
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	imageConfigReader, err := bufcli.NewWireImageConfigReader(
+		container,
+		storageosProvider,
+		runner,
+		clientConfig,
+	)
+	if err != nil {
+		return err
+	}
+	imageConfigs, fileAnnotations, err := imageConfigReader.GetImageConfigs(
+		ctx,
+		container,
+		ref,
+		flags.Config,
+		flags.Paths,
+		flags.ExcludePaths,
+		false, // input files must exist
+		false, // we need source info for the locations of violations
+	)
+	if err != nil {
+		return err
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, flags.ErrorFormat); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	var violations []bufanalysis.FileAnnotation
+	for _, imageConfig := range imageConfigs {
+		imageViolations, err := bufoption.VerifyImage(ctx, imageConfig.Image(), modifier, flags.Rules...)
+		if err != nil {
+			return err
+		}
+		violations = append(violations, imageViolations...)
+	}
+	if len(violations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(
+			container.Stdout(),
+			bufanalysis.DeduplicateAndSortFileAnnotations(violations),
+			flags.ErrorFormat,
+		); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	return nil
+}
@@ -24,11 +24,16 @@ import (
 	"github.com/bufbuild/buf/private/buf/bufwire"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufcheckplugin"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufowner"
+	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
 	"github.com/bufbuild/buf/private/pkg/command"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/bufbuild/buf/private/pkg/thread"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -43,6 +48,11 @@ const (
 	againstConfigFlagName     = "against-config"
 	excludePathsFlagName      = "exclude-path"
 	disableSymlinksFlagName   = "disable-symlinks"
+	exceptFlagName            = "except"
+	keepGoingFlagName         = "keep-going"
+	maxFailuresFlagName       = "max-failures"
+	parallelismFlagName       = "parallelism"
+	shardFlagName             = "shard"
 )
 
 // NewCommand returns a new Command.
@@ -63,7 +73,12 @@ func NewCommand(
 			},
 			bufcli.NewErrorInterceptor(),
 		),
-		BindFlags: flags.Bind,
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
+		CompleteFlags: map[string]appcmd.CompletionFunc{
+			againstFlagName: builder.NewCompletionFunc(bufcli.CompleteInput),
+			exceptFlagName:  builder.NewCompletionFunc(bufcli.CompleteBreakingRuleIDOrCategory),
+		},
 	}
 }
 
@@ -77,6 +92,11 @@ type flags struct {
 	AgainstConfig     string
 	ExcludePaths      []string
 	DisableSymlinks   bool
+	Except            []string
+	KeepGoing         bool
+	MaxFailures       int
+	Parallelism       int
+	Shard             string
 	// special
 	InputHashtag string
 }
@@ -90,6 +110,10 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
 	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
 	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	bufcli.BindKeepGoing(flagSet, &f.KeepGoing, keepGoingFlagName)
+	bufcli.BindMaxFailures(flagSet, &f.MaxFailures, maxFailuresFlagName)
+	bufcli.BindParallelism(flagSet, &f.Parallelism, parallelismFlagName)
+	bufcli.BindShard(flagSet, &f.Shard, shardFlagName)
 	flagSet.StringVar(
 		&f.ErrorFormat,
 		errorFormatFlagName,
@@ -137,6 +161,12 @@ Overrides --%s`,
 		"",
 		`The buf.yaml file or data to use to configure the against source, module, or image`,
 	)
+	flagSet.StringSliceVar(
+		&f.Except,
+		exceptFlagName,
+		nil,
+		`The rule or category IDs to exclude from breaking change detection, in addition to the except of the configuration. May be provided multiple times`,
+	)
 }
 
 func run(
@@ -150,6 +180,16 @@ func run(
 	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
 		return err
 	}
+	if err := bufcli.ValidateMaxFailuresFlag(flags.MaxFailures, maxFailuresFlagName); err != nil {
+		return err
+	}
+	if err := bufcli.ValidateParallelismFlag(flags.Parallelism, parallelismFlagName); err != nil {
+		return err
+	}
+	shard, err := bufcli.ParseShardFlag(flags.Shard, shardFlagName)
+	if err != nil {
+		return err
+	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
 	if err != nil {
 		return err
@@ -164,11 +204,28 @@ func run(
 	if err != nil {
 		return err
 	}
+	imageConfigReaderOptions := []bufwire.ImageConfigReaderOption{
+		// source info is only loaded for the files that need it, see bufbreaking.Handler
+		bufwire.ImageConfigReaderWithLazySourceCodeInfo(),
+	}
+	if flags.KeepGoing {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithKeepGoing(),
+		)
+	}
+	if flags.Parallelism > 1 {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithParallelism(flags.Parallelism),
+		)
+	}
 	imageConfigReader, err := bufcli.NewWireImageConfigReader(
 		container,
 		storageosProvider,
 		runner,
 		clientConfig,
+		imageConfigReaderOptions...,
 	)
 	if err != nil {
 		return err
@@ -186,16 +243,19 @@ func run(
 	if err != nil {
 		return err
 	}
-	if len(fileAnnotations) > 0 {
-		if err := bufanalysis.PrintFileAnnotations(
-			container.Stdout(),
-			fileAnnotations,
-			flags.ErrorFormat,
-		); err != nil {
+	if len(fileAnnotations) > 0 && !flags.KeepGoing {
+		if err := printFileAnnotations(container, fileAnnotations, flags.ErrorFormat, flags.MaxFailures); err != nil {
 			return err
 		}
 		return errors.New("")
 	}
+	if len(imageConfigs) == 0 {
+		// The input has the changed option and no .proto files changed.
+		return nil
+	}
+	// With --keep-going, the build failures of the modules that failed to build
+	// are reported along with the breaking changes of the modules that built.
+	buildFileAnnotations := fileAnnotations
 	// TODO: this doesn't actually work because we're using the same file paths for both sides
 	// if the roots change, then we're torched
 	externalPaths := flags.Paths
@@ -222,16 +282,18 @@ func run(
 	if err != nil {
 		return err
 	}
-	if len(fileAnnotations) > 0 {
-		if err := bufanalysis.PrintFileAnnotations(
-			container.Stdout(),
-			fileAnnotations,
-			flags.ErrorFormat,
-		); err != nil {
+	if len(fileAnnotations) > 0 && !flags.KeepGoing {
+		if err := printFileAnnotations(container, fileAnnotations, flags.ErrorFormat, flags.MaxFailures); err != nil {
 			return err
 		}
 		return bufcli.ErrFileAnnotation
 	}
+	if len(fileAnnotations) > 0 {
+		// The same failures may be reported for both inputs.
+		buildFileAnnotations = bufanalysis.DeduplicateAndSortFileAnnotations(
+			append(buildFileAnnotations, fileAnnotations...),
+		)
+	}
 	if len(imageConfigs) != len(againstImageConfigs) {
 		// If workspaces are being used as input, the number
 		// of images MUST match. Otherwise the results will
@@ -241,26 +303,79 @@ func run(
 		// we're torched.
 		return fmt.Errorf("input contained %d images, whereas against contained %d images", len(imageConfigs), len(againstImageConfigs))
 	}
-	var allFileAnnotations []bufanalysis.FileAnnotation
+	// Each module is checked separately, up to --parallelism modules at once.
+	moduleFileAnnotations := make([][]bufanalysis.FileAnnotation, len(imageConfigs))
+	jobs := make([]func(context.Context) error, 0, len(imageConfigs))
 	for i, imageConfig := range imageConfigs {
-		fileAnnotations, err := breakingForImage(
-			ctx,
-			container,
-			imageConfig,
-			againstImageConfigs[i],
-			flags.ExcludeImports,
-			flags.ErrorFormat,
-		)
-		if err != nil {
-			return err
+		if imageConfig == nil || againstImageConfigs[i] == nil {
+			// The module failed to build on either side, and its failures
+			// are in buildFileAnnotations.
+			continue
 		}
+		againstImage := againstImageConfigs[i].Image()
+		if shard != nil {
+			// Breaking changes are reported for the files of the against
+			// Image, so only the against Image needs to be limited to the
+			// shard. This also covers files of the shard that were deleted.
+			// Imports are dropped so that they are only checked by the shard
+			// of their own package, if they are part of the input.
+			againstImage, err = shard.ImageForShard(againstImage)
+			if err != nil {
+				return err
+			}
+			if againstImage == nil {
+				// None of the files of the module are in the shard.
+				continue
+			}
+			againstImage = bufimage.ImageWithoutImports(againstImage)
+		}
+		i := i
+		imageConfig := imageConfig
+		jobs = append(jobs, func(ctx context.Context) error {
+			fileAnnotations, err := breakingForImage(
+				ctx,
+				container,
+				runner,
+				imageConfig,
+				againstImage,
+				flags.ExcludeImports,
+				flags.Except,
+				flags.ErrorFormat,
+			)
+			if err != nil {
+				return err
+			}
+			moduleFileAnnotations[i] = fileAnnotations
+			return nil
+		})
+	}
+	if err := thread.Parallelize(ctx, jobs, thread.ParallelizeWithParallelism(flags.Parallelism)); err != nil {
+		return err
+	}
+	var allFileAnnotations []bufanalysis.FileAnnotation
+	for _, fileAnnotations := range moduleFileAnnotations {
 		allFileAnnotations = append(allFileAnnotations, fileAnnotations...)
 	}
-	if len(allFileAnnotations) > 0 {
-		if err := bufanalysis.PrintFileAnnotations(
-			container.Stdout(),
-			bufanalysis.DeduplicateAndSortFileAnnotations(allFileAnnotations),
+	if shard != nil {
+		var images []bufimage.Image
+		for i, imageConfig := range imageConfigs {
+			if imageConfig != nil {
+				images = append(images, imageConfig.Image())
+			}
+			if againstImageConfigs[i] != nil {
+				images = append(images, againstImageConfigs[i].Image())
+			}
+		}
+		if err := bufcli.PrintShardFingerprint(container, shard, images...); err != nil {
+			return err
+		}
+	}
+	if len(buildFileAnnotations) > 0 || len(allFileAnnotations) > 0 {
+		if err := printFileAnnotations(
+			container,
+			append(buildFileAnnotations, bufanalysis.DeduplicateAndSortFileAnnotations(allFileAnnotations)...),
 			flags.ErrorFormat,
+			flags.MaxFailures,
 		); err != nil {
 			return err
 		}
@@ -269,33 +384,84 @@ func run(
 	return nil
 }
 
+// printFileAnnotations prints at most maxFailures of the FileAnnotations to stdout.
+func printFileAnnotations(
+	container app.StdioContainer,
+	fileAnnotations []bufanalysis.FileAnnotation,
+	errorFormat string,
+	maxFailures int,
+) error {
+	fileAnnotations, err := bufcli.LimitFileAnnotations(container, fileAnnotations, maxFailures)
+	if err != nil {
+		return err
+	}
+	return bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, errorFormat)
+}
+
 func breakingForImage(
 	ctx context.Context,
 	container appflag.Container,
+	runner command.Runner,
 	imageConfig bufwire.ImageConfig,
-	againstImageConfig bufwire.ImageConfig,
+	againstImage bufimage.Image,
 	excludeImports bool,
+	except []string,
 	errorFormat string,
 ) ([]bufanalysis.FileAnnotation, error) {
 	image := imageConfig.Image()
 	if excludeImports {
 		image = bufimage.ImageWithoutImports(image)
 	}
-	againstImage := againstImageConfig.Image()
 	if excludeImports {
 		againstImage = bufimage.ImageWithoutImports(againstImage)
 	}
-	return bufbreaking.NewHandler(container.Logger()).Check(
+	breakingConfig := imageConfig.Config().Breaking
+	if len(except) > 0 {
+		breakingConfig = exceptBreakingConfig(breakingConfig, except)
+	}
+	fileAnnotations, err := bufbreaking.NewHandler(
+		container.Logger(),
+		bufbreaking.HandlerWithPluginRunner(
+			bufcheckplugin.NewRunner(container.Logger(), runner, container),
+		),
+	).Check(
 		ctx,
-		imageConfig.Config().Breaking,
+		breakingConfig,
 		againstImage,
 		image,
 	)
+	if err != nil {
+		return nil, err
+	}
+	// Annotations for deleted files are only in the against image.
+	return bufowner.AnnotateFileAnnotations(
+		imageConfig.Config().Owners,
+		fileAnnotations,
+		image,
+		againstImage,
+	), nil
+}
+
+// exceptBreakingConfig returns a copy of the breaking config, and of its overrides,
+// with the rule and/or category IDs added to their except.
+func exceptBreakingConfig(breakingConfig *bufbreakingconfig.Config, except []string) *bufbreakingconfig.Config {
+	newConfig := *breakingConfig
+	newConfig.Except = append(append([]string(nil), breakingConfig.Except...), except...)
+	if len(breakingConfig.Overrides) > 0 {
+		newConfig.Overrides = make(map[string]*bufbreakingconfig.Config, len(breakingConfig.Overrides))
+		for rootPath, overrideConfig := range breakingConfig.Overrides {
+			newConfig.Overrides[rootPath] = exceptBreakingConfig(overrideConfig, except)
+		}
+	}
+	return &newConfig
 }
 
 func getExternalPathsForImages(imageConfigs []bufwire.ImageConfig, excludeImports bool) ([]string, error) {
 	externalPaths := make(map[string]struct{})
 	for _, imageConfig := range imageConfigs {
+		if imageConfig == nil {
+			continue
+		}
 		image := imageConfig.Image()
 		if excludeImports {
 			image = bufimage.ImageWithoutImports(image)
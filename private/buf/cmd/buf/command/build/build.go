@@ -16,12 +16,16 @@ package build
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/buf/buffetch"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/gen/data/datawkt"
 	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
@@ -42,6 +46,11 @@ const (
 	excludePathsFlagName        = "exclude-path"
 	disableSymlinksFlagName     = "disable-symlinks"
 	typeFlagName                = "type"
+	warningsFlagName            = "warnings"
+	debugImportsFlagName        = "debug-imports"
+	keepGoingFlagName           = "keep-going"
+	maxFailuresFlagName         = "max-failures"
+	parallelismFlagName         = "parallelism"
 )
 
 // NewCommand returns a new Command.
@@ -61,7 +70,8 @@ func NewCommand(
 			},
 			bufcli.NewErrorInterceptor(),
 		),
-		BindFlags: flags.Bind,
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
 	}
 }
 
@@ -76,6 +86,11 @@ type flags struct {
 	ExcludePaths        []string
 	DisableSymlinks     bool
 	Types               []string
+	Warnings            string
+	DebugImports        bool
+	KeepGoing           bool
+	MaxFailures         int
+	Parallelism         int
 	// special
 	InputHashtag string
 }
@@ -92,6 +107,10 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
 	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
 	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	bufcli.BindWarnings(flagSet, &f.Warnings, warningsFlagName)
+	bufcli.BindKeepGoing(flagSet, &f.KeepGoing, keepGoingFlagName)
+	bufcli.BindMaxFailures(flagSet, &f.MaxFailures, maxFailuresFlagName)
+	bufcli.BindParallelism(flagSet, &f.Parallelism, parallelismFlagName)
 	flagSet.StringVar(
 		&f.ErrorFormat,
 		errorFormatFlagName,
@@ -123,6 +142,12 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		nil,
 		"The types (package, message, enum, extension, service, method) that should be included in this image. When specified, the resulting image will only include descriptors to describe the requested types",
 	)
+	flagSet.BoolVar(
+		&f.DebugImports,
+		debugImportsFlagName,
+		false,
+		`Print to stderr, for every import statement, the file that satisfied it and the workspace module, module cache entry, or bundled well-known type it came from`,
+	)
 }
 
 func run(
@@ -136,6 +161,15 @@ func run(
 	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
 		return err
 	}
+	if err := bufcli.ValidateWarningsFlag(flags.Warnings, warningsFlagName); err != nil {
+		return err
+	}
+	if err := bufcli.ValidateMaxFailuresFlag(flags.MaxFailures, maxFailuresFlagName); err != nil {
+		return err
+	}
+	if err := bufcli.ValidateParallelismFlag(flags.Parallelism, parallelismFlagName); err != nil {
+		return err
+	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
 	if err != nil {
 		return err
@@ -151,10 +185,23 @@ func run(
 		flags.ExcludePaths, // we exclude these paths
 		false,
 		flags.ExcludeSourceInfo,
+		flags.Warnings,
+		flags.KeepGoing,
+		flags.MaxFailures,
+		flags.Parallelism,
 	)
 	if err != nil {
+		if errors.Is(err, bufcli.ErrNoChangedFiles) {
+			// There is nothing to build.
+			return nil
+		}
 		return err
 	}
+	if flags.DebugImports {
+		if err := printImportResolutions(container.Stderr(), image); err != nil {
+			return err
+		}
+	}
 	imageRef, err := buffetch.NewImageRefParser(container.Logger()).GetImageRef(ctx, flags.Output)
 	if err != nil {
 		return fmt.Errorf("--%s: %v", outputFlagName, err)
@@ -176,3 +223,46 @@ func run(
 		flags.ExcludeImports,
 	)
 }
+
+// printImportResolutions prints a line for every import statement in the image,
+// stating which file satisfied the import and where that file came from.
+//
+// Files are printed in image order, and imports in the order they are declared
+// within each file.
+func printImportResolutions(writer io.Writer, image bufimage.Image) error {
+	for _, imageFile := range image.Files() {
+		for _, dependency := range imageFile.Proto().GetDependency() {
+			dependencyImageFile := image.GetFile(dependency)
+			if dependencyImageFile == nil {
+				// this should never happen as images are self-contained, but just in case
+				return fmt.Errorf("import %q of %q is not present in the image", dependency, imageFile.Path())
+			}
+			if _, err := fmt.Fprintf(
+				writer,
+				"%s: import %q resolved to %s (%s)\n",
+				imageFile.ExternalPath(),
+				dependency,
+				dependencyImageFile.ExternalPath(),
+				importSourceString(dependencyImageFile),
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// importSourceString describes where the given file was read from.
+func importSourceString(imageFile bufimage.ImageFile) string {
+	moduleIdentity := imageFile.ModuleIdentity()
+	switch {
+	case moduleIdentity != nil && imageFile.Commit() != "":
+		return "module cache entry " + moduleIdentity.IdentityString() + ":" + imageFile.Commit()
+	case moduleIdentity != nil:
+		return "local module " + moduleIdentity.IdentityString()
+	case imageFile.ExternalPath() == imageFile.Path() && datawkt.Exists(imageFile.Path()):
+		return "well-known type bundled with buf"
+	default:
+		return "local directory"
+	}
+}
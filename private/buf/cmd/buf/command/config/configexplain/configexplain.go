@@ -0,0 +1,256 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configexplain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/encoding"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	configFlagName          = "config"
+	formatFlagName          = "format"
+	disableSymlinksFlagName = "disable-symlinks"
+
+	formatYAML = "yaml"
+	formatJSON = "json"
+)
+
+var allFormats = []string{
+	formatYAML,
+	formatJSON,
+}
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <directory>",
+		Short: "Print the effective configuration of a module",
+		Long: fmt.Sprintf(
+			"Prints the fully-resolved configuration of the %s in the directory. "+
+				"Defaults are applied, and the lint and breaking categories in use and except "+
+				"are expanded into the rules that are checked. "+
+				"Defaults to the current directory if not specified.",
+			bufconfig.ExternalConfigV1FilePath,
+		),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Config          string
+	Format          string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.Config,
+		configFlagName,
+		"",
+		`The file or data to use for configuration`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		formatYAML,
+		fmt.Sprintf(
+			"The format to print the configuration as. Must be one of %s",
+			stringutil.SliceToString(allFormats),
+		),
+	)
+}
+
+type externalConfig struct {
+	Version  string                 `json:"version,omitempty" yaml:"version,omitempty"`
+	Name     string                 `json:"name,omitempty" yaml:"name,omitempty"`
+	Deps     []string               `json:"deps,omitempty" yaml:"deps,omitempty"`
+	Build    externalBuildConfig    `json:"build,omitempty" yaml:"build,omitempty"`
+	Breaking externalBreakingConfig `json:"breaking,omitempty" yaml:"breaking,omitempty"`
+	Lint     externalLintConfig     `json:"lint,omitempty" yaml:"lint,omitempty"`
+}
+
+type externalBuildConfig struct {
+	// Roots is only set for v1beta1 configurations with roots other than ".".
+	Roots    map[string][]string `json:"roots,omitempty" yaml:"roots,omitempty"`
+	Excludes []string            `json:"excludes,omitempty" yaml:"excludes,omitempty"`
+}
+
+type externalBreakingConfig struct {
+	Use                    []string            `json:"use" yaml:"use"`
+	Ignore                 []string            `json:"ignore,omitempty" yaml:"ignore,omitempty"`
+	IgnoreOnly             map[string][]string `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty"`
+	IgnoreUnstablePackages bool                `json:"ignore_unstable_packages" yaml:"ignore_unstable_packages"`
+	AllowCommentIgnores    bool                `json:"allow_comment_ignores" yaml:"allow_comment_ignores"`
+}
+
+type externalLintConfig struct {
+	Use                                  []string            `json:"use" yaml:"use"`
+	Ignore                               []string            `json:"ignore,omitempty" yaml:"ignore,omitempty"`
+	IgnoreOnly                           map[string][]string `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty"`
+	EnumZeroValueSuffix                  string              `json:"enum_zero_value_suffix" yaml:"enum_zero_value_suffix"`
+	RPCAllowSameRequestResponse          bool                `json:"rpc_allow_same_request_response" yaml:"rpc_allow_same_request_response"`
+	RPCAllowGoogleProtobufEmptyRequests  bool                `json:"rpc_allow_google_protobuf_empty_requests" yaml:"rpc_allow_google_protobuf_empty_requests"`
+	RPCAllowGoogleProtobufEmptyResponses bool                `json:"rpc_allow_google_protobuf_empty_responses" yaml:"rpc_allow_google_protobuf_empty_responses"`
+	ServiceSuffix                        string              `json:"service_suffix" yaml:"service_suffix"`
+	AllowCommentIgnores                  bool                `json:"allow_comment_ignores" yaml:"allow_comment_ignores"`
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	dirPath := "."
+	if container.NumArgs() > 0 {
+		dirPath = container.Arg(0)
+	}
+	if flags.Format != formatYAML && flags.Format != formatJSON {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s: unknown format %q, must be one of %s",
+			formatFlagName,
+			flags.Format,
+			stringutil.SliceToString(allFormats),
+		)
+	}
+	readBucket, err := bufcli.NewStorageosProvider(flags.DisableSymlinks).NewReadWriteBucket(
+		dirPath,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return err
+	}
+	config, err := bufconfig.ReadConfigOS(
+		ctx,
+		readBucket,
+		bufconfig.ReadConfigOSWithOverride(flags.Config),
+	)
+	if err != nil {
+		return err
+	}
+	effectiveConfig, err := getEffectiveConfig(config)
+	if err != nil {
+		return err
+	}
+	var data []byte
+	switch flags.Format {
+	case formatYAML:
+		data, err = encoding.MarshalYAML(effectiveConfig)
+	case formatJSON:
+		data, err = json.MarshalIndent(effectiveConfig, "", "  ")
+		data = append(data, '\n')
+	}
+	if err != nil {
+		return err
+	}
+	_, err = container.Stdout().Write(data)
+	return err
+}
+
+func getEffectiveConfig(config *bufconfig.Config) (*externalConfig, error) {
+	effectiveConfig := &externalConfig{
+		Version: config.Version,
+	}
+	if config.ModuleIdentity != nil {
+		effectiveConfig.Name = config.ModuleIdentity.IdentityString()
+	}
+	if config.Build != nil {
+		for _, dependencyModuleReference := range config.Build.DependencyModuleReferences {
+			effectiveConfig.Deps = append(effectiveConfig.Deps, dependencyModuleReference.String())
+		}
+		if excludes, ok := config.Build.RootToExcludes["."]; ok && len(config.Build.RootToExcludes) == 1 {
+			effectiveConfig.Build.Excludes = excludes
+		} else if len(config.Build.RootToExcludes) > 0 {
+			effectiveConfig.Build.Roots = config.Build.RootToExcludes
+		}
+	}
+	if config.Breaking != nil {
+		breakingRules, err := bufbreaking.RulesForConfig(config.Breaking)
+		if err != nil {
+			return nil, fmt.Errorf("breaking: %w", err)
+		}
+		effectiveConfig.Breaking = externalBreakingConfig{
+			Use:                    getRuleIDs(breakingRules),
+			Ignore:                 config.Breaking.IgnoreRootPaths,
+			IgnoreOnly:             config.Breaking.IgnoreIDOrCategoryToRootPaths,
+			IgnoreUnstablePackages: config.Breaking.IgnoreUnstablePackages,
+			AllowCommentIgnores:    config.Breaking.AllowCommentIgnores,
+		}
+	}
+	if config.Lint != nil {
+		lintRules, err := buflint.RulesForConfig(config.Lint)
+		if err != nil {
+			return nil, fmt.Errorf("lint: %w", err)
+		}
+		effectiveConfig.Lint = externalLintConfig{
+			Use:                                  getRuleIDs(lintRules),
+			Ignore:                               config.Lint.IgnoreRootPaths,
+			IgnoreOnly:                           config.Lint.IgnoreIDOrCategoryToRootPaths,
+			EnumZeroValueSuffix:                  config.Lint.EnumZeroValueSuffix,
+			RPCAllowSameRequestResponse:          config.Lint.RPCAllowSameRequestResponse,
+			RPCAllowGoogleProtobufEmptyRequests:  config.Lint.RPCAllowGoogleProtobufEmptyRequests,
+			RPCAllowGoogleProtobufEmptyResponses: config.Lint.RPCAllowGoogleProtobufEmptyResponses,
+			ServiceSuffix:                        config.Lint.ServiceSuffix,
+			AllowCommentIgnores:                  config.Lint.AllowCommentIgnores,
+		}
+		if effectiveConfig.Lint.EnumZeroValueSuffix == "" {
+			effectiveConfig.Lint.EnumZeroValueSuffix = buflint.DefaultEnumZeroValueSuffix
+		}
+		if effectiveConfig.Lint.ServiceSuffix == "" {
+			effectiveConfig.Lint.ServiceSuffix = buflint.DefaultServiceSuffix
+		}
+	}
+	return effectiveConfig, nil
+}
+
+func getRuleIDs(rules []bufcheck.Rule) []string {
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID())
+	}
+	sort.Strings(ids)
+	return ids
+}
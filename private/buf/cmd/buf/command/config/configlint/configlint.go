@@ -0,0 +1,277 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configlint
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufgen"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	errorFormatFlagName     = "error-format"
+	disableSymlinksFlagName = "disable-symlinks"
+
+	unknownKeyType    = "UNKNOWN_KEY"
+	invalidConfigType = "INVALID_CONFIG"
+)
+
+var (
+	// unknownKeyRegexp matches the errors produced by strict YAML decoding for unknown keys.
+	unknownKeyRegexp = regexp.MustCompile(`^line (\d+): field (\S+) not found in type \S+$`)
+	// lineErrorRegexp matches all other YAML decoding errors that have a line number.
+	lineErrorRegexp = regexp.MustCompile(`line (\d+): (.+)$`)
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <directory>",
+		Short: "Validate configuration files",
+		Long: fmt.Sprintf(
+			"Validates the %s, %s, and %s files in the directory against their schemas. "+
+				"Unknown keys, invalid values, and unknown lint and breaking rules are reported. "+
+				"Defaults to the current directory if not specified.",
+			bufconfig.ExternalConfigV1FilePath,
+			bufwork.ExternalConfigV1FilePath,
+			bufgen.ExternalConfigFilePath,
+		),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	ErrorFormat     string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for errors printed to stdout. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	dirPath := "."
+	if container.NumArgs() > 0 {
+		dirPath = container.Arg(0)
+	}
+	readBucket, err := bufcli.NewStorageosProvider(flags.DisableSymlinks).NewReadWriteBucket(
+		dirPath,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return err
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	configFilePath, err := bufconfig.ExistingConfigFilePath(ctx, readBucket)
+	if err != nil {
+		return err
+	}
+	if configFilePath != "" {
+		configFileAnnotations, err := lintConfig(ctx, readBucket, dirPath, configFilePath)
+		if err != nil {
+			return err
+		}
+		fileAnnotations = append(fileAnnotations, configFileAnnotations...)
+	}
+	workspaceConfigFilePath, err := bufwork.ExistingConfigFilePath(ctx, readBucket)
+	if err != nil {
+		return err
+	}
+	if workspaceConfigFilePath != "" {
+		if _, err := bufwork.GetConfigForBucket(ctx, readBucket, "."); err != nil {
+			fileAnnotations = append(fileAnnotations, newFileAnnotationsForError(dirPath, workspaceConfigFilePath, err)...)
+		}
+	}
+	genTemplateExists, err := storage.Exists(ctx, readBucket, bufgen.ExternalConfigFilePath)
+	if err != nil {
+		return err
+	}
+	if genTemplateExists {
+		if _, err := bufgen.ReadConfig(
+			ctx,
+			container.Logger(),
+			bufgen.NewProvider(container.Logger()),
+			readBucket,
+		); err != nil {
+			fileAnnotations = append(fileAnnotations, newFileAnnotationsForError(dirPath, bufgen.ExternalConfigFilePath, err)...)
+		}
+	}
+	if configFilePath == "" && workspaceConfigFilePath == "" && !genTemplateExists {
+		return fmt.Errorf(
+			"no %s, %s, or %s found in directory %q",
+			bufconfig.ExternalConfigV1FilePath,
+			bufwork.ExternalConfigV1FilePath,
+			bufgen.ExternalConfigFilePath,
+			dirPath,
+		)
+	}
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(
+			container.Stdout(),
+			bufanalysis.DeduplicateAndSortFileAnnotations(fileAnnotations),
+			flags.ErrorFormat,
+		); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	return nil
+}
+
+// lintConfig validates the buf.yaml, including that all of the lint and breaking
+// rules and categories are known.
+func lintConfig(
+	ctx context.Context,
+	readBucket storage.ReadBucket,
+	dirPath string,
+	configFilePath string,
+) ([]bufanalysis.FileAnnotation, error) {
+	config, err := bufconfig.ReadConfigOS(ctx, readBucket)
+	if err != nil {
+		return newFileAnnotationsForError(dirPath, configFilePath, err), nil
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	if _, err := buflint.RulesForConfig(config.Lint); err != nil {
+		fileAnnotations = append(fileAnnotations, newFileAnnotationsForError(dirPath, configFilePath, fmt.Errorf("lint: %w", err))...)
+	}
+	if _, err := bufbreaking.RulesForConfig(config.Breaking); err != nil {
+		fileAnnotations = append(fileAnnotations, newFileAnnotationsForError(dirPath, configFilePath, fmt.Errorf("breaking: %w", err))...)
+	}
+	return fileAnnotations, nil
+}
+
+// newFileAnnotationsForError converts the error returned when reading a configuration
+// file into FileAnnotations, using the line numbers from YAML decoding errors if present.
+func newFileAnnotationsForError(dirPath string, configFilePath string, err error) []bufanalysis.FileAnnotation {
+	fileInfo := &configFileInfo{
+		path:         configFilePath,
+		externalPath: filepath.Join(dirPath, configFilePath),
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, line := range strings.Split(err.Error(), "\n") {
+		line = strings.TrimSpace(line)
+		if matches := unknownKeyRegexp.FindStringSubmatch(line); len(matches) == 3 {
+			lineNumber, _ := strconv.Atoi(matches[1])
+			fileAnnotations = append(
+				fileAnnotations,
+				bufanalysis.NewFileAnnotation(
+					fileInfo,
+					lineNumber,
+					0,
+					lineNumber,
+					0,
+					unknownKeyType,
+					fmt.Sprintf("Unknown key %q.", matches[2]),
+				),
+			)
+			continue
+		}
+		if matches := lineErrorRegexp.FindStringSubmatch(line); len(matches) == 3 {
+			lineNumber, _ := strconv.Atoi(matches[1])
+			fileAnnotations = append(
+				fileAnnotations,
+				bufanalysis.NewFileAnnotation(
+					fileInfo,
+					lineNumber,
+					0,
+					lineNumber,
+					0,
+					invalidConfigType,
+					matches[2],
+				),
+			)
+		}
+	}
+	if len(fileAnnotations) == 0 {
+		fileAnnotations = append(
+			fileAnnotations,
+			bufanalysis.NewFileAnnotation(
+				fileInfo,
+				0,
+				0,
+				0,
+				0,
+				invalidConfigType,
+				err.Error(),
+			),
+		)
+	}
+	return fileAnnotations
+}
+
+// configFileInfo is the bufanalysis.FileInfo of a configuration file.
+//
+// bufmoduleref.FileInfo cannot be used as it only accepts .proto files.
+type configFileInfo struct {
+	path         string
+	externalPath string
+}
+
+func (c *configFileInfo) Path() string {
+	return c.path
+}
+
+func (c *configFileInfo) ExternalPath() string {
+	return c.externalPath
+}
@@ -0,0 +1,80 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configmigrate
+
+import (
+	"context"
+
+	"github.com/bufbuild/buf/private/buf/bufmigrate"
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <directory>",
+		Short: `Migrate configuration files to the latest version`,
+		Long: `Migrate the buf.yaml, buf.work.yaml, buf.gen.yaml, and buf.lock files in the directory to the latest version.
+Files are rewritten in place, and comments are preserved where possible. The semantic differences
+between the old and new configuration, such as lint and breaking rules that are now checked differently,
+are printed to stdout.
+Defaults to the current directory if not specified.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct{}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	dirPath := "."
+	if container.NumArgs() > 0 {
+		dirPath = container.Arg(0)
+	}
+	return bufmigrate.NewLatestMigrator(
+		"buf config migrate",
+		bufmigrate.LatestMigratorWithNotifier(newWriteMessageFunc(container)),
+	).Migrate(dirPath)
+}
+
+func newWriteMessageFunc(container app.StdoutContainer) func(string) error {
+	return func(message string) error {
+		_, err := container.Stdout().Write([]byte(message))
+		return err
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufapp"
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/app/appname"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const credentialHelperKey = "credential-helper"
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <key> <value>",
+		Short: "Set a value in the user configuration of buf",
+		Long: `Set a value in the config.yaml file in the buf configuration directory, such as ~/.config/buf.
+An empty value removes the key from the configuration.
+
+The supported keys are:
+
+credential-helper
+  The command line of the credential helper that provides the tokens of remotes, such as
+  "docker-credential-osxkeychain". The helper follows the protocol of Docker credential helpers:
+  it is run with the additional argument "get" and the remote address on stdin, and prints a JSON
+  object with the token in the "Secret" key to stdout. Tokens in the BUF_TOKEN environment
+  variable take precedence over the credential helper, and the credential helper takes
+  precedence over the .netrc file.`,
+		Args: cobra.ExactArgs(2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct{}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	key, value := container.Arg(0), container.Arg(1)
+	externalConfig := bufapp.ExternalConfig{}
+	if err := appname.ReadConfig(container, &externalConfig); err != nil {
+		return err
+	}
+	switch key {
+	case credentialHelperKey:
+		externalConfig.CredentialHelper = value
+	default:
+		return appcmd.NewInvalidArgumentErrorf("unknown key %q, must be %q", key, credentialHelperKey)
+	}
+	if externalConfig.Version == "" {
+		externalConfig.Version = "v1"
+	}
+	// Validate the configuration before writing it, so that buf keeps working
+	// if a value is invalid.
+	if _, err := bufapp.NewConfig(container, externalConfig); err != nil {
+		return err
+	}
+	if err := appname.WriteConfig(container, &externalConfig); err != nil {
+		return fmt.Errorf("could not write buf configuration: %w", err)
+	}
+	return nil
+}
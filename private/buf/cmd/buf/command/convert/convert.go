@@ -15,20 +15,27 @@
 package convert
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/buf/bufconvert"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
 	"github.com/bufbuild/buf/private/gen/data/datawkt"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/jsonpath"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -36,6 +43,8 @@ const (
 	typeFlagName        = "type"
 	fromFlagName        = "from"
 	outputFlagName      = "to"
+	jsonPathFlagName    = "jsonpath"
+	fieldMaskFlagName   = "field-mask"
 )
 
 // NewCommand returns a new Command.
@@ -77,6 +86,19 @@ Redirect from stdin to <input>:
 Use a module on the bsr:
 
     $ buf convert <buf.build/owner/repository> --type buf.Foo --from=payload.json
+
+Only keep some fields of the message with a field mask:
+
+    $ buf convert example.proto --type buf.Foo --from=payload.bin --to=out.bin --field-mask=one,items.name
+
+Print the values at a path in the JSON representation of the message, one per line:
+
+    $ buf convert example.proto --type buf.Foo --from=payload.bin --jsonpath='$.items[*].detail.name'
+
+Paths select object keys with .name or ["name"], array elements with [n], where a negative
+n counts from the end, and all elements or values with [*] or .*. The fields of messages
+packed in a google.protobuf.Any are selected directly on the Any, next to its "@type" key.
+Strings are printed as is, and all other values are printed as compact JSON.
 `,
 		Args: cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
@@ -94,6 +116,8 @@ type flags struct {
 	Type        string
 	From        string
 	To          string
+	JSONPath    string
+	FieldMask   []string
 
 	// special
 	InputHashtag string
@@ -138,6 +162,18 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 			bufconvert.MessageEncodingFormatsString,
 		),
 	)
+	flagSet.StringVar(
+		&f.JSONPath,
+		jsonPathFlagName,
+		"",
+		`Print the values at this path in the JSON representation of the converted message instead of the message itself, one per line`,
+	)
+	flagSet.StringSliceVar(
+		&f.FieldMask,
+		fieldMaskFlagName,
+		nil,
+		`Only keep the fields of the converted message selected by these comma-separated field mask paths, such as "name,items.id". Paths that go through repeated fields apply to every element, and paths that go through a google.protobuf.Any apply to the packed message`,
+	)
 }
 
 func run(
@@ -148,6 +184,14 @@ func run(
 	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
 		return err
 	}
+	var jsonPath jsonpath.Path
+	if flags.JSONPath != "" {
+		var err error
+		jsonPath, err = jsonpath.Parse(flags.JSONPath)
+		if err != nil {
+			return appcmd.NewInvalidArgumentErrorf("--%s: %v", jsonPathFlagName, err)
+		}
+	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
 	if err != nil {
 		return err
@@ -163,6 +207,10 @@ func run(
 		nil,   // externalExcludeDirOrFilePaths
 		false, // externalDirOrFilePathsAllowNotExist
 		false, // excludeSourceCodeInfo
+		"",    // warnings
+		false, // keepGoing
+		0,     // maxFailures
+		1,     // parallelism
 	)
 	var resolveWellKnownType bool
 	// only resolve wkts if input was not set.
@@ -205,14 +253,33 @@ func run(
 	if err != nil {
 		return err
 	}
+	if len(flags.FieldMask) > 0 {
+		resolver, err := protoencoding.NewResolver(bufimage.ImageToFileDescriptors(image)...)
+		if err != nil {
+			return err
+		}
+		if err := bufconvert.ApplyFieldMask(message, flags.FieldMask, resolver); err != nil {
+			return fmt.Errorf("--%s: %w", fieldMaskFlagName, err)
+		}
+	}
 	defaultToEncoding, err := inverseEncoding(fromMessageRef.MessageEncoding())
 	if err != nil {
 		return err
 	}
+	if jsonPath != nil {
+		// The path is always evaluated on the JSON representation.
+		defaultToEncoding = bufconvert.MessageEncodingJSON
+	}
 	outputMessageRef, err := bufconvert.NewMessageEncodingRef(ctx, flags.To, defaultToEncoding)
 	if err != nil {
 		return fmt.Errorf("--%s: %v", outputFlagName, err)
 	}
+	if jsonPath != nil {
+		if outputMessageRef.MessageEncoding() != bufconvert.MessageEncodingJSON {
+			return appcmd.NewInvalidArgumentErrorf("--%s can only be used with JSON output", jsonPathFlagName)
+		}
+		return writeJSONPathValues(container, image, message, jsonPath, outputMessageRef.Path())
+	}
 	return bufcli.NewWireProtoEncodingWriter(
 		container.Logger(),
 	).PutMessage(
@@ -224,6 +291,55 @@ func run(
 	)
 }
 
+// writeJSONPathValues writes the values at the path in the JSON representation
+// of the message to the output path, one per line.
+func writeJSONPathValues(
+	container appflag.Container,
+	image bufimage.Image,
+	message proto.Message,
+	jsonPath jsonpath.Path,
+	outputPath string,
+) (retErr error) {
+	resolver, err := protoencoding.NewResolver(bufimage.ImageToFileDescriptors(image)...)
+	if err != nil {
+		return err
+	}
+	data, err := protoencoding.NewJSONMarshaler(resolver).Marshal(message)
+	if err != nil {
+		return err
+	}
+	value, err := jsonpath.Decode(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	values := jsonPath.Select(value)
+	if len(values) == 0 {
+		return fmt.Errorf("--%s: no values found at %q", jsonPathFlagName, jsonPath.String())
+	}
+	var buffer bytes.Buffer
+	for _, value := range values {
+		formatted, err := jsonpath.Format(value)
+		if err != nil {
+			return err
+		}
+		buffer.WriteString(formatted)
+		buffer.WriteString("\n")
+	}
+	writer := container.Stdout()
+	if outputPath != "-" {
+		file, err := os.Create(outputPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			retErr = multierr.Append(retErr, file.Close())
+		}()
+		writer = file
+	}
+	_, err = writer.Write(buffer.Bytes())
+	return err
+}
+
 // inverseEncoding returns the opposite encoding of the provided encoding,
 // which will be the default output encoding for a given payload encoding.
 func inverseEncoding(encoding bufconvert.MessageEncoding) (bufconvert.MessageEncoding, error) {
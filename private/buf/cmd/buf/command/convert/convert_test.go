@@ -191,3 +191,59 @@ func TestConvertDir(t *testing.T) {
 		)
 	})
 }
+
+func TestConvertJSONPathAndFieldMask(t *testing.T) {
+	cmd := func(use string) *appcmd.Command { return NewCommand("convert", appflag.NewBuilder("convert")) }
+	run := func(t *testing.T, expectedExitCode int, expectedStdout string, args ...string) {
+		appcmdtesting.RunCommandExitCodeStdout(
+			t,
+			cmd,
+			expectedExitCode,
+			expectedStdout,
+			nil,
+			nil,
+			append(
+				[]string{
+					"testdata/convert/jsonpath",
+					"--type",
+					"jsonpath.v1.Store",
+					"--from",
+					"testdata/convert/jsonpath/payload.json",
+				},
+				args...,
+			)...,
+		)
+	}
+	t.Run("jsonpath-repeated", func(t *testing.T) {
+		run(t, 0, "1\n2", "--jsonpath", "$.items[*].id")
+	})
+	t.Run("jsonpath-index", func(t *testing.T) {
+		run(t, 0, `{"id":"2","price":"20","tags":["dog"]}`, "--jsonpath", "$.items[-1]")
+	})
+	t.Run("jsonpath-any", func(t *testing.T) {
+		run(t, 0, "alice", "--jsonpath", "detail.owner")
+	})
+	t.Run("jsonpath-not-found", func(t *testing.T) {
+		run(t, 1, "", "--jsonpath", "detail.unknown")
+	})
+	t.Run("jsonpath-bin-output", func(t *testing.T) {
+		run(t, 1, "", "--jsonpath", "name", "--to", "-#format=bin")
+	})
+	t.Run("field-mask", func(t *testing.T) {
+		run(
+			t,
+			0,
+			`{"items":[{"id":"1"},{"id":"2"}],"detail":{"@type":"type.googleapis.com/jsonpath.v1.Detail","region":"us"}}`,
+			"--field-mask",
+			"items.id,detail.region",
+			"--to",
+			"-#format=json",
+		)
+	})
+	t.Run("field-mask-and-jsonpath", func(t *testing.T) {
+		run(t, 0, `{"name":"pets"}`, "--field-mask", "name", "--jsonpath", "$")
+	})
+	t.Run("field-mask-unknown-field", func(t *testing.T) {
+		run(t, 1, "", "--field-mask", "detail.unknown", "--to", "-#format=json")
+	})
+}
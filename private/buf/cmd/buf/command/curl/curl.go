@@ -49,6 +49,7 @@ import (
 	"github.com/spf13/pflag"
 	"go.uber.org/multierr"
 	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const (
@@ -62,6 +63,7 @@ const (
 
 	// Protocol/transport flags
 	protocolFlagName            = "protocol"
+	getFlagName                 = "get"
 	unixSocketFlagName          = "unix-socket"
 	http2PriorKnowledgeFlagName = "http2-prior-knowledge"
 
@@ -93,6 +95,14 @@ const (
 	dataFlagShortName      = "d"
 	outputFlagName         = "output"
 	outputFlagShortName    = "o"
+
+	// Expectation flags
+	expectCodeFlagName         = "expect-code"
+	expectBodyJSONPathFlagName = "expect-body-jsonpath"
+	maxLatencyFlagName         = "max-latency"
+
+	// protocolAuto is the value of the protocol flag that negotiates the protocol.
+	protocolAuto = "auto"
 )
 
 // NewCommand returns a new Command.
@@ -118,8 +128,13 @@ The URL can use either http or https as the scheme. If http is used then HTTP 1.
 unless the --http2-prior-knowledge flag is set. If https is used then HTTP/2 will be preferred
 during protocol negotiation and HTTP 1.1 used only if the server does not support HTTP/2.
 
-The default RPC protocol used will be Connect. To use a different protocol (gRPC or gRPC-Web),
-use the --protocol flag. Note that the gRPC protocol cannot be used with HTTP 1.1.
+By default, the RPC protocol is negotiated: the Connect protocol is tried first, and if the server
+responds that it does not support it, the gRPC protocol is tried (if HTTP/2 can be used), and then
+the gRPC-Web protocol. To use a specific protocol (Connect, gRPC, or gRPC-Web), use the --protocol
+flag. Note that the gRPC protocol cannot be used with HTTP 1.1.
+
+Unary methods with the NO_SIDE_EFFECTS idempotency level can be invoked with a Connect GET
+request by setting the --get flag, which places the request message in the query of the URL.
 
 The input request is specified via the -d or --data flag. If absent, an empty request is sent. If
 the flag value starts with an at-sign (@), then the rest of the flag value is interpreted as a
@@ -177,9 +192,19 @@ protocol relies on bidirectional streaming. If server reflection is used, the as
 reflection service is the same as the given URL, but with the last two elements removed and
 replaced with the service and method name for server reflection.
 
+The result of the RPC can be checked with the --expect-code, --expect-body-jsonpath, and
+--max-latency flags, which turn this command into a smoke test, for example for a health check
+in CI:
+
+    $ buf curl --data '{"sentence": "Hi"}' --expect-code ok            \
+         --expect-body-jsonpath 'sentence!=""' --max-latency 500ms  \
+         https://demo.connect.build/buf.connect.demo.eliza.v1.ElizaService/Say
+
 If an error occurs that is due to incorrect usage or other unexpected error, this program will
-return an exit code that is less than 8. If the RPC fails otherwise, this program will return an
-exit code that is the gRPC code, shifted three bits to the left.
+return an exit code that is less than 8. If an expectation set with the --expect-code,
+--expect-body-jsonpath, or --max-latency flags does not hold, this program will return an exit
+code of 2. If the RPC fails otherwise, this program will return an exit code that is the gRPC
+code, shifted three bits to the left.
 `,
 		Args: checkPositionalArgs,
 		Run: builder.NewRunFunc(
@@ -203,6 +228,7 @@ type flags struct {
 
 	// Protocol details
 	Protocol            string
+	Get                 bool
 	UnixSocket          string
 	HTTP2PriorKnowledge bool
 
@@ -225,6 +251,11 @@ type flags struct {
 	Data      string
 	Output    string
 
+	// Expectations on the result
+	ExpectCode         string
+	ExpectBodyJSONPath []string
+	MaxLatency         time.Duration
+
 	// so we can inquire about which flags present on command-line
 	// TODO: ideally we'd use cobra directly instead of having the appcmd wrapper,
 	//  which prevents a lot of basic functionality by not exposing many cobra features
@@ -292,8 +323,21 @@ respectively`,
 	flagSet.StringVar(
 		&f.Protocol,
 		protocolFlagName,
-		connect.ProtocolConnect,
-		`The RPC protocol to use. This can be one of "grpc", "grpcweb", or "connect"`,
+		protocolAuto,
+		`The RPC protocol to use. This can be one of "auto", "grpc", "grpcweb", or "connect".
+With "auto", the Connect protocol is used unless the server does not support it, in which
+case the gRPC and then the gRPC-Web protocols are used`,
+	)
+	flagSet.BoolVar(
+		&f.Get,
+		getFlagName,
+		false,
+		fmt.Sprintf(
+			`Invoke the RPC with a Connect GET request. This can only be used for unary methods
+with the NO_SIDE_EFFECTS idempotency level, and with the Connect protocol or --%s=%s`,
+			protocolFlagName,
+			protocolAuto,
+		),
 	)
 	flagSet.StringVar(
 		&f.UnixSocket,
@@ -468,6 +512,30 @@ provided via stdin as a file descriptor set or image`,
 		"",
 		`Path to output file to create with response data. If absent, response is printed to stdout`,
 	)
+
+	flagSet.StringVar(
+		&f.ExpectCode,
+		expectCodeFlagName,
+		"",
+		`The expected code of the RPC, such as "ok" or "not_found". If the RPC fails with the
+expected code, this command succeeds`,
+	)
+	flagSet.StringArrayVar(
+		&f.ExpectBodyJSONPath,
+		expectBodyJSONPathFlagName,
+		nil,
+		`An expectation on the JSON of each response message, of the form "path==value" or
+"path!=value", such as 'user.id==1'. The path is a sequence of JSON field names separated
+by dots, each optionally followed by list indexes in brackets, such as "users[0].name". The
+value is a JSON value, or a string if it is not valid JSON. Fields with default values can
+be compared. This flag may be specified more than once`,
+	)
+	flagSet.DurationVar(
+		&f.MaxLatency,
+		maxLatencyFlagName,
+		0,
+		`The maximum duration of the RPC, such as "500ms". If the RPC takes longer, this command fails`,
+	)
 }
 
 func (f *flags) validate(isSecure bool) error {
@@ -526,11 +594,28 @@ func (f *flags) validate(isSecure bool) error {
 	}
 
 	switch f.Protocol {
-	case connect.ProtocolConnect, connect.ProtocolGRPC, connect.ProtocolGRPCWeb:
+	case protocolAuto, connect.ProtocolConnect, connect.ProtocolGRPC, connect.ProtocolGRPCWeb:
 	default:
 		return fmt.Errorf(
-			"--%s value must be one of %q, %q, or %q",
-			protocolFlagName, connect.ProtocolConnect, connect.ProtocolGRPC, connect.ProtocolGRPCWeb)
+			"--%s value must be one of %q, %q, %q, or %q",
+			protocolFlagName, protocolAuto, connect.ProtocolConnect, connect.ProtocolGRPC, connect.ProtocolGRPCWeb)
+	}
+	if f.Get && f.Protocol != protocolAuto && f.Protocol != connect.ProtocolConnect {
+		return fmt.Errorf("--%s can only be used with --%s=%s or --%s=%s", getFlagName, protocolFlagName, connect.ProtocolConnect, protocolFlagName, protocolAuto)
+	}
+
+	if f.ExpectCode != "" {
+		if _, err := bufcurl.ParseCode(f.ExpectCode); err != nil {
+			return fmt.Errorf("--%s: %w", expectCodeFlagName, err)
+		}
+	}
+	for _, expectBodyJSONPath := range f.ExpectBodyJSONPath {
+		if _, err := bufcurl.ParseBodyExpectation(expectBodyJSONPath); err != nil {
+			return fmt.Errorf("--%s: %w", expectBodyJSONPathFlagName, err)
+		}
+	}
+	if f.MaxLatency < 0 {
+		return fmt.Errorf("--%s value must be positive", maxLatencyFlagName)
 	}
 
 	if f.NoKeepAlive && f.flagSet.Changed(keepAliveFlagName) {
@@ -772,20 +857,38 @@ func run(ctx context.Context, container appflag.Container, f *flags) (err error)
 		return err
 	}
 
-	var clientOptions []connect.ClientOption
-	switch f.Protocol {
-	case connect.ProtocolGRPC:
-		clientOptions = []connect.ClientOption{connect.WithGRPC()}
-	case connect.ProtocolGRPCWeb:
-		clientOptions = []connect.ClientOption{connect.WithGRPCWeb()}
+	protocols := []string{f.Protocol}
+	if f.Protocol == protocolAuto {
+		protocols = []string{connect.ProtocolConnect}
+		if isSecure || f.HTTP2PriorKnowledge {
+			protocols = append(protocols, connect.ProtocolGRPC)
+		}
+		protocols = append(protocols, connect.ProtocolGRPCWeb)
 	}
-	if f.Protocol != connect.ProtocolGRPC {
-		// The transport will log trailers to the verbose printer. But if
-		// we're not using standard grpc protocol, trailers are actually encoded
-		// in an end-of-stream message for streaming calls. So this interceptor
-		// will print the trailers for streaming calls when the response stream
-		// is drained.
-		clientOptions = append(clientOptions, connect.WithInterceptors(bufcurl.TraceTrailersInterceptor(container.VerbosePrinter())))
+	protocolOptions := func(protocol string) []connect.ClientOption {
+		var clientOptions []connect.ClientOption
+		switch protocol {
+		case connect.ProtocolGRPC:
+			clientOptions = []connect.ClientOption{connect.WithGRPC()}
+		case connect.ProtocolGRPCWeb:
+			clientOptions = []connect.ClientOption{connect.WithGRPCWeb()}
+		case connect.ProtocolConnect:
+			if f.Get {
+				clientOptions = []connect.ClientOption{
+					connect.WithHTTPGet(),
+					connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+				}
+			}
+		}
+		if protocol != connect.ProtocolGRPC {
+			// The transport will log trailers to the verbose printer. But if
+			// we're not using standard grpc protocol, trailers are actually encoded
+			// in an end-of-stream message for streaming calls. So this interceptor
+			// will print the trailers for streaming calls when the response stream
+			// is drained.
+			clientOptions = append(clientOptions, connect.WithInterceptors(bufcurl.TraceTrailersInterceptor(container.VerbosePrinter())))
+		}
+		return clientOptions
 	}
 
 	dataSource := "(argument)"
@@ -880,8 +983,14 @@ func run(ctx context.Context, container appflag.Container, f *flags) (err error)
 		if err != nil {
 			return err
 		}
+		// Server reflection requires HTTP/2, so the gRPC protocol, which is supported
+		// by the most servers, is used when the protocol is negotiated.
+		reflectClientOptions := protocolOptions(connect.ProtocolGRPC)
+		if f.Protocol != protocolAuto {
+			reflectClientOptions = protocolOptions(f.Protocol)
+		}
 		var closeRes func()
-		res, closeRes = bufcurl.NewServerReflectionResolver(ctx, transport, clientOptions, baseURL, reflectProtocol, reflectHeaders, container.VerbosePrinter())
+		res, closeRes = bufcurl.NewServerReflectionResolver(ctx, transport, reflectClientOptions, baseURL, reflectProtocol, reflectHeaders, container.VerbosePrinter())
 		defer closeRes()
 	} else {
 		ref, err := buffetch.NewRefParser(container.Logger()).GetRef(ctx, f.Schema)
@@ -941,10 +1050,74 @@ func run(ctx context.Context, container appflag.Container, f *flags) (err error)
 	if err != nil {
 		return err
 	}
+	if f.Get {
+		if methodDescriptor.IsStreamingClient() || methodDescriptor.IsStreamingServer() {
+			return fmt.Errorf("--%s cannot be used with method %s as it is not a unary method", getFlagName, methodDescriptor.FullName())
+		}
+		methodOptions, _ := methodDescriptor.Options().(*descriptorpb.MethodOptions)
+		if methodOptions.GetIdempotencyLevel() != descriptorpb.MethodOptions_NO_SIDE_EFFECTS {
+			return fmt.Errorf("--%s cannot be used with method %s as it does not have the NO_SIDE_EFFECTS idempotency level", getFlagName, methodDescriptor.FullName())
+		}
+	}
+
+	var invokerOptions []bufcurl.InvokerOption
+	for _, expectBodyJSONPath := range f.ExpectBodyJSONPath {
+		bodyExpectation, err := bufcurl.ParseBodyExpectation(expectBodyJSONPath)
+		if err != nil {
+			return err
+		}
+		invokerOptions = append(invokerOptions, bufcurl.InvokerWithBodyExpectations(bodyExpectation))
+	}
 
 	// Now we can finally issue the RPC
-	invoker := bufcurl.NewInvoker(container, methodDescriptor, res, transport, clientOptions, container.Arg(0), output)
-	return invoker.Invoke(ctx, dataSource, dataReader, requestHeaders)
+	var invoker bufcurl.Invoker
+	if len(protocols) == 1 {
+		invoker = bufcurl.NewInvoker(container, methodDescriptor, res, transport, protocolOptions(protocols[0]), container.Arg(0), output, invokerOptions...)
+	} else {
+		invoker = bufcurl.NewProtocolNegotiatingInvoker(container, methodDescriptor, res, transport, protocols, protocolOptions, container.Arg(0), output, invokerOptions...)
+	}
+	start := time.Now()
+	err = invoker.Invoke(ctx, dataSource, dataReader, requestHeaders)
+	return f.checkExpectations(err, time.Since(start))
+}
+
+// checkExpectations checks the expected code and latency of the RPC, given the
+// error returned by the invocation and its duration.
+func (f *flags) checkExpectations(invokeErr error, latency time.Duration) error {
+	if f.ExpectCode != "" {
+		expectedCode, err := bufcurl.ParseCode(f.ExpectCode)
+		if err != nil {
+			return err
+		}
+		var code connect.Code
+		if invokeErr != nil {
+			var ok bool
+			if code, ok = bufcurl.ErrorCode(invokeErr); !ok {
+				return invokeErr
+			}
+		}
+		if code != expectedCode {
+			return app.NewErrorf(
+				bufcurl.ExitCodeExpectationFailed,
+				"expected code %s, got %s",
+				bufcurl.CodeString(expectedCode),
+				bufcurl.CodeString(code),
+			)
+		}
+		invokeErr = nil
+	}
+	if invokeErr != nil {
+		return invokeErr
+	}
+	if f.MaxLatency > 0 && latency > f.MaxLatency {
+		return app.NewErrorf(
+			bufcurl.ExitCodeExpectationFailed,
+			"expected a latency of at most %v, got %v",
+			f.MaxLatency,
+			latency.Round(time.Millisecond),
+		)
+	}
+	return nil
 }
 
 func makeHTTPClient(f *flags, isSecure bool, authority string, printer verbose.Printer) (connect.HTTPClient, error) {
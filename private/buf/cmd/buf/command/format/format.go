@@ -28,6 +28,7 @@ import (
 	"github.com/bufbuild/buf/private/buf/bufformat"
 	"github.com/bufbuild/buf/private/buf/bufwork"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
@@ -160,6 +161,28 @@ Write a diff and rewrite the file(s) in-place:
     ...
 
 The -w and -o flags cannot be used together in a single invocation.
+
+Comments and blank lines can optionally be formatted with the format section of
+the buf.yaml of the module. Comments are left as-is by default.
+
+    version: v1
+    format:
+      # Either "canonical" or "preserve". With "canonical", consecutive blank
+      # lines are collapsed into a single blank line, and the imports and file
+      # options are each sorted as a single group. With "preserve", blank lines
+      # are preserved as written, and the imports and file options are sorted
+      # within the groups separated by blank lines. Defaults to "canonical".
+      blank_lines: preserve
+      comments:
+        # Reflow the paragraphs of line comments that exceed the column limit.
+        reflow: true
+        # The column limit to reflow comments to. Defaults to 100.
+        column_limit: 80
+        # Normalize comments to either line comments ("line") or block
+        # comments ("block").
+        style: line
+        # Align the trailing comments of consecutive lines.
+        align_trailing: true
 `,
 		Args: cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
@@ -168,7 +191,8 @@ The -w and -o flags cannot be used together in a single invocation.
 			},
 			bufcli.NewErrorInterceptor(),
 		),
-		BindFlags: flags.Bind,
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
 	}
 }
 
@@ -403,12 +427,17 @@ func run(
 		if err != nil {
 			return err
 		}
+		formatOptions, err := formatOptionsForConfig(moduleConfigs[0].Config())
+		if err != nil {
+			return err
+		}
 		diffPresent, err := formatModule(
 			ctx,
 			container,
 			runner,
 			storageosProvider,
 			module,
+			formatOptions,
 			outputDirectory,
 			singleFileOutputFilename,
 			flags.ErrorFormat,
@@ -424,12 +453,17 @@ func run(
 		return nil
 	}
 	for _, moduleConfig := range moduleConfigs {
+		formatOptions, err := formatOptionsForConfig(moduleConfig.Config())
+		if err != nil {
+			return err
+		}
 		diffPresent, err := formatModule(
 			ctx,
 			container,
 			runner,
 			storageosProvider,
 			moduleConfig.Module(),
+			formatOptions,
 			outputDirectory,
 			singleFileOutputFilename,
 			flags.ErrorFormat,
@@ -446,6 +480,33 @@ func run(
 	return nil
 }
 
+// formatOptionsForConfig returns the bufformat.FormatOptions for the format
+// section of the given module config.
+func formatOptionsForConfig(config *bufconfig.Config) ([]bufformat.FormatOption, error) {
+	if config == nil || config.Format == nil {
+		return nil, nil
+	}
+	var formatOptions []bufformat.FormatOption
+	if config.Format.CommentColumnLimit > 0 {
+		formatOptions = append(
+			formatOptions,
+			bufformat.FormatWithCommentColumnLimit(config.Format.CommentColumnLimit),
+		)
+	}
+	commentStyle, err := bufformat.ParseCommentStyle(config.Format.CommentStyle)
+	if err != nil {
+		return nil, err
+	}
+	formatOptions = append(formatOptions, bufformat.FormatWithCommentStyle(commentStyle))
+	if config.Format.AlignTrailingComments {
+		formatOptions = append(formatOptions, bufformat.FormatWithAlignedTrailingComments())
+	}
+	if config.Format.PreserveBlankLines {
+		formatOptions = append(formatOptions, bufformat.FormatWithPreservedBlankLines())
+	}
+	return formatOptions, nil
+}
+
 // formatModule formats the module's target files and writes them to the
 // writeBucket, if any. If diff is true, the diff between the original and
 // formatted files is written to stdout.
@@ -457,6 +518,7 @@ func formatModule(
 	runner command.Runner,
 	storageosProvider storageos.Provider,
 	module bufmodule.Module,
+	formatOptions []bufformat.FormatOption,
 	outputDirectory string,
 	singleFileOutputFilename string,
 	errorFormat string,
@@ -472,7 +534,7 @@ func formatModule(
 		return false, err
 	}
 	// Note that external paths are set properly for the files in this read bucket.
-	formattedReadBucket, err := bufformat.Format(ctx, module)
+	formattedReadBucket, err := bufformat.Format(ctx, module, formatOptions...)
 	if err != nil {
 		return false, err
 	}
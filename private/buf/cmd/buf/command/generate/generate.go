@@ -15,9 +15,12 @@
 package generate
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"path/filepath"
+	"text/template"
+	"time"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/buf/buffetch"
@@ -29,6 +32,7 @@ import (
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
 	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/git"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"github.com/spf13/cobra"
@@ -48,6 +52,13 @@ const (
 	disableSymlinksFlagName     = "disable-symlinks"
 	typeFlagName                = "type"
 	typeDeprecatedFlagName      = "include-types"
+	pluginTimeoutFlagName       = "plugin-timeout"
+	commitToFlagName            = "commit-to"
+	commitMessageFlagName       = "commit-message"
+	onlyChangedFlagName         = "only-changed"
+	shardFlagName               = "shard"
+
+	defaultCommitMessage = "Generate code from {{.SourceCommit}}"
 )
 
 // NewCommand returns a new Command.
@@ -109,12 +120,98 @@ func NewCommand(
         # If omitted, "directory" is used. Most users should not need to set this option.
         # Optional.
         strategy: directory
+        # The working directory to run the plugin binary in, relative to the current directory.
+        # If omitted, the plugin binary is run in the current directory.
+        # Optional, and exclusive with "remote".
+        working_dir: tools
+        # Additional environment variables to set for the plugin.
+        # These take precedence over the environment variables of buf.
+        # Optional, and exclusive with "remote".
+        env:
+          GOFLAGS: -mod=mod
+        # The sandbox to run the plugin binary in. Must be one of "none" or "strict".
+        # With "strict", the plugin binary only has access to the PATH and the environment
+        # variables set with "env", and is run in a new temporary directory that is also its
+        # home directory. On Linux, it is also run without network access.
+        # If omitted, "none" is used.
+        # Optional, and exclusive with "remote" and "working_dir".
+        # sandbox: strict
+        # The maximum duration of the plugin execution, after which the plugin is killed.
+        # Takes precedence over --plugin-timeout.
+        # Optional, and exclusive with "remote".
+        timeout: 1m
+        # The maximum memory of the plugin binary, as a number of bytes with an optional
+        # unit of B, KB, MB, GB, KiB, MiB or GiB. Only supported on Linux.
+        # Optional, and exclusive with "remote".
+        # max_memory: 2GiB
       - plugin: java
         out: gen/java
         # Use the plugin hosted at buf.build/protocolbuffers/python at version v21.9.
         # If version is omitted, uses the latest version of the plugin.
       - plugin: buf.build/protocolbuffers/python:v21.9
         out: gen/python
+    # Packaging files to generate alongside the generated code, so that output
+    # directories can be consumed directly as SDKs.
+    # Optional.
+    packages:
+        # The relative output directory to write the packaging file to.
+        # Required.
+      - out: gen/go
+        # The type of packaging file. There are three options:
+        #
+        # 1. "go" generates a go.mod file.
+        # 2. "npm" generates a package.json file.
+        # 3. "python" generates a pyproject.toml file.
+        #
+        # Required.
+        type: go
+        # The name of the package. For "go", this is the module path.
+        # Required, unless type is "go" and managed mode sets a default go_package_prefix,
+        # in which case that prefix is used.
+        name: github.com/acme/weather/gen/go
+        # The version of the package. Go modules are versioned by VCS tags, so
+        # this cannot be set for "go".
+        # Optional, defaults to 0.0.0 for "npm" and "python".
+        # version: 1.0.0
+        # The dependencies of the package, as a map from name to version.
+        # Optional.
+        deps:
+          google.golang.org/protobuf: v1.30.0
+    # The header to add to the beginning of all generated files, as comments. Headers are
+    # added to the files of all plugins, and only to files with an extension of a language
+    # with line comments, such as .go, .java, .py or .ts. Files that start with "#!" or
+    # "<?php" get the header after their first line.
+    # Optional.
+    header:
+      # The text of the header, such as a license. Each line is prefixed with a comment.
+      # Optional.
+      text: |
+        Copyright 2023 Acme, Inc.
+      # Add "Code generated by buf. DO NOT EDIT." to the header.
+      # Optional.
+      do_not_edit: true
+      # Add the SHA256 digest of this template to the header, to record what
+      # the files were generated with.
+      # Optional.
+      metadata: true
+    # Limits on the response of each plugin, checked before any file is written. If a
+    # plugin exceeds a limit, generation fails with an error that names the plugin.
+    # Plugins can never write files outside of their output directory.
+    # Optional.
+    limits:
+      # The maximum number of files that a plugin can generate.
+      # Optional.
+      max_files: 1000
+      # The maximum total size of the files that a plugin can generate, as a number
+      # of bytes with an optional unit of B, KB, MB, GB, KiB, MiB or GiB.
+      # Optional.
+      max_size: 64MiB
+      # Paths, relative to the output directory given with --output, that plugins
+      # cannot write to or within.
+      # Optional.
+      forbidden_paths:
+        - .git
+        - buf.yaml
 
 As an example, here's a typical "buf.gen.yaml" go and grpc, assuming
 "protoc-gen-go" and "protoc-gen-go-grpc" are on your "$PATH":
@@ -182,6 +279,46 @@ has a per-directory parallel invocation, with results from each invocation combi
 before writing the result.
 
 Insertion points are processed in the order the plugins are specified in the template.
+A plugin, local or remote, can insert code into files generated by any earlier plugin, including
+plugins with a parent or child output directory, such as "gen" and "gen/go".
+
+Packaging files are written after all plugins have run, and overwrite any existing file
+of the same name in the output directory.
+
+The generated code can be committed to a branch of the git repository of the current
+directory instead of being written to the current directory:
+
+    $ buf generate --commit-to generated/main
+
+The branch is checked out in a temporary worktree, its files are replaced with the
+generated code, and the result is committed to the branch. The branch is created
+without history if it does not exist. The checked out branch and the working directory
+of the repository are not changed. Nothing is committed if the generated code did not
+change. With --commit-to, the -o flag is interpreted as relative to the root of the branch.
+
+The commit message is a Go template set with --commit-message. The template can use
+{{.SourceCommit}} and {{.SourceBranch}}, the commit and branch checked out in the current
+directory, and {{.Input}}, the input that code was generated from:
+
+    $ buf generate --commit-to generated/main --commit-message "Generate from {{.SourceBranch}}@{{.SourceCommit}}"
+
+Large generated trees can be updated by only regenerating the outputs affected by the
+files that changed since the last generation:
+
+    $ buf generate --only-changed
+
+With --only-changed, the inputs that each output was derived from are recorded in a
+.buf.gen.manifest.json file in the output directory set with -o. The inputs of an output
+are taken from the generated code info of the plugin response if present, and otherwise
+from the name of the output. A file is regenerated if it, or any file it imports, changed,
+along with the other files in its directory and any file that shares an output with it.
+Everything is regenerated if there is no manifest, if the plugins in the template changed,
+or if a plugin writes to an insertion point. Outputs of removed files are not deleted.
+
+Generation can be split across CI jobs with --shard, which only generates the files of
+the given shard of the input. Files are assigned to shards by Protobuf package, so plugins
+that generate a single output from all files, such as with strategy "all", should not be
+used with --shard.
 `,
 		Args: cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
@@ -190,7 +327,11 @@ Insertion points are processed in the order the plugins are specified in the tem
 			},
 			bufcli.NewErrorInterceptor(),
 		),
-		BindFlags: flags.Bind,
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
+		CompleteFlags: map[string]appcmd.CompletionFunc{
+			templateFlagName: builder.NewCompletionFunc(bufcli.CompleteTemplate),
+		},
 	}
 }
 
@@ -209,6 +350,11 @@ type flags struct {
 	// want to find out what will break if we do.
 	Types           []string
 	TypesDeprecated []string
+	PluginTimeout   time.Duration
+	CommitTo        string
+	CommitMessage   string
+	OnlyChanged     bool
+	Shard           string
 	// special
 	InputHashtag string
 }
@@ -222,6 +368,7 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	bufcli.BindInputHashtag(flagSet, &f.InputHashtag)
 	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
 	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
+	bufcli.BindShard(flagSet, &f.Shard, shardFlagName)
 	flagSet.BoolVar(
 		&f.IncludeImports,
 		includeImportsFlagName,
@@ -279,6 +426,40 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	)
 	_ = flagSet.MarkDeprecated(typeDeprecatedFlagName, fmt.Sprintf("Use --%s instead", typeFlagName))
 	_ = flagSet.MarkHidden(typeDeprecatedFlagName)
+	flagSet.DurationVar(
+		&f.PluginTimeout,
+		pluginTimeoutFlagName,
+		0,
+		fmt.Sprintf(
+			"The duration until timing out each plugin execution. Defaults to the value of %s if set, then to timeouts.plugin of the buf configuration file, otherwise only --timeout applies",
+			bufcli.PluginTimeoutEnvKey,
+		),
+	)
+	flagSet.StringVar(
+		&f.CommitTo,
+		commitToFlagName,
+		"",
+		"The branch of the git repository of the current directory to commit the generated code to, instead of writing it to the current directory",
+	)
+	flagSet.StringVar(
+		&f.CommitMessage,
+		commitMessageFlagName,
+		defaultCommitMessage,
+		fmt.Sprintf(
+			"The Go template of the commit message. Can use {{.SourceCommit}}, {{.SourceBranch}} and {{.Input}}. Cannot be set without --%s",
+			commitToFlagName,
+		),
+	)
+	flagSet.BoolVar(
+		&f.OnlyChanged,
+		onlyChangedFlagName,
+		false,
+		fmt.Sprintf(
+			"Only generate the outputs affected by the files that changed since the last generation with --%s. Cannot be set with --%s",
+			onlyChangedFlagName,
+			commitToFlagName,
+		),
+	)
 }
 
 func run(
@@ -294,9 +475,29 @@ func run(
 		// in the context of including imports.
 		return appcmd.NewInvalidArgumentErrorf("Cannot set --%s without --%s", includeWKTFlagName, includeImportsFlagName)
 	}
+	if flags.PluginTimeout < 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must not be negative", pluginTimeoutFlagName)
+	}
 	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
 		return err
 	}
+	if flags.CommitTo == "" && flags.CommitMessage != defaultCommitMessage {
+		return appcmd.NewInvalidArgumentErrorf("Cannot set --%s without --%s", commitMessageFlagName, commitToFlagName)
+	}
+	shard, err := bufcli.ParseShardFlag(flags.Shard, shardFlagName)
+	if err != nil {
+		return err
+	}
+	if shard != nil && flags.OnlyChanged {
+		return appcmd.NewInvalidArgumentErrorf("Cannot set both --%s and --%s", shardFlagName, onlyChangedFlagName)
+	}
+	if flags.OnlyChanged && flags.CommitTo != "" {
+		return appcmd.NewInvalidArgumentErrorf("Cannot set both --%s and --%s", onlyChangedFlagName, commitToFlagName)
+	}
+	commitMessageTemplate, err := template.New("commit-message").Parse(flags.CommitMessage)
+	if err != nil {
+		return appcmd.NewInvalidArgumentErrorf("--%s: %v", commitMessageFlagName, err)
+	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
 	if err != nil {
 		return err
@@ -364,9 +565,7 @@ func run(
 	if err != nil {
 		return err
 	}
-	generateOptions := []bufgen.GenerateOption{
-		bufgen.GenerateWithBaseOutDirPath(flags.BaseOutDirPath),
-	}
+	var generateOptions []bufgen.GenerateOption
 	if flags.IncludeImports {
 		generateOptions = append(
 			generateOptions,
@@ -379,6 +578,19 @@ func run(
 			bufgen.GenerateWithIncludeWellKnownTypes(),
 		)
 	}
+	pluginTimeout := flags.PluginTimeout
+	if pluginTimeout == 0 {
+		pluginTimeout, err = bufcli.GetPluginTimeout(container)
+		if err != nil {
+			return err
+		}
+	}
+	if pluginTimeout > 0 {
+		generateOptions = append(
+			generateOptions,
+			bufgen.GenerateWithPluginTimeout(pluginTimeout),
+		)
+	}
 	wasmEnabled, err := bufcli.IsAlphaWASMEnabled(container)
 	if err != nil {
 		return err
@@ -389,6 +601,12 @@ func run(
 			bufgen.GenerateWithWASMEnabled(),
 		)
 	}
+	if flags.OnlyChanged {
+		generateOptions = append(
+			generateOptions,
+			bufgen.GenerateWithOnlyChanged(),
+		)
+	}
 	var includedTypes []string
 	if len(flags.Types) > 0 || len(flags.TypesDeprecated) > 0 {
 		// command-line flags take precedence
@@ -402,22 +620,104 @@ func run(
 			return err
 		}
 	}
+	if shard != nil {
+		if err := bufcli.PrintShardFingerprint(container, shard, image); err != nil {
+			return err
+		}
+		image, err = shard.ImageForShard(image)
+		if err != nil {
+			return err
+		}
+		if image == nil {
+			// None of the files are in the shard.
+			return nil
+		}
+	}
 	wasmPluginExecutor, err := bufwasm.NewPluginExecutor(
 		filepath.Join(container.CacheDirPath(), bufcli.WASMCompilationCacheDir))
 	if err != nil {
 		return err
 	}
-	return bufgen.NewGenerator(
+	generator := bufgen.NewGenerator(
 		logger,
 		storageosProvider,
 		runner,
 		wasmPluginExecutor,
 		clientConfig,
-	).Generate(
+	)
+	if flags.CommitTo == "" {
+		return generator.Generate(
+			ctx,
+			container,
+			genConfig,
+			image,
+			append(generateOptions, bufgen.GenerateWithBaseOutDirPath(flags.BaseOutDirPath))...,
+		)
+	}
+	commitMessage, err := getCommitMessage(ctx, container, runner, commitMessageTemplate, input)
+	if err != nil {
+		return err
+	}
+	committed, err := git.NewBranchCommitter(runner).CommitToBranch(
 		ctx,
 		container,
-		genConfig,
-		image,
-		generateOptions...,
+		".",
+		flags.CommitTo,
+		commitMessage,
+		func(worktreeDirPath string) error {
+			return generator.Generate(
+				ctx,
+				container,
+				genConfig,
+				image,
+				append(generateOptions, bufgen.GenerateWithBaseOutDirPath(filepath.Join(worktreeDirPath, flags.BaseOutDirPath)))...,
+			)
+		},
 	)
+	if err != nil {
+		return err
+	}
+	if !committed {
+		if _, err := container.Stderr().Write(
+			[]byte(fmt.Sprintf("The generated code did not change; not creating a new commit on %s.\n", flags.CommitTo)),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getCommitMessage executes the commit message template for the git
+// repository of the current directory.
+func getCommitMessage(
+	ctx context.Context,
+	container appflag.Container,
+	runner command.Runner,
+	commitMessageTemplate *template.Template,
+	input string,
+) (string, error) {
+	sourceCommit, err := git.RevParse(ctx, runner, container, "", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	sourceBranch, err := git.RevParse(ctx, runner, container, "", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	buffer := bytes.NewBuffer(nil)
+	if err := commitMessageTemplate.Execute(
+		buffer,
+		struct {
+			SourceCommit string
+			SourceBranch string
+			Input        string
+		}{
+			SourceCommit: sourceCommit,
+			SourceBranch: sourceBranch,
+			Input:        input,
+		},
+	); err != nil {
+		return "", appcmd.NewInvalidArgumentErrorf("--%s: %v", commitMessageFlagName, err)
+	}
+	return buffer.String(), nil
 }
@@ -210,6 +210,40 @@ func TestGenerateInsertionPoint(t *testing.T) {
 	testGenerateInsertionPoint(t, runner, "gen/proto/insertion/", "./gen/proto/insertion", filepath.Join("testdata", "nested_insertion_point"))
 }
 
+func TestGenerateInsertionPointAcrossOutDirs(t *testing.T) {
+	t.Parallel()
+	runner := command.NewRunner()
+	template := `
+version: v1
+plugins:
+  - name: insertion-point-receiver
+    out: gen/proto/insertion
+  - name: insertion-point-writer
+    out: gen
+    opt: proto/insertion
+`
+	storageosProvider := storageos.NewProvider()
+	tempDir, readWriteBucket := internaltesting.CopyReadBucketToTempDir(
+		context.Background(),
+		t,
+		storageosProvider,
+		storagemem.NewReadWriteBucket(),
+	)
+	testRunSuccess(
+		t,
+		filepath.Join("testdata", "simple"), // The input directory is irrelevant for these insertion points.
+		"--template",
+		template,
+		"-o",
+		tempDir,
+	)
+	expectedOutput, err := storageosProvider.NewReadWriteBucket(filepath.Join("testdata", "nested_insertion_point"))
+	require.NoError(t, err)
+	diff, err := storage.DiffBytes(context.Background(), runner, expectedOutput, readWriteBucket)
+	require.NoError(t, err)
+	require.Empty(t, string(diff))
+}
+
 func TestGenerateInsertionPointFail(t *testing.T) {
 	t.Parallel()
 	successTemplate := `
@@ -225,7 +259,7 @@ plugins:
 		nil,
 		1,
 		``,
-		`Failure: plugin insertion-point-writer: test.txt: does not exist`,
+		`Failure: plugin insertion-point-writer: insertion point "example": file "test.txt" was not generated by an earlier plugin or earlier in the same response`,
 		filepath.Join("testdata", "simple"), // The input directory is irrelevant for these insertion points.
 		"--template",
 		successTemplate,
@@ -320,7 +354,7 @@ plugins:
 		nil,
 		1,
 		``,
-		`Failure: plugin insertion-point-writer: test.txt: does not exist`,
+		`Failure: plugin insertion-point-writer: insertion point "example": file "test.txt" was not generated by an earlier plugin or earlier in the same response`,
 		filepath.Join("testdata", "simple"), // The input directory is irrelevant for these insertion points.
 		"--template",
 		fmt.Sprintf(successTemplate, receiverOut, writerOut),
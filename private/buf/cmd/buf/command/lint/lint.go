@@ -20,14 +20,19 @@ import (
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/buf/buffetch"
+	"github.com/bufbuild/buf/private/buf/bufwire"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufowner"
+	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
 	"github.com/bufbuild/buf/private/pkg/command"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/bufbuild/buf/private/pkg/thread"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -38,6 +43,13 @@ const (
 	pathsFlagName           = "path"
 	excludePathsFlagName    = "exclude-path"
 	disableSymlinksFlagName = "disable-symlinks"
+	reportIgnoresFlagName   = "report-ignores"
+	reportUnusedFlagName    = "report-unused"
+	exceptFlagName          = "except"
+	keepGoingFlagName       = "keep-going"
+	maxFailuresFlagName     = "max-failures"
+	parallelismFlagName     = "parallelism"
+	shardFlagName           = "shard"
 )
 
 // NewCommand returns a new Command.
@@ -49,15 +61,24 @@ func NewCommand(
 	return &appcmd.Command{
 		Use:   name + " <input>",
 		Short: "Run linting on Protobuf files",
-		Long:  bufcli.GetInputLong(`the source, module, or Image to lint`),
-		Args:  cobra.MaximumNArgs(1),
+		Long: bufcli.GetInputLong(`the source, module, or Image to lint`) + `
+
+Images and FileDescriptorSets are linted using their source code info, which contains the
+comments and locations of the Protobuf definitions. If a file does not have source code info,
+the rules that check comments are skipped for this file with a warning, and the other rules
+are reported without locations.`,
+		Args: cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
 			func(ctx context.Context, container appflag.Container) error {
 				return run(ctx, container, flags)
 			},
 			bufcli.NewErrorInterceptor(),
 		),
-		BindFlags: flags.Bind,
+		BindFlags:    flags.Bind,
+		CompleteArgs: builder.NewCompletionFunc(bufcli.CompleteInput),
+		CompleteFlags: map[string]appcmd.CompletionFunc{
+			exceptFlagName: builder.NewCompletionFunc(bufcli.CompleteLintRuleIDOrCategory),
+		},
 	}
 }
 
@@ -67,6 +88,13 @@ type flags struct {
 	Paths           []string
 	ExcludePaths    []string
 	DisableSymlinks bool
+	ReportIgnores   bool
+	ReportUnused    bool
+	Except          []string
+	KeepGoing       bool
+	MaxFailures     int
+	Parallelism     int
+	Shard           string
 	// special
 	InputHashtag string
 }
@@ -80,6 +108,10 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 	bufcli.BindPaths(flagSet, &f.Paths, pathsFlagName)
 	bufcli.BindExcludePaths(flagSet, &f.ExcludePaths, excludePathsFlagName)
 	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	bufcli.BindKeepGoing(flagSet, &f.KeepGoing, keepGoingFlagName)
+	bufcli.BindMaxFailures(flagSet, &f.MaxFailures, maxFailuresFlagName)
+	bufcli.BindParallelism(flagSet, &f.Parallelism, parallelismFlagName)
+	bufcli.BindShard(flagSet, &f.Shard, shardFlagName)
 	flagSet.StringVar(
 		&f.ErrorFormat,
 		errorFormatFlagName,
@@ -95,6 +127,31 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		"",
 		`The buf.yaml file or data to use for configuration`,
 	)
+	flagSet.BoolVar(
+		&f.ReportIgnores,
+		reportIgnoresFlagName,
+		false,
+		fmt.Sprintf(
+			`List every comment ignore and configured ignore in effect instead of running lint checks. Printed using --%s`,
+			errorFormatFlagName,
+		),
+	)
+	flagSet.BoolVar(
+		&f.ReportUnused,
+		reportUnusedFlagName,
+		false,
+		fmt.Sprintf(
+			`Only report imports that are not used by the importing file and dependencies in buf.yaml that are not imported by any file, regardless of the configured rules. Unused dependencies are only reported if --%s and --%s are not set`,
+			pathsFlagName,
+			excludePathsFlagName,
+		),
+	)
+	flagSet.StringSliceVar(
+		&f.Except,
+		exceptFlagName,
+		nil,
+		`The rule or category IDs to exclude from the lint check, in addition to the except of the configuration. May be provided multiple times`,
+	)
 }
 
 func run(
@@ -105,6 +162,19 @@ func run(
 	if err := bufcli.ValidateErrorFormatFlagLint(flags.ErrorFormat, errorFormatFlagName); err != nil {
 		return err
 	}
+	if flags.ReportIgnores && flags.ReportUnused {
+		return appcmd.NewInvalidArgumentErrorf("--%s and --%s cannot be used together.", reportIgnoresFlagName, reportUnusedFlagName)
+	}
+	if err := bufcli.ValidateMaxFailuresFlag(flags.MaxFailures, maxFailuresFlagName); err != nil {
+		return err
+	}
+	if err := bufcli.ValidateParallelismFlag(flags.Parallelism, parallelismFlagName); err != nil {
+		return err
+	}
+	shard, err := bufcli.ParseShardFlag(flags.Shard, shardFlagName)
+	if err != nil {
+		return err
+	}
 	input, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
 	if err != nil {
 		return err
@@ -119,11 +189,28 @@ func run(
 	if err != nil {
 		return err
 	}
+	imageConfigReaderOptions := []bufwire.ImageConfigReaderOption{
+		// source info is only loaded for the files that need it, see buflint.Handler
+		bufwire.ImageConfigReaderWithLazySourceCodeInfo(),
+	}
+	if flags.KeepGoing {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithKeepGoing(),
+		)
+	}
+	if flags.Parallelism > 1 {
+		imageConfigReaderOptions = append(
+			imageConfigReaderOptions,
+			bufwire.ImageConfigReaderWithParallelism(flags.Parallelism),
+		)
+	}
 	imageConfigReader, err := bufcli.NewWireImageConfigReader(
 		container,
 		storageosProvider,
 		runner,
 		clientConfig,
+		imageConfigReaderOptions...,
 	)
 	if err != nil {
 		return err
@@ -141,19 +228,215 @@ func run(
 	if err != nil {
 		return err
 	}
-	if len(fileAnnotations) > 0 {
-		formatString := flags.ErrorFormat
-		if formatString == "config-ignore-yaml" {
-			formatString = "text"
+	if len(fileAnnotations) > 0 && (!flags.KeepGoing || flags.ReportIgnores) {
+		fileAnnotations, err = bufcli.LimitFileAnnotations(container, fileAnnotations, flags.MaxFailures)
+		if err != nil {
+			return err
 		}
-		if err := bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, formatString); err != nil {
+		if err := printBuildFileAnnotations(container, fileAnnotations, flags.ErrorFormat); err != nil {
 			return err
 		}
 		return bufcli.ErrFileAnnotation
 	}
+	// With --keep-going, the build failures of the modules that failed to build
+	// are reported along with the lint failures of the modules that built.
+	buildFileAnnotations := fileAnnotations
+	if flags.ReportIgnores {
+		for _, imageConfig := range imageConfigs {
+			if err := bufimage.LoadSourceCodeInfo(ctx, bufimage.ImageWithoutImports(imageConfig.Image())); err != nil {
+				return err
+			}
+		}
+		return reportIgnores(ctx, container, imageConfigs, flags.ErrorFormat)
+	}
+	// we can only tell if a dependency is unused if every file of the module is linted
+	checkDependencies := len(flags.Paths) == 0 && len(flags.ExcludePaths) == 0 && shard == nil
+	// Each module is linted separately, up to --parallelism modules at once.
+	moduleFileAnnotations := make([][]bufanalysis.FileAnnotation, len(imageConfigs))
+	jobs := make([]func(context.Context) error, 0, len(imageConfigs))
+	for i, imageConfig := range imageConfigs {
+		if imageConfig == nil {
+			// The module failed to build, and its failures are in buildFileAnnotations.
+			continue
+		}
+		image := imageConfig.Image()
+		if shard != nil {
+			image, err = shard.ImageForShard(image)
+			if err != nil {
+				return err
+			}
+			if image == nil {
+				// None of the files of the module are in the shard.
+				continue
+			}
+		}
+		i := i
+		imageConfig := imageConfig
+		jobs = append(jobs, func(ctx context.Context) error {
+			fileAnnotations, err := lintImageConfig(ctx, container, imageConfig, image, flags, checkDependencies)
+			if err != nil {
+				return err
+			}
+			moduleFileAnnotations[i] = fileAnnotations
+			return nil
+		})
+	}
+	if err := thread.Parallelize(ctx, jobs, thread.ParallelizeWithParallelism(flags.Parallelism)); err != nil {
+		return err
+	}
 	var allFileAnnotations []bufanalysis.FileAnnotation
+	for _, fileAnnotations := range moduleFileAnnotations {
+		allFileAnnotations = append(allFileAnnotations, fileAnnotations...)
+	}
+	if shard != nil {
+		if err := bufcli.PrintShardFingerprint(container, shard, imageConfigImages(imageConfigs)...); err != nil {
+			return err
+		}
+	}
+	if len(buildFileAnnotations) > 0 || len(allFileAnnotations) > 0 {
+		numBuildFileAnnotations := len(buildFileAnnotations)
+		fileAnnotations, err := bufcli.LimitFileAnnotations(
+			container,
+			append(buildFileAnnotations, bufanalysis.DeduplicateAndSortFileAnnotations(allFileAnnotations)...),
+			flags.MaxFailures,
+		)
+		if err != nil {
+			return err
+		}
+		if numBuildFileAnnotations > len(fileAnnotations) {
+			numBuildFileAnnotations = len(fileAnnotations)
+		}
+		if numBuildFileAnnotations > 0 {
+			if err := printBuildFileAnnotations(container, fileAnnotations[:numBuildFileAnnotations], flags.ErrorFormat); err != nil {
+				return err
+			}
+		}
+		if len(fileAnnotations) > numBuildFileAnnotations {
+			if err := buflintconfig.PrintFileAnnotations(
+				container.Stdout(),
+				fileAnnotations[numBuildFileAnnotations:],
+				flags.ErrorFormat,
+			); err != nil {
+				return err
+			}
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	return nil
+}
+
+// lintImageConfig lints the Image of a single module, which is the Image of the
+// ImageConfig limited to the files in the shard if --shard is set.
+func lintImageConfig(
+	ctx context.Context,
+	container appflag.Container,
+	imageConfig bufwire.ImageConfig,
+	image bufimage.Image,
+	flags *flags,
+	checkDependencies bool,
+) ([]bufanalysis.FileAnnotation, error) {
+	lintConfig := imageConfig.Config().Lint
+	if len(flags.Except) > 0 {
+		lintConfig = exceptLintConfig(lintConfig, flags.Except)
+	}
+	if flags.ReportUnused {
+		lintConfig = unusedLintConfig(lintConfig)
+	}
+	var checkOptions []buflint.CheckOption
+	if checkDependencies && imageConfig.Config().Build != nil {
+		checkOptions = append(
+			checkOptions,
+			buflint.CheckWithDependencies(
+				imageConfig.Config().Build.DependencyModuleReferences,
+				imageConfig.Image(),
+			),
+		)
+	}
+	fileAnnotations, err := buflint.NewHandler(container.Logger()).Check(
+		ctx,
+		lintConfig,
+		bufimage.ImageWithoutImports(image),
+		checkOptions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return bufowner.AnnotateFileAnnotations(
+		imageConfig.Config().Owners,
+		fileAnnotations,
+		image,
+	), nil
+}
+
+// imageConfigImages returns the Images of the ImageConfigs that are not nil.
+func imageConfigImages(imageConfigs []bufwire.ImageConfig) []bufimage.Image {
+	images := make([]bufimage.Image, 0, len(imageConfigs))
+	for _, imageConfig := range imageConfigs {
+		if imageConfig != nil {
+			images = append(images, imageConfig.Image())
+		}
+	}
+	return images
+}
+
+// printBuildFileAnnotations prints the build failures to stdout. Build failures
+// are printed as text if the format is config-ignore-yaml, as they cannot be ignored.
+func printBuildFileAnnotations(
+	container app.StdoutContainer,
+	fileAnnotations []bufanalysis.FileAnnotation,
+	errorFormat string,
+) error {
+	if errorFormat == "config-ignore-yaml" {
+		errorFormat = "text"
+	}
+	return bufanalysis.PrintFileAnnotations(container.Stdout(), fileAnnotations, errorFormat)
+}
+
+// exceptLintConfig returns a copy of the lint config, and of its overrides, with
+// the rule and/or category IDs added to their except.
+func exceptLintConfig(lintConfig *buflintconfig.Config, except []string) *buflintconfig.Config {
+	newConfig := *lintConfig
+	newConfig.Except = append(append([]string(nil), lintConfig.Except...), except...)
+	if len(lintConfig.Overrides) > 0 {
+		newConfig.Overrides = make(map[string]*buflintconfig.Config, len(lintConfig.Overrides))
+		for rootPath, overrideConfig := range lintConfig.Overrides {
+			newConfig.Overrides[rootPath] = exceptLintConfig(overrideConfig, except)
+		}
+	}
+	return &newConfig
+}
+
+// unusedLintConfig returns a copy of the lint config that only runs the rules
+// that report unused imports and dependencies, keeping the configured ignores.
+func unusedLintConfig(lintConfig *buflintconfig.Config) *buflintconfig.Config {
+	unusedLintConfig := *lintConfig
+	unusedLintConfig.Use = []string{
+		"IMPORT_USED",
+		"DEPENDENCY_USED",
+	}
+	unusedLintConfig.Except = nil
+	// these rules only exist in v1, so we drop any ignore_only keys that are
+	// only known to v1beta1
+	unusedLintConfig.Version = bufconfig.V1Version
+	v1IDsAndCategories := stringutil.SliceToMap(buflint.GetAllRulesAndCategoriesV1())
+	unusedLintConfig.IgnoreIDOrCategoryToRootPaths = make(map[string][]string)
+	for idOrCategory, rootPaths := range lintConfig.IgnoreIDOrCategoryToRootPaths {
+		if _, ok := v1IDsAndCategories[idOrCategory]; ok {
+			unusedLintConfig.IgnoreIDOrCategoryToRootPaths[idOrCategory] = rootPaths
+		}
+	}
+	return &unusedLintConfig
+}
+
+func reportIgnores(
+	ctx context.Context,
+	container appflag.Container,
+	imageConfigs []bufwire.ImageConfig,
+	errorFormat string,
+) error {
+	var allIgnoreFileAnnotations []bufanalysis.FileAnnotation
 	for _, imageConfig := range imageConfigs {
-		fileAnnotations, err := buflint.NewHandler(container.Logger()).Check(
+		ignoreFileAnnotations, err := buflint.NewHandler(container.Logger()).Ignores(
 			ctx,
 			imageConfig.Config().Lint,
 			bufimage.ImageWithoutImports(imageConfig.Image()),
@@ -161,17 +444,14 @@ func run(
 		if err != nil {
 			return err
 		}
-		allFileAnnotations = append(allFileAnnotations, fileAnnotations...)
+		allIgnoreFileAnnotations = append(allIgnoreFileAnnotations, ignoreFileAnnotations...)
 	}
-	if len(allFileAnnotations) > 0 {
-		if err := buflintconfig.PrintFileAnnotations(
-			container.Stdout(),
-			bufanalysis.DeduplicateAndSortFileAnnotations(allFileAnnotations),
-			flags.ErrorFormat,
-		); err != nil {
-			return err
-		}
-		return bufcli.ErrFileAnnotation
+	if errorFormat == "config-ignore-yaml" {
+		errorFormat = "text"
 	}
-	return nil
+	return bufanalysis.PrintFileAnnotations(
+		container.Stdout(),
+		bufanalysis.DeduplicateAndSortFileAnnotations(allIgnoreFileAnnotations),
+		errorFormat,
+	)
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"github.com/spf13/pflag"
 )
@@ -73,3 +74,32 @@ func BindLSRulesVersion(flagSet *pflag.FlagSet, addr *string, flagName string, a
 		),
 	)
 }
+
+// ReferencesPinnedByLock takes moduleReferences and a list of pins, then
+// returns a new list of moduleReferences with the same identity, but their
+// reference set to the commit of the pin with the corresponding identity.
+func ReferencesPinnedByLock(moduleReferences []bufmoduleref.ModuleReference, modulePins []bufmoduleref.ModulePin) ([]bufmoduleref.ModuleReference, error) {
+	pinsByIdentity := make(map[string]bufmoduleref.ModulePin, len(modulePins))
+	for _, modulePin := range modulePins {
+		pinsByIdentity[modulePin.IdentityString()] = modulePin
+	}
+
+	var pinnedModuleReferences []bufmoduleref.ModuleReference
+	for _, moduleReference := range moduleReferences {
+		pin, ok := pinsByIdentity[moduleReference.IdentityString()]
+		if !ok {
+			return nil, fmt.Errorf(`can't tidy with dependency %q: no corresponding entry found in buf.lock. Use "mod update" first if this is a new dependency`, moduleReference.IdentityString())
+		}
+		newModuleReference, err := bufmoduleref.NewModuleReference(
+			moduleReference.Remote(),
+			moduleReference.Owner(),
+			moduleReference.Repository(),
+			pin.Commit(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		pinnedModuleReferences = append(pinnedModuleReferences, newModuleReference)
+	}
+	return pinnedModuleReferences, nil
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufgen"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
@@ -26,6 +27,7 @@ import (
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -35,6 +37,8 @@ const (
 	outDirPathFlagName            = "output"
 	outDirPathFlagShortName       = "o"
 	uncommentFlagName             = "uncomment"
+	interactiveFlagName           = "interactive"
+	templateFlagName              = "template"
 )
 
 // NewCommand returns a new init Command.
@@ -46,7 +50,19 @@ func NewCommand(
 	return &appcmd.Command{
 		Use:   name + " [buf.build/owner/foobar]",
 		Short: fmt.Sprintf("Initializes and writes a new %s configuration file.", bufconfig.ExternalConfigV1FilePath),
-		Args:  cobra.MaximumNArgs(1),
+		Long: fmt.Sprintf(`By default, only a %s configuration file is written.
+
+If --%s or --%s is set, a %s, an example .proto file, and a CI snippet
+are also generated. With --%s, the module name, lint and breaking profiles,
+directory layout, and template are read from stdin, with the default for each
+shown in brackets.`,
+			bufconfig.ExternalConfigV1FilePath,
+			interactiveFlagName,
+			templateFlagName,
+			bufgen.ExternalConfigFilePath,
+			interactiveFlagName,
+		),
+		Args: cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
 			func(ctx context.Context, container appflag.Container) error {
 				return run(ctx, container, flags)
@@ -60,6 +76,8 @@ func NewCommand(
 type flags struct {
 	DocumentationComments bool
 	OutDirPath            string
+	Interactive           bool
+	Template              string
 
 	// Hidden.
 	// Just used for generating docs.buf.build.
@@ -84,6 +102,22 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		".",
 		`The directory to write the configuration file to`,
 	)
+	flagSet.BoolVar(
+		&f.Interactive,
+		interactiveFlagName,
+		false,
+		"Prompt for the module name, lint and breaking profiles, directory layout, and template",
+	)
+	flagSet.StringVar(
+		&f.Template,
+		templateFlagName,
+		"",
+		fmt.Sprintf(
+			"The template to also generate a %s, an example .proto file, and a CI snippet with. Must be one of %s",
+			bufgen.ExternalConfigFilePath,
+			stringutil.SliceToString(allTemplateNames),
+		),
+	)
 	flagSet.BoolVar(
 		&f.Uncomment,
 		uncommentFlagName,
@@ -101,6 +135,11 @@ func run(
 	if flags.OutDirPath == "" {
 		return appcmd.NewInvalidArgumentErrorf("required flag %q not set", outDirPathFlagName)
 	}
+	if flags.Template != "" {
+		if _, ok := templateNameToTemplate[flags.Template]; !ok {
+			return appcmd.NewInvalidArgumentErrorf("--%s: unknown template %q, must be one of %s", templateFlagName, flags.Template, stringutil.SliceToString(allTemplateNames))
+		}
+	}
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
 	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
 		flags.OutDirPath,
@@ -109,6 +148,16 @@ func run(
 	if err != nil {
 		return err
 	}
+	var moduleIdentity bufmoduleref.ModuleIdentity
+	if container.NumArgs() > 0 {
+		moduleIdentity, err = bufmoduleref.ModuleIdentityForString(container.Arg(0))
+		if err != nil {
+			return err
+		}
+	}
+	if flags.Interactive || flags.Template != "" {
+		return runTemplate(ctx, container, readWriteBucket, flags, moduleIdentity)
+	}
 	existingConfigFilePath, err := bufconfig.ExistingConfigFilePath(ctx, readWriteBucket)
 	if err != nil {
 		return err
@@ -116,12 +165,21 @@ func run(
 	if existingConfigFilePath != "" {
 		return fmt.Errorf("%s already exists, not overwriting", existingConfigFilePath)
 	}
+	return bufconfig.WriteConfig(
+		ctx,
+		readWriteBucket,
+		newWriteConfigOptions(flags, moduleIdentity, defaultLintProfile, defaultBreakingProfile)...,
+	)
+}
+
+func newWriteConfigOptions(
+	flags *flags,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	lintProfile string,
+	breakingProfile string,
+) []bufconfig.WriteConfigOption {
 	var writeConfigOptions []bufconfig.WriteConfigOption
-	if container.NumArgs() > 0 {
-		moduleIdentity, err := bufmoduleref.ModuleIdentityForString(container.Arg(0))
-		if err != nil {
-			return err
-		}
+	if moduleIdentity != nil {
 		writeConfigOptions = append(
 			writeConfigOptions,
 			bufconfig.WriteConfigWithModuleIdentity(moduleIdentity),
@@ -150,22 +208,17 @@ func run(
 		bufconfig.WriteConfigWithBreakingConfig(
 			&bufbreakingconfig.Config{
 				Version: version,
-				Use:     []string{"FILE"},
+				Use:     []string{breakingProfile},
 			},
 		),
 	)
-	writeConfigOptions = append(
+	return append(
 		writeConfigOptions,
 		bufconfig.WriteConfigWithLintConfig(
 			&buflintconfig.Config{
 				Version: version,
-				Use:     []string{"DEFAULT"},
+				Use:     []string{lintProfile},
 			},
 		),
 	)
-	return bufconfig.WriteConfig(
-		ctx,
-		readWriteBucket,
-		writeConfigOptions...,
-	)
 }
@@ -0,0 +1,441 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modinit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufgen"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+)
+
+const (
+	defaultLintProfile     = "DEFAULT"
+	defaultBreakingProfile = "FILE"
+	defaultTemplateName    = "go"
+
+	// layoutRoot places the module at the root of the output directory.
+	layoutRoot = "root"
+	// layoutProto places the module in a proto subdirectory of the output directory,
+	// with a buf.work.yaml and buf.gen.yaml at the root.
+	layoutProto  = "proto"
+	protoDirPath = "proto"
+
+	promptAttempts = 3
+)
+
+var (
+	allLintProfiles     = []string{"MINIMAL", "BASIC", "DEFAULT"}
+	allBreakingProfiles = []string{"FILE", "PACKAGE", "WIRE_JSON", "WIRE", "JSON"}
+	allLayouts          = []string{layoutRoot, layoutProto}
+	allTemplateNames    = []string{"go", "connect-go", "es"}
+
+	templateNameToTemplate = map[string]*initTemplate{
+		"go": {
+			plugins: []*initTemplatePlugin{
+				{
+					name: "buf.build/protocolbuffers/go",
+					out:  "gen/go",
+					opt:  "paths=source_relative",
+				},
+			},
+			goPackagePrefix: true,
+		},
+		"connect-go": {
+			plugins: []*initTemplatePlugin{
+				{
+					name: "buf.build/protocolbuffers/go",
+					out:  "gen/go",
+					opt:  "paths=source_relative",
+				},
+				{
+					name: "buf.build/bufbuild/connect-go",
+					out:  "gen/go",
+					opt:  "paths=source_relative",
+				},
+			},
+			goPackagePrefix: true,
+		},
+		"es": {
+			plugins: []*initTemplatePlugin{
+				{
+					name: "buf.build/bufbuild/es",
+					out:  "gen/es",
+					opt:  "target=ts",
+				},
+			},
+		},
+	}
+)
+
+type initTemplate struct {
+	plugins []*initTemplatePlugin
+	// If true, managed mode is enabled with a go_package_prefix.
+	goPackagePrefix bool
+}
+
+type initTemplatePlugin struct {
+	name string
+	out  string
+	opt  string
+}
+
+type initTemplateOptions struct {
+	moduleIdentity  bufmoduleref.ModuleIdentity
+	lintProfile     string
+	breakingProfile string
+	layout          string
+	templateName    string
+	goPackagePrefix string
+}
+
+func runTemplate(
+	ctx context.Context,
+	container app.Container,
+	readWriteBucket storage.ReadWriteBucket,
+	flags *flags,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+) error {
+	initTemplateOptions := &initTemplateOptions{
+		moduleIdentity:  moduleIdentity,
+		lintProfile:     defaultLintProfile,
+		breakingProfile: defaultBreakingProfile,
+		layout:          layoutRoot,
+		templateName:    flags.Template,
+	}
+	if initTemplateOptions.templateName == "" {
+		initTemplateOptions.templateName = defaultTemplateName
+	}
+	if flags.Interactive {
+		if err := promptInitTemplateOptions(container, initTemplateOptions); err != nil {
+			return err
+		}
+	}
+	initTemplate := templateNameToTemplate[initTemplateOptions.templateName]
+	if initTemplate.goPackagePrefix && initTemplateOptions.goPackagePrefix == "" {
+		initTemplateOptions.goPackagePrefix = defaultGoPackagePrefix(initTemplateOptions.moduleIdentity)
+	}
+	moduleDirPath := "."
+	moduleReadWriteBucket := readWriteBucket
+	if initTemplateOptions.layout == layoutProto {
+		moduleDirPath = protoDirPath
+		moduleReadWriteBucket = storage.MapReadWriteBucket(readWriteBucket, storage.MapOnPrefix(protoDirPath))
+	}
+	filePathToData := map[string][]byte{
+		bufgen.ExternalConfigFilePath: newGenConfigData(initTemplate, initTemplateOptions),
+	}
+	exampleFilePath, exampleData := newExampleFile(initTemplateOptions.moduleIdentity)
+	filePathToData[normalpath.Join(moduleDirPath, exampleFilePath)] = exampleData
+	if initTemplateOptions.layout == layoutProto {
+		filePathToData[bufwork.ExternalConfigV1FilePath] = []byte(
+			"version: " + bufwork.V1Version + "\ndirectories:\n  - " + protoDirPath + "\n",
+		)
+	}
+	// Check everything before writing anything, so that we never leave
+	// a partially-initialized directory behind.
+	existingConfigFilePath, err := bufconfig.ExistingConfigFilePath(ctx, moduleReadWriteBucket)
+	if err != nil {
+		return err
+	}
+	if existingConfigFilePath != "" {
+		return fmt.Errorf("%s already exists, not overwriting", normalpath.Join(moduleDirPath, existingConfigFilePath))
+	}
+	filePaths := []string{normalpath.Join(moduleDirPath, bufconfig.ExternalConfigV1FilePath)}
+	for filePath := range filePathToData {
+		filePaths = append(filePaths, filePath)
+	}
+	sort.Strings(filePaths)
+	existingFilePaths := append([]string{}, filePaths...)
+	if initTemplateOptions.layout == layoutProto {
+		existingFilePaths = append(existingFilePaths, bufwork.AllConfigFilePaths...)
+	}
+	for _, filePath := range existingFilePaths {
+		exists, err := storage.Exists(ctx, readWriteBucket, filePath)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("%s already exists, not overwriting", filePath)
+		}
+	}
+	if err := bufconfig.WriteConfig(
+		ctx,
+		moduleReadWriteBucket,
+		newWriteConfigOptions(
+			flags,
+			initTemplateOptions.moduleIdentity,
+			initTemplateOptions.lintProfile,
+			initTemplateOptions.breakingProfile,
+		)...,
+	); err != nil {
+		return err
+	}
+	for filePath, data := range filePathToData {
+		if err := storage.PutPath(ctx, readWriteBucket, filePath, data); err != nil {
+			return err
+		}
+	}
+	var output strings.Builder
+	for _, filePath := range filePaths {
+		output.WriteString("Wrote " + filePath + "\n")
+	}
+	output.WriteString("\nTo lint and check for breaking changes in CI, add the following to .github/workflows/buf.yaml:\n\n")
+	output.WriteString(newCISnippet(flags.OutDirPath))
+	if _, err := container.Stdout().Write([]byte(output.String())); err != nil {
+		return err
+	}
+	return nil
+}
+
+func promptInitTemplateOptions(container app.Container, initTemplateOptions *initTemplateOptions) error {
+	reader := bufio.NewReader(container.Stdin())
+	var defaultModuleName string
+	if initTemplateOptions.moduleIdentity != nil {
+		defaultModuleName = initTemplateOptions.moduleIdentity.IdentityString()
+	}
+	moduleName, err := promptValue(
+		container,
+		reader,
+		"Module name, e.g. buf.build/acme/weather (optional)",
+		defaultModuleName,
+		func(value string) (string, error) {
+			if value == "" {
+				return "", nil
+			}
+			if _, err := bufmoduleref.ModuleIdentityForString(value); err != nil {
+				return "", err
+			}
+			return value, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if moduleName != "" {
+		initTemplateOptions.moduleIdentity, err = bufmoduleref.ModuleIdentityForString(moduleName)
+		if err != nil {
+			return err
+		}
+	}
+	initTemplateOptions.lintProfile, err = promptChoice(container, reader, "Lint profile", initTemplateOptions.lintProfile, allLintProfiles, strings.ToUpper)
+	if err != nil {
+		return err
+	}
+	initTemplateOptions.breakingProfile, err = promptChoice(container, reader, "Breaking profile", initTemplateOptions.breakingProfile, allBreakingProfiles, strings.ToUpper)
+	if err != nil {
+		return err
+	}
+	initTemplateOptions.layout, err = promptChoice(container, reader, "Directory layout", initTemplateOptions.layout, allLayouts, strings.ToLower)
+	if err != nil {
+		return err
+	}
+	initTemplateOptions.templateName, err = promptChoice(container, reader, "Template", initTemplateOptions.templateName, allTemplateNames, strings.ToLower)
+	if err != nil {
+		return err
+	}
+	if templateNameToTemplate[initTemplateOptions.templateName].goPackagePrefix {
+		initTemplateOptions.goPackagePrefix, err = promptValue(
+			container,
+			reader,
+			"Go package prefix",
+			defaultGoPackagePrefix(initTemplateOptions.moduleIdentity),
+			func(value string) (string, error) {
+				if value == "" || strings.ContainsAny(value, " \t") {
+					return "", fmt.Errorf("invalid Go package prefix %q", value)
+				}
+				return value, nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// promptChoice prompts for one of the given choices, normalizing the answer with normalize.
+func promptChoice(
+	container app.Container,
+	reader *bufio.Reader,
+	prompt string,
+	defaultValue string,
+	choices []string,
+	normalize func(string) string,
+) (string, error) {
+	return promptValue(
+		container,
+		reader,
+		prompt+" ("+strings.Join(choices, ", ")+")",
+		defaultValue,
+		func(value string) (string, error) {
+			value = normalize(value)
+			for _, choice := range choices {
+				if value == choice {
+					return value, nil
+				}
+			}
+			return "", fmt.Errorf("invalid value %q, must be one of %s", value, stringutil.SliceToString(choices))
+		},
+	)
+}
+
+// promptValue prompts for a single line, using defaultValue if the line is empty.
+//
+// Unlike bufcli.PromptUser, this does not require stdin to be a terminal, so that
+// answers can be piped in. If stdin is exhausted, the default is used.
+func promptValue(
+	container app.Container,
+	reader *bufio.Reader,
+	prompt string,
+	defaultValue string,
+	validate func(string) (string, error),
+) (string, error) {
+	if defaultValue != "" {
+		prompt = prompt + " [" + defaultValue + "]"
+	}
+	for attempts := 0; attempts < promptAttempts; attempts++ {
+		if _, err := fmt.Fprint(container.Stdout(), prompt+": "); err != nil {
+			return "", err
+		}
+		line, readErr := reader.ReadString('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return "", readErr
+		}
+		value := strings.TrimSpace(line)
+		if value == "" {
+			value = defaultValue
+		}
+		value, err := validate(value)
+		if err != nil {
+			if readErr != nil {
+				// There is no more input to retry with.
+				return "", err
+			}
+			if _, err := fmt.Fprintln(container.Stdout(), err.Error()); err != nil {
+				return "", err
+			}
+			continue
+		}
+		return value, nil
+	}
+	return "", fmt.Errorf("did not receive a valid answer after %d attempts", promptAttempts)
+}
+
+func newGenConfigData(initTemplate *initTemplate, initTemplateOptions *initTemplateOptions) []byte {
+	var builder strings.Builder
+	builder.WriteString("version: v1\n")
+	if initTemplate.goPackagePrefix {
+		builder.WriteString("managed:\n  enabled: true\n  go_package_prefix:\n")
+		builder.WriteString("    default: " + initTemplateOptions.goPackagePrefix + "\n")
+	}
+	builder.WriteString("plugins:\n")
+	for _, plugin := range initTemplate.plugins {
+		builder.WriteString("  - plugin: " + plugin.name + "\n")
+		builder.WriteString("    out: " + plugin.out + "\n")
+		if plugin.opt != "" {
+			builder.WriteString("    opt: " + plugin.opt + "\n")
+		}
+	}
+	return []byte(builder.String())
+}
+
+// newExampleFile returns the path and content of an example .proto file that
+// passes the DEFAULT lint rules.
+func newExampleFile(moduleIdentity bufmoduleref.ModuleIdentity) (string, []byte) {
+	packageComponents := []string{"example", "v1"}
+	if moduleIdentity != nil {
+		packageComponents = []string{
+			toPackageComponent(moduleIdentity.Owner()),
+			toPackageComponent(moduleIdentity.Repository()),
+			"v1",
+		}
+	}
+	filePath := normalpath.Join(
+		normalpath.Join(packageComponents...),
+		packageComponents[len(packageComponents)-2]+".proto",
+	)
+	return filePath, []byte(`syntax = "proto3";
+
+package ` + strings.Join(packageComponents, ".") + `;
+
+// Example is an example message. Replace it with your own definitions.
+message Example {
+  // The name of the example.
+  string name = 1;
+}
+`)
+}
+
+// toPackageComponent converts an owner or repository name into a valid
+// lower_snake_case package component.
+func toPackageComponent(name string) string {
+	return strings.Map(
+		func(r rune) rune {
+			if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+				return r
+			}
+			return '_'
+		},
+		strings.ToLower(name),
+	)
+}
+
+func defaultGoPackagePrefix(moduleIdentity bufmoduleref.ModuleIdentity) string {
+	if moduleIdentity == nil {
+		return "example.com/gen/go"
+	}
+	return "github.com/" + moduleIdentity.Owner() + "/" + moduleIdentity.Repository() + "/gen/go"
+}
+
+func newCISnippet(outDirPath string) string {
+	input := normalpath.Normalize(outDirPath)
+	against := "https://github.com/${{ github.repository }}.git#branch=main"
+	if input != "." {
+		against += ",subdir=" + input
+	}
+	return `name: buf
+on:
+  push:
+    branches:
+      - main
+  pull_request:
+jobs:
+  buf:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v3
+      - uses: bufbuild/buf-setup-action@v1
+      - uses: bufbuild/buf-lint-action@v1
+        with:
+          input: ` + input + `
+      - uses: bufbuild/buf-breaking-action@v1
+        if: github.event_name == 'pull_request'
+        with:
+          input: ` + input + `
+          against: "` + against + `"
+`
+}
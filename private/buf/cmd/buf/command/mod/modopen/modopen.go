@@ -70,7 +70,7 @@ func run(
 	if existingConfigFilePath == "" {
 		return bufcli.ErrNoConfigFile
 	}
-	config, err := bufconfig.GetConfigForBucket(ctx, readWriteBucket)
+	config, err := bufconfig.ReadConfigOS(ctx, readWriteBucket)
 	if err != nil {
 		return err
 	}
@@ -19,10 +19,10 @@ import (
 	"fmt"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/mod/internal"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufconnect"
 	"github.com/bufbuild/buf/private/bufpkg/buflock"
-	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
 	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
@@ -77,7 +77,7 @@ func run(
 	if existingConfigFilePath == "" {
 		return bufcli.ErrNoConfigFile
 	}
-	config, err := bufconfig.GetConfigForBucket(ctx, readWriteBucket)
+	config, err := bufconfig.ReadConfigOS(ctx, readWriteBucket)
 	if err != nil {
 		return err
 	}
@@ -91,12 +91,12 @@ func run(
 	}
 	service := connectclient.Make(clientConfig, remote, registryv1alpha1connect.NewResolveServiceClient)
 
-	module, err := bufmodule.NewModuleForBucket(ctx, readWriteBucket)
+	currentModulePins, err := bufmoduleref.DependencyModulePinsForBucket(ctx, readWriteBucket)
 	if err != nil {
 		return fmt.Errorf("couldn't read current dependencies: %w", err)
 	}
 
-	requestReferences, err := referencesPinnedByLock(config.Build.DependencyModuleReferences, module.DependencyModulePins())
+	requestReferences, err := internal.ReferencesPinnedByLock(config.Build.DependencyModuleReferences, currentModulePins)
 	if err != nil {
 		return err
 	}
@@ -124,32 +124,3 @@ func run(
 	}
 	return nil
 }
-
-// referencesPinnedByLock takes moduleReferences and a list of pins, then
-// returns a new list of moduleReferences with the same identity, but their
-// reference set to the commit of the pin with the corresponding identity.
-func referencesPinnedByLock(moduleReferences []bufmoduleref.ModuleReference, modulePins []bufmoduleref.ModulePin) ([]bufmoduleref.ModuleReference, error) {
-	pinsByIdentity := make(map[string]bufmoduleref.ModulePin, len(modulePins))
-	for _, modulePin := range modulePins {
-		pinsByIdentity[modulePin.IdentityString()] = modulePin
-	}
-
-	var pinnedModuleReferences []bufmoduleref.ModuleReference
-	for _, moduleReference := range moduleReferences {
-		pin, ok := pinsByIdentity[moduleReference.IdentityString()]
-		if !ok {
-			return nil, fmt.Errorf(`can't tidy with dependency %q: no corresponding entry found in buf.lock. Use "mod update" first if this is a new dependency`, moduleReference.IdentityString())
-		}
-		newModuleReference, err := bufmoduleref.NewModuleReference(
-			moduleReference.Remote(),
-			moduleReference.Owner(),
-			moduleReference.Repository(),
-			pin.Commit(),
-		)
-		if err != nil {
-			return nil, err
-		}
-		pinnedModuleReferences = append(pinnedModuleReferences, newModuleReference)
-	}
-	return pinnedModuleReferences, nil
-}
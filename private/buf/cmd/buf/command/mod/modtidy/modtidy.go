@@ -0,0 +1,563 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modtidy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/command/mod/internal"
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufconnect"
+	"github.com/bufbuild/buf/private/bufpkg/buflock"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/data/datawkt"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/connect-go"
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// NewCommand returns a new tidy Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	return &appcmd.Command{
+		Use: name + " <directory>",
+		Short: fmt.Sprintf(
+			"Add missing and remove unused dependencies in the %s and %s files",
+			bufconfig.ExternalConfigV1FilePath,
+			buflock.ExternalConfigFilePath,
+		),
+		Long: `The first argument is the directory of the local module to tidy. Defaults to "." if no argument is specified.
+
+Dependencies are inferred from the import statements of the module's files, without compiling them.
+Configured dependencies that no file imports from are removed. Imports from the modules in the lock file
+that are not configured dependencies, or from the named modules of the workspace that contains the module,
+are added as dependencies. Imports that no such module contains are resolved with the registry, and are
+added as dependencies if exactly one module contains them. Imports that no module or several modules
+contain are reported, and must be added to the dependencies manually.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+	}
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+) error {
+	directoryInput, err := bufcli.GetInputValue(container, "", ".")
+	if err != nil {
+		return err
+	}
+	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
+	readWriteBucket, err := storageosProvider.NewReadWriteBucket(
+		directoryInput,
+		storageos.ReadWriteBucketWithSymlinksIfSupported(),
+	)
+	if err != nil {
+		return err
+	}
+	existingConfigFilePath, err := bufconfig.ExistingConfigFilePath(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	if existingConfigFilePath == "" {
+		return bufcli.ErrNoConfigFile
+	}
+	config, err := bufconfig.ReadConfigOS(ctx, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	remote := bufconnect.DefaultRemote
+	if config.ModuleIdentity != nil && config.ModuleIdentity.Remote() != "" {
+		remote = config.ModuleIdentity.Remote()
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	builtModule, err := bufmodulebuild.BuildForBucket(ctx, readWriteBucket, config.Build)
+	if err != nil {
+		return err
+	}
+	externalImportPaths, err := getExternalImportPaths(ctx, builtModule.Module)
+	if err != nil {
+		return err
+	}
+	workspaceImportPathToIdentity, err := getWorkspaceImportPathToIdentity(ctx, storageosProvider, directoryInput)
+	if err != nil {
+		return err
+	}
+	currentModulePins, err := bufmoduleref.DependencyModulePinsForBucket(ctx, readWriteBucket)
+	if err != nil {
+		return fmt.Errorf("couldn't read current dependencies: %w", err)
+	}
+	moduleReader, err := bufcli.NewModuleReaderAndCreateCacheDirs(container, clientConfig)
+	if err != nil {
+		return err
+	}
+	configuredIdentitySet := make(map[string]struct{}, len(config.Build.DependencyModuleReferences))
+	for _, moduleReference := range config.Build.DependencyModuleReferences {
+		configuredIdentitySet[moduleReference.IdentityString()] = struct{}{}
+	}
+	importPathToIdentity, err := getImportPathToIdentity(ctx, moduleReader, currentModulePins, configuredIdentitySet)
+	if err != nil {
+		return err
+	}
+	usedIdentities, unresolvedImportPaths := getUsedIdentities(
+		externalImportPaths,
+		workspaceImportPathToIdentity,
+		importPathToIdentity,
+	)
+	if len(unresolvedImportPaths) > 0 {
+		service := connectclient.Make(clientConfig, remote, registryv1alpha1connect.NewResolveServiceClient)
+		resolvedImportPathToIdentity, err := resolveImportPaths(ctx, container.Logger(), service, unresolvedImportPaths)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeUnimplemented && remote != bufconnect.DefaultRemote {
+				return bufcli.NewUnimplementedRemoteError(err, remote, config.ModuleIdentity.IdentityString())
+			}
+			return err
+		}
+		for importPath, identity := range resolvedImportPathToIdentity {
+			importPathToIdentity[importPath] = identity
+		}
+		usedIdentities, _ = getUsedIdentities(
+			externalImportPaths,
+			workspaceImportPathToIdentity,
+			importPathToIdentity,
+		)
+	}
+	var keptModuleReferences []bufmoduleref.ModuleReference
+	var addedModuleReferences []bufmoduleref.ModuleReference
+	if _, err := bufconfig.UpdateDependencies(
+		ctx,
+		readWriteBucket,
+		existingConfigFilePath,
+		os.LookupEnv,
+		func(dependencies []bufconfig.Dependency) ([]string, error) {
+			var values []string
+			var err error
+			keptModuleReferences, addedModuleReferences, values, err = tidyDependencies(dependencies, usedIdentities)
+			if err != nil {
+				return nil, err
+			}
+			for _, dependency := range dependencies {
+				if _, ok := usedIdentities.identitySet[dependency.ModuleReference.IdentityString()]; !ok {
+					container.Logger().Info(
+						"removing unused dependency",
+						zap.String("dependency", dependency.Value),
+					)
+				}
+			}
+			return values, nil
+		},
+	); err != nil {
+		return err
+	}
+	for _, moduleReference := range addedModuleReferences {
+		container.Logger().Info(
+			"adding dependency",
+			zap.String("dependency", moduleReference.IdentityString()),
+		)
+	}
+	// Dependencies already in the lock file stay at their pinned commit.
+	requestReferences, err := internal.ReferencesPinnedByLock(keptModuleReferences, currentModulePins)
+	if err != nil {
+		return err
+	}
+	for _, moduleReference := range addedModuleReferences {
+		pinnedModuleReferences, err := internal.ReferencesPinnedByLock(
+			[]bufmoduleref.ModuleReference{moduleReference},
+			currentModulePins,
+		)
+		if err != nil {
+			// Not in the lock file, resolve the latest commit.
+			requestReferences = append(requestReferences, moduleReference)
+			continue
+		}
+		requestReferences = append(requestReferences, pinnedModuleReferences...)
+	}
+	var dependencyModulePins []bufmoduleref.ModulePin
+	if len(requestReferences) > 0 {
+		service := connectclient.Make(clientConfig, remote, registryv1alpha1connect.NewResolveServiceClient)
+		resp, err := service.GetModulePins(
+			ctx,
+			connect.NewRequest(&registryv1alpha1.GetModulePinsRequest{
+				ModuleReferences: bufmoduleref.NewProtoModuleReferencesForModuleReferences(requestReferences...),
+			}),
+		)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeUnimplemented && remote != bufconnect.DefaultRemote {
+				return bufcli.NewUnimplementedRemoteError(err, remote, config.ModuleIdentity.IdentityString())
+			}
+			return err
+		}
+		dependencyModulePins, err = bufmoduleref.NewModulePinsForProtos(resp.Msg.ModulePins...)
+		if err != nil {
+			return bufcli.NewInternalError(err)
+		}
+	}
+	return bufmoduleref.PutDependencyModulePinsToBucket(ctx, readWriteBucket, dependencyModulePins)
+}
+
+// usedModuleIdentities are the identities of the modules that a module imports from.
+type usedModuleIdentities struct {
+	// identitySet contains the identities of all the modules.
+	identitySet map[string]struct{}
+	// sortedIdentities are the identities of the modules in the order they are added
+	// as dependencies if they are not configured.
+	sortedIdentities []string
+}
+
+// getUsedIdentities returns the identities of the modules that contain the
+// external import paths, and the sorted import paths that no module contains.
+//
+// The files of the workspace take precedence over the files of the pinned
+// modules, as they are used in place of them when building the workspace. An
+// empty identity in workspaceImportPathToIdentity is an unnamed workspace module,
+// which satisfies the import but cannot be a dependency.
+func getUsedIdentities(
+	externalImportPaths []string,
+	workspaceImportPathToIdentity map[string]string,
+	importPathToIdentity map[string]string,
+) (*usedModuleIdentities, []string) {
+	identitySet := make(map[string]struct{})
+	var unresolvedImportPaths []string
+	for _, importPath := range externalImportPaths {
+		identity, ok := workspaceImportPathToIdentity[importPath]
+		if !ok {
+			identity, ok = importPathToIdentity[importPath]
+		}
+		if !ok {
+			unresolvedImportPaths = append(unresolvedImportPaths, importPath)
+			continue
+		}
+		if identity != "" {
+			identitySet[identity] = struct{}{}
+		}
+	}
+	sortedIdentities := make([]string, 0, len(identitySet))
+	for identity := range identitySet {
+		sortedIdentities = append(sortedIdentities, identity)
+	}
+	sort.Strings(sortedIdentities)
+	sort.Strings(unresolvedImportPaths)
+	return &usedModuleIdentities{
+		identitySet:      identitySet,
+		sortedIdentities: sortedIdentities,
+	}, unresolvedImportPaths
+}
+
+// resolveImportPaths resolves the import paths with the registry, and returns a
+// map from the import paths that exactly one module contains to the identity of
+// this module.
+//
+// The import paths that no module or several modules contain are logged, as
+// they must be added to the dependencies manually.
+func resolveImportPaths(
+	ctx context.Context,
+	logger *zap.Logger,
+	service registryv1alpha1connect.ResolveServiceClient,
+	importPaths []string,
+) (map[string]string, error) {
+	resp, err := service.ResolveImportPaths(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.ResolveImportPathsRequest{
+			ImportPaths: importPaths,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	importPathToIdentity := make(map[string]string)
+	for _, importPathResolution := range resp.Msg.ImportPathResolutions {
+		protoModuleReferences := importPathResolution.ModuleReferences
+		switch len(protoModuleReferences) {
+		case 0:
+			logger.Warn(
+				"no known module contains the imported file, add the module that contains it to the deps manually",
+				zap.String("import_path", importPathResolution.ImportPath),
+			)
+		case 1:
+			moduleIdentity, err := bufmoduleref.NewModuleIdentity(
+				protoModuleReferences[0].Remote,
+				protoModuleReferences[0].Owner,
+				protoModuleReferences[0].Repository,
+			)
+			if err != nil {
+				return nil, bufcli.NewInternalError(err)
+			}
+			importPathToIdentity[importPathResolution.ImportPath] = moduleIdentity.IdentityString()
+		default:
+			candidates := make([]string, len(protoModuleReferences))
+			for i, protoModuleReference := range protoModuleReferences {
+				candidates[i] = protoModuleReference.Remote + "/" + protoModuleReference.Owner + "/" + protoModuleReference.Repository
+			}
+			logger.Warn(
+				"multiple modules contain the imported file, add the intended one to the deps manually",
+				zap.String("import_path", importPathResolution.ImportPath),
+				zap.String("candidates", strings.Join(candidates, ", ")),
+			)
+		}
+	}
+	return importPathToIdentity, nil
+}
+
+// tidyDependencies returns the configured dependencies that are used, the used
+// modules that are not configured dependencies, and the values of the new deps.
+//
+// The values of the kept dependencies are kept as written, so that their
+// references to environment variables are preserved.
+func tidyDependencies(
+	dependencies []bufconfig.Dependency,
+	usedIdentities *usedModuleIdentities,
+) (
+	keptModuleReferences []bufmoduleref.ModuleReference,
+	addedModuleReferences []bufmoduleref.ModuleReference,
+	values []string,
+	_ error,
+) {
+	configuredIdentitySet := make(map[string]struct{}, len(dependencies))
+	for _, dependency := range dependencies {
+		identity := dependency.ModuleReference.IdentityString()
+		configuredIdentitySet[identity] = struct{}{}
+		if _, ok := usedIdentities.identitySet[identity]; !ok {
+			continue
+		}
+		keptModuleReferences = append(keptModuleReferences, dependency.ModuleReference)
+		values = append(values, dependency.Value)
+	}
+	for _, identity := range usedIdentities.sortedIdentities {
+		if _, ok := configuredIdentitySet[identity]; ok {
+			continue
+		}
+		moduleReference, err := bufmoduleref.ModuleReferenceForString(identity)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		addedModuleReferences = append(addedModuleReferences, moduleReference)
+		values = append(values, moduleReference.String())
+	}
+	return keptModuleReferences, addedModuleReferences, values, nil
+}
+
+// getExternalImportPaths returns the sorted paths imported by the files of the
+// module that are neither files of the module nor well-known types.
+func getExternalImportPaths(ctx context.Context, module bufmodule.Module) ([]string, error) {
+	fileInfos, err := module.SourceFileInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	localPathSet := make(map[string]struct{}, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		localPathSet[fileInfo.Path()] = struct{}{}
+	}
+	importPathSet := make(map[string]struct{})
+	for _, fileInfo := range fileInfos {
+		importPaths, err := getImportPaths(ctx, module, fileInfo.Path())
+		if err != nil {
+			return nil, err
+		}
+		for _, importPath := range importPaths {
+			if _, ok := localPathSet[importPath]; ok {
+				continue
+			}
+			if datawkt.Exists(importPath) {
+				continue
+			}
+			importPathSet[importPath] = struct{}{}
+		}
+	}
+	importPaths := make([]string, 0, len(importPathSet))
+	for importPath := range importPathSet {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+	return importPaths, nil
+}
+
+// getImportPaths parses the import statements of the file, without compiling it.
+func getImportPaths(ctx context.Context, module bufmodule.Module, path string) (_ []string, retErr error) {
+	moduleFile, err := module.GetModuleFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, moduleFile.Close())
+	}()
+	fileNode, err := parser.Parse(moduleFile.ExternalPath(), moduleFile, reporter.NewHandler(nil))
+	if err != nil {
+		return nil, err
+	}
+	var importPaths []string
+	for _, decl := range fileNode.Decls {
+		if importNode, ok := decl.(*ast.ImportNode); ok {
+			importPaths = append(importPaths, importNode.Name.AsString())
+		}
+	}
+	return importPaths, nil
+}
+
+// getImportPathToIdentity returns a map from the paths of the files of the
+// pinned modules to the identity of the module that contains them.
+//
+// If several pinned modules contain the same path, configured dependencies are
+// preferred over transitive dependencies.
+func getImportPathToIdentity(
+	ctx context.Context,
+	moduleReader bufmodule.ModuleReader,
+	modulePins []bufmoduleref.ModulePin,
+	configuredIdentitySet map[string]struct{},
+) (map[string]string, error) {
+	importPathToIdentity := make(map[string]string)
+	for _, modulePin := range modulePins {
+		module, err := moduleReader.GetModule(ctx, modulePin)
+		if err != nil {
+			if storage.IsNotExist(err) {
+				return nil, fmt.Errorf("%s from %s does not exist", modulePin.String(), buflock.ExternalConfigFilePath)
+			}
+			return nil, err
+		}
+		fileInfos, err := module.SourceFileInfos(ctx)
+		if err != nil {
+			return nil, err
+		}
+		identity := modulePin.IdentityString()
+		_, configured := configuredIdentitySet[identity]
+		for _, fileInfo := range fileInfos {
+			if existingIdentity, ok := importPathToIdentity[fileInfo.Path()]; ok {
+				if _, existingConfigured := configuredIdentitySet[existingIdentity]; existingConfigured || !configured {
+					continue
+				}
+			}
+			importPathToIdentity[fileInfo.Path()] = identity
+		}
+	}
+	return importPathToIdentity, nil
+}
+
+// getWorkspaceImportPathToIdentity returns a map from the paths of the files of
+// the other modules of the workspace that contains the module in directoryPath
+// to the identity of the module that contains them, or to the empty string if
+// the module is not named.
+//
+// The workspace is the closest parent directory with a workspace configuration
+// file. Returns nil if this workspace does not list the module.
+func getWorkspaceImportPathToIdentity(
+	ctx context.Context,
+	storageosProvider storageos.Provider,
+	directoryPath string,
+) (map[string]string, error) {
+	absDirectoryPath, err := filepath.Abs(directoryPath)
+	if err != nil {
+		return nil, err
+	}
+	workspaceDirectoryPath := absDirectoryPath
+	for {
+		parentDirectoryPath := filepath.Dir(workspaceDirectoryPath)
+		if parentDirectoryPath == workspaceDirectoryPath {
+			return nil, nil
+		}
+		workspaceDirectoryPath = parentDirectoryPath
+		readBucket, err := storageosProvider.NewReadWriteBucket(
+			workspaceDirectoryPath,
+			storageos.ReadWriteBucketWithSymlinksIfSupported(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		workspaceConfigFilePath, err := bufwork.ExistingConfigFilePath(ctx, readBucket)
+		if err != nil {
+			return nil, err
+		}
+		if workspaceConfigFilePath == "" {
+			continue
+		}
+		relDirectoryPath, err := filepath.Rel(workspaceDirectoryPath, absDirectoryPath)
+		if err != nil {
+			return nil, err
+		}
+		return getWorkspaceImportPathToIdentityForBucket(ctx, readBucket, normalpath.Normalize(relDirectoryPath))
+	}
+}
+
+func getWorkspaceImportPathToIdentityForBucket(
+	ctx context.Context,
+	readBucket storage.ReadBucket,
+	directory string,
+) (map[string]string, error) {
+	workspaceConfig, err := bufwork.GetConfigForBucket(ctx, readBucket, ".")
+	if err != nil {
+		return nil, err
+	}
+	var isWorkspaceDirectory bool
+	for _, workspaceDirectory := range workspaceConfig.Directories {
+		if workspaceDirectory == directory {
+			isWorkspaceDirectory = true
+			break
+		}
+	}
+	if !isWorkspaceDirectory {
+		return nil, nil
+	}
+	workspaceModules, err := bufwork.GetWorkspaceModules(ctx, readBucket, ".", workspaceConfig)
+	if err != nil {
+		return nil, err
+	}
+	importPathToIdentity := make(map[string]string)
+	for _, workspaceModule := range workspaceModules {
+		if workspaceModule.Directory == directory {
+			continue
+		}
+		var identity string
+		if workspaceModule.Config.ModuleIdentity != nil {
+			identity = workspaceModule.Config.ModuleIdentity.IdentityString()
+		}
+		fileInfos, err := workspaceModule.Module.SourceFileInfos(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileInfo := range fileInfos {
+			importPathToIdentity[fileInfo.Path()] = identity
+		}
+	}
+	return importPathToIdentity, nil
+}
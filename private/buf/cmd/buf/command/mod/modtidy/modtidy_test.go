@@ -0,0 +1,148 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modtidy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUsedIdentities(t *testing.T) {
+	t.Parallel()
+	usedIdentities, unresolvedImportPaths := getUsedIdentities(
+		[]string{
+			"acme/units/v1/units.proto",
+			"acme/local/v1/local.proto",
+			"acme/sibling/v1/sibling.proto",
+			"acme/unknown/v1/unknown.proto",
+			"google/type/date.proto",
+		},
+		map[string]string{
+			"acme/local/v1/local.proto":     "",
+			"acme/sibling/v1/sibling.proto": "buf.build/acme/sibling",
+		},
+		map[string]string{
+			"acme/units/v1/units.proto":     "buf.build/acme/units",
+			"acme/sibling/v1/sibling.proto": "buf.build/acme/pinned-sibling",
+			"google/type/date.proto":        "buf.build/googleapis/googleapis",
+		},
+	)
+	assert.Equal(
+		t,
+		[]string{
+			"buf.build/acme/sibling",
+			"buf.build/acme/units",
+			"buf.build/googleapis/googleapis",
+		},
+		usedIdentities.sortedIdentities,
+	)
+	assert.Equal(t, []string{"acme/unknown/v1/unknown.proto"}, unresolvedImportPaths)
+}
+
+func TestTidyDependencies(t *testing.T) {
+	t.Parallel()
+	usedIdentities, _ := getUsedIdentities(
+		[]string{"a.proto", "b.proto"},
+		nil,
+		map[string]string{
+			"a.proto": "buf.example.com/acme/a",
+			"b.proto": "buf.build/acme/b",
+		},
+	)
+	keptModuleReferences, addedModuleReferences, values, err := tidyDependencies(
+		[]bufconfig.Dependency{
+			testNewDependency(t, "${REMOTE}/acme/a:v1", "buf.example.com/acme/a:v1"),
+			testNewDependency(t, "buf.build/acme/unused", "buf.build/acme/unused"),
+		},
+		usedIdentities,
+	)
+	require.NoError(t, err)
+	require.Len(t, keptModuleReferences, 1)
+	assert.Equal(t, "buf.example.com/acme/a:v1", keptModuleReferences[0].String())
+	require.Len(t, addedModuleReferences, 1)
+	assert.Equal(t, "buf.build/acme/b", addedModuleReferences[0].String())
+	// the kept dependency is written back as it was
+	assert.Equal(t, []string{"${REMOTE}/acme/a:v1", "buf.build/acme/b"}, values)
+}
+
+func TestGetWorkspaceImportPathToIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	workspaceDirPath := t.TempDir()
+	testWriteFiles(
+		t,
+		workspaceDirPath,
+		map[string]string{
+			"buf.work.yaml":             "version: v1\ndirectories:\n  - a\n  - b\n  - c\n",
+			"a/buf.yaml":                "version: v1\nname: buf.build/acme/a\n",
+			"a/a.proto":                 `syntax = "proto3"; import "b.proto"; import "c.proto";`,
+			"b/buf.yaml":                "version: v1\nname: buf.build/acme/b\n",
+			"b/b.proto":                 `syntax = "proto3";`,
+			"c/buf.yaml":                "version: v1\n",
+			"c/c.proto":                 `syntax = "proto3";`,
+			"other/buf.yaml":            "version: v1\n",
+			"other/nested/buf.yaml":     "version: v1\n",
+			"other/nested/nested.proto": `syntax = "proto3";`,
+		},
+	)
+	storageosProvider := storageos.NewProvider()
+	importPathToIdentity, err := getWorkspaceImportPathToIdentity(
+		ctx,
+		storageosProvider,
+		filepath.Join(workspaceDirPath, "a"),
+	)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		map[string]string{
+			"b.proto": "buf.build/acme/b",
+			"c.proto": "",
+		},
+		importPathToIdentity,
+	)
+	// not listed in the workspace
+	importPathToIdentity, err = getWorkspaceImportPathToIdentity(
+		ctx,
+		storageosProvider,
+		filepath.Join(workspaceDirPath, "other", "nested"),
+	)
+	require.NoError(t, err)
+	assert.Nil(t, importPathToIdentity)
+}
+
+func testNewDependency(t *testing.T, value string, moduleReferenceString string) bufconfig.Dependency {
+	moduleReference, err := bufmoduleref.ModuleReferenceForString(moduleReferenceString)
+	require.NoError(t, err)
+	return bufconfig.Dependency{
+		Value:           value,
+		ModuleReference: moduleReference,
+	}
+}
+
+func testWriteFiles(t *testing.T, dirPath string, pathToData map[string]string) {
+	for path, data := range pathToData {
+		filePath := filepath.Join(dirPath, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.NoError(t, os.WriteFile(filePath, []byte(data), 0600))
+	}
+}
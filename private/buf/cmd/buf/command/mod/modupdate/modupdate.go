@@ -22,6 +22,7 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufconnect"
 	"github.com/bufbuild/buf/private/bufpkg/buflock"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulelicense"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
 	modulev1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/module/v1alpha1"
@@ -37,7 +38,8 @@ import (
 )
 
 const (
-	onlyFlagName = "only"
+	onlyFlagName  = "only"
+	labelFlagName = "label"
 )
 
 // NewCommand returns a new update Command.
@@ -52,7 +54,8 @@ func NewCommand(
 		Long: "Fetch the latest digests for the specified references in the config file, " +
 			"and write them and their transitive dependencies to the " +
 			buflock.ExternalConfigFilePath +
-			` file. The first argument is the directory of the local module to update. Defaults to "." if no argument is specified.`,
+			` file. The first argument is the directory of the local module to update. Defaults to "." if no argument is specified. ` +
+			`If the config file has a license policy, the update fails when the license of a dependency violates it.`,
 		Args: cobra.MaximumNArgs(1),
 		Run: builder.NewRunFunc(
 			func(ctx context.Context, container appflag.Container) error {
@@ -65,7 +68,8 @@ func NewCommand(
 }
 
 type flags struct {
-	Only []string
+	Only  []string
+	Label string
 }
 
 func newFlags() *flags {
@@ -79,6 +83,12 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 		nil,
 		"The name of the dependency to update. When set, only this dependency is updated (along with any of its sub-dependencies). May be passed multiple times",
 	)
+	flagSet.StringVar(
+		&f.Label,
+		labelFlagName,
+		"",
+		`The label to resolve dependencies through, such as "prod". When set, each updated dependency is pinned to the commit the label points to in its repository, instead of the reference in the config file`,
+	)
 }
 
 // run update the buf.lock file for a specific module.
@@ -106,7 +116,7 @@ func run(
 	if existingConfigFilePath == "" {
 		return bufcli.ErrNoConfigFile
 	}
-	moduleConfig, err := bufconfig.GetConfigForBucket(ctx, readWriteBucket)
+	moduleConfig, err := bufconfig.ReadConfigOS(ctx, readWriteBucket)
 	if err != nil {
 		return err
 	}
@@ -168,6 +178,25 @@ func run(
 		}
 		return bufcli.NewInternalError(err)
 	}
+	// Before updating buf.lock file, verify that the licenses of the dependencies satisfy the license policy.
+	if moduleConfig.Licenses != nil && len(dependencyModulePins) > 0 {
+		clientConfig, err := bufcli.NewConnectClientConfig(container)
+		if err != nil {
+			return err
+		}
+		moduleReader, err := bufcli.NewModuleReaderAndCreateCacheDirs(container, clientConfig)
+		if err != nil {
+			return err
+		}
+		if err := bufmodulelicense.CheckDependencyModulePins(
+			ctx,
+			moduleReader,
+			moduleConfig.Licenses,
+			dependencyModulePins...,
+		); err != nil {
+			return err
+		}
+	}
 	if err := bufmoduleref.PutDependencyModulePinsToBucket(ctx, readWriteBucket, dependencyModulePins); err != nil {
 		return bufcli.NewInternalError(err)
 	}
@@ -190,7 +219,7 @@ func getDependencies(
 		return nil, err
 	}
 	service := connectclient.Make(clientConfig, remote, registryv1alpha1connect.NewResolveServiceClient)
-	var protoDependencyModuleReferences []*modulev1alpha1.ModuleReference
+	var dependencyModuleReferences []bufmoduleref.ModuleReference
 	var currentProtoModulePins []*modulev1alpha1.ModulePin
 	if len(flags.Only) > 0 {
 		referencesByIdentity := map[string]bufmoduleref.ModuleReference{}
@@ -202,7 +231,7 @@ func getDependencies(
 			if !ok {
 				return nil, fmt.Errorf("%q is not a valid --only input: no such dependency in current module deps", only)
 			}
-			protoDependencyModuleReferences = append(protoDependencyModuleReferences, bufmoduleref.NewProtoModuleReferenceForModuleReference(moduleReference))
+			dependencyModuleReferences = append(dependencyModuleReferences, moduleReference)
 		}
 		currentModulePins, err := bufmoduleref.DependencyModulePinsForBucket(ctx, readWriteBucket)
 		if err != nil {
@@ -210,10 +239,17 @@ func getDependencies(
 		}
 		currentProtoModulePins = bufmoduleref.NewProtoModulePinsForModulePins(currentModulePins...)
 	} else {
-		protoDependencyModuleReferences = bufmoduleref.NewProtoModuleReferencesForModuleReferences(
-			moduleConfig.Build.DependencyModuleReferences...,
-		)
+		dependencyModuleReferences = moduleConfig.Build.DependencyModuleReferences
 	}
+	if flags.Label != "" {
+		dependencyModuleReferences, err = moduleReferencesForLabel(dependencyModuleReferences, flags.Label)
+		if err != nil {
+			return nil, err
+		}
+	}
+	protoDependencyModuleReferences := bufmoduleref.NewProtoModuleReferencesForModuleReferences(
+		dependencyModuleReferences...,
+	)
 	resp, err := service.GetModulePins(
 		ctx,
 		connect.NewRequest(&registryv1alpha1.GetModulePinsRequest{
@@ -225,6 +261,9 @@ func getDependencies(
 		if connect.CodeOf(err) == connect.CodeUnimplemented && remote != bufconnect.DefaultRemote {
 			return nil, bufcli.NewUnimplementedRemoteError(err, remote, moduleConfig.ModuleIdentity.IdentityString())
 		}
+		if connect.CodeOf(err) == connect.CodeNotFound && flags.Label != "" {
+			return nil, fmt.Errorf("could not resolve dependencies through label %q, make sure every updated dependency has this label", flags.Label)
+		}
 		return nil, err
 	}
 	dependencyModulePins, err := bufmoduleref.NewModulePinsForProtos(resp.Msg.ModulePins...)
@@ -273,3 +312,25 @@ type pinnedRepository struct {
 	modulePin  bufmoduleref.ModulePin
 	repository *registryv1alpha1.Repository
 }
+
+// moduleReferencesForLabel returns copies of the given module references
+// that reference the given label instead of their configured reference.
+func moduleReferencesForLabel(
+	moduleReferences []bufmoduleref.ModuleReference,
+	label string,
+) ([]bufmoduleref.ModuleReference, error) {
+	labelModuleReferences := make([]bufmoduleref.ModuleReference, len(moduleReferences))
+	for i, moduleReference := range moduleReferences {
+		labelModuleReference, err := bufmoduleref.NewModuleReference(
+			moduleReference.Remote(),
+			moduleReference.Owner(),
+			moduleReference.Repository(),
+			label,
+		)
+		if err != nil {
+			return nil, appcmd.NewInvalidArgumentErrorf("invalid --%s: %s", labelFlagName, err.Error())
+		}
+		labelModuleReferences[i] = labelModuleReference
+	}
+	return labelModuleReferences, nil
+}
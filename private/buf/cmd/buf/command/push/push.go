@@ -15,9 +15,14 @@
 package push
 
 import (
+	"bytes"
 	"context"
+	"crypto"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
@@ -25,7 +30,9 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulesbom"
 	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	modulev1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/module/v1alpha1"
 	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
 	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/appflag"
@@ -36,14 +43,19 @@ import (
 	"github.com/bufbuild/connect-go"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"go.uber.org/zap"
 )
 
 const (
 	tagFlagName             = "tag"
 	tagFlagShortName        = "t"
+	tagMessageFlagName      = "tag-message"
 	draftFlagName           = "draft"
 	errorFormatFlagName     = "error-format"
 	disableSymlinksFlagName = "disable-symlinks"
+	sbomFlagName            = "sbom"
+	signingKeyFlagName      = "signing-key"
+	dryRunFlagName          = "dry-run"
 	// deprecated
 	trackFlagName = "track"
 )
@@ -71,9 +83,13 @@ func NewCommand(
 
 type flags struct {
 	Tags            []string
+	TagMessage      string
 	Draft           string
 	ErrorFormat     string
 	DisableSymlinks bool
+	SBOM            bool
+	SigningKey      string
+	DryRun          bool
 	// Deprecated
 	Tracks []string
 	// special
@@ -97,6 +113,16 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 			draftFlagName,
 		),
 	)
+	flagSet.StringVar(
+		&f.TagMessage,
+		tagMessageFlagName,
+		"",
+		fmt.Sprintf(
+			"Annotate the tags created for the pushed commit with this message. Requires --%s (-%s)",
+			tagFlagName,
+			tagFlagShortName,
+		),
+	)
 	flagSet.StringVar(
 		&f.Draft,
 		draftFlagName,
@@ -116,6 +142,24 @@ func (f *flags) Bind(flagSet *pflag.FlagSet) {
 			stringutil.SliceToString(bufanalysis.AllFormatStrings),
 		),
 	)
+	flagSet.BoolVar(
+		&f.SBOM,
+		sbomFlagName,
+		false,
+		"Attach a software bill of materials in the SPDX format to the pushed commit as an attestation. See buf beta sbom",
+	)
+	flagSet.StringVar(
+		&f.SigningKey,
+		signingKeyFlagName,
+		"",
+		"The path to a PEM-encoded ed25519 or ECDSA private key to sign the manifest of the pushed commit with. See buf beta verify",
+	)
+	flagSet.BoolVar(
+		&f.DryRun,
+		dryRunFlagName,
+		false,
+		"Build the module and have the registry validate the push, including authorization, breaking change policies, and size limits, without creating a commit. Prints the digest of the manifest of the module",
+	)
 	flagSet.StringSliceVar(
 		&f.Tracks,
 		trackFlagName,
@@ -139,6 +183,20 @@ func run(
 	if len(flags.Tags) > 0 && flags.Draft != "" {
 		return appcmd.NewInvalidArgumentErrorf("--%s (-%s) and --%s cannot be used together.", tagFlagName, tagFlagShortName, draftFlagName)
 	}
+	if flags.TagMessage != "" && len(flags.Tags) == 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s requires --%s (-%s).", tagMessageFlagName, tagFlagName, tagFlagShortName)
+	}
+	var signer crypto.Signer
+	if flags.SigningKey != "" {
+		privateKeyPEM, err := os.ReadFile(flags.SigningKey)
+		if err != nil {
+			return err
+		}
+		signer, err = bufmanifest.NewKeySigner(privateKeyPEM)
+		if err != nil {
+			return fmt.Errorf("--%s: %w", signingKeyFlagName, err)
+		}
+	}
 	source, err := bufcli.GetInputValue(container, flags.InputHashtag, ".")
 	if err != nil {
 		return err
@@ -163,11 +221,25 @@ func run(
 		ctx,
 		sourceBucket,
 		sourceConfig.Build,
+		bufmodulebuild.WithLookupEnv(os.LookupEnv),
 	)
 	if err != nil {
 		return err
 	}
-	modulePin, err := push(ctx, container, moduleIdentity, builtModule, flags)
+	var digestCache *manifest.FileDigestCache
+	if isLocalDirSource(source) {
+		// Reuse the digests of files that have not changed since the last push.
+		digestCache = bufcli.NewManifestDigestCache(container)
+		defer func() {
+			if err := digestCache.Save(); err != nil {
+				container.Logger().Debug("failed to save manifest digest cache", zap.Error(err))
+			}
+		}()
+	}
+	if flags.DryRun {
+		return dryRun(ctx, container, moduleIdentity, builtModule, digestCache, signer, flags)
+	}
+	modulePin, err := push(ctx, container, moduleIdentity, builtModule, digestCache, signer, flags)
 	if err != nil {
 		if connect.CodeOf(err) == connect.CodeAlreadyExists {
 			if _, err := container.Stderr().Write(
@@ -193,6 +265,8 @@ func push(
 	container appflag.Container,
 	moduleIdentity bufmoduleref.ModuleIdentity,
 	builtModule *bufmodulebuild.BuiltModule,
+	digestCache *manifest.FileDigestCache,
+	signer crypto.Signer,
 	flags *flags,
 ) (*registryv1alpha1.LocalModulePin, error) {
 	clientConfig, err := bufcli.NewConnectClientConfig(container)
@@ -205,26 +279,14 @@ func push(
 	if err != nil {
 		return nil, err
 	}
-	if tamperProofingEnabled {
-		m, blobSet, err := manifest.NewFromBucket(ctx, builtModule.Bucket)
-		if err != nil {
-			return nil, err
-		}
-		bucketManifest, blobs, err := bufmanifest.ToProtoManifestAndBlobs(ctx, m, blobSet)
+	// The pages of the docs directory, the SBOM, and the manifest signature can only be pushed as
+	// part of the manifest and blobs.
+	if tamperProofingEnabled || builtModule.Module.DocsDirectory() != "" || flags.SBOM || signer != nil {
+		request, err := newPushManifestAndBlobsRequest(ctx, container, clientConfig, moduleIdentity, builtModule, digestCache, signer, flags)
 		if err != nil {
 			return nil, err
 		}
-		resp, err := service.PushManifestAndBlobs(
-			ctx,
-			connect.NewRequest(&registryv1alpha1.PushManifestAndBlobsRequest{
-				Owner:      moduleIdentity.Owner(),
-				Repository: moduleIdentity.Repository(),
-				Manifest:   bucketManifest,
-				Blobs:      blobs,
-				Tags:       flags.Tags,
-				DraftName:  flags.Draft,
-			}),
-		)
+		resp, err := service.PushManifestAndBlobs(ctx, connect.NewRequest(request))
 		if err != nil {
 			return nil, err
 		}
@@ -242,6 +304,8 @@ func push(
 			Repository: moduleIdentity.Repository(),
 			Module:     protoModule,
 			Tags:       flags.Tags,
+			TagMessage: flags.TagMessage,
+			AtomicTags: len(flags.Tags) > 0,
 			DraftName:  flags.Draft,
 		}),
 	)
@@ -250,3 +314,160 @@ func push(
 	}
 	return resp.Msg.LocalModulePin, nil
 }
+
+// dryRun has the registry validate the push of the built module without
+// creating a commit, and prints the digest of the manifest of the module.
+//
+// Registries that do not support validating pushes only result in a warning,
+// as the module was still built.
+func dryRun(
+	ctx context.Context,
+	container appflag.Container,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	builtModule *bufmodulebuild.BuiltModule,
+	digestCache *manifest.FileDigestCache,
+	signer crypto.Signer,
+	flags *flags,
+) error {
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	request, err := newPushManifestAndBlobsRequest(ctx, container, clientConfig, moduleIdentity, builtModule, digestCache, signer, flags)
+	if err != nil {
+		return err
+	}
+	manifestDigest, err := bufmanifest.NewDigestFromProtoDigest(request.Manifest.Digest)
+	if err != nil {
+		return err
+	}
+	service := connectclient.Make(clientConfig, moduleIdentity.Remote(), registryv1alpha1connect.NewPushServiceClient)
+	if _, err := service.ValidatePushManifestAndBlobs(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.ValidatePushManifestAndBlobsRequest{
+			Push: request,
+		}),
+	); err != nil {
+		var message string
+		switch connect.CodeOf(err) {
+		case connect.CodeUnimplemented:
+			message = fmt.Sprintf("The registry %s does not support validating pushes; the module was built but the push was not validated.\n", moduleIdentity.Remote())
+		case connect.CodeAlreadyExists:
+			message = "The latest commit has the same content; a push would not create a new commit.\n"
+		default:
+			return err
+		}
+		if _, err := container.Stderr().Write([]byte(message)); err != nil {
+			return err
+		}
+	}
+	if _, err := container.Stdout().Write([]byte(manifestDigest.String() + "\n")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// newPushManifestAndBlobsRequest returns the request to push the built module
+// as a manifest and blobs.
+func newPushManifestAndBlobsRequest(
+	ctx context.Context,
+	container appflag.Container,
+	clientConfig *connectclient.Config,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	builtModule *bufmodulebuild.BuiltModule,
+	digestCache *manifest.FileDigestCache,
+	signer crypto.Signer,
+	flags *flags,
+) (*registryv1alpha1.PushManifestAndBlobsRequest, error) {
+	var fromBucketOptions []manifest.FromBucketOption
+	if digestCache != nil {
+		fromBucketOptions = append(fromBucketOptions, manifest.FromBucketWithDigestCache(digestCache))
+	}
+	m, blobSet, err := manifest.NewFromBucket(ctx, builtModule.Bucket, fromBucketOptions...)
+	if err != nil {
+		return nil, err
+	}
+	bucketManifest, blobs, err := bufmanifest.ToProtoManifestAndBlobs(ctx, m, blobSet)
+	if err != nil {
+		return nil, err
+	}
+	var manifestSignature []byte
+	if signer != nil {
+		envelope, err := bufmanifest.SignManifest(m, signer)
+		if err != nil {
+			return nil, err
+		}
+		manifestSignature, err = bufmanifest.MarshalEnvelope(envelope)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sbomAttestation *modulev1alpha1.Blob
+	if flags.SBOM {
+		sbomAttestation, err = getSBOMAttestation(ctx, container, clientConfig, moduleIdentity, builtModule)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &registryv1alpha1.PushManifestAndBlobsRequest{
+		Owner:             moduleIdentity.Owner(),
+		Repository:        moduleIdentity.Repository(),
+		Manifest:          bucketManifest,
+		Blobs:             blobs,
+		Tags:              flags.Tags,
+		TagMessage:        flags.TagMessage,
+		AtomicTags:        len(flags.Tags) > 0,
+		DraftName:         flags.Draft,
+		SbomAttestation:   sbomAttestation,
+		ManifestSignature: manifestSignature,
+	}, nil
+}
+
+// getSBOMAttestation returns the SPDX SBOM of the built module as a blob.
+func getSBOMAttestation(
+	ctx context.Context,
+	container appflag.Container,
+	clientConfig *connectclient.Config,
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	builtModule *bufmodulebuild.BuiltModule,
+) (*modulev1alpha1.Blob, error) {
+	moduleReader, err := bufcli.NewModuleReaderAndCreateCacheDirs(container, clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	sbom, err := bufmodulesbom.NewSBOM(
+		ctx,
+		moduleReader,
+		time.Now(),
+		&bufmodulesbom.Subject{
+			Module:         builtModule.Module,
+			ModuleIdentity: moduleIdentity,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	data, err := bufmodulesbom.Marshal(sbom, bufmodulesbom.FormatSPDX)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := manifest.NewMemoryBlobFromReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return bufmanifest.AsProtoBlob(ctx, blob)
+}
+
+// isLocalDirSource returns true if the source is a local directory.
+//
+// Only local directories are read via storageos buckets, whose external paths
+// are the local file paths needed by manifest.FileDigestCache. Directories
+// with extensions are excluded, as the extension may select another format,
+// for example a local git repository for .git.
+func isLocalDirSource(source string) bool {
+	if filepath.Ext(source) != "" {
+		return false
+	}
+	fileInfo, err := os.Stat(source)
+	return err == nil && fileInfo.IsDir()
+}
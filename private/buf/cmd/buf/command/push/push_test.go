@@ -16,8 +16,13 @@ package push
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -26,9 +31,11 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
 	"github.com/bufbuild/buf/private/buf/cmd/buf/internal/internaltesting"
@@ -83,6 +90,99 @@ func TestPushManifest(t *testing.T) {
 	)
 }
 
+func TestPushTags(t *testing.T) {
+	t.Parallel()
+	mock := newMockPushService(t)
+	mock.pushManifestResponse = &registryv1alpha1.PushManifestAndBlobsResponse{
+		LocalModulePin: &registryv1alpha1.LocalModulePin{},
+	}
+	server := createServer(t, mock)
+	err := appRun(
+		t,
+		map[string][]byte{
+			"buf.yaml":  bufYAML(t, server.URL, "owner", "repo"),
+			"foo.proto": nil,
+		},
+		true, // tamperProofingEnabled
+		"--tag",
+		"v1.0.0",
+		"--tag",
+		"stable",
+		"--tag-message",
+		"first stable release",
+	)
+	require.NoError(t, err)
+	request := mock.PushManifestRequest()
+	require.NotNil(t, request)
+	assert.Equal(t, []string{"v1.0.0", "stable"}, request.Tags)
+	assert.Equal(t, "first stable release", request.TagMessage)
+	assert.True(t, request.AtomicTags)
+}
+
+func TestPushSigningKey(t *testing.T) {
+	t.Parallel()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	privateKeyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	signingKeyFilePath := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(
+		t,
+		os.WriteFile(
+			signingKeyFilePath,
+			pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyDER}),
+			0600,
+		),
+	)
+	mock := newMockPushService(t)
+	mock.pushManifestResponse = &registryv1alpha1.PushManifestAndBlobsResponse{
+		LocalModulePin: &registryv1alpha1.LocalModulePin{},
+	}
+	server := createServer(t, mock)
+	err = appRun(
+		t,
+		map[string][]byte{
+			"buf.yaml":  bufYAML(t, server.URL, "owner", "repo"),
+			"foo.proto": nil,
+		},
+		false, // tamperProofingEnabled
+		"--signing-key",
+		signingKeyFilePath,
+	)
+	require.NoError(t, err)
+	// The manifest and blobs are pushed even with tamper proofing disabled.
+	request := mock.PushManifestRequest()
+	require.NotNil(t, request)
+	require.NotEmpty(t, request.ManifestSignature)
+	requestManifest, err := bufmanifest.NewManifestFromProto(context.Background(), request.Manifest)
+	require.NoError(t, err)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	verifier, err := bufmanifest.NewKeyVerifier(
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}),
+	)
+	require.NoError(t, err)
+	assert.NoError(t, bufmanifest.VerifyManifestSignature(requestManifest, request.ManifestSignature, verifier))
+}
+
+func TestPushTagMessageWithoutTags(t *testing.T) {
+	t.Parallel()
+	mock := newMockPushService(t)
+	server := createServer(t, mock)
+	err := appRun(
+		t,
+		map[string][]byte{
+			"buf.yaml":  bufYAML(t, server.URL, "owner", "repo"),
+			"foo.proto": nil,
+		},
+		false, // tamperProofingEnabled
+		"--tag-message",
+		"first stable release",
+	)
+	assert.ErrorContains(t, err, "--tag-message requires --tag")
+	assert.Nil(t, mock.PushRequest())
+}
+
 func TestPushManifestIsSmallerBucket(t *testing.T) {
 	// Assert push only manifests with only the files needed to build the
 	// module as described by configuration and file extension.
@@ -118,6 +218,126 @@ func TestPushManifestIsSmallerBucket(t *testing.T) {
 	assert.False(t, ok, "baz.file should not be pushed")
 }
 
+func TestPushDryRun(t *testing.T) {
+	t.Parallel()
+	mock := newMockPushService(t)
+	server := createServer(t, mock)
+	err := appRun(
+		t,
+		map[string][]byte{
+			"buf.yaml":  bufYAML(t, server.URL, "owner", "repo"),
+			"foo.proto": nil,
+		},
+		false, // tamperProofingEnabled
+		"--dry-run",
+		"--tag",
+		"v1.0.0",
+	)
+	require.NoError(t, err)
+	request := mock.ValidateRequest()
+	require.NotNil(t, request)
+	assert.Equal(t, "owner", request.GetPush().GetOwner())
+	assert.Equal(t, "repo", request.GetPush().GetRepository())
+	assert.Equal(t, []string{"v1.0.0"}, request.GetPush().GetTags())
+	// Nothing is pushed.
+	assert.Nil(t, mock.PushRequest())
+	assert.Nil(t, mock.PushManifestRequest())
+}
+
+func TestPushDryRunValidateError(t *testing.T) {
+	t.Parallel()
+	mock := newMockPushService(t)
+	mock.validateErr = connect_go.NewError(connect_go.CodeFailedPrecondition, errors.New("breaking changes"))
+	server := createServer(t, mock)
+	err := appRun(
+		t,
+		map[string][]byte{
+			"buf.yaml":  bufYAML(t, server.URL, "owner", "repo"),
+			"foo.proto": nil,
+		},
+		false, // tamperProofingEnabled
+		"--dry-run",
+	)
+	assert.ErrorContains(t, err, "breaking changes")
+	assert.Nil(t, mock.PushManifestRequest())
+}
+
+func TestPushDryRunValidateUnimplemented(t *testing.T) {
+	t.Parallel()
+	mock := newMockPushService(t)
+	mock.validateErr = connect_go.NewError(connect_go.CodeUnimplemented, errors.New("not implemented"))
+	server := createServer(t, mock)
+	err := appRun(
+		t,
+		map[string][]byte{
+			"buf.yaml":  bufYAML(t, server.URL, "owner", "repo"),
+			"foo.proto": nil,
+		},
+		false, // tamperProofingEnabled
+		"--dry-run",
+	)
+	assert.NoError(t, err)
+	assert.NotNil(t, mock.ValidateRequest())
+	assert.Nil(t, mock.PushManifestRequest())
+}
+
+func TestPushManifestDigestCache(t *testing.T) {
+	t.Parallel()
+	mock := newMockPushService(t)
+	mock.pushManifestResponse = &registryv1alpha1.PushManifestAndBlobsResponse{
+		LocalModulePin: &registryv1alpha1.LocalModulePin{},
+	}
+	server := createServer(t, mock)
+	const appName = "test"
+	env := amendEnv(
+		internaltesting.NewEnvFunc(t),
+		func(env map[string]string) map[string]string {
+			env["BUF_TOKEN"] = "invalid"
+			buftransport.SetDisableAPISubdomain(env)
+			injectConfig(t, appName, env)
+			env[bufcli.BetaEnableTamperProofingEnvKey] = "1"
+			return env
+		},
+	)(appName)
+	dirPath := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	writeFile := func(path string, content []byte) {
+		filePath := filepath.Join(dirPath, path)
+		require.NoError(t, os.WriteFile(filePath, content, 0600))
+		require.NoError(t, os.Chtimes(filePath, modTime, modTime))
+	}
+	push := func() *registryv1alpha1.PushManifestAndBlobsRequest {
+		require.NoError(
+			t,
+			appcmd.Run(
+				context.Background(),
+				app.NewContainer(env, nil, io.Discard, os.Stderr, appName, dirPath),
+				NewCommand(appName, appflag.NewBuilder(appName)),
+			),
+		)
+		return mock.PushManifestRequest()
+	}
+	writeFile("buf.yaml", bufYAML(t, server.URL, "owner", "repo"))
+	writeFile("foo.proto", []byte(`syntax = "proto3"; package foo;`))
+	push()
+	assert.FileExists(t, filepath.Join(env["TEST_CACHE_DIR"], "v1", "manifest", "digests.json"))
+	// Change the content without changing the size or modification time.
+	writeFile("foo.proto", []byte(`syntax = "proto3"; package bar;`))
+	request := push()
+	digester, err := manifest.NewDigester(manifest.DigestTypeShake256)
+	require.NoError(t, err)
+	var found bool
+	for _, blob := range request.Blobs {
+		expectedDigest, err := digester.Digest(bytes.NewReader(blob.Content))
+		require.NoError(t, err)
+		assert.Equal(t, expectedDigest.Hex(), hex.EncodeToString(blob.Digest.Digest))
+		if string(blob.Content) == `syntax = "proto3"; package bar;` {
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
+
 func TestBucketBlobs(t *testing.T) {
 	t.Parallel()
 	bucket, err := storagemem.NewReadBucket(
@@ -150,7 +370,7 @@ func TestBucketBlobs(t *testing.T) {
 type mockPushService struct {
 	t *testing.T
 
-	// protects pushRequest / pushManifestRequest
+	// protects pushRequest / pushManifestRequest / validateRequest
 	sync.RWMutex
 
 	// for testing with tamper proofing disabled
@@ -160,6 +380,10 @@ type mockPushService struct {
 	// for testing with tamper proofing enabled
 	pushManifestRequest  *registryv1alpha1.PushManifestAndBlobsRequest
 	pushManifestResponse *registryv1alpha1.PushManifestAndBlobsResponse
+
+	// for testing with --dry-run
+	validateRequest *registryv1alpha1.ValidatePushManifestAndBlobsRequest
+	validateErr     error
 }
 
 var _ registryv1alpha1connect.PushServiceHandler = (*mockPushService)(nil)
@@ -213,6 +437,26 @@ func (m *mockPushService) PushManifestRequest() *registryv1alpha1.PushManifestAn
 	return m.pushManifestRequest
 }
 
+func (m *mockPushService) ValidatePushManifestAndBlobs(
+	_ context.Context,
+	req *connect_go.Request[registryv1alpha1.ValidatePushManifestAndBlobsRequest],
+) (*connect_go.Response[registryv1alpha1.ValidatePushManifestAndBlobsResponse], error) {
+	m.Lock()
+	defer m.Unlock()
+	m.validateRequest = req.Msg
+	assert.NotNil(m.t, req.Msg.GetPush().GetManifest(), "missing manifest")
+	if m.validateErr != nil {
+		return nil, m.validateErr
+	}
+	return connect_go.NewResponse(&registryv1alpha1.ValidatePushManifestAndBlobsResponse{}), nil
+}
+
+func (m *mockPushService) ValidateRequest() *registryv1alpha1.ValidatePushManifestAndBlobsRequest {
+	m.RLock()
+	defer m.RUnlock()
+	return m.validateRequest
+}
+
 func createServer(t *testing.T, mock *mockPushService) *httptest.Server {
 	t.Helper()
 	mux := http.NewServeMux()
@@ -230,6 +474,7 @@ func appRun(
 	t *testing.T,
 	files map[string][]byte,
 	tamperProofingEnabled bool,
+	args ...string,
 ) error {
 	const appName = "test"
 	return appcmd.Run(
@@ -250,8 +495,13 @@ func appRun(
 			tarball(files),
 			os.Stdout,
 			os.Stderr,
-			appName,        // push ran as appName, aka "test"
-			"-#format=tar", // using stdin as a tar
+			append(
+				[]string{
+					appName,        // push ran as appName, aka "test"
+					"-#format=tar", // using stdin as a tar
+				},
+				args...,
+			)...,
 		),
 		NewCommand(
 			appName,
@@ -0,0 +1,155 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docdownload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	outputFlagName      = "output"
+	outputFlagShortName = "o"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository[:ref]>",
+		Short: "Download the documentation of a module",
+		Long: `Download the documentation of a module from the Buf Schema Registry.
+
+This writes the documentation file of the module, such as buf.md or README.md, and
+the pages of the docs directory configured in the buf.yaml of the module:
+
+    version: v1
+    name: buf.build/acme/weather
+    docs:
+      directory: docs
+
+Files are written to the output directory at the same paths as in the module.`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Output string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		".",
+		`The output directory for the documentation`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleReference, err := bufmoduleref.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	service := connectclient.Make(
+		clientConfig,
+		moduleReference.Remote(),
+		registryv1alpha1connect.NewDownloadServiceClient,
+	)
+	// The pages of the docs directory are only available as part of the manifest and blobs.
+	resp, err := service.DownloadManifestAndBlobs(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.DownloadManifestAndBlobsRequest{
+			Owner:      moduleReference.Owner(),
+			Repository: moduleReference.Repository(),
+			Reference:  moduleReference.Reference(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	if resp.Msg.Manifest == nil {
+		return errors.New("no manifest in response")
+	}
+	moduleManifest, err := bufmanifest.NewManifestFromProto(ctx, resp.Msg.Manifest)
+	if err != nil {
+		return err
+	}
+	blobSet, err := bufmanifest.NewBlobSetFromProto(ctx, resp.Msg.Blobs)
+	if err != nil {
+		return err
+	}
+	module, err := bufmodule.NewModuleForManifestAndBlobSet(ctx, moduleManifest, blobSet)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(flags.Output, 0755); err != nil {
+		return err
+	}
+	readWriteBucket, err := storageos.NewProvider().NewReadWriteBucket(flags.Output)
+	if err != nil {
+		return err
+	}
+	wroteDocs, err := bufmodule.ModuleDocsToBucket(ctx, module, readWriteBucket)
+	if err != nil {
+		return err
+	}
+	if !wroteDocs {
+		return fmt.Errorf("%s has no documentation", moduleReference.String())
+	}
+	return nil
+}
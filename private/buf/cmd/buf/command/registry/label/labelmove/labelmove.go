@@ -0,0 +1,164 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labelmove
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	fromFlagName   = "from"
+	formatFlagName = "format"
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository:reference> <label>",
+		Short: "Move an existing label to a specified commit",
+		Long: "Move a label, such as staging or prod, so that it points to the commit the reference resolves to. " +
+			"This is how a commit is promoted from one label to the next.",
+		Args: cobra.ExactArgs(2),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	From   string
+	Format string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.From,
+		fromFlagName,
+		"",
+		"The commit the label is expected to currently point to. If set, the label is only moved if it still points to this commit",
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleReference, err := bufmoduleref.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	label := container.Arg(1)
+	if label == "" {
+		return appcmd.NewInvalidArgumentError("label name is required")
+	}
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	repositoryCommitService := connectclient.Make(
+		clientConfig,
+		moduleReference.Remote(),
+		registryv1alpha1connect.NewRepositoryCommitServiceClient,
+	)
+	labelService := connectclient.Make(
+		clientConfig,
+		moduleReference.Remote(),
+		registryv1alpha1connect.NewLabelServiceClient,
+	)
+	commitResp, err := repositoryCommitService.GetRepositoryCommitByReference(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetRepositoryCommitByReferenceRequest{
+			RepositoryOwner: moduleReference.Owner(),
+			RepositoryName:  moduleReference.Repository(),
+			Reference:       moduleReference.Reference(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return bufcli.NewModuleReferenceNotFoundError(moduleReference)
+		}
+		return err
+	}
+	labelName := &registryv1alpha1.LabelName{
+		Namespace: registryv1alpha1.LabelNamespace_LABEL_NAMESPACE_BRANCH,
+		Name:      label,
+	}
+	labelValue := &registryv1alpha1.LabelValue{
+		CommitId: commitResp.Msg.RepositoryCommit.Name,
+	}
+	request := &registryv1alpha1.MoveLabelRequest{
+		LabelName: labelName,
+		To:        labelValue,
+	}
+	if flags.From != "" {
+		request.From = &registryv1alpha1.LabelValue{
+			CommitId: flags.From,
+		}
+	}
+	if _, err := labelService.MoveLabel(ctx, connect.NewRequest(request)); err != nil {
+		switch connect.CodeOf(err) {
+		case connect.CodeNotFound:
+			return bufcli.NewLabelNotFoundError(label)
+		case connect.CodeFailedPrecondition:
+			return fmt.Errorf("label %q does not point to commit %q", label, flags.From)
+		}
+		return err
+	}
+	return bufprint.NewLabelPrinter(container.Stdout()).PrintLabels(
+		ctx,
+		format,
+		&registryv1alpha1.Label{
+			LabelName:  labelName,
+			LabelValue: labelValue,
+		},
+	)
+}
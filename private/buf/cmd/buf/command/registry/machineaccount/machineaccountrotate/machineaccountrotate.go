@@ -0,0 +1,226 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machineaccountrotate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	noteFlagName         = "note"
+	expireAfterFlagName  = "expire-after"
+	keepPreviousFlagName = "keep-previous"
+	formatFlagName       = "format"
+
+	defaultNote = "Created by buf registry machine-account rotate"
+	// listTokensPageSize is the page size used to list the existing tokens
+	// of the machine account.
+	listTokensPageSize = 100
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/username>",
+		Short: "Rotate the token of a BSR machine account",
+		Long: `A new token is created for the machine account, and the tokens that the machine account had
+before the rotation are then deleted, unless --` + keepPreviousFlagName + ` is set. The previous tokens are only
+deleted once the new token was created.
+
+The text output is the new token only, so that it can be piped into a secret store. The JSON output
+also includes the IDs of the deleted tokens.`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Note         string
+	ExpireAfter  time.Duration
+	KeepPrevious bool
+	Format       string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Note,
+		noteFlagName,
+		defaultNote,
+		"The note of the new token",
+	)
+	flagSet.DurationVar(
+		&f.ExpireAfter,
+		expireAfterFlagName,
+		0,
+		`The duration after which the new token expires, for example "720h". The token does not expire if this is not set`,
+	)
+	flagSet.BoolVar(
+		&f.KeepPrevious,
+		keepPreviousFlagName,
+		false,
+		"Keep the tokens that the machine account had before the rotation",
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleOwner, err := bufmoduleref.ModuleOwnerForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if flags.ExpireAfter < 0 {
+		return appcmd.NewInvalidArgumentErrorf("--%s must not be negative", expireAfterFlagName)
+	}
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	userService := connectclient.Make(
+		clientConfig,
+		moduleOwner.Remote(),
+		registryv1alpha1connect.NewUserServiceClient,
+	)
+	userResp, err := userService.GetUserByUsername(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetUserByUsernameRequest{
+			Username: moduleOwner.Owner(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return bufcli.NewUserNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	user := userResp.Msg.User
+	if user.UserType != registryv1alpha1.UserType_USER_TYPE_MACHINE {
+		return appcmd.NewInvalidArgumentErrorf("%q is not a machine account", container.Arg(0))
+	}
+	tokenService := connectclient.Make(
+		clientConfig,
+		moduleOwner.Remote(),
+		registryv1alpha1connect.NewTokenServiceClient,
+	)
+	// The previous tokens are listed before the new token is created, so that
+	// the new token is never deleted.
+	previousTokens, err := listTokens(ctx, tokenService, user.Id)
+	if err != nil {
+		return err
+	}
+	createTokenRequest := &registryv1alpha1.CreateTokenRequest{
+		Note:   flags.Note,
+		UserId: user.Id,
+	}
+	if flags.ExpireAfter > 0 {
+		createTokenRequest.ExpireTime = timestamppb.New(time.Now().Add(flags.ExpireAfter))
+	}
+	createTokenResp, err := tokenService.CreateToken(ctx, connect.NewRequest(createTokenRequest))
+	if err != nil {
+		return err
+	}
+	var revokedTokenIDs []string
+	if !flags.KeepPrevious {
+		for _, previousToken := range previousTokens {
+			if _, err := tokenService.DeleteToken(
+				ctx,
+				connect.NewRequest(&registryv1alpha1.DeleteTokenRequest{
+					TokenId: previousToken.Id,
+				}),
+			); err != nil {
+				if connect.CodeOf(err) == connect.CodeNotFound {
+					// Already deleted or expired.
+					continue
+				}
+				return err
+			}
+			revokedTokenIDs = append(revokedTokenIDs, previousToken.Id)
+		}
+	}
+	return bufprint.NewMachineAccountTokenPrinter(
+		moduleOwner.Remote(),
+		container.Stdout(),
+	).PrintMachineAccountToken(ctx, format, user.Username, createTokenResp.Msg.Token, revokedTokenIDs)
+}
+
+// listTokens lists all the tokens of the user, following the pages.
+func listTokens(
+	ctx context.Context,
+	tokenService registryv1alpha1connect.TokenServiceClient,
+	userID string,
+) ([]*registryv1alpha1.Token, error) {
+	var tokens []*registryv1alpha1.Token
+	var pageToken string
+	for {
+		resp, err := tokenService.ListTokens(
+			ctx,
+			connect.NewRequest(&registryv1alpha1.ListTokensRequest{
+				PageSize:  listTokensPageSize,
+				PageToken: pageToken,
+				UserId:    userID,
+			}),
+		)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, resp.Msg.Tokens...)
+		pageToken = resp.Msg.NextPageToken
+		if pageToken == "" {
+			return tokens, nil
+		}
+	}
+}
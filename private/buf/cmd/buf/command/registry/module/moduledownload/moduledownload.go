@@ -0,0 +1,154 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moduledownload
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/multierr"
+)
+
+const (
+	outputFlagName      = "output"
+	outputFlagShortName = "o"
+	verifyFlagName      = "verify"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository[:ref]>",
+		Short: "Download the files of a module",
+		Long: `Download the manifest and files of a module from the Buf Schema Registry, and write the files
+to the output directory at the same paths as in the module.
+
+The content of every file is checked against its digest in the manifest. If --` + verifyFlagName + ` is set:
+
+- The module must contain exactly the files of the manifest.
+- The digest of the manifest must match the manifest digest recorded by the registry for the commit.
+- The manifest signature is verified if manifest_signature is set in the buf configuration.
+- The written files are read back and checked against their digests.`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Output string
+	Verify bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVarP(
+		&f.Output,
+		outputFlagName,
+		outputFlagShortName,
+		".",
+		`The output directory for the files of the module`,
+	)
+	flagSet.BoolVar(
+		&f.Verify,
+		verifyFlagName,
+		false,
+		`Verify the manifest of the module against the registry and the written files against the manifest`,
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleReference, err := bufmoduleref.ModuleReferenceForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	moduleManifest, blobSet, err := bufcli.DownloadModule(ctx, container, clientConfig, moduleReference, flags.Verify)
+	if err != nil {
+		return err
+	}
+	moduleBucket, err := manifest.NewBucket(*moduleManifest, *blobSet)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(flags.Output, 0755); err != nil {
+		return err
+	}
+	readWriteBucket, err := storageos.NewProvider().NewReadWriteBucket(flags.Output)
+	if err != nil {
+		return err
+	}
+	if _, err := storage.Copy(ctx, moduleBucket, readWriteBucket); err != nil {
+		return err
+	}
+	if flags.Verify {
+		return verifyFiles(ctx, readWriteBucket, moduleManifest)
+	}
+	return nil
+}
+
+// verifyFiles checks that the files of the manifest in the bucket match their digests.
+func verifyFiles(ctx context.Context, readBucket storage.ReadBucket, moduleManifest *manifest.Manifest) error {
+	return moduleManifest.Range(func(path string, digest manifest.Digest) (retErr error) {
+		digester, err := manifest.NewDigester(digest.Type())
+		if err != nil {
+			return err
+		}
+		readObjectCloser, err := readBucket.Get(ctx, path)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			retErr = multierr.Append(retErr, readObjectCloser.Close())
+		}()
+		fileDigest, err := digester.Digest(readObjectCloser)
+		if err != nil {
+			return err
+		}
+		if !fileDigest.Equal(digest) {
+			return fmt.Errorf("digest mismatch for written file %s - expected: %q, found: %q", path, digest.String(), fileDigest.String())
+		}
+		return nil
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrywhoami
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufconnect"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const formatFlagName = "format"
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <domain>",
+		Short: "Show the user or machine account that is authenticated with the Buf Schema Registry",
+		Long: `This prints the user or machine account that the token for the BSR belongs to, and where the token is read from.
+The token is read from the BUF_TOKEN environment variable, the configured credential helper, or your netrc file, in this order.
+The <domain> argument will default to buf.build if not specified.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Format string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	remote := bufconnect.DefaultRemote
+	if container.NumArgs() == 1 {
+		remote = container.Arg(0)
+	}
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	tokenSource, err := bufcli.GetTokenSource(container, remote)
+	if err != nil {
+		return err
+	}
+	if tokenSource == "" {
+		return fmt.Errorf("you are not logged in to %s. Run \"buf registry login\" or set BUF_TOKEN to authenticate", remote)
+	}
+	// The token is not prompted for, as this command reports on the
+	// token that is configured.
+	clientConfig, err := bufcli.NewConnectClientConfigWithoutPrompt(container)
+	if err != nil {
+		return err
+	}
+	service := connectclient.Make(
+		clientConfig,
+		remote,
+		registryv1alpha1connect.NewAuthnServiceClient,
+	)
+	resp, err := service.GetCurrentUser(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetCurrentUserRequest{}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeUnauthenticated {
+			return fmt.Errorf("the token for %s from the %s is not valid", remote, tokenSource)
+		}
+		return err
+	}
+	return bufprint.NewCurrentUserPrinter(
+		remote,
+		container.Stdout(),
+	).PrintCurrentUser(ctx, format, resp.Msg.User, tokenSource)
+}
@@ -0,0 +1,183 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repositoryaccessadd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	userFlagName           = "user"
+	machineAccountFlagName = "machine-account"
+	roleFlagName           = "role"
+)
+
+var roleFlagValueToRepositoryRole = map[string]registryv1alpha1.RepositoryRole{
+	"admin":         registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_ADMIN,
+	"write":         registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_WRITE,
+	"limited-write": registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_LIMITED_WRITE,
+	"read":          registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_READ,
+}
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build/owner/repository>",
+		Short: "Grant a role in a BSR repository to a user or machine account",
+		Long: `Exactly one of --user or --machine-account must be set. ` +
+			`If the user already has a role in the repository, the role is replaced.`,
+		Args: cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	User           string
+	MachineAccount string
+	Role           string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(
+		&f.User,
+		userFlagName,
+		"",
+		`The username of the user to grant the role to`,
+	)
+	flagSet.StringVar(
+		&f.MachineAccount,
+		machineAccountFlagName,
+		"",
+		`The username of the machine account to grant the role to`,
+	)
+	flagSet.StringVar(
+		&f.Role,
+		roleFlagName,
+		"",
+		`The role to grant. Must be one of "admin", "write", "limited-write", or "read"`,
+	)
+	_ = cobra.MarkFlagRequired(flagSet, roleFlagName)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString(container.Arg(0))
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+	if (flags.User == "") == (flags.MachineAccount == "") {
+		return appcmd.NewInvalidArgumentErrorf("exactly one of --%s or --%s must be set", userFlagName, machineAccountFlagName)
+	}
+	role, ok := roleFlagValueToRepositoryRole[strings.ToLower(flags.Role)]
+	if !ok {
+		return appcmd.NewInvalidArgumentErrorf("invalid --%s: %q", roleFlagName, flags.Role)
+	}
+
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	repositoryService := connectclient.Make(
+		clientConfig,
+		moduleIdentity.Remote(),
+		registryv1alpha1connect.NewRepositoryServiceClient,
+	)
+	userService := connectclient.Make(
+		clientConfig,
+		moduleIdentity.Remote(),
+		registryv1alpha1connect.NewUserServiceClient,
+	)
+	repositoryResp, err := repositoryService.GetRepositoryByFullName(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetRepositoryByFullNameRequest{
+			FullName: moduleIdentity.Owner() + "/" + moduleIdentity.Repository(),
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return bufcli.NewRepositoryNotFoundError(container.Arg(0))
+		}
+		return err
+	}
+	username := flags.User
+	if flags.MachineAccount != "" {
+		username = flags.MachineAccount
+	}
+	userResp, err := userService.GetUserByUsername(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.GetUserByUsernameRequest{
+			Username: username,
+		}),
+	)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return bufcli.NewUserNotFoundError(username)
+		}
+		return err
+	}
+	if flags.MachineAccount != "" && userResp.Msg.User.UserType != registryv1alpha1.UserType_USER_TYPE_MACHINE {
+		return appcmd.NewInvalidArgumentErrorf("%q is not a machine account", username)
+	}
+	if _, err := repositoryService.SetRepositoryContributor(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.SetRepositoryContributorRequest{
+			RepositoryId:   repositoryResp.Msg.Repository.Id,
+			UserId:         userResp.Msg.User.Id,
+			RepositoryRole: role,
+		}),
+	); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(
+		container.Stdout(),
+		"Granted role %s in %s to %s.\n",
+		strings.ToLower(flags.Role),
+		container.Arg(0),
+		username,
+	); err != nil {
+		return bufcli.NewInternalError(err)
+	}
+	return nil
+}
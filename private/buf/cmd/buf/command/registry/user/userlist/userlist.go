@@ -0,0 +1,186 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userlist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufprint"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/connectclient"
+	"github.com/bufbuild/connect-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	pageSizeFlagName  = "page-size"
+	pageTokenFlagName = "page-token"
+	reverseFlagName   = "reverse"
+	typeFlagName      = "type"
+	stateFlagName     = "state"
+	formatFlagName    = "format"
+)
+
+var (
+	userTypeFlagValueToUserType = map[string]registryv1alpha1.UserType{
+		"personal": registryv1alpha1.UserType_USER_TYPE_PERSONAL,
+		"machine":  registryv1alpha1.UserType_USER_TYPE_MACHINE,
+		"system":   registryv1alpha1.UserType_USER_TYPE_SYSTEM,
+	}
+	userStateFlagValueToUserState = map[string]registryv1alpha1.UserState{
+		"active":      registryv1alpha1.UserState_USER_STATE_ACTIVE,
+		"deactivated": registryv1alpha1.UserState_USER_STATE_DEACTIVATED,
+	}
+)
+
+// NewCommand returns a new Command
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <buf.build>",
+		Short: "List BSR users and machine accounts",
+		Long:  "Listing all users of the server requires the server admin role.",
+		Args:  cobra.ExactArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	PageSize  uint32
+	PageToken string
+	Reverse   bool
+	Types     []string
+	State     string
+	Format    string
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	flagSet.Uint32Var(&f.PageSize,
+		pageSizeFlagName,
+		10,
+		`The page size.`,
+	)
+	flagSet.StringVar(&f.PageToken,
+		pageTokenFlagName,
+		"",
+		`The page token. If more results are available, a "next_page" key is present in the --format=json output`,
+	)
+	flagSet.BoolVar(&f.Reverse,
+		reverseFlagName,
+		false,
+		`Reverse the results`,
+	)
+	flagSet.StringSliceVar(
+		&f.Types,
+		typeFlagName,
+		nil,
+		`Only list users of these types. Must be one of "personal", "machine", or "system". May be provided multiple times`,
+	)
+	flagSet.StringVar(
+		&f.State,
+		stateFlagName,
+		"",
+		`Only list users in this state. Must be one of "active" or "deactivated"`,
+	)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		bufprint.FormatText.String(),
+		fmt.Sprintf(`The output format to use. Must be one of %s`, bufprint.AllFormatsString),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	remote := container.Arg(0)
+	if err := bufmoduleref.ValidateRemoteNotEmpty(remote); err != nil {
+		return err
+	}
+	if err := bufmoduleref.ValidateRemoteHasNoPaths(remote); err != nil {
+		return err
+	}
+	userTypes := make([]registryv1alpha1.UserType, 0, len(flags.Types))
+	for _, typeFlagValue := range flags.Types {
+		userType, ok := userTypeFlagValueToUserType[strings.ToLower(typeFlagValue)]
+		if !ok {
+			return appcmd.NewInvalidArgumentErrorf("invalid --%s: %q", typeFlagName, typeFlagValue)
+		}
+		userTypes = append(userTypes, userType)
+	}
+	var userState registryv1alpha1.UserState
+	if flags.State != "" {
+		var ok bool
+		userState, ok = userStateFlagValueToUserState[strings.ToLower(flags.State)]
+		if !ok {
+			return appcmd.NewInvalidArgumentErrorf("invalid --%s: %q", stateFlagName, flags.State)
+		}
+	}
+	format, err := bufprint.ParseFormat(flags.Format)
+	if err != nil {
+		return appcmd.NewInvalidArgumentError(err.Error())
+	}
+
+	clientConfig, err := bufcli.NewConnectClientConfig(container)
+	if err != nil {
+		return err
+	}
+	service := connectclient.Make(
+		clientConfig,
+		remote,
+		registryv1alpha1connect.NewUserServiceClient,
+	)
+	resp, err := service.ListUsers(
+		ctx,
+		connect.NewRequest(&registryv1alpha1.ListUsersRequest{
+			PageSize:        flags.PageSize,
+			PageToken:       flags.PageToken,
+			Reverse:         flags.Reverse,
+			UserStateFilter: userState,
+			UserTypeFilters: userTypes,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	users, nextPageToken := resp.Msg.Users, resp.Msg.NextPageToken
+	return bufprint.NewUserPrinter(
+		remote,
+		container.Stdout(),
+	).PrintUsers(ctx, format, nextPageToken, users...)
+}
@@ -0,0 +1,175 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspacegraph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	formatFlagName          = "format"
+	disableSymlinksFlagName = "disable-symlinks"
+
+	formatText = "text"
+	formatDOT  = "dot"
+)
+
+var allFormats = []string{
+	formatText,
+	formatDOT,
+}
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <directory>",
+		Short: "Print the dependency graph of the modules in a workspace",
+		Long: fmt.Sprintf(
+			"Prints the dependencies between the modules in the %s of the given directory, "+
+				"as determined by the imports between them. Each module is identified by its directory. "+
+				"The first argument is the directory containing the %s. Defaults to \".\" if no argument is specified.",
+			bufwork.ExternalConfigV1FilePath,
+			bufwork.ExternalConfigV1FilePath,
+		),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Format          string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		formatText,
+		fmt.Sprintf(
+			"The format to print the graph as. Must be one of %s",
+			stringutil.SliceToString(allFormats),
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	dirPath := "."
+	if container.NumArgs() > 0 {
+		dirPath = container.Arg(0)
+	}
+	if flags.Format != formatText && flags.Format != formatDOT {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s: unknown format %q, must be one of %s",
+			formatFlagName,
+			flags.Format,
+			stringutil.SliceToString(allFormats),
+		)
+	}
+	workspaceModules, err := bufcli.WorkspaceModulesForDir(
+		ctx,
+		bufcli.NewStorageosProvider(flags.DisableSymlinks),
+		dirPath,
+	)
+	if err != nil {
+		return err
+	}
+	workspaceImports, err := bufwork.GetWorkspaceImports(ctx, workspaceModules)
+	if err != nil {
+		return err
+	}
+	graph := bufwork.GetWorkspaceGraph(workspaceImports)
+	directories := make([]string, 0, len(workspaceModules))
+	for _, workspaceModule := range workspaceModules {
+		directories = append(directories, workspaceModule.Directory)
+	}
+	if cycles := bufcli.WorkspaceModuleImportCycles(workspaceImports); len(cycles) > 0 {
+		descriptions := make([]string, len(cycles))
+		for i, cycle := range cycles {
+			descriptions[i] = cycle.Description()
+		}
+		return fmt.Errorf(
+			"workspace modules have a dependency cycle:\n%s",
+			strings.TrimSuffix(strings.Join(descriptions, "\n"), "\n"),
+		)
+	}
+	var builder strings.Builder
+	if flags.Format == formatDOT {
+		builder.WriteString("digraph {\n")
+	}
+	for _, directory := range directories {
+		dependencies := graph[directory]
+		if len(dependencies) == 0 {
+			writeNode(&builder, flags.Format, directory)
+			continue
+		}
+		for _, dependency := range dependencies {
+			writeEdge(&builder, flags.Format, directory, dependency)
+		}
+	}
+	if flags.Format == formatDOT {
+		builder.WriteString("}\n")
+	}
+	_, err = container.Stdout().Write([]byte(builder.String()))
+	return err
+}
+
+func writeNode(builder *strings.Builder, format string, directory string) {
+	switch format {
+	case formatText:
+		fmt.Fprintf(builder, "%s\n", normalpath.Unnormalize(directory))
+	case formatDOT:
+		fmt.Fprintf(builder, "  %q\n", normalpath.Unnormalize(directory))
+	}
+}
+
+func writeEdge(builder *strings.Builder, format string, from string, to string) {
+	switch format {
+	case formatText:
+		fmt.Fprintf(builder, "%s -> %s\n", normalpath.Unnormalize(from), normalpath.Unnormalize(to))
+	case formatDOT:
+		fmt.Fprintf(builder, "  %q -> %q\n", normalpath.Unnormalize(from), normalpath.Unnormalize(to))
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspacels
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	formatFlagName          = "format"
+	disableSymlinksFlagName = "disable-symlinks"
+
+	formatText = "text"
+	formatJSON = "json"
+)
+
+var allFormats = []string{
+	formatText,
+	formatJSON,
+}
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <directory>",
+		Short: "List the modules in a workspace",
+		Long: fmt.Sprintf(
+			"Lists the path and name of every module listed in the %s of the given directory. "+
+				"The first argument is the directory containing the %s. Defaults to \".\" if no argument is specified.",
+			bufwork.ExternalConfigV1FilePath,
+			bufwork.ExternalConfigV1FilePath,
+		),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	Format          string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.Format,
+		formatFlagName,
+		formatText,
+		fmt.Sprintf(
+			"The format to print modules as. Must be one of %s",
+			stringutil.SliceToString(allFormats),
+		),
+	)
+}
+
+type externalModule struct {
+	Path string `json:"path,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	dirPath := "."
+	if container.NumArgs() > 0 {
+		dirPath = container.Arg(0)
+	}
+	if flags.Format != formatText && flags.Format != formatJSON {
+		return appcmd.NewInvalidArgumentErrorf(
+			"--%s: unknown format %q, must be one of %s",
+			formatFlagName,
+			flags.Format,
+			stringutil.SliceToString(allFormats),
+		)
+	}
+	workspaceModules, err := bufcli.WorkspaceModulesForDir(
+		ctx,
+		bufcli.NewStorageosProvider(flags.DisableSymlinks),
+		dirPath,
+	)
+	if err != nil {
+		return err
+	}
+	for _, workspaceModule := range workspaceModules {
+		module := externalModule{
+			Path: normalpath.Unnormalize(workspaceModule.Directory),
+		}
+		if moduleIdentity := workspaceModule.Config.ModuleIdentity; moduleIdentity != nil {
+			module.Name = moduleIdentity.IdentityString()
+		}
+		switch flags.Format {
+		case formatText:
+			if module.Name == "" {
+				if _, err := fmt.Fprintln(container.Stdout(), module.Path); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(container.Stdout(), "%s\t%s\n", module.Path, module.Name); err != nil {
+				return err
+			}
+		case formatJSON:
+			data, err := json.Marshal(module)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(container.Stdout(), string(data)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
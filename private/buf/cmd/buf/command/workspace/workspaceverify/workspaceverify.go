@@ -0,0 +1,183 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspaceverify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/bufwork"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appflag"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	errorFormatFlagName     = "error-format"
+	disableSymlinksFlagName = "disable-symlinks"
+
+	undeclaredDependencyType = "UNDECLARED_DEPENDENCY"
+)
+
+// NewCommand returns a new Command.
+func NewCommand(
+	name string,
+	builder appflag.Builder,
+) *appcmd.Command {
+	flags := newFlags()
+	return &appcmd.Command{
+		Use:   name + " <directory>",
+		Short: "Verify that every import between workspace modules is declared",
+		Long: fmt.Sprintf(
+			"Verifies that every module in the %s of the given directory declares the modules "+
+				"it imports from within the workspace in its deps. Modules are built independently "+
+				"of the workspace when pushed, so these dependencies must be declared. "+
+				"The first argument is the directory containing the %s. Defaults to \".\" if no argument is specified.",
+			bufwork.ExternalConfigV1FilePath,
+			bufwork.ExternalConfigV1FilePath,
+		),
+		Args: cobra.MaximumNArgs(1),
+		Run: builder.NewRunFunc(
+			func(ctx context.Context, container appflag.Container) error {
+				return run(ctx, container, flags)
+			},
+			bufcli.NewErrorInterceptor(),
+		),
+		BindFlags: flags.Bind,
+	}
+}
+
+type flags struct {
+	ErrorFormat     string
+	DisableSymlinks bool
+}
+
+func newFlags() *flags {
+	return &flags{}
+}
+
+func (f *flags) Bind(flagSet *pflag.FlagSet) {
+	bufcli.BindDisableSymlinks(flagSet, &f.DisableSymlinks, disableSymlinksFlagName)
+	flagSet.StringVar(
+		&f.ErrorFormat,
+		errorFormatFlagName,
+		"text",
+		fmt.Sprintf(
+			"The format for errors printed to stdout. Must be one of %s",
+			stringutil.SliceToString(bufanalysis.AllFormatStrings),
+		),
+	)
+}
+
+func run(
+	ctx context.Context,
+	container appflag.Container,
+	flags *flags,
+) error {
+	if err := bufcli.ValidateErrorFormatFlag(flags.ErrorFormat, errorFormatFlagName); err != nil {
+		return err
+	}
+	dirPath := "."
+	if container.NumArgs() > 0 {
+		dirPath = container.Arg(0)
+	}
+	workspaceModules, err := bufcli.WorkspaceModulesForDir(
+		ctx,
+		bufcli.NewStorageosProvider(flags.DisableSymlinks),
+		dirPath,
+	)
+	if err != nil {
+		return err
+	}
+	workspaceImports, err := bufwork.GetWorkspaceImports(ctx, workspaceModules)
+	if err != nil {
+		return err
+	}
+	fileAnnotations := verifyWorkspaceImports(workspaceModules, workspaceImports)
+	if len(fileAnnotations) > 0 {
+		if err := bufanalysis.PrintFileAnnotations(
+			container.Stdout(),
+			bufanalysis.DeduplicateAndSortFileAnnotations(fileAnnotations),
+			flags.ErrorFormat,
+		); err != nil {
+			return err
+		}
+		return bufcli.ErrFileAnnotation
+	}
+	return nil
+}
+
+func verifyWorkspaceImports(
+	workspaceModules []*bufwork.WorkspaceModule,
+	workspaceImports []*bufwork.WorkspaceImport,
+) []bufanalysis.FileAnnotation {
+	directoryToWorkspaceModule := make(map[string]*bufwork.WorkspaceModule, len(workspaceModules))
+	for _, workspaceModule := range workspaceModules {
+		directoryToWorkspaceModule[workspaceModule.Directory] = workspaceModule
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, workspaceImport := range workspaceImports {
+		fromModule := directoryToWorkspaceModule[workspaceImport.FromDirectory]
+		toModule := directoryToWorkspaceModule[workspaceImport.ToDirectory]
+		var message string
+		if toModuleIdentity := toModule.Config.ModuleIdentity; toModuleIdentity == nil {
+			message = fmt.Sprintf(
+				`Import %q is provided by the module in directory %q, which has no name and cannot be declared as a dependency.`,
+				workspaceImport.ImportPath,
+				normalpath.Unnormalize(workspaceImport.ToDirectory),
+			)
+		} else if !isDependencyDeclared(fromModule, toModuleIdentity.IdentityString()) {
+			message = fmt.Sprintf(
+				`Import %q is provided by module %q in directory %q, which is not declared in the deps of the module in directory %q.`,
+				workspaceImport.ImportPath,
+				toModuleIdentity.IdentityString(),
+				normalpath.Unnormalize(workspaceImport.ToDirectory),
+				normalpath.Unnormalize(workspaceImport.FromDirectory),
+			)
+		} else {
+			continue
+		}
+		fileAnnotations = append(
+			fileAnnotations,
+			bufanalysis.NewFileAnnotation(
+				workspaceImport.FileInfo,
+				workspaceImport.Line,
+				workspaceImport.Column,
+				workspaceImport.Line,
+				workspaceImport.Column,
+				undeclaredDependencyType,
+				message,
+			),
+		)
+	}
+	return fileAnnotations
+}
+
+func isDependencyDeclared(workspaceModule *bufwork.WorkspaceModule, identityString string) bool {
+	if workspaceModule.Config.Build == nil {
+		return false
+	}
+	for _, moduleReference := range workspaceModule.Config.Build.DependencyModuleReferences {
+		if moduleReference.IdentityString() == identityString {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,2686 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buf
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/internal/internaltesting"
+	"github.com/bufbuild/buf/private/bufpkg/bufconnect"
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulecache"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/buftransport"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
+	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/webhook/v1alpha1/webhookv1alpha1connect"
+	modulev1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/module/v1alpha1"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	webhookv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/webhook/v1alpha1"
+	"github.com/bufbuild/buf/private/gen/proto/grpc/buf/alpha/registry/v1alpha1/registryv1alpha1grpc"
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd/appcmdtesting"
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRegistryLabel(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.commits["main"] = "commit1"
+	registry.commits["v2"] = "commit2"
+	repository := registry.remote + "/acme/weather"
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		`
+		Name  Commit
+		prod  commit1
+		`,
+		"registry",
+		"label",
+		"create",
+		repository+":main",
+		"prod",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		`[{"name":"staging","commit":"commit2"}]`,
+		"registry",
+		"label",
+		"create",
+		repository+":v2",
+		"staging",
+		"--format",
+		"json",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		`a label named "prod" already exists`,
+		"registry",
+		"label",
+		"create",
+		repository+":v2",
+		"prod",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"does not exist",
+		"registry",
+		"label",
+		"create",
+		repository+":unknown",
+		"dev",
+	)
+
+	// The label is only moved if it still points to the --from commit.
+	testRunStderrContainsRegistry(
+		t,
+		`label "prod" does not point to commit "commit2"`,
+		"registry",
+		"label",
+		"move",
+		repository+":v2",
+		"prod",
+		"--from",
+		"commit2",
+	)
+	assert.Equal(t, "commit1", registry.labels["prod"])
+	testRunStdoutRegistry(
+		t,
+		0,
+		`
+		Name  Commit
+		prod  commit2
+		`,
+		"registry",
+		"label",
+		"move",
+		repository+":v2",
+		"prod",
+		"--from",
+		"commit1",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		`a label named "dev" does not exist`,
+		"registry",
+		"label",
+		"move",
+		repository+":v2",
+		"dev",
+	)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		`
+		Name     Commit
+		prod     commit2
+		staging  commit2
+		`,
+		"registry",
+		"label",
+		"list",
+		repository,
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		``,
+		"registry",
+		"label",
+		"list",
+		repository,
+		"--commit",
+		"commit1",
+	)
+}
+
+func TestModUpdateLabel(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.commits["main"] = "commit1"
+	registry.labels["prod"] = "commit2"
+	dirPath := t.TempDir()
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(dirPath, "buf.yaml"),
+			[]byte(fmt.Sprintf("version: v1\nname: %[1]s/acme/app\ndeps:\n  - %[1]s/acme/weather\n", registry.remote)),
+			0600,
+		),
+	)
+
+	testRunStdoutRegistry(t, 0, ``, "mod", "update", dirPath)
+	data, err := os.ReadFile(filepath.Join(dirPath, "buf.lock"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "commit: commit1")
+
+	// The dependency is pinned to the commit of the label instead of its reference.
+	testRunStdoutRegistry(t, 0, ``, "mod", "update", dirPath, "--label", "prod")
+	data, err = os.ReadFile(filepath.Join(dirPath, "buf.lock"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "commit: commit2")
+
+	testRunStderrContainsRegistry(
+		t,
+		`could not resolve dependencies through label "staging"`,
+		"mod",
+		"update",
+		dirPath,
+		"--label",
+		"staging",
+	)
+}
+
+func TestModTidyResolveImportPaths(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.commits["main"] = "commit1"
+	registry.importPaths["acme/units/v1/units.proto"] = []string{"acme/units"}
+	registry.importPaths["acme/shared/v1/shared.proto"] = []string{"acme/shared", "acme/shared-fork"}
+	dirPath := t.TempDir()
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(dirPath, "buf.yaml"),
+			[]byte(fmt.Sprintf("version: v1\nname: %s/acme/app\n", registry.remote)),
+			0600,
+		),
+	)
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(dirPath, "app.proto"),
+			[]byte(`syntax = "proto3";
+package acme.app.v1;
+import "acme/units/v1/units.proto";
+import "acme/shared/v1/shared.proto";
+import "acme/unknown/v1/unknown.proto";
+`),
+			0600,
+		),
+	)
+
+	// Only the import that exactly one module contains is added as a dependency.
+	testRunStdoutRegistry(t, 0, ``, "mod", "tidy", dirPath)
+	data, err := os.ReadFile(filepath.Join(dirPath, "buf.yaml"))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		fmt.Sprintf("version: v1\nname: %[1]s/acme/app\ndeps:\n  - %[1]s/acme/units\n", registry.remote),
+		string(data),
+	)
+	data, err = os.ReadFile(filepath.Join(dirPath, "buf.lock"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "repository: units")
+	assert.Contains(t, string(data), "commit: commit1")
+}
+
+func TestRegistryUser(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.users["alice"] = &registryv1alpha1.User{
+		Id:         "user1",
+		Username:   "alice",
+		UserType:   registryv1alpha1.UserType_USER_TYPE_PERSONAL,
+		CreateTime: fakeRegistryCreateTime,
+		UpdateTime: fakeRegistryCreateTime,
+	}
+	registry.users["bob"] = &registryv1alpha1.User{
+		Id:          "user2",
+		Username:    "bob",
+		UserType:    registryv1alpha1.UserType_USER_TYPE_PERSONAL,
+		Deactivated: true,
+		CreateTime:  fakeRegistryCreateTime,
+		UpdateTime:  fakeRegistryCreateTime,
+	}
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		userTableRow(registry.remote+"/alice", "Full name", "Type      Deactivated  Created")+
+			userTableRow(registry.remote+"/alice", registry.remote+"/alice", "personal  false        2023-01-02T03:04:05Z"),
+		"registry",
+		"user",
+		"info",
+		registry.remote+"/alice",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`a user named "%s/carol" does not exist`, registry.remote),
+		"registry",
+		"user",
+		"info",
+		registry.remote+"/carol",
+	)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		userTableRow(registry.remote+"/alice", "Full name", "Type      Deactivated  Created")+
+			userTableRow(registry.remote+"/alice", registry.remote+"/alice", "personal  false        2023-01-02T03:04:05Z")+
+			userTableRow(registry.remote+"/alice", registry.remote+"/bob", "personal  true         2023-01-02T03:04:05Z"),
+		"registry",
+		"user",
+		"list",
+		registry.remote,
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(
+			`{"next_page":"1","results":[{"id":"user1","remote":"%[1]s","username":"alice","type":"personal","deactivated":false,"create_time":"2023-01-02T03:04:05Z","update_time":"2023-01-02T03:04:05Z"}]}`,
+			registry.remote,
+		),
+		"registry",
+		"user",
+		"list",
+		registry.remote,
+		"--page-size",
+		"1",
+		"--format",
+		"json",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(
+			`{"results":[{"id":"user2","remote":"%[1]s","username":"bob","type":"personal","deactivated":true,"create_time":"2023-01-02T03:04:05Z","update_time":"2023-01-02T03:04:05Z"}]}`,
+			registry.remote,
+		),
+		"registry",
+		"user",
+		"list",
+		registry.remote,
+		"--page-size",
+		"1",
+		"--page-token",
+		"1",
+		"--format",
+		"json",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		``,
+		"registry",
+		"user",
+		"list",
+		registry.remote,
+		"--type",
+		"machine",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		`invalid --type: "robot"`,
+		"registry",
+		"user",
+		"list",
+		registry.remote,
+		"--type",
+		"robot",
+	)
+
+	// Machine accounts are created without tokens.
+	testRunStdoutRegistry(
+		t,
+		0,
+		userTableRow(registry.remote+"/bot", "Full name", "Type     Deactivated  Created")+
+			userTableRow(registry.remote+"/bot", registry.remote+"/bot", "machine  false        2023-01-02T03:04:05Z"),
+		"registry",
+		"machine-account",
+		"create",
+		registry.remote+"/bot",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`a user named "%s/bot" already exists`, registry.remote),
+		"registry",
+		"machine-account",
+		"create",
+		registry.remote+"/bot",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		userTableRow(registry.remote+"/bot", "Full name", "Type     Deactivated  Created")+
+			userTableRow(registry.remote+"/bot", registry.remote+"/bot", "machine  false        2023-01-02T03:04:05Z"),
+		"registry",
+		"user",
+		"list",
+		registry.remote,
+		"--type",
+		"machine",
+		"--state",
+		"active",
+	)
+}
+
+func TestRegistryWhoami(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.users["bot"] = &registryv1alpha1.User{
+		Id:         "user1",
+		Username:   "bot",
+		UserType:   registryv1alpha1.UserType_USER_TYPE_MACHINE,
+		CreateTime: fakeRegistryCreateTime,
+		UpdateTime: fakeRegistryCreateTime,
+	}
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`the token for %s from the BUF_TOKEN environment variable is not valid`, registry.remote),
+		"registry",
+		"whoami",
+		registry.remote,
+	)
+
+	// The tests set BUF_TOKEN to "invalid".
+	registry.tokenUsernames["invalid"] = "bot"
+	testRunStdoutRegistry(
+		t,
+		0,
+		userTableRow(registry.remote+"/bot", "Full name", "Type     Deactivated  Token source")+
+			userTableRow(registry.remote+"/bot", registry.remote+"/bot", "machine  false        BUF_TOKEN environment variable"),
+		"registry",
+		"whoami",
+		registry.remote,
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(
+			`{"id":"user1","remote":"%[1]s","username":"bot","type":"machine","deactivated":false,"create_time":"2023-01-02T03:04:05Z","update_time":"2023-01-02T03:04:05Z","token_source":"BUF_TOKEN environment variable"}`,
+			registry.remote,
+		),
+		"registry",
+		"whoami",
+		registry.remote,
+		"--format",
+		"json",
+	)
+}
+
+func TestRegistryMachineAccountRotate(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.users["alice"] = &registryv1alpha1.User{
+		Id:       "user1",
+		Username: "alice",
+		UserType: registryv1alpha1.UserType_USER_TYPE_PERSONAL,
+	}
+	registry.users["bot"] = &registryv1alpha1.User{
+		Id:       "user2",
+		Username: "bot",
+		UserType: registryv1alpha1.UserType_USER_TYPE_MACHINE,
+	}
+	registry.tokens["token1"] = &fakeRegistryToken{userID: "user1"}
+	registry.tokens["token2"] = &fakeRegistryToken{userID: "user2"}
+
+	// The previous tokens of the machine account are deleted, and only the new
+	// token is printed.
+	testRunStdoutRegistry(
+		t,
+		0,
+		`secret-token3`,
+		"registry",
+		"machine-account",
+		"rotate",
+		registry.remote+"/bot",
+		"--note",
+		"ci",
+	)
+	assert.Equal(t, []string{"token1", "token3"}, registry.tokenIDs())
+	assert.Equal(t, "user2", registry.tokens["token3"].userID)
+	assert.Equal(t, "ci", registry.tokens["token3"].note)
+	assert.False(t, registry.tokens["token3"].expires)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(
+			`{"remote":"%s","username":"bot","token":"secret-token4","revoked_token_ids":[]}`,
+			registry.remote,
+		),
+		"registry",
+		"machine-account",
+		"rotate",
+		registry.remote+"/bot",
+		"--keep-previous",
+		"--expire-after",
+		"720h",
+		"--format",
+		"json",
+	)
+	assert.Equal(t, []string{"token1", "token3", "token4"}, registry.tokenIDs())
+	assert.True(t, registry.tokens["token4"].expires)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(
+			`{"remote":"%s","username":"bot","token":"secret-token5","revoked_token_ids":["token3","token4"]}`,
+			registry.remote,
+		),
+		"registry",
+		"machine-account",
+		"rotate",
+		registry.remote+"/bot",
+		"--format",
+		"json",
+	)
+	assert.Equal(t, []string{"token1", "token5"}, registry.tokenIDs())
+
+	// Personal users are never rotated.
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`"%s/alice" is not a machine account`, registry.remote),
+		"registry",
+		"machine-account",
+		"rotate",
+		registry.remote+"/alice",
+	)
+	assert.Equal(t, []string{"token1", "token5"}, registry.tokenIDs())
+}
+
+func TestRegistryModuleDownload(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	files := map[string][]byte{
+		"buf.yaml":  []byte("version: v1\n"),
+		"a/a.proto": []byte("syntax = \"proto3\";\n\npackage a;\n"),
+	}
+	registry.addModule(t, "v1", "commit1", files)
+	repository := registry.remote + "/acme/weather"
+
+	for _, verify := range []bool{false, true} {
+		outputDirPath := t.TempDir()
+		args := []string{"registry", "module", "download", repository + ":v1", "-o", outputDirPath}
+		if verify {
+			args = append(args, "--verify")
+		}
+		testRunStdoutRegistry(t, 0, ``, args...)
+		for path, content := range files {
+			data, err := os.ReadFile(filepath.Join(outputDirPath, filepath.FromSlash(path)))
+			require.NoError(t, err)
+			assert.Equal(t, string(content), string(data))
+		}
+	}
+	// The commit is also a reference.
+	testRunStdoutRegistry(t, 0, ``, "registry", "module", "download", repository+":commit1", "-o", t.TempDir(), "--verify")
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`"%s:v2" does not exist`, repository),
+		"registry",
+		"module",
+		"download",
+		repository+":v2",
+		"-o",
+		t.TempDir(),
+	)
+}
+
+func TestRegistryModuleDownloadDigestMismatch(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	files := map[string][]byte{
+		"buf.yaml":  []byte("version: v1\n"),
+		"a/a.proto": []byte("syntax = \"proto3\";\n\npackage a;\n"),
+	}
+	repository := registry.remote + "/acme/weather"
+
+	// A blob whose content does not match its digest is always rejected.
+	module := registry.addModule(t, "tampered-blob", "commit1", files)
+	module.blobs[0].Content = append(module.blobs[0].Content, []byte("// tampered\n")...)
+	for _, verify := range []bool{false, true} {
+		outputDirPath := t.TempDir()
+		args := []string{"registry", "module", "download", repository + ":tampered-blob", "-o", outputDirPath}
+		if verify {
+			args = append(args, "--verify")
+		}
+		testRunStderrContainsRegistry(t, "digest and content mismatch", args...)
+		assertEmptyDir(t, outputDirPath)
+	}
+
+	// A manifest that does not match the manifest digest of the commit is
+	// rejected with --verify.
+	module = registry.addModule(t, "tampered-manifest", "commit2", files)
+	module.manifestDigest = registry.addModule(t, "other", "commit3", map[string][]byte{"buf.yaml": []byte("version: v1\n")}).manifestDigest
+	outputDirPath := t.TempDir()
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`manifest digest mismatch for %s:tampered-manifest - expected: %q`, repository, module.manifestDigest),
+		"registry",
+		"module",
+		"download",
+		repository+":tampered-manifest",
+		"-o",
+		outputDirPath,
+		"--verify",
+	)
+	assertEmptyDir(t, outputDirPath)
+	testRunStdoutRegistry(t, 0, ``, "registry", "module", "download", repository+":tampered-manifest", "-o", t.TempDir())
+
+	// A module that is missing a blob of its manifest is rejected with --verify.
+	module = registry.addModule(t, "missing-blob", "commit4", files)
+	module.blobs = module.blobs[:1]
+	outputDirPath = t.TempDir()
+	testRunStderrContainsRegistry(
+		t,
+		"has no associated blob",
+		"registry",
+		"module",
+		"download",
+		repository+":missing-blob",
+		"-o",
+		outputDirPath,
+		"--verify",
+	)
+	assertEmptyDir(t, outputDirPath)
+}
+
+func TestBetaMirrorSync(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.addModule(
+		t,
+		"v1",
+		"commit1",
+		map[string][]byte{
+			"buf.yaml":  []byte("version: v1\n"),
+			"a/a.proto": []byte("syntax = \"proto3\";\n\npackage a;\n"),
+		},
+	)
+	registry.addModule(
+		t,
+		"main",
+		"commit2",
+		map[string][]byte{
+			"buf.yaml":  []byte("version: v1\n"),
+			"b/b.proto": []byte("syntax = \"proto3\";\n\npackage b;\n"),
+		},
+	)
+	repository := registry.remote + "/acme/weather"
+	mirrorDirPath := t.TempDir()
+	modulesFilePath := filepath.Join(t.TempDir(), "modules.txt")
+	require.NoError(
+		t,
+		os.WriteFile(
+			modulesFilePath,
+			[]byte("# the modules to mirror\n\n"+repository+":v1\n"+repository+"\n"),
+			0600,
+		),
+	)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(`
+		%[1]s:commit1	downloaded
+		%[1]s:commit2	downloaded
+		`, repository),
+		"beta",
+		"mirror",
+		"sync",
+		"--modules-file",
+		modulesFilePath,
+		"--dir",
+		mirrorDirPath,
+	)
+	assertMirroredModule(t, registry, mirrorDirPath, "commit1")
+	assertMirroredModule(t, registry, mirrorDirPath, "commit2")
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(`
+		%[1]s:commit1	up to date
+		%[1]s:commit2	up to date
+		`, repository),
+		"beta",
+		"mirror",
+		"sync",
+		"--modules-file",
+		modulesFilePath,
+		"--dir",
+		mirrorDirPath,
+	)
+
+	// The new commit of the branch is added to the mirror.
+	registry.addModule(
+		t,
+		"main",
+		"commit3",
+		map[string][]byte{
+			"buf.yaml":  []byte("version: v1\n"),
+			"c/c.proto": []byte("syntax = \"proto3\";\n\npackage c;\n"),
+		},
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(`
+		%[1]s:commit1	up to date
+		%[1]s:commit3	downloaded
+		`, repository),
+		"beta",
+		"mirror",
+		"sync",
+		"--modules-file",
+		modulesFilePath,
+		"--dir",
+		mirrorDirPath,
+	)
+	assertMirroredModule(t, registry, mirrorDirPath, "commit2")
+	assertMirroredModule(t, registry, mirrorDirPath, "commit3")
+
+	// Mirrored blobs that do not match their digests are downloaded again.
+	blobsDirPath := filepath.Join(
+		bufcli.CASModuleCacheDirPath(mirrorDirPath),
+		registry.remote,
+		"acme",
+		"weather",
+		"blobs",
+	)
+	require.NoError(
+		t,
+		filepath.WalkDir(
+			blobsDirPath,
+			func(path string, dirEntry fs.DirEntry, err error) error {
+				if err != nil || dirEntry.IsDir() {
+					return err
+				}
+				return os.WriteFile(path, []byte("tampered"), 0600)
+			},
+		),
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(`
+		%[1]s:commit1	downloaded
+		%[1]s:commit3	downloaded
+		`, repository),
+		"beta",
+		"mirror",
+		"sync",
+		"--modules-file",
+		modulesFilePath,
+		"--dir",
+		mirrorDirPath,
+	)
+	assertMirroredModule(t, registry, mirrorDirPath, "commit1")
+	assertMirroredModule(t, registry, mirrorDirPath, "commit3")
+}
+
+func TestBetaMirrorSyncDigestMismatch(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	files := map[string][]byte{
+		"buf.yaml":  []byte("version: v1\n"),
+		"a/a.proto": []byte("syntax = \"proto3\";\n\npackage a;\n"),
+	}
+	repository := registry.remote + "/acme/weather"
+	mirrorDirPath := t.TempDir()
+
+	module := registry.addModule(t, "tampered-blob", "commit1", files)
+	module.blobs[0].Content = append(module.blobs[0].Content, []byte("// tampered\n")...)
+	module = registry.addModule(t, "tampered-manifest", "commit2", files)
+	module.manifestDigest = registry.addModule(t, "other", "commit3", map[string][]byte{"buf.yaml": []byte("version: v1\n")}).manifestDigest
+
+	for reference, expectedStderr := range map[string]string{
+		"tampered-blob":     "digest and content mismatch",
+		"tampered-manifest": fmt.Sprintf(`manifest digest mismatch for %s:commit2 - expected: %q`, repository, module.manifestDigest),
+	} {
+		modulesFilePath := filepath.Join(t.TempDir(), "modules.txt")
+		require.NoError(t, os.WriteFile(modulesFilePath, []byte(repository+":"+reference+"\n"), 0600))
+		testRunStderrContainsRegistry(
+			t,
+			expectedStderr,
+			"beta",
+			"mirror",
+			"sync",
+			"--modules-file",
+			modulesFilePath,
+			"--dir",
+			mirrorDirPath,
+		)
+	}
+	// Nothing was added to the mirror.
+	assertEmptyDir(t, bufcli.CASModuleCacheDirPath(mirrorDirPath))
+}
+
+func TestBetaRegistryRepositoryList(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.organizations["acme"] = &registryv1alpha1.Organization{
+		Id:   "org1",
+		Name: "acme",
+	}
+	registry.users["alice"] = &registryv1alpha1.User{
+		Id:       "user1",
+		Username: "alice",
+	}
+	registry.repositories = []*registryv1alpha1.Repository{
+		{
+			Id:         "repo1",
+			Name:       "weather",
+			Owner:      &registryv1alpha1.Repository_OrganizationId{OrganizationId: "org1"},
+			Visibility: registryv1alpha1.Visibility_VISIBILITY_PUBLIC,
+			CreateTime: fakeRegistryCreateTime,
+		},
+		{
+			Id:         "repo2",
+			Name:       "payments",
+			Owner:      &registryv1alpha1.Repository_OrganizationId{OrganizationId: "org1"},
+			Visibility: registryv1alpha1.Visibility_VISIBILITY_PRIVATE,
+			Deprecated: true,
+			CreateTime: fakeRegistryCreateTime,
+		},
+		{
+			Id:         "repo3",
+			Name:       "notes",
+			Owner:      &registryv1alpha1.Repository_UserId{UserId: "user1"},
+			Visibility: registryv1alpha1.Visibility_VISIBILITY_PUBLIC,
+			CreateTime: fakeRegistryCreateTime,
+		},
+	}
+	registry.latestCommitTimes["repo1"] = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	registry.latestCommitTimes["repo2"] = time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	weather := fmt.Sprintf(`{"id":"repo1","remote":"%s","owner":"acme","name":"weather","create_time":"2023-01-02T03:04:05Z"}`, registry.remote)
+	payments := fmt.Sprintf(`{"id":"repo2","remote":"%s","owner":"acme","name":"payments","create_time":"2023-01-02T03:04:05Z"}`, registry.remote)
+	notes := fmt.Sprintf(`{"id":"repo3","remote":"%s","owner":"alice","name":"notes","create_time":"2023-01-02T03:04:05Z"}`, registry.remote)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{"results":[`+weather+`,`+payments+`,`+notes+`]}`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--format",
+		"json",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{"results":[`+weather+`,`+payments+`]}`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--owner",
+		"acme",
+		"--format",
+		"json",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{"results":[`+notes+`]}`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--owner",
+		"alice",
+		"--format",
+		"json",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`no user or organization named "bob" exists on %s`, registry.remote),
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--owner",
+		"bob",
+	)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{"results":[`+weather+`,`+notes+`]}`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--visibility",
+		"public",
+		"--format",
+		"json",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{"results":[`+payments+`]}`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--deprecated",
+		"true",
+		"--format",
+		"json",
+	)
+	// Repositories that were never pushed to are filtered out.
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{"results":[`+weather+`,`+payments+`]}`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--last-push-after",
+		"2023-02-01",
+		"--format",
+		"json",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{"results":[`+weather+`]}`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--last-push-after",
+		"2023-05-31T12:00:00Z",
+		"--format",
+		"json",
+	)
+	// The next page is kept when all the repositories of a page are filtered out.
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{"next_page":"2","results":[]}`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--deprecated",
+		"false",
+		"--page-size",
+		"1",
+		"--page-token",
+		"1",
+		"--format",
+		"json",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		``,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--deprecated",
+		"false",
+		"--page-size",
+		"1",
+		"--page-token",
+		"1",
+	)
+
+	testRunStderrContainsRegistry(
+		t,
+		`--deprecated must be "true" or "false": "yes"`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--deprecated",
+		"yes",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		`--last-push-after must be in RFC 3339 or YYYY-MM-DD format: "yesterday"`,
+		"beta",
+		"registry",
+		"repository",
+		"list",
+		registry.remote,
+		"--last-push-after",
+		"yesterday",
+	)
+}
+
+func TestRegistryRepositoryAccess(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.organizations["acme"] = &registryv1alpha1.Organization{
+		Id:   "org1",
+		Name: "acme",
+	}
+	registry.users["alice"] = &registryv1alpha1.User{
+		Id:       "user1",
+		Username: "alice",
+		UserType: registryv1alpha1.UserType_USER_TYPE_PERSONAL,
+	}
+	registry.users["bot"] = &registryv1alpha1.User{
+		Id:       "user2",
+		Username: "bot",
+		UserType: registryv1alpha1.UserType_USER_TYPE_MACHINE,
+	}
+	registry.users["carol"] = &registryv1alpha1.User{
+		Id:       "user3",
+		Username: "carol",
+		UserType: registryv1alpha1.UserType_USER_TYPE_PERSONAL,
+	}
+	registry.repositories = []*registryv1alpha1.Repository{
+		{
+			Id:    "repo1",
+			Name:  "weather",
+			Owner: &registryv1alpha1.Repository_OrganizationId{OrganizationId: "org1"},
+		},
+	}
+	// carol has a role through the organization that owns the repository.
+	registry.implicitRepositoryRoles["user3"] = registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_READ
+	repository := registry.remote + "/acme/weather"
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf("Granted role write in %s to alice.", repository),
+		"registry",
+		"repository",
+		"access",
+		"add",
+		repository,
+		"--user",
+		"alice",
+		"--role",
+		"write",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf("Granted role limited-write in %s to bot.", repository),
+		"registry",
+		"repository",
+		"access",
+		"add",
+		repository,
+		"--machine-account",
+		"bot",
+		"--role",
+		"limited-write",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		userTableRow(registry.remote+"/alice", "Full name", "Type      Role           Implicit role")+
+			userTableRow(registry.remote+"/alice", registry.remote+"/alice", "personal  write          -")+
+			userTableRow(registry.remote+"/alice", registry.remote+"/bot", "machine   limited-write  -")+
+			userTableRow(registry.remote+"/alice", registry.remote+"/carol", "personal  -              read"),
+		"registry",
+		"repository",
+		"access",
+		"list",
+		repository,
+	)
+
+	// The role of a user that already has a role is replaced.
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf("Granted role admin in %s to alice.", repository),
+		"registry",
+		"repository",
+		"access",
+		"add",
+		repository,
+		"--user",
+		"alice",
+		"--role",
+		"ADMIN",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf("Revoked the role of bot in %s.", repository),
+		"registry",
+		"repository",
+		"access",
+		"remove",
+		repository,
+		"--machine-account",
+		"bot",
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		fmt.Sprintf(
+			`{"repository":"%[1]s","contributors":[`+
+				`{"user_id":"user1","remote":"%[2]s","username":"alice","type":"personal","role":"admin"},`+
+				`{"user_id":"user3","remote":"%[2]s","username":"carol","type":"personal","implicit_role":"read"}`+
+				`]}`,
+			repository,
+			registry.remote,
+		),
+		"registry",
+		"repository",
+		"access",
+		"list",
+		repository,
+		"--format",
+		"json",
+	)
+
+	testRunStderrContainsRegistry(
+		t,
+		`"alice" is not a machine account`,
+		"registry",
+		"repository",
+		"access",
+		"add",
+		repository,
+		"--machine-account",
+		"alice",
+		"--role",
+		"read",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		`"alice" is not a machine account`,
+		"registry",
+		"repository",
+		"access",
+		"remove",
+		repository,
+		"--machine-account",
+		"alice",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"exactly one of --user or --machine-account must be set",
+		"registry",
+		"repository",
+		"access",
+		"add",
+		repository,
+		"--user",
+		"alice",
+		"--machine-account",
+		"bot",
+		"--role",
+		"read",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		`invalid --role: "owner"`,
+		"registry",
+		"repository",
+		"access",
+		"add",
+		repository,
+		"--user",
+		"alice",
+		"--role",
+		"owner",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		`a user named "dave" does not exist`,
+		"registry",
+		"repository",
+		"access",
+		"add",
+		repository,
+		"--user",
+		"dave",
+		"--role",
+		"read",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`a repository named "%s/acme/unknown" does not exist`, registry.remote),
+		"registry",
+		"repository",
+		"access",
+		"list",
+		registry.remote+"/acme/unknown",
+	)
+}
+
+func TestRegistryWebhookGRPC(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	// The grpc-go stubs are served by the Connect handlers of the registry,
+	// which support the gRPC protocol over HTTP/2.
+	mux := http.NewServeMux()
+	mux.Handle(registryv1alpha1connect.NewWebhookServiceHandler(registry))
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(server.Certificate())
+	clientConn, err := grpc.Dial(
+		strings.TrimPrefix(server.URL, "https://"),
+		grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(certPool, "")),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, clientConn.Close()) })
+
+	request := &registryv1alpha1.CreateWebhookRequest{
+		WebhookEvent:   registryv1alpha1.WebhookEvent_WEBHOOK_EVENT_REPOSITORY_PUSH,
+		OwnerName:      "acme",
+		RepositoryName: "weather",
+		CallbackUrl:    "https://example.com/webhook",
+	}
+	response, err := registryv1alpha1grpc.NewWebhookServiceClient(clientConn).CreateWebhook(
+		context.Background(),
+		request,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "1", response.Webhook.WebhookId)
+	require.Len(t, registry.createWebhookRequests, 1)
+	assert.True(t, proto.Equal(request, registry.createWebhookRequests[0]))
+
+	_, err = registryv1alpha1grpc.NewWebhookServiceClient(clientConn).DeleteWebhook(
+		context.Background(),
+		&registryv1alpha1.DeleteWebhookRequest{
+			WebhookId: "1",
+		},
+	)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestBetaRegistryWebhookCreateRetryPolicy(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{
+			"webhook_id": "1"
+		}`,
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+		)...,
+	)
+	require.Len(t, registry.createWebhookRequests, 1)
+	assert.True(
+		t,
+		proto.Equal(
+			&registryv1alpha1.CreateWebhookRequest{
+				WebhookEvent:   registryv1alpha1.WebhookEvent_WEBHOOK_EVENT_REPOSITORY_PUSH,
+				OwnerName:      "acme",
+				RepositoryName: "weather",
+				CallbackUrl:    "https://example.com/webhook",
+			},
+			registry.createWebhookRequests[0],
+		),
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{
+			"webhook_id": "2"
+		}`,
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--max-attempts",
+			"5",
+			"--initial-backoff",
+			"1s",
+			"--max-backoff",
+			"1m",
+			"--attempt-timeout",
+			"10s",
+			"--failure-notification-email",
+			"oncall@example.com",
+		)...,
+	)
+	require.Len(t, registry.createWebhookRequests, 2)
+	assert.True(
+		t,
+		proto.Equal(
+			&registryv1alpha1.WebhookRetryPolicy{
+				MaxAttempts:              5,
+				InitialBackoff:           durationpb.New(time.Second),
+				MaxBackoff:               durationpb.New(time.Minute),
+				AttemptTimeout:           durationpb.New(10 * time.Second),
+				FailureNotificationEmail: "oncall@example.com",
+			},
+			registry.createWebhookRequests[1].RetryPolicy,
+		),
+	)
+
+	testRunStderrContainsRegistry(
+		t,
+		"--initial-backoff must not be negative",
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--initial-backoff",
+			"-1s",
+		)...,
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"--max-backoff must not be less than --initial-backoff",
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--initial-backoff",
+			"1m",
+			"--max-backoff",
+			"1s",
+		)...,
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"--failure-notification-email is not a valid email address",
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--failure-notification-email",
+			"oncall",
+		)...,
+	)
+	assert.Len(t, registry.createWebhookRequests, 2)
+}
+
+func TestBetaRegistryWebhookCreatePayload(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{
+			"webhook_id": "1"
+		}`,
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--payload-version",
+			"WEBHOOK_PAYLOAD_VERSION_V1ALPHA1",
+			"--payload-field",
+			"repository_commit.name",
+			"--payload-field",
+			"event_time",
+		)...,
+	)
+	require.Len(t, registry.createWebhookRequests, 1)
+	assert.Equal(
+		t,
+		registryv1alpha1.WebhookPayloadVersion_WEBHOOK_PAYLOAD_VERSION_V1ALPHA1,
+		registry.createWebhookRequests[0].PayloadVersion,
+	)
+	assert.Equal(
+		t,
+		[]string{"repository_commit.name", "event_time"},
+		registry.createWebhookRequests[0].PayloadFieldMask.GetPaths(),
+	)
+
+	testRunStderrContainsRegistry(
+		t,
+		`unknown --payload-version "WEBHOOK_PAYLOAD_VERSION_V2"`,
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--payload-version",
+			"WEBHOOK_PAYLOAD_VERSION_V2",
+		)...,
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"invalid --payload-field",
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--payload-field",
+			"repository_commit.unknown",
+		)...,
+	)
+	assert.Len(t, registry.createWebhookRequests, 1)
+}
+
+func TestBetaRegistryWebhookCreateDeliveryFormat(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{
+			"webhook_id": "1"
+		}`,
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--delivery-format",
+			"WEBHOOK_DELIVERY_FORMAT_CLOUDEVENTS_JSON",
+		)...,
+	)
+	require.Len(t, registry.createWebhookRequests, 1)
+	assert.Equal(
+		t,
+		registryv1alpha1.WebhookDeliveryFormat_WEBHOOK_DELIVERY_FORMAT_CLOUDEVENTS_JSON,
+		registry.createWebhookRequests[0].DeliveryFormat,
+	)
+
+	testRunStderrContainsRegistry(
+		t,
+		`unknown --delivery-format "CLOUDEVENTS"`,
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--delivery-format",
+			"CLOUDEVENTS",
+		)...,
+	)
+	assert.Len(t, registry.createWebhookRequests, 1)
+}
+
+func TestBetaRegistryWebhookCreateSink(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{
+			"webhook_id": "1"
+		}`,
+		webhookCreateArgs(
+			registry,
+			"--sqs-queue-url",
+			"https://sqs.us-east-1.amazonaws.com/123456789012/buf-events",
+			"--sqs-role-arn",
+			"arn:aws:iam::123456789012:role/buf-events",
+		)...,
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{
+			"webhook_id": "2"
+		}`,
+		webhookCreateArgs(
+			registry,
+			"--pubsub-topic",
+			"projects/acme/topics/buf-events",
+		)...,
+	)
+	testRunStdoutRegistry(
+		t,
+		0,
+		`{
+			"webhook_id": "3"
+		}`,
+		webhookCreateArgs(
+			registry,
+			"--kafka-topic",
+			"buf-events",
+			"--kafka-bootstrap-server",
+			"kafka1.example.com:9092",
+			"--kafka-bootstrap-server",
+			"kafka2.example.com:9092",
+		)...,
+	)
+	require.Len(t, registry.createWebhookRequests, 3)
+	for i, expectedSink := range []*registryv1alpha1.WebhookSink{
+		{
+			Sink: &registryv1alpha1.WebhookSink_AmazonSqs{
+				AmazonSqs: &registryv1alpha1.WebhookAmazonSqsSink{
+					QueueUrl: "https://sqs.us-east-1.amazonaws.com/123456789012/buf-events",
+					RoleArn:  "arn:aws:iam::123456789012:role/buf-events",
+				},
+			},
+		},
+		{
+			Sink: &registryv1alpha1.WebhookSink_GooglePubSub{
+				GooglePubSub: &registryv1alpha1.WebhookGooglePubSubSink{
+					Topic: "projects/acme/topics/buf-events",
+				},
+			},
+		},
+		{
+			Sink: &registryv1alpha1.WebhookSink_Kafka{
+				Kafka: &registryv1alpha1.WebhookKafkaSink{
+					BootstrapServers: []string{"kafka1.example.com:9092", "kafka2.example.com:9092"},
+					Topic:            "buf-events",
+				},
+			},
+		},
+	} {
+		assert.True(t, proto.Equal(expectedSink, registry.createWebhookRequests[i].Sink))
+		assert.Empty(t, registry.createWebhookRequests[i].CallbackUrl)
+	}
+
+	testRunStderrContainsRegistry(
+		t,
+		"either --callback-url or a sink must be set",
+		webhookCreateArgs(registry)...,
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"--callback-url cannot be set with a sink",
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--pubsub-topic",
+			"projects/acme/topics/buf-events",
+		)...,
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"only one sink can be set",
+		webhookCreateArgs(
+			registry,
+			"--pubsub-topic",
+			"projects/acme/topics/buf-events",
+			"--kafka-topic",
+			"buf-events",
+			"--kafka-bootstrap-server",
+			"kafka1.example.com:9092",
+		)...,
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"--sqs-role-arn requires --sqs-queue-url",
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--sqs-role-arn",
+			"arn:aws:iam::123456789012:role/buf-events",
+		)...,
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"--kafka-topic requires --kafka-bootstrap-server",
+		webhookCreateArgs(
+			registry,
+			"--kafka-topic",
+			"buf-events",
+		)...,
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"--kafka-bootstrap-server requires --kafka-topic",
+		webhookCreateArgs(
+			registry,
+			"--callback-url",
+			"https://example.com/webhook",
+			"--kafka-bootstrap-server",
+			"kafka1.example.com:9092",
+		)...,
+	)
+	assert.Len(t, registry.createWebhookRequests, 3)
+}
+
+func TestBetaEventsTail(t *testing.T) {
+	t.Parallel()
+	registry := newFakeRegistry(t)
+	registry.repositoryEvents["acme/weather"] = []*webhookv1alpha1.RepositoryEvent{
+		newFakeRegistryRepositoryPushEvent("event1", "commit1", "alice", time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)),
+		newFakeRegistryRepositoryPushEvent("event2", "commit2", "", time.Date(2023, 1, 2, 3, 5, 5, 0, time.UTC)),
+		newFakeRegistryRepositoryPushEvent("event3", "commit3", "bob", time.Date(2023, 1, 2, 3, 6, 5, 0, time.UTC)),
+	}
+	repository := registry.remote + "/acme/weather"
+	expectedStdout := `
+		2023-01-02T03:04:05Z  WEBHOOK_EVENT_REPOSITORY_PUSH  acme/weather:commit1  alice
+		2023-01-02T03:05:05Z  WEBHOOK_EVENT_REPOSITORY_PUSH  acme/weather:commit2  -
+		2023-01-02T03:06:05Z  WEBHOOK_EVENT_REPOSITORY_PUSH  acme/weather:commit3  bob
+	`
+
+	// The fake registry returns the events in pages of two, and fails the
+	// poll once it has no more events, which ends the command.
+	testRunStdoutRegistry(
+		t,
+		1,
+		expectedStdout,
+		"beta",
+		"events",
+		"tail",
+		repository,
+		"--wait-timeout",
+		"1s",
+	)
+	require.Len(t, registry.pollRepositoryEventsRequests, 3)
+	for i, expectedCursor := range []string{"", "2", "3"} {
+		request := registry.pollRepositoryEventsRequests[i]
+		assert.Equal(t, "acme", request.OwnerName)
+		assert.Equal(t, "weather", request.RepositoryName)
+		assert.Equal(t, expectedCursor, request.Cursor)
+		assert.Equal(t, time.Second, request.WaitTimeout.AsDuration())
+		assert.Empty(t, request.Events)
+	}
+
+	stdout := bytes.NewBuffer(nil)
+	appcmdtesting.RunCommandExitCode(
+		t,
+		func(use string) *appcmd.Command { return NewRootCommand(use) },
+		1,
+		newRegistryEnvFunc(t),
+		nil,
+		stdout,
+		nil,
+		"beta",
+		"events",
+		"tail",
+		repository,
+		"--format",
+		"json",
+		"--event",
+		"WEBHOOK_EVENT_REPOSITORY_PUSH",
+	)
+	lines := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	for i, line := range lines {
+		event := &webhookv1alpha1.RepositoryEvent{}
+		require.NoError(t, protojson.Unmarshal([]byte(line), event))
+		assert.True(t, proto.Equal(registry.repositoryEvents["acme/weather"][i], event), line)
+	}
+	require.Len(t, registry.pollRepositoryEventsRequests, 6)
+	for _, request := range registry.pollRepositoryEventsRequests[3:] {
+		assert.Equal(t, 30*time.Second, request.WaitTimeout.AsDuration())
+		assert.Equal(
+			t,
+			[]registryv1alpha1.WebhookEvent{registryv1alpha1.WebhookEvent_WEBHOOK_EVENT_REPOSITORY_PUSH},
+			request.Events,
+		)
+	}
+
+	// Tailing ends without an error when the command is interrupted.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	registry.onRepositoryEventsExhausted = cancel
+	stdout = bytes.NewBuffer(nil)
+	require.NoError(
+		t,
+		appcmd.Run(
+			ctx,
+			app.NewContainer(
+				newRegistryEnvFunc(t)("test"),
+				nil,
+				stdout,
+				io.Discard,
+				"test",
+				"beta",
+				"events",
+				"tail",
+				repository,
+			),
+			NewRootCommand("test"),
+		),
+	)
+	assert.Equal(t, stringutil.TrimLines(expectedStdout), stringutil.TrimLines(stdout.String()))
+
+	testRunStderrContainsRegistry(
+		t,
+		fmt.Sprintf(`a repository named "%s/acme/unknown" does not exist`, registry.remote),
+		"beta",
+		"events",
+		"tail",
+		registry.remote+"/acme/unknown",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		"--wait-timeout must be positive",
+		"beta",
+		"events",
+		"tail",
+		repository,
+		"--wait-timeout",
+		"0s",
+	)
+	testRunStderrContainsRegistry(
+		t,
+		`unknown --event "WEBHOOK_EVENT_UNSPECIFIED"`,
+		"beta",
+		"events",
+		"tail",
+		repository,
+		"--event",
+		"WEBHOOK_EVENT_UNSPECIFIED",
+	)
+	assert.Len(t, registry.pollRepositoryEventsRequests, 10)
+}
+
+// fakeRegistry is a registry server backed by in-memory state.
+//
+// The maps are protected by the embedded mutex while the server is running.
+type fakeRegistry struct {
+	registryv1alpha1connect.UnimplementedRepositoryCommitServiceHandler
+	registryv1alpha1connect.UnimplementedLabelServiceHandler
+	registryv1alpha1connect.UnimplementedResolveServiceHandler
+	registryv1alpha1connect.UnimplementedRepositoryServiceHandler
+	registryv1alpha1connect.UnimplementedUserServiceHandler
+	registryv1alpha1connect.UnimplementedAdminServiceHandler
+	registryv1alpha1connect.UnimplementedTokenServiceHandler
+	registryv1alpha1connect.UnimplementedDownloadServiceHandler
+	registryv1alpha1connect.UnimplementedAuthnServiceHandler
+	registryv1alpha1connect.UnimplementedOrganizationServiceHandler
+	registryv1alpha1connect.UnimplementedWebhookServiceHandler
+	webhookv1alpha1connect.UnimplementedRepositoryEventServiceHandler
+
+	sync.Mutex
+
+	// remote is the remote of the server, such as "127.0.0.1:1234".
+	remote string
+	// commits maps the references of the repositories to commit names.
+	commits map[string]string
+	// labels maps the label names to commit names.
+	labels map[string]string
+	// users maps the usernames to users.
+	users map[string]*registryv1alpha1.User
+	// tokenUsernames maps the values of the tokens that are authenticated to
+	// the usernames of their users.
+	tokenUsernames map[string]string
+	// tokens maps the token IDs to tokens.
+	tokens map[string]*fakeRegistryToken
+	// nextTokenID is the number used by the next created token.
+	nextTokenID int
+	// modules maps the commit names to the modules of the commits.
+	modules map[string]*fakeRegistryModule
+	// importPaths maps the import paths to the full names of the repositories
+	// that contain a file at the import path.
+	importPaths map[string][]string
+	// organizations maps the organization names to organizations.
+	organizations map[string]*registryv1alpha1.Organization
+	// repositories are the listed repositories, in order.
+	repositories []*registryv1alpha1.Repository
+	// latestCommitTimes maps the repository IDs to the times of the latest
+	// commits of the repositories.
+	latestCommitTimes map[string]time.Time
+	// repositoryRoles maps the repository IDs to maps of the user IDs to the
+	// roles the users were granted in the repositories.
+	repositoryRoles map[string]map[string]registryv1alpha1.RepositoryRole
+	// implicitRepositoryRoles maps the user IDs to the roles the users have in
+	// all repositories through the organizations that own them.
+	implicitRepositoryRoles map[string]registryv1alpha1.RepositoryRole
+	// createWebhookRequests are the requests to create webhooks, in order.
+	// The IDs of the webhooks are their indexes plus one.
+	createWebhookRequests []*registryv1alpha1.CreateWebhookRequest
+	// repositoryEvents maps the full names of the repositories to the events of
+	// the repositories, in order. The cursors are the indexes of the next events.
+	repositoryEvents map[string][]*webhookv1alpha1.RepositoryEvent
+	// pollRepositoryEventsRequests are the requests to poll events, in order.
+	pollRepositoryEventsRequests []*webhookv1alpha1.PollRepositoryEventsRequest
+	// onRepositoryEventsExhausted is called when a poll has no more events.
+	// If it is nil, such a poll fails, otherwise the poll waits for the
+	// events until it is canceled or its wait timeout elapses.
+	onRepositoryEventsExhausted func()
+}
+
+// fakeRegistryCreateTime is the create time of the users created by the fakeRegistry.
+var fakeRegistryCreateTime = timestamppb.New(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+
+type fakeRegistryModule struct {
+	manifest       *modulev1alpha1.Blob
+	blobs          []*modulev1alpha1.Blob
+	manifestDigest string
+}
+
+type fakeRegistryToken struct {
+	userID  string
+	note    string
+	expires bool
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	registry := &fakeRegistry{
+		commits:                 make(map[string]string),
+		labels:                  make(map[string]string),
+		users:                   make(map[string]*registryv1alpha1.User),
+		tokenUsernames:          make(map[string]string),
+		tokens:                  make(map[string]*fakeRegistryToken),
+		modules:                 make(map[string]*fakeRegistryModule),
+		importPaths:             make(map[string][]string),
+		organizations:           make(map[string]*registryv1alpha1.Organization),
+		latestCommitTimes:       make(map[string]time.Time),
+		repositoryRoles:         make(map[string]map[string]registryv1alpha1.RepositoryRole),
+		implicitRepositoryRoles: make(map[string]registryv1alpha1.RepositoryRole),
+		repositoryEvents:        make(map[string][]*webhookv1alpha1.RepositoryEvent),
+	}
+	mux := http.NewServeMux()
+	mux.Handle(registryv1alpha1connect.NewRepositoryCommitServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewLabelServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewResolveServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewRepositoryServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewUserServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewAdminServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewTokenServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewDownloadServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewAuthnServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewOrganizationServiceHandler(registry))
+	mux.Handle(registryv1alpha1connect.NewWebhookServiceHandler(registry))
+	mux.Handle(webhookv1alpha1connect.NewRepositoryEventServiceHandler(registry))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	registry.remote = serverURL.Host
+	return registry
+}
+
+func (r *fakeRegistry) GetRepositoryCommitByReference(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetRepositoryCommitByReferenceRequest],
+) (*connect.Response[registryv1alpha1.GetRepositoryCommitByReferenceResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	commit, ok := r.commits[req.Msg.Reference]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reference %q not found", req.Msg.Reference))
+	}
+	repositoryCommit := &registryv1alpha1.RepositoryCommit{
+		Id:         commit,
+		Name:       commit,
+		CreateTime: fakeRegistryCreateTime,
+	}
+	if module, ok := r.modules[commit]; ok {
+		repositoryCommit.ManifestDigest = module.manifestDigest
+	}
+	return connect.NewResponse(&registryv1alpha1.GetRepositoryCommitByReferenceResponse{
+		RepositoryCommit: repositoryCommit,
+	}), nil
+}
+
+// addModule adds the module of the files as the commit, referenced by the
+// reference and the commit. The returned module can be modified until the
+// next request.
+func (r *fakeRegistry) addModule(
+	t *testing.T,
+	reference string,
+	commit string,
+	files map[string][]byte,
+) *fakeRegistryModule {
+	ctx := context.Background()
+	readBucket, err := storagemem.NewReadBucket(files)
+	require.NoError(t, err)
+	moduleManifest, blobSet, err := manifest.NewFromBucket(ctx, readBucket)
+	require.NoError(t, err)
+	manifestBlob, blobs, err := bufmanifest.ToProtoManifestAndBlobs(ctx, moduleManifest, blobSet)
+	require.NoError(t, err)
+	manifestDigest, err := bufmanifest.NewDigestFromProtoDigest(manifestBlob.Digest)
+	require.NoError(t, err)
+	module := &fakeRegistryModule{
+		manifest:       manifestBlob,
+		blobs:          blobs,
+		manifestDigest: manifestDigest.String(),
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.commits[reference] = commit
+	r.commits[commit] = commit
+	r.modules[commit] = module
+	return module
+}
+
+func (r *fakeRegistry) DownloadManifestAndBlobs(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.DownloadManifestAndBlobsRequest],
+) (*connect.Response[registryv1alpha1.DownloadManifestAndBlobsResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	module, ok := r.modules[r.commits[req.Msg.Reference]]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reference %q not found", req.Msg.Reference))
+	}
+	return connect.NewResponse(&registryv1alpha1.DownloadManifestAndBlobsResponse{
+		Manifest: module.manifest,
+		Blobs:    module.blobs,
+	}), nil
+}
+
+func (r *fakeRegistry) CreateLabel(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.CreateLabelRequest],
+) (*connect.Response[registryv1alpha1.CreateLabelResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	name := req.Msg.LabelName.GetName()
+	if _, ok := r.labels[name]; ok {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("label already exists"))
+	}
+	r.labels[name] = req.Msg.LabelValue.GetCommitId()
+	return connect.NewResponse(&registryv1alpha1.CreateLabelResponse{
+		CommitId: req.Msg.LabelValue,
+	}), nil
+}
+
+func (r *fakeRegistry) MoveLabel(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.MoveLabelRequest],
+) (*connect.Response[registryv1alpha1.MoveLabelResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	name := req.Msg.LabelName.GetName()
+	commit, ok := r.labels[name]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("label not found"))
+	}
+	if req.Msg.From != nil && req.Msg.From.CommitId != commit {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("label moved"))
+	}
+	r.labels[name] = req.Msg.To.GetCommitId()
+	return connect.NewResponse(&registryv1alpha1.MoveLabelResponse{}), nil
+}
+
+func (r *fakeRegistry) GetLabels(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetLabelsRequest],
+) (*connect.Response[registryv1alpha1.GetLabelsResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	var labels []*registryv1alpha1.Label
+	for name, commit := range r.labels {
+		if req.Msg.LabelValue != nil && req.Msg.LabelValue.CommitId != commit {
+			continue
+		}
+		labels = append(
+			labels,
+			&registryv1alpha1.Label{
+				LabelName: &registryv1alpha1.LabelName{
+					Namespace: registryv1alpha1.LabelNamespace_LABEL_NAMESPACE_BRANCH,
+					Name:      name,
+				},
+				LabelValue: &registryv1alpha1.LabelValue{
+					CommitId: commit,
+				},
+			},
+		)
+	}
+	sort.Slice(labels, func(i int, j int) bool {
+		return labels[i].LabelName.Name < labels[j].LabelName.Name
+	})
+	return connect.NewResponse(&registryv1alpha1.GetLabelsResponse{
+		Labels: labels,
+	}), nil
+}
+
+// GetModulePins resolves the references through the labels first, and then
+// through the commits.
+func (r *fakeRegistry) GetModulePins(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetModulePinsRequest],
+) (*connect.Response[registryv1alpha1.GetModulePinsResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	modulePins := make([]*modulev1alpha1.ModulePin, len(req.Msg.ModuleReferences))
+	for i, moduleReference := range req.Msg.ModuleReferences {
+		commit, ok := r.labels[moduleReference.Reference]
+		if !ok {
+			commit, ok = r.commits[moduleReference.Reference]
+		}
+		if !ok {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reference %q not found", moduleReference.Reference))
+		}
+		modulePins[i] = &modulev1alpha1.ModulePin{
+			Remote:     moduleReference.Remote,
+			Owner:      moduleReference.Owner,
+			Repository: moduleReference.Repository,
+			Commit:     commit,
+		}
+	}
+	return connect.NewResponse(&registryv1alpha1.GetModulePinsResponse{
+		ModulePins: modulePins,
+	}), nil
+}
+
+func (r *fakeRegistry) ResolveImportPaths(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.ResolveImportPathsRequest],
+) (*connect.Response[registryv1alpha1.ResolveImportPathsResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	importPathResolutions := make([]*registryv1alpha1.ImportPathResolution, len(req.Msg.ImportPaths))
+	for i, importPath := range req.Msg.ImportPaths {
+		var moduleReferences []*modulev1alpha1.ModuleReference
+		for _, fullName := range r.importPaths[importPath] {
+			owner, repository, _ := strings.Cut(fullName, "/")
+			moduleReferences = append(
+				moduleReferences,
+				&modulev1alpha1.ModuleReference{
+					Remote:     r.remote,
+					Owner:      owner,
+					Repository: repository,
+				},
+			)
+		}
+		importPathResolutions[i] = &registryv1alpha1.ImportPathResolution{
+			ImportPath:       importPath,
+			ModuleReferences: moduleReferences,
+		}
+	}
+	return connect.NewResponse(&registryv1alpha1.ResolveImportPathsResponse{
+		ImportPathResolutions: importPathResolutions,
+	}), nil
+}
+
+func (r *fakeRegistry) GetRepositoriesByFullName(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetRepositoriesByFullNameRequest],
+) (*connect.Response[registryv1alpha1.GetRepositoriesByFullNameResponse], error) {
+	repositories := make([]*registryv1alpha1.Repository, len(req.Msg.FullNames))
+	for i, fullName := range req.Msg.FullNames {
+		owner, name, _ := strings.Cut(fullName, "/")
+		repositories[i] = &registryv1alpha1.Repository{
+			Name:  name,
+			Owner: &registryv1alpha1.Repository_OrganizationId{OrganizationId: owner},
+		}
+	}
+	return connect.NewResponse(&registryv1alpha1.GetRepositoriesByFullNameResponse{
+		Repositories: repositories,
+	}), nil
+}
+
+func (r *fakeRegistry) ListRepositories(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.ListRepositoriesRequest],
+) (*connect.Response[registryv1alpha1.ListRepositoriesResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	repositories, nextPageToken, err := fakeRegistryRepositoriesPage(
+		r.repositories,
+		req.Msg.PageSize,
+		req.Msg.PageToken,
+		req.Msg.Reverse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&registryv1alpha1.ListRepositoriesResponse{
+		Repositories:  repositories,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (r *fakeRegistry) ListUserRepositories(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.ListUserRepositoriesRequest],
+) (*connect.Response[registryv1alpha1.ListUserRepositoriesResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	var userRepositories []*registryv1alpha1.Repository
+	for _, repository := range r.repositories {
+		if repository.GetUserId() == req.Msg.UserId {
+			userRepositories = append(userRepositories, repository)
+		}
+	}
+	repositories, nextPageToken, err := fakeRegistryRepositoriesPage(
+		userRepositories,
+		req.Msg.PageSize,
+		req.Msg.PageToken,
+		req.Msg.Reverse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&registryv1alpha1.ListUserRepositoriesResponse{
+		Repositories:  repositories,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (r *fakeRegistry) ListOrganizationRepositories(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.ListOrganizationRepositoriesRequest],
+) (*connect.Response[registryv1alpha1.ListOrganizationRepositoriesResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	var organizationRepositories []*registryv1alpha1.Repository
+	for _, repository := range r.repositories {
+		if repository.GetOrganizationId() == req.Msg.OrganizationId {
+			organizationRepositories = append(organizationRepositories, repository)
+		}
+	}
+	repositories, nextPageToken, err := fakeRegistryRepositoriesPage(
+		organizationRepositories,
+		req.Msg.PageSize,
+		req.Msg.PageToken,
+		req.Msg.Reverse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&registryv1alpha1.ListOrganizationRepositoriesResponse{
+		Repositories:  repositories,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (r *fakeRegistry) GetRepositoriesMetadata(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetRepositoriesMetadataRequest],
+) (*connect.Response[registryv1alpha1.GetRepositoriesMetadataResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	metadata := make([]*registryv1alpha1.RepositoryMetadata, len(req.Msg.Ids))
+	for i, id := range req.Msg.Ids {
+		metadata[i] = &registryv1alpha1.RepositoryMetadata{
+			Id: id,
+		}
+		if latestCommitTime, ok := r.latestCommitTimes[id]; ok {
+			metadata[i].LatestCommitTime = timestamppb.New(latestCommitTime)
+		}
+	}
+	return connect.NewResponse(&registryv1alpha1.GetRepositoriesMetadataResponse{
+		Metadata: metadata,
+	}), nil
+}
+
+func (r *fakeRegistry) GetRepositoryByFullName(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetRepositoryByFullNameRequest],
+) (*connect.Response[registryv1alpha1.GetRepositoryByFullNameResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	for _, repository := range r.repositories {
+		if r.repositoryOwnerNameLocked(repository)+"/"+repository.Name == req.Msg.FullName {
+			return connect.NewResponse(&registryv1alpha1.GetRepositoryByFullNameResponse{
+				Repository: repository,
+			}), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("repository not found"))
+}
+
+func (r *fakeRegistry) SetRepositoryContributor(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.SetRepositoryContributorRequest],
+) (*connect.Response[registryv1alpha1.SetRepositoryContributorResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	userIDToRole, ok := r.repositoryRoles[req.Msg.RepositoryId]
+	if !ok {
+		userIDToRole = make(map[string]registryv1alpha1.RepositoryRole)
+		r.repositoryRoles[req.Msg.RepositoryId] = userIDToRole
+	}
+	if req.Msg.RepositoryRole == registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_UNSPECIFIED {
+		delete(userIDToRole, req.Msg.UserId)
+	} else {
+		userIDToRole[req.Msg.UserId] = req.Msg.RepositoryRole
+	}
+	return connect.NewResponse(&registryv1alpha1.SetRepositoryContributorResponse{}), nil
+}
+
+// ListRepositoryContributors lists the users with a role in the repository,
+// sorted by username, in a single page.
+func (r *fakeRegistry) ListRepositoryContributors(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.ListRepositoryContributorsRequest],
+) (*connect.Response[registryv1alpha1.ListRepositoryContributorsResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	var contributors []*registryv1alpha1.RepositoryContributor
+	for _, user := range r.users {
+		explicitRole := r.repositoryRoles[req.Msg.RepositoryId][user.Id]
+		implicitRole := r.implicitRepositoryRoles[user.Id]
+		if explicitRole == registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_UNSPECIFIED &&
+			implicitRole == registryv1alpha1.RepositoryRole_REPOSITORY_ROLE_UNSPECIFIED {
+			continue
+		}
+		contributors = append(contributors, &registryv1alpha1.RepositoryContributor{
+			User:         user,
+			RepositoryId: req.Msg.RepositoryId,
+			ExplicitRole: explicitRole,
+			ImplicitRole: implicitRole,
+		})
+	}
+	sort.Slice(contributors, func(i int, j int) bool {
+		return contributors[i].User.Username < contributors[j].User.Username
+	})
+	return connect.NewResponse(&registryv1alpha1.ListRepositoryContributorsResponse{
+		Users: contributors,
+	}), nil
+}
+
+func (r *fakeRegistry) GetOrganization(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetOrganizationRequest],
+) (*connect.Response[registryv1alpha1.GetOrganizationResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	for _, organization := range r.organizations {
+		if organization.Id == req.Msg.Id {
+			return connect.NewResponse(&registryv1alpha1.GetOrganizationResponse{
+				Organization: organization,
+			}), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("organization not found"))
+}
+
+func (r *fakeRegistry) GetOrganizationByName(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetOrganizationByNameRequest],
+) (*connect.Response[registryv1alpha1.GetOrganizationByNameResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	organization, ok := r.organizations[req.Msg.Name]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("organization not found"))
+	}
+	return connect.NewResponse(&registryv1alpha1.GetOrganizationByNameResponse{
+		Organization: organization,
+	}), nil
+}
+
+func (r *fakeRegistry) GetUser(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetUserRequest],
+) (*connect.Response[registryv1alpha1.GetUserResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	for _, user := range r.users {
+		if user.Id == req.Msg.Id {
+			return connect.NewResponse(&registryv1alpha1.GetUserResponse{
+				User: user,
+			}), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+}
+
+func (r *fakeRegistry) GetUserByUsername(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetUserByUsernameRequest],
+) (*connect.Response[registryv1alpha1.GetUserByUsernameResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	user, ok := r.users[req.Msg.Username]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	return connect.NewResponse(&registryv1alpha1.GetUserByUsernameResponse{
+		User: user,
+	}), nil
+}
+
+func (r *fakeRegistry) GetCurrentUser(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.GetCurrentUserRequest],
+) (*connect.Response[registryv1alpha1.GetCurrentUserResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	token := strings.TrimPrefix(req.Header().Get(bufconnect.AuthenticationHeader), bufconnect.AuthenticationTokenPrefix)
+	username, ok := r.tokenUsernames[token]
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid token"))
+	}
+	return connect.NewResponse(&registryv1alpha1.GetCurrentUserResponse{
+		User: r.users[username],
+	}), nil
+}
+
+// ListUsers lists the users sorted by username. The page tokens are the
+// indexes of the first user of the pages.
+func (r *fakeRegistry) ListUsers(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.ListUsersRequest],
+) (*connect.Response[registryv1alpha1.ListUsersResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	var users []*registryv1alpha1.User
+	for _, user := range r.users {
+		if req.Msg.UserStateFilter == registryv1alpha1.UserState_USER_STATE_ACTIVE && user.Deactivated ||
+			req.Msg.UserStateFilter == registryv1alpha1.UserState_USER_STATE_DEACTIVATED && !user.Deactivated {
+			continue
+		}
+		if len(req.Msg.UserTypeFilters) > 0 && !slicesContainUserType(req.Msg.UserTypeFilters, user.UserType) {
+			continue
+		}
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i int, j int) bool {
+		if req.Msg.Reverse {
+			return users[i].Username > users[j].Username
+		}
+		return users[i].Username < users[j].Username
+	})
+	var start int
+	if req.Msg.PageToken != "" {
+		var err error
+		start, err = strconv.Atoi(req.Msg.PageToken)
+		if err != nil || start > len(users) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
+		}
+	}
+	users = users[start:]
+	var nextPageToken string
+	if req.Msg.PageSize > 0 && len(users) > int(req.Msg.PageSize) {
+		users = users[:req.Msg.PageSize]
+		nextPageToken = strconv.Itoa(start + int(req.Msg.PageSize))
+	}
+	return connect.NewResponse(&registryv1alpha1.ListUsersResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (r *fakeRegistry) CreateMachineUser(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.CreateMachineUserRequest],
+) (*connect.Response[registryv1alpha1.CreateMachineUserResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	if _, ok := r.users[req.Msg.Username]; ok {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("user already exists"))
+	}
+	user := &registryv1alpha1.User{
+		Id:         fmt.Sprintf("user%d", len(r.users)+1),
+		Username:   req.Msg.Username,
+		UserType:   registryv1alpha1.UserType_USER_TYPE_MACHINE,
+		CreateTime: fakeRegistryCreateTime,
+		UpdateTime: fakeRegistryCreateTime,
+	}
+	r.users[user.Username] = user
+	return connect.NewResponse(&registryv1alpha1.CreateMachineUserResponse{
+		User: user,
+	}), nil
+}
+
+func (r *fakeRegistry) ListTokens(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.ListTokensRequest],
+) (*connect.Response[registryv1alpha1.ListTokensResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	var tokens []*registryv1alpha1.Token
+	for _, tokenID := range r.tokenIDsLocked() {
+		if r.tokens[tokenID].userID == req.Msg.UserId {
+			tokens = append(tokens, &registryv1alpha1.Token{Id: tokenID})
+		}
+	}
+	return connect.NewResponse(&registryv1alpha1.ListTokensResponse{
+		Tokens: tokens,
+	}), nil
+}
+
+// CreateToken creates the token "token<n>", whose secret is "secret-token<n>",
+// where n is one more than the number of tokens.
+func (r *fakeRegistry) CreateToken(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.CreateTokenRequest],
+) (*connect.Response[registryv1alpha1.CreateTokenResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.nextTokenID == 0 {
+		r.nextTokenID = len(r.tokens) + 1
+	}
+	tokenID := fmt.Sprintf("token%d", r.nextTokenID)
+	r.nextTokenID++
+	r.tokens[tokenID] = &fakeRegistryToken{
+		userID:  req.Msg.UserId,
+		note:    req.Msg.Note,
+		expires: req.Msg.ExpireTime != nil,
+	}
+	return connect.NewResponse(&registryv1alpha1.CreateTokenResponse{
+		Token: "secret-" + tokenID,
+	}), nil
+}
+
+func (r *fakeRegistry) DeleteToken(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.DeleteTokenRequest],
+) (*connect.Response[registryv1alpha1.DeleteTokenResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	if _, ok := r.tokens[req.Msg.TokenId]; !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("token not found"))
+	}
+	delete(r.tokens, req.Msg.TokenId)
+	return connect.NewResponse(&registryv1alpha1.DeleteTokenResponse{}), nil
+}
+
+// tokenIDs returns the sorted IDs of the tokens.
+func (r *fakeRegistry) tokenIDs() []string {
+	r.Lock()
+	defer r.Unlock()
+	return r.tokenIDsLocked()
+}
+
+func (r *fakeRegistry) tokenIDsLocked() []string {
+	tokenIDs := make([]string, 0, len(r.tokens))
+	for tokenID := range r.tokens {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Strings(tokenIDs)
+	return tokenIDs
+}
+
+// assertEmptyDir asserts that the directory has no files.
+func (r *fakeRegistry) CreateWebhook(
+	_ context.Context,
+	req *connect.Request[registryv1alpha1.CreateWebhookRequest],
+) (*connect.Response[registryv1alpha1.CreateWebhookResponse], error) {
+	r.Lock()
+	defer r.Unlock()
+	r.createWebhookRequests = append(r.createWebhookRequests, req.Msg)
+	return connect.NewResponse(&registryv1alpha1.CreateWebhookResponse{
+		Webhook: &registryv1alpha1.Webhook{
+			WebhookId: strconv.Itoa(len(r.createWebhookRequests)),
+		},
+	}), nil
+}
+
+// PollRepositoryEvents returns the events after the cursor in pages of at
+// most two events.
+func (r *fakeRegistry) PollRepositoryEvents(
+	ctx context.Context,
+	req *connect.Request[webhookv1alpha1.PollRepositoryEventsRequest],
+) (*connect.Response[webhookv1alpha1.PollRepositoryEventsResponse], error) {
+	r.Lock()
+	r.pollRepositoryEventsRequests = append(r.pollRepositoryEventsRequests, req.Msg)
+	repositoryEvents, ok := r.repositoryEvents[req.Msg.OwnerName+"/"+req.Msg.RepositoryName]
+	onRepositoryEventsExhausted := r.onRepositoryEventsExhausted
+	r.Unlock()
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("repository not found"))
+	}
+	var start int
+	if req.Msg.Cursor != "" {
+		var err error
+		start, err = strconv.Atoi(req.Msg.Cursor)
+		if err != nil || start > len(repositoryEvents) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid cursor"))
+		}
+	}
+	var events []*webhookv1alpha1.RepositoryEvent
+	end := start
+	for ; end < len(repositoryEvents) && len(events) < 2; end++ {
+		if len(req.Msg.Events) == 0 || slicesContainWebhookEvent(req.Msg.Events, repositoryEvents[end].Event) {
+			events = append(events, repositoryEvents[end])
+		}
+	}
+	if len(events) == 0 {
+		if onRepositoryEventsExhausted == nil {
+			return nil, connect.NewError(connect.CodeUnavailable, errors.New("no more events"))
+		}
+		onRepositoryEventsExhausted()
+		select {
+		case <-ctx.Done():
+			return nil, connect.NewError(connect.CodeCanceled, ctx.Err())
+		case <-time.After(req.Msg.WaitTimeout.AsDuration()):
+		}
+	}
+	return connect.NewResponse(&webhookv1alpha1.PollRepositoryEventsResponse{
+		RepositoryEvents: events,
+		NextCursor:       strconv.Itoa(end),
+	}), nil
+}
+
+// repositoryOwnerNameLocked returns the name of the organization or user that
+// owns the repository.
+func (r *fakeRegistry) repositoryOwnerNameLocked(repository *registryv1alpha1.Repository) string {
+	for _, organization := range r.organizations {
+		if organization.Id == repository.GetOrganizationId() {
+			return organization.Name
+		}
+	}
+	for _, user := range r.users {
+		if user.Id == repository.GetUserId() {
+			return user.Username
+		}
+	}
+	return ""
+}
+
+// fakeRegistryRepositoriesPage returns the page of the repositories. The page
+// tokens are the indexes of the first repository of the pages.
+func fakeRegistryRepositoriesPage(
+	repositories []*registryv1alpha1.Repository,
+	pageSize uint32,
+	pageToken string,
+	reverse bool,
+) ([]*registryv1alpha1.Repository, string, error) {
+	if reverse {
+		reversedRepositories := make([]*registryv1alpha1.Repository, len(repositories))
+		for i, repository := range repositories {
+			reversedRepositories[len(repositories)-1-i] = repository
+		}
+		repositories = reversedRepositories
+	}
+	var start int
+	if pageToken != "" {
+		var err error
+		start, err = strconv.Atoi(pageToken)
+		if err != nil || start > len(repositories) {
+			return nil, "", connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
+		}
+	}
+	repositories = repositories[start:]
+	var nextPageToken string
+	if pageSize > 0 && len(repositories) > int(pageSize) {
+		repositories = repositories[:pageSize]
+		nextPageToken = strconv.Itoa(start + int(pageSize))
+	}
+	return repositories, nextPageToken, nil
+}
+
+func assertEmptyDir(t *testing.T, dirPath string) {
+	entries, err := os.ReadDir(dirPath)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+// assertMirroredModule asserts that the mirror has the module of the commit,
+// with the manifest digest of the registry.
+func assertMirroredModule(t *testing.T, registry *fakeRegistry, mirrorDirPath string, commit string) {
+	storeBucket, err := storageos.NewProvider().NewReadWriteBucket(bufcli.CASModuleCacheDirPath(mirrorDirPath))
+	require.NoError(t, err)
+	modulePin, err := bufmoduleref.NewModulePin(registry.remote, "acme", "weather", "", commit, "", time.Time{})
+	require.NoError(t, err)
+	module, err := bufmodulecache.NewCASModuleStore(zap.NewNop(), storeBucket).GetModule(context.Background(), modulePin)
+	require.NoError(t, err)
+	manifestBlob, err := module.Manifest().Blob()
+	require.NoError(t, err)
+	assert.Equal(t, registry.modules[commit].manifestDigest, manifestBlob.Digest().String())
+}
+
+// userTableRow returns a row of the text output of the users, whose first
+// column is as wide as the longest full name.
+func userTableRow(longestFullName string, fullName string, otherColumns string) string {
+	return fmt.Sprintf("%-*s%s\n", len(longestFullName)+2, fullName, otherColumns)
+}
+
+// newFakeRegistryRepositoryPushEvent returns an event of a push to the
+// acme/weather repository.
+func newFakeRegistryRepositoryPushEvent(
+	id string,
+	commitName string,
+	author string,
+	eventTime time.Time,
+) *webhookv1alpha1.RepositoryEvent {
+	return &webhookv1alpha1.RepositoryEvent{
+		Id:        id,
+		Event:     registryv1alpha1.WebhookEvent_WEBHOOK_EVENT_REPOSITORY_PUSH,
+		EventTime: timestamppb.New(eventTime),
+		Payload: &webhookv1alpha1.EventPayload{
+			Payload: &webhookv1alpha1.EventPayload_RepositoryPush{
+				RepositoryPush: &webhookv1alpha1.RepositoryPushEvent{
+					EventTime: timestamppb.New(eventTime),
+					RepositoryCommit: &registryv1alpha1.RepositoryCommit{
+						Name:   commitName,
+						Author: author,
+					},
+					Repository: &registryv1alpha1.Repository{
+						Name:      "weather",
+						OwnerName: "acme",
+					},
+				},
+			},
+		},
+	}
+}
+
+func slicesContainUserType(userTypes []registryv1alpha1.UserType, userType registryv1alpha1.UserType) bool {
+	for _, value := range userTypes {
+		if value == userType {
+			return true
+		}
+	}
+	return false
+}
+
+func slicesContainWebhookEvent(events []registryv1alpha1.WebhookEvent, event registryv1alpha1.WebhookEvent) bool {
+	for _, value := range events {
+		if value == event {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookCreateArgs returns the arguments to create a webhook for the pushes
+// to the acme/weather repository of the registry, followed by args.
+func webhookCreateArgs(registry *fakeRegistry, args ...string) []string {
+	return append(
+		[]string{
+			"beta",
+			"registry",
+			"webhook",
+			"create",
+			"--remote",
+			registry.remote,
+			"--owner",
+			"acme",
+			"--repository",
+			"weather",
+			"--event",
+			"WEBHOOK_EVENT_REPOSITORY_PUSH",
+		},
+		args...,
+	)
+}
+
+func testRunStdoutRegistry(
+	t *testing.T,
+	expectedExitCode int,
+	expectedStdout string,
+	args ...string,
+) {
+	appcmdtesting.RunCommandExitCodeStdout(
+		t,
+		func(use string) *appcmd.Command { return NewRootCommand(use) },
+		expectedExitCode,
+		expectedStdout,
+		newRegistryEnvFunc(t),
+		nil,
+		args...,
+	)
+}
+
+// testRunStderrContainsRegistry runs the command, which is expected to fail
+// with an error that contains expectedStderr.
+func testRunStderrContainsRegistry(
+	t *testing.T,
+	expectedStderr string,
+	args ...string,
+) {
+	stderr := bytes.NewBuffer(nil)
+	appcmdtesting.RunCommandExitCode(
+		t,
+		func(use string) *appcmd.Command { return NewRootCommand(use) },
+		1,
+		newRegistryEnvFunc(t),
+		nil,
+		io.Discard,
+		stderr,
+		args...,
+	)
+	assert.Contains(t, stderr.String(), expectedStderr)
+}
+
+// newRegistryEnvFunc returns a new env func for testing against a
+// fakeRegistry, which does not use TLS nor the API subdomain.
+func newRegistryEnvFunc(t *testing.T) func(string) map[string]string {
+	newEnv := internaltesting.NewEnvFunc(t)
+	return func(use string) map[string]string {
+		env := newEnv(use)
+		env["BUF_TOKEN"] = "invalid"
+		buftransport.SetDisableAPISubdomain(env)
+		require.NoError(
+			t,
+			os.WriteFile(
+				filepath.Join(env[strings.ToUpper(use)+"_CONFIG_DIR"], "config.yaml"),
+				[]byte("version: v1\ntls:\n  use: false\n"),
+				0600,
+			),
+		)
+		return env
+	}
+}
@@ -18,14 +18,20 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/bufbuild/buf/private/buf/bufcli"
+	"github.com/bufbuild/buf/private/buf/cmd/buf/internal/internaltesting"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd/appcmdtesting"
 	"github.com/bufbuild/buf/private/pkg/osextended"
 	"github.com/bufbuild/buf/private/pkg/storage/storagearchive"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -256,6 +262,35 @@ func TestWorkspaceArchiveDir(t *testing.T) {
 	)
 }
 
+func TestWorkspaceArchiveStdin(t *testing.T) {
+	// Archive of unknown size read from stdin, which is unarchived to disk.
+	t.Parallel()
+	readBucket, err := storageos.NewProvider().NewReadWriteBucket(
+		filepath.Join("testdata", "workspace", "success", "dir"),
+	)
+	require.NoError(t, err)
+	buffer := bytes.NewBuffer(nil)
+	require.NoError(t, storagearchive.Tar(context.Background(), readBucket, buffer))
+	testRunStdout(
+		t,
+		bytes.NewReader(buffer.Bytes()),
+		0,
+		filepath.FromSlash(`proto/rpc.proto`),
+		"ls-files",
+		"-#format=tar,subdir=proto",
+	)
+	testRunStdout(
+		t,
+		bytes.NewReader(buffer.Bytes()),
+		0,
+		``,
+		"build",
+		"-#format=tar,subdir=proto",
+		"-o",
+		filepath.Join(t.TempDir(), "image.bin"),
+	)
+}
+
 func TestWorkspaceNestedArchive(t *testing.T) {
 	// Archive that defines a workspace in a sub-directory to the root.
 	t.Parallel()
@@ -914,7 +949,7 @@ func TestWorkspaceDuplicateFail(t *testing.T) {
 		nil,
 		1,
 		``,
-		filepath.FromSlash(`Failure: foo.proto exists in multiple locations: testdata/workspace/fail/duplicate/other/proto/foo.proto testdata/workspace/fail/duplicate/proto/foo.proto`),
+		filepath.FromSlash(`Failure: foo.proto exists in multiple modules: testdata/workspace/fail/duplicate/other/proto/foo.proto, testdata/workspace/fail/duplicate/proto/foo.proto. If the file exists in both a workspace module and a dependency, set duplicate_strategy to "prefer-workspace" or "prefer-dep" in buf.work.yaml to choose one`),
 		"build",
 		filepath.Join("testdata", "workspace", "fail", "duplicate"),
 	)
@@ -1242,3 +1277,180 @@ func TestWorkspaceProtoFile(t *testing.T) {
 		filepath.Join("testdata", "workspace", "success", "protofileref", "another", "foo", "foo.proto"),
 	)
 }
+
+func TestWorkspaceLsVerifyGraph(t *testing.T) {
+	t.Parallel()
+	testRunStdout(
+		t,
+		nil,
+		0,
+		filepath.FromSlash(`other/proto	bufbuild.test/workspace/third
+private/proto	bufbuild.test/workspace/second
+proto	bufbuild.test/workspace/first`),
+		"workspace",
+		"ls",
+		filepath.Join("testdata", "workspace", "success", "diamond"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		filepath.FromSlash(`{"path":"other/proto","name":"bufbuild.test/workspace/third"}
+{"path":"private/proto","name":"bufbuild.test/workspace/second"}
+{"path":"proto","name":"bufbuild.test/workspace/first"}`),
+		"workspace",
+		"ls",
+		filepath.Join("testdata", "workspace", "success", "diamond"),
+		"--format",
+		"json",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"workspace",
+		"verify",
+		filepath.Join("testdata", "workspace", "success", "diamond"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		filepath.FromSlash(`other/proto
+private/proto -> other/proto
+proto -> other/proto
+proto -> private/proto`),
+		"workspace",
+		"graph",
+		filepath.Join("testdata", "workspace", "success", "diamond"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`testdata/workspace/fail/undeclared/proto/a.proto:5:1:Import "b.proto" is provided by module "bufbuild.test/workspace/second" in directory "other/proto", which is not declared in the deps of the module in directory "proto".`),
+		"workspace",
+		"verify",
+		filepath.Join("testdata", "workspace", "fail", "undeclared"),
+	)
+}
+
+func TestWorkspaceWellKnownTypes(t *testing.T) {
+	t.Parallel()
+	// The wkt directory provides a version of google/protobuf/empty.proto with an
+	// additional message that proto/a/a.proto depends on.
+	testRunStdoutStderr(
+		t,
+		nil,
+		0,
+		``,
+		``,
+		"build",
+		filepath.Join("testdata", "workspace", "success", "wkt_module"),
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		``,
+		filepath.FromSlash(`testdata/workspace/fail/wkt_bundled/proto/a/a.proto:9:3:field a.A.pinned_empty: unknown type google.protobuf.PinnedEmpty`),
+		"build",
+		filepath.Join("testdata", "workspace", "fail", "wkt_bundled"),
+	)
+	// Without well_known_types, the shadowing file is used and warned about once,
+	// even though it is opened by the builds of both workspace directories.
+	stderr := bytes.NewBuffer(nil)
+	appcmdtesting.RunCommandExitCode(
+		t,
+		func(use string) *appcmd.Command { return NewRootCommand(use) },
+		0,
+		internaltesting.NewEnvFunc(t),
+		nil,
+		io.Discard,
+		stderr,
+		"build",
+		filepath.Join("testdata", "workspace", "success", "wkt_shadow"),
+	)
+	assert.Contains(
+		t,
+		stderr.String(),
+		filepath.FromSlash(`testdata/workspace/success/wkt_shadow/wkt/google/protobuf/empty.proto shadows the well-known type bundled with buf`),
+	)
+	assert.Equal(t, 1, strings.Count(stderr.String(), "shadows the well-known type bundled with buf"))
+}
+
+func TestWorkspaceKeepGoing(t *testing.T) {
+	t.Parallel()
+	// Module a fails to build, and module b has a lint failure.
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`testdata/workspace/fail/keepgoing/a/a.proto:6:3:field a.A.undefined: unknown type Undefined`),
+		"lint",
+		filepath.Join("testdata", "workspace", "fail", "keepgoing"),
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`testdata/workspace/fail/keepgoing/a/a.proto:6:3:field a.A.undefined: unknown type Undefined
+testdata/workspace/fail/keepgoing/b/b.proto:6:10:Field name "fooBar" should be lower_snake_case, such as "foo_bar".`),
+		"lint",
+		filepath.Join("testdata", "workspace", "fail", "keepgoing"),
+		"--keep-going",
+	)
+	testRunStdoutStderr(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`testdata/workspace/fail/keepgoing/a/a.proto:6:3:field a.A.undefined: unknown type Undefined`),
+		`1 of 2 failures were not printed.`,
+		"lint",
+		filepath.Join("testdata", "workspace", "fail", "keepgoing"),
+		"--keep-going",
+		"--max-failures",
+		"1",
+	)
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`testdata/workspace/fail/keepgoing/a/a.proto:6:3:field a.A.undefined: unknown type Undefined`),
+		"breaking",
+		filepath.Join("testdata", "workspace", "fail", "keepgoing"),
+		"--against",
+		filepath.Join("testdata", "workspace", "fail", "keepgoing"),
+		"--keep-going",
+	)
+}
+
+func TestWorkspaceParallelism(t *testing.T) {
+	t.Parallel()
+	// The output is the same as if the modules were processed one at a time.
+	testRunStdout(
+		t,
+		nil,
+		bufcli.ExitCodeFileAnnotation,
+		filepath.FromSlash(`testdata/workspace/fail/keepgoing/a/a.proto:6:3:field a.A.undefined: unknown type Undefined
+testdata/workspace/fail/keepgoing/b/b.proto:6:10:Field name "fooBar" should be lower_snake_case, such as "foo_bar".`),
+		"lint",
+		filepath.Join("testdata", "workspace", "fail", "keepgoing"),
+		"--keep-going",
+		"--parallelism",
+		"2",
+	)
+	testRunStdout(
+		t,
+		nil,
+		0,
+		``,
+		"breaking",
+		filepath.Join("testdata", "workspace", "success", "breaking"),
+		"--against",
+		filepath.Join("testdata", "workspace", "success", "breaking"),
+		"--parallelism",
+		"2",
+	)
+}
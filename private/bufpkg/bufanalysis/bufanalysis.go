@@ -34,6 +34,28 @@ const (
 	FormatJUnit
 )
 
+const (
+	// SeverityError is the severity of a FileAnnotation that fails the operation.
+	//
+	// This is the default severity.
+	SeverityError Severity = iota + 1
+	// SeverityWarning is the severity of a FileAnnotation that is non-fatal, such
+	// as a compiler warning.
+	SeverityWarning
+)
+
+const (
+	// ChangeKindRemoved is the kind of a change that removes a descriptor.
+	ChangeKindRemoved ChangeKind = iota + 1
+	// ChangeKindRenamed is the kind of a change that renames a descriptor.
+	ChangeKindRenamed
+	// ChangeKindTypeChanged is the kind of a change that changes the type of a descriptor.
+	ChangeKindTypeChanged
+	// ChangeKindChanged is the kind of any other change to a descriptor, such as
+	// a changed option or label.
+	ChangeKindChanged
+)
+
 var (
 	// AllFormatStrings is all format strings without aliases.
 	//
@@ -69,6 +91,16 @@ var (
 		FormatMSVS:  "msvs",
 		FormatJUnit: "junit",
 	}
+	severityToString = map[Severity]string{
+		SeverityError:   "error",
+		SeverityWarning: "warning",
+	}
+	changeKindToString = map[ChangeKind]string{
+		ChangeKindRemoved:     "removed",
+		ChangeKindRenamed:     "renamed",
+		ChangeKindTypeChanged: "type_changed",
+		ChangeKindChanged:     "changed",
+	}
 )
 
 // Format is a FileAnnotation format.
@@ -98,6 +130,31 @@ func ParseFormat(s string) (Format, error) {
 	return 0, fmt.Errorf("unknown format: %q", s)
 }
 
+// Severity is the severity of a FileAnnotation.
+type Severity int
+
+// String implements fmt.Stringer.
+func (s Severity) String() string {
+	str, ok := severityToString[s]
+	if !ok {
+		return strconv.Itoa(int(s))
+	}
+	return str
+}
+
+// ChangeKind is the kind of change that a FileAnnotation reports, such as
+// for breaking changes.
+type ChangeKind int
+
+// String implements fmt.Stringer.
+func (c ChangeKind) String() string {
+	s, ok := changeKindToString[c]
+	if !ok {
+		return strconv.Itoa(int(c))
+	}
+	return s
+}
+
 // FileInfo is a minimal FileInfo interface.
 type FileInfo interface {
 	Path() string
@@ -138,6 +195,37 @@ type FileAnnotation interface {
 	Type() string
 	// Message is the message of the annotation.
 	Message() string
+	// RelatedInformation is additional locations related to this annotation,
+	// such as the location where a symbol was previously defined.
+	//
+	// This may be empty.
+	RelatedInformation() []RelatedInformation
+	// Severity is the severity of the annotation.
+	//
+	// This is SeverityError unless FileAnnotationWithSeverity was used.
+	Severity() Severity
+	// Categories are the categories of the rule that produced this annotation.
+	//
+	// This may be empty.
+	Categories() []string
+	// Descriptor is the fully-qualified name of the descriptor this annotation is for.
+	//
+	// This may be empty.
+	Descriptor() string
+	// ChangeKind is the kind of change this annotation reports.
+	//
+	// This is 0 if the annotation does not report a change.
+	ChangeKind() ChangeKind
+	// AgainstLocation is the location of the descriptor in the input that was
+	// compared against, for annotations that report a change.
+	//
+	// This may be nil.
+	AgainstLocation() RelatedInformation
+	// Owners are the owners of the file this annotation is for, as configured
+	// in the owners section of buf.yaml.
+	//
+	// This may be empty.
+	Owners() []string
 }
 
 // NewFileAnnotation returns a new FileAnnotation.
@@ -149,6 +237,7 @@ func NewFileAnnotation(
 	endColumn int,
 	typeString string,
 	message string,
+	options ...FileAnnotationOption,
 ) FileAnnotation {
 	return newFileAnnotation(
 		fileInfo,
@@ -158,6 +247,144 @@ func NewFileAnnotation(
 		endColumn,
 		typeString,
 		message,
+		options...,
+	)
+}
+
+// FileAnnotationOption is an option for a new FileAnnotation.
+type FileAnnotationOption func(*fileAnnotation)
+
+// FileAnnotationWithRelatedInformation returns a new FileAnnotationOption that
+// attaches the given RelatedInformation to the FileAnnotation.
+func FileAnnotationWithRelatedInformation(relatedInformation ...RelatedInformation) FileAnnotationOption {
+	return func(fileAnnotation *fileAnnotation) {
+		fileAnnotation.relatedInformation = append(fileAnnotation.relatedInformation, relatedInformation...)
+	}
+}
+
+// FileAnnotationWithSeverity returns a new FileAnnotationOption that sets
+// the Severity of the FileAnnotation.
+//
+// The default is SeverityError.
+func FileAnnotationWithSeverity(severity Severity) FileAnnotationOption {
+	return func(fileAnnotation *fileAnnotation) {
+		fileAnnotation.severity = severity
+	}
+}
+
+// FileAnnotationWithCategories returns a new FileAnnotationOption that sets
+// the categories of the rule that produced the FileAnnotation.
+func FileAnnotationWithCategories(categories ...string) FileAnnotationOption {
+	return func(fileAnnotation *fileAnnotation) {
+		fileAnnotation.categories = categories
+	}
+}
+
+// FileAnnotationWithDescriptor returns a new FileAnnotationOption that sets
+// the fully-qualified name of the descriptor the FileAnnotation is for.
+func FileAnnotationWithDescriptor(descriptor string) FileAnnotationOption {
+	return func(fileAnnotation *fileAnnotation) {
+		fileAnnotation.descriptor = descriptor
+	}
+}
+
+// FileAnnotationWithChangeKind returns a new FileAnnotationOption that sets
+// the kind of change the FileAnnotation reports.
+func FileAnnotationWithChangeKind(changeKind ChangeKind) FileAnnotationOption {
+	return func(fileAnnotation *fileAnnotation) {
+		fileAnnotation.changeKind = changeKind
+	}
+}
+
+// FileAnnotationWithAgainstLocation returns a new FileAnnotationOption that sets
+// the location of the descriptor in the input that was compared against.
+func FileAnnotationWithAgainstLocation(againstLocation RelatedInformation) FileAnnotationOption {
+	return func(fileAnnotation *fileAnnotation) {
+		fileAnnotation.againstLocation = againstLocation
+	}
+}
+
+// FileAnnotationWithOwners returns a new FileAnnotationOption that sets
+// the owners of the file the FileAnnotation is for.
+func FileAnnotationWithOwners(owners ...string) FileAnnotationOption {
+	return func(fileAnnotation *fileAnnotation) {
+		fileAnnotation.owners = owners
+	}
+}
+
+// CopyFileAnnotation returns a copy of the FileAnnotation with the options applied.
+func CopyFileAnnotation(f FileAnnotation, options ...FileAnnotationOption) FileAnnotation {
+	return newFileAnnotation(
+		f.FileInfo(),
+		f.StartLine(),
+		f.StartColumn(),
+		f.EndLine(),
+		f.EndColumn(),
+		f.Type(),
+		f.Message(),
+		append(
+			[]FileAnnotationOption{
+				FileAnnotationWithRelatedInformation(f.RelatedInformation()...),
+				FileAnnotationWithSeverity(f.Severity()),
+				FileAnnotationWithCategories(f.Categories()...),
+				FileAnnotationWithDescriptor(f.Descriptor()),
+				FileAnnotationWithChangeKind(f.ChangeKind()),
+				FileAnnotationWithAgainstLocation(f.AgainstLocation()),
+				FileAnnotationWithOwners(f.Owners()...),
+			},
+			options...,
+		)...,
+	)
+}
+
+// RelatedInformation is a location related to a FileAnnotation.
+//
+// For example, a FileAnnotation for a duplicate symbol will have a RelatedInformation
+// pointing at the location where the symbol was previously defined.
+type RelatedInformation interface {
+	// Stringer returns the string representation of this related information.
+	fmt.Stringer
+
+	// FileInfo is the FileInfo for this related information.
+	//
+	// This may be nil.
+	FileInfo() FileInfo
+	// StartLine is the starting line.
+	//
+	// If the starting line is not known, this will be 0.
+	StartLine() int
+	// StartColumn is the starting column.
+	//
+	// If the starting column is not known, this will be 0.
+	StartColumn() int
+	// EndLine is the ending line.
+	//
+	// If the ending line is not known, this will be 0.
+	EndLine() int
+	// EndColumn is the ending column.
+	//
+	// If the ending column is not known, this will be 0.
+	EndColumn() int
+	// Message is the message of the related information.
+	Message() string
+}
+
+// NewRelatedInformation returns a new RelatedInformation.
+func NewRelatedInformation(
+	fileInfo FileInfo,
+	startLine int,
+	startColumn int,
+	endLine int,
+	endColumn int,
+	message string,
+) RelatedInformation {
+	return newRelatedInformation(
+		fileInfo,
+		startLine,
+		startColumn,
+		endLine,
+		endColumn,
+		message,
 	)
 }
 
@@ -194,6 +421,9 @@ func DeduplicateAndSortFileAnnotations(fileAnnotations []FileAnnotation) []FileA
 }
 
 // PrintFileAnnotations prints the file annotations separated by newlines.
+//
+// For the text and JSON formats, any RelatedInformation is printed along with
+// the FileAnnotation it belongs to.
 func PrintFileAnnotations(writer io.Writer, fileAnnotations []FileAnnotation, formatString string) error {
 	format, err := ParseFormat(formatString)
 	if err != nil {
@@ -228,6 +458,10 @@ func hash(fileAnnotation FileAnnotation) string {
 	_, _ = hash.Write([]byte(strconv.Itoa(fileAnnotation.EndColumn())))
 	_, _ = hash.Write([]byte(fileAnnotation.Type()))
 	_, _ = hash.Write([]byte(fileAnnotation.Message()))
+	_, _ = hash.Write([]byte(fileAnnotation.Severity().String()))
+	for _, relatedInformation := range fileAnnotation.RelatedInformation() {
+		_, _ = hash.Write([]byte(relatedInformation.String()))
+	}
 	return string(hash.Sum(nil))
 }
 
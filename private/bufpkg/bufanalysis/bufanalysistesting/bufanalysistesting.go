@@ -159,6 +159,7 @@ func normalizeFileAnnotations(
 			a.EndColumn(),
 			a.Type(),
 			"",
+			bufanalysis.FileAnnotationWithSeverity(a.Severity()),
 		)
 	}
 	return normalizedFileAnnotations
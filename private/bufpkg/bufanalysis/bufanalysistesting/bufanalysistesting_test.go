@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -94,3 +95,53 @@ path/to/file.proto(2,1) : error FOO : Hello.
 		sb.String(),
 	)
 }
+
+func TestRelatedInformation(t *testing.T) {
+	t.Parallel()
+	fileAnnotations := []bufanalysis.FileAnnotation{
+		bufanalysis.NewFileAnnotation(
+			newFileInfo(t, "path/to/b.proto"),
+			5,
+			9,
+			5,
+			12,
+			"COMPILE",
+			`symbol "a.Foo" already defined at path/to/a.proto:5:9`,
+			bufanalysis.FileAnnotationWithRelatedInformation(
+				bufanalysis.NewRelatedInformation(
+					newFileInfo(t, "path/to/a.proto"),
+					5,
+					9,
+					5,
+					9,
+					"previously defined here",
+				),
+			),
+		),
+	}
+	sb := &strings.Builder{}
+	err := bufanalysis.PrintFileAnnotations(sb, fileAnnotations, "text")
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`path/to/b.proto:5:9:symbol "a.Foo" already defined at path/to/a.proto:5:9
+	path/to/a.proto:5:9:previously defined here
+`,
+		sb.String(),
+	)
+	sb.Reset()
+	err = bufanalysis.PrintFileAnnotations(sb, fileAnnotations, "json")
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"path":"path/to/b.proto","start_line":5,"start_column":9,"end_line":5,"end_column":12,"type":"COMPILE","message":"symbol \"a.Foo\" already defined at path/to/a.proto:5:9","related":[{"path":"path/to/a.proto","start_line":5,"start_column":9,"end_line":5,"end_column":9,"message":"previously defined here"}]}
+`,
+		sb.String(),
+	)
+}
+
+func newFileInfo(t *testing.T, path string) bufmoduleref.FileInfo {
+	fileInfo, err := bufmoduleref.NewFileInfo(path, "", false, nil, "")
+	require.NoError(t, err)
+	return fileInfo
+}
@@ -27,6 +27,14 @@ type fileAnnotation struct {
 	endColumn   int
 	typeString  string
 	message     string
+	severity    Severity
+	categories  []string
+	descriptor  string
+	changeKind  ChangeKind
+	owners      []string
+
+	relatedInformation []RelatedInformation
+	againstLocation    RelatedInformation
 }
 
 func newFileAnnotation(
@@ -37,8 +45,9 @@ func newFileAnnotation(
 	endColumn int,
 	typeString string,
 	message string,
+	options ...FileAnnotationOption,
 ) *fileAnnotation {
-	return &fileAnnotation{
+	fileAnnotation := &fileAnnotation{
 		fileInfo:    fileInfo,
 		startLine:   startLine,
 		startColumn: startColumn,
@@ -46,7 +55,12 @@ func newFileAnnotation(
 		endColumn:   endColumn,
 		typeString:  typeString,
 		message:     message,
+		severity:    SeverityError,
+	}
+	for _, option := range options {
+		option(fileAnnotation)
 	}
+	return fileAnnotation
 }
 
 func (f *fileAnnotation) FileInfo() FileInfo {
@@ -77,6 +91,34 @@ func (f *fileAnnotation) Message() string {
 	return f.message
 }
 
+func (f *fileAnnotation) RelatedInformation() []RelatedInformation {
+	return f.relatedInformation
+}
+
+func (f *fileAnnotation) Severity() Severity {
+	return f.severity
+}
+
+func (f *fileAnnotation) Categories() []string {
+	return f.categories
+}
+
+func (f *fileAnnotation) Descriptor() string {
+	return f.descriptor
+}
+
+func (f *fileAnnotation) ChangeKind() ChangeKind {
+	return f.changeKind
+}
+
+func (f *fileAnnotation) AgainstLocation() RelatedInformation {
+	return f.againstLocation
+}
+
+func (f *fileAnnotation) Owners() []string {
+	return f.owners
+}
+
 func (f *fileAnnotation) String() string {
 	if f == nil {
 		return ""
@@ -102,6 +144,9 @@ func (f *fileAnnotation) String() string {
 	_, _ = buffer.WriteRune(':')
 	_, _ = buffer.WriteString(strconv.Itoa(column))
 	_, _ = buffer.WriteRune(':')
+	if f.severity == SeverityWarning {
+		_, _ = buffer.WriteString("warning: ")
+	}
 	_, _ = buffer.WriteString(message)
 	return buffer.String()
 }
@@ -148,6 +148,10 @@ func groupAnnotationsByPath(annotations []FileAnnotation) [][]FileAnnotation {
 
 func printFileAnnotationAsText(buffer *bytes.Buffer, f FileAnnotation) error {
 	_, _ = buffer.WriteString(f.String())
+	for _, relatedInformation := range f.RelatedInformation() {
+		_, _ = buffer.WriteString("\n\t")
+		_, _ = buffer.WriteString(relatedInformation.String())
+	}
 	return nil
 }
 
@@ -182,7 +186,12 @@ func printFileAnnotationAsMSVS(buffer *bytes.Buffer, f FileAnnotation) error {
 		_, _ = buffer.WriteRune(',')
 		_, _ = buffer.WriteString(strconv.Itoa(column))
 	}
-	_, _ = buffer.WriteString(") : error ")
+	_, _ = buffer.WriteString(") : ")
+	if f.Severity() == SeverityWarning {
+		_, _ = buffer.WriteString("warning ")
+	} else {
+		_, _ = buffer.WriteString("error ")
+	}
 	_, _ = buffer.WriteString(typeString)
 	_, _ = buffer.WriteString(" : ")
 	_, _ = buffer.WriteString(message)
@@ -206,6 +215,20 @@ type externalFileAnnotation struct {
 	EndColumn   int    `json:"end_column,omitempty" yaml:"end_column,omitempty"`
 	Type        string `json:"type,omitempty" yaml:"type,omitempty"`
 	Message     string `json:"message,omitempty" yaml:"message,omitempty"`
+	// Severity is only set for annotations that are not errors, so that the
+	// output for errors is unchanged.
+	Severity string `json:"severity,omitempty" yaml:"severity,omitempty"`
+
+	Related []externalRelatedInformation `json:"related,omitempty" yaml:"related,omitempty"`
+
+	// These are only set for annotations that report changes, such as breaking changes.
+	Categories []string                    `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Descriptor string                      `json:"descriptor,omitempty" yaml:"descriptor,omitempty"`
+	ChangeKind string                      `json:"change_kind,omitempty" yaml:"change_kind,omitempty"`
+	Against    *externalRelatedInformation `json:"against,omitempty" yaml:"against,omitempty"`
+
+	// Owners is only set if owners are configured for the file.
+	Owners []string `json:"owners,omitempty" yaml:"owners,omitempty"`
 }
 
 func newExternalFileAnnotation(f FileAnnotation) externalFileAnnotation {
@@ -213,6 +236,23 @@ func newExternalFileAnnotation(f FileAnnotation) externalFileAnnotation {
 	if f.FileInfo() != nil {
 		path = f.FileInfo().ExternalPath()
 	}
+	var related []externalRelatedInformation
+	for _, relatedInformation := range f.RelatedInformation() {
+		related = append(related, newExternalRelatedInformation(relatedInformation))
+	}
+	severity := ""
+	if f.Severity() != SeverityError {
+		severity = f.Severity().String()
+	}
+	changeKind := ""
+	if f.ChangeKind() != 0 {
+		changeKind = f.ChangeKind().String()
+	}
+	var against *externalRelatedInformation
+	if f.AgainstLocation() != nil {
+		externalAgainstLocation := newExternalRelatedInformation(f.AgainstLocation())
+		against = &externalAgainstLocation
+	}
 	return externalFileAnnotation{
 		Path:        path,
 		StartLine:   atLeast1(f.StartLine()),
@@ -221,6 +261,37 @@ func newExternalFileAnnotation(f FileAnnotation) externalFileAnnotation {
 		EndColumn:   atLeast1(f.EndColumn()),
 		Type:        f.Type(),
 		Message:     f.Message(),
+		Severity:    severity,
+		Related:     related,
+		Categories:  f.Categories(),
+		Descriptor:  f.Descriptor(),
+		ChangeKind:  changeKind,
+		Against:     against,
+		Owners:      f.Owners(),
+	}
+}
+
+type externalRelatedInformation struct {
+	Path        string `json:"path,omitempty" yaml:"path,omitempty"`
+	StartLine   int    `json:"start_line,omitempty" yaml:"start_line,omitempty"`
+	StartColumn int    `json:"start_column,omitempty" yaml:"start_column,omitempty"`
+	EndLine     int    `json:"end_line,omitempty" yaml:"end_line,omitempty"`
+	EndColumn   int    `json:"end_column,omitempty" yaml:"end_column,omitempty"`
+	Message     string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+func newExternalRelatedInformation(r RelatedInformation) externalRelatedInformation {
+	path := ""
+	if r.FileInfo() != nil {
+		path = r.FileInfo().ExternalPath()
+	}
+	return externalRelatedInformation{
+		Path:        path,
+		StartLine:   atLeast1(r.StartLine()),
+		StartColumn: atLeast1(r.StartColumn()),
+		EndLine:     atLeast1(r.EndLine()),
+		EndColumn:   atLeast1(r.EndColumn()),
+		Message:     r.Message(),
 	}
 }
 
@@ -0,0 +1,90 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufanalysis
+
+import (
+	"bytes"
+	"strconv"
+)
+
+type relatedInformation struct {
+	fileInfo    FileInfo
+	startLine   int
+	startColumn int
+	endLine     int
+	endColumn   int
+	message     string
+}
+
+func newRelatedInformation(
+	fileInfo FileInfo,
+	startLine int,
+	startColumn int,
+	endLine int,
+	endColumn int,
+	message string,
+) *relatedInformation {
+	return &relatedInformation{
+		fileInfo:    fileInfo,
+		startLine:   startLine,
+		startColumn: startColumn,
+		endLine:     endLine,
+		endColumn:   endColumn,
+		message:     message,
+	}
+}
+
+func (r *relatedInformation) FileInfo() FileInfo {
+	return r.fileInfo
+}
+
+func (r *relatedInformation) StartLine() int {
+	return r.startLine
+}
+
+func (r *relatedInformation) StartColumn() int {
+	return r.startColumn
+}
+
+func (r *relatedInformation) EndLine() int {
+	return r.endLine
+}
+
+func (r *relatedInformation) EndColumn() int {
+	return r.endColumn
+}
+
+func (r *relatedInformation) Message() string {
+	return r.message
+}
+
+func (r *relatedInformation) String() string {
+	if r == nil {
+		return ""
+	}
+	path := "<input>"
+	if r.fileInfo != nil {
+		path = r.fileInfo.ExternalPath()
+	}
+	buffer := bytes.NewBuffer(nil)
+	_, _ = buffer.WriteString(path)
+	_, _ = buffer.WriteRune(':')
+	_, _ = buffer.WriteString(strconv.Itoa(atLeast1(r.startLine)))
+	_, _ = buffer.WriteRune(':')
+	_, _ = buffer.WriteString(strconv.Itoa(atLeast1(r.startColumn)))
+	_, _ = buffer.WriteRune(':')
+	_, _ = buffer.WriteString(r.message)
+	return buffer.String()
+}
@@ -16,6 +16,7 @@
 package bufapimodule
 
 import (
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
 	"github.com/bufbuild/buf/private/pkg/connectclient"
@@ -58,6 +59,17 @@ func WithTamperProofing() ModuleReaderOption {
 	}
 }
 
+// WithManifestSignatureVerifier requires every downloaded module to have a
+// manifest signature that verifies with the given verifier.
+//
+// This implies WithTamperProofing, as signatures are made over manifests.
+func WithManifestSignatureVerifier(verifier bufmanifest.SignatureVerifier) ModuleReaderOption {
+	return func(reader *moduleReader) {
+		reader.tamperProofingEnabled = true
+		reader.signatureVerifier = verifier
+	}
+}
+
 // NewModuleResolver returns a new ModuleResolver backed by the resolve service.
 func NewModuleResolver(
 	logger *zap.Logger,
@@ -17,6 +17,7 @@ package bufapimodule
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
@@ -29,6 +30,7 @@ import (
 type moduleReader struct {
 	downloadClientFactory DownloadServiceClientFactory
 	tamperProofingEnabled bool
+	signatureVerifier     bufmanifest.SignatureVerifier
 }
 
 func newModuleReader(
@@ -71,11 +73,24 @@ func (m *moduleReader) GetModule(ctx context.Context, modulePin bufmoduleref.Mod
 		if err != nil {
 			return nil, err
 		}
+		if m.signatureVerifier != nil {
+			if len(resp.ManifestSignature) == 0 {
+				return nil, fmt.Errorf("module %s has no manifest signature", modulePin.String())
+			}
+			if err := bufmanifest.VerifyManifestSignature(moduleManifest, resp.ManifestSignature, m.signatureVerifier); err != nil {
+				return nil, fmt.Errorf("module %s: %w", modulePin.String(), err)
+			}
+		}
 		blobSet, err := bufmanifest.NewBlobSetFromProto(ctx, resp.Blobs)
 		if err != nil {
 			return nil, err
 		}
-		return bufmodule.NewModuleForManifestAndBlobSet(ctx, moduleManifest, blobSet)
+		return bufmodule.NewModuleForManifestAndBlobSet(
+			ctx,
+			moduleManifest,
+			blobSet,
+			bufmodule.ModuleWithManifestSignature(resp.ManifestSignature),
+		)
 	}
 	resp, err := m.download(ctx, modulePin)
 	if err != nil {
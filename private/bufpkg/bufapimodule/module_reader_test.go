@@ -16,6 +16,11 @@ package bufapimodule
 
 import (
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"testing"
 	"time"
@@ -123,6 +128,75 @@ func TestDownload(t *testing.T) {
 	)
 }
 
+func TestDownloadWithManifestSignatureVerifier(t *testing.T) {
+	t.Parallel()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	verifier, err := bufmanifest.NewKeyVerifier(
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}),
+	)
+	require.NoError(t, err)
+	files := map[string][]byte{
+		"foo.proto": []byte(`syntax = "proto3";`),
+	}
+	testDownloadWithManifestSignatureVerifier(
+		t,
+		"valid signature",
+		verifier,
+		newMockDownloadService(t, withBlobsFromMap(files), withManifestSignature(privateKey)),
+		"",
+	)
+	testDownloadWithManifestSignatureVerifier(
+		t,
+		"signature by other key",
+		verifier,
+		newMockDownloadService(t, withBlobsFromMap(files), withManifestSignature(otherPrivateKey)),
+		"no valid manifest signature",
+	)
+	testDownloadWithManifestSignatureVerifier(
+		t,
+		"no signature",
+		verifier,
+		newMockDownloadService(t, withBlobsFromMap(files)),
+		"has no manifest signature",
+	)
+}
+
+func testDownloadWithManifestSignatureVerifier(
+	t *testing.T,
+	desc string,
+	verifier bufmanifest.SignatureVerifier,
+	mock *mockDownloadService,
+	errorContains string,
+) {
+	t.Helper()
+	t.Run(desc, func(t *testing.T) {
+		t.Parallel()
+		moduleReader := newModuleReader(mock.factory, WithManifestSignatureVerifier(verifier))
+		pin, err := bufmoduleref.NewModulePin(
+			"remote",
+			"owner",
+			"repository",
+			"branch",
+			"commit",
+			"digest",
+			time.Now(),
+		)
+		require.NoError(t, err)
+		module, err := moduleReader.GetModule(context.Background(), pin)
+		if errorContains != "" {
+			assert.ErrorContains(t, err, errorContains)
+		} else {
+			assert.NotNil(t, module)
+			assert.NoError(t, err)
+		}
+	})
+}
+
 func testDownload(
 	t *testing.T,
 	desc string,
@@ -160,10 +234,11 @@ func testDownload(
 }
 
 type mockDownloadService struct {
-	module       *modulev1alpha1.Module
-	manifestBlob *modulev1alpha1.Blob
-	blobs        []*modulev1alpha1.Blob
-	err          error
+	module            *modulev1alpha1.Module
+	manifestBlob      *modulev1alpha1.Blob
+	blobs             []*modulev1alpha1.Blob
+	manifestSignature []byte
+	err               error
 }
 
 type option interface {
@@ -206,6 +281,26 @@ func withBlobsFromMap(files map[string][]byte) option {
 	return filemap(files)
 }
 
+type manifestSigner struct{ signer crypto.Signer }
+
+func (ms manifestSigner) apply(m *mockDownloadService) error {
+	moduleManifest, err := bufmanifest.NewManifestFromProto(context.Background(), m.manifestBlob)
+	if err != nil {
+		return err
+	}
+	envelope, err := bufmanifest.SignManifest(moduleManifest, ms.signer)
+	if err != nil {
+		return err
+	}
+	m.manifestSignature, err = bufmanifest.MarshalEnvelope(envelope)
+	return err
+}
+
+// withManifestSignature signs the manifest set by a previous option.
+func withManifestSignature(signer crypto.Signer) option {
+	return manifestSigner{signer: signer}
+}
+
 type retErr struct{ err error }
 
 func (re retErr) apply(m *mockDownloadService) error {
@@ -265,7 +360,8 @@ func (m *mockDownloadService) DownloadManifestAndBlobs(
 		return nil, m.err
 	}
 	return connect.NewResponse(&registryv1alpha1.DownloadManifestAndBlobsResponse{
-		Manifest: m.manifestBlob,
-		Blobs:    m.blobs,
+		Manifest:          m.manifestBlob,
+		Blobs:             m.blobs,
+		ManifestSignature: m.manifestSignature,
 	}), nil
 }
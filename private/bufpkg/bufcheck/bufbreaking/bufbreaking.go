@@ -25,6 +25,7 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/internal/bufbreakingv1"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/internal/bufbreakingv1beta1"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufcheckplugin"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
@@ -36,7 +37,9 @@ type Handler interface {
 	// Check runs the breaking checks.
 	//
 	// The image should have source code info for this to work properly. The previousImage
-	// does not need to have source code info.
+	// does not need to have source code info. Lazy source code info, see
+	// bufimage.ImageFileWithLazySourceCodeInfo, is only loaded for the files with
+	// FileAnnotations, unless the config has plugins.
 	//
 	// Images should be filtered with regards to imports before passing to this function.
 	Check(
@@ -48,8 +51,21 @@ type Handler interface {
 }
 
 // NewHandler returns a new Handler.
-func NewHandler(logger *zap.Logger) Handler {
-	return newHandler(logger)
+func NewHandler(logger *zap.Logger, options ...HandlerOption) Handler {
+	return newHandler(logger, options...)
+}
+
+// HandlerOption is an option for a new Handler.
+type HandlerOption func(*handler)
+
+// HandlerWithPluginRunner returns a new HandlerOption that runs the check
+// plugins in the Config with the given Runner.
+//
+// If a Config has plugins and this option is not set, Check returns an error.
+func HandlerWithPluginRunner(pluginRunner bufcheckplugin.Runner) HandlerOption {
+	return func(handler *handler) {
+		handler.pluginRunner = pluginRunner
+	}
 }
 
 // RulesForConfig returns the rules for a given config.
@@ -122,7 +138,10 @@ func internalConfigForConfig(config *bufbreakingconfig.Config) (*internal.Config
 		Except:                        config.Except,
 		IgnoreRootPaths:               config.IgnoreRootPaths,
 		IgnoreIDOrCategoryToRootPaths: config.IgnoreIDOrCategoryToRootPaths,
+		AllowCommentIgnores:           config.AllowCommentIgnores,
 		IgnoreUnstablePackages:        config.IgnoreUnstablePackages,
+		FrozenOptions:                 config.FrozenOptions,
+		AppendOnlyOptions:             config.AppendOnlyOptions,
 	}.NewConfig(
 		versionSpec,
 	)
@@ -23,6 +23,7 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis/bufanalysistesting"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal/internaltesting"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagebuild"
@@ -216,6 +217,75 @@ func TestRunBreakingFieldSameJSType(t *testing.T) {
 	)
 }
 
+func TestRunBreakingProtovalidate(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_protovalidate",
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 8, 3, 8, 61, "FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 9, 3, 9, 63, "FIELD_NO_NEW_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 11, 3, 11, 57, "FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 12, 3, 12, 67, "FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 14, 3, 17, 6, "FIELD_NO_NEW_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 14, 3, 17, 6, "FIELD_NO_NEW_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 18, 3, 18, 61, "FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 19, 3, 22, 5, "FIELD_NO_NEW_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 19, 3, 22, 5, "FIELD_NO_NEW_PROTOVALIDATE_CONSTRAINTS"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 24, 3, 24, 68, "FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS"),
+	)
+}
+
+func TestRunBreakingExtensionDeclarations(t *testing.T) {
+	t.Parallel()
+	// Declarations cannot be compiled from source with the bundled descriptor.proto,
+	// so they are set on the options of the extension ranges of the built Images.
+	fileAnnotations := testBreakingGetFileAnnotationsWithImageFunc(
+		t,
+		"breaking_extension_declarations",
+		func(image bufimage.Image, previous bool) {
+			if previous {
+				internaltesting.SetExtensionDeclarations(
+					t,
+					image,
+					"One",
+					internaltesting.ExtensionDeclaration{Number: 100, FullName: ".a.foo", Type: "string"},
+					internaltesting.ExtensionDeclaration{Number: 101, FullName: ".a.bar", Type: "int32"},
+					internaltesting.ExtensionDeclaration{Number: 102, Reserved: true},
+					internaltesting.ExtensionDeclaration{Number: 103, FullName: ".a.baz", Type: "int32"},
+					internaltesting.ExtensionDeclaration{Number: 104, FullName: ".a.qux", Type: ".a.Two"},
+				)
+				internaltesting.SetExtensionDeclarations(
+					t,
+					image,
+					"Two",
+					internaltesting.ExtensionDeclaration{Number: 100, Reserved: true},
+				)
+				return
+			}
+			internaltesting.SetExtensionDeclarations(
+				t,
+				image,
+				"One",
+				internaltesting.ExtensionDeclaration{Number: 100, FullName: ".a.foo", Type: "string"},
+				internaltesting.ExtensionDeclaration{Number: 101, FullName: ".a.bar2", Type: "int64", Repeated: true},
+				internaltesting.ExtensionDeclaration{Number: 102, FullName: ".a.reused", Type: "int32"},
+				internaltesting.ExtensionDeclaration{Number: 103, Reserved: true},
+			)
+		},
+	)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			bufanalysistesting.NewFileAnnotation(t, "1.proto", 5, 1, 7, 2, "EXTENSION_DECLARATION_NO_DELETE"),
+			bufanalysistesting.NewFileAnnotation(t, "1.proto", 6, 14, 6, 24, "EXTENSION_DECLARATION_SAME_EXTENSION"),
+			bufanalysistesting.NewFileAnnotation(t, "1.proto", 6, 14, 6, 24, "EXTENSION_DECLARATION_SAME_EXTENSION"),
+			bufanalysistesting.NewFileAnnotation(t, "1.proto", 6, 14, 6, 24, "EXTENSION_DECLARATION_SAME_EXTENSION"),
+			bufanalysistesting.NewFileAnnotation(t, "1.proto", 6, 14, 6, 24, "EXTENSION_DECLARATION_SAME_EXTENSION"),
+			bufanalysistesting.NewFileAnnotation(t, "1.proto", 9, 1, 11, 2, "EXTENSION_DECLARATION_NO_DELETE"),
+		},
+		fileAnnotations,
+	)
+}
+
 func TestRunBreakingFieldSameLabel(t *testing.T) {
 	testBreaking(
 		t,
@@ -352,6 +422,45 @@ func TestRunBreakingFieldWireJSONCompatibleType(t *testing.T) {
 	)
 }
 
+func TestRunBreakingFieldJSONCompatibleType(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_field_json_compatible_type",
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 18, 3, 18, 8, "FIELD_JSON_COMPATIBLE_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 19, 3, 19, 8, "FIELD_JSON_COMPATIBLE_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 20, 3, 20, 8, "FIELD_JSON_COMPATIBLE_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 21, 3, 21, 9, "FIELD_JSON_COMPATIBLE_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 22, 3, 22, 9, "FIELD_JSON_COMPATIBLE_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 23, 3, 23, 8, "FIELD_JSON_COMPATIBLE_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 44, 3, 44, 11, "FIELD_JSON_COMPATIBLE_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 46, 3, 46, 6, "FIELD_JSON_COMPATIBLE_TYPE"),
+	)
+}
+
+func TestRunBreakingJSON(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_json",
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 7, 20, 7, 21, "ENUM_VALUE_SAME_NAME"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 15, 21, 15, 40, "FIELD_SAME_JSON_NAME"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 17, 3, 17, 8, "FIELD_JSON_COMPATIBLE_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "2.proto", 19, 5, 19, 19, "FIELD_SAME_ONEOF"),
+	)
+}
+
+func TestRunBreakingFrozen(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_frozen",
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 7, 9, 7, 15, "FROZEN"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 24, 1, 30, 2, "APPEND_ONLY"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 28, 3, 28, 19, "APPEND_ONLY"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 32, 6, 32, 16, "FROZEN"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 38, 1, 43, 2, "APPEND_ONLY"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 41, 3, 41, 23, "APPEND_ONLY"),
+	)
+}
+
 func TestRunBreakingFileNoDelete(t *testing.T) {
 	testBreaking(
 		t,
@@ -670,6 +779,60 @@ func TestRunBreakingIgnoreUnstablePackagesDeleteFile(t *testing.T) {
 	)
 }
 
+func TestRunBreakingCommentIgnoresOff(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_comment_ignores_off",
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 7, 3, 7, 8, "FIELD_SAME_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 8, 3, 8, 8, "FIELD_SAME_TYPE"),
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 12, 1, 14, 2, "FIELD_NO_DELETE"),
+	)
+}
+
+func TestRunBreakingCommentIgnoresOn(t *testing.T) {
+	testBreaking(
+		t,
+		"breaking_comment_ignores_on",
+		bufanalysistesting.NewFileAnnotation(t, "1.proto", 8, 3, 8, 8, "FIELD_SAME_TYPE"),
+	)
+}
+
+func TestRunBreakingChangeMetadata(t *testing.T) {
+	t.Parallel()
+	// The previous image is built without source code info, so only the path
+	// of the against location is checked.
+	fileAnnotations := testBreakingGetFileAnnotations(t, "breaking_change_metadata")
+	require.Len(t, fileAnnotations, 3)
+	typeToFileAnnotation := make(map[string]bufanalysis.FileAnnotation, len(fileAnnotations))
+	for _, fileAnnotation := range fileAnnotations {
+		typeToFileAnnotation[fileAnnotation.Type()] = fileAnnotation
+	}
+
+	fileAnnotation := typeToFileAnnotation["FIELD_NO_DELETE"]
+	require.NotNil(t, fileAnnotation)
+	assert.Equal(t, []string{"FILE", "PACKAGE"}, fileAnnotation.Categories())
+	assert.Equal(t, "a.Foo.three", fileAnnotation.Descriptor())
+	assert.Equal(t, bufanalysis.ChangeKindRemoved, fileAnnotation.ChangeKind())
+	require.NotNil(t, fileAnnotation.AgainstLocation())
+	assert.Equal(t, "1.proto", fileAnnotation.AgainstLocation().FileInfo().Path())
+
+	fileAnnotation = typeToFileAnnotation["FIELD_SAME_NAME"]
+	require.NotNil(t, fileAnnotation)
+	assert.Equal(t, []string{"FILE", "PACKAGE", "WIRE_JSON"}, fileAnnotation.Categories())
+	assert.Equal(t, "a.Foo.two", fileAnnotation.Descriptor())
+	assert.Equal(t, bufanalysis.ChangeKindRenamed, fileAnnotation.ChangeKind())
+	require.NotNil(t, fileAnnotation.AgainstLocation())
+	assert.Equal(t, "1.proto", fileAnnotation.AgainstLocation().FileInfo().Path())
+
+	fileAnnotation = typeToFileAnnotation["FIELD_SAME_TYPE"]
+	require.NotNil(t, fileAnnotation)
+	assert.Equal(t, []string{"FILE", "PACKAGE"}, fileAnnotation.Categories())
+	assert.Equal(t, "a.Foo.one", fileAnnotation.Descriptor())
+	assert.Equal(t, bufanalysis.ChangeKindTypeChanged, fileAnnotation.ChangeKind())
+	require.NotNil(t, fileAnnotation.AgainstLocation())
+	assert.Equal(t, "1.proto", fileAnnotation.AgainstLocation().FileInfo().Path())
+}
+
 func TestRunBreakingIntEnum(t *testing.T) {
 	testBreaking(
 		t,
@@ -715,6 +878,28 @@ func testBreaking(
 	expectedFileAnnotations ...bufanalysis.FileAnnotation,
 ) {
 	t.Parallel()
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		expectedFileAnnotations,
+		testBreakingGetFileAnnotations(t, relDirPath),
+	)
+}
+
+func testBreakingGetFileAnnotations(
+	t *testing.T,
+	relDirPath string,
+) []bufanalysis.FileAnnotation {
+	return testBreakingGetFileAnnotationsWithImageFunc(t, relDirPath, nil)
+}
+
+// testBreakingGetFileAnnotationsWithImageFunc is testBreakingGetFileAnnotations, with
+// a function that can modify the built previous and current Images before they are
+// checked.
+func testBreakingGetFileAnnotationsWithImageFunc(
+	t *testing.T,
+	relDirPath string,
+	imageFunc func(image bufimage.Image, previous bool),
+) []bufanalysis.FileAnnotation {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	logger := zap.NewNop()
@@ -781,6 +966,10 @@ func testBreaking(
 	require.NoError(t, err)
 	require.Empty(t, fileAnnotations)
 	image = bufimage.ImageWithoutImports(image)
+	if imageFunc != nil {
+		imageFunc(previousImage, true)
+		imageFunc(image, false)
+	}
 
 	handler := bufbreaking.NewHandler(logger)
 	fileAnnotations, err = handler.Check(
@@ -790,11 +979,7 @@ func testBreaking(
 		image,
 	)
 	assert.NoError(t, err)
-	bufanalysistesting.AssertFileAnnotationsEqual(
-		t,
-		expectedFileAnnotations,
-		fileAnnotations,
-	)
+	return fileAnnotations
 }
 
 func testGetConfig(
@@ -18,7 +18,10 @@ import (
 	"encoding/json"
 	"sort"
 
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 	breakingv1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/breaking/v1"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
 )
 
 const (
@@ -46,8 +49,37 @@ type Config struct {
 	//   v\d+(alpha|beta)\d+
 	//   v\d+p\d+(alpha|beta)\d+
 	IgnoreUnstablePackages bool
+	// AllowCommentIgnores turns on comment-driven ignores.
+	AllowCommentIgnores bool
+	// FrozenOptions are the fully-qualified names of the bool message and enum options that mark
+	// messages and enums as frozen. Frozen messages and enums must not change.
+	FrozenOptions []string
+	// AppendOnlyOptions are the fully-qualified names of the bool message and enum options that mark
+	// messages and enums as append-only. Existing fields of append-only messages and values of
+	// append-only enums must not be deleted or changed, but new ones can be added.
+	AppendOnlyOptions []string
 	// Version represents the version of the breaking change rule and category IDs that should be used with this config.
 	Version string
+	// Plugins are the check plugins that implement custom breaking change rules.
+	//
+	// Plugins are run on all files with this config, so the configs within Overrides never
+	// have Plugins. Plugins are not part of the proto representation of the config.
+	Plugins []*PluginConfig
+	// Overrides is a map of directory and/or file paths to the configs that are used in place of this
+	// config for the files within these paths. All paths are relative to the root of the module.
+	//
+	// Each config is this config merged with the override, see ExternalOverrideConfigV1. If multiple
+	// paths contain a file, the config for the most specific path is used. The configs within
+	// Overrides never have Overrides of their own.
+	Overrides map[string]*Config
+}
+
+// PluginConfig is the configuration for a check plugin.
+type PluginConfig struct {
+	// Plugin is the name or path of the plugin binary.
+	Plugin string
+	// Args are the arguments to pass to the plugin.
+	Args []string
 }
 
 // NewConfigV1Beta1 returns a new Config.
@@ -64,14 +96,25 @@ func NewConfigV1Beta1(externalConfig ExternalConfigV1Beta1) *Config {
 
 // NewConfigV1 returns a new Config.
 func NewConfigV1(externalConfig ExternalConfigV1) *Config {
-	return &Config{
+	// ignore_only_by_path is ignore_only keyed by path instead of by rule and/or category ID.
+	ignoreIDOrCategoryToRootPaths := internal.MergeIgnoreIDOrCategoryToRootPaths(
+		externalConfig.IgnoreOnly,
+		internal.IgnoreIDOrCategoryToRootPathsForRootPathToIDOrCategories(externalConfig.IgnoreOnlyByPath),
+	)
+	config := &Config{
 		Use:                           externalConfig.Use,
 		Except:                        externalConfig.Except,
 		IgnoreRootPaths:               externalConfig.Ignore,
-		IgnoreIDOrCategoryToRootPaths: externalConfig.IgnoreOnly,
+		IgnoreIDOrCategoryToRootPaths: ignoreIDOrCategoryToRootPaths,
 		IgnoreUnstablePackages:        externalConfig.IgnoreUnstablePackages,
+		AllowCommentIgnores:           externalConfig.AllowCommentIgnores,
+		FrozenOptions:                 externalConfig.FrozenOptions,
+		AppendOnlyOptions:             externalConfig.AppendOnlyOptions,
 		Version:                       v1Version,
+		Plugins:                       pluginConfigsForExternalPluginConfigs(externalConfig.Plugins),
 	}
+	config.Overrides = overrideConfigsForExternalOverrideConfigs(config, externalConfig.Overrides)
+	return config
 }
 
 // ConfigForProto returns the Config given the proto.
@@ -82,7 +125,11 @@ func ConfigForProto(protoConfig *breakingv1.Config) *Config {
 		IgnoreRootPaths:               protoConfig.GetIgnorePaths(),
 		IgnoreIDOrCategoryToRootPaths: ignoreIDOrCategoryToRootPathsForProto(protoConfig.GetIgnoreIdPaths()),
 		IgnoreUnstablePackages:        protoConfig.GetIgnoreUnstablePackages(),
+		AllowCommentIgnores:           protoConfig.GetAllowCommentIgnores(),
+		FrozenOptions:                 protoConfig.GetFrozenOptions(),
+		AppendOnlyOptions:             protoConfig.GetAppendOnlyOptions(),
 		Version:                       protoConfig.GetVersion(),
+		Overrides:                     overrideConfigsForProto(protoConfig.GetOverrides()),
 	}
 }
 
@@ -94,7 +141,11 @@ func ProtoForConfig(config *Config) *breakingv1.Config {
 		IgnorePaths:            config.IgnoreRootPaths,
 		IgnoreIdPaths:          protoForIgnoreIDOrCategoryToRootPaths(config.IgnoreIDOrCategoryToRootPaths),
 		IgnoreUnstablePackages: config.IgnoreUnstablePackages,
+		AllowCommentIgnores:    config.AllowCommentIgnores,
+		FrozenOptions:          config.FrozenOptions,
+		AppendOnlyOptions:      config.AppendOnlyOptions,
 		Version:                config.Version,
+		Overrides:              protoForOverrideConfigs(config.Overrides),
 	}
 }
 
@@ -111,13 +162,40 @@ type ExternalConfigV1Beta1 struct {
 
 // ExternalConfigV1 is an external config.
 type ExternalConfigV1 struct {
+	Use    []string `json:"use,omitempty" yaml:"use,omitempty"`
+	Except []string `json:"except,omitempty" yaml:"except,omitempty"`
+	// IgnoreRootPaths
+	Ignore []string `json:"ignore,omitempty" yaml:"ignore,omitempty"`
+	// IgnoreIDOrCategoryToRootPaths
+	IgnoreOnly             map[string][]string                 `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty"`
+	IgnoreOnlyByPath       map[string][]string                 `json:"ignore_only_by_path,omitempty" yaml:"ignore_only_by_path,omitempty"`
+	IgnoreUnstablePackages bool                                `json:"ignore_unstable_packages,omitempty" yaml:"ignore_unstable_packages,omitempty"`
+	AllowCommentIgnores    bool                                `json:"allow_comment_ignores,omitempty" yaml:"allow_comment_ignores,omitempty"`
+	FrozenOptions          []string                            `json:"frozen_options,omitempty" yaml:"frozen_options,omitempty"`
+	AppendOnlyOptions      []string                            `json:"append_only_options,omitempty" yaml:"append_only_options,omitempty"`
+	Plugins                []ExternalPluginConfigV1            `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	Overrides              map[string]ExternalOverrideConfigV1 `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+}
+
+// ExternalOverrideConfigV1 is an external config for the files within a path.
+//
+// It is merged with the config that contains it: the fields that are set replace the
+// fields of this config, except for ignore and ignore_only, which are added to them.
+type ExternalOverrideConfigV1 struct {
 	Use    []string `json:"use,omitempty" yaml:"use,omitempty"`
 	Except []string `json:"except,omitempty" yaml:"except,omitempty"`
 	// IgnoreRootPaths
 	Ignore []string `json:"ignore,omitempty" yaml:"ignore,omitempty"`
 	// IgnoreIDOrCategoryToRootPaths
 	IgnoreOnly             map[string][]string `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty"`
-	IgnoreUnstablePackages bool                `json:"ignore_unstable_packages,omitempty" yaml:"ignore_unstable_packages,omitempty"`
+	IgnoreUnstablePackages *bool               `json:"ignore_unstable_packages,omitempty" yaml:"ignore_unstable_packages,omitempty"`
+	AllowCommentIgnores    *bool               `json:"allow_comment_ignores,omitempty" yaml:"allow_comment_ignores,omitempty"`
+}
+
+// ExternalPluginConfigV1 is an external check plugin config.
+type ExternalPluginConfigV1 struct {
+	Plugin string   `json:"plugin,omitempty" yaml:"plugin,omitempty"`
+	Args   []string `json:"args,omitempty" yaml:"args,omitempty"`
 }
 
 // ExternalConfigV1Beta1ForConfig takes a *Config and returns the v1beta1 external config representation.
@@ -139,6 +217,11 @@ func ExternalConfigV1ForConfig(config *Config) ExternalConfigV1 {
 		Ignore:                 config.IgnoreRootPaths,
 		IgnoreOnly:             config.IgnoreIDOrCategoryToRootPaths,
 		IgnoreUnstablePackages: config.IgnoreUnstablePackages,
+		AllowCommentIgnores:    config.AllowCommentIgnores,
+		FrozenOptions:          config.FrozenOptions,
+		AppendOnlyOptions:      config.AppendOnlyOptions,
+		Plugins:                externalPluginConfigsForPluginConfigs(config.Plugins),
+		Overrides:              externalOverrideConfigsForOverrideConfigs(config, config.Overrides),
 	}
 }
 
@@ -153,12 +236,27 @@ func BytesForConfig(config *Config) ([]byte, error) {
 }
 
 type configJSON struct {
-	Use                           []string      `json:"use,omitempty"`
-	Except                        []string      `json:"except,omitempty"`
-	IgnoreRootPaths               []string      `json:"ignore_root_paths,omitempty"`
-	IgnoreIDOrCategoryToRootPaths []idPathsJSON `json:"ignore_id_to_root_paths,omitempty"`
-	IgnoreUnstablePackages        bool          `json:"ignore_unstable_packages,omitempty"`
-	Version                       string        `json:"version,omitempty"`
+	Use                           []string       `json:"use,omitempty"`
+	Except                        []string       `json:"except,omitempty"`
+	IgnoreRootPaths               []string       `json:"ignore_root_paths,omitempty"`
+	IgnoreIDOrCategoryToRootPaths []idPathsJSON  `json:"ignore_id_to_root_paths,omitempty"`
+	IgnoreUnstablePackages        bool           `json:"ignore_unstable_packages,omitempty"`
+	AllowCommentIgnores           bool           `json:"allow_comment_ignores,omitempty"`
+	FrozenOptions                 []string       `json:"frozen_options,omitempty"`
+	AppendOnlyOptions             []string       `json:"append_only_options,omitempty"`
+	Version                       string         `json:"version,omitempty"`
+	Plugins                       []pluginJSON   `json:"plugins,omitempty"`
+	Overrides                     []overrideJSON `json:"overrides,omitempty"`
+}
+
+type overrideJSON struct {
+	RootPath string      `json:"root_path,omitempty"`
+	Config   *configJSON `json:"config,omitempty"`
+}
+
+type pluginJSON struct {
+	Plugin string   `json:"plugin,omitempty"`
+	Args   []string `json:"args,omitempty"`
 }
 
 type idPathsJSON struct {
@@ -189,14 +287,164 @@ func configToJSON(config *Config) *configJSON {
 	sort.Strings(use)
 	sort.Strings(except)
 	sort.Strings(ignoreRootPaths)
+	frozenOptions := make([]string, len(config.FrozenOptions))
+	copy(frozenOptions, config.FrozenOptions)
+	sort.Strings(frozenOptions)
+	appendOnlyOptions := make([]string, len(config.AppendOnlyOptions))
+	copy(appendOnlyOptions, config.AppendOnlyOptions)
+	sort.Strings(appendOnlyOptions)
+	// Plugins are run in order, so they are not sorted.
+	var pluginsJSON []pluginJSON
+	for _, pluginConfig := range config.Plugins {
+		pluginsJSON = append(pluginsJSON, pluginJSON{
+			Plugin: pluginConfig.Plugin,
+			Args:   pluginConfig.Args,
+		})
+	}
+	overridesJSON := make([]overrideJSON, 0, len(config.Overrides))
+	for rootPath, overrideConfig := range config.Overrides {
+		overridesJSON = append(overridesJSON, overrideJSON{
+			RootPath: rootPath,
+			Config:   configToJSON(overrideConfig),
+		})
+	}
+	sort.Slice(overridesJSON, func(i, j int) bool { return overridesJSON[i].RootPath < overridesJSON[j].RootPath })
 	return &configJSON{
 		Use:                           use,
 		Except:                        except,
 		IgnoreRootPaths:               ignoreRootPaths,
 		IgnoreIDOrCategoryToRootPaths: ignoreIDPathsJSON,
 		IgnoreUnstablePackages:        config.IgnoreUnstablePackages,
+		AllowCommentIgnores:           config.AllowCommentIgnores,
+		FrozenOptions:                 frozenOptions,
+		AppendOnlyOptions:             appendOnlyOptions,
 		Version:                       config.Version,
+		Plugins:                       pluginsJSON,
+		Overrides:                     overridesJSON,
+	}
+}
+
+func pluginConfigsForExternalPluginConfigs(externalPluginConfigs []ExternalPluginConfigV1) []*PluginConfig {
+	if len(externalPluginConfigs) == 0 {
+		return nil
+	}
+	pluginConfigs := make([]*PluginConfig, len(externalPluginConfigs))
+	for i, externalPluginConfig := range externalPluginConfigs {
+		pluginConfigs[i] = &PluginConfig{
+			Plugin: externalPluginConfig.Plugin,
+			Args:   externalPluginConfig.Args,
+		}
+	}
+	return pluginConfigs
+}
+
+func externalPluginConfigsForPluginConfigs(pluginConfigs []*PluginConfig) []ExternalPluginConfigV1 {
+	if len(pluginConfigs) == 0 {
+		return nil
+	}
+	externalPluginConfigs := make([]ExternalPluginConfigV1, len(pluginConfigs))
+	for i, pluginConfig := range pluginConfigs {
+		externalPluginConfigs[i] = ExternalPluginConfigV1{
+			Plugin: pluginConfig.Plugin,
+			Args:   pluginConfig.Args,
+		}
+	}
+	return externalPluginConfigs
+}
+
+func overrideConfigsForExternalOverrideConfigs(
+	config *Config,
+	externalOverrideConfigs map[string]ExternalOverrideConfigV1,
+) map[string]*Config {
+	if len(externalOverrideConfigs) == 0 {
+		return nil
+	}
+	overrideConfigs := make(map[string]*Config, len(externalOverrideConfigs))
+	for rootPath, externalOverrideConfig := range externalOverrideConfigs {
+		overrideConfig := &Config{
+			Use:                           config.Use,
+			Except:                        config.Except,
+			IgnoreRootPaths:               internal.MergeIgnoreRootPaths(config.IgnoreRootPaths, externalOverrideConfig.Ignore),
+			IgnoreIDOrCategoryToRootPaths: internal.MergeIgnoreIDOrCategoryToRootPaths(config.IgnoreIDOrCategoryToRootPaths, externalOverrideConfig.IgnoreOnly),
+			IgnoreUnstablePackages:        config.IgnoreUnstablePackages,
+			AllowCommentIgnores:           config.AllowCommentIgnores,
+			FrozenOptions:                 config.FrozenOptions,
+			AppendOnlyOptions:             config.AppendOnlyOptions,
+			Version:                       config.Version,
+		}
+		if len(externalOverrideConfig.Use) > 0 {
+			overrideConfig.Use = externalOverrideConfig.Use
+		}
+		if len(externalOverrideConfig.Except) > 0 {
+			overrideConfig.Except = externalOverrideConfig.Except
+		}
+		if externalOverrideConfig.IgnoreUnstablePackages != nil {
+			overrideConfig.IgnoreUnstablePackages = *externalOverrideConfig.IgnoreUnstablePackages
+		}
+		if externalOverrideConfig.AllowCommentIgnores != nil {
+			overrideConfig.AllowCommentIgnores = *externalOverrideConfig.AllowCommentIgnores
+		}
+		overrideConfigs[normalpath.Normalize(rootPath)] = overrideConfig
+	}
+	return overrideConfigs
+}
+
+// externalOverrideConfigsForOverrideConfigs returns the external overrides that
+// merge with config into overrideConfigs.
+func externalOverrideConfigsForOverrideConfigs(
+	config *Config,
+	overrideConfigs map[string]*Config,
+) map[string]ExternalOverrideConfigV1 {
+	if len(overrideConfigs) == 0 {
+		return nil
+	}
+	externalOverrideConfigs := make(map[string]ExternalOverrideConfigV1, len(overrideConfigs))
+	for rootPath, overrideConfig := range overrideConfigs {
+		externalOverrideConfig := ExternalOverrideConfigV1{
+			Ignore:     internal.IgnoreRootPathsDiff(config.IgnoreRootPaths, overrideConfig.IgnoreRootPaths),
+			IgnoreOnly: internal.IgnoreIDOrCategoryToRootPathsDiff(config.IgnoreIDOrCategoryToRootPaths, overrideConfig.IgnoreIDOrCategoryToRootPaths),
+		}
+		if !stringutil.SliceElementsEqual(config.Use, overrideConfig.Use) {
+			externalOverrideConfig.Use = overrideConfig.Use
+		}
+		if !stringutil.SliceElementsEqual(config.Except, overrideConfig.Except) {
+			externalOverrideConfig.Except = overrideConfig.Except
+		}
+		if config.IgnoreUnstablePackages != overrideConfig.IgnoreUnstablePackages {
+			externalOverrideConfig.IgnoreUnstablePackages = &overrideConfig.IgnoreUnstablePackages
+		}
+		if config.AllowCommentIgnores != overrideConfig.AllowCommentIgnores {
+			externalOverrideConfig.AllowCommentIgnores = &overrideConfig.AllowCommentIgnores
+		}
+		externalOverrideConfigs[rootPath] = externalOverrideConfig
+	}
+	return externalOverrideConfigs
+}
+
+func overrideConfigsForProto(protoOverrides []*breakingv1.Override) map[string]*Config {
+	if len(protoOverrides) == 0 {
+		return nil
+	}
+	overrideConfigs := make(map[string]*Config, len(protoOverrides))
+	for _, protoOverride := range protoOverrides {
+		overrideConfigs[protoOverride.GetRootPath()] = ConfigForProto(protoOverride.GetConfig())
+	}
+	return overrideConfigs
+}
+
+func protoForOverrideConfigs(overrideConfigs map[string]*Config) []*breakingv1.Override {
+	if len(overrideConfigs) == 0 {
+		return nil
+	}
+	protoOverrides := make([]*breakingv1.Override, 0, len(overrideConfigs))
+	for rootPath, overrideConfig := range overrideConfigs {
+		protoOverrides = append(protoOverrides, &breakingv1.Override{
+			RootPath: rootPath,
+			Config:   ProtoForConfig(overrideConfig),
+		})
 	}
+	sort.Slice(protoOverrides, func(i, j int) bool { return protoOverrides[i].RootPath < protoOverrides[j].RootPath })
+	return protoOverrides
 }
 
 func ignoreIDOrCategoryToRootPathsForProto(protoIgnoreIDPaths []*breakingv1.IDPaths) map[string][]string {
@@ -16,30 +16,42 @@ package bufbreaking
 
 import (
 	"context"
+	"errors"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/internal/bufbreakingcheck"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufcheckplugin"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/protosource"
+	"github.com/bufbuild/buf/private/pkg/timing"
 	"go.uber.org/zap"
 )
 
 type handler struct {
-	logger *zap.Logger
-	runner *internal.Runner
+	logger       *zap.Logger
+	runner       *internal.Runner
+	pluginRunner bufcheckplugin.Runner
 }
 
 func newHandler(
 	logger *zap.Logger,
+	options ...HandlerOption,
 ) *handler {
-	return &handler{
+	handler := &handler{
 		logger: logger,
-		// comment ignores are not allowed for breaking changes
-		// so do not set the ignore prefix per the RunnerWithIgnorePrefix comments
-		runner: internal.NewRunner(logger),
+		runner: internal.NewRunner(
+			logger,
+			internal.RunnerWithIgnorePrefix(bufbreakingcheck.CommentIgnorePrefix),
+		),
 	}
+	for _, option := range options {
+		option(handler)
+	}
+	return handler
 }
 
 func (h *handler) Check(
@@ -47,6 +59,31 @@ func (h *handler) Check(
 	config *bufbreakingconfig.Config,
 	previousImage bufimage.Image,
 	image bufimage.Image,
+) ([]bufanalysis.FileAnnotation, error) {
+	defer timing.Start(ctx, timing.PhaseCheck)()
+	if len(config.Plugins) > 0 {
+		// plugins can look at any part of the source code info, this is a no-op
+		// if it was already loaded
+		if err := bufimage.LoadSourceCodeInfo(ctx, image); err != nil {
+			return nil, err
+		}
+		return h.checkImages(ctx, config, previousImage, image)
+	}
+	return internal.CheckWithLazySourceCodeInfo(
+		ctx,
+		image,
+		func(ctx context.Context) ([]bufanalysis.FileAnnotation, error) {
+			return h.checkImages(ctx, config, previousImage, image)
+		},
+	)
+}
+
+// checkImages runs the breaking change check for the config, its overrides and its plugins.
+func (h *handler) checkImages(
+	ctx context.Context,
+	config *bufbreakingconfig.Config,
+	previousImage bufimage.Image,
+	image bufimage.Image,
 ) ([]bufanalysis.FileAnnotation, error) {
 	previousFiles, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(previousImage.Files())...)
 	if err != nil {
@@ -56,9 +93,134 @@ func (h *handler) Check(
 	if err != nil {
 		return nil, err
 	}
+	fileAnnotations, err := h.checkFiles(ctx, config, previousFiles, files)
+	if err != nil {
+		return nil, err
+	}
+	// Plugins are only run with the config, on all files.
+	pluginFileAnnotations, err := h.checkPlugins(ctx, config, previousImage, image)
+	if err != nil {
+		return nil, err
+	}
+	fileAnnotations = append(fileAnnotations, pluginFileAnnotations...)
+	if len(pluginFileAnnotations) > 0 {
+		bufanalysis.SortFileAnnotations(fileAnnotations)
+	}
+	return fileAnnotations, nil
+}
+
+// checkFiles runs the builtin rules for the config and its overrides.
+func (h *handler) checkFiles(
+	ctx context.Context,
+	config *bufbreakingconfig.Config,
+	previousFiles []protosource.File,
+	files []protosource.File,
+) ([]bufanalysis.FileAnnotation, error) {
+	if len(config.Overrides) == 0 {
+		return h.check(ctx, config, previousFiles, files)
+	}
+	overrideRootPaths := make(map[string]struct{}, len(config.Overrides))
+	scopeConfigs := make(map[string]*bufbreakingconfig.Config, len(config.Overrides)+1)
+	scopeConfigs[""] = config
+	for rootPath, overrideConfig := range config.Overrides {
+		overrideRootPaths[rootPath] = struct{}{}
+		scopeConfigs[rootPath] = overrideConfig
+	}
+	// Each config is only run against the previous files and files within its scope, where
+	// the empty root path is the scope of the config.
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for rootPath, scopeConfig := range scopeConfigs {
+		scopePreviousFiles := internal.FilesForOverride(previousFiles, overrideRootPaths, rootPath)
+		scopeFiles := internal.FilesForOverride(files, overrideRootPaths, rootPath)
+		if len(scopePreviousFiles) == 0 && len(scopeFiles) == 0 {
+			continue
+		}
+		scopeFileAnnotations, err := h.check(ctx, scopeConfig, scopePreviousFiles, scopeFiles)
+		if err != nil {
+			return nil, err
+		}
+		fileAnnotations = append(
+			fileAnnotations,
+			internal.FileAnnotationsForOverride(scopeFileAnnotations, overrideRootPaths, rootPath)...,
+		)
+	}
+	bufanalysis.SortFileAnnotations(fileAnnotations)
+	return fileAnnotations, nil
+}
+
+// check runs the builtin rules for the config.
+func (h *handler) check(
+	ctx context.Context,
+	config *bufbreakingconfig.Config,
+	previousFiles []protosource.File,
+	files []protosource.File,
+) ([]bufanalysis.FileAnnotation, error) {
 	internalConfig, err := internalConfigForConfig(config)
 	if err != nil {
 		return nil, err
 	}
-	return h.runner.Check(ctx, internalConfig, previousFiles, files)
+	fileAnnotations, err := h.runner.Check(ctx, internalConfig, previousFiles, files)
+	if err != nil {
+		return nil, err
+	}
+	return withCategories(fileAnnotations, internalConfig.Rules), nil
+}
+
+// withCategories returns the FileAnnotations with the categories of the Rule
+// that produced each FileAnnotation attached.
+func withCategories(fileAnnotations []bufanalysis.FileAnnotation, rules []*internal.Rule) []bufanalysis.FileAnnotation {
+	idToCategories := make(map[string][]string, len(rules))
+	for _, rule := range rules {
+		idToCategories[rule.ID()] = rule.Categories()
+	}
+	for i, fileAnnotation := range fileAnnotations {
+		if categories, ok := idToCategories[fileAnnotation.Type()]; ok {
+			fileAnnotations[i] = bufanalysis.CopyFileAnnotation(
+				fileAnnotation,
+				bufanalysis.FileAnnotationWithCategories(categories...),
+			)
+		}
+	}
+	return fileAnnotations
+}
+
+func (h *handler) checkPlugins(
+	ctx context.Context,
+	config *bufbreakingconfig.Config,
+	previousImage bufimage.Image,
+	image bufimage.Image,
+) ([]bufanalysis.FileAnnotation, error) {
+	if len(config.Plugins) == 0 {
+		return nil, nil
+	}
+	if h.pluginRunner == nil {
+		return nil, errors.New("breaking plugins are not supported in this context")
+	}
+	ignoreRootPaths := make(map[string]struct{}, len(config.IgnoreRootPaths))
+	for _, rootPath := range config.IgnoreRootPaths {
+		ignoreRootPaths[normalpath.Normalize(rootPath)] = struct{}{}
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, pluginConfig := range config.Plugins {
+		pluginFileAnnotations, err := h.pluginRunner.Check(
+			ctx,
+			pluginConfig.Plugin,
+			pluginConfig.Args,
+			image,
+			previousImage,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileAnnotation := range pluginFileAnnotations {
+			// Only the ignore paths apply to plugins, as the ids and categories
+			// of the other options only refer to builtin rules.
+			if fileInfo := fileAnnotation.FileInfo(); fileInfo != nil &&
+				normalpath.MapHasEqualOrContainingPath(ignoreRootPaths, fileInfo.Path(), normalpath.Relative) {
+				continue
+			}
+			fileAnnotations = append(fileAnnotations, fileAnnotation)
+		}
+	}
+	return fileAnnotations, nil
 }
@@ -21,11 +21,25 @@
 package bufbreakingbuild
 
 import (
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/internal/bufbreakingcheck"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
+	"github.com/bufbuild/buf/private/pkg/protosource"
 )
 
 var (
+	// AppendOnlyRuleBuilder is a rule builder.
+	AppendOnlyRuleBuilder = internal.NewRuleBuilder(
+		"APPEND_ONLY",
+		func(configBuilder internal.ConfigBuilder) (string, error) {
+			return "messages and enums marked append-only by an option only have fields and values added (options are configurable)", nil
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			return internal.CheckFunc(func(id string, ignoreFunc internal.IgnoreFunc, previousFiles []protosource.File, files []protosource.File) ([]bufanalysis.FileAnnotation, error) {
+				return bufbreakingcheck.CheckAppendOnly(id, ignoreFunc, previousFiles, files, configBuilder.AppendOnlyOptions)
+			}), nil
+		},
+	)
 	// EnumNoDeleteRuleBuilder is a rule builder.
 	EnumNoDeleteRuleBuilder = internal.NewNopRuleBuilder(
 		"ENUM_NO_DELETE",
@@ -56,12 +70,30 @@ var (
 		"enum values have the same name",
 		bufbreakingcheck.CheckEnumValueSameName,
 	)
+	// ExtensionDeclarationNoDeleteRuleBuilder is a rule builder.
+	ExtensionDeclarationNoDeleteRuleBuilder = internal.NewNopRuleBuilder(
+		"EXTENSION_DECLARATION_NO_DELETE",
+		"extension declarations are not deleted from a given message",
+		bufbreakingcheck.CheckExtensionDeclarationNoDelete,
+	)
+	// ExtensionDeclarationSameExtensionRuleBuilder is a rule builder.
+	ExtensionDeclarationSameExtensionRuleBuilder = internal.NewNopRuleBuilder(
+		"EXTENSION_DECLARATION_SAME_EXTENSION",
+		"extension declarations are not changed to a different extension, and reserved extension declarations are not reused",
+		bufbreakingcheck.CheckExtensionDeclarationSameExtension,
+	)
 	// ExtensionMessageNoDeleteRuleBuilder is a rule builder.
 	ExtensionMessageNoDeleteRuleBuilder = internal.NewNopRuleBuilder(
 		"EXTENSION_MESSAGE_NO_DELETE",
 		"extension ranges are not deleted from a given message",
 		bufbreakingcheck.CheckExtensionMessageNoDelete,
 	)
+	// FieldJSONCompatibleTypeRuleBuilder is a rule builder.
+	FieldJSONCompatibleTypeRuleBuilder = internal.NewNopRuleBuilder(
+		"FIELD_JSON_COMPATIBLE_TYPE",
+		"fields have JSON compatible types in a given message",
+		bufbreakingcheck.CheckFieldJSONCompatibleType,
+	)
 	// FieldNoDeleteRuleBuilder is a rule builder.
 	FieldNoDeleteRuleBuilder = internal.NewNopRuleBuilder(
 		"FIELD_NO_DELETE",
@@ -80,6 +112,18 @@ var (
 		"fields are not deleted from a given message unless the number is reserved",
 		bufbreakingcheck.CheckFieldNoDeleteUnlessNumberReserved,
 	)
+	// FieldNoNewProtovalidateConstraintsRuleBuilder is a rule builder.
+	FieldNoNewProtovalidateConstraintsRuleBuilder = internal.NewNopRuleBuilder(
+		"FIELD_NO_NEW_PROTOVALIDATE_CONSTRAINTS",
+		"fields do not have new protovalidate constraints",
+		bufbreakingcheck.CheckFieldNoNewProtovalidateConstraints,
+	)
+	// FieldNoTighterProtovalidateConstraintsRuleBuilder is a rule builder.
+	FieldNoTighterProtovalidateConstraintsRuleBuilder = internal.NewNopRuleBuilder(
+		"FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS",
+		"fields do not have tighter protovalidate constraints",
+		bufbreakingcheck.CheckFieldNoTighterProtovalidateConstraints,
+	)
 	// FieldSameCTypeRuleBuilder is a rule builder.
 	FieldSameCTypeRuleBuilder = internal.NewNopRuleBuilder(
 		"FIELD_SAME_CTYPE",
@@ -260,6 +304,18 @@ var (
 		"files have the same syntax",
 		bufbreakingcheck.CheckFileSameSyntax,
 	)
+	// FrozenRuleBuilder is a rule builder.
+	FrozenRuleBuilder = internal.NewRuleBuilder(
+		"FROZEN",
+		func(configBuilder internal.ConfigBuilder) (string, error) {
+			return "messages and enums marked frozen by an option are not changed (options are configurable)", nil
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			return internal.CheckFunc(func(id string, ignoreFunc internal.IgnoreFunc, previousFiles []protosource.File, files []protosource.File) ([]bufanalysis.FileAnnotation, error) {
+				return bufbreakingcheck.CheckFrozen(id, ignoreFunc, previousFiles, files, configBuilder.FrozenOptions)
+			}), nil
+		},
+	)
 	// MessageNoDeleteRuleBuilder is a rule builder.
 	MessageNoDeleteRuleBuilder = internal.NewNopRuleBuilder(
 		"MESSAGE_NO_DELETE",
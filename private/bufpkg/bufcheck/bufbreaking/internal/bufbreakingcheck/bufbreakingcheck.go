@@ -23,12 +23,129 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 	"github.com/bufbuild/buf/private/pkg/protodescriptor"
 	"github.com/bufbuild/buf/private/pkg/protosource"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+const (
+	// CommentIgnorePrefix is the comment ignore prefix.
+	//
+	// This is used in bufbreaking when constructing a new Runner, and is passed to the
+	// RunnerWithIgnorePrefix option.
+	CommentIgnorePrefix = "buf:breaking:ignore"
+)
+
+// CheckAppendOnly is a check function.
+func CheckAppendOnly(
+	id string,
+	ignoreFunc internal.IgnoreFunc,
+	previousFiles []protosource.File,
+	files []protosource.File,
+	appendOnlyOptions []string,
+) ([]bufanalysis.FileAnnotation, error) {
+	return newFilesCheckFunc(
+		func(add addFunc, corpus *corpus) error {
+			return checkAppendOnly(add, corpus, appendOnlyOptions)
+		},
+	)(id, ignoreFunc, previousFiles, files)
+}
+
+func checkAppendOnly(add addFunc, corpus *corpus, appendOnlyOptions []string) error {
+	if len(appendOnlyOptions) == 0 {
+		return nil
+	}
+	// The options are resolved against the previous files, as it is the previous
+	// messages and enums that determine what is append-only.
+	messageOptionNumberToName := getBoolOptionNumberToName(corpus.previousFiles, appendOnlyOptions, messageOptionsFullName)
+	enumOptionNumberToName := getBoolOptionNumberToName(corpus.previousFiles, appendOnlyOptions, enumOptionsFullName)
+	previousFullNameToMessage, err := protosource.FullNameToMessage(corpus.previousFiles...)
+	if err != nil {
+		return err
+	}
+	fullNameToMessage, err := protosource.FullNameToMessage(corpus.files...)
+	if err != nil {
+		return err
+	}
+	for previousFullName, previousMessage := range previousFullNameToMessage {
+		message, ok := fullNameToMessage[previousFullName]
+		if !ok {
+			// checked by MESSAGE_NO_DELETE
+			continue
+		}
+		previousDescriptorProto := getDescriptorProtoForMessage(previousMessage)
+		optionName, ok := getBoolOptionSet(previousDescriptorProto.GetOptions().ProtoReflect(), messageOptionNumberToName)
+		if !ok {
+			continue
+		}
+		numberToFieldDescriptorProto := make(map[int32]*descriptorpb.FieldDescriptorProto)
+		for _, fieldDescriptorProto := range getDescriptorProtoForMessage(message).GetField() {
+			numberToFieldDescriptorProto[fieldDescriptorProto.GetNumber()] = fieldDescriptorProto
+		}
+		numberToField, err := protosource.NumberToMessageField(message)
+		if err != nil {
+			return err
+		}
+		for _, previousFieldDescriptorProto := range previousDescriptorProto.GetField() {
+			previousNumber := previousFieldDescriptorProto.GetNumber()
+			fieldDescriptorProto, ok := numberToFieldDescriptorProto[previousNumber]
+			if !ok {
+				add(message, previousMessage, nil, message.Location(), `Field "%d" with name %q on message %q was deleted, but the message is append-only by option %q.`, previousNumber, previousFieldDescriptorProto.GetName(), message.Name(), optionName)
+				continue
+			}
+			if !proto.Equal(previousFieldDescriptorProto, fieldDescriptorProto) {
+				add(numberToField[int(previousNumber)], previousMessage, nil, numberToField[int(previousNumber)].Location(), `Field "%d" with name %q on message %q changed, but the message is append-only by option %q.`, previousNumber, fieldDescriptorProto.GetName(), message.Name(), optionName)
+			}
+		}
+	}
+	previousFullNameToEnum, err := protosource.FullNameToEnum(corpus.previousFiles...)
+	if err != nil {
+		return err
+	}
+	fullNameToEnum, err := protosource.FullNameToEnum(corpus.files...)
+	if err != nil {
+		return err
+	}
+	for previousFullName, previousEnum := range previousFullNameToEnum {
+		enum, ok := fullNameToEnum[previousFullName]
+		if !ok {
+			// checked by ENUM_NO_DELETE
+			continue
+		}
+		optionName, ok := getBoolOptionSet(getDescriptorProtoForEnum(previousEnum).GetOptions().ProtoReflect(), enumOptionNumberToName)
+		if !ok {
+			continue
+		}
+		previousNumberToNameToEnumValue, err := protosource.NumberToNameToEnumValue(previousEnum)
+		if err != nil {
+			return err
+		}
+		numberToNameToEnumValue, err := protosource.NumberToNameToEnumValue(enum)
+		if err != nil {
+			return err
+		}
+		for previousNumber, previousNameToEnumValue := range previousNumberToNameToEnumValue {
+			nameToEnumValue, ok := numberToNameToEnumValue[previousNumber]
+			if !ok {
+				add(enum, previousEnum, nil, enum.Location(), `Enum value "%d" on enum %q was deleted, but the enum is append-only by option %q.`, previousNumber, enum.Name(), optionName)
+				continue
+			}
+			previousNames := getSortedEnumValueNames(previousNameToEnumValue)
+			names := getSortedEnumValueNames(nameToEnumValue)
+			if !stringutil.SliceElementsContained(names, previousNames) {
+				for _, enumValue := range nameToEnumValue {
+					add(enumValue, previousEnum, nil, enumValue.NameLocation(), `Enum value "%d" on enum %q changed name from %s to %s, but the enum is append-only by option %q.`, previousNumber, enum.Name(), stringutil.JoinSliceQuoted(previousNames, ", "), stringutil.JoinSliceQuoted(names, ", "), optionName)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // CheckEnumNoDelete is a check function.
 var CheckEnumNoDelete = newFilePairCheckFunc(checkEnumNoDelete)
 
@@ -41,14 +158,14 @@ func checkEnumNoDelete(add addFunc, corpus *corpus, previousFile protosource.Fil
 	if err != nil {
 		return err
 	}
-	for previousNestedName := range previousNestedNameToEnum {
+	for previousNestedName, previousEnum := range previousNestedNameToEnum {
 		if _, ok := nestedNameToEnum[previousNestedName]; !ok {
 			// TODO: search for enum in other files and return that the enum was moved?
 			descriptor, location, err := getDescriptorAndLocationForDeletedEnum(file, previousNestedName)
 			if err != nil {
 				return err
 			}
-			add(descriptor, nil, location, `Previously present enum %q was deleted from file.`, previousNestedName)
+			add(descriptor, previousEnum, nil, location, `Previously present enum %q was deleted from file.`, previousNestedName)
 		}
 	}
 	return nil
@@ -101,7 +218,7 @@ func checkEnumValueNoDeleteWithRules(add addFunc, previousEnum protosource.Enum,
 					}
 					suffix = fmt.Sprintf(` without reserving the name%s %s`, nameSuffix, stringutil.JoinSliceQuoted(getSortedEnumValueNames(previousNameToEnumValue), ", "))
 				}
-				add(enum, nil, enum.Location(), `Previously present enum value "%d" on enum %q was deleted%s.`, previousNumber, enum.Name(), suffix)
+				add(enum, previousNameToEnumValue[getSortedEnumValueNames(previousNameToEnumValue)[0]], nil, enum.Location(), `Previously present enum value "%d" on enum %q was deleted%s.`, previousNumber, enum.Name(), suffix)
 			}
 		}
 	}
@@ -141,8 +258,51 @@ func checkEnumValueSameName(add addFunc, corpus *corpus, previousNameToEnumValue
 		if len(previousNames) > 1 && len(names) > 1 {
 			nameSuffix = "s"
 		}
+		previousEnumValue := previousNameToEnumValue[previousNames[0]]
 		for _, enumValue := range nameToEnumValue {
-			add(enumValue, nil, enumValue.NumberLocation(), `Enum value "%d" on enum %q changed name%s from %s to %s.`, enumValue.Number(), enumValue.Enum().Name(), nameSuffix, previousNamesString, namesString)
+			add(enumValue, previousEnumValue, nil, enumValue.NumberLocation(), `Enum value "%d" on enum %q changed name%s from %s to %s.`, enumValue.Number(), enumValue.Enum().Name(), nameSuffix, previousNamesString, namesString)
+		}
+	}
+	return nil
+}
+
+// CheckExtensionDeclarationNoDelete is a check function.
+var CheckExtensionDeclarationNoDelete = newMessagePairCheckFunc(checkExtensionDeclarationNoDelete)
+
+func checkExtensionDeclarationNoDelete(add addFunc, corpus *corpus, previousMessage protosource.Message, message protosource.Message) error {
+	numberToDeclaration := getNumberToExtensionDeclaration(message)
+	for _, previousDeclaration := range getExtensionDeclarations(previousMessage) {
+		if _, ok := numberToDeclaration[previousDeclaration.Number()]; !ok {
+			// A deleted declaration allows the number to be reused, even if it was reserved.
+			add(message, previousMessage, nil, message.Location(), `Previously present extension declaration "%d" on message %q was deleted.`, previousDeclaration.Number(), message.Name())
+		}
+	}
+	return nil
+}
+
+// CheckExtensionDeclarationSameExtension is a check function.
+var CheckExtensionDeclarationSameExtension = newMessagePairCheckFunc(checkExtensionDeclarationSameExtension)
+
+func checkExtensionDeclarationSameExtension(add addFunc, corpus *corpus, previousMessage protosource.Message, message protosource.Message) error {
+	previousNumberToDeclaration := getNumberToExtensionDeclaration(previousMessage)
+	for _, declaration := range getExtensionDeclarations(message) {
+		previousDeclaration, ok := previousNumberToDeclaration[declaration.Number()]
+		if !ok || declaration.Reserved() {
+			continue
+		}
+		location := getExtensionDeclarationLocation(declaration)
+		if previousDeclaration.Reserved() {
+			add(message, previousMessage, nil, location, `Extension declaration "%d" on message %q was reserved and is now declared for %q.`, declaration.Number(), message.Name(), strings.TrimPrefix(declaration.FullName(), "."))
+			continue
+		}
+		if previousDeclaration.FullName() != declaration.FullName() {
+			add(message, previousMessage, nil, location, `Extension declaration "%d" on message %q changed from %q to %q.`, declaration.Number(), message.Name(), strings.TrimPrefix(previousDeclaration.FullName(), "."), strings.TrimPrefix(declaration.FullName(), "."))
+		}
+		if previousDeclaration.Type() != declaration.Type() {
+			add(message, previousMessage, nil, location, `Extension declaration "%d" on message %q changed type from %q to %q.`, declaration.Number(), message.Name(), previousDeclaration.Type(), declaration.Type())
+		}
+		if previousDeclaration.Repeated() != declaration.Repeated() {
+			add(message, previousMessage, nil, location, `Extension declaration "%d" on message %q changed repeated from "%t" to "%t".`, declaration.Number(), message.Name(), previousDeclaration.Repeated(), declaration.Repeated())
 		}
 	}
 	return nil
@@ -156,7 +316,7 @@ func checkExtensionMessageNoDelete(add addFunc, corpus *corpus, previousMessage
 	stringToExtensionRange := protosource.StringToExtensionMessageRange(message)
 	for previousString := range previousStringToExtensionRange {
 		if _, ok := stringToExtensionRange[previousString]; !ok {
-			add(message, nil, message.Location(), `Previously present extension range %q on message %q was deleted.`, previousString, message.Name())
+			add(message, previousMessage, nil, message.Location(), `Previously present extension range %q on message %q was deleted.`, previousString, message.Name())
 		}
 	}
 	return nil
@@ -207,7 +367,7 @@ func checkFieldNoDeleteWithRules(add addFunc, previousMessage protosource.Messag
 				if allowIfNameReserved {
 					suffix = fmt.Sprintf(` without reserving the name %q`, previousField.Name())
 				}
-				add(message, nil, message.Location(), `Previously present field %q with name %q on message %q was deleted%s.`, previousNumberString, previousField.Name(), message.Name(), suffix)
+				add(message, previousField, nil, message.Location(), `Previously present field %q with name %q on message %q was deleted%s.`, previousNumberString, previousField.Name(), message.Name(), suffix)
 			}
 		}
 	}
@@ -219,6 +379,73 @@ func isDeletedFieldAllowedWithRules(previousField protosource.Field, message pro
 		(allowIfNameReserved && protosource.NameInReservedNames(previousField.Name(), message.ReservedNames()...))
 }
 
+// CheckFieldNoNewProtovalidateConstraints is a check function.
+var CheckFieldNoNewProtovalidateConstraints = newFieldPairCheckFunc(checkFieldNoNewProtovalidateConstraints)
+
+func checkFieldNoNewProtovalidateConstraints(add addFunc, corpus *corpus, previousField protosource.Field, field protosource.Field) error {
+	previousConstraints := getProtovalidateConstraints(previousField)
+	constraints := getProtovalidateConstraints(field)
+	for _, path := range sortedProtovalidateConstraintPaths(constraints) {
+		constraint := constraints[path]
+		if _, ok := previousConstraints[path]; ok {
+			continue
+		}
+		switch constraint.rule.kind {
+		case protovalidateRuleKindLoosening:
+			continue
+		case protovalidateRuleKindLower, protovalidateRuleKindUpper:
+			// a bound that replaces a bound of the same side, such as gte replacing gt,
+			// is checked by FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS
+			if getProtovalidateBound(previousConstraints, strings.TrimSuffix(path, constraint.rule.name), constraint.rule.kind) != nil {
+				continue
+			}
+		}
+		// otherwise prints as hex
+		numberString := strconv.FormatInt(int64(field.Number()), 10)
+		add(field, previousField, nil, field.Location(), `Field %q with name %q on message %q added protovalidate constraint "%s".`, numberString, field.Name(), field.Message().Name(), constraint.String())
+	}
+	return nil
+}
+
+// CheckFieldNoTighterProtovalidateConstraints is a check function.
+var CheckFieldNoTighterProtovalidateConstraints = newFieldPairCheckFunc(checkFieldNoTighterProtovalidateConstraints)
+
+func checkFieldNoTighterProtovalidateConstraints(add addFunc, corpus *corpus, previousField protosource.Field, field protosource.Field) error {
+	previousConstraints := getProtovalidateConstraints(previousField)
+	constraints := getProtovalidateConstraints(field)
+	// otherwise prints as hex
+	numberString := strconv.FormatInt(int64(field.Number()), 10)
+	for _, path := range sortedProtovalidateConstraintPaths(constraints) {
+		constraint := constraints[path]
+		var previousConstraint *protovalidateConstraint
+		switch constraint.rule.kind {
+		case protovalidateRuleKindLower, protovalidateRuleKindUpper:
+			prefix := strings.TrimSuffix(path, constraint.rule.name)
+			previousBound := getProtovalidateBound(previousConstraints, prefix, constraint.rule.kind)
+			bound := getProtovalidateBound(constraints, prefix, constraint.rule.kind)
+			if previousBound == nil || bound.constraint != constraint || !protovalidateBoundIsTighter(previousBound, bound, constraint.rule.kind) {
+				continue
+			}
+			previousConstraint = previousBound.constraint
+		default:
+			var ok bool
+			previousConstraint, ok = previousConstraints[path]
+			if !ok || !protovalidateConstraintIsTighter(previousConstraint, constraint) {
+				continue
+			}
+		}
+		add(field, previousField, nil, field.Location(), `Field %q with name %q on message %q tightened protovalidate constraint "%s" to "%s".`, numberString, field.Name(), field.Message().Name(), previousConstraint.String(), constraint.String())
+	}
+	for _, path := range sortedProtovalidateConstraintPaths(previousConstraints) {
+		previousConstraint := previousConstraints[path]
+		if _, ok := constraints[path]; ok || previousConstraint.rule.kind != protovalidateRuleKindLoosening {
+			continue
+		}
+		add(field, previousField, nil, field.Location(), `Field %q with name %q on message %q removed protovalidate constraint "%s".`, numberString, field.Name(), field.Message().Name(), previousConstraint.String())
+	}
+	return nil
+}
+
 // CheckFieldSameCType is a check function.
 var CheckFieldSameCType = newFieldPairCheckFunc(checkFieldSameCType)
 
@@ -226,7 +453,7 @@ func checkFieldSameCType(add addFunc, corpus *corpus, previousField protosource.
 	if previousField.CType() != field.CType() {
 		// otherwise prints as hex
 		numberString := strconv.FormatInt(int64(field.Number()), 10)
-		add(field, nil, withBackupLocation(field.CTypeLocation(), field.Location()), `Field %q with name %q on message %q changed option "ctype" from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousField.CType().String(), field.CType().String())
+		add(field, previousField, nil, withBackupLocation(field.CTypeLocation(), field.Location()), `Field %q with name %q on message %q changed option "ctype" from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousField.CType().String(), field.CType().String())
 	}
 	return nil
 }
@@ -238,7 +465,7 @@ func checkFieldSameJSONName(add addFunc, corpus *corpus, previousField protosour
 	if previousField.JSONName() != field.JSONName() {
 		// otherwise prints as hex
 		numberString := strconv.FormatInt(int64(field.Number()), 10)
-		add(field, nil, withBackupLocation(field.JSONNameLocation(), field.Location()), `Field %q with name %q on message %q changed option "json_name" from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousField.JSONName(), field.JSONName())
+		add(field, previousField, nil, withBackupLocation(field.JSONNameLocation(), field.Location()), `Field %q with name %q on message %q changed option "json_name" from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousField.JSONName(), field.JSONName())
 	}
 	return nil
 }
@@ -250,7 +477,7 @@ func checkFieldSameJSType(add addFunc, corpus *corpus, previousField protosource
 	if previousField.JSType() != field.JSType() {
 		// otherwise prints as hex
 		numberString := strconv.FormatInt(int64(field.Number()), 10)
-		add(field, nil, withBackupLocation(field.JSTypeLocation(), field.Location()), `Field %q with name %q on message %q changed option "jstype" from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousField.JSType().String(), field.JSType().String())
+		add(field, previousField, nil, withBackupLocation(field.JSTypeLocation(), field.Location()), `Field %q with name %q on message %q changed option "jstype" from %q to %q.`, numberString, field.Name(), field.Message().Name(), previousField.JSType().String(), field.JSType().String())
 	}
 	return nil
 }
@@ -263,7 +490,7 @@ func checkFieldSameLabel(add addFunc, corpus *corpus, previousField protosource.
 		// otherwise prints as hex
 		numberString := strconv.FormatInt(int64(field.Number()), 10)
 		// TODO: specific label location
-		add(field, nil, field.Location(), `Field %q on message %q changed label from %q to %q.`, numberString, field.Message().Name(), protodescriptor.FieldDescriptorProtoLabelPrettyString(previousField.Label()), protodescriptor.FieldDescriptorProtoLabelPrettyString(field.Label()))
+		add(field, previousField, nil, field.Location(), `Field %q on message %q changed label from %q to %q.`, numberString, field.Message().Name(), protodescriptor.FieldDescriptorProtoLabelPrettyString(previousField.Label()), protodescriptor.FieldDescriptorProtoLabelPrettyString(field.Label()))
 	}
 	return nil
 }
@@ -275,7 +502,7 @@ func checkFieldSameName(add addFunc, corpus *corpus, previousField protosource.F
 	if previousField.Name() != field.Name() {
 		// otherwise prints as hex
 		numberString := strconv.FormatInt(int64(field.Number()), 10)
-		add(field, nil, field.NameLocation(), `Field %q on message %q changed name from %q to %q.`, numberString, field.Message().Name(), previousField.Name(), field.Name())
+		add(field, previousField, nil, field.NameLocation(), `Field %q on message %q changed name from %q to %q.`, numberString, field.Message().Name(), previousField.Name(), field.Name())
 	}
 	return nil
 }
@@ -295,7 +522,7 @@ func checkFieldSameOneof(add addFunc, corpus *corpus, previousField protosource.
 		if previousOneof.Name() != oneof.Name() {
 			// otherwise prints as hex
 			numberString := strconv.FormatInt(int64(field.Number()), 10)
-			add(field, nil, field.Location(), `Field %q on message %q moved from oneof %q to oneof %q.`, numberString, field.Message().Name(), previousOneof.Name(), oneof.Name())
+			add(field, previousField, nil, field.Location(), `Field %q on message %q moved from oneof %q to oneof %q.`, numberString, field.Message().Name(), previousOneof.Name(), oneof.Name())
 		}
 		return nil
 	}
@@ -308,7 +535,7 @@ func checkFieldSameOneof(add addFunc, corpus *corpus, previousField protosource.
 	}
 	// otherwise prints as hex
 	numberString := strconv.FormatInt(int64(field.Number()), 10)
-	add(field, nil, field.Location(), `Field %q on message %q moved from %s to %s a oneof.`, numberString, field.Message().Name(), previous, current)
+	add(field, previousField, nil, field.Location(), `Field %q on message %q moved from %s to %s a oneof.`, numberString, field.Message().Name(), previous, current)
 	return nil
 }
 
@@ -433,6 +660,73 @@ func checkFieldWireJSONCompatibleType(add addFunc, corpus *corpus, previousField
 	return nil
 }
 
+// CheckFieldJSONCompatibleType is a check function.
+var CheckFieldJSONCompatibleType = newFieldPairCheckFunc(checkFieldJSONCompatibleType)
+
+func checkFieldJSONCompatibleType(add addFunc, corpus *corpus, previousField protosource.Field, field protosource.Field) error {
+	previousJSONCompatibilityGroup, ok := fieldDescriptorProtoTypeToJSONCompatibilityGroup[previousField.Type()]
+	if !ok {
+		return fmt.Errorf("unknown FieldDescriptorProtoType: %v", previousField.Type())
+	}
+	jsonCompatibilityGroup, ok := fieldDescriptorProtoTypeToJSONCompatibilityGroup[field.Type()]
+	if !ok {
+		return fmt.Errorf("unknown FieldDescriptorProtoType: %v", field.Type())
+	}
+	if previousJSONCompatibilityGroup != jsonCompatibilityGroup {
+		addFieldChangedType(
+			add,
+			previousField,
+			field,
+			"See https://developers.google.com/protocol-buffers/docs/proto3#json for JSON compatibility rules.",
+		)
+		return nil
+	}
+	switch field.Type() {
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		if previousField.TypeName() != field.TypeName() {
+			return checkEnumJSONCompatibleForField(add, corpus, previousField, field)
+		}
+	case descriptorpb.FieldDescriptorProto_TYPE_GROUP,
+		descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+		if previousField.TypeName() != field.TypeName() {
+			addEnumGroupMessageFieldChangedTypeName(add, previousField, field)
+			return nil
+		}
+	}
+	return nil
+}
+
+// checkEnumJSONCompatibleForField allows an enum field to change to another
+// enum type as long as every value name of the previous enum is still present,
+// as enum values are represented by their names in JSON.
+func checkEnumJSONCompatibleForField(add addFunc, corpus *corpus, previousField protosource.Field, field protosource.Field) error {
+	previousEnum, err := getEnumByFullName(
+		corpus.previousFiles,
+		strings.TrimPrefix(previousField.TypeName(), "."),
+	)
+	if err != nil {
+		return err
+	}
+	enum, err := getEnumByFullName(
+		corpus.files,
+		strings.TrimPrefix(field.TypeName(), "."),
+	)
+	if err != nil {
+		return err
+	}
+	valueNames := make(map[string]struct{}, len(enum.Values()))
+	for _, enumValue := range enum.Values() {
+		valueNames[enumValue.Name()] = struct{}{}
+	}
+	for _, previousEnumValue := range previousEnum.Values() {
+		if _, ok := valueNames[previousEnumValue.Name()]; !ok {
+			addEnumGroupMessageFieldChangedTypeName(add, previousField, field)
+			return nil
+		}
+	}
+	return nil
+}
+
 func checkEnumWireCompatibleForField(add addFunc, corpus *corpus, previousField protosource.Field, field protosource.Field) error {
 	previousEnum, err := getEnumByFullName(
 		corpus.previousFiles,
@@ -489,6 +783,7 @@ func addFieldChangedType(add addFunc, previousField protosource.Field, field pro
 	previousNumberString := strconv.FormatInt(int64(previousField.Number()), 10)
 	add(
 		field,
+		previousField,
 		nil,
 		fieldLocation,
 		`Field %q on message %q changed type from %q to %q.%s`,
@@ -505,6 +800,7 @@ func addEnumGroupMessageFieldChangedTypeName(add addFunc, previousField protosou
 	numberString := strconv.FormatInt(int64(previousField.Number()), 10)
 	add(
 		field,
+		previousField,
 		nil,
 		field.TypeNameLocation(),
 		`Field %q on message %q changed type from %q to %q.`,
@@ -532,7 +828,7 @@ func checkFileNoDelete(add addFunc, corpus *corpus) error {
 			// Add previous descriptor to check for ignores. This will mean that if
 			// we have ignore_unstable_packages set, this file will cause the ignore
 			// to happen.
-			add(nil, []protosource.Descriptor{previousFile}, nil, `Previously present file %q was deleted.`, previousFilePath)
+			add(nil, previousFile, []protosource.Descriptor{previousFile}, nil, `Previously present file %q was deleted.`, previousFilePath)
 		}
 	}
 	return nil
@@ -542,133 +838,133 @@ func checkFileNoDelete(add addFunc, corpus *corpus) error {
 var CheckFileSameCsharpNamespace = newFilePairCheckFunc(checkFileSameCsharpNamespace)
 
 func checkFileSameCsharpNamespace(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.CsharpNamespace(), file.CsharpNamespace(), file, file.CsharpNamespaceLocation(), `option "csharp_namespace"`)
+	return checkFileSameValue(add, previousFile.CsharpNamespace(), file.CsharpNamespace(), previousFile, file, file.CsharpNamespaceLocation(), `option "csharp_namespace"`)
 }
 
 // CheckFileSameGoPackage is a check function.
 var CheckFileSameGoPackage = newFilePairCheckFunc(checkFileSameGoPackage)
 
 func checkFileSameGoPackage(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.GoPackage(), file.GoPackage(), file, file.GoPackageLocation(), `option "go_package"`)
+	return checkFileSameValue(add, previousFile.GoPackage(), file.GoPackage(), previousFile, file, file.GoPackageLocation(), `option "go_package"`)
 }
 
 // CheckFileSameJavaMultipleFiles is a check function.
 var CheckFileSameJavaMultipleFiles = newFilePairCheckFunc(checkFileSameJavaMultipleFiles)
 
 func checkFileSameJavaMultipleFiles(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, strconv.FormatBool(previousFile.JavaMultipleFiles()), strconv.FormatBool(file.JavaMultipleFiles()), file, file.JavaMultipleFilesLocation(), `option "java_multiple_files"`)
+	return checkFileSameValue(add, strconv.FormatBool(previousFile.JavaMultipleFiles()), strconv.FormatBool(file.JavaMultipleFiles()), previousFile, file, file.JavaMultipleFilesLocation(), `option "java_multiple_files"`)
 }
 
 // CheckFileSameJavaOuterClassname is a check function.
 var CheckFileSameJavaOuterClassname = newFilePairCheckFunc(checkFileSameJavaOuterClassname)
 
 func checkFileSameJavaOuterClassname(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.JavaOuterClassname(), file.JavaOuterClassname(), file, file.JavaOuterClassnameLocation(), `option "java_outer_classname"`)
+	return checkFileSameValue(add, previousFile.JavaOuterClassname(), file.JavaOuterClassname(), previousFile, file, file.JavaOuterClassnameLocation(), `option "java_outer_classname"`)
 }
 
 // CheckFileSameJavaPackage is a check function.
 var CheckFileSameJavaPackage = newFilePairCheckFunc(checkFileSameJavaPackage)
 
 func checkFileSameJavaPackage(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.JavaPackage(), file.JavaPackage(), file, file.JavaPackageLocation(), `option "java_package"`)
+	return checkFileSameValue(add, previousFile.JavaPackage(), file.JavaPackage(), previousFile, file, file.JavaPackageLocation(), `option "java_package"`)
 }
 
 // CheckFileSameJavaStringCheckUtf8 is a check function.
 var CheckFileSameJavaStringCheckUtf8 = newFilePairCheckFunc(checkFileSameJavaStringCheckUtf8)
 
 func checkFileSameJavaStringCheckUtf8(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, strconv.FormatBool(previousFile.JavaStringCheckUtf8()), strconv.FormatBool(file.JavaStringCheckUtf8()), file, file.JavaStringCheckUtf8Location(), `option "java_string_check_utf8"`)
+	return checkFileSameValue(add, strconv.FormatBool(previousFile.JavaStringCheckUtf8()), strconv.FormatBool(file.JavaStringCheckUtf8()), previousFile, file, file.JavaStringCheckUtf8Location(), `option "java_string_check_utf8"`)
 }
 
 // CheckFileSameObjcClassPrefix is a check function.
 var CheckFileSameObjcClassPrefix = newFilePairCheckFunc(checkFileSameObjcClassPrefix)
 
 func checkFileSameObjcClassPrefix(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.ObjcClassPrefix(), file.ObjcClassPrefix(), file, file.ObjcClassPrefixLocation(), `option "objc_class_prefix"`)
+	return checkFileSameValue(add, previousFile.ObjcClassPrefix(), file.ObjcClassPrefix(), previousFile, file, file.ObjcClassPrefixLocation(), `option "objc_class_prefix"`)
 }
 
 // CheckFileSamePackage is a check function.
 var CheckFileSamePackage = newFilePairCheckFunc(checkFileSamePackage)
 
 func checkFileSamePackage(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.Package(), file.Package(), file, file.PackageLocation(), `package`)
+	return checkFileSameValue(add, previousFile.Package(), file.Package(), previousFile, file, file.PackageLocation(), `package`)
 }
 
 // CheckFileSamePhpClassPrefix is a check function.
 var CheckFileSamePhpClassPrefix = newFilePairCheckFunc(checkFileSamePhpClassPrefix)
 
 func checkFileSamePhpClassPrefix(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.PhpClassPrefix(), file.PhpClassPrefix(), file, file.PhpClassPrefixLocation(), `option "php_class_prefix"`)
+	return checkFileSameValue(add, previousFile.PhpClassPrefix(), file.PhpClassPrefix(), previousFile, file, file.PhpClassPrefixLocation(), `option "php_class_prefix"`)
 }
 
 // CheckFileSamePhpNamespace is a check function.
 var CheckFileSamePhpNamespace = newFilePairCheckFunc(checkFileSamePhpNamespace)
 
 func checkFileSamePhpNamespace(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.PhpNamespace(), file.PhpNamespace(), file, file.PhpNamespaceLocation(), `option "php_namespace"`)
+	return checkFileSameValue(add, previousFile.PhpNamespace(), file.PhpNamespace(), previousFile, file, file.PhpNamespaceLocation(), `option "php_namespace"`)
 }
 
 // CheckFileSamePhpMetadataNamespace is a check function.
 var CheckFileSamePhpMetadataNamespace = newFilePairCheckFunc(checkFileSamePhpMetadataNamespace)
 
 func checkFileSamePhpMetadataNamespace(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.PhpMetadataNamespace(), file.PhpMetadataNamespace(), file, file.PhpMetadataNamespaceLocation(), `option "php_metadata_namespace"`)
+	return checkFileSameValue(add, previousFile.PhpMetadataNamespace(), file.PhpMetadataNamespace(), previousFile, file, file.PhpMetadataNamespaceLocation(), `option "php_metadata_namespace"`)
 }
 
 // CheckFileSameRubyPackage is a check function.
 var CheckFileSameRubyPackage = newFilePairCheckFunc(checkFileSameRubyPackage)
 
 func checkFileSameRubyPackage(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.RubyPackage(), file.RubyPackage(), file, file.RubyPackageLocation(), `option "ruby_package"`)
+	return checkFileSameValue(add, previousFile.RubyPackage(), file.RubyPackage(), previousFile, file, file.RubyPackageLocation(), `option "ruby_package"`)
 }
 
 // CheckFileSameSwiftPrefix is a check function.
 var CheckFileSameSwiftPrefix = newFilePairCheckFunc(checkFileSameSwiftPrefix)
 
 func checkFileSameSwiftPrefix(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.SwiftPrefix(), file.SwiftPrefix(), file, file.SwiftPrefixLocation(), `option "swift_prefix"`)
+	return checkFileSameValue(add, previousFile.SwiftPrefix(), file.SwiftPrefix(), previousFile, file, file.SwiftPrefixLocation(), `option "swift_prefix"`)
 }
 
 // CheckFileSameOptimizeFor is a check function.
 var CheckFileSameOptimizeFor = newFilePairCheckFunc(checkFileSameOptimizeFor)
 
 func checkFileSameOptimizeFor(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, previousFile.OptimizeFor().String(), file.OptimizeFor().String(), file, file.OptimizeForLocation(), `option "optimize_for"`)
+	return checkFileSameValue(add, previousFile.OptimizeFor().String(), file.OptimizeFor().String(), previousFile, file, file.OptimizeForLocation(), `option "optimize_for"`)
 }
 
 // CheckFileSameCcGenericServices is a check function.
 var CheckFileSameCcGenericServices = newFilePairCheckFunc(checkFileSameCcGenericServices)
 
 func checkFileSameCcGenericServices(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, strconv.FormatBool(previousFile.CcGenericServices()), strconv.FormatBool(file.CcGenericServices()), file, file.CcGenericServicesLocation(), `option "cc_generic_services"`)
+	return checkFileSameValue(add, strconv.FormatBool(previousFile.CcGenericServices()), strconv.FormatBool(file.CcGenericServices()), previousFile, file, file.CcGenericServicesLocation(), `option "cc_generic_services"`)
 }
 
 // CheckFileSameJavaGenericServices is a check function.
 var CheckFileSameJavaGenericServices = newFilePairCheckFunc(checkFileSameJavaGenericServices)
 
 func checkFileSameJavaGenericServices(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, strconv.FormatBool(previousFile.JavaGenericServices()), strconv.FormatBool(file.JavaGenericServices()), file, file.JavaGenericServicesLocation(), `option "java_generic_services"`)
+	return checkFileSameValue(add, strconv.FormatBool(previousFile.JavaGenericServices()), strconv.FormatBool(file.JavaGenericServices()), previousFile, file, file.JavaGenericServicesLocation(), `option "java_generic_services"`)
 }
 
 // CheckFileSamePyGenericServices is a check function.
 var CheckFileSamePyGenericServices = newFilePairCheckFunc(checkFileSamePyGenericServices)
 
 func checkFileSamePyGenericServices(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, strconv.FormatBool(previousFile.PyGenericServices()), strconv.FormatBool(file.PyGenericServices()), file, file.PyGenericServicesLocation(), `option "py_generic_services"`)
+	return checkFileSameValue(add, strconv.FormatBool(previousFile.PyGenericServices()), strconv.FormatBool(file.PyGenericServices()), previousFile, file, file.PyGenericServicesLocation(), `option "py_generic_services"`)
 }
 
 // CheckFileSamePhpGenericServices is a check function.
 var CheckFileSamePhpGenericServices = newFilePairCheckFunc(checkFileSamePhpGenericServices)
 
 func checkFileSamePhpGenericServices(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, strconv.FormatBool(previousFile.PhpGenericServices()), strconv.FormatBool(file.PhpGenericServices()), file, file.PhpGenericServicesLocation(), `option "php_generic_services"`)
+	return checkFileSameValue(add, strconv.FormatBool(previousFile.PhpGenericServices()), strconv.FormatBool(file.PhpGenericServices()), previousFile, file, file.PhpGenericServicesLocation(), `option "php_generic_services"`)
 }
 
 // CheckFileSameCcEnableArenas is a check function.
 var CheckFileSameCcEnableArenas = newFilePairCheckFunc(checkFileSameCcEnableArenas)
 
 func checkFileSameCcEnableArenas(add addFunc, corpus *corpus, previousFile protosource.File, file protosource.File) error {
-	return checkFileSameValue(add, strconv.FormatBool(previousFile.CcEnableArenas()), strconv.FormatBool(file.CcEnableArenas()), file, file.CcEnableArenasLocation(), `option "cc_enable_arenas"`)
+	return checkFileSameValue(add, strconv.FormatBool(previousFile.CcEnableArenas()), strconv.FormatBool(file.CcEnableArenas()), previousFile, file, file.CcEnableArenasLocation(), `option "cc_enable_arenas"`)
 }
 
 // CheckFileSameSyntax is a check function.
@@ -683,12 +979,84 @@ func checkFileSameSyntax(add addFunc, corpus *corpus, previousFile protosource.F
 	if syntax == protosource.SyntaxUnspecified {
 		syntax = protosource.SyntaxProto2
 	}
-	return checkFileSameValue(add, previousSyntax.String(), syntax.String(), file, file.SyntaxLocation(), `syntax`)
+	return checkFileSameValue(add, previousSyntax.String(), syntax.String(), previousFile, file, file.SyntaxLocation(), `syntax`)
 }
 
-func checkFileSameValue(add addFunc, previousValue interface{}, value interface{}, file protosource.File, location protosource.Location, name string) error {
+func checkFileSameValue(add addFunc, previousValue interface{}, value interface{}, previousFile protosource.File, file protosource.File, location protosource.Location, name string) error {
 	if previousValue != value {
-		add(file, nil, location, `File %s changed from %q to %q.`, name, previousValue, value)
+		add(file, previousFile, nil, location, `File %s changed from %q to %q.`, name, previousValue, value)
+	}
+	return nil
+}
+
+// CheckFrozen is a check function.
+func CheckFrozen(
+	id string,
+	ignoreFunc internal.IgnoreFunc,
+	previousFiles []protosource.File,
+	files []protosource.File,
+	frozenOptions []string,
+) ([]bufanalysis.FileAnnotation, error) {
+	return newFilesCheckFunc(
+		func(add addFunc, corpus *corpus) error {
+			return checkFrozen(add, corpus, frozenOptions)
+		},
+	)(id, ignoreFunc, previousFiles, files)
+}
+
+func checkFrozen(add addFunc, corpus *corpus, frozenOptions []string) error {
+	if len(frozenOptions) == 0 {
+		return nil
+	}
+	// The options are resolved against the previous files, as it is the previous
+	// messages and enums that determine what is frozen.
+	messageOptionNumberToName := getBoolOptionNumberToName(corpus.previousFiles, frozenOptions, messageOptionsFullName)
+	enumOptionNumberToName := getBoolOptionNumberToName(corpus.previousFiles, frozenOptions, enumOptionsFullName)
+	previousFullNameToMessage, err := protosource.FullNameToMessage(corpus.previousFiles...)
+	if err != nil {
+		return err
+	}
+	fullNameToMessage, err := protosource.FullNameToMessage(corpus.files...)
+	if err != nil {
+		return err
+	}
+	for previousFullName, previousMessage := range previousFullNameToMessage {
+		message, ok := fullNameToMessage[previousFullName]
+		if !ok {
+			// checked by MESSAGE_NO_DELETE
+			continue
+		}
+		previousDescriptorProto := getDescriptorProtoForMessage(previousMessage)
+		optionName, ok := getBoolOptionSet(previousDescriptorProto.GetOptions().ProtoReflect(), messageOptionNumberToName)
+		if !ok {
+			continue
+		}
+		if !proto.Equal(previousDescriptorProto, getDescriptorProtoForMessage(message)) {
+			add(message, previousMessage, nil, message.NameLocation(), `Message %q changed, but it is frozen by option %q.`, message.Name(), optionName)
+		}
+	}
+	previousFullNameToEnum, err := protosource.FullNameToEnum(corpus.previousFiles...)
+	if err != nil {
+		return err
+	}
+	fullNameToEnum, err := protosource.FullNameToEnum(corpus.files...)
+	if err != nil {
+		return err
+	}
+	for previousFullName, previousEnum := range previousFullNameToEnum {
+		enum, ok := fullNameToEnum[previousFullName]
+		if !ok {
+			// checked by ENUM_NO_DELETE
+			continue
+		}
+		previousDescriptorProto := getDescriptorProtoForEnum(previousEnum)
+		optionName, ok := getBoolOptionSet(previousDescriptorProto.GetOptions().ProtoReflect(), enumOptionNumberToName)
+		if !ok {
+			continue
+		}
+		if !proto.Equal(previousDescriptorProto, getDescriptorProtoForEnum(enum)) {
+			add(enum, previousEnum, nil, enum.NameLocation(), `Enum %q changed, but it is frozen by option %q.`, enum.Name(), optionName)
+		}
 	}
 	return nil
 }
@@ -705,10 +1073,10 @@ func checkMessageNoDelete(add addFunc, corpus *corpus, previousFile protosource.
 	if err != nil {
 		return err
 	}
-	for previousNestedName := range previousNestedNameToMessage {
+	for previousNestedName, previousMessage := range previousNestedNameToMessage {
 		if _, ok := nestedNameToMessage[previousNestedName]; !ok {
 			descriptor, location := getDescriptorAndLocationForDeletedMessage(file, nestedNameToMessage, previousNestedName)
-			add(descriptor, nil, location, `Previously present message %q was deleted from file.`, previousNestedName)
+			add(descriptor, previousMessage, nil, location, `Previously present message %q was deleted from file.`, previousNestedName)
 		}
 	}
 	return nil
@@ -721,7 +1089,7 @@ func checkMessageNoRemoveStandardDescriptorAccessor(add addFunc, corpus *corpus,
 	previous := strconv.FormatBool(previousMessage.NoStandardDescriptorAccessor())
 	current := strconv.FormatBool(message.NoStandardDescriptorAccessor())
 	if previous == "false" && current == "true" {
-		add(message, nil, message.NoStandardDescriptorAccessorLocation(), `Message option "no_standard_descriptor_accessor" changed from %q to %q.`, previous, current)
+		add(message, previousMessage, nil, message.NoStandardDescriptorAccessorLocation(), `Message option "no_standard_descriptor_accessor" changed from %q to %q.`, previous, current)
 	}
 	return nil
 }
@@ -733,7 +1101,7 @@ func checkMessageSameMessageSetWireFormat(add addFunc, corpus *corpus, previousM
 	previous := strconv.FormatBool(previousMessage.MessageSetWireFormat())
 	current := strconv.FormatBool(message.MessageSetWireFormat())
 	if previous != current {
-		add(message, nil, message.MessageSetWireFormatLocation(), `Message option "message_set_wire_format" changed from %q to %q.`, previous, current)
+		add(message, previousMessage, nil, message.MessageSetWireFormatLocation(), `Message option "message_set_wire_format" changed from %q to %q.`, previous, current)
 	}
 	return nil
 }
@@ -756,16 +1124,16 @@ func checkMessageSameRequiredFields(add addFunc, corpus *corpus, previousMessage
 	if err != nil {
 		return err
 	}
-	for previousNumber := range previousNumberToRequiredField {
+	for previousNumber, previousRequiredField := range previousNumberToRequiredField {
 		if _, ok := numberToRequiredField[previousNumber]; !ok {
 			// we attach the error to the message as the field no longer exists
-			add(message, nil, message.Location(), `Message %q had required field "%d" deleted. Required fields must always be sent, so if one side does not know about the required field, this will result in a breakage.`, previousMessage.Name(), previousNumber)
+			add(message, previousRequiredField, nil, message.Location(), `Message %q had required field "%d" deleted. Required fields must always be sent, so if one side does not know about the required field, this will result in a breakage.`, previousMessage.Name(), previousNumber)
 		}
 	}
 	for number, requiredField := range numberToRequiredField {
 		if _, ok := previousNumberToRequiredField[number]; !ok {
 			// we attach the error to the added required field
-			add(message, nil, requiredField.Location(), `Message %q had required field "%d" added. Required fields must always be sent, so if one side does not know about the required field, this will result in a breakage.`, message.Name(), number)
+			add(message, previousMessage, nil, requiredField.Location(), `Message %q had required field "%d" added. Required fields must always be sent, so if one side does not know about the required field, this will result in a breakage.`, message.Name(), number)
 		}
 	}
 	return nil
@@ -783,9 +1151,9 @@ func checkOneofNoDelete(add addFunc, corpus *corpus, previousMessage protosource
 	if err != nil {
 		return err
 	}
-	for previousName := range previousNameToOneof {
+	for previousName, previousOneof := range previousNameToOneof {
 		if _, ok := nameToOneof[previousName]; !ok {
-			add(message, nil, message.Location(), `Previously present oneof %q on message %q was deleted.`, previousName, message.Name())
+			add(message, previousOneof, nil, message.Location(), `Previously present oneof %q on message %q was deleted.`, previousName, message.Name())
 		}
 	}
 	return nil
@@ -824,13 +1192,13 @@ func checkPackageEnumNoDelete(add addFunc, corpus *corpus) error {
 						if err != nil {
 							return err
 						}
-						add(descriptor, nil, location, `Previously present enum %q was deleted from package %q.`, previousNestedName, previousPackage)
+						add(descriptor, previousEnum, nil, location, `Previously present enum %q was deleted from package %q.`, previousNestedName, previousPackage)
 					} else {
 						// File does not exist, we don't know where the enum was deleted from.
 						// Add the previous enum to check for ignores. This means that if
 						// ignore_unstable_packages is set, this will be triggered if the
 						// previous enum was in an unstable package.
-						add(nil, []protosource.Descriptor{previousEnum}, nil, `Previously present enum %q was deleted from package %q.`, previousNestedName, previousPackage)
+						add(nil, previousEnum, []protosource.Descriptor{previousEnum}, nil, `Previously present enum %q was deleted from package %q.`, previousNestedName, previousPackage)
 					}
 				}
 			}
@@ -869,13 +1237,13 @@ func checkPackageMessageNoDelete(add addFunc, corpus *corpus) error {
 					if ok {
 						// File exists, try to get a location to attach the error to.
 						descriptor, location := getDescriptorAndLocationForDeletedMessage(file, nestedNameToMessage, previousNestedName)
-						add(descriptor, nil, location, `Previously present message %q was deleted from package %q.`, previousNestedName, previousPackage)
+						add(descriptor, previousMessage, nil, location, `Previously present message %q was deleted from package %q.`, previousNestedName, previousPackage)
 					} else {
 						// File does not exist, we don't know where the message was deleted from.
 						// Add the previous message to check for ignores. This means that if
 						// ignore_unstable_packages is set, this will be triggered if the
 						// previous message was in an unstable package.
-						add(nil, []protosource.Descriptor{previousMessage}, nil, `Previously present message %q was deleted from package %q.`, previousNestedName, previousPackage)
+						add(nil, previousMessage, []protosource.Descriptor{previousMessage}, nil, `Previously present message %q was deleted from package %q.`, previousNestedName, previousPackage)
 					}
 				}
 			}
@@ -907,7 +1275,7 @@ func checkPackageNoDelete(add addFunc, corpus *corpus) error {
 			for i, previousFile := range previousFiles {
 				previousDescriptors[i] = previousFile
 			}
-			add(nil, previousDescriptors, nil, `Previously present package %q was deleted.`, previousPackage)
+			add(nil, nil, previousDescriptors, nil, `Previously present package %q was deleted.`, previousPackage)
 		}
 	}
 	return nil
@@ -942,14 +1310,14 @@ func checkPackageServiceNoDelete(add addFunc, corpus *corpus) error {
 					file, ok := filePathToFile[previousService.File().Path()]
 					if ok {
 						// File exists.
-						add(file, nil, nil, `Previously present service %q was deleted from package %q.`, previousName, previousPackage)
+						add(file, previousService, nil, nil, `Previously present service %q was deleted from package %q.`, previousName, previousPackage)
 					} else {
 						// File does not exist, we don't know where the service was deleted from.
 						// Add the previous service to check for ignores. This means that if
 						// ignore_unstable_packages is set, this will be triggered if the
 						// previous service was in an unstable package.
 						// TODO: find the service and print that this moved?
-						add(nil, []protosource.Descriptor{previousService}, nil, `Previously present service %q was deleted from package %q.`, previousName, previousPackage)
+						add(nil, previousService, []protosource.Descriptor{previousService}, nil, `Previously present service %q was deleted from package %q.`, previousName, previousPackage)
 					}
 				}
 			}
@@ -966,14 +1334,14 @@ func checkReservedEnumNoDelete(add addFunc, corpus *corpus, previousEnum protoso
 	ranges := enum.ReservedTagRanges()
 	if isSubset, missing := protosource.CheckTagRangeIsSubset(ranges, previousRanges); !isSubset {
 		for _, tagRange := range missing {
-			add(enum, nil, enum.Location(), `Previously present reserved range %q on enum %q was deleted.`, protosource.TagRangeString(tagRange), enum.Name())
+			add(enum, previousEnum, nil, enum.Location(), `Previously present reserved range %q on enum %q was deleted.`, protosource.TagRangeString(tagRange), enum.Name())
 		}
 	}
 	previousValueToReservedName := protosource.ValueToReservedName(previousEnum)
 	valueToReservedName := protosource.ValueToReservedName(enum)
 	for previousValue := range previousValueToReservedName {
 		if _, ok := valueToReservedName[previousValue]; !ok {
-			add(enum, nil, enum.Location(), `Previously present reserved name %q on enum %q was deleted.`, previousValue, enum.Name())
+			add(enum, previousEnum, nil, enum.Location(), `Previously present reserved name %q on enum %q was deleted.`, previousValue, enum.Name())
 		}
 	}
 	return nil
@@ -987,14 +1355,14 @@ func checkReservedMessageNoDelete(add addFunc, corpus *corpus, previousMessage p
 	ranges := message.ReservedTagRanges()
 	if isSubset, missing := protosource.CheckTagRangeIsSubset(ranges, previousRanges); !isSubset {
 		for _, tagRange := range missing {
-			add(message, nil, message.Location(), `Previously present reserved range %q on message %q was deleted.`, protosource.TagRangeString(tagRange), message.Name())
+			add(message, previousMessage, nil, message.Location(), `Previously present reserved range %q on message %q was deleted.`, protosource.TagRangeString(tagRange), message.Name())
 		}
 	}
 	previousValueToReservedName := protosource.ValueToReservedName(previousMessage)
 	valueToReservedName := protosource.ValueToReservedName(message)
 	for previousValue := range previousValueToReservedName {
 		if _, ok := valueToReservedName[previousValue]; !ok {
-			add(message, nil, message.Location(), `Previously present reserved name %q on message %q was deleted.`, previousValue, message.Name())
+			add(message, previousMessage, nil, message.Location(), `Previously present reserved name %q on message %q was deleted.`, previousValue, message.Name())
 		}
 	}
 	return nil
@@ -1012,9 +1380,9 @@ func checkRPCNoDelete(add addFunc, corpus *corpus, previousService protosource.S
 	if err != nil {
 		return err
 	}
-	for previousName := range previousNameToMethod {
+	for previousName, previousMethod := range previousNameToMethod {
 		if _, ok := nameToMethod[previousName]; !ok {
-			add(service, nil, service.Location(), `Previously present RPC %q on service %q was deleted.`, previousName, service.Name())
+			add(service, previousMethod, nil, service.Location(), `Previously present RPC %q on service %q was deleted.`, previousName, service.Name())
 		}
 	}
 	return nil
@@ -1031,7 +1399,7 @@ func checkRPCSameClientStreaming(add addFunc, corpus *corpus, previousMethod pro
 			previous = "unary"
 			current = "streaming"
 		}
-		add(method, nil, method.Location(), `RPC %q on service %q changed from client %s to client %s.`, method.Name(), method.Service().Name(), previous, current)
+		add(method, previousMethod, nil, method.Location(), `RPC %q on service %q changed from client %s to client %s.`, method.Name(), method.Service().Name(), previous, current)
 	}
 	return nil
 }
@@ -1043,7 +1411,7 @@ func checkRPCSameIdempotencyLevel(add addFunc, corpus *corpus, previousMethod pr
 	previous := previousMethod.IdempotencyLevel()
 	current := method.IdempotencyLevel()
 	if previous != current {
-		add(method, nil, method.IdempotencyLevelLocation(), `RPC %q on service %q changed option "idempotency_level" from %q to %q.`, method.Name(), method.Service().Name(), previous.String(), current.String())
+		add(method, previousMethod, nil, method.IdempotencyLevelLocation(), `RPC %q on service %q changed option "idempotency_level" from %q to %q.`, method.Name(), method.Service().Name(), previous.String(), current.String())
 	}
 	return nil
 }
@@ -1053,7 +1421,7 @@ var CheckRPCSameRequestType = newMethodPairCheckFunc(checkRPCSameRequestType)
 
 func checkRPCSameRequestType(add addFunc, corpus *corpus, previousMethod protosource.Method, method protosource.Method) error {
 	if previousMethod.InputTypeName() != method.InputTypeName() {
-		add(method, nil, method.InputTypeLocation(), `RPC %q on service %q changed request type from %q to %q.`, method.Name(), method.Service().Name(), previousMethod.InputTypeName(), method.InputTypeName())
+		add(method, previousMethod, nil, method.InputTypeLocation(), `RPC %q on service %q changed request type from %q to %q.`, method.Name(), method.Service().Name(), previousMethod.InputTypeName(), method.InputTypeName())
 	}
 	return nil
 }
@@ -1063,7 +1431,7 @@ var CheckRPCSameResponseType = newMethodPairCheckFunc(checkRPCSameResponseType)
 
 func checkRPCSameResponseType(add addFunc, corpus *corpus, previousMethod protosource.Method, method protosource.Method) error {
 	if previousMethod.OutputTypeName() != method.OutputTypeName() {
-		add(method, nil, method.OutputTypeLocation(), `RPC %q on service %q changed response type from %q to %q.`, method.Name(), method.Service().Name(), previousMethod.OutputTypeName(), method.OutputTypeName())
+		add(method, previousMethod, nil, method.OutputTypeLocation(), `RPC %q on service %q changed response type from %q to %q.`, method.Name(), method.Service().Name(), previousMethod.OutputTypeName(), method.OutputTypeName())
 	}
 	return nil
 }
@@ -1079,7 +1447,7 @@ func checkRPCSameServerStreaming(add addFunc, corpus *corpus, previousMethod pro
 			previous = "unary"
 			current = "streaming"
 		}
-		add(method, nil, method.Location(), `RPC %q on service %q changed from server %s to server %s.`, method.Name(), method.Service().Name(), previous, current)
+		add(method, previousMethod, nil, method.Location(), `RPC %q on service %q changed from server %s to server %s.`, method.Name(), method.Service().Name(), previous, current)
 	}
 	return nil
 }
@@ -1096,9 +1464,9 @@ func checkServiceNoDelete(add addFunc, corpus *corpus, previousFile protosource.
 	if err != nil {
 		return err
 	}
-	for previousName := range previousNameToService {
+	for previousName, previousService := range previousNameToService {
 		if _, ok := nameToService[previousName]; !ok {
-			add(file, nil, nil, `Previously present service %q was deleted from file.`, previousName)
+			add(file, previousService, nil, nil, `Previously present service %q was deleted from file.`, previousName)
 		}
 	}
 	return nil
@@ -0,0 +1,629 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufbreakingcheck
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// protovalidateFieldExtensionNumber is the number of the buf.validate.field
+// extension of google.protobuf.FieldOptions, which contains the
+// buf.validate.FieldConstraints of a field.
+const protovalidateFieldExtensionNumber = 1159
+
+// protovalidateRuleKind determines how changes of the value of a rule change
+// the values that are valid.
+type protovalidateRuleKind int
+
+const (
+	// protovalidateRuleKindExact rules accept other values if their value
+	// changes, such as const, len, prefix, or pattern.
+	protovalidateRuleKindExact protovalidateRuleKind = iota + 1
+	// protovalidateRuleKindFlag rules reject values if they are true, such as
+	// required, email, or defined_only. They are ignored if they are false.
+	protovalidateRuleKindFlag
+	// protovalidateRuleKindLoosening rules accept values if they are true,
+	// such as ignore_empty. They are ignored if they are false.
+	protovalidateRuleKindLoosening
+	// protovalidateRuleKindMin rules are tightened if their value increases.
+	protovalidateRuleKindMin
+	// protovalidateRuleKindMax rules are tightened if their value decreases.
+	protovalidateRuleKindMax
+	// protovalidateRuleKindLower rules are the gt and gte lower bounds.
+	protovalidateRuleKindLower
+	// protovalidateRuleKindUpper rules are the lt and lte upper bounds.
+	protovalidateRuleKindUpper
+	// protovalidateRuleKindIn rules are tightened if values are removed.
+	protovalidateRuleKindIn
+	// protovalidateRuleKindNotIn rules are tightened if values are added,
+	// which includes CEL expressions.
+	protovalidateRuleKindNotIn
+	// protovalidateRuleKindRules rules contain other rules.
+	protovalidateRuleKindRules
+)
+
+// protovalidateValueType is the type of the value of a rule.
+type protovalidateValueType int
+
+const (
+	protovalidateValueTypeInt32 protovalidateValueType = iota + 1
+	protovalidateValueTypeInt64
+	protovalidateValueTypeUint32
+	protovalidateValueTypeUint64
+	protovalidateValueTypeSint32
+	protovalidateValueTypeSint64
+	protovalidateValueTypeFixed32
+	protovalidateValueTypeFixed64
+	protovalidateValueTypeSfixed32
+	protovalidateValueTypeSfixed64
+	protovalidateValueTypeFloat
+	protovalidateValueTypeDouble
+	protovalidateValueTypeBool
+	protovalidateValueTypeString
+	protovalidateValueTypeBytes
+	// protovalidateValueTypeMessage values, such as durations, are compared
+	// by their encoding.
+	protovalidateValueTypeMessage
+	// protovalidateValueTypeConstraint values are buf.validate.Constraint
+	// messages, and are compared by their CEL expression.
+	protovalidateValueTypeConstraint
+)
+
+// protovalidateRule is a field of a message of protovalidate rules.
+type protovalidateRule struct {
+	name      string
+	kind      protovalidateRuleKind
+	valueType protovalidateValueType
+	// rules are the rules of the message, for protovalidateRuleKindRules.
+	rules map[protowire.Number]*protovalidateRule
+}
+
+// protovalidateFieldConstraintsRules are the rules of buf.validate.FieldConstraints.
+//
+// The field numbers are those of the published protovalidate schema. Unknown
+// fields are ignored.
+var protovalidateFieldConstraintsRules = map[protowire.Number]*protovalidateRule{
+	1:  newProtovalidateNumberRules("float", protovalidateValueTypeFloat),
+	2:  newProtovalidateNumberRules("double", protovalidateValueTypeDouble),
+	3:  newProtovalidateNumberRules("int32", protovalidateValueTypeInt32),
+	4:  newProtovalidateNumberRules("int64", protovalidateValueTypeInt64),
+	5:  newProtovalidateNumberRules("uint32", protovalidateValueTypeUint32),
+	6:  newProtovalidateNumberRules("uint64", protovalidateValueTypeUint64),
+	7:  newProtovalidateNumberRules("sint32", protovalidateValueTypeSint32),
+	8:  newProtovalidateNumberRules("sint64", protovalidateValueTypeSint64),
+	9:  newProtovalidateNumberRules("fixed32", protovalidateValueTypeFixed32),
+	10: newProtovalidateNumberRules("fixed64", protovalidateValueTypeFixed64),
+	11: newProtovalidateNumberRules("sfixed32", protovalidateValueTypeSfixed32),
+	12: newProtovalidateNumberRules("sfixed64", protovalidateValueTypeSfixed64),
+	13: {
+		name: "bool",
+		kind: protovalidateRuleKindRules,
+		rules: map[protowire.Number]*protovalidateRule{
+			1: {name: "const", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeBool},
+		},
+	},
+	14: {
+		name: "string",
+		kind: protovalidateRuleKindRules,
+		rules: map[protowire.Number]*protovalidateRule{
+			1:  {name: "const", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeString},
+			19: {name: "len", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeUint64},
+			2:  {name: "min_len", kind: protovalidateRuleKindMin, valueType: protovalidateValueTypeUint64},
+			3:  {name: "max_len", kind: protovalidateRuleKindMax, valueType: protovalidateValueTypeUint64},
+			20: {name: "len_bytes", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeUint64},
+			4:  {name: "min_bytes", kind: protovalidateRuleKindMin, valueType: protovalidateValueTypeUint64},
+			5:  {name: "max_bytes", kind: protovalidateRuleKindMax, valueType: protovalidateValueTypeUint64},
+			6:  {name: "pattern", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeString},
+			7:  {name: "prefix", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeString},
+			8:  {name: "suffix", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeString},
+			9:  {name: "contains", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeString},
+			23: {name: "not_contains", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeString},
+			10: {name: "in", kind: protovalidateRuleKindIn, valueType: protovalidateValueTypeString},
+			11: {name: "not_in", kind: protovalidateRuleKindNotIn, valueType: protovalidateValueTypeString},
+			12: {name: "email", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			13: {name: "hostname", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			14: {name: "ip", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			15: {name: "ipv4", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			16: {name: "ipv6", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			17: {name: "uri", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			18: {name: "uri_ref", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			21: {name: "address", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			22: {name: "uuid", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			24: {name: "well_known_regex", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeInt32},
+			25: {name: "strict", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeBool},
+		},
+	},
+	15: {
+		name: "bytes",
+		kind: protovalidateRuleKindRules,
+		rules: map[protowire.Number]*protovalidateRule{
+			1:  {name: "const", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeBytes},
+			13: {name: "len", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeUint64},
+			2:  {name: "min_len", kind: protovalidateRuleKindMin, valueType: protovalidateValueTypeUint64},
+			3:  {name: "max_len", kind: protovalidateRuleKindMax, valueType: protovalidateValueTypeUint64},
+			4:  {name: "pattern", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeString},
+			5:  {name: "prefix", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeBytes},
+			6:  {name: "suffix", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeBytes},
+			7:  {name: "contains", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeBytes},
+			8:  {name: "in", kind: protovalidateRuleKindIn, valueType: protovalidateValueTypeBytes},
+			9:  {name: "not_in", kind: protovalidateRuleKindNotIn, valueType: protovalidateValueTypeBytes},
+			10: {name: "ip", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			11: {name: "ipv4", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			12: {name: "ipv6", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+		},
+	},
+	16: {
+		name: "enum",
+		kind: protovalidateRuleKindRules,
+		rules: map[protowire.Number]*protovalidateRule{
+			1: {name: "const", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeInt32},
+			2: {name: "defined_only", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			3: {name: "in", kind: protovalidateRuleKindIn, valueType: protovalidateValueTypeInt32},
+			4: {name: "not_in", kind: protovalidateRuleKindNotIn, valueType: protovalidateValueTypeInt32},
+		},
+	},
+	18: {
+		name: "repeated",
+		kind: protovalidateRuleKindRules,
+		rules: map[protowire.Number]*protovalidateRule{
+			1: {name: "min_items", kind: protovalidateRuleKindMin, valueType: protovalidateValueTypeUint64},
+			2: {name: "max_items", kind: protovalidateRuleKindMax, valueType: protovalidateValueTypeUint64},
+			3: {name: "unique", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+			// items is set in init, as it refers to protovalidateFieldConstraintsRules.
+		},
+	},
+	19: {
+		name: "map",
+		kind: protovalidateRuleKindRules,
+		rules: map[protowire.Number]*protovalidateRule{
+			1: {name: "min_pairs", kind: protovalidateRuleKindMin, valueType: protovalidateValueTypeUint64},
+			2: {name: "max_pairs", kind: protovalidateRuleKindMax, valueType: protovalidateValueTypeUint64},
+			// keys and values are set in init, as they refer to protovalidateFieldConstraintsRules.
+		},
+	},
+	20: {
+		name: "any",
+		kind: protovalidateRuleKindRules,
+		rules: map[protowire.Number]*protovalidateRule{
+			2: {name: "in", kind: protovalidateRuleKindIn, valueType: protovalidateValueTypeString},
+			3: {name: "not_in", kind: protovalidateRuleKindNotIn, valueType: protovalidateValueTypeString},
+		},
+	},
+	21: newProtovalidateTimeRules("duration"),
+	22: newProtovalidateTimeRules("timestamp"),
+	23: {name: "cel", kind: protovalidateRuleKindNotIn, valueType: protovalidateValueTypeConstraint},
+	24: {name: "skipped", kind: protovalidateRuleKindLoosening, valueType: protovalidateValueTypeBool},
+	25: {name: "required", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool},
+	26: {name: "ignore_empty", kind: protovalidateRuleKindLoosening, valueType: protovalidateValueTypeBool},
+}
+
+func init() {
+	protovalidateFieldConstraintsRules[18].rules[4] = &protovalidateRule{
+		name:  "items",
+		kind:  protovalidateRuleKindRules,
+		rules: protovalidateFieldConstraintsRules,
+	}
+	protovalidateFieldConstraintsRules[19].rules[4] = &protovalidateRule{
+		name:  "keys",
+		kind:  protovalidateRuleKindRules,
+		rules: protovalidateFieldConstraintsRules,
+	}
+	protovalidateFieldConstraintsRules[19].rules[5] = &protovalidateRule{
+		name:  "values",
+		kind:  protovalidateRuleKindRules,
+		rules: protovalidateFieldConstraintsRules,
+	}
+}
+
+func newProtovalidateNumberRules(name string, valueType protovalidateValueType) *protovalidateRule {
+	rules := map[protowire.Number]*protovalidateRule{
+		1: {name: "const", kind: protovalidateRuleKindExact, valueType: valueType},
+		2: {name: "lt", kind: protovalidateRuleKindUpper, valueType: valueType},
+		3: {name: "lte", kind: protovalidateRuleKindUpper, valueType: valueType},
+		4: {name: "gt", kind: protovalidateRuleKindLower, valueType: valueType},
+		5: {name: "gte", kind: protovalidateRuleKindLower, valueType: valueType},
+		6: {name: "in", kind: protovalidateRuleKindIn, valueType: valueType},
+		7: {name: "not_in", kind: protovalidateRuleKindNotIn, valueType: valueType},
+	}
+	if valueType == protovalidateValueTypeFloat || valueType == protovalidateValueTypeDouble {
+		rules[8] = &protovalidateRule{name: "finite", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool}
+	}
+	return &protovalidateRule{
+		name:  name,
+		kind:  protovalidateRuleKindRules,
+		rules: rules,
+	}
+}
+
+// newProtovalidateTimeRules returns the rules of durations and timestamps,
+// the bounds of which are messages, and are compared by their encoding.
+func newProtovalidateTimeRules(name string) *protovalidateRule {
+	rules := map[protowire.Number]*protovalidateRule{
+		2: {name: "const", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeMessage},
+		3: {name: "lt", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeMessage},
+		4: {name: "lte", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeMessage},
+		5: {name: "gt", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeMessage},
+		6: {name: "gte", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeMessage},
+	}
+	if name == "duration" {
+		rules[7] = &protovalidateRule{name: "in", kind: protovalidateRuleKindIn, valueType: protovalidateValueTypeMessage}
+		rules[8] = &protovalidateRule{name: "not_in", kind: protovalidateRuleKindNotIn, valueType: protovalidateValueTypeMessage}
+	} else {
+		rules[7] = &protovalidateRule{name: "lt_now", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool}
+		rules[8] = &protovalidateRule{name: "gt_now", kind: protovalidateRuleKindFlag, valueType: protovalidateValueTypeBool}
+		rules[9] = &protovalidateRule{name: "within", kind: protovalidateRuleKindExact, valueType: protovalidateValueTypeMessage}
+	}
+	return &protovalidateRule{
+		name:  name,
+		kind:  protovalidateRuleKindRules,
+		rules: rules,
+	}
+}
+
+// protovalidateValue is a value of a rule.
+type protovalidateValue struct {
+	// display is the representation of the value in messages, which is
+	// also used to compare values.
+	display string
+	// number is the value of a number, or nil.
+	number *big.Float
+}
+
+// protovalidateConstraint is a rule that is set for a field, with its values.
+type protovalidateConstraint struct {
+	// path is the path of the rule, such as "string.min_len" or
+	// "repeated.items.int32.gt".
+	path   string
+	rule   *protovalidateRule
+	values []protovalidateValue
+}
+
+// String returns the path and the values of the constraint, such as
+// "string.min_len = 3" or "string.in = [a, b]".
+func (c *protovalidateConstraint) String() string {
+	return c.path + " = " + c.valueString()
+}
+
+func (c *protovalidateConstraint) valueString() string {
+	if c.rule.kind == protovalidateRuleKindIn || c.rule.kind == protovalidateRuleKindNotIn {
+		displays := make([]string, len(c.values))
+		for i, value := range c.values {
+			displays[i] = value.display
+		}
+		return "[" + strings.Join(displays, ", ") + "]"
+	}
+	return c.values[len(c.values)-1].display
+}
+
+// parseProtovalidateConstraints returns the constraints of the encoded
+// buf.validate.FieldConstraints, by path.
+//
+// Flags that are false are not returned.
+func parseProtovalidateConstraints(data []byte) map[string]*protovalidateConstraint {
+	pathToConstraint := make(map[string]*protovalidateConstraint)
+	parseProtovalidateRules(data, protovalidateFieldConstraintsRules, "", pathToConstraint)
+	for path, constraint := range pathToConstraint {
+		if (constraint.rule.kind == protovalidateRuleKindFlag || constraint.rule.kind == protovalidateRuleKindLoosening) &&
+			constraint.valueString() != "true" {
+			delete(pathToConstraint, path)
+		}
+	}
+	return pathToConstraint
+}
+
+func parseProtovalidateRules(
+	data []byte,
+	rules map[protowire.Number]*protovalidateRule,
+	prefix string,
+	pathToConstraint map[string]*protovalidateConstraint,
+) {
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return
+		}
+		data = data[n:]
+		valueLength := protowire.ConsumeFieldValue(number, wireType, data)
+		if valueLength < 0 {
+			return
+		}
+		valueData := data[:valueLength]
+		data = data[valueLength:]
+		rule, ok := rules[number]
+		if !ok {
+			continue
+		}
+		path := prefix + rule.name
+		if rule.kind == protovalidateRuleKindRules {
+			if wireType != protowire.BytesType {
+				continue
+			}
+			messageData, _ := protowire.ConsumeBytes(valueData)
+			parseProtovalidateRules(messageData, rule.rules, path+".", pathToConstraint)
+			continue
+		}
+		values := parseProtovalidateValues(rule.valueType, wireType, valueData)
+		if len(values) == 0 {
+			continue
+		}
+		constraint, ok := pathToConstraint[path]
+		if !ok {
+			constraint = &protovalidateConstraint{
+				path: path,
+				rule: rule,
+			}
+			pathToConstraint[path] = constraint
+		}
+		constraint.values = append(constraint.values, values...)
+	}
+}
+
+// parseProtovalidateValues parses the encoded values of the field, which may
+// be packed.
+func parseProtovalidateValues(valueType protovalidateValueType, wireType protowire.Type, data []byte) []protovalidateValue {
+	if wireType == protowire.BytesType && protovalidateValueTypeIsScalar(valueType) {
+		packedData, _ := protowire.ConsumeBytes(data)
+		var values []protovalidateValue
+		for len(packedData) > 0 {
+			value, n := parseProtovalidateScalarValue(valueType, packedData)
+			if n < 0 {
+				return values
+			}
+			values = append(values, value)
+			packedData = packedData[n:]
+		}
+		return values
+	}
+	if protovalidateValueTypeIsScalar(valueType) {
+		value, n := parseProtovalidateScalarValue(valueType, data)
+		if n < 0 {
+			return nil
+		}
+		return []protovalidateValue{value}
+	}
+	if wireType != protowire.BytesType {
+		return nil
+	}
+	bytesValue, n := protowire.ConsumeBytes(data)
+	if n < 0 {
+		return nil
+	}
+	switch valueType {
+	case protovalidateValueTypeString:
+		return []protovalidateValue{{display: strconv.Quote(string(bytesValue))}}
+	case protovalidateValueTypeConstraint:
+		return []protovalidateValue{{display: strconv.Quote(protovalidateConstraintExpression(bytesValue))}}
+	default:
+		// Bytes, and messages compared by their encoding.
+		return []protovalidateValue{{display: strconv.Quote(string(bytesValue))}}
+	}
+}
+
+func protovalidateValueTypeIsScalar(valueType protovalidateValueType) bool {
+	switch valueType {
+	case protovalidateValueTypeString, protovalidateValueTypeBytes, protovalidateValueTypeMessage, protovalidateValueTypeConstraint:
+		return false
+	default:
+		return true
+	}
+}
+
+// parseProtovalidateScalarValue parses a scalar value, and returns the number
+// of bytes read, or a negative number on error.
+func parseProtovalidateScalarValue(valueType protovalidateValueType, data []byte) (protovalidateValue, int) {
+	switch valueType {
+	case protovalidateValueTypeFixed32, protovalidateValueTypeSfixed32, protovalidateValueTypeFloat:
+		fixed32, n := protowire.ConsumeFixed32(data)
+		if n < 0 {
+			return protovalidateValue{}, n
+		}
+		switch valueType {
+		case protovalidateValueTypeFixed32:
+			return newProtovalidateIntValue(int64(fixed32)), n
+		case protovalidateValueTypeSfixed32:
+			return newProtovalidateIntValue(int64(int32(fixed32))), n
+		default:
+			return newProtovalidateFloatValue(float64(math.Float32frombits(fixed32))), n
+		}
+	case protovalidateValueTypeFixed64, protovalidateValueTypeSfixed64, protovalidateValueTypeDouble:
+		fixed64, n := protowire.ConsumeFixed64(data)
+		if n < 0 {
+			return protovalidateValue{}, n
+		}
+		switch valueType {
+		case protovalidateValueTypeFixed64:
+			return newProtovalidateUintValue(fixed64), n
+		case protovalidateValueTypeSfixed64:
+			return newProtovalidateIntValue(int64(fixed64)), n
+		default:
+			return newProtovalidateFloatValue(math.Float64frombits(fixed64)), n
+		}
+	default:
+		varint, n := protowire.ConsumeVarint(data)
+		if n < 0 {
+			return protovalidateValue{}, n
+		}
+		switch valueType {
+		case protovalidateValueTypeBool:
+			return protovalidateValue{display: strconv.FormatBool(varint != 0)}, n
+		case protovalidateValueTypeInt32:
+			return newProtovalidateIntValue(int64(int32(varint))), n
+		case protovalidateValueTypeUint32:
+			return newProtovalidateIntValue(int64(uint32(varint))), n
+		case protovalidateValueTypeUint64:
+			return newProtovalidateUintValue(varint), n
+		case protovalidateValueTypeSint32:
+			return newProtovalidateIntValue(int64(int32(protowire.DecodeZigZag(varint & math.MaxUint32)))), n
+		case protovalidateValueTypeSint64:
+			return newProtovalidateIntValue(protowire.DecodeZigZag(varint)), n
+		default:
+			return newProtovalidateIntValue(int64(varint)), n
+		}
+	}
+}
+
+func newProtovalidateIntValue(value int64) protovalidateValue {
+	return protovalidateValue{
+		display: strconv.FormatInt(value, 10),
+		number:  new(big.Float).SetInt64(value),
+	}
+}
+
+func newProtovalidateUintValue(value uint64) protovalidateValue {
+	return protovalidateValue{
+		display: strconv.FormatUint(value, 10),
+		number:  new(big.Float).SetUint64(value),
+	}
+}
+
+func newProtovalidateFloatValue(value float64) protovalidateValue {
+	protovalidateValue := protovalidateValue{
+		display: strconv.FormatFloat(value, 'g', -1, 64),
+	}
+	if !math.IsNaN(value) {
+		protovalidateValue.number = new(big.Float).SetFloat64(value)
+	}
+	return protovalidateValue
+}
+
+// protovalidateConstraintExpression returns the expression of the encoded
+// buf.validate.Constraint.
+func protovalidateConstraintExpression(data []byte) string {
+	var expression string
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			break
+		}
+		data = data[n:]
+		if number == 3 && wireType == protowire.BytesType {
+			value, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				break
+			}
+			expression = string(value)
+		}
+		m := protowire.ConsumeFieldValue(number, wireType, data)
+		if m < 0 {
+			break
+		}
+		data = data[m:]
+	}
+	return expression
+}
+
+// protovalidateBound is the lower or upper bound of a number.
+type protovalidateBound struct {
+	constraint *protovalidateConstraint
+	inclusive  bool
+}
+
+// getProtovalidateBound returns the bound of the kind with the path prefix,
+// such as "int32.", or nil.
+func getProtovalidateBound(
+	pathToConstraint map[string]*protovalidateConstraint,
+	prefix string,
+	kind protovalidateRuleKind,
+) *protovalidateBound {
+	exclusiveName, inclusiveName := "gt", "gte"
+	if kind == protovalidateRuleKindUpper {
+		exclusiveName, inclusiveName = "lt", "lte"
+	}
+	if constraint, ok := pathToConstraint[prefix+exclusiveName]; ok {
+		return &protovalidateBound{constraint: constraint}
+	}
+	if constraint, ok := pathToConstraint[prefix+inclusiveName]; ok {
+		return &protovalidateBound{constraint: constraint, inclusive: true}
+	}
+	return nil
+}
+
+// protovalidateBoundIsTighter returns true if the bound accepts fewer values
+// than the previous bound.
+//
+// Bounds that define an exclusive range, with a lower bound above the upper
+// bound, are compared as if they were independent.
+func protovalidateBoundIsTighter(previousBound *protovalidateBound, bound *protovalidateBound, kind protovalidateRuleKind) bool {
+	previousNumber := previousBound.constraint.values[len(previousBound.constraint.values)-1].number
+	number := bound.constraint.values[len(bound.constraint.values)-1].number
+	if previousNumber == nil || number == nil {
+		return previousBound.constraint.valueString() != bound.constraint.valueString() ||
+			previousBound.inclusive != bound.inclusive
+	}
+	comparison := number.Cmp(previousNumber)
+	if kind == protovalidateRuleKindUpper {
+		comparison = -comparison
+	}
+	return comparison > 0 || (comparison == 0 && previousBound.inclusive && !bound.inclusive)
+}
+
+// protovalidateConstraintIsTighter returns true if the constraint accepts
+// fewer values than the previous constraint with the same path.
+//
+// Bounds are compared by getProtovalidateBound and protovalidateBoundIsTighter.
+func protovalidateConstraintIsTighter(previousConstraint *protovalidateConstraint, constraint *protovalidateConstraint) bool {
+	switch constraint.rule.kind {
+	case protovalidateRuleKindMin, protovalidateRuleKindMax:
+		previousNumber := previousConstraint.values[len(previousConstraint.values)-1].number
+		number := constraint.values[len(constraint.values)-1].number
+		if previousNumber == nil || number == nil {
+			return previousConstraint.valueString() != constraint.valueString()
+		}
+		if constraint.rule.kind == protovalidateRuleKindMin {
+			return number.Cmp(previousNumber) > 0
+		}
+		return number.Cmp(previousNumber) < 0
+	case protovalidateRuleKindIn:
+		// Values that are removed are no longer valid.
+		return !protovalidateValuesContainAll(constraint.values, previousConstraint.values)
+	case protovalidateRuleKindNotIn:
+		// Values that are added are no longer valid.
+		return !protovalidateValuesContainAll(previousConstraint.values, constraint.values)
+	case protovalidateRuleKindFlag, protovalidateRuleKindLoosening:
+		// Both are true, as flags that are false are not parsed.
+		return false
+	default:
+		return previousConstraint.valueString() != constraint.valueString()
+	}
+}
+
+func protovalidateValuesContainAll(values []protovalidateValue, otherValues []protovalidateValue) bool {
+	displays := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		displays[value.display] = struct{}{}
+	}
+	for _, otherValue := range otherValues {
+		if _, ok := displays[otherValue.display]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// sortedProtovalidateConstraintPaths returns the sorted paths of the constraints.
+func sortedProtovalidateConstraintPaths(pathToConstraint map[string]*protovalidateConstraint) []string {
+	paths := make([]string, 0, len(pathToConstraint))
+	for path := range pathToConstraint {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
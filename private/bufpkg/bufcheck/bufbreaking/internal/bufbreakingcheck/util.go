@@ -22,10 +22,52 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 	"github.com/bufbuild/buf/private/pkg/protosource"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+const (
+	messageOptionsFullName = "google.protobuf.MessageOptions"
+	enumOptionsFullName    = "google.protobuf.EnumOptions"
+)
+
 var (
+	// ruleIDToChangeKind is the kind of change reported by each rule ID.
+	//
+	// Rules not in this map report bufanalysis.ChangeKindChanged.
+	ruleIDToChangeKind = map[string]bufanalysis.ChangeKind{
+		"ENUM_NO_DELETE":                              bufanalysis.ChangeKindRemoved,
+		"ENUM_VALUE_NO_DELETE":                        bufanalysis.ChangeKindRemoved,
+		"ENUM_VALUE_NO_DELETE_UNLESS_NAME_RESERVED":   bufanalysis.ChangeKindRemoved,
+		"ENUM_VALUE_NO_DELETE_UNLESS_NUMBER_RESERVED": bufanalysis.ChangeKindRemoved,
+		"EXTENSION_DECLARATION_NO_DELETE":             bufanalysis.ChangeKindRemoved,
+		"EXTENSION_MESSAGE_NO_DELETE":                 bufanalysis.ChangeKindRemoved,
+		"FIELD_NO_DELETE":                             bufanalysis.ChangeKindRemoved,
+		"FIELD_NO_DELETE_UNLESS_NAME_RESERVED":        bufanalysis.ChangeKindRemoved,
+		"FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED":      bufanalysis.ChangeKindRemoved,
+		"FILE_NO_DELETE":                              bufanalysis.ChangeKindRemoved,
+		"MESSAGE_NO_DELETE":                           bufanalysis.ChangeKindRemoved,
+		"ONEOF_NO_DELETE":                             bufanalysis.ChangeKindRemoved,
+		"PACKAGE_ENUM_NO_DELETE":                      bufanalysis.ChangeKindRemoved,
+		"PACKAGE_MESSAGE_NO_DELETE":                   bufanalysis.ChangeKindRemoved,
+		"PACKAGE_NO_DELETE":                           bufanalysis.ChangeKindRemoved,
+		"PACKAGE_SERVICE_NO_DELETE":                   bufanalysis.ChangeKindRemoved,
+		"RESERVED_ENUM_NO_DELETE":                     bufanalysis.ChangeKindRemoved,
+		"RESERVED_MESSAGE_NO_DELETE":                  bufanalysis.ChangeKindRemoved,
+		"RPC_NO_DELETE":                               bufanalysis.ChangeKindRemoved,
+		"SERVICE_NO_DELETE":                           bufanalysis.ChangeKindRemoved,
+		"ENUM_VALUE_SAME_NAME":                        bufanalysis.ChangeKindRenamed,
+		"FIELD_SAME_NAME":                             bufanalysis.ChangeKindRenamed,
+		"FIELD_SAME_TYPE":                             bufanalysis.ChangeKindTypeChanged,
+		"FIELD_WIRE_COMPATIBLE_TYPE":                  bufanalysis.ChangeKindTypeChanged,
+		"FIELD_WIRE_JSON_COMPATIBLE_TYPE":             bufanalysis.ChangeKindTypeChanged,
+		"FIELD_JSON_COMPATIBLE_TYPE":                  bufanalysis.ChangeKindTypeChanged,
+		"RPC_SAME_REQUEST_TYPE":                       bufanalysis.ChangeKindTypeChanged,
+		"RPC_SAME_RESPONSE_TYPE":                      bufanalysis.ChangeKindTypeChanged,
+	}
+
 	// https://developers.google.com/protocol-buffers/docs/proto3#updating
 	fieldDescriptorProtoTypeToWireCompatiblityGroup = map[descriptorpb.FieldDescriptorProto_Type]int{
 		descriptorpb.FieldDescriptorProto_TYPE_INT32:  1,
@@ -82,12 +124,107 @@ var (
 		descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:  14,
 		descriptorpb.FieldDescriptorProto_TYPE_ENUM:     15,
 	}
+
+	fieldDescriptorProtoTypeToJSONCompatibilityGroup = map[descriptorpb.FieldDescriptorProto_Type]int{
+		// 32-bit integers are all JSON numbers
+		descriptorpb.FieldDescriptorProto_TYPE_INT32:    1,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT32:   1,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT32:   1,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED32:  1,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED32: 1,
+		// 64-bit integers are all JSON strings
+		descriptorpb.FieldDescriptorProto_TYPE_INT64:    2,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64:   2,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64:   2,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64:  2,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64: 2,
+		descriptorpb.FieldDescriptorProto_TYPE_BOOL:     3,
+		descriptorpb.FieldDescriptorProto_TYPE_STRING:   4,
+		// bytes are base64-encoded JSON strings, which are not compatible with string
+		descriptorpb.FieldDescriptorProto_TYPE_BYTES:   5,
+		descriptorpb.FieldDescriptorProto_TYPE_FLOAT:   6,
+		descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:  6,
+		descriptorpb.FieldDescriptorProto_TYPE_GROUP:   7,
+		descriptorpb.FieldDescriptorProto_TYPE_MESSAGE: 8,
+		descriptorpb.FieldDescriptorProto_TYPE_ENUM:    9,
+	}
 )
 
 // addFunc adds a FileAnnotation.
 //
-// Both the Descriptor and Location can be nil.
-type addFunc func(protosource.Descriptor, []protosource.Descriptor, protosource.Location, string, ...interface{})
+// The previous Descriptor is the Descriptor in the previous files that the change is
+// relative to, such as a deleted field. It is used for the against location and the
+// descriptor name of the FileAnnotation.
+//
+// The Descriptor, previous Descriptor, and Location can be nil.
+type addFunc func(protosource.Descriptor, protosource.Descriptor, []protosource.Descriptor, protosource.Location, string, ...interface{})
+
+// newAddFunc returns a new addFunc for the Helper.
+//
+// The location passed to an addFunc is frequently a sub-location of the descriptor, such as
+// the location of a field type, which never has leading comments. The location of the entire
+// descriptor is also checked for comment ignores, so that a comment ignore can be placed
+// directly above the descriptor that was changed.
+func newAddFunc(helper *internal.Helper, id string) addFunc {
+	changeKind, ok := ruleIDToChangeKind[id]
+	if !ok {
+		changeKind = bufanalysis.ChangeKindChanged
+	}
+	return func(
+		descriptor protosource.Descriptor,
+		previousDescriptor protosource.Descriptor,
+		extraIgnoreDescriptors []protosource.Descriptor,
+		location protosource.Location,
+		format string,
+		args ...interface{},
+	) {
+		var extraIgnoreLocations []protosource.Location
+		if locationDescriptor, ok := descriptor.(protosource.LocationDescriptor); ok {
+			extraIgnoreLocations = append(extraIgnoreLocations, locationDescriptor.Location())
+		}
+		options := []bufanalysis.FileAnnotationOption{
+			bufanalysis.FileAnnotationWithChangeKind(changeKind),
+		}
+		// The previous descriptor is preferred, as it is the descriptor as known to
+		// consumers of the previous files, and is the only descriptor for deletions.
+		if namedDescriptor, ok := previousDescriptor.(protosource.NamedDescriptor); ok {
+			options = append(options, bufanalysis.FileAnnotationWithDescriptor(namedDescriptor.FullName()))
+		} else if namedDescriptor, ok := descriptor.(protosource.NamedDescriptor); ok {
+			options = append(options, bufanalysis.FileAnnotationWithDescriptor(namedDescriptor.FullName()))
+		}
+		if previousDescriptor != nil {
+			options = append(options, bufanalysis.FileAnnotationWithAgainstLocation(newAgainstLocation(previousDescriptor)))
+		}
+		helper.AddFileAnnotationWithExtraIgnoresAndOptionsf(
+			descriptor,
+			extraIgnoreDescriptors,
+			location,
+			extraIgnoreLocations,
+			options,
+			format,
+			args...,
+		)
+	}
+}
+
+// newAgainstLocation returns the against location for the previous Descriptor.
+func newAgainstLocation(previousDescriptor protosource.Descriptor) bufanalysis.RelatedInformation {
+	var location protosource.Location
+	if locationDescriptor, ok := previousDescriptor.(protosource.LocationDescriptor); ok {
+		location = locationDescriptor.Location()
+	}
+	if location == nil {
+		return bufanalysis.NewRelatedInformation(previousDescriptor.File(), 0, 0, 0, 0, "")
+	}
+	return bufanalysis.NewRelatedInformation(
+		previousDescriptor.File(),
+		location.StartLine(),
+		location.StartColumn(),
+		location.EndLine(),
+		location.EndColumn(),
+		"",
+	)
+}
 
 // corpus is a store of the previous files and files given to a check function.
 //
@@ -112,7 +249,7 @@ func newFilesCheckFunc(
 ) func(string, internal.IgnoreFunc, []protosource.File, []protosource.File) ([]bufanalysis.FileAnnotation, error) {
 	return func(id string, ignoreFunc internal.IgnoreFunc, previousFiles []protosource.File, files []protosource.File) ([]bufanalysis.FileAnnotation, error) {
 		helper := internal.NewHelper(id, ignoreFunc)
-		if err := f(helper.AddFileAnnotationWithExtraIgnoreDescriptorsf, newCorpus(previousFiles, files)); err != nil {
+		if err := f(newAddFunc(helper, id), newCorpus(previousFiles, files)); err != nil {
 			return nil, err
 		}
 		return helper.FileAnnotations(), nil
@@ -345,9 +482,156 @@ func getEnumByFullName(files []protosource.File, enumFullName string) (protosour
 	return enum, nil
 }
 
+// getProtovalidateConstraints returns the protovalidate constraints of the field, by path.
+func getProtovalidateConstraints(field protosource.Field) map[string]*protovalidateConstraint {
+	data, ok := field.OptionExtensionMessageBytes(protovalidateFieldExtensionNumber)
+	if !ok {
+		return nil
+	}
+	return parseProtovalidateConstraints(data)
+}
+
+// getExtensionDeclarations returns the extension declarations of all extension ranges of the message.
+func getExtensionDeclarations(message protosource.Message) []protosource.ExtensionDeclaration {
+	var declarations []protosource.ExtensionDeclaration
+	for _, extensionRange := range message.ExtensionRanges() {
+		declarations = append(declarations, extensionRange.Declarations()...)
+	}
+	return declarations
+}
+
+// getExtensionDeclarationLocation returns the location of the declaration, or of its
+// extension range if the declaration has no location.
+func getExtensionDeclarationLocation(declaration protosource.ExtensionDeclaration) protosource.Location {
+	return withBackupLocation(declaration.Location(), declaration.ExtensionRange().Location())
+}
+
+func getNumberToExtensionDeclaration(message protosource.Message) map[int]protosource.ExtensionDeclaration {
+	numberToDeclaration := make(map[int]protosource.ExtensionDeclaration)
+	for _, declaration := range getExtensionDeclarations(message) {
+		numberToDeclaration[declaration.Number()] = declaration
+	}
+	return numberToDeclaration
+}
+
 func withBackupLocation(primary protosource.Location, secondary protosource.Location) protosource.Location {
 	if primary != nil {
 		return primary
 	}
 	return secondary
 }
+
+// getDescriptorProtoForMessage returns the DescriptorProto of the message from the
+// FileDescriptor of its file, or nil if it cannot be found.
+func getDescriptorProtoForMessage(message protosource.Message) *descriptorpb.DescriptorProto {
+	descriptorProtos := message.File().FileDescriptor().GetMessageType()
+	var descriptorProto *descriptorpb.DescriptorProto
+	for _, name := range strings.Split(message.NestedName(), ".") {
+		descriptorProto = nil
+		for _, candidate := range descriptorProtos {
+			if candidate.GetName() == name {
+				descriptorProto = candidate
+				break
+			}
+		}
+		if descriptorProto == nil {
+			return nil
+		}
+		descriptorProtos = descriptorProto.GetNestedType()
+	}
+	return descriptorProto
+}
+
+// getDescriptorProtoForEnum returns the EnumDescriptorProto of the enum from the
+// FileDescriptor of its file, or nil if it cannot be found.
+func getDescriptorProtoForEnum(enum protosource.Enum) *descriptorpb.EnumDescriptorProto {
+	enumDescriptorProtos := enum.File().FileDescriptor().GetEnumType()
+	if parent := enum.Parent(); parent != nil {
+		enumDescriptorProtos = getDescriptorProtoForMessage(parent).GetEnumType()
+	}
+	for _, enumDescriptorProto := range enumDescriptorProtos {
+		if enumDescriptorProto.GetName() == enum.Name() {
+			return enumDescriptorProto
+		}
+	}
+	return nil
+}
+
+// getBoolOptionNumberToName returns a map from field number to name for the bool
+// extensions of the options message with the given full name that have one of the
+// given names.
+//
+// Extensions that are not defined in the files are not returned.
+func getBoolOptionNumberToName(files []protosource.File, optionNames []string, optionsFullName string) map[int32]string {
+	optionNameMap := stringutil.SliceToMap(optionNames)
+	numberToName := make(map[int32]string)
+	for _, file := range files {
+		extensions := append([]protosource.Field{}, file.Extensions()...)
+		_ = protosource.ForEachMessage(
+			func(message protosource.Message) error {
+				extensions = append(extensions, message.Extensions()...)
+				return nil
+			},
+			file,
+		)
+		for _, extension := range extensions {
+			if extension.Extendee() != optionsFullName || extension.Type() != descriptorpb.FieldDescriptorProto_TYPE_BOOL {
+				continue
+			}
+			if _, ok := optionNameMap[extension.FullName()]; ok {
+				numberToName[int32(extension.Number())] = extension.FullName()
+			}
+		}
+	}
+	return numberToName
+}
+
+// getBoolOptionSet returns the name of the first of the bool options in numberToName
+// that is set to true on the options message, if any.
+//
+// Options that are not known to this program are read from the unknown fields of the
+// options message.
+func getBoolOptionSet(options protoreflect.Message, numberToName map[int32]string) (string, bool) {
+	if len(numberToName) == 0 || !options.IsValid() {
+		return "", false
+	}
+	numberToValue := make(map[int32]bool)
+	options.Range(func(fieldDescriptor protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		number := int32(fieldDescriptor.Number())
+		if _, ok := numberToName[number]; ok && fieldDescriptor.IsExtension() && fieldDescriptor.Kind() == protoreflect.BoolKind {
+			numberToValue[number] = value.Bool()
+		}
+		return true
+	})
+	for b := options.GetUnknown(); len(b) > 0; {
+		number, wireType, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			break
+		}
+		b = b[n:]
+		if _, ok := numberToName[int32(number)]; ok && wireType == protowire.VarintType {
+			value, m := protowire.ConsumeVarint(b)
+			if m < 0 {
+				break
+			}
+			// The last value wins.
+			numberToValue[int32(number)] = value != 0
+		}
+		m := protowire.ConsumeFieldValue(number, wireType, b)
+		if m < 0 {
+			break
+		}
+		b = b[m:]
+	}
+	var names []string
+	for number, value := range numberToValue {
+		if value {
+			names = append(names, numberToName[number])
+		}
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+	sort.Strings(names)
+	return names[0], true
+}
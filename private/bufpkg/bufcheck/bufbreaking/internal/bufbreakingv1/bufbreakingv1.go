@@ -30,6 +30,8 @@ import "github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 // Splits FIELD_SAME_TYPE into FIELD_SAME_TYPE for FILE AND PACKAGE,
 // FIRE_WIRE_JSON_COMPATIBLE_TYPE for WIRE_JSON, and
 // FIELD_WIRE_COMPATIBLE_TYPE for WIRE.
+//
+// Adds the JSON category and FIELD_JSON_COMPATIBLE_TYPE for JSON.
 var VersionSpec = &internal.VersionSpec{
 	RuleBuilders:      v1RuleBuilders,
 	DefaultCategories: v1DefaultCategories,
@@ -22,15 +22,21 @@ import (
 var (
 	// v1RuleBuilders are the rule builders.
 	v1RuleBuilders = []*internal.RuleBuilder{
+		bufbreakingbuild.AppendOnlyRuleBuilder,
 		bufbreakingbuild.EnumNoDeleteRuleBuilder,
 		bufbreakingbuild.EnumValueNoDeleteRuleBuilder,
 		bufbreakingbuild.EnumValueNoDeleteUnlessNameReservedRuleBuilder,
 		bufbreakingbuild.EnumValueNoDeleteUnlessNumberReservedRuleBuilder,
 		bufbreakingbuild.EnumValueSameNameRuleBuilder,
+		bufbreakingbuild.ExtensionDeclarationNoDeleteRuleBuilder,
+		bufbreakingbuild.ExtensionDeclarationSameExtensionRuleBuilder,
 		bufbreakingbuild.ExtensionMessageNoDeleteRuleBuilder,
+		bufbreakingbuild.FieldJSONCompatibleTypeRuleBuilder,
 		bufbreakingbuild.FieldNoDeleteRuleBuilder,
 		bufbreakingbuild.FieldNoDeleteUnlessNameReservedRuleBuilder,
 		bufbreakingbuild.FieldNoDeleteUnlessNumberReservedRuleBuilder,
+		bufbreakingbuild.FieldNoNewProtovalidateConstraintsRuleBuilder,
+		bufbreakingbuild.FieldNoTighterProtovalidateConstraintsRuleBuilder,
 		bufbreakingbuild.FieldSameCTypeRuleBuilder,
 		bufbreakingbuild.FieldSameJSONNameRuleBuilder,
 		bufbreakingbuild.FieldSameJSTypeRuleBuilder,
@@ -61,6 +67,7 @@ var (
 		bufbreakingbuild.FileSamePhpGenericServicesRuleBuilder,
 		bufbreakingbuild.FileSameCcEnableArenasRuleBuilder,
 		bufbreakingbuild.FileSameSyntaxRuleBuilder,
+		bufbreakingbuild.FrozenRuleBuilder,
 		bufbreakingbuild.MessageNoDeleteRuleBuilder,
 		bufbreakingbuild.MessageNoRemoveStandardDescriptorAccessorRuleBuilder,
 		bufbreakingbuild.MessageSameMessageSetWireFormatRuleBuilder,
@@ -87,6 +94,13 @@ var (
 	}
 	// v1IDToCategories associates IDs to categories.
 	v1IDToCategories = map[string][]string{
+		"APPEND_ONLY": {
+			"FILE",
+			"PACKAGE",
+			"WIRE_JSON",
+			"WIRE",
+			"JSON",
+		},
 		"ENUM_NO_DELETE": {
 			"FILE",
 		},
@@ -96,6 +110,7 @@ var (
 		},
 		"ENUM_VALUE_NO_DELETE_UNLESS_NAME_RESERVED": {
 			"WIRE_JSON",
+			"JSON",
 		},
 		"ENUM_VALUE_NO_DELETE_UNLESS_NUMBER_RESERVED": {
 			"WIRE_JSON",
@@ -105,22 +120,35 @@ var (
 			"FILE",
 			"PACKAGE",
 			"WIRE_JSON",
+			"JSON",
 		},
+		"EXTENSION_DECLARATION_NO_DELETE":      {},
+		"EXTENSION_DECLARATION_SAME_EXTENSION": {},
 		"EXTENSION_MESSAGE_NO_DELETE": {
 			"FILE",
 			"PACKAGE",
 		},
+		"FIELD_JSON_COMPATIBLE_TYPE": {
+			"JSON",
+		},
 		"FIELD_NO_DELETE": {
 			"FILE",
 			"PACKAGE",
 		},
 		"FIELD_NO_DELETE_UNLESS_NAME_RESERVED": {
 			"WIRE_JSON",
+			"JSON",
 		},
 		"FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED": {
 			"WIRE_JSON",
 			"WIRE",
 		},
+		"FIELD_NO_NEW_PROTOVALIDATE_CONSTRAINTS": {
+			"PROTOVALIDATE",
+		},
+		"FIELD_NO_TIGHTER_PROTOVALIDATE_CONSTRAINTS": {
+			"PROTOVALIDATE",
+		},
 		"FIELD_SAME_CTYPE": {
 			"FILE",
 			"PACKAGE",
@@ -129,6 +157,7 @@ var (
 			"FILE",
 			"PACKAGE",
 			"WIRE_JSON",
+			"JSON",
 		},
 		"FIELD_SAME_JSTYPE": {
 			"FILE",
@@ -139,6 +168,7 @@ var (
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"FIELD_SAME_NAME": {
 			"FILE",
@@ -150,6 +180,7 @@ var (
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"FIELD_SAME_TYPE": {
 			"FILE",
@@ -197,6 +228,7 @@ var (
 			"PACKAGE",
 			"WIRE",
 			"WIRE_JSON",
+			"JSON",
 		},
 		"FILE_SAME_PHP_CLASS_PREFIX": {
 			"FILE",
@@ -246,6 +278,13 @@ var (
 			"FILE",
 			"PACKAGE",
 		},
+		"FROZEN": {
+			"FILE",
+			"PACKAGE",
+			"WIRE_JSON",
+			"WIRE",
+			"JSON",
+		},
 		"MESSAGE_NO_DELETE": {
 			"FILE",
 		},
@@ -264,6 +303,7 @@ var (
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"ONEOF_NO_DELETE": {
 			"FILE",
@@ -286,12 +326,14 @@ var (
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"RESERVED_MESSAGE_NO_DELETE": {
 			"FILE",
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"RPC_NO_DELETE": {
 			"FILE",
@@ -302,30 +344,35 @@ var (
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"RPC_SAME_IDEMPOTENCY_LEVEL": {
 			"FILE",
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"RPC_SAME_REQUEST_TYPE": {
 			"FILE",
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"RPC_SAME_RESPONSE_TYPE": {
 			"FILE",
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"RPC_SAME_SERVER_STREAMING": {
 			"FILE",
 			"PACKAGE",
 			"WIRE_JSON",
 			"WIRE",
+			"JSON",
 		},
 		"SERVICE_NO_DELETE": {
 			"FILE",
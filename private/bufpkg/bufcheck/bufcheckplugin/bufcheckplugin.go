@@ -0,0 +1,140 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufcheckplugin implements the check plugin protocol.
+//
+// A check plugin is an executable that implements custom lint or breaking
+// change rules. The plugin is given a JSON-encoded Request on stdin, and writes
+// zero or more JSON-encoded Annotations to stdout, one per line. Annotations
+// have the same shape as the annotations printed with --error-format=json.
+//
+// The plugin should exit with a non-zero exit code only if it failed to run.
+// Annotations do not result in a non-zero exit code.
+//
+// If ListRules is set on the Request, the plugin instead writes the Rules it
+// implements to stdout, one JSON-encoded Rule per line.
+package bufcheckplugin
+
+import (
+	"context"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"go.uber.org/zap"
+)
+
+// Request is the request written to the stdin of a check plugin.
+type Request struct {
+	// Image is the binary-encoded buf.alpha.image.v1.Image to check.
+	//
+	// The Image contains source code info for the files that are checked.
+	Image []byte `json:"image,omitempty"`
+	// AgainstImage is the binary-encoded buf.alpha.image.v1.Image to check against.
+	//
+	// This is only set for breaking change plugins.
+	AgainstImage []byte `json:"against_image,omitempty"`
+	// ListRules says to list the rules implemented by the plugin instead of
+	// running a check. No images are set if this is true.
+	ListRules bool `json:"list_rules,omitempty"`
+}
+
+// Rule is a rule written to the stdout of a check plugin when ListRules is set.
+type Rule struct {
+	// ID is the ID of the rule, and the type of the annotations it produces.
+	//
+	// UPPER_SNAKE_CASE.
+	ID string `json:"id,omitempty"`
+	// Categories are the categories of the rule.
+	//
+	// UPPER_SNAKE_CASE.
+	Categories []string `json:"categories,omitempty"`
+	// Purpose is the purpose of the rule.
+	//
+	// Full sentence.
+	Purpose string `json:"purpose,omitempty"`
+	// Example is an optional example for the rule in Markdown.
+	Example string `json:"example,omitempty"`
+}
+
+// PluginRule is a bufcheck.Rule implemented by a check plugin.
+type PluginRule interface {
+	bufcheck.Rule
+
+	// Plugin is the name or path of the plugin that implements the rule.
+	Plugin() string
+	// Example is the example for the rule in Markdown.
+	//
+	// May be empty.
+	Example() string
+}
+
+// Annotation is an annotation written to the stdout of a check plugin.
+type Annotation struct {
+	// Path is the path of the file within the Image.
+	//
+	// Path may be empty if the annotation does not apply to a specific file.
+	Path        string `json:"path,omitempty"`
+	StartLine   int    `json:"start_line,omitempty"`
+	StartColumn int    `json:"start_column,omitempty"`
+	EndLine     int    `json:"end_line,omitempty"`
+	EndColumn   int    `json:"end_column,omitempty"`
+	// Type is the ID of the rule that produced the annotation.
+	//
+	// UPPER_SNAKE_CASE.
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Runner runs check plugins.
+type Runner interface {
+	// Check runs the plugin with the args against the images.
+	//
+	// againstImage should be nil for lint plugins.
+	//
+	// The returned FileAnnotations reference the files of image.
+	Check(
+		ctx context.Context,
+		plugin string,
+		args []string,
+		image bufimage.Image,
+		againstImage bufimage.Image,
+	) ([]bufanalysis.FileAnnotation, error)
+	// ListRules lists the rules implemented by the plugin.
+	//
+	// The rules are sorted by ID.
+	ListRules(
+		ctx context.Context,
+		plugin string,
+		args []string,
+	) ([]PluginRule, error)
+}
+
+// NewRunner returns a new Runner.
+//
+// Plugins are run with the environment of the container, and the stderr of
+// plugins is written to the stderr of the container.
+func NewRunner(
+	logger *zap.Logger,
+	runner command.Runner,
+	container app.EnvStderrContainer,
+) Runner {
+	return newRunner(
+		logger,
+		runner,
+		container,
+	)
+}
@@ -0,0 +1,87 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcheckplugin
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagetesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileAnnotations(t *testing.T) {
+	t.Parallel()
+	image := bufimagetesting.NewImage(t, bufimagetesting.NewProtoImageFile(t, "a/a.proto"))
+	fileAnnotations, err := parseFileAnnotations(
+		[]byte(`{"path":"a/a.proto","start_line":3,"start_column":1,"end_line":3,"end_column":10,"type":"NO_NEW_REQUIRED","message":"Field \"1\" is now required."}
+
+{"type":"NO_NEW_REQUIRED","message":"No file."}
+`),
+		image,
+	)
+	require.NoError(t, err)
+	require.Len(t, fileAnnotations, 2)
+	assert.Equal(t, "a/a.proto", fileAnnotations[0].FileInfo().Path())
+	assert.Equal(t, 3, fileAnnotations[0].StartLine())
+	assert.Equal(t, 1, fileAnnotations[0].StartColumn())
+	assert.Equal(t, 3, fileAnnotations[0].EndLine())
+	assert.Equal(t, 10, fileAnnotations[0].EndColumn())
+	assert.Equal(t, "NO_NEW_REQUIRED", fileAnnotations[0].Type())
+	assert.Equal(t, `Field "1" is now required.`, fileAnnotations[0].Message())
+	assert.Nil(t, fileAnnotations[1].FileInfo())
+
+	_, err = parseFileAnnotations([]byte(`{"path":"b/b.proto","type":"NO_NEW_REQUIRED"}`), image)
+	assert.Error(t, err)
+	_, err = parseFileAnnotations([]byte(`{"path":"a/a.proto"}`), image)
+	assert.Error(t, err)
+	_, err = parseFileAnnotations([]byte(`not json`), image)
+	assert.Error(t, err)
+}
+
+func TestParsePluginRules(t *testing.T) {
+	t.Parallel()
+	pluginRules, err := parsePluginRules(
+		[]byte(`{"id":"NO_NEW_REQUIRED","categories":["PROTOVALIDATE","CUSTOM"],"purpose":"Checks that no new required constraints are added.","example":"Adding required is breaking."}
+{"id":"ANOTHER_RULE","purpose":"Checks another thing."}
+`),
+		"buf-plugin-protovalidate",
+	)
+	require.NoError(t, err)
+	require.Len(t, pluginRules, 2)
+	assert.Equal(t, "ANOTHER_RULE", pluginRules[0].ID())
+	assert.Equal(t, "NO_NEW_REQUIRED", pluginRules[1].ID())
+	assert.Equal(t, []string{"CUSTOM", "PROTOVALIDATE"}, pluginRules[1].Categories())
+	assert.Equal(t, "Checks that no new required constraints are added.", pluginRules[1].Purpose())
+	assert.Equal(t, "Adding required is breaking.", pluginRules[1].Example())
+	assert.Equal(t, "buf-plugin-protovalidate", pluginRules[1].Plugin())
+
+	_, err = parsePluginRules([]byte(`{"purpose":"No id."}`), "buf-plugin-protovalidate")
+	assert.Error(t, err)
+	_, err = parsePluginRules([]byte("{\"id\":\"A\"}\n{\"id\":\"A\"}\n"), "buf-plugin-protovalidate")
+	assert.Error(t, err)
+}
+
+func TestNewRequest(t *testing.T) {
+	t.Parallel()
+	image := bufimagetesting.NewImage(t, bufimagetesting.NewProtoImageFile(t, "a/a.proto"))
+	request, err := newRequest(image, nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, request.Image)
+	assert.Empty(t, request.AgainstImage)
+	request, err = newRequest(image, image)
+	require.NoError(t, err)
+	assert.Equal(t, request.Image, request.AgainstImage)
+}
@@ -0,0 +1,72 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcheckplugin
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+type pluginRule struct {
+	plugin     string
+	id         string
+	categories []string
+	purpose    string
+	example    string
+}
+
+func newPluginRule(plugin string, rule *Rule) *pluginRule {
+	categories := make([]string, len(rule.Categories))
+	copy(categories, rule.Categories)
+	sort.Strings(categories)
+	return &pluginRule{
+		plugin:     plugin,
+		id:         rule.ID,
+		categories: categories,
+		purpose:    rule.Purpose,
+		example:    rule.Example,
+	}
+}
+
+func (r *pluginRule) ID() string {
+	return r.id
+}
+
+func (r *pluginRule) Categories() []string {
+	return r.categories
+}
+
+func (r *pluginRule) Purpose() string {
+	return r.purpose
+}
+
+func (r *pluginRule) Plugin() string {
+	return r.plugin
+}
+
+func (r *pluginRule) Example() string {
+	return r.example
+}
+
+func (r *pluginRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pluginRuleJSON{ID: r.id, Categories: r.categories, Purpose: r.purpose, Plugin: r.plugin})
+}
+
+type pluginRuleJSON struct {
+	ID         string   `json:"id" yaml:"id"`
+	Categories []string `json:"categories" yaml:"categories"`
+	Purpose    string   `json:"purpose" yaml:"purpose"`
+	Plugin     string   `json:"plugin" yaml:"plugin"`
+}
@@ -0,0 +1,219 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufcheckplugin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"go.uber.org/zap"
+)
+
+type runner struct {
+	logger    *zap.Logger
+	runner    command.Runner
+	container app.EnvStderrContainer
+}
+
+func newRunner(
+	logger *zap.Logger,
+	commandRunner command.Runner,
+	container app.EnvStderrContainer,
+) *runner {
+	return &runner{
+		logger:    logger.Named("bufcheckplugin"),
+		runner:    commandRunner,
+		container: container,
+	}
+}
+
+func (r *runner) Check(
+	ctx context.Context,
+	plugin string,
+	args []string,
+	image bufimage.Image,
+	againstImage bufimage.Image,
+) ([]bufanalysis.FileAnnotation, error) {
+	request, err := newRequest(image, againstImage)
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := r.run(ctx, plugin, args, request)
+	if err != nil {
+		return nil, err
+	}
+	fileAnnotations, err := parseFileAnnotations(stdout, image)
+	if err != nil {
+		return nil, fmt.Errorf("check plugin %s: %w", plugin, err)
+	}
+	return fileAnnotations, nil
+}
+
+func (r *runner) ListRules(
+	ctx context.Context,
+	plugin string,
+	args []string,
+) ([]PluginRule, error) {
+	stdout, err := r.run(ctx, plugin, args, &Request{ListRules: true})
+	if err != nil {
+		return nil, err
+	}
+	pluginRules, err := parsePluginRules(stdout, plugin)
+	if err != nil {
+		return nil, fmt.Errorf("check plugin %s: %w", plugin, err)
+	}
+	return pluginRules, nil
+}
+
+// run runs the plugin with the request and returns its stdout.
+func (r *runner) run(
+	ctx context.Context,
+	plugin string,
+	args []string,
+	request *Request,
+) ([]byte, error) {
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	stdout := bytes.NewBuffer(nil)
+	runOptions := []command.RunOption{
+		command.RunWithEnv(app.EnvironMap(r.container)),
+		command.RunWithStdin(bytes.NewReader(requestData)),
+		command.RunWithStdout(stdout),
+		command.RunWithStderr(r.container.Stderr()),
+	}
+	if len(args) > 0 {
+		runOptions = append(runOptions, command.RunWithArgs(args...))
+	}
+	r.logger.Debug("check_plugin", zap.String("plugin", plugin), zap.Strings("args", args), zap.Bool("list_rules", request.ListRules))
+	if err := r.runner.Run(ctx, plugin, runOptions...); err != nil {
+		return nil, fmt.Errorf("check plugin %s: %w", plugin, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+func newRequest(image bufimage.Image, againstImage bufimage.Image) (*Request, error) {
+	marshaler := protoencoding.NewWireMarshaler()
+	imageData, err := marshaler.Marshal(bufimage.ImageToProtoImage(image))
+	if err != nil {
+		return nil, err
+	}
+	request := &Request{
+		Image: imageData,
+	}
+	if againstImage != nil {
+		againstImageData, err := marshaler.Marshal(bufimage.ImageToProtoImage(againstImage))
+		if err != nil {
+			return nil, err
+		}
+		request.AgainstImage = againstImageData
+	}
+	return request, nil
+}
+
+// parsePluginRules parses the newline-delimited Rules written by a plugin.
+func parsePluginRules(data []byte, plugin string) ([]PluginRule, error) {
+	var pluginRules []PluginRule
+	seenIDs := make(map[string]struct{})
+	if err := forEachLine(data, func(line []byte) error {
+		rule := &Rule{}
+		if err := json.Unmarshal(line, rule); err != nil {
+			return fmt.Errorf("invalid rule %q: %w", string(line), err)
+		}
+		if rule.ID == "" {
+			return fmt.Errorf("rule %q has no id", string(line))
+		}
+		if _, ok := seenIDs[rule.ID]; ok {
+			return fmt.Errorf("duplicate rule %q", rule.ID)
+		}
+		seenIDs[rule.ID] = struct{}{}
+		pluginRules = append(pluginRules, newPluginRule(plugin, rule))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(pluginRules, func(i int, j int) bool { return pluginRules[i].ID() < pluginRules[j].ID() })
+	return pluginRules, nil
+}
+
+// parseFileAnnotations parses the newline-delimited Annotations written by a plugin.
+func parseFileAnnotations(data []byte, image bufimage.Image) ([]bufanalysis.FileAnnotation, error) {
+	var fileAnnotations []bufanalysis.FileAnnotation
+	if err := forEachLine(data, func(line []byte) error {
+		annotation := &Annotation{}
+		if err := json.Unmarshal(line, annotation); err != nil {
+			return fmt.Errorf("invalid annotation %q: %w", string(line), err)
+		}
+		if annotation.Type == "" {
+			return fmt.Errorf("annotation %q has no type", string(line))
+		}
+		var fileInfo bufanalysis.FileInfo
+		if annotation.Path != "" {
+			path, err := normalpath.NormalizeAndValidate(annotation.Path)
+			if err != nil {
+				return fmt.Errorf("annotation %q: %w", string(line), err)
+			}
+			imageFile := image.GetFile(path)
+			if imageFile == nil {
+				return fmt.Errorf("annotation %q references unknown file %q", string(line), annotation.Path)
+			}
+			fileInfo = imageFile
+		}
+		fileAnnotations = append(
+			fileAnnotations,
+			bufanalysis.NewFileAnnotation(
+				fileInfo,
+				annotation.StartLine,
+				annotation.StartColumn,
+				annotation.EndLine,
+				annotation.EndColumn,
+				annotation.Type,
+				annotation.Message,
+			),
+		)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return fileAnnotations, nil
+}
+
+// forEachLine calls f for each non-empty line of data.
+func forEachLine(data []byte, f func([]byte) error) error {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	// Lines with long messages should not fail the scan.
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), len(data)+1)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := f(line); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,101 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufdeprecation tracks deprecated fields and methods.
+//
+// Every field and method marked with the deprecated option is expected to carry a
+// structured deprecation annotation in its leading comments of the form:
+//
+//	buf:deprecated replacement=foo.v2.Bar.baz remove_by=2024-06-30
+//
+// The replacement is the fully-qualified name of the field or method that replaces the
+// deprecated descriptor, and remove_by is the date by which the deprecated descriptor
+// is expected to be removed.
+package bufdeprecation
+
+import (
+	"context"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+)
+
+const (
+	// CommentPrefix is the prefix of the deprecation annotation comment.
+	CommentPrefix = "buf:deprecated"
+	// RemoveByLayout is the time layout of the remove_by value.
+	RemoveByLayout = "2006-01-02"
+
+	// AnnotatedID is the FileAnnotation type for deprecations without a valid annotation.
+	AnnotatedID = "DEPRECATION_ANNOTATED"
+	// PastDueID is the FileAnnotation type for deprecations past their remove_by date.
+	PastDueID = "DEPRECATION_PAST_DUE"
+)
+
+// Deprecation is a deprecated field or method.
+type Deprecation interface {
+	// Descriptor is the deprecated field or method.
+	Descriptor() protosource.NamedDescriptor
+	// Kind is either "field" or "rpc".
+	Kind() string
+	// Replacement is the fully-qualified name of the replacement.
+	//
+	// Empty if the annotation is missing or invalid.
+	Replacement() string
+	// RemoveBy is the date by which the descriptor is expected to be removed.
+	//
+	// Zero if the annotation is missing or invalid.
+	RemoveBy() time.Time
+	// AnnotationErr is the error with the deprecation annotation, if any.
+	//
+	// Nil if the annotation is present and valid.
+	AnnotationErr() error
+
+	isDeprecation()
+}
+
+// GetDeprecations returns the Deprecations for the non-import files of the Image.
+//
+// The Image must have source code info loaded.
+// The Deprecations are sorted by file path, then by position within the file.
+func GetDeprecations(ctx context.Context, image bufimage.Image) ([]Deprecation, error) {
+	files, err := protosource.NewFilesUnstable(
+		ctx,
+		bufimageutil.NewInputFiles(bufimage.ImageWithoutImports(image).Files())...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return getDeprecations(files)
+}
+
+// IsPastDue returns true if the Deprecation has a valid annotation and its remove_by
+// date has fully passed in UTC as of now.
+//
+// The descriptor can still be present on the remove_by date itself.
+func IsPastDue(deprecation Deprecation, now time.Time) bool {
+	if deprecation.AnnotationErr() != nil {
+		return false
+	}
+	return !now.UTC().Before(deprecation.RemoveBy().AddDate(0, 0, 1))
+}
+
+// Check returns FileAnnotations for the Deprecations that are missing a valid
+// annotation, or that are past due as of now.
+func Check(deprecations []Deprecation, now time.Time) []bufanalysis.FileAnnotation {
+	return check(deprecations, now)
+}
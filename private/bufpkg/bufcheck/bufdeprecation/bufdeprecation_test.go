@@ -0,0 +1,113 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufdeprecation_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis/bufanalysistesting"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufdeprecation"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleconfig"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetDeprecations(t *testing.T) {
+	t.Parallel()
+	deprecations := testGetDeprecations(t, "report")
+	require.Len(t, deprecations, 5)
+	fullNames := make([]string, len(deprecations))
+	for i, deprecation := range deprecations {
+		fullNames[i] = deprecation.Descriptor().FullName()
+	}
+	assert.Equal(
+		t,
+		[]string{
+			"a.Foo.one",
+			"a.Foo.three",
+			"a.Foo.four",
+			"a.Foo.five",
+			"a.FooService.Old",
+		},
+		fullNames,
+	)
+	assert.Equal(t, "field", deprecations[0].Kind())
+	assert.Equal(t, "a.Foo.two", deprecations[0].Replacement())
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), deprecations[0].RemoveBy())
+	assert.NoError(t, deprecations[0].AnnotationErr())
+	assert.Error(t, deprecations[1].AnnotationErr())
+	assert.Error(t, deprecations[3].AnnotationErr())
+	assert.Equal(t, "rpc", deprecations[4].Kind())
+	assert.Equal(t, "a.FooService.New", deprecations[4].Replacement())
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	deprecations := testGetDeprecations(t, "report")
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 7, 3, 7, 37, "DEPRECATION_PAST_DUE"),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 9, 3, 9, 39, "DEPRECATION_ANNOTATED"),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 13, 3, 13, 38, "DEPRECATION_ANNOTATED"),
+		},
+		bufdeprecation.Check(deprecations, time.Date(2020, 6, 30, 23, 59, 0, 0, time.UTC)),
+	)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 7, 3, 7, 37, "DEPRECATION_PAST_DUE"),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 9, 3, 9, 39, "DEPRECATION_ANNOTATED"),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 13, 3, 13, 38, "DEPRECATION_ANNOTATED"),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 19, 3, 21, 4, "DEPRECATION_PAST_DUE"),
+		},
+		bufdeprecation.Check(deprecations, time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)),
+	)
+}
+
+func testGetDeprecations(t *testing.T, relDirPath string) []bufdeprecation.Deprecation {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	readWriteBucket, err := storageos.NewProvider().NewReadWriteBucket(filepath.Join("testdata", relDirPath))
+	require.NoError(t, err)
+	moduleConfig, err := bufmoduleconfig.NewConfigV1(bufmoduleconfig.ExternalConfigV1{})
+	require.NoError(t, err)
+	module, err := bufmodulebuild.BuildForBucket(ctx, readWriteBucket, moduleConfig)
+	require.NoError(t, err)
+	moduleFileSet, err := bufmodulebuild.NewModuleFileSetBuilder(
+		zap.NewNop(),
+		bufmodule.NewNopModuleReader(),
+	).Build(
+		ctx,
+		module,
+	)
+	require.NoError(t, err)
+	image, fileAnnotations, err := bufimagebuild.NewBuilder(zap.NewNop()).Build(ctx, moduleFileSet)
+	require.NoError(t, err)
+	require.Empty(t, fileAnnotations)
+	deprecations, err := bufdeprecation.GetDeprecations(ctx, bufimage.ImageWithoutImports(image))
+	require.NoError(t, err)
+	return deprecations
+}
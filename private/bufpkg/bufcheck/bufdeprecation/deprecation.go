@@ -0,0 +1,211 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufdeprecation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+)
+
+const (
+	replacementKey = "replacement"
+	removeByKey    = "remove_by"
+)
+
+type deprecation struct {
+	descriptor    protosource.NamedDescriptor
+	kind          string
+	replacement   string
+	removeBy      time.Time
+	annotationErr error
+}
+
+func newDeprecation(descriptor protosource.NamedDescriptor, kind string) *deprecation {
+	deprecation := &deprecation{
+		descriptor: descriptor,
+		kind:       kind,
+	}
+	deprecation.replacement, deprecation.removeBy, deprecation.annotationErr = parseAnnotation(descriptor.Location())
+	return deprecation
+}
+
+func (d *deprecation) Descriptor() protosource.NamedDescriptor {
+	return d.descriptor
+}
+
+func (d *deprecation) Kind() string {
+	return d.kind
+}
+
+func (d *deprecation) Replacement() string {
+	return d.replacement
+}
+
+func (d *deprecation) RemoveBy() time.Time {
+	return d.removeBy
+}
+
+func (d *deprecation) AnnotationErr() error {
+	return d.annotationErr
+}
+
+func (*deprecation) isDeprecation() {}
+
+func getDeprecations(files []protosource.File) ([]Deprecation, error) {
+	var deprecations []Deprecation
+	for _, file := range files {
+		if err := protosource.ForEachMessage(
+			func(message protosource.Message) error {
+				for _, field := range message.Fields() {
+					if field.Deprecated() {
+						deprecations = append(deprecations, newDeprecation(field, "field"))
+					}
+				}
+				return nil
+			},
+			file,
+		); err != nil {
+			return nil, err
+		}
+		for _, service := range file.Services() {
+			for _, method := range service.Methods() {
+				if method.Deprecated() {
+					deprecations = append(deprecations, newDeprecation(method, "rpc"))
+				}
+			}
+		}
+	}
+	sort.SliceStable(
+		deprecations,
+		func(i int, j int) bool {
+			one := deprecations[i].Descriptor()
+			two := deprecations[j].Descriptor()
+			if one.File().Path() != two.File().Path() {
+				return one.File().Path() < two.File().Path()
+			}
+			oneStartLine, twoStartLine := locationStartLine(one.Location()), locationStartLine(two.Location())
+			if oneStartLine != twoStartLine {
+				return oneStartLine < twoStartLine
+			}
+			return one.FullName() < two.FullName()
+		},
+	)
+	return deprecations, nil
+}
+
+func check(deprecations []Deprecation, now time.Time) []bufanalysis.FileAnnotation {
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, deprecation := range deprecations {
+		descriptor := deprecation.Descriptor()
+		if annotationErr := deprecation.AnnotationErr(); annotationErr != nil {
+			fileAnnotations = append(
+				fileAnnotations,
+				newFileAnnotation(
+					descriptor,
+					AnnotatedID,
+					fmt.Sprintf("Deprecated %s %q %s.", deprecation.Kind(), descriptor.FullName(), annotationErr.Error()),
+				),
+			)
+			continue
+		}
+		if IsPastDue(deprecation, now) {
+			fileAnnotations = append(
+				fileAnnotations,
+				newFileAnnotation(
+					descriptor,
+					PastDueID,
+					fmt.Sprintf(
+						"Deprecated %s %q was due to be removed by %s, use %q instead.",
+						deprecation.Kind(),
+						descriptor.FullName(),
+						deprecation.RemoveBy().Format(RemoveByLayout),
+						deprecation.Replacement(),
+					),
+				),
+			)
+		}
+	}
+	return fileAnnotations
+}
+
+// parseAnnotation parses the deprecation annotation in the leading comments of the location.
+func parseAnnotation(location protosource.Location) (string, time.Time, error) {
+	if location == nil {
+		return "", time.Time{}, errors.New("is missing a " + CommentPrefix + " annotation")
+	}
+	for _, line := range stringutil.SplitTrimLinesNoEmpty(location.LeadingComments()) {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != CommentPrefix {
+			continue
+		}
+		keyToValue := make(map[string]string)
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok || value == "" {
+				return "", time.Time{}, fmt.Errorf("has an invalid %s annotation: expected key=value but got %q", CommentPrefix, field)
+			}
+			switch key {
+			case replacementKey, removeByKey:
+			default:
+				return "", time.Time{}, fmt.Errorf("has an invalid %s annotation: unknown key %q", CommentPrefix, key)
+			}
+			if _, ok := keyToValue[key]; ok {
+				return "", time.Time{}, fmt.Errorf("has an invalid %s annotation: duplicate key %q", CommentPrefix, key)
+			}
+			keyToValue[key] = value
+		}
+		for _, key := range []string{replacementKey, removeByKey} {
+			if _, ok := keyToValue[key]; !ok {
+				return "", time.Time{}, fmt.Errorf("has an invalid %s annotation: missing key %q", CommentPrefix, key)
+			}
+		}
+		removeBy, err := time.Parse(RemoveByLayout, keyToValue[removeByKey])
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("has an invalid %s annotation: %s must be of the form YYYY-MM-DD but got %q", CommentPrefix, removeByKey, keyToValue[removeByKey])
+		}
+		return keyToValue[replacementKey], removeBy, nil
+	}
+	return "", time.Time{}, errors.New("is missing a " + CommentPrefix + " annotation")
+}
+
+func newFileAnnotation(descriptor protosource.NamedDescriptor, id string, message string) bufanalysis.FileAnnotation {
+	location := descriptor.Location()
+	if location == nil {
+		return bufanalysis.NewFileAnnotation(descriptor.File(), 0, 0, 0, 0, id, message)
+	}
+	return bufanalysis.NewFileAnnotation(
+		descriptor.File(),
+		location.StartLine(),
+		location.StartColumn(),
+		location.EndLine(),
+		location.EndColumn(),
+		id,
+		message,
+	)
+}
+
+func locationStartLine(location protosource.Location) int {
+	if location == nil {
+		return 0
+	}
+	return location.StartLine()
+}
@@ -0,0 +1,83 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufextension verifies extension declarations.
+//
+// Extension declarations are declared on the extension ranges of messages, and
+// coordinate the numbers of the extensions of the messages. An extension range
+// with declarations, or with a verification state of DECLARATION, requires that
+// every extension in the range matches a declaration that is not reserved.
+package bufextension
+
+import (
+	"context"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+)
+
+// ID is the FileAnnotation type for invalid extension declarations and for
+// extensions that do not match the declarations of their extendee.
+const ID = "EXTENSION_DECLARATION"
+
+// AddFunc adds a FileAnnotation.
+//
+// Both the Descriptor and Location can be nil.
+type AddFunc func(descriptor protosource.Descriptor, location protosource.Location, format string, args ...interface{})
+
+// VerifyImage verifies the extension declarations and the extensions of the Image.
+//
+// The Image should include imports, so that extensions are verified against the
+// declarations of extendees in dependencies, and so that extension numbers are
+// verified to be unique across the Image and its dependencies. FileAnnotations
+// are only returned for the non-import files of the Image.
+func VerifyImage(ctx context.Context, image bufimage.Image) ([]bufanalysis.FileAnnotation, error) {
+	files, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(image.Files())...)
+	if err != nil {
+		return nil, err
+	}
+	nonImportPaths := make(map[string]struct{})
+	for _, imageFile := range image.Files() {
+		if !imageFile.IsImport() {
+			nonImportPaths[imageFile.Path()] = struct{}{}
+		}
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	if err := Verify(
+		files,
+		func(descriptor protosource.Descriptor, location protosource.Location, format string, args ...interface{}) {
+			if descriptor == nil {
+				return
+			}
+			if _, ok := nonImportPaths[descriptor.File().Path()]; !ok {
+				return
+			}
+			fileAnnotations = append(fileAnnotations, newFileAnnotation(descriptor, location, format, args...))
+		},
+	); err != nil {
+		return nil, err
+	}
+	return bufanalysis.DeduplicateAndSortFileAnnotations(fileAnnotations), nil
+}
+
+// Verify verifies the extension declarations and the extensions of the files,
+// and calls add for every violation.
+//
+// Extensions are only verified against the declarations of extendees that are
+// in the files.
+func Verify(files []protosource.File, add AddFunc) error {
+	return verify(files, add)
+}
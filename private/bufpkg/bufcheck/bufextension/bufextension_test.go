@@ -0,0 +1,73 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufextension_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis/bufanalysistesting"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufextension"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal/internaltesting"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagebuild/bufimagebuildtesting"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyImage(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	image := bufimagebuildtesting.BuildImage(t, ctx, filepath.Join("testdata", "verify"))
+	// Declarations cannot be compiled from source with the bundled descriptor.proto,
+	// so they are set on the options of the extension ranges of the built Image.
+	internaltesting.SetExtensionDeclarations(
+		t,
+		image,
+		"One",
+		internaltesting.ExtensionDeclaration{Number: 100, FullName: ".a.foo", Type: "string"},
+		internaltesting.ExtensionDeclaration{Number: 101, FullName: ".a.bar", Type: "int32"},
+		internaltesting.ExtensionDeclaration{Number: 102, Reserved: true},
+		internaltesting.ExtensionDeclaration{Number: 104, FullName: ".a.other", Type: "int32", Repeated: true},
+		internaltesting.ExtensionDeclaration{Number: 105, FullName: ".a.corge", Type: ".a.One", Repeated: true},
+		internaltesting.ExtensionDeclaration{Number: 100, FullName: ".a.duplicate", Type: "int32"},
+		internaltesting.ExtensionDeclaration{Number: 150, FullName: ".a.foo", Type: "string"},
+		internaltesting.ExtensionDeclaration{Number: 151, FullName: ".a.typeless"},
+		internaltesting.ExtensionDeclaration{Number: 152},
+		internaltesting.ExtensionDeclaration{Number: 153, FullName: "a.relative", Type: "int32"},
+		internaltesting.ExtensionDeclaration{Number: 250, FullName: ".a.outside", Type: "int32"},
+	)
+	fileAnnotations, err := bufextension.VerifyImage(ctx, image)
+	require.NoError(t, err)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 14, 6, 24, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 14, 6, 24, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 14, 6, 24, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 14, 6, 24, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 14, 6, 24, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 6, 14, 6, 24, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 15, 12, 15, 17, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 16, 24, 16, 27, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 17, 24, 17, 27, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 18, 25, 18, 28, bufextension.ID),
+			bufanalysistesting.NewFileAnnotation(t, "a.proto", 19, 3, 19, 28, bufextension.ID),
+		},
+		fileAnnotations,
+	)
+}
@@ -0,0 +1,277 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufextension
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func verify(files []protosource.File, add AddFunc) error {
+	fullNameToMessage, err := protosource.FullNameToMessage(files...)
+	if err != nil {
+		return err
+	}
+	// Extension names are global, so a name may only be declared once.
+	fullNameToDeclaration := make(map[string]protosource.ExtensionDeclaration)
+	for _, file := range files {
+		if err := protosource.ForEachMessage(
+			func(message protosource.Message) error {
+				verifyDeclarations(add, message, fullNameToDeclaration)
+				return nil
+			},
+			file,
+		); err != nil {
+			return err
+		}
+	}
+	extendeeToNumberToExtension := make(map[string]map[int]protosource.Field)
+	for _, file := range files {
+		extensions := file.Extensions()
+		if err := protosource.ForEachMessage(
+			func(message protosource.Message) error {
+				extensions = append(extensions, message.Extensions()...)
+				return nil
+			},
+			file,
+		); err != nil {
+			return err
+		}
+		for _, extension := range extensions {
+			numberToExtension, ok := extendeeToNumberToExtension[extension.Extendee()]
+			if !ok {
+				numberToExtension = make(map[int]protosource.Field)
+				extendeeToNumberToExtension[extension.Extendee()] = numberToExtension
+			}
+			if previousExtension, ok := numberToExtension[extension.Number()]; ok {
+				add(
+					extension,
+					extension.NumberLocation(),
+					"Extension %q uses number %d of message %q, which is already used by extension %q.",
+					extension.FullName(),
+					extension.Number(),
+					extension.Extendee(),
+					previousExtension.FullName(),
+				)
+			} else {
+				numberToExtension[extension.Number()] = extension
+			}
+			if extendee, ok := fullNameToMessage[extension.Extendee()]; ok {
+				verifyExtension(add, extension, extendee)
+			}
+		}
+	}
+	return nil
+}
+
+func verifyDeclarations(
+	add AddFunc,
+	message protosource.Message,
+	fullNameToDeclaration map[string]protosource.ExtensionDeclaration,
+) {
+	numberToDeclaration := make(map[int]protosource.ExtensionDeclaration)
+	for _, extensionRange := range message.ExtensionRanges() {
+		for _, declaration := range extensionRange.Declarations() {
+			location := declaration.Location()
+			if location == nil {
+				location = extensionRange.Location()
+			}
+			if declaration.Number() < extensionRange.Start() || declaration.Number() > extensionRange.End() {
+				add(
+					declaration,
+					location,
+					"Extension declaration number %d is outside of the extension range %s of message %q.",
+					declaration.Number(),
+					protosource.TagRangeString(extensionRange),
+					message.FullName(),
+				)
+			}
+			if _, ok := numberToDeclaration[declaration.Number()]; ok {
+				add(
+					declaration,
+					location,
+					"Extension number %d is declared more than once for message %q.",
+					declaration.Number(),
+					message.FullName(),
+				)
+			} else {
+				numberToDeclaration[declaration.Number()] = declaration
+			}
+			if declaration.FullName() == "" && declaration.Type() == "" {
+				if !declaration.Reserved() {
+					add(
+						declaration,
+						location,
+						"Extension declaration %d of message %q must have a full_name and a type unless it is reserved.",
+						declaration.Number(),
+						message.FullName(),
+					)
+				}
+				continue
+			}
+			if declaration.FullName() == "" || declaration.Type() == "" {
+				add(
+					declaration,
+					location,
+					"Extension declaration %d of message %q must have both a full_name and a type.",
+					declaration.Number(),
+					message.FullName(),
+				)
+			}
+			if declaration.FullName() == "" {
+				continue
+			}
+			if !strings.HasPrefix(declaration.FullName(), ".") {
+				add(
+					declaration,
+					location,
+					"Extension declaration %d of message %q has full_name %q, which must be fully-qualified with a leading dot.",
+					declaration.Number(),
+					message.FullName(),
+					declaration.FullName(),
+				)
+			}
+			if previousDeclaration, ok := fullNameToDeclaration[declaration.FullName()]; ok {
+				add(
+					declaration,
+					location,
+					"Extension %q is declared more than once, it is also declared with number %d for message %q.",
+					strings.TrimPrefix(declaration.FullName(), "."),
+					previousDeclaration.Number(),
+					previousDeclaration.ExtensionRange().Message().FullName(),
+				)
+			} else {
+				fullNameToDeclaration[declaration.FullName()] = declaration
+			}
+		}
+	}
+}
+
+func verifyExtension(add AddFunc, extension protosource.Field, extendee protosource.Message) {
+	for _, extensionRange := range extendee.ExtensionRanges() {
+		if extension.Number() < extensionRange.Start() || extension.Number() > extensionRange.End() {
+			continue
+		}
+		if !extensionRange.Declared() {
+			return
+		}
+		var declaration protosource.ExtensionDeclaration
+		for _, candidate := range extensionRange.Declarations() {
+			if candidate.Number() == extension.Number() {
+				declaration = candidate
+				break
+			}
+		}
+		switch {
+		case declaration == nil:
+			add(
+				extension,
+				extension.NumberLocation(),
+				"Extension %q uses number %d of message %q, which is not declared.",
+				extension.FullName(),
+				extension.Number(),
+				extendee.FullName(),
+			)
+		case declaration.Reserved():
+			add(
+				extension,
+				extension.NumberLocation(),
+				"Extension %q uses number %d of message %q, which is reserved.",
+				extension.FullName(),
+				extension.Number(),
+				extendee.FullName(),
+			)
+		case declaration.FullName() != "."+extension.FullName():
+			add(
+				extension,
+				extension.NumberLocation(),
+				"Extension %q uses number %d of message %q, which is declared for extension %q.",
+				extension.FullName(),
+				extension.Number(),
+				extendee.FullName(),
+				strings.TrimPrefix(declaration.FullName(), "."),
+			)
+		default:
+			if extensionType := GetExtensionType(extension); declaration.Type() != extensionType {
+				add(
+					extension,
+					extension.TypeLocation(),
+					"Extension %q has type %q, but is declared with type %q.",
+					extension.FullName(),
+					extensionType,
+					declaration.Type(),
+				)
+			}
+			if repeated := extension.Label() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED; declaration.Repeated() != repeated {
+				add(
+					extension,
+					extension.Location(),
+					"Extension %q is %s, but is declared as %s.",
+					extension.FullName(),
+					repeatedString(repeated),
+					repeatedString(declaration.Repeated()),
+				)
+			}
+		}
+		return
+	}
+}
+
+// GetExtensionType returns the type of the extension as it is written in
+// extension declarations.
+//
+// This is the name of the type for scalar types, such as "int32", and the
+// fully-qualified name of the type with a leading dot for messages and enums.
+func GetExtensionType(extension protosource.Field) string {
+	switch extension.Type() {
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptorpb.FieldDescriptorProto_TYPE_ENUM,
+		descriptorpb.FieldDescriptorProto_TYPE_GROUP:
+		return "." + extension.TypeName()
+	default:
+		return strings.ToLower(strings.TrimPrefix(extension.Type().String(), "TYPE_"))
+	}
+}
+
+func repeatedString(repeated bool) string {
+	if repeated {
+		return "repeated"
+	}
+	return "not repeated"
+}
+
+func newFileAnnotation(
+	descriptor protosource.Descriptor,
+	location protosource.Location,
+	format string,
+	args ...interface{},
+) bufanalysis.FileAnnotation {
+	if location == nil {
+		return bufanalysis.NewFileAnnotation(descriptor.File(), 0, 0, 0, 0, ID, fmt.Sprintf(format, args...))
+	}
+	return bufanalysis.NewFileAnnotation(
+		descriptor.File(),
+		location.StartLine(),
+		location.StartColumn(),
+		location.EndLine(),
+		location.EndColumn(),
+		ID,
+		fmt.Sprintf(format, args...),
+	)
+}
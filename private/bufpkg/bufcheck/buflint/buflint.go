@@ -28,9 +28,18 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"go.uber.org/zap"
 )
 
+const (
+	// DefaultEnumZeroValueSuffix is the suffix used by ENUM_ZERO_VALUE_SUFFIX if none is configured.
+	DefaultEnumZeroValueSuffix = internal.DefaultEnumZeroValueSuffix
+	// DefaultServiceSuffix is the suffix used by SERVICE_SUFFIX if none is configured.
+	DefaultServiceSuffix = internal.DefaultServiceSuffix
+)
+
 // AllFormatStrings are all format strings.
 var AllFormatStrings = append(
 	bufanalysis.AllFormatStrings,
@@ -41,13 +50,28 @@ var AllFormatStrings = append(
 type Handler interface {
 	// Check runs the lint checks.
 	//
-	// The image should have source code info for this to work properly.
+	// The image should have source code info for this to work properly. Lazy source
+	// code info, see bufimage.ImageFileWithLazySourceCodeInfo, is only loaded for the
+	// files with FileAnnotations, unless the config has rules that require it.
 	//
 	// Images should be filtered with regards to imports before passing to this function.
 	Check(
 		ctx context.Context,
 		config *buflintconfig.Config,
 		image bufimage.Image,
+		options ...CheckOption,
+	) ([]bufanalysis.FileAnnotation, error)
+	// Ignores returns the lint ignores that apply to the image.
+	//
+	// A FileAnnotation is returned for every comment ignore within the image, and for
+	// every path ignored by the config. The Type of each FileAnnotation is the ignored
+	// rule or category ID, or "ALL" for paths that are ignored for all rules.
+	//
+	// The image should have source code info for comment ignores to be found.
+	Ignores(
+		ctx context.Context,
+		config *buflintconfig.Config,
+		image bufimage.Image,
 	) ([]bufanalysis.FileAnnotation, error)
 }
 
@@ -56,6 +80,26 @@ func NewHandler(logger *zap.Logger) Handler {
 	return newHandler(logger)
 }
 
+// CheckOption is an option for Check.
+type CheckOption func(*checkOptions)
+
+// CheckWithDependencies returns a new CheckOption that sets the dependencies declared
+// by the module being linted, along with the Image including imports that the linted
+// Image was derived from.
+//
+// DEPENDENCY_USED only reports unused dependencies if this option is set. The linted
+// Image should contain every file of the module, otherwise dependencies that are
+// only imported by files that were filtered out will be reported as unused.
+func CheckWithDependencies(
+	dependencyModuleReferences []bufmoduleref.ModuleReference,
+	imageWithImports bufimage.Image,
+) CheckOption {
+	return func(checkOptions *checkOptions) {
+		checkOptions.dependencyModuleReferences = dependencyModuleReferences
+		checkOptions.imageWithImports = imageWithImports
+	}
+}
+
 // RulesForConfig returns the rules for a given config.
 //
 // Should only be used for printing.
@@ -114,6 +158,13 @@ func GetAllRulesAndCategoriesV1() []string {
 }
 
 func internalConfigForConfig(config *buflintconfig.Config) (*internal.Config, error) {
+	return internalConfigForConfigAndCheckOptions(config, newCheckOptions())
+}
+
+func internalConfigForConfigAndCheckOptions(
+	config *buflintconfig.Config,
+	checkOptions *checkOptions,
+) (*internal.Config, error) {
 	var versionSpec *internal.VersionSpec
 	switch config.Version {
 	case bufconfig.V1Beta1Version:
@@ -132,6 +183,8 @@ func internalConfigForConfig(config *buflintconfig.Config) (*internal.Config, er
 		RPCAllowGoogleProtobufEmptyRequests:  config.RPCAllowGoogleProtobufEmptyRequests,
 		RPCAllowGoogleProtobufEmptyResponses: config.RPCAllowGoogleProtobufEmptyResponses,
 		ServiceSuffix:                        config.ServiceSuffix,
+		DependencyModuleIdentityStrings:      checkOptions.dependencyModuleIdentityStrings(),
+		ImportPathToModuleIdentityString:     checkOptions.importPathToModuleIdentityString(),
 	}.NewConfig(
 		versionSpec,
 	)
@@ -147,3 +200,37 @@ func rulesForInternalRules(rules []*internal.Rule) []bufcheck.Rule {
 	}
 	return s
 }
+
+type checkOptions struct {
+	dependencyModuleReferences []bufmoduleref.ModuleReference
+	imageWithImports           bufimage.Image
+}
+
+func newCheckOptions() *checkOptions {
+	return &checkOptions{}
+}
+
+func (c *checkOptions) dependencyModuleIdentityStrings() []string {
+	dependencyModuleIdentityStrings := make([]string, len(c.dependencyModuleReferences))
+	for i, dependencyModuleReference := range c.dependencyModuleReferences {
+		dependencyModuleIdentityStrings[i] = dependencyModuleReference.IdentityString()
+	}
+	return stringutil.SliceToUniqueSortedSlice(dependencyModuleIdentityStrings)
+}
+
+func (c *checkOptions) importPathToModuleIdentityString() map[string]string {
+	if c.imageWithImports == nil {
+		return nil
+	}
+	importPathToModuleIdentityString := make(map[string]string)
+	for _, imageFile := range c.imageWithImports.Files() {
+		if !imageFile.IsImport() {
+			continue
+		}
+		// the ModuleIdentity is nil if the file did not come from a named module
+		if moduleIdentity := imageFile.ModuleIdentity(); moduleIdentity != nil {
+			importPathToModuleIdentityString[imageFile.Path()] = moduleIdentity.IdentityString()
+		}
+	}
+	return importPathToModuleIdentityString
+}
@@ -17,22 +17,28 @@ package buflint_test
 import (
 	"context"
 	"path/filepath"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis/bufanalysistesting"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagebuild"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/storage"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // Hint on how to get these:
@@ -147,6 +153,39 @@ func TestRunComments(t *testing.T) {
 	)
 }
 
+func TestRunCommentsWithoutSourceCodeInfo(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	image, config := testGetImageAndConfig(
+		ctx,
+		t,
+		"comments",
+		func(config *bufconfig.Config) {
+			config.Lint.Use = append(config.Lint.Use, "PACKAGE_DIRECTORY_MATCH")
+		},
+	)
+	for _, imageFile := range image.Files() {
+		imageFile.Proto().SourceCodeInfo = nil
+	}
+
+	fileAnnotations, err := buflint.NewHandler(zap.NewNop()).Check(
+		ctx,
+		config.Lint,
+		image,
+	)
+	assert.NoError(t, err)
+	// the comment rules are skipped, the other rules still run without locations
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			bufanalysistesting.NewFileAnnotationNoLocation(t, "a.proto", "PACKAGE_DIRECTORY_MATCH"),
+		},
+		fileAnnotations,
+	)
+}
+
 func TestRunDirectorySamePackage(t *testing.T) {
 	testLint(
 		t,
@@ -168,6 +207,17 @@ func TestRunImportNoPublic(t *testing.T) {
 	)
 }
 
+func TestRunImportNoPublicForward(t *testing.T) {
+	testLint(
+		t,
+		"import_no_public_forward",
+		bufanalysistesting.NewFileAnnotation(t, "c/c.proto", 8, 3, 8, 6, "IMPORT_NO_PUBLIC_FORWARD"),
+		bufanalysistesting.NewFileAnnotation(t, "c/c.proto", 10, 3, 10, 19, "IMPORT_NO_PUBLIC_FORWARD"),
+		bufanalysistesting.NewFileAnnotation(t, "c/c.proto", 14, 11, 14, 14, "IMPORT_NO_PUBLIC_FORWARD"),
+		bufanalysistesting.NewFileAnnotation(t, "d/d.proto", 10, 3, 10, 6, "IMPORT_NO_PUBLIC_FORWARD"),
+	)
+}
+
 func TestRunImportNoWeak(t *testing.T) {
 	testLint(
 		t,
@@ -188,6 +238,56 @@ func TestRunImportUsed(t *testing.T) {
 	)
 }
 
+func TestRunDependencyUsed(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	image, config := testGetImageAndConfig(ctx, t, "dependency_used", nil)
+	usedModuleIdentity, err := bufmoduleref.NewModuleIdentity("buf.build", "acme", "used")
+	require.NoError(t, err)
+	// treat dep/dep.proto as if it came from the buf.build/acme/used dependency
+	imageFiles := make([]bufimage.ImageFile, 0, len(image.Files()))
+	for _, imageFile := range image.Files() {
+		if imageFile.Path() == "dep/dep.proto" {
+			imageFile, err = bufimage.NewImageFile(
+				imageFile.FileDescriptor(),
+				usedModuleIdentity,
+				"",
+				imageFile.ExternalPath(),
+				true,
+				imageFile.IsSyntaxUnspecified(),
+				imageFile.UnusedDependencyIndexes(),
+			)
+			require.NoError(t, err)
+		}
+		imageFiles = append(imageFiles, imageFile)
+	}
+	imageWithImports, err := bufimage.NewImage(imageFiles)
+	require.NoError(t, err)
+
+	handler := buflint.NewHandler(zap.NewNop())
+	fileAnnotations, err := handler.Check(
+		ctx,
+		config.Lint,
+		bufimage.ImageWithoutImports(imageWithImports),
+		buflint.CheckWithDependencies(config.Build.DependencyModuleReferences, imageWithImports),
+	)
+	require.NoError(t, err)
+	require.Len(t, fileAnnotations, 1)
+	assert.Nil(t, fileAnnotations[0].FileInfo())
+	assert.Equal(t, "DEPENDENCY_USED", fileAnnotations[0].Type())
+	assert.Equal(t, `Dependency "buf.build/acme/unused" is not imported by any file in the module.`, fileAnnotations[0].Message())
+
+	// without the dependencies, nothing can be reported
+	fileAnnotations, err = handler.Check(
+		ctx,
+		config.Lint,
+		bufimage.ImageWithoutImports(imageWithImports),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, fileAnnotations)
+}
+
 func TestRunEnumFirstValueZero(t *testing.T) {
 	testLint(
 		t,
@@ -369,6 +469,28 @@ func TestRunFileLowerSnakeCase(t *testing.T) {
 	)
 }
 
+func TestRunHTTP(t *testing.T) {
+	testLint(
+		t,
+		"http",
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 30, 3, 32, 4, "HTTP_PATH_SYNTAX"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 33, 3, 35, 4, "HTTP_PATH_SYNTAX"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 36, 3, 44, 4, "HTTP_PATH_SYNTAX"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 45, 3, 47, 4, "HTTP_PATH_VARIABLES"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 45, 3, 47, 4, "HTTP_PATH_VARIABLES"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 45, 3, 47, 4, "HTTP_PATH_VARIABLES"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 45, 3, 47, 4, "HTTP_PATH_VARIABLES"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 45, 3, 47, 4, "HTTP_PATH_VARIABLES"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 48, 3, 54, 4, "HTTP_BODY"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 48, 3, 54, 4, "HTTP_BODY"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 48, 3, 54, 4, "HTTP_BODY"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 55, 3, 61, 4, "HTTP_BODY"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 62, 3, 67, 4, "HTTP_ROUTE_UNIQUE"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 76, 3, 78, 4, "HTTP_ROUTE_UNIQUE"),
+		bufanalysistesting.NewFileAnnotation(t, "a/a.proto", 79, 3, 81, 4, "HTTP_ROUTE_UNIQUE"),
+	)
+}
+
 func TestRunMessagePascalCase(t *testing.T) {
 	testLint(
 		t,
@@ -785,6 +907,83 @@ func TestRunIgnores4(t *testing.T) {
 	)
 }
 
+func TestRunIgnores5(t *testing.T) {
+	testLint(
+		t,
+		"ignores5",
+		bufanalysistesting.NewFileAnnotation(t, "buf/bar/bar.proto", 6, 9, 6, 15, "FIELD_LOWER_SNAKE_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/bar/bar2.proto", 6, 9, 6, 15, "FIELD_LOWER_SNAKE_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/bar/bar2.proto", 9, 9, 9, 13, "MESSAGE_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/bar/bar2.proto", 13, 6, 13, 10, "ENUM_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/buf.proto", 6, 9, 6, 15, "FIELD_LOWER_SNAKE_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/buf.proto", 9, 9, 9, 12, "MESSAGE_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/buf.proto", 13, 6, 13, 9, "ENUM_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/baz/baz.proto", 6, 9, 6, 15, "FIELD_LOWER_SNAKE_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/baz/baz.proto", 9, 9, 9, 12, "MESSAGE_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/baz/baz.proto", 13, 6, 13, 9, "ENUM_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/buf.proto", 6, 9, 6, 15, "FIELD_LOWER_SNAKE_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/buf.proto", 9, 9, 9, 12, "MESSAGE_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/buf.proto", 13, 6, 13, 9, "ENUM_PASCAL_CASE"),
+	)
+}
+
+func TestRunOverrides(t *testing.T) {
+	testLint(
+		t,
+		"overrides",
+		bufanalysistesting.NewFileAnnotation(t, "buf/buf.proto", 6, 9, 6, 15, "FIELD_LOWER_SNAKE_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/bar/bar.proto", 13, 6, 13, 9, "ENUM_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/foo.proto", 9, 9, 9, 12, "MESSAGE_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/foo.proto", 13, 6, 13, 9, "ENUM_PASCAL_CASE"),
+	)
+}
+
+func TestRunOverridesMerge(t *testing.T) {
+	testLint(
+		t,
+		"overrides_merge",
+		bufanalysistesting.NewFileAnnotation(t, "buf/buf.proto", 6, 9, 6, 15, "FIELD_LOWER_SNAKE_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/buf.proto", 9, 9, 9, 12, "MESSAGE_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/bar/bar.proto", 13, 6, 13, 9, "ENUM_PASCAL_CASE"),
+		bufanalysistesting.NewFileAnnotation(t, "buf/foo/foo.proto", 9, 9, 9, 12, "MESSAGE_PASCAL_CASE"),
+	)
+}
+
+func TestOverridesConfigRoundTrip(t *testing.T) {
+	t.Parallel()
+	allowCommentIgnores := true
+	externalConfig := buflintconfig.ExternalConfigV1{
+		Use: []string{"DEFAULT"},
+		IgnoreOnly: map[string][]string{
+			"FIELD_LOWER_SNAKE_CASE": {"a/b.proto"},
+		},
+		Overrides: map[string]buflintconfig.ExternalOverrideConfigV1{
+			"a": {
+				Use:    []string{"MINIMAL"},
+				Ignore: []string{"a/c"},
+				IgnoreOnly: map[string][]string{
+					"FIELD_LOWER_SNAKE_CASE": {"a/d.proto"},
+				},
+				AllowCommentIgnores: &allowCommentIgnores,
+			},
+		},
+	}
+	config := buflintconfig.NewConfigV1(externalConfig)
+	require.Len(t, config.Overrides, 1)
+	overrideConfig := config.Overrides["a"]
+	require.NotNil(t, overrideConfig)
+	assert.Equal(t, []string{"MINIMAL"}, overrideConfig.Use)
+	assert.Equal(t, []string{"a/c"}, overrideConfig.IgnoreRootPaths)
+	assert.Equal(
+		t,
+		map[string][]string{"FIELD_LOWER_SNAKE_CASE": {"a/b.proto", "a/d.proto"}},
+		overrideConfig.IgnoreIDOrCategoryToRootPaths,
+	)
+	assert.True(t, overrideConfig.AllowCommentIgnores)
+	assert.Equal(t, externalConfig.Overrides, buflintconfig.ExternalConfigV1ForConfig(config).Overrides)
+	assert.Equal(t, config.Overrides, buflintconfig.ConfigForProto(buflintconfig.ProtoForConfig(config)).Overrides)
+}
+
 func TestCommentIgnoresOff(t *testing.T) {
 	testLint(
 		t,
@@ -837,6 +1036,93 @@ func TestCommentIgnoresOn(t *testing.T) {
 	)
 }
 
+func TestRunLazySourceCodeInfo(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	image, config := testGetImageAndConfig(
+		ctx,
+		t,
+		"comment_ignores",
+		func(config *bufconfig.Config) {
+			config.Lint.Use = []string{"ENUM_FIRST_VALUE_ZERO"}
+		},
+	)
+	lazyImage, loadedPaths := testNewLazyImage(t, image)
+	fileAnnotations, err := buflint.NewHandler(zap.NewNop()).Check(
+		ctx,
+		config.Lint,
+		lazyImage,
+	)
+	assert.NoError(t, err)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			bufanalysistesting.NewFileAnnotation(t, "b.proto", 9, 26, 9, 28, "ENUM_FIRST_VALUE_ZERO"),
+		},
+		fileAnnotations,
+	)
+	// only the file with FileAnnotations is parsed for source code info
+	assert.Equal(t, []string{"b.proto"}, loadedPaths())
+}
+
+func TestRunLazySourceCodeInfoCommentIgnores(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	image, config := testGetImageAndConfig(
+		ctx,
+		t,
+		"comment_ignores",
+		func(config *bufconfig.Config) {
+			config.Lint.AllowCommentIgnores = true
+		},
+	)
+	lazyImage, loadedPaths := testNewLazyImage(t, image)
+	fileAnnotations, err := buflint.NewHandler(zap.NewNop()).Check(
+		ctx,
+		config.Lint,
+		lazyImage,
+	)
+	assert.NoError(t, err)
+	assert.Empty(t, fileAnnotations)
+	assert.Equal(t, []string{"a.proto", "b.proto"}, loadedPaths())
+}
+
+func TestRunLazySourceCodeInfoComments(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	image, config := testGetImageAndConfig(
+		ctx,
+		t,
+		"comment_ignores",
+		func(config *bufconfig.Config) {
+			config.Lint.Use = []string{"COMMENT_SERVICE"}
+		},
+	)
+	expectedFileAnnotations, err := buflint.NewHandler(zap.NewNop()).Check(
+		ctx,
+		config.Lint,
+		image,
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, expectedFileAnnotations)
+	lazyImage, loadedPaths := testNewLazyImage(t, image)
+	fileAnnotations, err := buflint.NewHandler(zap.NewNop()).Check(
+		ctx,
+		config.Lint,
+		lazyImage,
+	)
+	assert.NoError(t, err)
+	bufanalysistesting.AssertFileAnnotationsEqual(t, expectedFileAnnotations, fileAnnotations)
+	// the comment rules need the source code info of all files
+	assert.Len(t, loadedPaths(), len(lazyImage.Files()))
+}
+
 func TestCommentIgnoresCascadeOff(t *testing.T) {
 	testLint(
 		t,
@@ -887,6 +1173,57 @@ func TestCommentIgnoresCascadeOn(t *testing.T) {
 	)
 }
 
+func TestIgnores(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	image, config := testGetImageAndConfig(
+		ctx,
+		t,
+		"comment_ignores",
+		func(config *bufconfig.Config) {
+			config.Lint.AllowCommentIgnores = true
+			config.Lint.IgnoreRootPaths = []string{"b.proto"}
+			config.Lint.IgnoreIDOrCategoryToRootPaths = map[string][]string{
+				"FIELD_LOWER_SNAKE_CASE": {"a.proto"},
+			}
+		},
+	)
+	fileAnnotations, err := buflint.NewHandler(zap.NewNop()).Ignores(ctx, config.Lint, image)
+	require.NoError(t, err)
+	var aCommentIgnoreCount int
+	var sawIgnore bool
+	var sawIgnoreOnly bool
+	for _, fileAnnotation := range fileAnnotations {
+		switch {
+		case fileAnnotation.FileInfo().Path() == "b.proto" && fileAnnotation.Type() == "ALL":
+			sawIgnore = true
+		case fileAnnotation.FileInfo().Path() == "a.proto" && fileAnnotation.StartLine() == 0:
+			assert.Equal(t, "FIELD_LOWER_SNAKE_CASE", fileAnnotation.Type())
+			sawIgnoreOnly = true
+		case fileAnnotation.FileInfo().Path() == "a.proto":
+			aCommentIgnoreCount++
+		}
+	}
+	assert.True(t, sawIgnore)
+	assert.True(t, sawIgnoreOnly)
+	// a.proto has a comment ignore for every failure it would otherwise produce.
+	assert.NotZero(t, aCommentIgnoreCount)
+	assert.Contains(
+		t,
+		fileAnnotations,
+		bufanalysis.NewFileAnnotation(
+			image.GetFile("a.proto"),
+			9,
+			1,
+			9,
+			11,
+			"PACKAGE_DIRECTORY_MATCH",
+			"Comment ignore for PACKAGE_DIRECTORY_MATCH.",
+		),
+	)
+}
+
 func testLint(
 	t *testing.T,
 	relDirPath string,
@@ -911,6 +1248,28 @@ func testLintConfigModifier(
 	defer cancel()
 	logger := zap.NewNop()
 
+	image, config := testGetImageAndConfig(ctx, t, relDirPath, configModifier)
+
+	handler := buflint.NewHandler(logger)
+	fileAnnotations, err := handler.Check(
+		ctx,
+		config.Lint,
+		image,
+	)
+	assert.NoError(t, err)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		expectedFileAnnotations,
+		fileAnnotations,
+	)
+}
+
+func testGetImageAndConfig(
+	ctx context.Context,
+	t *testing.T,
+	relDirPath string,
+	configModifier func(*bufconfig.Config),
+) (bufimage.Image, *bufconfig.Config) {
 	dirPath := filepath.Join("testdata", relDirPath)
 
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
@@ -945,20 +1304,49 @@ func testLintConfigModifier(
 	)
 	require.NoError(t, err)
 	require.Empty(t, fileAnnotations)
-	image = bufimage.ImageWithoutImports(image)
+	return bufimage.ImageWithoutImports(image), config
+}
 
-	handler := buflint.NewHandler(logger)
-	fileAnnotations, err = handler.Check(
-		ctx,
-		config.Lint,
-		image,
-	)
-	assert.NoError(t, err)
-	bufanalysistesting.AssertFileAnnotationsEqual(
-		t,
-		expectedFileAnnotations,
-		fileAnnotations,
-	)
+// testNewLazyImage returns a copy of the image with lazy source code info, and a
+// function that returns the sorted paths of the files with loaded source code info.
+func testNewLazyImage(t *testing.T, image bufimage.Image) (bufimage.Image, func() []string) {
+	var lock sync.Mutex
+	var loadedPaths []string
+	imageFiles := make([]bufimage.ImageFile, 0, len(image.Files()))
+	for _, imageFile := range image.Files() {
+		fileDescriptorProto := proto.Clone(imageFile.Proto()).(*descriptorpb.FileDescriptorProto)
+		sourceCodeInfo := fileDescriptorProto.SourceCodeInfo
+		fileDescriptorProto.SourceCodeInfo = nil
+		path := imageFile.Path()
+		lazyImageFile, err := bufimage.NewImageFile(
+			fileDescriptorProto,
+			imageFile.ModuleIdentity(),
+			imageFile.Commit(),
+			imageFile.ExternalPath(),
+			imageFile.IsImport(),
+			imageFile.IsSyntaxUnspecified(),
+			imageFile.UnusedDependencyIndexes(),
+			bufimage.ImageFileWithLazySourceCodeInfo(
+				func() (*descriptorpb.SourceCodeInfo, error) {
+					lock.Lock()
+					defer lock.Unlock()
+					loadedPaths = append(loadedPaths, path)
+					return sourceCodeInfo, nil
+				},
+			),
+		)
+		require.NoError(t, err)
+		imageFiles = append(imageFiles, lazyImageFile)
+	}
+	lazyImage, err := bufimage.NewImage(imageFiles)
+	require.NoError(t, err)
+	return lazyImage, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		sortedLoadedPaths := append([]string(nil), loadedPaths...)
+		sort.Strings(sortedLoadedPaths)
+		return sortedLoadedPaths
+	}
 }
 
 func testGetConfig(
@@ -22,7 +22,10 @@ import (
 	"strings"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 	lintv1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/lint/v1"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
 )
 
 const (
@@ -61,6 +64,13 @@ type Config struct {
 	AllowCommentIgnores bool
 	// Version represents the version of the lint rule and category IDs that should be used with this config.
 	Version string
+	// Overrides is a map of directory and/or file paths to the configs that are used in place of this
+	// config for the files within these paths. All paths are relative to the root of the module.
+	//
+	// Each config is this config merged with the override, see ExternalOverrideConfigV1. If multiple
+	// paths contain a file, the config for the most specific path is used. The configs within
+	// Overrides never have Overrides of their own.
+	Overrides map[string]*Config
 }
 
 // NewConfigV1Beta1 returns a new Config.
@@ -82,11 +92,16 @@ func NewConfigV1Beta1(externalConfig ExternalConfigV1Beta1) *Config {
 
 // NewConfigV1 returns a new Config.
 func NewConfigV1(externalConfig ExternalConfigV1) *Config {
-	return &Config{
+	// ignore_only_by_path is ignore_only keyed by path instead of by rule and/or category ID.
+	ignoreIDOrCategoryToRootPaths := internal.MergeIgnoreIDOrCategoryToRootPaths(
+		externalConfig.IgnoreOnly,
+		internal.IgnoreIDOrCategoryToRootPathsForRootPathToIDOrCategories(externalConfig.IgnoreOnlyByPath),
+	)
+	config := &Config{
 		Use:                                  externalConfig.Use,
 		Except:                               externalConfig.Except,
 		IgnoreRootPaths:                      externalConfig.Ignore,
-		IgnoreIDOrCategoryToRootPaths:        externalConfig.IgnoreOnly,
+		IgnoreIDOrCategoryToRootPaths:        ignoreIDOrCategoryToRootPaths,
 		EnumZeroValueSuffix:                  externalConfig.EnumZeroValueSuffix,
 		RPCAllowSameRequestResponse:          externalConfig.RPCAllowSameRequestResponse,
 		RPCAllowGoogleProtobufEmptyRequests:  externalConfig.RPCAllowGoogleProtobufEmptyRequests,
@@ -95,6 +110,8 @@ func NewConfigV1(externalConfig ExternalConfigV1) *Config {
 		AllowCommentIgnores:                  externalConfig.AllowCommentIgnores,
 		Version:                              v1Version,
 	}
+	config.Overrides = overrideConfigsForExternalOverrideConfigs(config, externalConfig.Overrides)
+	return config
 }
 
 // ConfigForProto returns the Config given the proto.
@@ -111,6 +128,7 @@ func ConfigForProto(protoConfig *lintv1.Config) *Config {
 		ServiceSuffix:                        protoConfig.GetServiceSuffix(),
 		AllowCommentIgnores:                  protoConfig.GetAllowCommentIgnores(),
 		Version:                              protoConfig.GetVersion(),
+		Overrides:                            overrideConfigsForProto(protoConfig.GetOverrides()),
 	}
 }
 
@@ -128,6 +146,7 @@ func ProtoForConfig(config *Config) *lintv1.Config {
 		ServiceSuffix:                        config.ServiceSuffix,
 		AllowCommentIgnores:                  config.AllowCommentIgnores,
 		Version:                              config.Version,
+		Overrides:                            protoForOverrideConfigs(config.Overrides),
 	}
 }
 
@@ -149,6 +168,27 @@ type ExternalConfigV1Beta1 struct {
 
 // ExternalConfigV1 is an external config.
 type ExternalConfigV1 struct {
+	Use    []string `json:"use,omitempty" yaml:"use,omitempty"`
+	Except []string `json:"except,omitempty" yaml:"except,omitempty"`
+	// IgnoreRootPaths
+	Ignore []string `json:"ignore,omitempty" yaml:"ignore,omitempty"`
+	// IgnoreIDOrCategoryToRootPaths
+	IgnoreOnly                           map[string][]string                 `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty"`
+	IgnoreOnlyByPath                     map[string][]string                 `json:"ignore_only_by_path,omitempty" yaml:"ignore_only_by_path,omitempty"`
+	EnumZeroValueSuffix                  string                              `json:"enum_zero_value_suffix,omitempty" yaml:"enum_zero_value_suffix,omitempty"`
+	RPCAllowSameRequestResponse          bool                                `json:"rpc_allow_same_request_response,omitempty" yaml:"rpc_allow_same_request_response,omitempty"`
+	RPCAllowGoogleProtobufEmptyRequests  bool                                `json:"rpc_allow_google_protobuf_empty_requests,omitempty" yaml:"rpc_allow_google_protobuf_empty_requests,omitempty"`
+	RPCAllowGoogleProtobufEmptyResponses bool                                `json:"rpc_allow_google_protobuf_empty_responses,omitempty" yaml:"rpc_allow_google_protobuf_empty_responses,omitempty"`
+	ServiceSuffix                        string                              `json:"service_suffix,omitempty" yaml:"service_suffix,omitempty"`
+	AllowCommentIgnores                  bool                                `json:"allow_comment_ignores,omitempty" yaml:"allow_comment_ignores,omitempty"`
+	Overrides                            map[string]ExternalOverrideConfigV1 `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+}
+
+// ExternalOverrideConfigV1 is an external config for the files within a path.
+//
+// It is merged with the config that contains it: the fields that are set replace the
+// fields of this config, except for ignore and ignore_only, which are added to them.
+type ExternalOverrideConfigV1 struct {
 	Use    []string `json:"use,omitempty" yaml:"use,omitempty"`
 	Except []string `json:"except,omitempty" yaml:"except,omitempty"`
 	// IgnoreRootPaths
@@ -156,11 +196,11 @@ type ExternalConfigV1 struct {
 	// IgnoreIDOrCategoryToRootPaths
 	IgnoreOnly                           map[string][]string `json:"ignore_only,omitempty" yaml:"ignore_only,omitempty"`
 	EnumZeroValueSuffix                  string              `json:"enum_zero_value_suffix,omitempty" yaml:"enum_zero_value_suffix,omitempty"`
-	RPCAllowSameRequestResponse          bool                `json:"rpc_allow_same_request_response,omitempty" yaml:"rpc_allow_same_request_response,omitempty"`
-	RPCAllowGoogleProtobufEmptyRequests  bool                `json:"rpc_allow_google_protobuf_empty_requests,omitempty" yaml:"rpc_allow_google_protobuf_empty_requests,omitempty"`
-	RPCAllowGoogleProtobufEmptyResponses bool                `json:"rpc_allow_google_protobuf_empty_responses,omitempty" yaml:"rpc_allow_google_protobuf_empty_responses,omitempty"`
+	RPCAllowSameRequestResponse          *bool               `json:"rpc_allow_same_request_response,omitempty" yaml:"rpc_allow_same_request_response,omitempty"`
+	RPCAllowGoogleProtobufEmptyRequests  *bool               `json:"rpc_allow_google_protobuf_empty_requests,omitempty" yaml:"rpc_allow_google_protobuf_empty_requests,omitempty"`
+	RPCAllowGoogleProtobufEmptyResponses *bool               `json:"rpc_allow_google_protobuf_empty_responses,omitempty" yaml:"rpc_allow_google_protobuf_empty_responses,omitempty"`
 	ServiceSuffix                        string              `json:"service_suffix,omitempty" yaml:"service_suffix,omitempty"`
-	AllowCommentIgnores                  bool                `json:"allow_comment_ignores,omitempty" yaml:"allow_comment_ignores,omitempty"`
+	AllowCommentIgnores                  *bool               `json:"allow_comment_ignores,omitempty" yaml:"allow_comment_ignores,omitempty"`
 }
 
 // ExternalConfigV1Beta1ForConfig takes a *Config and returns the v1beta1 externalconfig representation.
@@ -192,6 +232,7 @@ func ExternalConfigV1ForConfig(config *Config) ExternalConfigV1 {
 		RPCAllowGoogleProtobufEmptyResponses: config.RPCAllowGoogleProtobufEmptyResponses,
 		ServiceSuffix:                        config.ServiceSuffix,
 		AllowCommentIgnores:                  config.AllowCommentIgnores,
+		Overrides:                            externalOverrideConfigsForOverrideConfigs(config, config.Overrides),
 	}
 }
 
@@ -206,17 +247,23 @@ func BytesForConfig(config *Config) ([]byte, error) {
 }
 
 type configJSON struct {
-	Use                                  []string      `json:"use,omitempty"`
-	Except                               []string      `json:"except,omitempty"`
-	IgnoreRootPaths                      []string      `json:"ignore_root_paths,omitempty"`
-	IgnoreIDOrCategoryToRootPaths        []idPathsJSON `json:"ignore_id_to_root_paths,omitempty"`
-	EnumZeroValueSuffix                  string        `json:"enum_zero_value_suffix,omitempty"`
-	RPCAllowSameRequestResponse          bool          `json:"rpc_allow_same_request_response,omitempty"`
-	RPCAllowGoogleProtobufEmptyRequests  bool          `json:"rpc_allow_google_protobuf_empty_requests,omitempty"`
-	RPCAllowGoogleProtobufEmptyResponses bool          `json:"rpc_allow_google_protobuf_empty_response,omitempty"`
-	ServiceSuffix                        string        `json:"service_suffix,omitempty"`
-	AllowCommentIgnores                  bool          `json:"allow_comment_ignores,omitempty"`
-	Version                              string        `json:"version,omitempty"`
+	Use                                  []string       `json:"use,omitempty"`
+	Except                               []string       `json:"except,omitempty"`
+	IgnoreRootPaths                      []string       `json:"ignore_root_paths,omitempty"`
+	IgnoreIDOrCategoryToRootPaths        []idPathsJSON  `json:"ignore_id_to_root_paths,omitempty"`
+	EnumZeroValueSuffix                  string         `json:"enum_zero_value_suffix,omitempty"`
+	RPCAllowSameRequestResponse          bool           `json:"rpc_allow_same_request_response,omitempty"`
+	RPCAllowGoogleProtobufEmptyRequests  bool           `json:"rpc_allow_google_protobuf_empty_requests,omitempty"`
+	RPCAllowGoogleProtobufEmptyResponses bool           `json:"rpc_allow_google_protobuf_empty_response,omitempty"`
+	ServiceSuffix                        string         `json:"service_suffix,omitempty"`
+	AllowCommentIgnores                  bool           `json:"allow_comment_ignores,omitempty"`
+	Version                              string         `json:"version,omitempty"`
+	Overrides                            []overrideJSON `json:"overrides,omitempty"`
+}
+
+type overrideJSON struct {
+	RootPath string      `json:"root_path,omitempty"`
+	Config   *configJSON `json:"config,omitempty"`
 }
 
 type idPathsJSON struct {
@@ -247,6 +294,14 @@ func configToJSON(config *Config) *configJSON {
 	sort.Strings(use)
 	sort.Strings(except)
 	sort.Strings(ignoreRootPaths)
+	overridesJSON := make([]overrideJSON, 0, len(config.Overrides))
+	for rootPath, overrideConfig := range config.Overrides {
+		overridesJSON = append(overridesJSON, overrideJSON{
+			RootPath: rootPath,
+			Config:   configToJSON(overrideConfig),
+		})
+	}
+	sort.Slice(overridesJSON, func(i, j int) bool { return overridesJSON[i].RootPath < overridesJSON[j].RootPath })
 	return &configJSON{
 		Use:                                  use,
 		Except:                               except,
@@ -259,6 +314,7 @@ func configToJSON(config *Config) *configJSON {
 		ServiceSuffix:                        config.ServiceSuffix,
 		AllowCommentIgnores:                  config.AllowCommentIgnores,
 		Version:                              config.Version,
+		Overrides:                            overridesJSON,
 	}
 }
 
@@ -358,3 +414,124 @@ func protoForIgnoreIDOrCategoryToRootPaths(ignoreIDOrCategoryToRootPaths map[str
 	}
 	return idPathsProto
 }
+
+func overrideConfigsForExternalOverrideConfigs(
+	config *Config,
+	externalOverrideConfigs map[string]ExternalOverrideConfigV1,
+) map[string]*Config {
+	if len(externalOverrideConfigs) == 0 {
+		return nil
+	}
+	overrideConfigs := make(map[string]*Config, len(externalOverrideConfigs))
+	for rootPath, externalOverrideConfig := range externalOverrideConfigs {
+		overrideConfig := &Config{
+			Use:                                  config.Use,
+			Except:                               config.Except,
+			IgnoreRootPaths:                      internal.MergeIgnoreRootPaths(config.IgnoreRootPaths, externalOverrideConfig.Ignore),
+			IgnoreIDOrCategoryToRootPaths:        internal.MergeIgnoreIDOrCategoryToRootPaths(config.IgnoreIDOrCategoryToRootPaths, externalOverrideConfig.IgnoreOnly),
+			EnumZeroValueSuffix:                  config.EnumZeroValueSuffix,
+			RPCAllowSameRequestResponse:          config.RPCAllowSameRequestResponse,
+			RPCAllowGoogleProtobufEmptyRequests:  config.RPCAllowGoogleProtobufEmptyRequests,
+			RPCAllowGoogleProtobufEmptyResponses: config.RPCAllowGoogleProtobufEmptyResponses,
+			ServiceSuffix:                        config.ServiceSuffix,
+			AllowCommentIgnores:                  config.AllowCommentIgnores,
+			Version:                              config.Version,
+		}
+		if len(externalOverrideConfig.Use) > 0 {
+			overrideConfig.Use = externalOverrideConfig.Use
+		}
+		if len(externalOverrideConfig.Except) > 0 {
+			overrideConfig.Except = externalOverrideConfig.Except
+		}
+		if externalOverrideConfig.EnumZeroValueSuffix != "" {
+			overrideConfig.EnumZeroValueSuffix = externalOverrideConfig.EnumZeroValueSuffix
+		}
+		if externalOverrideConfig.RPCAllowSameRequestResponse != nil {
+			overrideConfig.RPCAllowSameRequestResponse = *externalOverrideConfig.RPCAllowSameRequestResponse
+		}
+		if externalOverrideConfig.RPCAllowGoogleProtobufEmptyRequests != nil {
+			overrideConfig.RPCAllowGoogleProtobufEmptyRequests = *externalOverrideConfig.RPCAllowGoogleProtobufEmptyRequests
+		}
+		if externalOverrideConfig.RPCAllowGoogleProtobufEmptyResponses != nil {
+			overrideConfig.RPCAllowGoogleProtobufEmptyResponses = *externalOverrideConfig.RPCAllowGoogleProtobufEmptyResponses
+		}
+		if externalOverrideConfig.ServiceSuffix != "" {
+			overrideConfig.ServiceSuffix = externalOverrideConfig.ServiceSuffix
+		}
+		if externalOverrideConfig.AllowCommentIgnores != nil {
+			overrideConfig.AllowCommentIgnores = *externalOverrideConfig.AllowCommentIgnores
+		}
+		overrideConfigs[normalpath.Normalize(rootPath)] = overrideConfig
+	}
+	return overrideConfigs
+}
+
+// externalOverrideConfigsForOverrideConfigs returns the external overrides that
+// merge with config into overrideConfigs.
+func externalOverrideConfigsForOverrideConfigs(
+	config *Config,
+	overrideConfigs map[string]*Config,
+) map[string]ExternalOverrideConfigV1 {
+	if len(overrideConfigs) == 0 {
+		return nil
+	}
+	externalOverrideConfigs := make(map[string]ExternalOverrideConfigV1, len(overrideConfigs))
+	for rootPath, overrideConfig := range overrideConfigs {
+		externalOverrideConfig := ExternalOverrideConfigV1{
+			Ignore:     internal.IgnoreRootPathsDiff(config.IgnoreRootPaths, overrideConfig.IgnoreRootPaths),
+			IgnoreOnly: internal.IgnoreIDOrCategoryToRootPathsDiff(config.IgnoreIDOrCategoryToRootPaths, overrideConfig.IgnoreIDOrCategoryToRootPaths),
+		}
+		if !stringutil.SliceElementsEqual(config.Use, overrideConfig.Use) {
+			externalOverrideConfig.Use = overrideConfig.Use
+		}
+		if !stringutil.SliceElementsEqual(config.Except, overrideConfig.Except) {
+			externalOverrideConfig.Except = overrideConfig.Except
+		}
+		if config.EnumZeroValueSuffix != overrideConfig.EnumZeroValueSuffix {
+			externalOverrideConfig.EnumZeroValueSuffix = overrideConfig.EnumZeroValueSuffix
+		}
+		if config.RPCAllowSameRequestResponse != overrideConfig.RPCAllowSameRequestResponse {
+			externalOverrideConfig.RPCAllowSameRequestResponse = &overrideConfig.RPCAllowSameRequestResponse
+		}
+		if config.RPCAllowGoogleProtobufEmptyRequests != overrideConfig.RPCAllowGoogleProtobufEmptyRequests {
+			externalOverrideConfig.RPCAllowGoogleProtobufEmptyRequests = &overrideConfig.RPCAllowGoogleProtobufEmptyRequests
+		}
+		if config.RPCAllowGoogleProtobufEmptyResponses != overrideConfig.RPCAllowGoogleProtobufEmptyResponses {
+			externalOverrideConfig.RPCAllowGoogleProtobufEmptyResponses = &overrideConfig.RPCAllowGoogleProtobufEmptyResponses
+		}
+		if config.ServiceSuffix != overrideConfig.ServiceSuffix {
+			externalOverrideConfig.ServiceSuffix = overrideConfig.ServiceSuffix
+		}
+		if config.AllowCommentIgnores != overrideConfig.AllowCommentIgnores {
+			externalOverrideConfig.AllowCommentIgnores = &overrideConfig.AllowCommentIgnores
+		}
+		externalOverrideConfigs[rootPath] = externalOverrideConfig
+	}
+	return externalOverrideConfigs
+}
+
+func overrideConfigsForProto(protoOverrides []*lintv1.Override) map[string]*Config {
+	if len(protoOverrides) == 0 {
+		return nil
+	}
+	overrideConfigs := make(map[string]*Config, len(protoOverrides))
+	for _, protoOverride := range protoOverrides {
+		overrideConfigs[protoOverride.GetRootPath()] = ConfigForProto(protoOverride.GetConfig())
+	}
+	return overrideConfigs
+}
+
+func protoForOverrideConfigs(overrideConfigs map[string]*Config) []*lintv1.Override {
+	if len(overrideConfigs) == 0 {
+		return nil
+	}
+	protoOverrides := make([]*lintv1.Override, 0, len(overrideConfigs))
+	for rootPath, overrideConfig := range overrideConfigs {
+		protoOverrides = append(protoOverrides, &lintv1.Override{
+			RootPath: rootPath,
+			Config:   ProtoForConfig(overrideConfig),
+		})
+	}
+	sort.Slice(protoOverrides, func(i, j int) bool { return protoOverrides[i].RootPath < protoOverrides[j].RootPath })
+	return protoOverrides
+}
@@ -16,6 +16,7 @@ package buflint
 
 import (
 	"context"
+	"strings"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
@@ -24,9 +25,24 @@ import (
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
 	"github.com/bufbuild/buf/private/pkg/protosource"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"github.com/bufbuild/buf/private/pkg/timing"
 	"go.uber.org/zap"
 )
 
+// sourceCodeInfoRuleIDs are the IDs of the rules that require source code info.
+//
+// These rules check comments, which are only available as part of source code info.
+var sourceCodeInfoRuleIDs = map[string]struct{}{
+	"COMMENT_ENUM":       {},
+	"COMMENT_ENUM_VALUE": {},
+	"COMMENT_FIELD":      {},
+	"COMMENT_MESSAGE":    {},
+	"COMMENT_ONEOF":      {},
+	"COMMENT_RPC":        {},
+	"COMMENT_SERVICE":    {},
+}
+
 type handler struct {
 	logger *zap.Logger
 	runner *internal.Runner
@@ -50,14 +66,227 @@ func (h *handler) Check(
 	ctx context.Context,
 	config *buflintconfig.Config,
 	image bufimage.Image,
+	options ...CheckOption,
+) ([]bufanalysis.FileAnnotation, error) {
+	defer timing.Start(ctx, timing.PhaseCheck)()
+	checkOptions := newCheckOptions()
+	for _, option := range options {
+		option(checkOptions)
+	}
+	requiresSourceCodeInfo, err := configRequiresSourceCodeInfo(config, checkOptions)
+	if err != nil {
+		return nil, err
+	}
+	if requiresSourceCodeInfo {
+		// the comment rules are skipped for files without source code info, so we
+		// need it for all files, this is a no-op if it was already loaded
+		if err := bufimage.LoadSourceCodeInfo(ctx, image); err != nil {
+			return nil, err
+		}
+		return h.checkImage(ctx, config, checkOptions, image)
+	}
+	return internal.CheckWithLazySourceCodeInfo(
+		ctx,
+		image,
+		func(ctx context.Context) ([]bufanalysis.FileAnnotation, error) {
+			return h.checkImage(ctx, config, checkOptions, image)
+		},
+	)
+}
+
+// checkImage runs the lint check for the config and its overrides.
+func (h *handler) checkImage(
+	ctx context.Context,
+	config *buflintconfig.Config,
+	checkOptions *checkOptions,
+	image bufimage.Image,
 ) ([]bufanalysis.FileAnnotation, error) {
 	files, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(image.Files())...)
 	if err != nil {
 		return nil, err
 	}
-	internalConfig, err := internalConfigForConfig(config)
+	pathsWithoutSourceCodeInfo := getPathsWithoutSourceCodeInfo(image)
+	if len(config.Overrides) == 0 {
+		fileAnnotations, skippedRuleIDs, err := h.check(ctx, config, checkOptions, files, pathsWithoutSourceCodeInfo)
+		if err != nil {
+			return nil, err
+		}
+		h.warnSkippedRuleIDs(skippedRuleIDs, pathsWithoutSourceCodeInfo)
+		return fileAnnotations, nil
+	}
+	overrideRootPaths := getOverrideRootPaths(config)
+	// Each config is only run against the files within its scope. Rules that look at more
+	// than one file, such as the package rules, only see the files within the same scope.
+	var fileAnnotations []bufanalysis.FileAnnotation
+	var skippedRuleIDs []string
+	for rootPath, scopeConfig := range getScopeConfigs(config) {
+		scopeFiles := internal.FilesForOverride(files, overrideRootPaths, rootPath)
+		if len(scopeFiles) == 0 {
+			continue
+		}
+		scopeFileAnnotations, scopeSkippedRuleIDs, err := h.check(ctx, scopeConfig, checkOptions, scopeFiles, pathsWithoutSourceCodeInfo)
+		if err != nil {
+			return nil, err
+		}
+		fileAnnotations = append(
+			fileAnnotations,
+			internal.FileAnnotationsForOverride(scopeFileAnnotations, overrideRootPaths, rootPath)...,
+		)
+		skippedRuleIDs = append(skippedRuleIDs, scopeSkippedRuleIDs...)
+	}
+	bufanalysis.SortFileAnnotations(fileAnnotations)
+	h.warnSkippedRuleIDs(skippedRuleIDs, pathsWithoutSourceCodeInfo)
+	return fileAnnotations, nil
+}
+
+// warnSkippedRuleIDs warns about the rules that were skipped for the paths without
+// source code info.
+func (h *handler) warnSkippedRuleIDs(skippedRuleIDs []string, pathsWithoutSourceCodeInfo []string) {
+	if len(skippedRuleIDs) == 0 {
+		return
+	}
+	h.logger.Sugar().Warnf(
+		"%d file(s) do not have source code info, so the following lint rules were skipped for them: %s. Include source code info in the image to run these rules, for example with protoc --include_source_info.",
+		len(pathsWithoutSourceCodeInfo),
+		strings.Join(stringutil.SliceToUniqueSortedSlice(skippedRuleIDs), ", "),
+	)
+	h.logger.Sugar().Debugf("files without source code info: %s", strings.Join(pathsWithoutSourceCodeInfo, ", "))
+}
+
+// check runs the lint check for the config.
+//
+// The rules that require source code info are skipped for the paths without source code
+// info, and the IDs of the skipped rules are returned.
+func (h *handler) check(
+	ctx context.Context,
+	config *buflintconfig.Config,
+	checkOptions *checkOptions,
+	files []protosource.File,
+	pathsWithoutSourceCodeInfo []string,
+) ([]bufanalysis.FileAnnotation, []string, error) {
+	internalConfig, err := internalConfigForConfigAndCheckOptions(config, checkOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	skippedRuleIDs := skipSourceCodeInfoRules(internalConfig, pathsWithoutSourceCodeInfo)
+	fileAnnotations, err := h.runner.Check(ctx, internalConfig, nil, files)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fileAnnotations, skippedRuleIDs, nil
+}
+
+func (h *handler) Ignores(
+	ctx context.Context,
+	config *buflintconfig.Config,
+	image bufimage.Image,
+) ([]bufanalysis.FileAnnotation, error) {
+	if len(config.Overrides) == 0 {
+		return getIgnoreFileAnnotations(config, image)
+	}
+	overrideRootPaths := getOverrideRootPaths(config)
+	fileAnnotations, err := getIgnoreFileAnnotations(config, image)
 	if err != nil {
 		return nil, err
 	}
-	return h.runner.Check(ctx, internalConfig, nil, files)
+	fileAnnotations = internal.FileAnnotationsForOverride(fileAnnotations, overrideRootPaths, "")
+	for rootPath, overrideConfig := range config.Overrides {
+		overrideFileAnnotations, err := getIgnoreFileAnnotations(overrideConfig, image)
+		if err != nil {
+			return nil, err
+		}
+		fileAnnotations = append(
+			fileAnnotations,
+			internal.FileAnnotationsForOverride(overrideFileAnnotations, overrideRootPaths, rootPath)...,
+		)
+	}
+	bufanalysis.SortFileAnnotations(fileAnnotations)
+	return fileAnnotations, nil
+}
+
+// configRequiresSourceCodeInfo returns true if any of the rules of the config or
+// its overrides require source code info.
+func configRequiresSourceCodeInfo(config *buflintconfig.Config, checkOptions *checkOptions) (bool, error) {
+	internalConfig, err := internalConfigForConfigAndCheckOptions(config, checkOptions)
+	if err != nil {
+		return false, err
+	}
+	for _, rule := range internalConfig.Rules {
+		if _, ok := sourceCodeInfoRuleIDs[rule.ID()]; ok {
+			return true, nil
+		}
+	}
+	for _, overrideConfig := range config.Overrides {
+		requiresSourceCodeInfo, err := configRequiresSourceCodeInfo(overrideConfig, checkOptions)
+		if err != nil {
+			return false, err
+		}
+		if requiresSourceCodeInfo {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func getOverrideRootPaths(config *buflintconfig.Config) map[string]struct{} {
+	overrideRootPaths := make(map[string]struct{}, len(config.Overrides))
+	for rootPath := range config.Overrides {
+		overrideRootPaths[rootPath] = struct{}{}
+	}
+	return overrideRootPaths
+}
+
+// getScopeConfigs returns the config and its overrides by the root path of their scope,
+// where the empty root path is the scope of the config.
+func getScopeConfigs(config *buflintconfig.Config) map[string]*buflintconfig.Config {
+	scopeConfigs := make(map[string]*buflintconfig.Config, len(config.Overrides)+1)
+	scopeConfigs[""] = config
+	for rootPath, overrideConfig := range config.Overrides {
+		scopeConfigs[rootPath] = overrideConfig
+	}
+	return scopeConfigs
+}
+
+// getPathsWithoutSourceCodeInfo returns the paths of the non-import files of the
+// image that do not have source code info.
+//
+// This is generally the case for images and FileDescriptorSets that were built
+// without source code info, for example by protoc without --include_source_info.
+func getPathsWithoutSourceCodeInfo(image bufimage.Image) []string {
+	var paths []string
+	for _, imageFile := range image.Files() {
+		if imageFile.IsImport() {
+			continue
+		}
+		if len(imageFile.Proto().GetSourceCodeInfo().GetLocation()) == 0 {
+			paths = append(paths, imageFile.Path())
+		}
+	}
+	return paths
+}
+
+// skipSourceCodeInfoRules ignores the rules of the config that require source code info for
+// the given paths, and returns the IDs of the rules that were skipped.
+//
+// Without source code info, these rules would report every descriptor in these paths.
+func skipSourceCodeInfoRules(internalConfig *internal.Config, pathsWithoutSourceCodeInfo []string) []string {
+	if len(pathsWithoutSourceCodeInfo) == 0 {
+		return nil
+	}
+	var skippedRuleIDs []string
+	for _, rule := range internalConfig.Rules {
+		if _, ok := sourceCodeInfoRuleIDs[rule.ID()]; !ok {
+			continue
+		}
+		ignoreRootPaths, ok := internalConfig.IgnoreIDToRootPaths[rule.ID()]
+		if !ok {
+			ignoreRootPaths = make(map[string]struct{}, len(pathsWithoutSourceCodeInfo))
+			internalConfig.IgnoreIDToRootPaths[rule.ID()] = ignoreRootPaths
+		}
+		for _, path := range pathsWithoutSourceCodeInfo {
+			ignoreRootPaths[path] = struct{}{}
+		}
+		skippedRuleIDs = append(skippedRuleIDs, rule.ID())
+	}
+	return skippedRuleIDs
 }
@@ -0,0 +1,171 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buflint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/internal/buflintcheck"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+)
+
+// allRulesIgnoreType is the FileAnnotation type used for paths that are
+// ignored for all rules with the ignore key.
+const allRulesIgnoreType = "ALL"
+
+func getIgnoreFileAnnotations(
+	config *buflintconfig.Config,
+	image bufimage.Image,
+) ([]bufanalysis.FileAnnotation, error) {
+	commentIgnoreFileAnnotations := getCommentIgnoreFileAnnotations(config, image)
+	configIgnoreFileAnnotations, err := getConfigIgnoreFileAnnotations(config, image)
+	if err != nil {
+		return nil, err
+	}
+	return bufanalysis.DeduplicateAndSortFileAnnotations(
+		append(commentIgnoreFileAnnotations, configIgnoreFileAnnotations...),
+	), nil
+}
+
+func getCommentIgnoreFileAnnotations(
+	config *buflintconfig.Config,
+	image bufimage.Image,
+) []bufanalysis.FileAnnotation {
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, imageFile := range image.Files() {
+		for _, location := range imageFile.Proto().GetSourceCodeInfo().GetLocation() {
+			for _, id := range getCommentIgnoreIDs(location.GetLeadingComments()) {
+				message := fmt.Sprintf("Comment ignore for %s.", id)
+				if !config.AllowCommentIgnores {
+					message += " This has no effect as allow_comment_ignores is not set."
+				}
+				startLine, startColumn, endLine, endColumn := getSpan(location.GetSpan())
+				fileAnnotations = append(
+					fileAnnotations,
+					bufanalysis.NewFileAnnotation(
+						imageFile,
+						startLine,
+						startColumn,
+						endLine,
+						endColumn,
+						id,
+						message,
+					),
+				)
+			}
+		}
+	}
+	return fileAnnotations
+}
+
+func getConfigIgnoreFileAnnotations(
+	config *buflintconfig.Config,
+	image bufimage.Image,
+) ([]bufanalysis.FileAnnotation, error) {
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, rootPath := range config.IgnoreRootPaths {
+		fileInfo, err := getConfigIgnoreFileInfo(rootPath, image)
+		if err != nil {
+			return nil, err
+		}
+		fileAnnotations = append(
+			fileAnnotations,
+			bufanalysis.NewFileAnnotation(
+				fileInfo,
+				0,
+				0,
+				0,
+				0,
+				allRulesIgnoreType,
+				`Ignored for all rules by the "ignore" configuration.`,
+			),
+		)
+	}
+	idOrCategories := make([]string, 0, len(config.IgnoreIDOrCategoryToRootPaths))
+	for idOrCategory := range config.IgnoreIDOrCategoryToRootPaths {
+		idOrCategories = append(idOrCategories, idOrCategory)
+	}
+	sort.Strings(idOrCategories)
+	for _, idOrCategory := range idOrCategories {
+		for _, rootPath := range config.IgnoreIDOrCategoryToRootPaths[idOrCategory] {
+			fileInfo, err := getConfigIgnoreFileInfo(rootPath, image)
+			if err != nil {
+				return nil, err
+			}
+			fileAnnotations = append(
+				fileAnnotations,
+				bufanalysis.NewFileAnnotation(
+					fileInfo,
+					0,
+					0,
+					0,
+					0,
+					idOrCategory,
+					fmt.Sprintf(`Ignored for %s by the "ignore_only" configuration.`, idOrCategory),
+				),
+			)
+		}
+	}
+	return fileAnnotations, nil
+}
+
+// getCommentIgnoreIDs returns the rule IDs of all comment ignores within the comment.
+func getCommentIgnoreIDs(comment string) []string {
+	var ids []string
+	for _, line := range stringutil.SplitTrimLinesNoEmpty(comment) {
+		if !strings.HasPrefix(line, buflintcheck.CommentIgnorePrefix+" ") {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, buflintcheck.CommentIgnorePrefix))
+		if len(fields) > 0 {
+			ids = append(ids, fields[0])
+		}
+	}
+	return ids
+}
+
+// getConfigIgnoreFileInfo returns the FileInfo for an ignored path within the config.
+//
+// If the path is a file within the image, the FileInfo of the ImageFile is used so
+// that the external path is printed.
+func getConfigIgnoreFileInfo(rootPath string, image bufimage.Image) (bufanalysis.FileInfo, error) {
+	path, err := normalpath.NormalizeAndValidate(rootPath)
+	if err != nil {
+		return nil, err
+	}
+	if imageFile := image.GetFile(path); imageFile != nil {
+		return imageFile, nil
+	}
+	return bufmoduleref.NewFileInfo(path, "", false, nil, "")
+}
+
+// getSpan returns the 1-indexed start and end positions for the SourceCodeInfo span.
+func getSpan(span []int32) (int, int, int, int) {
+	switch len(span) {
+	case 3:
+		return int(span[0]) + 1, int(span[1]) + 1, int(span[0]) + 1, int(span[2]) + 1
+	case 4:
+		return int(span[0]) + 1, int(span[1]) + 1, int(span[2]) + 1, int(span[3]) + 1
+	default:
+		return 0, 0, 0, 0
+	}
+}
@@ -67,6 +67,24 @@ var (
 		"services have non-empty comments",
 		newAdapter(buflintcheck.CheckCommentService),
 	)
+	// DependencyUsedRuleBuilder is a rule builder.
+	DependencyUsedRuleBuilder = internal.NewRuleBuilder(
+		"DEPENDENCY_USED",
+		func(configBuilder internal.ConfigBuilder) (string, error) {
+			return "all dependencies declared in buf.yaml are imported", nil
+		},
+		func(configBuilder internal.ConfigBuilder) (internal.CheckFunc, error) {
+			return internal.CheckFunc(func(id string, ignoreFunc internal.IgnoreFunc, _ []protosource.File, files []protosource.File) ([]bufanalysis.FileAnnotation, error) {
+				return buflintcheck.CheckDependencyUsed(
+					id,
+					ignoreFunc,
+					files,
+					configBuilder.DependencyModuleIdentityStrings,
+					configBuilder.ImportPathToModuleIdentityString,
+				)
+			}), nil
+		},
+	)
 	// DirectorySamePackageRuleBuilder is a rule builder.
 	DirectorySamePackageRuleBuilder = internal.NewNopRuleBuilder(
 		"DIRECTORY_SAME_PACKAGE",
@@ -121,6 +139,12 @@ var (
 			}), nil
 		},
 	)
+	// ExtensionDeclarationValidRuleBuilder is a rule builder.
+	ExtensionDeclarationValidRuleBuilder = internal.NewNopRuleBuilder(
+		"EXTENSION_DECLARATION_VALID",
+		"extension declarations are valid and extensions match the declarations of their extendee",
+		newAdapter(buflintcheck.CheckExtensionDeclarationValid),
+	)
 	// FieldLowerSnakeCaseRuleBuilder is a rule builder.
 	FieldLowerSnakeCaseRuleBuilder = internal.NewNopRuleBuilder(
 		"FIELD_LOWER_SNAKE_CASE",
@@ -139,12 +163,42 @@ var (
 		"filenames are lower_snake_case",
 		newAdapter(buflintcheck.CheckFileLowerSnakeCase),
 	)
+	// HTTPBodyRuleBuilder is a rule builder.
+	HTTPBodyRuleBuilder = internal.NewNopRuleBuilder(
+		"HTTP_BODY",
+		"google.api.http body and response_body refer to top-level fields of the request and response",
+		newAdapter(buflintcheck.CheckHTTPBody),
+	)
+	// HTTPPathSyntaxRuleBuilder is a rule builder.
+	HTTPPathSyntaxRuleBuilder = internal.NewNopRuleBuilder(
+		"HTTP_PATH_SYNTAX",
+		"google.api.http annotations have valid path templates",
+		newAdapter(buflintcheck.CheckHTTPPathSyntax),
+	)
+	// HTTPPathVariablesRuleBuilder is a rule builder.
+	HTTPPathVariablesRuleBuilder = internal.NewNopRuleBuilder(
+		"HTTP_PATH_VARIABLES",
+		"google.api.http path variables refer to singular fields of the request",
+		newAdapter(buflintcheck.CheckHTTPPathVariables),
+	)
+	// HTTPRouteUniqueRuleBuilder is a rule builder.
+	HTTPRouteUniqueRuleBuilder = internal.NewNopRuleBuilder(
+		"HTTP_ROUTE_UNIQUE",
+		"google.api.http routes are unique across all services",
+		newAdapter(buflintcheck.CheckHTTPRouteUnique),
+	)
 	// ImportNoPublicRuleBuilder is a rule builder.
 	ImportNoPublicRuleBuilder = internal.NewNopRuleBuilder(
 		"IMPORT_NO_PUBLIC",
 		"imports are not public",
 		newAdapter(buflintcheck.CheckImportNoPublic),
 	)
+	// ImportNoPublicForwardRuleBuilder is a rule builder.
+	ImportNoPublicForwardRuleBuilder = internal.NewNopRuleBuilder(
+		"IMPORT_NO_PUBLIC_FORWARD",
+		"types are imported from the files that define them rather than forwarded by public imports",
+		newAdapter(buflintcheck.CheckImportNoPublicForward),
+	)
 	// ImportNoWeakRuleBuilder is a rule builder.
 	ImportNoWeakRuleBuilder = internal.NewNopRuleBuilder(
 		"IMPORT_NO_WEAK",
@@ -24,7 +24,10 @@ import (
 	"strings"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufextension"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
+	"github.com/bufbuild/buf/private/bufpkg/bufhttprule"
+	"github.com/bufbuild/buf/private/bufpkg/bufimport"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/protosource"
 	"github.com/bufbuild/buf/private/pkg/protoversion"
@@ -101,6 +104,47 @@ func checkCommentNamedDescriptor(
 	return nil
 }
 
+// CheckDependencyUsed is a check function.
+var CheckDependencyUsed = func(
+	id string,
+	ignoreFunc internal.IgnoreFunc,
+	files []protosource.File,
+	dependencyModuleIdentityStrings []string,
+	importPathToModuleIdentityString map[string]string,
+) ([]bufanalysis.FileAnnotation, error) {
+	return newFilesCheckFunc(
+		func(add addFunc, files []protosource.File) error {
+			return checkDependencyUsed(add, files, dependencyModuleIdentityStrings, importPathToModuleIdentityString)
+		},
+	)(id, ignoreFunc, files)
+}
+
+func checkDependencyUsed(
+	add addFunc,
+	files []protosource.File,
+	dependencyModuleIdentityStrings []string,
+	importPathToModuleIdentityString map[string]string,
+) error {
+	if len(dependencyModuleIdentityStrings) == 0 {
+		return nil
+	}
+	usedModuleIdentityStrings := make(map[string]struct{})
+	for _, file := range files {
+		for _, fileImport := range file.FileImports() {
+			if moduleIdentityString, ok := importPathToModuleIdentityString[fileImport.Import()]; ok {
+				usedModuleIdentityStrings[moduleIdentityString] = struct{}{}
+			}
+		}
+	}
+	for _, dependencyModuleIdentityString := range dependencyModuleIdentityStrings {
+		if _, ok := usedModuleIdentityStrings[dependencyModuleIdentityString]; !ok {
+			// there is no descriptor to attach this to, the dependency is declared in buf.yaml
+			add(nil, nil, nil, `Dependency %q is not imported by any file in the module.`, dependencyModuleIdentityString)
+		}
+	}
+	return nil
+}
+
 // CheckDirectorySamePackage is a check function.
 var CheckDirectorySamePackage = newDirToFilesCheckFunc(checkDirectorySamePackage)
 
@@ -262,6 +306,18 @@ func checkEnumZeroValueSuffix(add addFunc, enumValue protosource.EnumValue, suff
 	return nil
 }
 
+// CheckExtensionDeclarationValid is a check function.
+var CheckExtensionDeclarationValid = newFilesCheckFunc(checkExtensionDeclarationValid)
+
+func checkExtensionDeclarationValid(add addFunc, files []protosource.File) error {
+	return bufextension.Verify(
+		files,
+		func(descriptor protosource.Descriptor, location protosource.Location, format string, args ...interface{}) {
+			add(descriptor, location, nil, format, args...)
+		},
+	)
+}
+
 // CheckFieldLowerSnakeCase is a check function.
 var CheckFieldLowerSnakeCase = newFieldCheckFunc(checkFieldLowerSnakeCase)
 
@@ -330,9 +386,123 @@ func checkFileLowerSnakeCase(add addFunc, file protosource.File) error {
 	return nil
 }
 
+// CheckHTTPBody is a check function.
+var CheckHTTPBody = newHTTPBindingCheckFunc(checkHTTPBody)
+
+func checkHTTPBody(
+	add addFunc,
+	method protosource.Method,
+	binding *bufhttprule.Binding,
+	fullNameToMessage map[string]protosource.Message,
+) error {
+	if binding.Body != "" && binding.Method == bufhttprule.MethodGet && !binding.Custom {
+		addHTTPBinding(add, method, "RPC %q must not have an HTTP body for %s %q.", method.Name(), binding.Method, binding.Path)
+	}
+	if binding.Body != "" && binding.Body != "*" {
+		if message, ok := fullNameToMessage[method.InputTypeName()]; ok && getFieldByName(message, binding.Body) == nil {
+			addHTTPBinding(add, method, "RPC %q has HTTP body %q which is not a field of request %q.", method.Name(), binding.Body, message.FullName())
+		}
+	}
+	if binding.ResponseBody == "*" {
+		addHTTPBinding(add, method, `RPC %q has HTTP response body "*", which must be omitted to use the entire response.`, method.Name())
+	} else if binding.ResponseBody != "" {
+		if message, ok := fullNameToMessage[method.OutputTypeName()]; ok && getFieldByName(message, binding.ResponseBody) == nil {
+			addHTTPBinding(add, method, "RPC %q has HTTP response body %q which is not a field of response %q.", method.Name(), binding.ResponseBody, message.FullName())
+		}
+	}
+	return nil
+}
+
+// CheckHTTPPathSyntax is a check function.
+var CheckHTTPPathSyntax = newMethodCheckFunc(checkHTTPPathSyntax)
+
+func checkHTTPPathSyntax(add addFunc, method protosource.Method) error {
+	bindings, err := bufhttprule.BindingsForMethod(method)
+	if err != nil {
+		addHTTPBinding(add, method, "RPC %q has an invalid google.api.http annotation: %v.", method.Name(), err)
+		return nil
+	}
+	for _, binding := range bindings {
+		if binding.Method == "" {
+			addHTTPBinding(add, method, "RPC %q has a google.api.http binding without an HTTP method and path.", method.Name())
+			continue
+		}
+		if _, err := bufhttprule.ParseTemplate(binding.Path); err != nil {
+			addHTTPBinding(add, method, "RPC %q has invalid HTTP path %q: %v.", method.Name(), binding.Path, err)
+		}
+	}
+	return nil
+}
+
+// CheckHTTPPathVariables is a check function.
+var CheckHTTPPathVariables = newHTTPBindingCheckFunc(checkHTTPPathVariables)
+
+func checkHTTPPathVariables(
+	add addFunc,
+	method protosource.Method,
+	binding *bufhttprule.Binding,
+	fullNameToMessage map[string]protosource.Message,
+) error {
+	template, err := bufhttprule.ParseTemplate(binding.Path)
+	if err != nil {
+		// Reported by HTTP_PATH_SYNTAX.
+		return nil
+	}
+	message, ok := fullNameToMessage[method.InputTypeName()]
+	if !ok {
+		// The request is not part of the files being linted.
+		return nil
+	}
+	for _, variable := range template.Variables {
+		if problem := getHTTPPathVariableProblem(message, variable.FieldPath, fullNameToMessage); problem != "" {
+			addHTTPBinding(add, method, "RPC %q has HTTP path %q with variable %q that %s.", method.Name(), binding.Path, variable.FieldPath, problem)
+		}
+	}
+	return nil
+}
+
+// CheckHTTPRouteUnique is a check function.
+var CheckHTTPRouteUnique = newFilesCheckFunc(checkHTTPRouteUnique)
+
+func checkHTTPRouteUnique(add addFunc, files []protosource.File) error {
+	routeToMethod := make(map[string]protosource.Method)
+	for _, file := range files {
+		for _, service := range file.Services() {
+			for _, method := range service.Methods() {
+				bindings, err := bufhttprule.BindingsForMethod(method)
+				if err != nil {
+					// Reported by HTTP_PATH_SYNTAX.
+					continue
+				}
+				for _, binding := range bindings {
+					template, err := bufhttprule.ParseTemplate(binding.Path)
+					if err != nil || binding.Method == "" {
+						// Reported by HTTP_PATH_SYNTAX.
+						continue
+					}
+					route := binding.Method + " " + template.Pattern()
+					otherMethod, ok := routeToMethod[route]
+					if !ok {
+						routeToMethod[route] = method
+						continue
+					}
+					if otherMethod == method {
+						addHTTPBinding(add, method, "RPC %q has more than one HTTP binding for route %q.", method.Name(), route)
+					} else {
+						addHTTPBinding(add, method, "RPC %q has HTTP route %q which is also bound by RPC %q.", method.Name(), route, otherMethod.FullName())
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 var (
 	// CheckImportNoPublic is a check function.
 	CheckImportNoPublic = newFileImportCheckFunc(checkImportNoPublic)
+	// CheckImportNoPublicForward is a check function.
+	CheckImportNoPublicForward = newFilesCheckFunc(checkImportNoPublicForward)
 	// CheckImportNoWeak is a check function.
 	CheckImportNoWeak = newFileImportCheckFunc(checkImportNoWeak)
 	// CheckImportUsed is a check function.
@@ -343,6 +513,25 @@ func checkImportNoPublic(add addFunc, fileImport protosource.FileImport) error {
 	return checkImportNoPublicWeak(add, fileImport, fileImport.IsPublic(), "public")
 }
 
+func checkImportNoPublicForward(add addFunc, files []protosource.File) error {
+	forwards, err := bufimport.GetForwards(files)
+	if err != nil {
+		return err
+	}
+	for _, forward := range forwards {
+		add(
+			forward.File,
+			forward.Location,
+			nil,
+			`Type %q is defined in %q, which is only imported through the public imports of %q.`,
+			forward.TypeName,
+			forward.DefiningFilePath,
+			forward.Import.Import(),
+		)
+	}
+	return nil
+}
+
 func checkImportNoWeak(add addFunc, fileImport protosource.FileImport) error {
 	return checkImportNoPublicWeak(add, fileImport, fileImport.IsWeak(), "weak")
 }
@@ -15,12 +15,15 @@
 package buflintcheck
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
+	"github.com/bufbuild/buf/private/bufpkg/bufhttprule"
 	"github.com/bufbuild/buf/private/pkg/protosource"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // addFunc adds a FileAnnotation.
@@ -286,3 +289,103 @@ func newMethodCheckFunc(
 		},
 	)
 }
+
+// newHTTPBindingCheckFunc calls f for every binding of the google.api.http annotations
+// of all methods, along with the messages of all files to resolve request and response fields.
+//
+// Methods with invalid annotations are skipped, as these are reported by HTTP_PATH_SYNTAX.
+func newHTTPBindingCheckFunc(
+	f func(addFunc, protosource.Method, *bufhttprule.Binding, map[string]protosource.Message) error,
+) func(string, internal.IgnoreFunc, []protosource.File) ([]bufanalysis.FileAnnotation, error) {
+	return newFilesCheckFunc(
+		func(add addFunc, files []protosource.File) error {
+			fullNameToMessage, err := protosource.FullNameToMessage(files...)
+			if err != nil {
+				return err
+			}
+			for _, file := range files {
+				for _, service := range file.Services() {
+					for _, method := range service.Methods() {
+						bindings, err := bufhttprule.BindingsForMethod(method)
+						if err != nil {
+							continue
+						}
+						for _, binding := range bindings {
+							if err := f(add, method, binding, fullNameToMessage); err != nil {
+								return err
+							}
+						}
+					}
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// addHTTPBinding adds a FileAnnotation for the google.api.http annotation of the method.
+func addHTTPBinding(add addFunc, method protosource.Method, format string, args ...interface{}) {
+	add(
+		method,
+		method.Location(),
+		// also check the service for this comment ignore
+		// this allows users to set this "globally" for a service
+		[]protosource.Location{
+			method.Service().Location(),
+		},
+		format,
+		args...,
+	)
+}
+
+// getHTTPPathVariableProblem returns why the field path of a path variable cannot be bound
+// for the request message, or empty if it can be bound.
+//
+// Path variables must refer to singular fields, and all but the last field must be messages.
+// The last field must not be a message, unless it is a well-known type that has a string
+// representation, such as google.protobuf.Timestamp.
+//
+// Fields of messages that are not part of the files are not verified.
+func getHTTPPathVariableProblem(
+	message protosource.Message,
+	fieldPath string,
+	fullNameToMessage map[string]protosource.Message,
+) string {
+	names := strings.Split(fieldPath, ".")
+	for i, name := range names {
+		field := getFieldByName(message, name)
+		if field == nil {
+			return fmt.Sprintf("is not a field of %q", message.FullName())
+		}
+		if field.Label() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			return fmt.Sprintf("refers to repeated field %q", field.FullName())
+		}
+		isMessage := field.Type() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE ||
+			field.Type() == descriptorpb.FieldDescriptorProto_TYPE_GROUP
+		if i == len(names)-1 {
+			if isMessage && !strings.HasPrefix(field.TypeName(), "google.protobuf.") {
+				return fmt.Sprintf("refers to message field %q", field.FullName())
+			}
+			return ""
+		}
+		if !isMessage {
+			return fmt.Sprintf("refers to fields of non-message field %q", field.FullName())
+		}
+		nextMessage, ok := fullNameToMessage[field.TypeName()]
+		if !ok {
+			return ""
+		}
+		message = nextMessage
+	}
+	return ""
+}
+
+// getFieldByName returns the field of the message with the name, or nil.
+func getFieldByName(message protosource.Message, name string) protosource.Field {
+	for _, field := range message.Fields() {
+		if field.Name() == name {
+			return field
+		}
+	}
+	return nil
+}
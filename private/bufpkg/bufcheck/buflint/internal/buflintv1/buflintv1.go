@@ -28,6 +28,10 @@ import "github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 // The IMPORT_USED rule was added to BASIC, DEFAULT.
 // ENUM_FIRST_VALUE_ZERO was added to BASIC, DEFAULT.
 // PACKAGE_NO_IMPORT_CYCLE was added as an uncategorized lint rule.
+// DEPENDENCY_USED was added as an uncategorized lint rule.
+// HTTP_BODY, HTTP_PATH_SYNTAX, HTTP_PATH_VARIABLES, and HTTP_ROUTE_UNIQUE were
+// added to the new HTTP category.
+// IMPORT_NO_PUBLIC_FORWARD was added as an uncategorized lint rule.
 // The FIELD_NO_DESCRIPTOR rule was removed altogether.
 //
 // A number of categories were removed between v1beta1 and v1. The difference
@@ -49,6 +53,7 @@ import "github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
 //   - DEFAULT
 //   - COMMENTS
 //   - UNARY_RPC
+//   - HTTP
 //
 // The rules included in the MINIMAL lint category have also been adjusted.
 // The difference is shown below:
@@ -29,6 +29,7 @@ var (
 		buflintbuild.CommentOneofRuleBuilder,
 		buflintbuild.CommentRPCRuleBuilder,
 		buflintbuild.CommentServiceRuleBuilder,
+		buflintbuild.DependencyUsedRuleBuilder,
 		buflintbuild.DirectorySamePackageRuleBuilder,
 		buflintbuild.EnumFirstValueZeroRuleBuilder,
 		buflintbuild.EnumNoAllowAliasRuleBuilder,
@@ -36,9 +37,15 @@ var (
 		buflintbuild.EnumValuePrefixRuleBuilder,
 		buflintbuild.EnumValueUpperSnakeCaseRuleBuilder,
 		buflintbuild.EnumZeroValueSuffixRuleBuilder,
+		buflintbuild.ExtensionDeclarationValidRuleBuilder,
 		buflintbuild.FieldLowerSnakeCaseRuleBuilder,
 		buflintbuild.FileLowerSnakeCaseRuleBuilder,
+		buflintbuild.HTTPBodyRuleBuilder,
+		buflintbuild.HTTPPathSyntaxRuleBuilder,
+		buflintbuild.HTTPPathVariablesRuleBuilder,
+		buflintbuild.HTTPRouteUniqueRuleBuilder,
 		buflintbuild.ImportNoPublicRuleBuilder,
+		buflintbuild.ImportNoPublicForwardRuleBuilder,
 		buflintbuild.ImportNoWeakRuleBuilder,
 		buflintbuild.ImportUsedRuleBuilder,
 		buflintbuild.MessagePascalCaseRuleBuilder,
@@ -94,6 +101,7 @@ var (
 		"COMMENT_SERVICE": {
 			"COMMENTS",
 		},
+		"DEPENDENCY_USED": {},
 		"DIRECTORY_SAME_PACKAGE": {
 			"MINIMAL",
 			"BASIC",
@@ -121,6 +129,7 @@ var (
 		"ENUM_ZERO_VALUE_SUFFIX": {
 			"DEFAULT",
 		},
+		"EXTENSION_DECLARATION_VALID": {},
 		"FIELD_LOWER_SNAKE_CASE": {
 			"BASIC",
 			"DEFAULT",
@@ -128,10 +137,23 @@ var (
 		"FILE_LOWER_SNAKE_CASE": {
 			"DEFAULT",
 		},
+		"HTTP_BODY": {
+			"HTTP",
+		},
+		"HTTP_PATH_SYNTAX": {
+			"HTTP",
+		},
+		"HTTP_PATH_VARIABLES": {
+			"HTTP",
+		},
+		"HTTP_ROUTE_UNIQUE": {
+			"HTTP",
+		},
 		"IMPORT_NO_PUBLIC": {
 			"BASIC",
 			"DEFAULT",
 		},
+		"IMPORT_NO_PUBLIC_FORWARD": {},
 		"IMPORT_NO_WEAK": {
 			"BASIC",
 			"DEFAULT",
@@ -0,0 +1,218 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufoption verifies that the file options of Images match the values
+// that managed mode sets.
+//
+// Files that set their options by hand drift from the managed mode configuration
+// over time, for example when a go_package is copied from another module. Options
+// that are not set are not verified, as managed mode sets these on generation.
+package bufoption
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagemodify"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	imagev1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/image/v1"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const ruleIDSuffix = "_MATCHES_MODULE"
+
+var (
+	// AllRuleIDs are the IDs of all rules, sorted.
+	//
+	// Every rule verifies a single file option, and is named after the option,
+	// for example GO_PACKAGE_MATCHES_MODULE verifies the go_package option.
+	AllRuleIDs []string
+
+	fileOptionsDescriptor = (&descriptorpb.FileOptions{}).ProtoReflect().Descriptor()
+	// the file options that managed mode sets, with the locations of their values
+	optionNameToGetLocation = map[protoreflect.Name]func(protosource.File) protosource.Location{
+		"cc_enable_arenas":       protosource.File.CcEnableArenasLocation,
+		"csharp_namespace":       protosource.File.CsharpNamespaceLocation,
+		"go_package":             protosource.File.GoPackageLocation,
+		"java_multiple_files":    protosource.File.JavaMultipleFilesLocation,
+		"java_outer_classname":   protosource.File.JavaOuterClassnameLocation,
+		"java_package":           protosource.File.JavaPackageLocation,
+		"java_string_check_utf8": protosource.File.JavaStringCheckUtf8Location,
+		"objc_class_prefix":      protosource.File.ObjcClassPrefixLocation,
+		"optimize_for":           protosource.File.OptimizeForLocation,
+		"php_metadata_namespace": protosource.File.PhpMetadataNamespaceLocation,
+		"php_namespace":          protosource.File.PhpNamespaceLocation,
+		"ruby_package":           protosource.File.RubyPackageLocation,
+	}
+)
+
+func init() {
+	for optionName := range optionNameToGetLocation {
+		AllRuleIDs = append(AllRuleIDs, optionNameToRuleID(optionName))
+	}
+	AllRuleIDs = stringutil.SliceToUniqueSortedSlice(AllRuleIDs)
+}
+
+// VerifyImage verifies that the options of the non-import files of the Image
+// match the values that the managed mode Modifier sets.
+//
+// The ruleIDs are matched case-insensitively. If no ruleIDs are given, all rules
+// are used. The Image is not modified.
+func VerifyImage(
+	ctx context.Context,
+	image bufimage.Image,
+	modifier bufimagemodify.Modifier,
+	ruleIDs ...string,
+) ([]bufanalysis.FileAnnotation, error) {
+	optionNames, err := getOptionNames(ruleIDs)
+	if err != nil {
+		return nil, err
+	}
+	managedImage, err := bufimage.NewImageForProto(
+		proto.Clone(bufimage.ImageToProtoImage(image)).(*imagev1.Image),
+		bufimage.WithNoReparse(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := modifier.Modify(ctx, managedImage); err != nil {
+		return nil, err
+	}
+	files, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(image.Files())...)
+	if err != nil {
+		return nil, err
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, file := range files {
+		imageFile := image.GetFile(file.Path())
+		if imageFile == nil || imageFile.IsImport() {
+			continue
+		}
+		managedImageFile := managedImage.GetFile(file.Path())
+		if managedImageFile == nil {
+			// this should never happen, but just in case
+			return nil, fmt.Errorf("%s was not present in the managed Image", file.Path())
+		}
+		fileAnnotations = append(
+			fileAnnotations,
+			verifyFile(
+				file,
+				imageFile.Proto().GetOptions(),
+				managedImageFile.Proto().GetOptions(),
+				optionNames,
+			)...,
+		)
+	}
+	return bufanalysis.DeduplicateAndSortFileAnnotations(fileAnnotations), nil
+}
+
+func verifyFile(
+	file protosource.File,
+	options *descriptorpb.FileOptions,
+	managedOptions *descriptorpb.FileOptions,
+	optionNames []protoreflect.Name,
+) []bufanalysis.FileAnnotation {
+	if options == nil || managedOptions == nil {
+		return nil
+	}
+	var fileAnnotations []bufanalysis.FileAnnotation
+	for _, optionName := range optionNames {
+		fieldDescriptor := fileOptionsDescriptor.Fields().ByName(optionName)
+		if !options.ProtoReflect().Has(fieldDescriptor) || !managedOptions.ProtoReflect().Has(fieldDescriptor) {
+			continue
+		}
+		value := options.ProtoReflect().Get(fieldDescriptor)
+		managedValue := managedOptions.ProtoReflect().Get(fieldDescriptor)
+		if value.Interface() == managedValue.Interface() {
+			continue
+		}
+		fileAnnotations = append(
+			fileAnnotations,
+			newFileAnnotation(
+				file,
+				optionNameToGetLocation[optionName](file),
+				optionNameToRuleID(optionName),
+				fmt.Sprintf(
+					"Option %s is %s but managed mode sets it to %s.",
+					optionName,
+					valueString(fieldDescriptor, value),
+					valueString(fieldDescriptor, managedValue),
+				),
+			),
+		)
+	}
+	return fileAnnotations
+}
+
+// getOptionNames returns the option names of the rules, or of all rules
+// if no ruleIDs are given.
+func getOptionNames(ruleIDs []string) ([]protoreflect.Name, error) {
+	if len(ruleIDs) == 0 {
+		ruleIDs = AllRuleIDs
+	}
+	optionNames := make([]protoreflect.Name, 0, len(ruleIDs))
+	for _, ruleID := range stringutil.SliceToUniqueSortedSlice(ruleIDs) {
+		normalizedRuleID := strings.ToUpper(strings.TrimSpace(ruleID))
+		optionName := protoreflect.Name(strings.ToLower(strings.TrimSuffix(normalizedRuleID, ruleIDSuffix)))
+		if _, ok := optionNameToGetLocation[optionName]; !ok || !strings.HasSuffix(normalizedRuleID, ruleIDSuffix) {
+			return nil, fmt.Errorf("unknown rule %q, must be one of %s", ruleID, stringutil.SliceToString(AllRuleIDs))
+		}
+		optionNames = append(optionNames, optionName)
+	}
+	return optionNames, nil
+}
+
+func optionNameToRuleID(optionName protoreflect.Name) string {
+	return strings.ToUpper(string(optionName)) + ruleIDSuffix
+}
+
+func valueString(fieldDescriptor protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch fieldDescriptor.Kind() {
+	case protoreflect.StringKind:
+		return strconv.Quote(value.String())
+	case protoreflect.EnumKind:
+		if enumValueDescriptor := fieldDescriptor.Enum().Values().ByNumber(value.Enum()); enumValueDescriptor != nil {
+			return string(enumValueDescriptor.Name())
+		}
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+func newFileAnnotation(
+	file protosource.File,
+	location protosource.Location,
+	ruleID string,
+	message string,
+) bufanalysis.FileAnnotation {
+	if location == nil {
+		return bufanalysis.NewFileAnnotation(file, 0, 0, 0, 0, ruleID, message)
+	}
+	return bufanalysis.NewFileAnnotation(
+		file,
+		location.StartLine(),
+		location.StartColumn(),
+		location.EndLine(),
+		location.EndColumn(),
+		ruleID,
+		message,
+	)
+}
@@ -0,0 +1,77 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufoption_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis/bufanalysistesting"
+	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufoption"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagebuild/bufimagebuildtesting"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagemodify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestVerifyImage(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	image := bufimagebuildtesting.BuildImage(t, ctx, filepath.Join("testdata", "verify"))
+	modifier := testNewModifier(t)
+	fileAnnotations, err := bufoption.VerifyImage(ctx, image, modifier)
+	require.NoError(t, err)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			bufanalysistesting.NewFileAnnotation(t, "acme/weather/v1/weather.proto", 5, 1, 5, 69, "GO_PACKAGE_MATCHES_MODULE"),
+			bufanalysistesting.NewFileAnnotation(t, "acme/weather/v1/weather.proto", 8, 1, 8, 33, "OPTIMIZE_FOR_MATCHES_MODULE"),
+		},
+		fileAnnotations,
+	)
+	// The Image is not modified.
+	assert.Equal(
+		t,
+		"github.com/acme/gen/acme/billing/v1;billingv1",
+		image.GetFile("acme/weather/v1/weather.proto").Proto().GetOptions().GetGoPackage(),
+	)
+	fileAnnotations, err = bufoption.VerifyImage(ctx, image, modifier, "go_package_matches_module", "JAVA_PACKAGE_MATCHES_MODULE")
+	require.NoError(t, err)
+	bufanalysistesting.AssertFileAnnotationsEqual(
+		t,
+		[]bufanalysis.FileAnnotation{
+			bufanalysistesting.NewFileAnnotation(t, "acme/weather/v1/weather.proto", 5, 1, 5, 69, "GO_PACKAGE_MATCHES_MODULE"),
+		},
+		fileAnnotations,
+	)
+	_, err = bufoption.VerifyImage(ctx, image, modifier, "GO_PACKAGE")
+	assert.Error(t, err)
+}
+
+func testNewModifier(t *testing.T) bufimagemodify.Modifier {
+	goPackageModifier, err := bufimagemodify.GoPackage(zap.NewNop(), nil, "github.com/acme/gen", nil, nil, nil)
+	require.NoError(t, err)
+	javaPackageModifier, err := bufimagemodify.JavaPackage(zap.NewNop(), nil, "com", nil, nil, nil)
+	require.NoError(t, err)
+	optimizeForModifier, err := bufimagemodify.OptimizeFor(zap.NewNop(), nil, descriptorpb.FileOptions_SPEED, nil, nil, nil)
+	require.NoError(t, err)
+	return bufimagemodify.NewMultiModifier(goPackageModifier, javaPackageModifier, optimizeForModifier)
+}
@@ -24,8 +24,10 @@ import (
 )
 
 const (
-	defaultEnumZeroValueSuffix = "_UNSPECIFIED"
-	defaultServiceSuffix       = "Service"
+	// DefaultEnumZeroValueSuffix is the enum zero value suffix used if none is configured.
+	DefaultEnumZeroValueSuffix = "_UNSPECIFIED"
+	// DefaultServiceSuffix is the service suffix used if none is configured.
+	DefaultServiceSuffix = "Service"
 )
 
 // Config is the check config.
@@ -59,6 +61,20 @@ type ConfigBuilder struct {
 	RPCAllowGoogleProtobufEmptyRequests  bool
 	RPCAllowGoogleProtobufEmptyResponses bool
 	ServiceSuffix                        string
+
+	// FrozenOptions are the fully-qualified names of the options that mark messages and
+	// enums as frozen.
+	FrozenOptions []string
+	// AppendOnlyOptions are the fully-qualified names of the options that mark messages
+	// and enums as append-only.
+	AppendOnlyOptions []string
+
+	// DependencyModuleIdentityStrings are the identity strings of the dependencies
+	// declared by the module being checked.
+	DependencyModuleIdentityStrings []string
+	// ImportPathToModuleIdentityString maps the paths of imported files to the
+	// identity string of the module each file came from.
+	ImportPathToModuleIdentityString map[string]string
 }
 
 // NewConfig returns a new Config.
@@ -74,10 +90,10 @@ func newConfig(configBuilder ConfigBuilder, versionSpec *VersionSpec) (*Config,
 		configBuilder.Use = versionSpec.DefaultCategories
 	}
 	if configBuilder.EnumZeroValueSuffix == "" {
-		configBuilder.EnumZeroValueSuffix = defaultEnumZeroValueSuffix
+		configBuilder.EnumZeroValueSuffix = DefaultEnumZeroValueSuffix
 	}
 	if configBuilder.ServiceSuffix == "" {
-		configBuilder.ServiceSuffix = defaultServiceSuffix
+		configBuilder.ServiceSuffix = DefaultServiceSuffix
 	}
 	return newConfigForRuleBuilders(
 		configBuilder,
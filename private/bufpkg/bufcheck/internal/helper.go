@@ -51,6 +51,7 @@ func (h *Helper) AddFileAnnotationf(
 		nil,
 		location,
 		nil,
+		nil,
 		format,
 		args...,
 	)
@@ -74,6 +75,7 @@ func (h *Helper) AddFileAnnotationWithExtraIgnoreDescriptorsf(
 		extraIgnoreDescriptors,
 		location,
 		nil,
+		nil,
 		format,
 		args...,
 	)
@@ -97,6 +99,35 @@ func (h *Helper) AddFileAnnotationWithExtraIgnoreLocationsf(
 		nil,
 		location,
 		extraIgnoreLocations,
+		nil,
+		format,
+		args...,
+	)
+}
+
+// AddFileAnnotationWithExtraIgnoresAndOptionsf adds a FileAnnotation with the id as the Type.
+//
+// extraIgnoreDescriptors are extra desciptors to check for ignores.
+// extraIgnoreLocations are extra locations to check for comment ignores.
+// options are applied to the FileAnnotation.
+//
+// If descriptor is nil, no filename information is added.
+// If location is nil, no line or column information will be added.
+func (h *Helper) AddFileAnnotationWithExtraIgnoresAndOptionsf(
+	descriptor protosource.Descriptor,
+	extraIgnoreDescriptors []protosource.Descriptor,
+	location protosource.Location,
+	extraIgnoreLocations []protosource.Location,
+	options []bufanalysis.FileAnnotationOption,
+	format string,
+	args ...interface{},
+) {
+	h.addFileAnnotationf(
+		descriptor,
+		extraIgnoreDescriptors,
+		location,
+		extraIgnoreLocations,
+		options,
 		format,
 		args...,
 	)
@@ -107,6 +138,7 @@ func (h *Helper) addFileAnnotationf(
 	extraIgnoreDescriptors []protosource.Descriptor,
 	location protosource.Location,
 	extraIgnoreLocations []protosource.Location,
+	options []bufanalysis.FileAnnotationOption,
 	format string,
 	args ...interface{},
 ) {
@@ -123,6 +155,7 @@ func (h *Helper) addFileAnnotationf(
 			h.id,
 			descriptor,
 			location,
+			options,
 			format,
 			args...,
 		),
@@ -142,6 +175,7 @@ func newFileAnnotationf(
 	id string,
 	descriptor protosource.Descriptor,
 	location protosource.Location,
+	options []bufanalysis.FileAnnotationOption,
 	format string,
 	args ...interface{},
 ) bufanalysis.FileAnnotation {
@@ -167,5 +201,6 @@ func newFileAnnotationf(
 		endColumn,
 		id,
 		fmt.Sprintf(format, args...),
+		options...,
 	)
 }
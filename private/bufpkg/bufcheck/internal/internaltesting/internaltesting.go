@@ -18,16 +18,79 @@ import (
 	"testing"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/internal"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+// ExtensionDeclaration is an extension declaration for testing.
+type ExtensionDeclaration struct {
+	Number   int32
+	FullName string
+	Type     string
+	Reserved bool
+	Repeated bool
+}
+
 // RunTestVersionSpec tests the VersionSpec.
 func RunTestVersionSpec(t *testing.T, versionSpec *internal.VersionSpec) {
 	runTestDefaultConfigBuilder(t, versionSpec)
 	runTestRuleBuilders(t, versionSpec)
 }
 
+// SetExtensionDeclarations sets the declarations on the first extension range
+// of the top-level message with the given name.
+//
+// The declarations are encoded as unknown fields of the ExtensionRangeOptions,
+// as the descriptor.proto bundled with buf predates extension declarations.
+func SetExtensionDeclarations(
+	t testing.TB,
+	image bufimage.Image,
+	messageName string,
+	declarations ...ExtensionDeclaration,
+) {
+	var data []byte
+	for _, declaration := range declarations {
+		var declarationData []byte
+		declarationData = protowire.AppendTag(declarationData, 1, protowire.VarintType)
+		declarationData = protowire.AppendVarint(declarationData, uint64(declaration.Number))
+		if declaration.FullName != "" {
+			declarationData = protowire.AppendTag(declarationData, 2, protowire.BytesType)
+			declarationData = protowire.AppendString(declarationData, declaration.FullName)
+		}
+		if declaration.Type != "" {
+			declarationData = protowire.AppendTag(declarationData, 3, protowire.BytesType)
+			declarationData = protowire.AppendString(declarationData, declaration.Type)
+		}
+		if declaration.Reserved {
+			declarationData = protowire.AppendTag(declarationData, 5, protowire.VarintType)
+			declarationData = protowire.AppendVarint(declarationData, 1)
+		}
+		if declaration.Repeated {
+			declarationData = protowire.AppendTag(declarationData, 6, protowire.VarintType)
+			declarationData = protowire.AppendVarint(declarationData, 1)
+		}
+		data = protowire.AppendTag(data, 2, protowire.BytesType)
+		data = protowire.AppendBytes(data, declarationData)
+	}
+	for _, imageFile := range image.Files() {
+		for _, descriptorProto := range imageFile.Proto().GetMessageType() {
+			if descriptorProto.GetName() != messageName {
+				continue
+			}
+			require.NotEmpty(t, descriptorProto.GetExtensionRange())
+			options := &descriptorpb.ExtensionRangeOptions{}
+			options.ProtoReflect().SetUnknown(data)
+			descriptorProto.GetExtensionRange()[0].Options = options
+			return
+		}
+	}
+	require.Failf(t, "message not found", "%s", messageName)
+}
+
 func runTestDefaultConfigBuilder(t *testing.T, versionSpec *internal.VersionSpec) {
 	_, err := internal.ConfigBuilder{}.NewConfig(versionSpec)
 	assert.NoError(t, err)
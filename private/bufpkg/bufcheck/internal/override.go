@@ -0,0 +1,201 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sort"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+)
+
+// FileAnnotationsForOverride returns the FileAnnotations that are within the scope of the
+// override root path.
+//
+// A FileAnnotation is within the scope of the most specific of the override root paths that
+// equals or contains the path of its file. The empty override root path is the scope of the
+// top-level config, which contains all FileAnnotations that are not within the scope of any
+// override root path, including FileAnnotations without file information.
+//
+// The override root paths are expected to be normalized and validated.
+func FileAnnotationsForOverride(
+	fileAnnotations []bufanalysis.FileAnnotation,
+	overrideRootPaths map[string]struct{},
+	overrideRootPath string,
+) []bufanalysis.FileAnnotation {
+	var scopedFileAnnotations []bufanalysis.FileAnnotation
+	for _, fileAnnotation := range fileAnnotations {
+		if overrideRootPathForFileAnnotation(fileAnnotation, overrideRootPaths) == overrideRootPath {
+			scopedFileAnnotations = append(scopedFileAnnotations, fileAnnotation)
+		}
+	}
+	return scopedFileAnnotations
+}
+
+// FilesForOverride returns the Files that are within the scope of the override root path.
+//
+// A File is within the scope of the most specific of the override root paths that equals
+// or contains its path. The empty override root path is the scope of the top-level config,
+// which contains all Files that are not within the scope of any override root path.
+//
+// The override root paths are expected to be normalized and validated.
+func FilesForOverride(
+	files []protosource.File,
+	overrideRootPaths map[string]struct{},
+	overrideRootPath string,
+) []protosource.File {
+	var scopedFiles []protosource.File
+	for _, file := range files {
+		if overrideRootPathForPath(file.Path(), overrideRootPaths) == overrideRootPath {
+			scopedFiles = append(scopedFiles, file)
+		}
+	}
+	return scopedFiles
+}
+
+func overrideRootPathForFileAnnotation(
+	fileAnnotation bufanalysis.FileAnnotation,
+	overrideRootPaths map[string]struct{},
+) string {
+	fileInfo := fileAnnotation.FileInfo()
+	if fileInfo == nil {
+		return ""
+	}
+	return overrideRootPathForPath(fileInfo.Path(), overrideRootPaths)
+}
+
+func overrideRootPathForPath(path string, overrideRootPaths map[string]struct{}) string {
+	// These are sorted, so the most specific path is last.
+	matchingRootPaths := normalpath.MapAllEqualOrContainingPaths(overrideRootPaths, path, normalpath.Relative)
+	if len(matchingRootPaths) == 0 {
+		return ""
+	}
+	return matchingRootPaths[len(matchingRootPaths)-1]
+}
+
+// MergeIgnoreRootPaths returns the ignore root paths of a config with the ignore root
+// paths of an override added, without duplicates.
+//
+// Neither slice is modified.
+func MergeIgnoreRootPaths(ignoreRootPaths []string, overrideIgnoreRootPaths []string) []string {
+	if len(overrideIgnoreRootPaths) == 0 {
+		return ignoreRootPaths
+	}
+	return appendMissing(append([]string(nil), ignoreRootPaths...), overrideIgnoreRootPaths)
+}
+
+// MergeIgnoreIDOrCategoryToRootPaths returns the rule and/or category IDs to ignore root
+// paths map of a config with the map of an override added, without duplicates.
+//
+// Neither map is modified.
+func MergeIgnoreIDOrCategoryToRootPaths(
+	ignoreIDOrCategoryToRootPaths map[string][]string,
+	overrideIgnoreIDOrCategoryToRootPaths map[string][]string,
+) map[string][]string {
+	if len(overrideIgnoreIDOrCategoryToRootPaths) == 0 {
+		return ignoreIDOrCategoryToRootPaths
+	}
+	merged := make(map[string][]string, len(ignoreIDOrCategoryToRootPaths)+len(overrideIgnoreIDOrCategoryToRootPaths))
+	for idOrCategory, rootPaths := range ignoreIDOrCategoryToRootPaths {
+		merged[idOrCategory] = append([]string(nil), rootPaths...)
+	}
+	for idOrCategory, rootPaths := range overrideIgnoreIDOrCategoryToRootPaths {
+		merged[idOrCategory] = appendMissing(merged[idOrCategory], rootPaths)
+	}
+	return merged
+}
+
+// IgnoreIDOrCategoryToRootPathsForRootPathToIDOrCategories returns the rule and/or category
+// IDs to ignore root paths map for a map of ignore root paths to rule and/or category IDs,
+// such as the one of ignore_only_by_path.
+//
+// The root paths of each rule and/or category ID are sorted.
+func IgnoreIDOrCategoryToRootPathsForRootPathToIDOrCategories(rootPathToIDOrCategories map[string][]string) map[string][]string {
+	if len(rootPathToIDOrCategories) == 0 {
+		return nil
+	}
+	// Iterate over the paths in order so that the root paths are deterministic.
+	rootPaths := make([]string, 0, len(rootPathToIDOrCategories))
+	for rootPath := range rootPathToIDOrCategories {
+		rootPaths = append(rootPaths, rootPath)
+	}
+	sort.Strings(rootPaths)
+	ignoreIDOrCategoryToRootPaths := make(map[string][]string)
+	for _, rootPath := range rootPaths {
+		for _, idOrCategory := range rootPathToIDOrCategories[rootPath] {
+			ignoreIDOrCategoryToRootPaths[idOrCategory] = appendMissing(ignoreIDOrCategoryToRootPaths[idOrCategory], []string{rootPath})
+		}
+	}
+	return ignoreIDOrCategoryToRootPaths
+}
+
+// IgnoreRootPathsDiff returns the ignore root paths of the override config that are not
+// ignore root paths of the config, that is the inverse of MergeIgnoreRootPaths.
+func IgnoreRootPathsDiff(ignoreRootPaths []string, overrideIgnoreRootPaths []string) []string {
+	return appendMissing(nil, subtract(overrideIgnoreRootPaths, ignoreRootPaths))
+}
+
+// IgnoreIDOrCategoryToRootPathsDiff returns the entries of the rule and/or category IDs to
+// ignore root paths map of the override config that are not in the map of the config, that
+// is the inverse of MergeIgnoreIDOrCategoryToRootPaths.
+func IgnoreIDOrCategoryToRootPathsDiff(
+	ignoreIDOrCategoryToRootPaths map[string][]string,
+	overrideIgnoreIDOrCategoryToRootPaths map[string][]string,
+) map[string][]string {
+	var diff map[string][]string
+	for idOrCategory, rootPaths := range overrideIgnoreIDOrCategoryToRootPaths {
+		diffRootPaths := subtract(rootPaths, ignoreIDOrCategoryToRootPaths[idOrCategory])
+		if len(diffRootPaths) == 0 {
+			continue
+		}
+		if diff == nil {
+			diff = make(map[string][]string)
+		}
+		diff[idOrCategory] = diffRootPaths
+	}
+	return diff
+}
+
+// appendMissing appends the values that are not already in the slice.
+func appendMissing(slice []string, values []string) []string {
+	set := make(map[string]struct{}, len(slice)+len(values))
+	for _, value := range slice {
+		set[value] = struct{}{}
+	}
+	for _, value := range values {
+		if _, ok := set[value]; ok {
+			continue
+		}
+		set[value] = struct{}{}
+		slice = append(slice, value)
+	}
+	return slice
+}
+
+// subtract returns the values of one that are not in two.
+func subtract(one []string, two []string) []string {
+	twoSet := make(map[string]struct{}, len(two))
+	for _, value := range two {
+		twoSet[value] = struct{}{}
+	}
+	var result []string
+	for _, value := range one {
+		if _, ok := twoSet[value]; !ok {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -116,7 +116,6 @@ func (r *Runner) newIgnoreFunc(config *Config) IgnoreFunc {
 			return true
 		}
 		// if ignorePrefix is empty, comment ignores are not enabled for the runner
-		// this is the case with breaking changes
 		if r.ignorePrefix != "" && config.AllowCommentIgnores &&
 			locationsAreIgnored(id, r.ignorePrefix, locations, config) {
 			return true
@@ -0,0 +1,57 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+)
+
+// CheckWithLazySourceCodeInfo runs check, and only loads the lazy SourceCodeInfo of
+// the files of the image that have FileAnnotations.
+//
+// check must not depend on SourceCodeInfo other than to add locations to FileAnnotations
+// and to resolve comment ignores. Comment ignores can only remove FileAnnotations, so check
+// is first run without the lazy SourceCodeInfo, and if this loads the SourceCodeInfo of any
+// of the files with FileAnnotations, check is run again to get the final FileAnnotations.
+func CheckWithLazySourceCodeInfo(
+	ctx context.Context,
+	image bufimage.Image,
+	check func(context.Context) ([]bufanalysis.FileAnnotation, error),
+) ([]bufanalysis.FileAnnotation, error) {
+	fileAnnotations, err := check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(fileAnnotations))
+	for _, fileAnnotation := range fileAnnotations {
+		if fileInfo := fileAnnotation.FileInfo(); fileInfo != nil {
+			paths = append(paths, fileInfo.Path())
+		}
+	}
+	if len(paths) == 0 {
+		return fileAnnotations, nil
+	}
+	loaded, err := bufimage.LoadSourceCodeInfoForPaths(ctx, image, paths)
+	if err != nil {
+		return nil, err
+	}
+	if !loaded {
+		return fileAnnotations, nil
+	}
+	return check(ctx)
+}
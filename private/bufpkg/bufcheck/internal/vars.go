@@ -21,11 +21,13 @@ var topLevelCategoryToPriority = map[string]int{
 	"DEFAULT":   3,
 	"COMMENTS":  4,
 	"UNARY_RPC": 5,
-	"OTHER":     6,
+	"HTTP":      6,
+	"OTHER":     7,
 	"FILE":      1,
 	"PACKAGE":   2,
 	"WIRE_JSON": 3,
 	"WIRE":      4,
+	"JSON":      5,
 }
 
 func categoryLess(one string, two string) bool {
@@ -21,8 +21,10 @@ import (
 
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
+	"github.com/bufbuild/buf/private/bufpkg/buflicense"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/bufowner"
 	"github.com/bufbuild/buf/private/pkg/storage"
 )
 
@@ -39,6 +41,21 @@ const (
 	// V1Beta1Version is the v1beta1 version.
 	V1Beta1Version = "v1beta1"
 
+	// FormatCommentStyleLine is the comment style of line comments, i.e. "// comment".
+	FormatCommentStyleLine = "line"
+
+	// FormatCommentStyleBlock is the comment style of block comments, i.e. "/* comment */".
+	FormatCommentStyleBlock = "block"
+
+	// FormatBlankLinesCanonical collapses consecutive blank lines into a single blank line.
+	FormatBlankLinesCanonical = "canonical"
+
+	// FormatBlankLinesPreserve preserves blank lines as written.
+	FormatBlankLinesPreserve = "preserve"
+
+	// DefaultFormatCommentColumnLimit is the default column limit that comments are reflowed to.
+	DefaultFormatCommentColumnLimit = 100
+
 	// backupExternalConfigV1FilePath is another acceptable configuration file path for v1.
 	//
 	// Originally we thought we were going to move to buf.mod, and had this around for
@@ -70,20 +87,104 @@ type Config struct {
 	Build          *bufmoduleconfig.Config
 	Breaking       *bufbreakingconfig.Config
 	Lint           *buflintconfig.Config
+	// DocsDirectory is the directory of additional documentation pages, relative
+	// to the root of the module. Empty if not set.
+	//
+	// Normalized and validated.
+	DocsDirectory string
+	// Licenses is the license policy for the dependencies of the module.
+	//
+	// This may be nil if no license policy is configured.
+	Licenses *buflicense.Config
+	// Owners is the ownership of the files of the module.
+	//
+	// This may be nil if no owners are configured.
+	Owners *bufowner.Config
+	// Format is the configuration of buf format for the module.
+	//
+	// This may be nil if no format options are configured.
+	Format *FormatConfig
+}
+
+// FormatConfig is the configuration of buf format.
+type FormatConfig struct {
+	// CommentColumnLimit is the column limit that long comment paragraphs are
+	// reflowed to. Zero if comments are not reflowed.
+	CommentColumnLimit int
+	// CommentStyle is the style that comments are normalized to, one of
+	// FormatCommentStyleLine or FormatCommentStyleBlock. Empty if the style of
+	// comments is preserved.
+	CommentStyle string
+	// AlignTrailingComments says to align the trailing comments of consecutive lines.
+	AlignTrailingComments bool
+	// PreserveBlankLines says to preserve the blank lines between declarations
+	// as written instead of collapsing them.
+	PreserveBlankLines bool
 }
 
 // GetConfigForBucket gets the Config for the YAML data at ConfigFilePath.
 //
 // If the data is of length 0, returns the default config.
 func GetConfigForBucket(ctx context.Context, readBucket storage.ReadBucket) (*Config, error) {
-	return getConfigForBucket(ctx, readBucket)
+	return getConfigForBucket(ctx, readBucket, nil)
 }
 
 // GetConfigForData gets the Config for the given JSON or YAML data.
 //
 // If the data is of length 0, returns the default config.
 func GetConfigForData(ctx context.Context, data []byte) (*Config, error) {
-	return getConfigForData(ctx, data)
+	return getConfigForData(ctx, data, nil)
+}
+
+// Dependency is a dependency in the deps of a configuration file.
+type Dependency struct {
+	// Value is the dependency as written in the configuration file.
+	//
+	// It may reference environment variables.
+	Value string
+	// ModuleReference is the ModuleReference that Value expands to.
+	ModuleReference bufmoduleref.ModuleReference
+}
+
+// UpdateDependencies updates the deps of the configuration file at configFilePath.
+//
+// update is called with the current deps, with references to environment variables
+// expanded with lookupEnv, and returns the values of the new deps. The deps that are
+// kept should be returned with their Value as is, so that their references to
+// environment variables are preserved.
+//
+// The rest of the configuration file, including comments, is preserved, and the
+// configuration file is only written if the deps changed. Only YAML configuration
+// files are supported.
+//
+// Returns true if the configuration file was written.
+func UpdateDependencies(
+	ctx context.Context,
+	readWriteBucket storage.ReadWriteBucket,
+	configFilePath string,
+	lookupEnv func(string) (string, bool),
+	update func([]Dependency) ([]string, error),
+) (bool, error) {
+	return updateDependencies(ctx, readWriteBucket, configFilePath, lookupEnv, update)
+}
+
+// RenameModuleIdentity sets the name of the configuration file at configFilePath to
+// newModuleIdentity if it is moduleIdentity, with references to environment variables
+// expanded with lookupEnv.
+//
+// The rest of the configuration file, including comments, is preserved. Only YAML
+// configuration files are supported.
+//
+// Returns true if the configuration file was written.
+func RenameModuleIdentity(
+	ctx context.Context,
+	readWriteBucket storage.ReadWriteBucket,
+	configFilePath string,
+	lookupEnv func(string) (string, bool),
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	newModuleIdentity bufmoduleref.ModuleIdentity,
+) (bool, error) {
+	return renameModuleIdentity(ctx, readWriteBucket, configFilePath, lookupEnv, moduleIdentity, newModuleIdentity)
 }
 
 // WriteConfig writes an initial configuration file into the bucket.
@@ -163,6 +264,16 @@ func WriteConfigWithLintConfig(lintConfig *buflintconfig.Config) WriteConfigOpti
 	}
 }
 
+// WriteConfigWithDocsDirectory returns a new WriteConfigOption that sets the directory of
+// additional documentation pages for the module.
+//
+// The default is to not set a docs directory. This is only valid for v1.
+func WriteConfigWithDocsDirectory(docsDirectory string) WriteConfigOption {
+	return func(writeConfigOptions *writeConfigOptions) {
+		writeConfigOptions.docsDirectory = docsDirectory
+	}
+}
+
 // WriteConfigWithVersion returns a new WriteConfigOption that sets the version of the config
 // being written.
 //
@@ -175,6 +286,11 @@ func WriteConfigWithVersion(version string) WriteConfigOption {
 
 // ReadConfigOS reads the configuration from the OS or an override, if any.
 //
+// References to environment variables of the form ${KEY} within configuration
+// values are expanded from the environment of the process. It is an error to
+// reference an environment variable that is not set, unless a default is given
+// with ${KEY:-default}.
+//
 // ONLY USE IN CLI TOOLS.
 func ReadConfigOS(
 	ctx context.Context,
@@ -251,6 +367,46 @@ type ExternalConfigV1 struct {
 	Build    bufmoduleconfig.ExternalConfigV1   `json:"build,omitempty" yaml:"build,omitempty"`
 	Breaking bufbreakingconfig.ExternalConfigV1 `json:"breaking,omitempty" yaml:"breaking,omitempty"`
 	Lint     buflintconfig.ExternalConfigV1     `json:"lint,omitempty" yaml:"lint,omitempty"`
+	Docs     ExternalDocsConfigV1               `json:"docs,omitempty" yaml:"docs,omitempty"`
+	Licenses buflicense.ExternalConfigV1        `json:"licenses,omitempty" yaml:"licenses,omitempty"`
+	Owners   []bufowner.ExternalRuleV1          `json:"owners,omitempty" yaml:"owners,omitempty"`
+	Format   ExternalFormatConfigV1             `json:"format,omitempty" yaml:"format,omitempty"`
+}
+
+// ExternalDocsConfigV1 represents the on-disk representation of the
+// documentation configuration at version v1.
+type ExternalDocsConfigV1 struct {
+	// Directory is the directory of additional documentation pages, such as
+	// markdown files and images, relative to the root of the module.
+	Directory string `json:"directory,omitempty" yaml:"directory,omitempty"`
+}
+
+// ExternalFormatConfigV1 represents the on-disk representation of the
+// buf format configuration at version v1.
+type ExternalFormatConfigV1 struct {
+	Comments ExternalFormatCommentsConfigV1 `json:"comments,omitempty" yaml:"comments,omitempty"`
+	// BlankLines is how blank lines are normalized, either "canonical" or "preserve".
+	BlankLines string `json:"blank_lines,omitempty" yaml:"blank_lines,omitempty"`
+}
+
+// IsEmpty returns true if the config is empty.
+func (e ExternalFormatConfigV1) IsEmpty() bool {
+	return e.Comments == ExternalFormatCommentsConfigV1{} && e.BlankLines == ""
+}
+
+// ExternalFormatCommentsConfigV1 represents the on-disk representation of the
+// comment formatting configuration at version v1.
+type ExternalFormatCommentsConfigV1 struct {
+	// Reflow says to reflow the paragraphs of line comments that exceed the
+	// column limit.
+	Reflow bool `json:"reflow,omitempty" yaml:"reflow,omitempty"`
+	// ColumnLimit is the column limit for Reflow. Defaults to
+	// DefaultFormatCommentColumnLimit.
+	ColumnLimit int `json:"column_limit,omitempty" yaml:"column_limit,omitempty"`
+	// Style is the style to normalize comments to, either "line" or "block".
+	Style string `json:"style,omitempty" yaml:"style,omitempty"`
+	// AlignTrailing says to align the trailing comments of consecutive lines.
+	AlignTrailing bool `json:"align_trailing,omitempty" yaml:"align_trailing,omitempty"`
 }
 
 // ExternalConfigVersion defines the subset of all config
@@ -15,10 +15,17 @@
 package bufconfig
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
+	"github.com/bufbuild/buf/private/bufpkg/buflicense"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/bufowner"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
 )
 
 func newConfigV1Beta1(externalConfig ExternalConfigV1Beta1) (*Config, error) {
@@ -54,11 +61,128 @@ func newConfigV1(externalConfig ExternalConfigV1) (*Config, error) {
 			return nil, err
 		}
 	}
+	var docsDirectory string
+	if externalConfig.Docs.Directory != "" {
+		docsDirectory, err = normalpath.NormalizeAndValidate(externalConfig.Docs.Directory)
+		if err != nil {
+			return nil, fmt.Errorf("docs directory %q is invalid: %w", externalConfig.Docs.Directory, err)
+		}
+		if docsDirectory == "." {
+			return nil, errors.New("docs directory cannot be the root of the module")
+		}
+	}
+	for _, plugin := range externalConfig.Breaking.Plugins {
+		if plugin.Plugin == "" {
+			return nil, errors.New("breaking plugin is required for each entry in breaking plugins")
+		}
+	}
+	breakingOverrideRootPaths := make([]string, 0, len(externalConfig.Breaking.Overrides))
+	for rootPath := range externalConfig.Breaking.Overrides {
+		breakingOverrideRootPaths = append(breakingOverrideRootPaths, rootPath)
+	}
+	if err := validateOverrideRootPaths("breaking", breakingOverrideRootPaths); err != nil {
+		return nil, err
+	}
+	lintOverrideRootPaths := make([]string, 0, len(externalConfig.Lint.Overrides))
+	for rootPath := range externalConfig.Lint.Overrides {
+		lintOverrideRootPaths = append(lintOverrideRootPaths, rootPath)
+	}
+	if err := validateOverrideRootPaths("lint", lintOverrideRootPaths); err != nil {
+		return nil, err
+	}
+	licenseConfig, err := buflicense.NewConfigV1(externalConfig.Licenses)
+	if err != nil {
+		return nil, fmt.Errorf("invalid licenses: %w", err)
+	}
+	ownerConfig, err := bufowner.NewConfigV1(externalConfig.Owners)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owners: %w", err)
+	}
+	formatConfig, err := newFormatConfigV1(externalConfig.Format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format: %w", err)
+	}
 	return &Config{
 		Version:        V1Version,
 		ModuleIdentity: moduleIdentity,
 		Build:          buildConfig,
 		Breaking:       bufbreakingconfig.NewConfigV1(externalConfig.Breaking),
 		Lint:           buflintconfig.NewConfigV1(externalConfig.Lint),
+		DocsDirectory:  docsDirectory,
+		Licenses:       licenseConfig,
+		Owners:         ownerConfig,
+		Format:         formatConfig,
+	}, nil
+}
+
+// newFormatConfigV1 returns the FormatConfig for the given external config,
+// or nil if it is empty.
+func newFormatConfigV1(externalConfig ExternalFormatConfigV1) (*FormatConfig, error) {
+	if externalConfig.IsEmpty() {
+		return nil, nil
+	}
+	comments := externalConfig.Comments
+	var commentColumnLimit int
+	switch {
+	case comments.ColumnLimit < 0:
+		return nil, fmt.Errorf("comments column_limit must be positive, got %d", comments.ColumnLimit)
+	case comments.ColumnLimit > 0 && !comments.Reflow:
+		return nil, errors.New("comments column_limit can only be set if reflow is enabled")
+	case comments.ColumnLimit > 0:
+		commentColumnLimit = comments.ColumnLimit
+	case comments.Reflow:
+		commentColumnLimit = DefaultFormatCommentColumnLimit
+	}
+	switch comments.Style {
+	case "", FormatCommentStyleLine, FormatCommentStyleBlock:
+	default:
+		return nil, fmt.Errorf(
+			"unknown comments style %q, must be one of %q or %q",
+			comments.Style,
+			FormatCommentStyleLine,
+			FormatCommentStyleBlock,
+		)
+	}
+	var preserveBlankLines bool
+	switch externalConfig.BlankLines {
+	case "", FormatBlankLinesCanonical:
+	case FormatBlankLinesPreserve:
+		preserveBlankLines = true
+	default:
+		return nil, fmt.Errorf(
+			"unknown blank_lines %q, must be one of %q or %q",
+			externalConfig.BlankLines,
+			FormatBlankLinesCanonical,
+			FormatBlankLinesPreserve,
+		)
+	}
+	return &FormatConfig{
+		CommentColumnLimit:    commentColumnLimit,
+		CommentStyle:          comments.Style,
+		AlignTrailingComments: comments.AlignTrailing,
+		PreserveBlankLines:    preserveBlankLines,
 	}, nil
 }
+
+// validateOverrideRootPaths validates the root paths of the overrides of the given section.
+//
+// The root paths must be relative, must not be the root of the module, and must be unique
+// once normalized.
+func validateOverrideRootPaths(section string, rootPaths []string) error {
+	sort.Strings(rootPaths)
+	normalizedRootPaths := make(map[string]struct{}, len(rootPaths))
+	for _, rootPath := range rootPaths {
+		normalizedRootPath, err := normalpath.NormalizeAndValidate(rootPath)
+		if err != nil {
+			return fmt.Errorf("%s override path %q is invalid: %w", section, rootPath, err)
+		}
+		if normalizedRootPath == "." {
+			return fmt.Errorf("%s override path %q cannot be the root of the module, set the options directly in the %s section instead", section, rootPath, section)
+		}
+		if _, ok := normalizedRootPaths[normalizedRootPath]; ok {
+			return fmt.Errorf("duplicate %s override path %q", section, normalizedRootPath)
+		}
+		normalizedRootPaths[normalizedRootPath] = struct{}{}
+	}
+	return nil
+}
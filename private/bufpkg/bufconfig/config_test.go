@@ -0,0 +1,80 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfigForDataFormat(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	config, err := GetConfigForData(ctx, []byte(`version: v1
+`))
+	require.NoError(t, err)
+	assert.Nil(t, config.Format)
+	config, err = GetConfigForData(ctx, []byte(`version: v1
+format:
+  comments:
+    reflow: true
+    style: line
+    align_trailing: true
+`))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		&FormatConfig{
+			CommentColumnLimit:    DefaultFormatCommentColumnLimit,
+			CommentStyle:          FormatCommentStyleLine,
+			AlignTrailingComments: true,
+		},
+		config.Format,
+	)
+	config, err = GetConfigForData(ctx, []byte(`version: v1
+format:
+  comments:
+    reflow: true
+    column_limit: 80
+`))
+	require.NoError(t, err)
+	assert.Equal(t, &FormatConfig{CommentColumnLimit: 80}, config.Format)
+	config, err = GetConfigForData(ctx, []byte(`version: v1
+format:
+  blank_lines: preserve
+`))
+	require.NoError(t, err)
+	assert.Equal(t, &FormatConfig{PreserveBlankLines: true}, config.Format)
+	_, err = GetConfigForData(ctx, []byte(`version: v1
+format:
+  comments:
+    column_limit: 80
+`))
+	assert.Error(t, err)
+	_, err = GetConfigForData(ctx, []byte(`version: v1
+format:
+  comments:
+    style: javadoc
+`))
+	assert.Error(t, err)
+	_, err = GetConfigForData(ctx, []byte(`version: v1
+format:
+  blank_lines: none
+`))
+	assert.Error(t, err)
+}
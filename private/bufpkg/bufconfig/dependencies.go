@@ -0,0 +1,196 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconfig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/encoding"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
+)
+
+const dependenciesKey = "deps"
+
+// externalDependencies is the part of an external configuration that is
+// common to all versions and contains the deps.
+type externalDependencies struct {
+	Deps []string `json:"deps,omitempty" yaml:"deps,omitempty"`
+}
+
+func updateDependencies(
+	ctx context.Context,
+	readWriteBucket storage.ReadWriteBucket,
+	configFilePath string,
+	lookupEnv func(string) (string, bool),
+	update func([]Dependency) ([]string, error),
+) (bool, error) {
+	data, err := storage.ReadPath(ctx, readWriteBucket, configFilePath)
+	if err != nil {
+		return false, err
+	}
+	dependencies, err := getDependencies(data, lookupEnv)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", configFilePath, err)
+	}
+	values, err := update(dependencies)
+	if err != nil {
+		return false, err
+	}
+	if dependenciesHaveValues(dependencies, values) {
+		return false, nil
+	}
+	data, err = setDependencies(data, values)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", configFilePath, err)
+	}
+	return true, storage.PutPath(ctx, readWriteBucket, configFilePath, data)
+}
+
+// getDependencies returns the deps of the YAML configuration data, with their
+// values as written and as expanded with lookupEnv.
+func getDependencies(data []byte, lookupEnv func(string) (string, bool)) ([]Dependency, error) {
+	var rawExternalDependencies externalDependencies
+	if err := encoding.UnmarshalYAMLNonStrict(data, &rawExternalDependencies); err != nil {
+		return nil, err
+	}
+	expandedData, err := encoding.ExpandYAMLEnv(data, lookupEnv)
+	if err != nil {
+		return nil, err
+	}
+	var expandedExternalDependencies externalDependencies
+	if err := encoding.UnmarshalYAMLNonStrict(expandedData, &expandedExternalDependencies); err != nil {
+		return nil, err
+	}
+	if len(rawExternalDependencies.Deps) != len(expandedExternalDependencies.Deps) {
+		// this should never happen, expanding only changes the scalar values
+		return nil, errors.New("deps changed when expanding environment variables")
+	}
+	dependencies := make([]Dependency, len(rawExternalDependencies.Deps))
+	for i, value := range rawExternalDependencies.Deps {
+		moduleReference, err := bufmoduleref.ModuleReferenceForString(expandedExternalDependencies.Deps[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid dependency %q: %w", value, err)
+		}
+		dependencies[i] = Dependency{
+			Value:           value,
+			ModuleReference: moduleReference,
+		}
+	}
+	return dependencies, nil
+}
+
+func dependenciesHaveValues(dependencies []Dependency, values []string) bool {
+	if len(dependencies) != len(values) {
+		return false
+	}
+	for i, dependency := range dependencies {
+		if dependency.Value != values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// setDependencies sets the deps of the YAML configuration data, leaving the
+// rest of the data, including comments, as is.
+func setDependencies(data []byte, dependencies []string) ([]byte, error) {
+	if len(dependencies) == 0 {
+		return setValue(data, dependenciesKey, nil)
+	}
+	valueNode := &yaml.Node{
+		Kind: yaml.SequenceNode,
+	}
+	for _, dependency := range dependencies {
+		valueNode.Content = append(
+			valueNode.Content,
+			&yaml.Node{
+				Kind:  yaml.ScalarNode,
+				Value: dependency,
+			},
+		)
+	}
+	return setValue(data, dependenciesKey, valueNode)
+}
+
+// setValue sets the value of the top-level key of the YAML configuration data,
+// leaving the rest of the data, including comments, as is.
+//
+// If valueNode is nil, the key is removed. New keys are placed after the version
+// and name of the module, like in the configuration written by buf mod init.
+func setValue(data []byte, key string, valueNode *yaml.Node) ([]byte, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	if len(document.Content) == 0 {
+		document = yaml.Node{
+			Kind: yaml.DocumentNode,
+			Content: []*yaml.Node{
+				{
+					Kind: yaml.MappingNode,
+				},
+			},
+		}
+	}
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, errors.New("configuration is not a YAML mapping")
+	}
+	// The content of a mapping node alternates between keys and values.
+	keyIndex := -1
+	insertIndex := len(root.Content)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		switch root.Content[i].Value {
+		case key:
+			keyIndex = i
+		case "version", "name":
+			insertIndex = i + 2
+		}
+	}
+	switch {
+	case keyIndex >= 0 && valueNode == nil:
+		root.Content = append(root.Content[:keyIndex], root.Content[keyIndex+2:]...)
+	case keyIndex >= 0:
+		// Keep the comments of the value that is replaced.
+		valueNode.HeadComment = root.Content[keyIndex+1].HeadComment
+		valueNode.LineComment = root.Content[keyIndex+1].LineComment
+		valueNode.FootComment = root.Content[keyIndex+1].FootComment
+		root.Content[keyIndex+1] = valueNode
+	case valueNode != nil:
+		keyNode := &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Value: key,
+		}
+		content := make([]*yaml.Node, 0, len(root.Content)+2)
+		content = append(content, root.Content[:insertIndex]...)
+		content = append(content, keyNode, valueNode)
+		root.Content = append(content, root.Content[insertIndex:]...)
+	}
+	buffer := bytes.NewBuffer(nil)
+	yamlEncoder := encoding.NewYAMLEncoder(buffer)
+	if err := yamlEncoder.Encode(&document); err != nil {
+		return nil, multierr.Append(err, yamlEncoder.Close())
+	}
+	if err := yamlEncoder.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
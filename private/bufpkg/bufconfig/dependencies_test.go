@@ -0,0 +1,171 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDependencies(t *testing.T) {
+	t.Parallel()
+	testSetDependencies(
+		t,
+		"add",
+		`version: v1
+# The name of the module.
+name: buf.build/acme/weather
+lint:
+  use:
+    - DEFAULT
+`,
+		[]string{"buf.build/acme/units"},
+		`version: v1
+# The name of the module.
+name: buf.build/acme/weather
+deps:
+  - buf.build/acme/units
+lint:
+  use:
+    - DEFAULT
+`,
+	)
+	testSetDependencies(
+		t,
+		"replace",
+		`version: v1
+deps:
+  - buf.build/acme/units
+  - buf.build/acme/unused
+`,
+		[]string{"buf.build/acme/units", "buf.build/googleapis/googleapis"},
+		`version: v1
+deps:
+  - buf.build/acme/units
+  - buf.build/googleapis/googleapis
+`,
+	)
+	testSetDependencies(
+		t,
+		"remove",
+		`version: v1
+deps:
+  - buf.build/acme/unused
+breaking:
+  use:
+    - FILE
+`,
+		nil,
+		`version: v1
+breaking:
+  use:
+    - FILE
+`,
+	)
+	testSetDependencies(
+		t,
+		"empty",
+		``,
+		[]string{"buf.build/acme/units"},
+		`deps:
+  - buf.build/acme/units
+`,
+	)
+}
+
+func TestUpdateDependencies(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	readWriteBucket := storagemem.NewReadWriteBucket()
+	require.NoError(
+		t,
+		storage.PutPath(
+			ctx,
+			readWriteBucket,
+			ExternalConfigV1FilePath,
+			[]byte(`version: v1
+deps:
+  - ${REMOTE:-buf.build}/acme/units
+  - buf.build/acme/unused:v1
+`),
+		),
+	)
+	lookupEnv := func(key string) (string, bool) {
+		if key == "REMOTE" {
+			return "buf.example.com", true
+		}
+		return "", false
+	}
+	var dependencies []Dependency
+	updated, err := UpdateDependencies(
+		ctx,
+		readWriteBucket,
+		ExternalConfigV1FilePath,
+		lookupEnv,
+		func(currentDependencies []Dependency) ([]string, error) {
+			dependencies = currentDependencies
+			return []string{currentDependencies[0].Value}, nil
+		},
+	)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	require.Len(t, dependencies, 2)
+	assert.Equal(t, "${REMOTE:-buf.build}/acme/units", dependencies[0].Value)
+	assert.Equal(t, "buf.example.com/acme/units", dependencies[0].ModuleReference.String())
+	assert.Equal(t, "buf.build/acme/unused:v1", dependencies[1].Value)
+	assert.Equal(t, "v1", dependencies[1].ModuleReference.Reference())
+	data, err := storage.ReadPath(ctx, readWriteBucket, ExternalConfigV1FilePath)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`version: v1
+deps:
+  - ${REMOTE:-buf.build}/acme/units
+`,
+		string(data),
+	)
+
+	updated, err = UpdateDependencies(
+		ctx,
+		readWriteBucket,
+		ExternalConfigV1FilePath,
+		lookupEnv,
+		func(currentDependencies []Dependency) ([]string, error) {
+			return []string{currentDependencies[0].Value}, nil
+		},
+	)
+	require.NoError(t, err)
+	assert.False(t, updated)
+}
+
+func testSetDependencies(
+	t *testing.T,
+	desc string,
+	data string,
+	dependencies []string,
+	expected string,
+) {
+	t.Run(desc, func(t *testing.T) {
+		t.Parallel()
+		actual, err := setDependencies([]byte(data), dependencies)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+}
@@ -27,7 +27,11 @@ import (
 	"go.uber.org/multierr"
 )
 
-func getConfigForBucket(ctx context.Context, readBucket storage.ReadBucket) (_ *Config, retErr error) {
+func getConfigForBucket(
+	ctx context.Context,
+	readBucket storage.ReadBucket,
+	lookupEnv func(string) (string, bool),
+) (_ *Config, retErr error) {
 	ctx, span := otel.GetTracerProvider().Tracer("bufbuild/buf").Start(ctx, "get_config")
 	defer span.End()
 	defer func() {
@@ -73,13 +77,18 @@ func getConfigForBucket(ctx context.Context, readBucket storage.ReadBucket) (_ *
 			encoding.UnmarshalYAMLStrict,
 			data,
 			readObjectCloser.ExternalPath(),
+			lookupEnv,
 		)
 	default:
 		return nil, fmt.Errorf("only one configuration file can exist but found multiple configuration files: %s", stringutil.SliceToString(foundConfigFilePaths))
 	}
 }
 
-func getConfigForData(ctx context.Context, data []byte) (*Config, error) {
+func getConfigForData(
+	ctx context.Context,
+	data []byte,
+	lookupEnv func(string) (string, bool),
+) (*Config, error) {
 	_, span := otel.GetTracerProvider().Tracer("bufbuild/buf").Start(ctx, "get_config_for_data")
 	defer span.End()
 	config, err := getConfigForDataInternal(
@@ -88,6 +97,7 @@ func getConfigForData(ctx context.Context, data []byte) (*Config, error) {
 		encoding.UnmarshalJSONOrYAMLStrict,
 		data,
 		"Configuration data",
+		lookupEnv,
 	)
 	if err != nil {
 		span.RecordError(err)
@@ -102,7 +112,15 @@ func getConfigForDataInternal(
 	unmarshalStrict func([]byte, interface{}) error,
 	data []byte,
 	id string,
+	lookupEnv func(string) (string, bool),
 ) (*Config, error) {
+	if lookupEnv != nil {
+		var err error
+		data, err = encoding.ExpandYAMLEnv(data, lookupEnv)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+	}
 	var externalConfigVersion ExternalConfigVersion
 	if err := unmarshalNonStrict(data, &externalConfigVersion); err != nil {
 		return nil, err
@@ -0,0 +1,77 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/encoding"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"gopkg.in/yaml.v3"
+)
+
+const nameKey = "name"
+
+// externalName is the part of an external configuration that is common to
+// all versions and contains the name of the module.
+type externalName struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func renameModuleIdentity(
+	ctx context.Context,
+	readWriteBucket storage.ReadWriteBucket,
+	configFilePath string,
+	lookupEnv func(string) (string, bool),
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	newModuleIdentity bufmoduleref.ModuleIdentity,
+) (bool, error) {
+	data, err := storage.ReadPath(ctx, readWriteBucket, configFilePath)
+	if err != nil {
+		return false, err
+	}
+	expandedData, err := encoding.ExpandYAMLEnv(data, lookupEnv)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", configFilePath, err)
+	}
+	var expandedExternalName externalName
+	if err := encoding.UnmarshalYAMLNonStrict(expandedData, &expandedExternalName); err != nil {
+		return false, fmt.Errorf("%s: %w", configFilePath, err)
+	}
+	if expandedExternalName.Name == "" {
+		return false, nil
+	}
+	currentModuleIdentity, err := bufmoduleref.ModuleIdentityForString(expandedExternalName.Name)
+	if err != nil {
+		return false, fmt.Errorf("%s: invalid name %q: %w", configFilePath, expandedExternalName.Name, err)
+	}
+	if currentModuleIdentity.IdentityString() != moduleIdentity.IdentityString() {
+		return false, nil
+	}
+	data, err = setValue(
+		data,
+		nameKey,
+		&yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Value: newModuleIdentity.IdentityString(),
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", configFilePath, err)
+	}
+	return true, storage.PutPath(ctx, readWriteBucket, configFilePath, data)
+}
@@ -0,0 +1,76 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameModuleIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	readWriteBucket := storagemem.NewReadWriteBucket()
+	require.NoError(
+		t,
+		storage.PutPath(
+			ctx,
+			readWriteBucket,
+			ExternalConfigV1FilePath,
+			[]byte(`version: v1
+name: ${REMOTE:-buf.build}/acme/old # the name
+deps:
+  - buf.build/acme/old
+`),
+		),
+	)
+	lookupEnv := func(key string) (string, bool) {
+		if key == "REMOTE" {
+			return "buf.example.com", true
+		}
+		return "", false
+	}
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.build/acme/old")
+	require.NoError(t, err)
+	newModuleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.example.com/acme/new")
+	require.NoError(t, err)
+	renamed, err := RenameModuleIdentity(ctx, readWriteBucket, ExternalConfigV1FilePath, lookupEnv, moduleIdentity, newModuleIdentity)
+	require.NoError(t, err)
+	assert.False(t, renamed)
+
+	moduleIdentity, err = bufmoduleref.ModuleIdentityForString("buf.example.com/acme/old")
+	require.NoError(t, err)
+	renamed, err = RenameModuleIdentity(ctx, readWriteBucket, ExternalConfigV1FilePath, lookupEnv, moduleIdentity, newModuleIdentity)
+	require.NoError(t, err)
+	assert.True(t, renamed)
+	data, err := storage.ReadPath(ctx, readWriteBucket, ExternalConfigV1FilePath)
+	require.NoError(t, err)
+	// Only the name is changed, the deps are updated separately.
+	assert.Equal(
+		t,
+		`version: v1
+name: buf.example.com/acme/new # the name
+deps:
+  - buf.build/acme/old
+`,
+		string(data),
+	)
+}
@@ -44,9 +44,9 @@ func readConfigOS(
 		default:
 			data = []byte(readConfigOSOptions.override)
 		}
-		return GetConfigForData(ctx, data)
+		return getConfigForData(ctx, data, os.LookupEnv)
 	}
-	return GetConfigForBucket(ctx, readBucket)
+	return getConfigForBucket(ctx, readBucket, os.LookupEnv)
 }
 
 type readConfigOSOptions struct {
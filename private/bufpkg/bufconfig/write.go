@@ -193,6 +193,7 @@ breaking:
   # - [PACKAGE]
   # - [WIRE]
   # - [WIRE_JSON]
+  # - [JSON]
   #
   # The default is [FILE], as done below.
   use:
@@ -245,7 +246,42 @@ breaking:
   # - foo.bar.v1alpha1
   # - foo.bar.v1beta1
   # - foo.bar.v1test
-  {{if not .Uncomment}}#{{end}}ignore_unstable_packages: false`
+  {{if not .Uncomment}}#{{end}}ignore_unstable_packages: false
+
+  # allow_comment_ignores allows comment-driven ignores.
+  #
+  # If this option is set, leading comments can be added within Protobuf files
+  # to acknowledge intentional breaking changes. If any line in a leading comment
+  # of a changed component starts with "buf:breaking:ignore ID", then Buf will
+  # ignore breaking changes for this id. For example:
+  #
+  #   message Foo {
+  #     // buf:breaking:ignore FIELD_SAME_TYPE
+  #     int64 bar = 1;
+  #   }
+  #
+  # Comment ignores only apply to the input being checked, not to the input
+  # being checked against.
+  {{if not .Uncomment}}#{{end}}allow_comment_ignores: false
+
+  # frozen_options is the list of fully-qualified names of bool message and
+  # enum options that mark messages and enums as frozen. The FROZEN rule reports
+  # any change to a message or enum that has one of these options set to true
+  # in the input being checked against. For example:
+  #
+  #   message Foo {
+  #     option (acme.frozen) = true;
+  #     int64 bar = 1;
+  #   }
+  {{if not .Uncomment}}#{{end}}frozen_options:
+  {{if not .Uncomment}}#{{end}}  - acme.frozen
+
+  # append_only_options is the list of fully-qualified names of bool message and
+  # enum options that mark messages and enums as append-only. The APPEND_ONLY
+  # rule reports deleted or changed fields of append-only messages, and deleted
+  # or renamed values of append-only enums. New fields and values can be added.
+  {{if not .Uncomment}}#{{end}}append_only_options:
+  {{if not .Uncomment}}#{{end}}  - acme.append_only`
 )
 
 func writeConfig(
@@ -271,6 +307,10 @@ func writeConfig(
 	config := &Config{
 		Version:        version,
 		ModuleIdentity: writeConfigOptions.moduleIdentity,
+		DocsDirectory:  writeConfigOptions.docsDirectory,
+	}
+	if config.DocsDirectory != "" && version != V1Version {
+		return fmt.Errorf("docs directory is only supported for version %q", V1Version)
 	}
 	var breakingConfigVersion string
 	breakingConfig := writeConfigOptions.breakingConfig
@@ -361,6 +401,9 @@ func writeExternalConfig(
 			Deps:     dependencies,
 			Breaking: externalBreakingConfig,
 			Lint:     externalLintConfig,
+			Docs: ExternalDocsConfigV1{
+				Directory: config.DocsDirectory,
+			},
 		}
 		buffer := bytes.NewBuffer(nil)
 		encoder := yaml.NewEncoder(buffer)
@@ -449,6 +492,7 @@ type writeConfigOptions struct {
 	dependencyModuleReferences []bufmoduleref.ModuleReference
 	breakingConfig             *bufbreakingconfig.Config
 	lintConfig                 *buflintconfig.Config
+	docsDirectory              string
 	version                    string
 }
 
@@ -0,0 +1,114 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufconnect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/command"
+)
+
+// credentialHelperTimeout is the maximum time a credential helper may run to
+// get the token of a remote.
+const credentialHelperTimeout = 30 * time.Second
+
+// credentialHelperTokenProvider is used to provide remote tokens from an
+// external credential helper.
+type credentialHelperTokenProvider struct {
+	container app.EnvContainer
+	runner    command.Runner
+	name      string
+	args      []string
+
+	lock           sync.Mutex
+	addressToToken map[string]string
+}
+
+// NewCredentialHelperTokenProvider returns a TokenProvider that gets the token
+// of each remote from an external credential helper.
+//
+// The helper is the command line of the credential helper, such as
+// "docker-credential-osxkeychain". It follows the protocol of Docker credential
+// helpers: the helper is run with the additional argument "get" and the remote
+// address on stdin, and prints a JSON object with the token in the "Secret" key
+// to stdout. The helper is run at most once per remote.
+func NewCredentialHelperTokenProvider(
+	container app.EnvContainer,
+	runner command.Runner,
+	helper string,
+) TokenProvider {
+	fields := strings.Fields(helper)
+	if len(fields) == 0 {
+		return nopTokenProvider{}
+	}
+	return &credentialHelperTokenProvider{
+		container:      container,
+		runner:         runner,
+		name:           fields[0],
+		args:           append(fields[1:], "get"),
+		addressToToken: make(map[string]string),
+	}
+}
+
+func (c *credentialHelperTokenProvider) RemoteToken(address string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if token, ok := c.addressToToken[address]; ok {
+		return token
+	}
+	// Like netrc, a remote the helper has no credentials for is not an
+	// error, the request is sent without a token instead.
+	token, _ := c.getToken(address)
+	c.addressToToken[address] = token
+	return token
+}
+
+func (c *credentialHelperTokenProvider) IsFromEnvVar() bool {
+	return false
+}
+
+func (c *credentialHelperTokenProvider) getToken(address string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialHelperTimeout)
+	defer cancel()
+	stdout := bytes.NewBuffer(nil)
+	if err := c.runner.Run(
+		ctx,
+		c.name,
+		command.RunWithArgs(c.args...),
+		command.RunWithEnv(app.EnvironMap(c.container)),
+		command.RunWithStdin(strings.NewReader(address+"\n")),
+		command.RunWithStdout(stdout),
+	); err != nil {
+		return "", err
+	}
+	var credentials externalCredentials
+	if err := json.Unmarshal(stdout.Bytes(), &credentials); err != nil {
+		return "", err
+	}
+	return credentials.Secret, nil
+}
+
+// externalCredentials are the credentials printed by a credential helper.
+type externalCredentials struct {
+	ServerURL string `json:"ServerURL,omitempty"`
+	Username  string `json:"Username,omitempty"`
+	Secret    string `json:"Secret,omitempty"`
+}
@@ -0,0 +1,67 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package bufconnect
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialHelperTokenProvider(t *testing.T) {
+	t.Parallel()
+	helperPath := filepath.Join(t.TempDir(), "credential-helper")
+	require.NoError(
+		t,
+		os.WriteFile(
+			helperPath,
+			[]byte(`#!/bin/sh
+test "$1" = "--flag" && test "$2" = "get" || exit 1
+read address
+case "$address" in
+  buf.build) echo '{"ServerURL":"buf.build","Username":"user1","Secret":"token1"}' ;;
+  *) echo "credentials not found in native keychain"; exit 1 ;;
+esac
+`),
+			0700,
+		),
+	)
+	tokenProvider := NewCredentialHelperTokenProvider(
+		app.NewEnvContainer(nil),
+		command.NewRunner(),
+		helperPath+" --flag",
+	)
+	assert.Equal(t, "token1", tokenProvider.RemoteToken("buf.build"))
+	assert.Equal(t, "", tokenProvider.RemoteToken("buf.example.com"))
+	assert.False(t, tokenProvider.IsFromEnvVar())
+}
+
+func TestCredentialHelperTokenProviderEmpty(t *testing.T) {
+	t.Parallel()
+	tokenProvider := NewCredentialHelperTokenProvider(
+		app.NewEnvContainer(nil),
+		command.NewRunner(),
+		"",
+	)
+	assert.Equal(t, "", tokenProvider.RemoteToken("buf.build"))
+}
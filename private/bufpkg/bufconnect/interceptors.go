@@ -16,6 +16,7 @@ package bufconnect
 
 import (
 	"context"
+	"sync"
 
 	"github.com/bufbuild/connect-go"
 )
@@ -47,12 +48,37 @@ type TokenProvider interface {
 	IsFromEnvVar() bool
 }
 
+// TokenPromptFunc prompts for the token of the remote address.
+//
+// Returns an empty token if the token cannot be prompted for.
+type TokenPromptFunc func(address string) (string, error)
+
 // NewAuthorizationInterceptorProvider returns a new provider function which, when invoked, returns an interceptor
 // which will set the auth token into the request header by the provided option.
 //
 // Note that the interceptor returned from this provider is always applied LAST in the series of interceptors added to
 // a client.
 func NewAuthorizationInterceptorProvider(tokenProviders ...TokenProvider) func(string) connect.UnaryInterceptorFunc {
+	return newAuthorizationInterceptorProvider(nil, tokenProviders...)
+}
+
+// NewAuthorizationInterceptorProviderWithPrompt returns a new provider function like
+// NewAuthorizationInterceptorProvider, whose interceptors also call the TokenPromptFunc
+// when a request is rejected as unauthenticated, and retry the request with the prompted token.
+//
+// The token is not prompted for if the rejected token came from an environment variable.
+// The prompted token is used for all later requests to the same address.
+func NewAuthorizationInterceptorProviderWithPrompt(
+	tokenPromptFunc TokenPromptFunc,
+	tokenProviders ...TokenProvider,
+) func(string) connect.UnaryInterceptorFunc {
+	return newAuthorizationInterceptorProvider(newTokenPrompter(tokenPromptFunc), tokenProviders...)
+}
+
+func newAuthorizationInterceptorProvider(
+	tokenPrompter *tokenPrompter,
+	tokenProviders ...TokenProvider,
+) func(string) connect.UnaryInterceptorFunc {
 	return func(address string) connect.UnaryInterceptorFunc {
 		interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
 			return connect.UnaryFunc(func(
@@ -60,14 +86,32 @@ func NewAuthorizationInterceptorProvider(tokenProviders ...TokenProvider) func(s
 				req connect.AnyRequest,
 			) (connect.AnyResponse, error) {
 				usingTokenEnvKey := false
-				for _, tf := range tokenProviders {
-					if token := tf.RemoteToken(address); token != "" {
-						req.Header().Set(AuthenticationHeader, AuthenticationTokenPrefix+token)
-						usingTokenEnvKey = tf.IsFromEnvVar()
-						break
+				var token string
+				if tokenPrompter != nil {
+					token = tokenPrompter.promptedToken(address)
+				}
+				if token == "" {
+					for _, tf := range tokenProviders {
+						if token = tf.RemoteToken(address); token != "" {
+							usingTokenEnvKey = tf.IsFromEnvVar()
+							break
+						}
 					}
 				}
+				if token != "" {
+					req.Header().Set(AuthenticationHeader, AuthenticationTokenPrefix+token)
+				}
 				response, err := next(ctx, req)
+				if err != nil && tokenPrompter != nil && !usingTokenEnvKey && connect.CodeOf(err) == connect.CodeUnauthenticated {
+					promptedToken, promptErr := tokenPrompter.prompt(address, token)
+					if promptErr != nil {
+						return nil, promptErr
+					}
+					if promptedToken != "" {
+						req.Header().Set(AuthenticationHeader, AuthenticationTokenPrefix+promptedToken)
+						response, err = next(ctx, req)
+					}
+				}
 				if err != nil && usingTokenEnvKey {
 					err = &AuthError{cause: err, tokenEnvKey: tokenEnvKey}
 				}
@@ -77,3 +121,43 @@ func NewAuthorizationInterceptorProvider(tokenProviders ...TokenProvider) func(s
 		return interceptor
 	}
 }
+
+type tokenPrompter struct {
+	tokenPromptFunc TokenPromptFunc
+
+	addressToToken map[string]string
+	lock           sync.Mutex
+}
+
+func newTokenPrompter(tokenPromptFunc TokenPromptFunc) *tokenPrompter {
+	return &tokenPrompter{
+		tokenPromptFunc: tokenPromptFunc,
+		addressToToken:  make(map[string]string),
+	}
+}
+
+func (t *tokenPrompter) promptedToken(address string) string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.addressToToken[address]
+}
+
+// prompt prompts for the token of the address after rejectedToken was rejected.
+//
+// Only one prompt is shown at a time. If another request already prompted for
+// the token of the address, that token is returned instead.
+func (t *tokenPrompter) prompt(address string, rejectedToken string) (string, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if token, ok := t.addressToToken[address]; ok && token != rejectedToken {
+		return token, nil
+	}
+	token, err := t.tokenPromptFunc(address)
+	if err != nil {
+		return "", err
+	}
+	if token != "" {
+		t.addressToToken[address] = token
+	}
+	return token, nil
+}
@@ -101,3 +101,51 @@ func TestNewAuthorizationInterceptorProvider(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, tokenEnvKey, authErr.tokenEnvKey)
 }
+
+func TestNewAuthorizationInterceptorProviderWithPrompt(t *testing.T) {
+	tokenSet, err := NewTokenProviderFromString("invalid@host1")
+	assert.NoError(t, err)
+	var numPrompts int
+	interceptorProvider := NewAuthorizationInterceptorProviderWithPrompt(
+		func(address string) (string, error) {
+			numPrompts++
+			return "valid", nil
+		},
+		tokenSet,
+	)
+	next := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Header().Get(AuthenticationHeader) != AuthenticationTokenPrefix+"valid" {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid token"))
+		}
+		return nil, nil
+	}
+	_, err = interceptorProvider("host1")(next)(context.Background(), connect.NewRequest(&bytes.Buffer{}))
+	assert.NoError(t, err)
+	// The prompted token is used for later requests without prompting again.
+	_, err = interceptorProvider("host1")(next)(context.Background(), connect.NewRequest(&bytes.Buffer{}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, numPrompts)
+
+	// Empty prompted tokens are not retried.
+	_, err = NewAuthorizationInterceptorProviderWithPrompt(
+		func(address string) (string, error) {
+			return "", nil
+		},
+		tokenSet,
+	)("host1")(next)(context.Background(), connect.NewRequest(&bytes.Buffer{}))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	// Tokens from the environment are not prompted for.
+	tokenSet, err = NewTokenProviderFromContainer(app.NewEnvContainer(map[string]string{
+		tokenEnvKey: "invalid",
+	}))
+	assert.NoError(t, err)
+	_, err = NewAuthorizationInterceptorProviderWithPrompt(
+		func(address string) (string, error) {
+			return "valid", nil
+		},
+		tokenSet,
+	)("default")(next)(context.Background(), connect.NewRequest(&bytes.Buffer{}))
+	_, ok := AsAuthError(err)
+	assert.True(t, ok)
+}
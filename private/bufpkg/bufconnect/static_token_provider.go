@@ -34,7 +34,9 @@ func NewTokenProviderFromString(token string) (TokenProvider, error) {
 
 // newTokenProviderFromString returns a TokenProvider with auth keys from the provided token. The
 // remote token is in the format: token1@remote1,token2@remote2.
-// The special characters `@` and `,` are used as the splitters. The tokens and remote addresses
+// Each token may be prefixed with the username it belongs to, as in user1:token1@remote1, in
+// which case the username is ignored.
+// The special characters `@`, `,` and `:` are used as the splitters. The tokens and remote addresses
 // do not contain these characters since they are enforced by the rules in BSR.
 func newTokenProviderFromString(token string, isFromEnvVar bool) (TokenProvider, error) {
 	if token == "" {
@@ -99,8 +101,14 @@ func newMultipleTokenProvider(tokens []string, isFromEnvVar bool) (*multipleToke
 		if split[0] == "" || split[1] == "" {
 			return nil, fmt.Errorf("invalid token: %s", token)
 		}
-		if strings.Contains(split[0], ":") {
-			return nil, fmt.Errorf("invalid token: %s, token cannot contain special character `:`", token)
+		if username, remoteToken, ok := strings.Cut(split[0], ":"); ok {
+			if username == "" || remoteToken == "" {
+				return nil, fmt.Errorf("invalid token: %s", token)
+			}
+			if strings.Contains(remoteToken, ":") {
+				return nil, fmt.Errorf("invalid token: %s, token cannot contain special character `:`", token)
+			}
+			split[0] = remoteToken
 		}
 		if strings.Contains(split[0], ",") {
 			return nil, fmt.Errorf("invalid token: %s, token cannot contain special character `,`", token)
@@ -42,6 +42,10 @@ func TestNewTokenProviderFromString(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "token1", tokenProvider.RemoteToken("remote1"))
 	assert.Equal(t, "token2", tokenProvider.RemoteToken("remote2"))
+	tokenProvider, err = NewTokenProviderFromString("user1:token1@remote1,token2@remote2")
+	assert.NoError(t, err)
+	assert.Equal(t, "token1", tokenProvider.RemoteToken("remote1"))
+	assert.Equal(t, "token2", tokenProvider.RemoteToken("remote2"))
 	_, err = NewTokenProviderFromString("")
 	assert.NoError(t, err)
 }
@@ -59,6 +63,9 @@ func TestInvalidTokens(t *testing.T) {
 		",",
 		"token,",
 		",token",
+		":token1@host1",
+		"user1:@host1",
+		"user1:token1:other@host1",
 	}
 
 	for _, token := range invalidTokens {
@@ -0,0 +1,152 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package buffieldnumber reports the usage of field numbers by messages.
+//
+// Fields with numbers from 1 to 15 are encoded with a one-byte tag, and fields with
+// numbers from 16 to 2047 are encoded with a two-byte tag. The report shows how many
+// numbers remain in these ranges for new fields of every message.
+package buffieldnumber
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+)
+
+const (
+	// OneByteMaxNumber is the largest field number that is encoded with a one-byte tag.
+	OneByteMaxNumber = 15
+	// TwoByteMaxNumber is the largest field number that is encoded with a two-byte tag.
+	TwoByteMaxNumber = 2047
+	// MaxNumber is the largest field number.
+	MaxNumber = 536870911
+
+	// The field numbers reserved for the implementation of Protocol Buffers.
+	implementationReservedStartNumber = 19000
+	implementationReservedEndNumber   = 19999
+)
+
+const (
+	// StatusOK is the Status of a message with more than the threshold of one-byte numbers remaining.
+	StatusOK Status = iota + 1
+	// StatusOneByteLow is the Status of a message with at most the threshold of one-byte numbers remaining.
+	StatusOneByteLow
+	// StatusOneByteExhausted is the Status of a message with no one-byte numbers remaining, and more
+	// than the threshold of two-byte numbers remaining.
+	StatusOneByteExhausted
+	// StatusTwoByteLow is the Status of a message with no one-byte numbers remaining, and at most the
+	// threshold of two-byte numbers remaining.
+	StatusTwoByteLow
+	// StatusTwoByteExhausted is the Status of a message with no one-byte or two-byte numbers remaining.
+	StatusTwoByteExhausted
+)
+
+var (
+	statusToString = map[Status]string{
+		StatusOK:               "ok",
+		StatusOneByteLow:       "one_byte_low",
+		StatusOneByteExhausted: "one_byte_exhausted",
+		StatusTwoByteLow:       "two_byte_low",
+		StatusTwoByteExhausted: "two_byte_exhausted",
+	}
+)
+
+// Status is the status of the remaining field numbers of a message.
+type Status int
+
+// String implements fmt.Stringer.
+func (s Status) String() string {
+	if value, ok := statusToString[s]; ok {
+		return value
+	}
+	return strconv.Itoa(int(s))
+}
+
+// Report is the field number usage of a message.
+//
+// Numbers are remaining if they are larger than the highest field number of the message,
+// and are not reserved, in an extension range, or reserved for the implementation of
+// Protocol Buffers. Numbers below the highest field number are never considered remaining,
+// as they may be the numbers of deleted fields that were not reserved.
+type Report interface {
+	// Message is the message.
+	Message() protosource.Message
+	// FieldCount is the number of fields of the message, not including extensions.
+	FieldCount() int
+	// HighestNumber is the highest field number of the message.
+	//
+	// Zero if the message has no fields.
+	HighestNumber() int
+	// ReservedRanges are the reserved ranges of the message.
+	ReservedRanges() []protosource.MessageRange
+	// OneByteRemaining is the number of remaining numbers from 1 to 15.
+	OneByteRemaining() int
+	// TwoByteRemaining is the number of remaining numbers from 16 to 2047.
+	TwoByteRemaining() int
+	// SuggestedNumbers are the lowest remaining numbers, up to the requested count.
+	SuggestedNumbers() []int
+	// Status is the status of the remaining numbers.
+	Status() Status
+
+	isReport()
+}
+
+// GetReports returns the Reports for the messages of the non-import files of the Image.
+//
+// Map entry messages are not included. A message is flagged as low on one-byte or
+// two-byte numbers if at most threshold numbers of the range remain, and up to
+// suggestionCount remaining numbers are suggested for every message.
+//
+// The Reports are sorted by file path, then by the order of the messages within the file.
+func GetReports(
+	ctx context.Context,
+	image bufimage.Image,
+	threshold int,
+	suggestionCount int,
+) ([]Report, error) {
+	if threshold < 0 {
+		return nil, fmt.Errorf("threshold must be non-negative: %d", threshold)
+	}
+	if suggestionCount < 0 {
+		return nil, fmt.Errorf("suggestion count must be non-negative: %d", suggestionCount)
+	}
+	files, err := protosource.NewFilesUnstable(
+		ctx,
+		bufimageutil.NewInputFiles(bufimage.ImageWithoutImports(image).Files())...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var reports []Report
+	for _, file := range files {
+		if err := protosource.ForEachMessage(
+			func(message protosource.Message) error {
+				if message.IsMapEntry() {
+					return nil
+				}
+				reports = append(reports, newReport(message, threshold, suggestionCount))
+				return nil
+			},
+			file,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return reports, nil
+}
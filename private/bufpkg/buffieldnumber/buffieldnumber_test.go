@@ -0,0 +1,117 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffieldnumber_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/buffieldnumber"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleconfig"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetReports(t *testing.T) {
+	t.Parallel()
+	reports := testGetReports(t, "report", 3, 2)
+	require.Len(t, reports, 5)
+	fullNames := make([]string, len(reports))
+	for i, report := range reports {
+		fullNames[i] = report.Message().FullName()
+	}
+	assert.Equal(
+		t,
+		[]string{
+			"a.Empty",
+			"a.Low",
+			"a.Exhausted",
+			"a.Gap",
+			"a.Gap.Nested",
+		},
+		fullNames,
+	)
+	testAssertReport(t, reports[0], 0, 0, 15, 2032, []int{1, 2}, buffieldnumber.StatusOK)
+	// 13 and 15 remain, as 14 is reserved.
+	testAssertReport(t, reports[1], 3, 12, 2, 2032, []int{13, 15}, buffieldnumber.StatusOneByteLow)
+	testAssertReport(t, reports[2], 1, 15, 0, 39, []int{2001, 2002}, buffieldnumber.StatusOneByteExhausted)
+	// Numbers below the highest field number are not remaining.
+	testAssertReport(t, reports[3], 2, 10, 5, 2032, []int{11, 12}, buffieldnumber.StatusOK)
+	testAssertReport(t, reports[4], 1, 2046, 0, 1, []int{2047, 2048}, buffieldnumber.StatusTwoByteLow)
+	assert.Len(t, reports[2].ReservedRanges(), 2)
+}
+
+func TestGetReportsThreshold(t *testing.T) {
+	t.Parallel()
+	reports := testGetReports(t, "report", 0, 0)
+	require.Len(t, reports, 5)
+	assert.Equal(t, buffieldnumber.StatusOK, reports[1].Status())
+	assert.Empty(t, reports[1].SuggestedNumbers())
+	assert.Equal(t, buffieldnumber.StatusOneByteExhausted, reports[4].Status())
+	reports = testGetReports(t, "report", 40, 0)
+	require.Len(t, reports, 5)
+	assert.Equal(t, buffieldnumber.StatusTwoByteLow, reports[2].Status())
+}
+
+func testAssertReport(
+	t *testing.T,
+	report buffieldnumber.Report,
+	expectedFieldCount int,
+	expectedHighestNumber int,
+	expectedOneByteRemaining int,
+	expectedTwoByteRemaining int,
+	expectedSuggestedNumbers []int,
+	expectedStatus buffieldnumber.Status,
+) {
+	name := report.Message().FullName()
+	assert.Equal(t, expectedFieldCount, report.FieldCount(), name)
+	assert.Equal(t, expectedHighestNumber, report.HighestNumber(), name)
+	assert.Equal(t, expectedOneByteRemaining, report.OneByteRemaining(), name)
+	assert.Equal(t, expectedTwoByteRemaining, report.TwoByteRemaining(), name)
+	assert.Equal(t, expectedSuggestedNumbers, report.SuggestedNumbers(), name)
+	assert.Equal(t, expectedStatus, report.Status(), name)
+}
+
+func testGetReports(t *testing.T, relDirPath string, threshold int, suggestionCount int) []buffieldnumber.Report {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	readWriteBucket, err := storageos.NewProvider().NewReadWriteBucket(filepath.Join("testdata", relDirPath))
+	require.NoError(t, err)
+	moduleConfig, err := bufmoduleconfig.NewConfigV1(bufmoduleconfig.ExternalConfigV1{})
+	require.NoError(t, err)
+	module, err := bufmodulebuild.BuildForBucket(ctx, readWriteBucket, moduleConfig)
+	require.NoError(t, err)
+	moduleFileSet, err := bufmodulebuild.NewModuleFileSetBuilder(
+		zap.NewNop(),
+		bufmodule.NewNopModuleReader(),
+	).Build(
+		ctx,
+		module,
+	)
+	require.NoError(t, err)
+	image, fileAnnotations, err := bufimagebuild.NewBuilder(zap.NewNop()).Build(ctx, moduleFileSet)
+	require.NoError(t, err)
+	require.Empty(t, fileAnnotations)
+	reports, err := buffieldnumber.GetReports(ctx, image, threshold, suggestionCount)
+	require.NoError(t, err)
+	return reports
+}
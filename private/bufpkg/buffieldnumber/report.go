@@ -0,0 +1,124 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffieldnumber
+
+import (
+	"github.com/bufbuild/buf/private/pkg/protosource"
+)
+
+type report struct {
+	message          protosource.Message
+	fieldCount       int
+	highestNumber    int
+	oneByteRemaining int
+	twoByteRemaining int
+	suggestedNumbers []int
+	status           Status
+}
+
+func newReport(message protosource.Message, threshold int, suggestionCount int) *report {
+	report := &report{
+		message:    message,
+		fieldCount: len(message.Fields()),
+	}
+	for _, field := range message.Fields() {
+		if field.Number() > report.highestNumber {
+			report.highestNumber = field.Number()
+		}
+	}
+	var unavailableRanges []protosource.MessageRange
+	unavailableRanges = append(unavailableRanges, message.ReservedMessageRanges()...)
+	unavailableRanges = append(unavailableRanges, message.ExtensionMessageRanges()...)
+	// Iterate over the remaining numbers, skipping over unavailable ranges as
+	// they may extend to the maximum field number.
+	for number := report.highestNumber + 1; number <= MaxNumber; number++ {
+		if number >= implementationReservedStartNumber && number <= implementationReservedEndNumber {
+			number = implementationReservedEndNumber
+			continue
+		}
+		if unavailableRange := getContainingRange(number, unavailableRanges); unavailableRange != nil {
+			number = unavailableRange.End()
+			continue
+		}
+		if number > TwoByteMaxNumber && len(report.suggestedNumbers) >= suggestionCount {
+			break
+		}
+		switch {
+		case number <= OneByteMaxNumber:
+			report.oneByteRemaining++
+		case number <= TwoByteMaxNumber:
+			report.twoByteRemaining++
+		}
+		if len(report.suggestedNumbers) < suggestionCount {
+			report.suggestedNumbers = append(report.suggestedNumbers, number)
+		}
+	}
+	switch {
+	case report.oneByteRemaining > threshold:
+		report.status = StatusOK
+	case report.oneByteRemaining > 0:
+		report.status = StatusOneByteLow
+	case report.twoByteRemaining > threshold:
+		report.status = StatusOneByteExhausted
+	case report.twoByteRemaining > 0:
+		report.status = StatusTwoByteLow
+	default:
+		report.status = StatusTwoByteExhausted
+	}
+	return report
+}
+
+func (r *report) Message() protosource.Message {
+	return r.message
+}
+
+func (r *report) FieldCount() int {
+	return r.fieldCount
+}
+
+func (r *report) HighestNumber() int {
+	return r.highestNumber
+}
+
+func (r *report) ReservedRanges() []protosource.MessageRange {
+	return r.message.ReservedMessageRanges()
+}
+
+func (r *report) OneByteRemaining() int {
+	return r.oneByteRemaining
+}
+
+func (r *report) TwoByteRemaining() int {
+	return r.twoByteRemaining
+}
+
+func (r *report) SuggestedNumbers() []int {
+	return r.suggestedNumbers
+}
+
+func (r *report) Status() Status {
+	return r.status
+}
+
+func (*report) isReport() {}
+
+func getContainingRange(number int, messageRanges []protosource.MessageRange) protosource.MessageRange {
+	for _, messageRange := range messageRanges {
+		if number >= messageRange.Start() && number <= messageRange.End() {
+			return messageRange
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufhttprule reads the google.api.http annotations of RPCs, and
+// builds tables of the HTTP routes of RPCs.
+//
+// The annotations are read from the encoded options of methods, so that
+// google/api/http.proto does not need to be known to this program.
+//
+// See https://github.com/googleapis/googleapis/blob/master/google/api/http.proto
+package bufhttprule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ExtensionNumber is the number of the google.api.http extension
+// of google.protobuf.MethodOptions.
+const ExtensionNumber = 72295728
+
+const (
+	// MethodGet is the GET HTTP method.
+	MethodGet = "GET"
+	// MethodPut is the PUT HTTP method.
+	MethodPut = "PUT"
+	// MethodPost is the POST HTTP method.
+	MethodPost = "POST"
+	// MethodDelete is the DELETE HTTP method.
+	MethodDelete = "DELETE"
+	// MethodPatch is the PATCH HTTP method.
+	MethodPatch = "PATCH"
+
+	// The field numbers of google.api.HttpRule.
+	httpRuleGetFieldNumber                = 2
+	httpRulePutFieldNumber                = 3
+	httpRulePostFieldNumber               = 4
+	httpRuleDeleteFieldNumber             = 5
+	httpRulePatchFieldNumber              = 6
+	httpRuleBodyFieldNumber               = 7
+	httpRuleCustomFieldNumber             = 8
+	httpRuleAdditionalBindingsFieldNumber = 11
+	httpRuleResponseBodyFieldNumber       = 12
+
+	// The field numbers of google.api.CustomHttpPattern.
+	customHTTPPatternKindFieldNumber = 1
+	customHTTPPatternPathFieldNumber = 2
+)
+
+var (
+	httpRuleFieldNumberToMethod = map[protowire.Number]string{
+		httpRuleGetFieldNumber:    MethodGet,
+		httpRulePutFieldNumber:    MethodPut,
+		httpRulePostFieldNumber:   MethodPost,
+		httpRuleDeleteFieldNumber: MethodDelete,
+		httpRulePatchFieldNumber:  MethodPatch,
+	}
+
+	errMalformed = errors.New("malformed google.api.HttpRule")
+)
+
+// Binding is a binding of an RPC to an HTTP method and path.
+//
+// Every google.api.http annotation has a primary binding, and one binding
+// for each of its additional_bindings.
+type Binding struct {
+	// Method is the HTTP method, such as GET, or the kind of a custom pattern.
+	//
+	// Empty if the rule does not set a pattern.
+	Method string
+	// Custom is true if the binding uses a custom pattern.
+	Custom bool
+	// Path is the path template.
+	Path string
+	// Body is the name of the request field that is mapped to the request
+	// body, "*" for all fields that are not bound by the path, or empty for
+	// no request body.
+	Body string
+	// ResponseBody is the name of the response field that is mapped to the
+	// response body, or empty for the entire response.
+	ResponseBody string
+	// Additional is true if this binding is one of the additional_bindings
+	// of the annotation.
+	Additional bool
+}
+
+// BindingsForMethod returns the bindings of the google.api.http annotation
+// of the method, with the primary binding first.
+//
+// Returns nil if the method has no google.api.http annotation. Returns an error
+// if the annotation is not a valid google.api.HttpRule, including if any of its
+// additional_bindings have additional_bindings themselves.
+func BindingsForMethod(method protosource.Method) ([]*Binding, error) {
+	data, ok := method.OptionExtensionMessageBytes(ExtensionNumber)
+	if !ok {
+		return nil, nil
+	}
+	return ParseBindings(data)
+}
+
+// ParseBindings returns the bindings of the encoded google.api.HttpRule,
+// with the primary binding first.
+func ParseBindings(data []byte) ([]*Binding, error) {
+	binding, additionalBindingsData, err := parseBinding(data)
+	if err != nil {
+		return nil, err
+	}
+	bindings := []*Binding{binding}
+	for _, additionalBindingData := range additionalBindingsData {
+		additionalBinding, nestedAdditionalBindingsData, err := parseBinding(additionalBindingData)
+		if err != nil {
+			return nil, err
+		}
+		if len(nestedAdditionalBindingsData) > 0 {
+			return nil, errors.New("additional_bindings must not have additional_bindings")
+		}
+		additionalBinding.Additional = true
+		bindings = append(bindings, additionalBinding)
+	}
+	return bindings, nil
+}
+
+// parseBinding parses the binding of the encoded google.api.HttpRule, and returns
+// the encoded additional_bindings separately.
+func parseBinding(data []byte) (*Binding, [][]byte, error) {
+	binding := &Binding{}
+	var additionalBindingsData [][]byte
+	for len(data) > 0 {
+		fieldNumber, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, nil, errMalformed
+		}
+		data = data[n:]
+		if wireType != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(fieldNumber, wireType, data)
+			if n < 0 {
+				return nil, nil, errMalformed
+			}
+			data = data[n:]
+			continue
+		}
+		value, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return nil, nil, errMalformed
+		}
+		data = data[n:]
+		// The pattern is a oneof, so the last pattern wins.
+		if method, ok := httpRuleFieldNumberToMethod[fieldNumber]; ok {
+			binding.Method = method
+			binding.Custom = false
+			binding.Path = string(value)
+			continue
+		}
+		switch fieldNumber {
+		case httpRuleBodyFieldNumber:
+			binding.Body = string(value)
+		case httpRuleResponseBodyFieldNumber:
+			binding.ResponseBody = string(value)
+		case httpRuleCustomFieldNumber:
+			kind, path, err := parseCustomHTTPPattern(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			binding.Method = kind
+			binding.Custom = true
+			binding.Path = path
+		case httpRuleAdditionalBindingsFieldNumber:
+			additionalBindingsData = append(additionalBindingsData, value)
+		}
+	}
+	return binding, additionalBindingsData, nil
+}
+
+func parseCustomHTTPPattern(data []byte) (string, string, error) {
+	var kind string
+	var path string
+	for len(data) > 0 {
+		fieldNumber, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return "", "", errMalformed
+		}
+		data = data[n:]
+		if wireType == protowire.BytesType && (fieldNumber == customHTTPPatternKindFieldNumber || fieldNumber == customHTTPPatternPathFieldNumber) {
+			value, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return "", "", errMalformed
+			}
+			data = data[n:]
+			if fieldNumber == customHTTPPatternKindFieldNumber {
+				kind = string(value)
+			} else {
+				path = string(value)
+			}
+			continue
+		}
+		n = protowire.ConsumeFieldValue(fieldNumber, wireType, data)
+		if n < 0 {
+			return "", "", errMalformed
+		}
+		data = data[n:]
+	}
+	if kind == "" {
+		return "", "", fmt.Errorf("custom pattern with path %q has no kind", path)
+	}
+	return kind, path, nil
+}
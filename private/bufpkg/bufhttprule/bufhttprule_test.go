@@ -0,0 +1,120 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufhttprule_test
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufhttprule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestParseBindings(t *testing.T) {
+	t.Parallel()
+	var additionalBinding []byte
+	additionalBinding = appendString(additionalBinding, 4, "/v1/books:create")
+	additionalBinding = appendString(additionalBinding, 7, "*")
+	var customPattern []byte
+	customPattern = appendString(customPattern, 1, "HEAD")
+	customPattern = appendString(customPattern, 2, "/v1/books")
+	var data []byte
+	data = appendString(data, 1, "example.BookService.CreateBook")
+	data = appendString(data, 4, "/v1/books")
+	data = appendString(data, 7, "book")
+	data = appendString(data, 12, "name")
+	data = appendString(data, 11, string(additionalBinding))
+	data = appendString(data, 11, string(appendString(nil, 8, string(customPattern))))
+	bindings, err := bufhttprule.ParseBindings(data)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		[]*bufhttprule.Binding{
+			{
+				Method:       bufhttprule.MethodPost,
+				Path:         "/v1/books",
+				Body:         "book",
+				ResponseBody: "name",
+			},
+			{
+				Method:     bufhttprule.MethodPost,
+				Path:       "/v1/books:create",
+				Body:       "*",
+				Additional: true,
+			},
+			{
+				Method:     "HEAD",
+				Custom:     true,
+				Path:       "/v1/books",
+				Additional: true,
+			},
+		},
+		bindings,
+	)
+
+	var nestedData []byte
+	nestedData = appendString(nestedData, 2, "/v1/books")
+	nestedData = appendString(nestedData, 11, string(data))
+	_, err = bufhttprule.ParseBindings(nestedData)
+	assert.Error(t, err)
+
+	_, err = bufhttprule.ParseBindings(appendString(nil, 8, string(appendString(nil, 2, "/v1/books"))))
+	assert.Error(t, err)
+}
+
+func TestParseTemplate(t *testing.T) {
+	t.Parallel()
+	testParseTemplate(t, "/v1/books", "/v1/books")
+	testParseTemplate(t, "/v1/{name=shelves/*/books/*}", "/v1/shelves/*/books/*", "name")
+	testParseTemplate(t, "/v1/shelves/{shelf}/books/{book.id}:publish", "/v1/shelves/*/books/*:publish", "shelf", "book.id")
+	testParseTemplate(t, "/v1/{name=files/**}", "/v1/files/**", "name")
+	testParseTemplate(t, "/v1/*/books/**", "/v1/*/books/**")
+	testParseTemplate(t, "/v1/books/@latest", "/v1/books/@latest")
+	testParseTemplateError(t, "")
+	testParseTemplateError(t, "v1/books")
+	testParseTemplateError(t, "/v1//books")
+	testParseTemplateError(t, "/v1/books/")
+	testParseTemplateError(t, "/v1/{name")
+	testParseTemplateError(t, "/v1/{}")
+	testParseTemplateError(t, "/v1/{1name}")
+	testParseTemplateError(t, "/v1/{name=shelves/{shelf}}")
+	testParseTemplateError(t, "/v1/{name}/{name}")
+	testParseTemplateError(t, "/v1/**/books")
+	testParseTemplateError(t, "/v1/books:")
+	testParseTemplateError(t, "/v1/books?id=1")
+	testParseTemplateError(t, "/v1/book*")
+}
+
+func testParseTemplate(t *testing.T, path string, expectedPattern string, expectedFieldPaths ...string) {
+	template, err := bufhttprule.ParseTemplate(path)
+	require.NoError(t, err, path)
+	assert.Equal(t, expectedPattern, template.Pattern(), path)
+	var fieldPaths []string
+	for _, variable := range template.Variables {
+		fieldPaths = append(fieldPaths, variable.FieldPath)
+	}
+	assert.Equal(t, expectedFieldPaths, fieldPaths, path)
+}
+
+func testParseTemplateError(t *testing.T, path string) {
+	_, err := bufhttprule.ParseTemplate(path)
+	assert.Error(t, err, path)
+}
+
+func appendString(data []byte, fieldNumber protowire.Number, value string) []byte {
+	data = protowire.AppendTag(data, fieldNumber, protowire.BytesType)
+	return protowire.AppendString(data, value)
+}
@@ -0,0 +1,140 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufhttprule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// RouteKindHTTPRule is the kind of the Routes of google.api.http annotations.
+	RouteKindHTTPRule = "google.api.http"
+	// RouteKindConnect is the kind of the default Routes of the Connect protocol.
+	RouteKindConnect = "connect"
+)
+
+// Route is an HTTP route of an RPC.
+type Route struct {
+	// Kind is where the Route comes from, either RouteKindHTTPRule or RouteKindConnect.
+	Kind string
+	// HTTPMethod is the HTTP method, such as GET, or the kind of a custom pattern.
+	HTTPMethod string
+	// Path is the path template for RouteKindHTTPRule, or the path for RouteKindConnect.
+	Path string
+	// Body is the body of the binding for RouteKindHTTPRule.
+	Body string
+	// ResponseBody is the response body of the binding for RouteKindHTTPRule.
+	ResponseBody string
+	// Method is the RPC of the Route.
+	Method protosource.Method
+}
+
+// GetRoutes returns the Routes of all RPCs of the non-import files of the image,
+// sorted by path and HTTP method.
+//
+// If includeConnect is true, the default Connect routes of all RPCs are included. All
+// RPCs are available with POST at /package.Service/Method, and unary RPCs with an
+// idempotency_level of NO_SIDE_EFFECTS are also available with GET.
+//
+// Returns an error if any google.api.http annotation is invalid.
+func GetRoutes(ctx context.Context, image bufimage.Image, includeConnect bool) ([]*Route, error) {
+	files, err := protosource.NewFilesUnstable(
+		ctx,
+		bufimageutil.NewInputFiles(bufimage.ImageWithoutImports(image).Files())...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var routes []*Route
+	for _, file := range files {
+		for _, service := range file.Services() {
+			for _, method := range service.Methods() {
+				methodRoutes, err := getRoutesForMethod(method, includeConnect)
+				if err != nil {
+					return nil, err
+				}
+				routes = append(routes, methodRoutes...)
+			}
+		}
+	}
+	sort.SliceStable(
+		routes,
+		func(i int, j int) bool {
+			if routes[i].Path != routes[j].Path {
+				return routes[i].Path < routes[j].Path
+			}
+			return routes[i].HTTPMethod < routes[j].HTTPMethod
+		},
+	)
+	return routes, nil
+}
+
+func getRoutesForMethod(method protosource.Method, includeConnect bool) ([]*Route, error) {
+	bindings, err := BindingsForMethod(method)
+	if err != nil {
+		return nil, fmt.Errorf("RPC %q has an invalid google.api.http annotation: %w", method.FullName(), err)
+	}
+	var routes []*Route
+	for _, binding := range bindings {
+		if binding.Method == "" {
+			return nil, fmt.Errorf("RPC %q has a google.api.http binding without an HTTP method and path", method.FullName())
+		}
+		routes = append(
+			routes,
+			&Route{
+				Kind:         RouteKindHTTPRule,
+				HTTPMethod:   binding.Method,
+				Path:         binding.Path,
+				Body:         binding.Body,
+				ResponseBody: binding.ResponseBody,
+				Method:       method,
+			},
+		)
+	}
+	if !includeConnect {
+		return routes, nil
+	}
+	connectPath := "/" + method.Service().FullName() + "/" + method.Name()
+	routes = append(
+		routes,
+		&Route{
+			Kind:       RouteKindConnect,
+			HTTPMethod: MethodPost,
+			Path:       connectPath,
+			Method:     method,
+		},
+	)
+	if !method.ClientStreaming() &&
+		!method.ServerStreaming() &&
+		method.IdempotencyLevel() == descriptorpb.MethodOptions_NO_SIDE_EFFECTS {
+		routes = append(
+			routes,
+			&Route{
+				Kind:       RouteKindConnect,
+				HTTPMethod: MethodGet,
+				Path:       connectPath,
+				Method:     method,
+			},
+		)
+	}
+	return routes, nil
+}
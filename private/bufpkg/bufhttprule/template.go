@@ -0,0 +1,267 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufhttprule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// SegmentWildcard is the segment that matches a single path segment.
+	SegmentWildcard = "*"
+	// SegmentDoubleWildcard is the segment that matches zero or more path segments.
+	//
+	// It may only be the last segment of a template.
+	SegmentDoubleWildcard = "**"
+)
+
+// Template is a parsed path template.
+//
+// The syntax of path templates is:
+//
+//	Template = "/" Segments [ Verb ] ;
+//	Segments = Segment { "/" Segment } ;
+//	Segment  = "*" | "**" | LITERAL | Variable ;
+//	Variable = "{" FieldPath [ "=" Segments ] "}" ;
+//	FieldPath = IDENT { "." IDENT } ;
+//	Verb     = ":" LITERAL ;
+type Template struct {
+	// Segments are the segments of the path, where the segments
+	// of variables are inlined.
+	Segments []string
+	// Variables are the variables of the path, in order.
+	Variables []*Variable
+	// Verb is the verb of the path, or empty.
+	Verb string
+}
+
+// Pattern returns the path that the template matches, with the variables
+// replaced with their segments, such as "/v1/shelves/*/books/*:verb".
+//
+// Two templates with the same Pattern match the same paths.
+func (t *Template) Pattern() string {
+	pattern := "/" + strings.Join(t.Segments, "/")
+	if t.Verb != "" {
+		pattern += ":" + t.Verb
+	}
+	return pattern
+}
+
+// Variable is a variable of a path template.
+type Variable struct {
+	// FieldPath is the path of the request field that is bound to the variable,
+	// such as "book.name".
+	FieldPath string
+	// Segments are the segments that the variable matches.
+	//
+	// This is ["*"] if the variable does not specify segments.
+	Segments []string
+}
+
+// ParseTemplate parses the path template.
+func ParseTemplate(path string) (*Template, error) {
+	if path == "" {
+		return nil, errors.New("path is empty")
+	}
+	parser := &templateParser{path: path}
+	template, err := parser.parseTemplate()
+	if err != nil {
+		return nil, err
+	}
+	fieldPaths := make(map[string]struct{}, len(template.Variables))
+	for _, variable := range template.Variables {
+		if _, ok := fieldPaths[variable.FieldPath]; ok {
+			return nil, fmt.Errorf("field %q is bound more than once", variable.FieldPath)
+		}
+		fieldPaths[variable.FieldPath] = struct{}{}
+	}
+	for i, segment := range template.Segments {
+		if segment == SegmentDoubleWildcard && i != len(template.Segments)-1 {
+			return nil, fmt.Errorf("%q must be the last segment", SegmentDoubleWildcard)
+		}
+	}
+	return template, nil
+}
+
+type templateParser struct {
+	path     string
+	position int
+}
+
+func (p *templateParser) parseTemplate() (*Template, error) {
+	if !p.consume('/') {
+		return nil, errors.New(`path must start with "/"`)
+	}
+	template := &Template{}
+	segments, err := p.parseSegments(template)
+	if err != nil {
+		return nil, err
+	}
+	template.Segments = segments
+	if p.consume(':') {
+		verb := p.consumeLiteral()
+		if verb == "" {
+			return nil, errors.New("verb is empty")
+		}
+		template.Verb = verb
+	}
+	if !p.done() {
+		return nil, p.unexpectedError()
+	}
+	return template, nil
+}
+
+// parseSegments parses segments and adds variables to the template.
+//
+// If template is nil, variables are not allowed.
+func (p *templateParser) parseSegments(template *Template) ([]string, error) {
+	var segments []string
+	for {
+		switch {
+		case p.consumeString(SegmentDoubleWildcard):
+			segments = append(segments, SegmentDoubleWildcard)
+		case p.consumeString(SegmentWildcard):
+			segments = append(segments, SegmentWildcard)
+		case p.peek() == '{':
+			if template == nil {
+				return nil, errors.New("variables must not be nested")
+			}
+			variable, err := p.parseVariable()
+			if err != nil {
+				return nil, err
+			}
+			template.Variables = append(template.Variables, variable)
+			segments = append(segments, variable.Segments...)
+		default:
+			literal := p.consumeLiteral()
+			if literal == "" {
+				if p.done() || p.peek() == '/' {
+					return nil, errors.New("path must not have empty segments")
+				}
+				return nil, p.unexpectedError()
+			}
+			segments = append(segments, literal)
+		}
+		if !p.consume('/') {
+			return segments, nil
+		}
+	}
+}
+
+func (p *templateParser) parseVariable() (*Variable, error) {
+	p.consume('{')
+	start := p.position
+	for !p.done() && p.peek() != '=' && p.peek() != '}' {
+		p.position++
+	}
+	fieldPath := p.path[start:p.position]
+	if err := validateFieldPath(fieldPath); err != nil {
+		return nil, err
+	}
+	variable := &Variable{
+		FieldPath: fieldPath,
+		Segments:  []string{SegmentWildcard},
+	}
+	if p.consume('=') {
+		segments, err := p.parseSegments(nil)
+		if err != nil {
+			return nil, err
+		}
+		variable.Segments = segments
+	}
+	if !p.consume('}') {
+		return nil, fmt.Errorf("variable %q is not closed", fieldPath)
+	}
+	return variable, nil
+}
+
+// consumeLiteral consumes the characters that are allowed in literals, which are
+// the unreserved, sub-delims, and percent-encoded characters of RFC 3986, and "@".
+func (p *templateParser) consumeLiteral() string {
+	start := p.position
+	for !p.done() && isLiteralByte(p.peek()) {
+		p.position++
+	}
+	return p.path[start:p.position]
+}
+
+func (p *templateParser) consumeString(s string) bool {
+	if strings.HasPrefix(p.path[p.position:], s) {
+		p.position += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *templateParser) consume(c byte) bool {
+	if p.peek() == c {
+		p.position++
+		return true
+	}
+	return false
+}
+
+func (p *templateParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.path[p.position]
+}
+
+func (p *templateParser) done() bool {
+	return p.position >= len(p.path)
+}
+
+func (p *templateParser) unexpectedError() error {
+	return fmt.Errorf("unexpected character %q at position %d", p.peek(), p.position)
+}
+
+func validateFieldPath(fieldPath string) error {
+	if fieldPath == "" {
+		return errors.New("variable has no field path")
+	}
+	for _, name := range strings.Split(fieldPath, ".") {
+		if !isIdent(name) {
+			return fmt.Errorf("variable %q is not a valid field path", fieldPath)
+		}
+	}
+	return nil
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case '0' <= c && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isLiteralByte(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("-._~%!$&'()+,;=@", c) >= 0
+}
@@ -15,14 +15,19 @@
 package bufimage
 
 import (
+	"context"
 	"fmt"
 	"sort"
+	"strings"
+	"sync/atomic"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	imagev1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/image/v1"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/protodescriptor"
 	"github.com/bufbuild/buf/private/pkg/protoencoding"
+	"github.com/bufbuild/buf/private/pkg/thread"
+	"github.com/bufbuild/buf/private/pkg/timing"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 )
@@ -69,7 +74,12 @@ func NewImageFile(
 	isImport bool,
 	isSyntaxUnspecified bool,
 	unusedDependencyIndexes []int32,
+	options ...ImageFileOption,
 ) (ImageFile, error) {
+	imageFileOptions := newImageFileOptions()
+	for _, option := range options {
+		option(imageFileOptions)
+	}
 	return newImageFile(
 		fileDescriptor,
 		moduleIdentity,
@@ -78,9 +88,24 @@ func NewImageFile(
 		isImport,
 		isSyntaxUnspecified,
 		unusedDependencyIndexes,
+		imageFileOptions.getSourceCodeInfo,
 	)
 }
 
+// ImageFileOption is an option for NewImageFile.
+type ImageFileOption func(*imageFileOptions)
+
+// ImageFileWithLazySourceCodeInfo returns a new ImageFileOption that gets the
+// SourceCodeInfo of the ImageFile with the given function when LoadSourceCodeInfo
+// is called, if the FileDescriptor does not already have SourceCodeInfo.
+//
+// The function is called at most once.
+func ImageFileWithLazySourceCodeInfo(getSourceCodeInfo func() (*descriptorpb.SourceCodeInfo, error)) ImageFileOption {
+	return func(imageFileOptions *imageFileOptions) {
+		imageFileOptions.getSourceCodeInfo = getSourceCodeInfo
+	}
+}
+
 // Image is a buf image.
 type Image interface {
 	// Files are the files that comprise the image.
@@ -149,6 +174,123 @@ func MergeImages(images ...Image) (Image, error) {
 	}
 }
 
+// MergeConflictResolution determines how MergeImagesWithConflictResolution handles
+// a file that is defined differently in multiple Images.
+type MergeConflictResolution int
+
+const (
+	// MergeConflictResolutionFail returns an *ImageMergeConflictError.
+	MergeConflictResolutionFail MergeConflictResolution = iota + 1
+	// MergeConflictResolutionPreferFirst uses the definition from the first Image
+	// that defines the file.
+	MergeConflictResolutionPreferFirst
+	// MergeConflictResolutionPreferLast uses the definition from the last Image
+	// that defines the file.
+	MergeConflictResolutionPreferLast
+)
+
+// ImageMergeConflict is a file that is defined differently in multiple Images.
+type ImageMergeConflict struct {
+	// Path is the path of the file.
+	Path string
+	// ImageIndexes are the indexes of the Images that define the file, in order.
+	ImageIndexes []int
+}
+
+// ImageMergeConflictError is returned by MergeImagesWithConflictResolution
+// with MergeConflictResolutionFail if any file is defined differently in
+// multiple Images.
+type ImageMergeConflictError struct {
+	// Conflicts are the conflicting files, sorted by path.
+	Conflicts []ImageMergeConflict
+}
+
+// Error implements error.
+func (e *ImageMergeConflictError) Error() string {
+	paths := make([]string, len(e.Conflicts))
+	for i, conflict := range e.Conflicts {
+		paths[i] = conflict.Path
+	}
+	return fmt.Sprintf("files defined differently in multiple images: %s", strings.Join(paths, ", "))
+}
+
+// MergeImagesWithConflictResolution returns a new Image for the given Images.
+//
+// Unlike MergeImages, a file may be a non-import in multiple Images. Files that
+// are defined identically in multiple Images, ignoring source code info, are
+// merged as in MergeImages. Files that are defined differently are resolved
+// with the given MergeConflictResolution.
+//
+// Reorders the ImageFiles to be in DAG order.
+func MergeImagesWithConflictResolution(resolution MergeConflictResolution, images ...Image) (Image, error) {
+	switch resolution {
+	case MergeConflictResolutionFail, MergeConflictResolutionPreferFirst, MergeConflictResolutionPreferLast:
+	default:
+		return nil, fmt.Errorf("unknown MergeConflictResolution: %v", resolution)
+	}
+	if len(images) == 0 {
+		return nil, nil
+	}
+	var paths []string
+	pathToImageIndexes := make(map[string][]int)
+	pathToConflict := make(map[string]bool)
+	for i, image := range images {
+		for _, imageFile := range image.Files() {
+			path := imageFile.Path()
+			imageIndexes, ok := pathToImageIndexes[path]
+			if !ok {
+				paths = append(paths, path)
+			} else if !imageFilesEqual(images[imageIndexes[0]].GetFile(path), imageFile) {
+				pathToConflict[path] = true
+			}
+			pathToImageIndexes[path] = append(imageIndexes, i)
+		}
+	}
+	if resolution == MergeConflictResolutionFail && len(pathToConflict) > 0 {
+		conflicts := make([]ImageMergeConflict, 0, len(pathToConflict))
+		for path := range pathToConflict {
+			conflicts = append(conflicts, ImageMergeConflict{
+				Path:         path,
+				ImageIndexes: pathToImageIndexes[path],
+			})
+		}
+		sort.Slice(conflicts, func(i int, j int) bool { return conflicts[i].Path < conflicts[j].Path })
+		return nil, &ImageMergeConflictError{Conflicts: conflicts}
+	}
+	imageFiles := make([]ImageFile, 0, len(paths))
+	for _, path := range paths {
+		imageIndexes := pathToImageIndexes[path]
+		isImport := true
+		for _, imageIndex := range imageIndexes {
+			if !images[imageIndex].GetFile(path).IsImport() {
+				isImport = false
+				break
+			}
+		}
+		var imageFile ImageFile
+		switch {
+		case pathToConflict[path] && resolution == MergeConflictResolutionPreferLast:
+			imageFile = images[imageIndexes[len(imageIndexes)-1]].GetFile(path)
+		case pathToConflict[path]:
+			imageFile = images[imageIndexes[0]].GetFile(path)
+		default:
+			// Prefer the first non-import version of the file, as in MergeImages.
+			imageFile = images[imageIndexes[0]].GetFile(path)
+			for _, imageIndex := range imageIndexes {
+				if candidate := images[imageIndex].GetFile(path); !candidate.IsImport() {
+					imageFile = candidate
+					break
+				}
+			}
+		}
+		if imageFile.IsImport() != isImport {
+			imageFile = imageFile.withIsImport(isImport)
+		}
+		imageFiles = append(imageFiles, imageFile)
+	}
+	return newImage(imageFiles, true)
+}
+
 // NewImageForProto returns a new Image for the given proto Image.
 //
 // The input Files are expected to be in correct DAG order!
@@ -351,6 +493,33 @@ func ImageByDir(image Image) ([]Image, error) {
 	return newImages, nil
 }
 
+// LoadSourceCodeInfo loads the SourceCodeInfo of the files of the Image that were
+// created with ImageFileWithLazySourceCodeInfo.
+//
+// Files that already have SourceCodeInfo, or were not created with
+// ImageFileWithLazySourceCodeInfo, are left unchanged. To only load the SourceCodeInfo
+// of the non-import files, call this with ImageWithoutImports.
+//
+// The backing Files are modified, so this affects all Images that share them.
+func LoadSourceCodeInfo(ctx context.Context, image Image) error {
+	_, err := loadSourceCodeInfo(ctx, image.Files())
+	return err
+}
+
+// LoadSourceCodeInfoForPaths is LoadSourceCodeInfo for the files of the Image
+// with the given paths. Paths that are not in the Image are ignored.
+//
+// Returns true if the SourceCodeInfo of any of these files was loaded by this call.
+func LoadSourceCodeInfoForPaths(ctx context.Context, image Image, paths []string) (bool, error) {
+	imageFiles := make([]ImageFile, 0, len(paths))
+	for _, path := range paths {
+		if imageFile := image.GetFile(path); imageFile != nil {
+			imageFiles = append(imageFiles, imageFile)
+		}
+	}
+	return loadSourceCodeInfo(ctx, imageFiles)
+}
+
 // ImageToProtoImage returns a new ProtoImage for the Image.
 func ImageToProtoImage(image Image) *imagev1.Image {
 	imageFiles := image.Files()
@@ -467,6 +636,40 @@ func ProtoImageToFileDescriptors(protoImage *imagev1.Image) []protodescriptor.Fi
 	return protoImageFilesToFileDescriptors(protoImage.File)
 }
 
+func loadSourceCodeInfo(ctx context.Context, imageFiles []ImageFile) (bool, error) {
+	defer timing.Start(ctx, timing.PhaseSourceCodeInfo)()
+	var loaded atomic.Bool
+	jobs := make([]func(context.Context) error, 0, len(imageFiles))
+	for _, file := range imageFiles {
+		lazyImageFile, ok := file.(*imageFile)
+		if !ok || lazyImageFile.sourceCodeInfoLoader == nil {
+			continue
+		}
+		jobs = append(
+			jobs,
+			func(context.Context) error {
+				fileLoaded, err := lazyImageFile.loadSourceCodeInfo()
+				if fileLoaded {
+					loaded.Store(true)
+				}
+				return err
+			},
+		)
+	}
+	if err := thread.Parallelize(ctx, jobs); err != nil {
+		return false, err
+	}
+	return loaded.Load(), nil
+}
+
+type imageFileOptions struct {
+	getSourceCodeInfo func() (*descriptorpb.SourceCodeInfo, error)
+}
+
+func newImageFileOptions() *imageFileOptions {
+	return &imageFileOptions{}
+}
+
 type newImageForProtoOptions struct {
 	noReparse            bool
 	computeUnusedImports bool
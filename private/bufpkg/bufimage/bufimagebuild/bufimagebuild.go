@@ -55,3 +55,30 @@ func WithExcludeSourceCodeInfo() BuildOption {
 		buildOptions.excludeSourceCodeInfo = true
 	}
 }
+
+// WithLazySourceCodeInfo returns a BuildOption that does not compute the
+// sourceCodeInfo during compilation, and instead computes it for each file when
+// bufimage.LoadSourceCodeInfo is called on the Image.
+//
+// This reduces the memory used by compilation for consumers that only need the
+// sourceCodeInfo of some files, such as the non-imports when linting. Computing the
+// sourceCodeInfo of a file later requires parsing and linking the file again.
+//
+// This option has no effect if WithExcludeSourceCodeInfo is set.
+func WithLazySourceCodeInfo() BuildOption {
+	return func(buildOptions *buildOptions) {
+		buildOptions.lazySourceCodeInfo = true
+	}
+}
+
+// WithWarnings returns a BuildOption that passes the compiler warnings for the target
+// files, such as unused imports or a missing syntax declaration, to warningsFunc.
+//
+// Warnings are FileAnnotations with bufanalysis.SeverityWarning, and never fail the
+// build. warningsFunc is only called if the build succeeds and there is at least one
+// warning.
+func WithWarnings(warningsFunc func([]bufanalysis.FileAnnotation)) BuildOption {
+	return func(buildOptions *buildOptions) {
+		buildOptions.warningsFunc = warningsFunc
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"testing"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
@@ -32,12 +33,25 @@ import (
 	"github.com/bufbuild/buf/private/pkg/prototesting"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
 	"github.com/bufbuild/buf/private/pkg/tmp"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"golang.org/x/tools/txtar"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+// BuildImage builds the Image for the module in the directory at the given path for testing.
+//
+// The module must build without any FileAnnotations.
+func BuildImage(t testing.TB, ctx context.Context, dirPath string) bufimage.Image {
+	moduleFileSet, err := fuzzGetModuleFileSet(ctx, dirPath)
+	require.NoError(t, err)
+	image, fileAnnotations, err := bufimagebuild.NewBuilder(zap.NewNop()).Build(ctx, moduleFileSet)
+	require.NoError(t, err)
+	require.Empty(t, fileAnnotations)
+	return image
+}
+
 // Fuzz is the entrypoint for the fuzzer.
 // We use https://github.com/dvyukov/go-fuzz for fuzzing.
 // Please follow the instructions
@@ -18,22 +18,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufimage"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleprotocompile"
 	"github.com/bufbuild/buf/private/pkg/thread"
+	"github.com/bufbuild/buf/private/pkg/timing"
 	"github.com/bufbuild/protocompile"
 	"github.com/bufbuild/protocompile/linker"
+	"github.com/bufbuild/protocompile/options"
 	"github.com/bufbuild/protocompile/parser"
 	"github.com/bufbuild/protocompile/protoutil"
 	"github.com/bufbuild/protocompile/reporter"
+	"github.com/bufbuild/protocompile/sourceinfo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const (
@@ -44,12 +50,18 @@ const (
 type builder struct {
 	logger *zap.Logger
 	tracer trace.Tracer
+	// warnedShadowedWellKnownTypeLocations are the locations of the shadowed
+	// well-known types that were already warned about, as the same file is
+	// typically opened by the builds of multiple workspace modules.
+	warnedShadowedWellKnownTypeLocations map[string]struct{}
+	lock                                 sync.Mutex
 }
 
 func newBuilder(logger *zap.Logger) *builder {
 	return &builder{
-		logger: logger.Named(loggerName),
-		tracer: otel.GetTracerProvider().Tracer(tracerName),
+		logger:                               logger.Named(loggerName),
+		tracer:                               otel.GetTracerProvider().Tracer(tracerName),
+		warnedShadowedWellKnownTypeLocations: make(map[string]struct{}),
 	}
 }
 
@@ -66,6 +78,8 @@ func (b *builder) Build(
 		ctx,
 		moduleFileSet,
 		buildOptions.excludeSourceCodeInfo,
+		buildOptions.lazySourceCodeInfo,
+		buildOptions.warningsFunc,
 	)
 }
 
@@ -73,6 +87,8 @@ func (b *builder) build(
 	ctx context.Context,
 	moduleFileSet bufmodule.ModuleFileSet,
 	excludeSourceCodeInfo bool,
+	lazySourceCodeInfo bool,
+	warningsFunc func([]bufanalysis.FileAnnotation),
 ) (_ bufimage.Image, _ []bufanalysis.FileAnnotation, retErr error) {
 	ctx, span := b.tracer.Start(ctx, "build")
 	defer span.End()
@@ -83,7 +99,11 @@ func (b *builder) build(
 		}
 	}()
 
-	parserAccessorHandler := bufmoduleprotocompile.NewParserAccessorHandler(ctx, moduleFileSet)
+	parserAccessorHandler := bufmoduleprotocompile.NewParserAccessorHandler(
+		ctx,
+		moduleFileSet,
+		bufmoduleprotocompile.ParserAccessorHandlerWithWellKnownTypesStrategy(moduleFileSet.WellKnownTypesStrategy()),
+	)
 	targetFileInfos, err := moduleFileSet.TargetFileInfos(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -100,8 +120,11 @@ func (b *builder) build(
 		ctx,
 		parserAccessorHandler,
 		paths,
-		excludeSourceCodeInfo,
+		excludeSourceCodeInfo || lazySourceCodeInfo,
 	)
+	// Shadowed well-known types are a common cause of build failures, so these
+	// are reported even if the build fails.
+	b.warnShadowedWellKnownTypes(parserAccessorHandler)
 	if buildResult.Err != nil {
 		return nil, nil, buildResult.Err
 	}
@@ -116,6 +139,7 @@ func (b *builder) build(
 	image, err := getImage(
 		ctx,
 		excludeSourceCodeInfo,
+		lazySourceCodeInfo && !excludeSourceCodeInfo,
 		fileDescriptors,
 		parserAccessorHandler,
 		buildResult.SyntaxUnspecifiedFilenames,
@@ -125,9 +149,79 @@ func (b *builder) build(
 	if err != nil {
 		return nil, nil, err
 	}
+	if warningsFunc != nil {
+		warnings, err := getWarnings(ctx, parserAccessorHandler, paths, buildResult.WarningErrorsWithPos)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(warnings) > 0 {
+			warningsFunc(warnings)
+		}
+	}
 	return image, nil, nil
 }
 
+// warnShadowedWellKnownTypes warns about every module file that was used instead
+// of the well-known type bundled with buf.
+func (b *builder) warnShadowedWellKnownTypes(parserAccessorHandler bufmoduleprotocompile.ParserAccessorHandler) {
+	for _, path := range parserAccessorHandler.ShadowedWellKnownTypePaths() {
+		location := parserAccessorHandler.ExternalPath(path)
+		if moduleIdentity := parserAccessorHandler.ModuleIdentity(path); moduleIdentity != nil {
+			location = moduleIdentity.IdentityString()
+			if commit := parserAccessorHandler.Commit(path); commit != "" {
+				location += ":" + commit
+			}
+			location = path + " from " + location
+		}
+		b.lock.Lock()
+		_, warned := b.warnedShadowedWellKnownTypeLocations[location]
+		b.warnedShadowedWellKnownTypeLocations[location] = struct{}{}
+		b.lock.Unlock()
+		if warned {
+			continue
+		}
+		b.logger.Sugar().Warnf(
+			"%s shadows the well-known type bundled with buf. Set well_known_types to %q in buf.work.yaml to always use the well-known types provided by the workspace and its dependencies, or to %q to always use the bundled versions",
+			location,
+			bufmodule.WellKnownTypesStrategyModule.String(),
+			bufmodule.WellKnownTypesStrategyBundled.String(),
+		)
+	}
+}
+
+// getWarnings gets the FileAnnotations for the compiler warnings of the target paths.
+//
+// Warnings for imported files are dropped, as these files are not being built.
+func getWarnings(
+	ctx context.Context,
+	parserAccessorHandler bufmoduleprotocompile.ParserAccessorHandler,
+	paths []string,
+	warningErrorsWithPos []reporter.ErrorWithPos,
+) ([]bufanalysis.FileAnnotation, error) {
+	pathMap := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		pathMap[path] = struct{}{}
+	}
+	var targetWarningErrorsWithPos []reporter.ErrorWithPos
+	for _, warningErrorWithPos := range warningErrorsWithPos {
+		if _, ok := pathMap[warningErrorWithPos.GetPosition().Filename]; ok {
+			targetWarningErrorsWithPos = append(targetWarningErrorsWithPos, warningErrorWithPos)
+		}
+	}
+	if len(targetWarningErrorsWithPos) == 0 {
+		return nil, nil
+	}
+	warnings, err := bufmoduleprotocompile.GetWarningFileAnnotations(
+		ctx,
+		parserAccessorHandler,
+		targetWarningErrorsWithPos,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return bufanalysis.DeduplicateAndSortFileAnnotations(warnings), nil
+}
+
 func getBuildResult(
 	ctx context.Context,
 	parserAccessorHandler bufmoduleprotocompile.ParserAccessorHandler,
@@ -155,7 +249,9 @@ func getBuildResult(
 		),
 	}
 	// fileDescriptors are in the same order as paths per the documentation
+	stopTiming := timing.Start(ctx, timing.PhaseCompile)
 	compiledFiles, err := compiler.Compile(ctx, paths...)
+	stopTiming()
 	if err != nil {
 		if err == reporter.ErrInvalidSource {
 			if len(errorsWithPos) == 0 {
@@ -164,6 +260,7 @@ func getBuildResult(
 					nil,
 					nil,
 					nil,
+					nil,
 					errors.New("got invalid source error from parse but no errors reported"),
 				)
 			}
@@ -173,9 +270,9 @@ func getBuildResult(
 				errorsWithPos,
 			)
 			if err != nil {
-				return newBuildResult(nil, nil, nil, nil, err)
+				return newBuildResult(nil, nil, nil, nil, nil, err)
 			}
-			return newBuildResult(nil, nil, nil, fileAnnotations, nil)
+			return newBuildResult(nil, nil, nil, nil, fileAnnotations, nil)
 		}
 		if errorWithPos, ok := err.(reporter.ErrorWithPos); ok {
 			fileAnnotations, err := bufmoduleprotocompile.GetFileAnnotations(
@@ -184,11 +281,11 @@ func getBuildResult(
 				[]reporter.ErrorWithPos{errorWithPos},
 			)
 			if err != nil {
-				return newBuildResult(nil, nil, nil, nil, err)
+				return newBuildResult(nil, nil, nil, nil, nil, err)
 			}
-			return newBuildResult(nil, nil, nil, fileAnnotations, nil)
+			return newBuildResult(nil, nil, nil, nil, fileAnnotations, nil)
 		}
-		return newBuildResult(nil, nil, nil, nil, err)
+		return newBuildResult(nil, nil, nil, nil, nil, err)
 	} else if len(errorsWithPos) > 0 {
 		// https://github.com/jhump/protoreflect/pull/331
 		return newBuildResult(
@@ -196,6 +293,7 @@ func getBuildResult(
 			nil,
 			nil,
 			nil,
+			nil,
 			errors.New("got no error from parse but errors reported"),
 		)
 	}
@@ -205,6 +303,7 @@ func getBuildResult(
 			nil,
 			nil,
 			nil,
+			nil,
 			fmt.Errorf("expected FileDescriptors to be of length %d but was %d", len(paths), len(compiledFiles)),
 		)
 	}
@@ -219,6 +318,7 @@ func getBuildResult(
 				nil,
 				nil,
 				nil,
+				nil,
 				fmt.Errorf("expected fileDescriptor name %s to be a equal to %s", filename, path),
 			)
 		}
@@ -237,6 +337,7 @@ func getBuildResult(
 		fileDescriptors,
 		syntaxUnspecifiedFilenames,
 		filenameToUnusedDependencyFilenames,
+		warningErrorsWithPos,
 		nil,
 		nil,
 	)
@@ -284,6 +385,7 @@ func checkAndSortFileDescriptors(
 func getImage(
 	ctx context.Context,
 	excludeSourceCodeInfo bool,
+	lazySourceCodeInfo bool,
 	sortedFileDescriptors []protoreflect.FileDescriptor,
 	parserAccessorHandler bufmoduleprotocompile.ParserAccessorHandler,
 	syntaxUnspecifiedFilenames map[string]struct{},
@@ -313,6 +415,7 @@ func getImage(
 		imageFiles, err = getImageFilesRec(
 			ctx,
 			excludeSourceCodeInfo,
+			lazySourceCodeInfo,
 			fileDescriptor,
 			parserAccessorHandler,
 			syntaxUnspecifiedFilenames,
@@ -338,6 +441,7 @@ func getImage(
 func getImageFilesRec(
 	ctx context.Context,
 	excludeSourceCodeInfo bool,
+	lazySourceCodeInfo bool,
 	fileDescriptor protoreflect.FileDescriptor,
 	parserAccessorHandler bufmoduleprotocompile.ParserAccessorHandler,
 	syntaxUnspecifiedFilenames map[string]struct{},
@@ -374,6 +478,7 @@ func getImageFilesRec(
 		imageFiles, err = getImageFilesRec(
 			ctx,
 			excludeSourceCodeInfo,
+			lazySourceCodeInfo,
 			dependency,
 			parserAccessorHandler,
 			syntaxUnspecifiedFilenames,
@@ -395,6 +500,17 @@ func getImageFilesRec(
 		// need to do this anyways as Parser does not respect this for FileDescriptorProtos
 		fileDescriptorProto.SourceCodeInfo = nil
 	}
+	var imageFileOptions []bufimage.ImageFileOption
+	if lazySourceCodeInfo {
+		imageFileOptions = append(
+			imageFileOptions,
+			bufimage.ImageFileWithLazySourceCodeInfo(
+				func() (*descriptorpb.SourceCodeInfo, error) {
+					return getSourceCodeInfo(parserAccessorHandler, fileDescriptor)
+				},
+			),
+		)
+	}
 	_, isNotImport := nonImportFilenames[path]
 	_, syntaxUnspecified := syntaxUnspecifiedFilenames[path]
 	imageFile, err := bufimage.NewImageFile(
@@ -406,6 +522,7 @@ func getImageFilesRec(
 		!isNotImport,
 		syntaxUnspecified,
 		unusedDependencyIndexes,
+		imageFileOptions...,
 	)
 	if err != nil {
 		return nil, err
@@ -413,6 +530,52 @@ func getImageFilesRec(
 	return append(imageFiles, imageFile), nil
 }
 
+// getSourceCodeInfo computes the standard SourceCodeInfo for the file by parsing and
+// linking it again against its already compiled imports.
+//
+// Linking and interpreting the options is needed to compute the locations of options.
+func getSourceCodeInfo(
+	parserAccessorHandler bufmoduleprotocompile.ParserAccessorHandler,
+	fileDescriptor protoreflect.FileDescriptor,
+) (_ *descriptorpb.SourceCodeInfo, retErr error) {
+	path := fileDescriptor.Path()
+	readCloser, err := parserAccessorHandler.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, readCloser.Close())
+	}()
+	// The file was already compiled successfully, so any error is a system error.
+	handler := reporter.NewHandler(nil)
+	fileNode, err := parser.Parse(path, readCloser, handler)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q for source code info: %w", path, err)
+	}
+	parseResult, err := parser.ResultFromAST(fileNode, true, handler)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q for source code info: %w", path, err)
+	}
+	imports := fileDescriptor.Imports()
+	dependencies := make(linker.Files, imports.Len())
+	for i := 0; i < imports.Len(); i++ {
+		dependency, err := linker.NewFileRecursive(imports.Get(i).FileDescriptor)
+		if err != nil {
+			return nil, err
+		}
+		dependencies[i] = dependency
+	}
+	linkResult, err := linker.Link(parseResult, dependencies, nil, handler)
+	if err != nil {
+		return nil, fmt.Errorf("could not link %q for source code info: %w", path, err)
+	}
+	optionsIndex, err := options.InterpretOptions(linkResult, handler)
+	if err != nil {
+		return nil, fmt.Errorf("could not interpret options of %q for source code info: %w", path, err)
+	}
+	return sourceinfo.GenerateSourceInfo(fileNode, optionsIndex), nil
+}
+
 func maybeAddSyntaxUnspecified(
 	syntaxUnspecifiedFilenames map[string]struct{},
 	errorWithPos reporter.ErrorWithPos,
@@ -444,6 +607,7 @@ type buildResult struct {
 	FileDescriptors                     []protoreflect.FileDescriptor
 	SyntaxUnspecifiedFilenames          map[string]struct{}
 	FilenameToUnusedDependencyFilenames map[string]map[string]struct{}
+	WarningErrorsWithPos                []reporter.ErrorWithPos
 	FileAnnotations                     []bufanalysis.FileAnnotation
 	Err                                 error
 }
@@ -452,6 +616,7 @@ func newBuildResult(
 	fileDescriptors []protoreflect.FileDescriptor,
 	syntaxUnspecifiedFilenames map[string]struct{},
 	filenameToUnusedDependencyFilenames map[string]map[string]struct{},
+	warningErrorsWithPos []reporter.ErrorWithPos,
 	fileAnnotations []bufanalysis.FileAnnotation,
 	err error,
 ) *buildResult {
@@ -459,6 +624,7 @@ func newBuildResult(
 		FileDescriptors:                     fileDescriptors,
 		SyntaxUnspecifiedFilenames:          syntaxUnspecifiedFilenames,
 		FilenameToUnusedDependencyFilenames: filenameToUnusedDependencyFilenames,
+		WarningErrorsWithPos:                warningErrorsWithPos,
 		FileAnnotations:                     fileAnnotations,
 		Err:                                 err,
 	}
@@ -466,6 +632,8 @@ func newBuildResult(
 
 type buildOptions struct {
 	excludeSourceCodeInfo bool
+	lazySourceCodeInfo    bool
+	warningsFunc          func([]bufanalysis.FileAnnotation)
 }
 
 func newBuildOptions() *buildOptions {
@@ -40,6 +40,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 var buftestingDirPath = filepath.Join(
@@ -238,6 +239,12 @@ func TestCompareTrailingComments(t *testing.T) {
 	testCompare(t, runner, "trailingcomments")
 }
 
+func TestLazySourceCodeInfo(t *testing.T) {
+	t.Parallel()
+	testLazySourceCodeInfo(t, "customoptions1")
+	testLazySourceCodeInfo(t, "trailingcomments")
+}
+
 func TestCustomOptionsError1(t *testing.T) {
 	t.Parallel()
 	testFileAnnotations(
@@ -272,14 +279,61 @@ func TestCyclicImport(t *testing.T) {
 		t,
 		"cyclicimport",
 		// Since the compiler is multi-threaded, order of file compilation can happen one of two ways
-		fmt.Sprintf(`%s:5:8:cycle found in imports: "a/a.proto" -> "b/b.proto" -> "a/a.proto"
-				|| %s:5:8:cycle found in imports: "b/b.proto" -> "a/a.proto" -> "b/b.proto"`,
+		fmt.Sprintf(`%s:5:8:import cycle: a/a.proto -> b/b.proto -> a/a.proto; consider removing the import of "b/b.proto" from a/a.proto to break the cycle
+				|| %s:5:8:import cycle: b/b.proto -> a/a.proto -> b/b.proto; consider removing the import of "b/b.proto" from a/a.proto to break the cycle`,
 			filepath.FromSlash("testdata/cyclicimport/a/a.proto"),
 			filepath.FromSlash("testdata/cyclicimport/b/b.proto"),
 		),
 	)
 }
 
+func TestCyclicImportRelatedInformation(t *testing.T) {
+	t.Parallel()
+	_, fileAnnotations := testBuild(t, false, filepath.Join("testdata", "cyclicimport"))
+	require.NotEmpty(t, fileAnnotations)
+	relatedInformation := fileAnnotations[0].RelatedInformation()
+	require.Len(t, relatedInformation, 2)
+	relatedInformationStrings := []string{
+		relatedInformation[0].String(),
+		relatedInformation[1].String(),
+	}
+	// every import of the cycle is reported, starting from the file the compiler reported
+	assert.ElementsMatch(
+		t,
+		[]string{
+			filepath.FromSlash(`testdata/cyclicimport/a/a.proto:5:8:a/a.proto imports "b/b.proto"`),
+			filepath.FromSlash(`testdata/cyclicimport/b/b.proto:5:8:b/b.proto imports "a/a.proto"`),
+		},
+		relatedInformationStrings,
+	)
+}
+
+func TestWarnings(t *testing.T) {
+	t.Parallel()
+	moduleFileSet := testGetModuleFileSet(t, filepath.Join("testdata", "warnings"))
+	var warnings []bufanalysis.FileAnnotation
+	image, fileAnnotations, err := NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		moduleFileSet,
+		WithExcludeSourceCodeInfo(),
+		WithWarnings(
+			func(moduleWarnings []bufanalysis.FileAnnotation) {
+				warnings = append(warnings, moduleWarnings...)
+			},
+		),
+	)
+	require.NoError(t, err)
+	require.Empty(t, fileAnnotations)
+	require.NotNil(t, image)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, bufanalysis.SeverityWarning, warnings[0].Severity())
+	assert.Equal(
+		t,
+		filepath.FromSlash(`testdata/warnings/a/a.proto:5:1:warning: import "b/b.proto" not used`),
+		warnings[0].String(),
+	)
+}
+
 func TestDuplicateSyntheticOneofs(t *testing.T) {
 	// https://github.com/bufbuild/buf/issues/1071
 	t.Parallel()
@@ -296,6 +350,25 @@ func TestDuplicateSyntheticOneofs(t *testing.T) {
 	)
 }
 
+func TestDuplicateSyntheticOneofsRelatedInformation(t *testing.T) {
+	t.Parallel()
+	_, fileAnnotations := testBuild(t, false, filepath.Join("testdata", "duplicatesyntheticoneofs"))
+	require.Equal(t, 3, len(fileAnnotations))
+	for _, fileAnnotation := range fileAnnotations {
+		relatedInformation := fileAnnotation.RelatedInformation()
+		require.Equal(t, 1, len(relatedInformation))
+		assert.Equal(t, "previously defined here", relatedInformation[0].Message())
+		assert.Equal(t, fileAnnotation.StartLine(), relatedInformation[0].StartLine())
+		assert.Equal(t, fileAnnotation.StartColumn(), relatedInformation[0].StartColumn())
+		assert.NotEqual(t, fileAnnotation.FileInfo().Path(), relatedInformation[0].FileInfo().Path())
+		// Both span the name of the definition, which is "Foo" or "bar".
+		assert.Equal(t, fileAnnotation.StartLine(), fileAnnotation.EndLine())
+		assert.Equal(t, fileAnnotation.StartColumn()+3, fileAnnotation.EndColumn())
+		assert.Equal(t, fileAnnotation.EndLine(), relatedInformation[0].EndLine())
+		assert.Equal(t, fileAnnotation.EndColumn(), relatedInformation[0].EndColumn())
+	}
+}
+
 func TestOptionPanic(t *testing.T) {
 	t.Parallel()
 	require.NotPanics(t, func() {
@@ -325,6 +398,37 @@ func testCompare(t *testing.T, runner command.Runner, relDirPath string) {
 	prototesting.AssertFileDescriptorSetsEqual(t, runner, fileDescriptorSet, actualProtocFileDescriptorSet)
 }
 
+func testLazySourceCodeInfo(t *testing.T, relDirPath string) {
+	dirPath := filepath.Join("testdata", relDirPath)
+	image, fileAnnotations := testBuild(t, true, dirPath)
+	require.Equal(t, 0, len(fileAnnotations), fileAnnotations)
+	lazyImage, fileAnnotations, err := NewBuilder(zap.NewNop()).Build(
+		context.Background(),
+		testGetModuleFileSet(t, dirPath),
+		WithLazySourceCodeInfo(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(fileAnnotations), fileAnnotations)
+	for _, imageFile := range lazyImage.Files() {
+		assert.Nil(t, imageFile.Proto().GetSourceCodeInfo(), imageFile.Path())
+	}
+	require.NoError(t, bufimage.LoadSourceCodeInfo(context.Background(), bufimage.ImageWithoutImports(lazyImage)))
+	for _, imageFile := range lazyImage.Files() {
+		if imageFile.IsImport() {
+			assert.Nil(t, imageFile.Proto().GetSourceCodeInfo(), imageFile.Path())
+			continue
+		}
+		expectedImageFile := image.GetFile(imageFile.Path())
+		require.NotNil(t, expectedImageFile, imageFile.Path())
+		require.NotNil(t, imageFile.Proto().GetSourceCodeInfo(), imageFile.Path())
+		assert.True(
+			t,
+			proto.Equal(expectedImageFile.Proto().GetSourceCodeInfo(), imageFile.Proto().GetSourceCodeInfo()),
+			imageFile.Path(),
+		)
+	}
+}
+
 func testBuildGoogleapis(t *testing.T, includeSourceInfo bool) bufimage.Image {
 	googleapisDirPath := buftesting.GetGoogleapisDirPath(t, buftestingDirPath)
 	image, fileAnnotations := testBuild(t, includeSourceInfo, googleapisDirPath)
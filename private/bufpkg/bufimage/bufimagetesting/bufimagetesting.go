@@ -26,6 +26,22 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// NewImage returns a new Image for testing that contains the given FileDescriptors.
+//
+// The ImageFiles have no module identity and their external paths are their paths.
+func NewImage(
+	t testing.TB,
+	fileDescriptors ...protodescriptor.FileDescriptor,
+) bufimage.Image {
+	imageFiles := make([]bufimage.ImageFile, len(fileDescriptors))
+	for i, fileDescriptor := range fileDescriptors {
+		imageFiles[i] = NewImageFile(t, fileDescriptor, nil, "", "", false, false, nil)
+	}
+	image, err := bufimage.NewImage(imageFiles)
+	require.NoError(t, err)
+	return image
+}
+
 // NewImageFile returns a new ImageFile for testing.
 //
 // TODO: moduleIdentity and commit should be options.
@@ -15,6 +15,8 @@
 package bufimage
 
 import (
+	"sync"
+
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/protodescriptor"
 	"google.golang.org/protobuf/types/descriptorpb"
@@ -29,6 +31,9 @@ type imageFile struct {
 
 	isSyntaxUnspecified           bool
 	storedUnusedDependencyIndexes []int32
+	// sourceCodeInfoLoader is shared between the copies of the imageFile,
+	// as they share the fileDescriptorProto.
+	sourceCodeInfoLoader *sourceCodeInfoLoader
 }
 
 func newImageFile(
@@ -39,6 +44,7 @@ func newImageFile(
 	isImport bool,
 	isSyntaxUnspecified bool,
 	unusedDependencyIndexes []int32,
+	getSourceCodeInfo func() (*descriptorpb.SourceCodeInfo, error),
 ) (*imageFile, error) {
 	if err := protodescriptor.ValidateFileDescriptor(fileDescriptor); err != nil {
 		return nil, err
@@ -57,6 +63,12 @@ func newImageFile(
 	if len(unusedDependencyIndexes) == 0 {
 		unusedDependencyIndexes = nil
 	}
+	var loader *sourceCodeInfoLoader
+	if getSourceCodeInfo != nil {
+		loader = &sourceCodeInfoLoader{
+			getSourceCodeInfo: getSourceCodeInfo,
+		}
+	}
 	return &imageFile{
 		FileInfo: fileInfo,
 		// protodescriptor.FileDescriptorProtoForFileDescriptor is a no-op if fileDescriptor
@@ -64,6 +76,7 @@ func newImageFile(
 		fileDescriptorProto:           protodescriptor.FileDescriptorProtoForFileDescriptor(fileDescriptor),
 		isSyntaxUnspecified:           isSyntaxUnspecified,
 		storedUnusedDependencyIndexes: unusedDependencyIndexes,
+		sourceCodeInfoLoader:          loader,
 	}, nil
 }
 
@@ -92,7 +105,38 @@ func (f *imageFile) withIsImport(isImport bool) ImageFile {
 		fileDescriptorProto:           f.fileDescriptorProto,
 		isSyntaxUnspecified:           f.isSyntaxUnspecified,
 		storedUnusedDependencyIndexes: f.storedUnusedDependencyIndexes,
+		sourceCodeInfoLoader:          f.sourceCodeInfoLoader,
+	}
+}
+
+// loadSourceCodeInfo sets the SourceCodeInfo of the backing FileDescriptorProto
+// if it has a sourceCodeInfoLoader and no SourceCodeInfo.
+//
+// Returns true if the SourceCodeInfo was loaded by this call.
+func (f *imageFile) loadSourceCodeInfo() (bool, error) {
+	if f.sourceCodeInfoLoader == nil {
+		return false, nil
 	}
+	var loaded bool
+	f.sourceCodeInfoLoader.once.Do(func() {
+		if f.fileDescriptorProto.SourceCodeInfo != nil {
+			return
+		}
+		sourceCodeInfo, err := f.sourceCodeInfoLoader.getSourceCodeInfo()
+		if err != nil {
+			f.sourceCodeInfoLoader.err = err
+			return
+		}
+		f.fileDescriptorProto.SourceCodeInfo = sourceCodeInfo
+		loaded = true
+	})
+	return loaded, f.sourceCodeInfoLoader.err
 }
 
 func (*imageFile) isImageFile() {}
+
+type sourceCodeInfoLoader struct {
+	getSourceCodeInfo func() (*descriptorpb.SourceCodeInfo, error)
+	once              sync.Once
+	err               error
+}
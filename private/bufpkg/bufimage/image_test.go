@@ -15,12 +15,14 @@
 package bufimage
 
 import (
+	"context"
 	"testing"
 
 	imagev1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/image/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestMergeImagesWithImports(t *testing.T) {
@@ -137,3 +139,112 @@ func TestMergeImagesOrdered(t *testing.T) {
 	}
 	assert.Equal(t, []string{"a.proto", "b.proto", "c.proto", "d.proto"}, paths)
 }
+
+func TestMergeImagesWithConflictResolution(t *testing.T) {
+	t.Parallel()
+	firstProtoImage := &imagev1.Image{
+		File: []*imagev1.ImageFile{
+			{
+				Syntax:  proto.String("proto3"),
+				Name:    proto.String("a.proto"),
+				Package: proto.String("a"),
+			},
+			{
+				Syntax:  proto.String("proto3"),
+				Name:    proto.String("b.proto"),
+				Package: proto.String("first"),
+			},
+		},
+	}
+	secondProtoImage := &imagev1.Image{
+		File: []*imagev1.ImageFile{
+			{
+				Syntax:  proto.String("proto3"),
+				Name:    proto.String("a.proto"),
+				Package: proto.String("a"),
+			},
+			{
+				Syntax:  proto.String("proto3"),
+				Name:    proto.String("b.proto"),
+				Package: proto.String("second"),
+			},
+		},
+	}
+	firstImage, err := NewImageForProto(firstProtoImage)
+	require.NoError(t, err)
+	secondImage, err := NewImageForProto(secondProtoImage)
+	require.NoError(t, err)
+
+	_, err = MergeImagesWithConflictResolution(MergeConflictResolutionFail, firstImage, secondImage)
+	conflictErr := &ImageMergeConflictError{}
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(
+		t,
+		[]ImageMergeConflict{
+			{
+				Path:         "b.proto",
+				ImageIndexes: []int{0, 1},
+			},
+		},
+		conflictErr.Conflicts,
+	)
+
+	image, err := MergeImagesWithConflictResolution(MergeConflictResolutionPreferFirst, firstImage, secondImage)
+	require.NoError(t, err)
+	require.Len(t, image.Files(), 2)
+	assert.Equal(t, "first", image.GetFile("b.proto").FileDescriptor().GetPackage())
+
+	image, err = MergeImagesWithConflictResolution(MergeConflictResolutionPreferLast, firstImage, secondImage)
+	require.NoError(t, err)
+	require.Len(t, image.Files(), 2)
+	assert.Equal(t, "second", image.GetFile("b.proto").FileDescriptor().GetPackage())
+}
+
+func TestLoadSourceCodeInfoForPaths(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pathToLoadCount := make(map[string]int)
+	var imageFiles []ImageFile
+	for _, path := range []string{"a.proto", "b.proto"} {
+		path := path
+		imageFile, err := NewImageFile(
+			&descriptorpb.FileDescriptorProto{
+				Name:   proto.String(path),
+				Syntax: proto.String("proto3"),
+			},
+			nil,
+			"",
+			"",
+			false,
+			false,
+			nil,
+			ImageFileWithLazySourceCodeInfo(
+				func() (*descriptorpb.SourceCodeInfo, error) {
+					pathToLoadCount[path]++
+					return &descriptorpb.SourceCodeInfo{
+						Location: []*descriptorpb.SourceCodeInfo_Location{{}},
+					}, nil
+				},
+			),
+		)
+		require.NoError(t, err)
+		imageFiles = append(imageFiles, imageFile)
+	}
+	image, err := NewImage(imageFiles)
+	require.NoError(t, err)
+
+	loaded, err := LoadSourceCodeInfoForPaths(ctx, image, []string{"a.proto", "c.proto"})
+	require.NoError(t, err)
+	assert.True(t, loaded)
+	assert.Equal(t, map[string]int{"a.proto": 1}, pathToLoadCount)
+	assert.NotNil(t, image.GetFile("a.proto").Proto().GetSourceCodeInfo())
+	assert.Nil(t, image.GetFile("b.proto").Proto().GetSourceCodeInfo())
+
+	loaded, err = LoadSourceCodeInfoForPaths(ctx, image, []string{"a.proto"})
+	require.NoError(t, err)
+	assert.False(t, loaded)
+	assert.Equal(t, map[string]int{"a.proto": 1}, pathToLoadCount)
+
+	require.NoError(t, LoadSourceCodeInfo(ctx, image))
+	assert.Equal(t, map[string]int{"a.proto": 1, "b.proto": 1}, pathToLoadCount)
+}
@@ -499,3 +499,13 @@ func isFileToGenerate(
 	}
 	return true
 }
+
+// imageFilesEqual returns true if the two ImageFiles have the same
+// FileDescriptorProto, ignoring source code info.
+func imageFilesEqual(one ImageFile, two ImageFile) bool {
+	oneProto := proto.Clone(one.Proto()).(*descriptorpb.FileDescriptorProto)
+	twoProto := proto.Clone(two.Proto()).(*descriptorpb.FileDescriptorProto)
+	oneProto.SourceCodeInfo = nil
+	twoProto.SourceCodeInfo = nil
+	return proto.Equal(oneProto, twoProto)
+}
@@ -0,0 +1,362 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufimport reports the use of public and weak imports.
+//
+// A file that imports another file publicly forwards the definitions of the
+// imported file, so that files importing the forwarding file can use them
+// without importing the defining file. This complicates moving definitions
+// between files, as the files that depend on the forwarding are not visible
+// from their imports.
+package bufimport
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// KindPublic is the Kind of public imports.
+	KindPublic Kind = iota + 1
+	// KindWeak is the Kind of weak imports.
+	KindWeak
+)
+
+// Kind is the kind of an import.
+type Kind int
+
+// String implements fmt.Stringer.
+func (k Kind) String() string {
+	switch k {
+	case KindPublic:
+		return "public"
+	case KindWeak:
+		return "weak"
+	default:
+		return ""
+	}
+}
+
+// Forward is the use of a type that a file does not import directly, but
+// through one or more public imports.
+type Forward struct {
+	// File is the file that uses the type.
+	File protosource.File
+	// TypeName is the fully-qualified name of the type.
+	TypeName string
+	// Location is the location of the use of the type, which may be nil.
+	Location protosource.Location
+	// DefiningFilePath is the path of the file that defines the type.
+	DefiningFilePath string
+	// Import is the direct import of File that forwards the type.
+	Import protosource.FileImport
+	// PublicImports are the public imports that forward the defining file to File,
+	// starting with the public import in the file of Import.
+	PublicImports []protosource.FileImport
+}
+
+// Declaration is a public or weak import.
+type Declaration struct {
+	// File is the file that declares the import.
+	File protosource.File
+	// FileImport is the import.
+	FileImport protosource.FileImport
+	// Kind is the kind of the import.
+	Kind Kind
+	// DependentFilePaths are the paths of the files that use types that are
+	// forwarded by this import, sorted.
+	//
+	// Always empty for weak imports.
+	DependentFilePaths []string
+}
+
+// GetForwards returns the Forwards of the files, in the order of the files
+// and the uses of types within the files.
+//
+// Types are only resolved within the files, so uses of types that are defined
+// in other files, or that are forwarded through files that are not part of
+// the files, are not returned.
+func GetForwards(files []protosource.File) ([]*Forward, error) {
+	filePathToFile, err := protosource.FilePathToFile(files...)
+	if err != nil {
+		return nil, err
+	}
+	typeNameToFilePath, err := getTypeNameToFilePath(files)
+	if err != nil {
+		return nil, err
+	}
+	var forwards []*Forward
+	for _, file := range files {
+		directImportPaths := make(map[string]struct{}, len(file.FileImports()))
+		for _, fileImport := range file.FileImports() {
+			directImportPaths[fileImport.Import()] = struct{}{}
+		}
+		if err := forEachTypeUse(
+			file,
+			func(typeName string, location protosource.Location) error {
+				definingFilePath, ok := typeNameToFilePath[typeName]
+				if !ok || definingFilePath == file.Path() {
+					return nil
+				}
+				if _, ok := directImportPaths[definingFilePath]; ok {
+					return nil
+				}
+				for _, fileImport := range file.FileImports() {
+					publicImports := getPublicImportChain(filePathToFile, fileImport.Import(), definingFilePath, nil)
+					if publicImports == nil {
+						continue
+					}
+					forwards = append(
+						forwards,
+						&Forward{
+							File:             file,
+							TypeName:         typeName,
+							Location:         location,
+							DefiningFilePath: definingFilePath,
+							Import:           fileImport,
+							PublicImports:    publicImports,
+						},
+					)
+					return nil
+				}
+				return nil
+			},
+		); err != nil {
+			return nil, err
+		}
+	}
+	return forwards, nil
+}
+
+// GetImageForwards returns the Forwards of the non-import files of the image.
+//
+// Types are resolved within all files of the image.
+func GetImageForwards(ctx context.Context, image bufimage.Image) ([]*Forward, error) {
+	files, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(image.Files())...)
+	if err != nil {
+		return nil, err
+	}
+	forwards, err := GetForwards(files)
+	if err != nil {
+		return nil, err
+	}
+	var imageForwards []*Forward
+	for _, forward := range forwards {
+		if imageFile := image.GetFile(forward.File.Path()); imageFile != nil && !imageFile.IsImport() {
+			imageForwards = append(imageForwards, forward)
+		}
+	}
+	return imageForwards, nil
+}
+
+// GetDeclarations returns the public and weak imports of the non-import files of
+// the image, along with the files that use types forwarded by the public imports.
+//
+// Only files of the image are considered as dependent files.
+func GetDeclarations(ctx context.Context, image bufimage.Image) ([]*Declaration, error) {
+	files, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(image.Files())...)
+	if err != nil {
+		return nil, err
+	}
+	forwards, err := GetForwards(files)
+	if err != nil {
+		return nil, err
+	}
+	// The public imports are identified by their file and import path, as these
+	// are unique within a valid image.
+	publicImportKeyToDependentFilePaths := make(map[string]map[string]struct{})
+	for _, forward := range forwards {
+		for _, publicImport := range forward.PublicImports {
+			key := getFileImportKey(publicImport)
+			dependentFilePaths, ok := publicImportKeyToDependentFilePaths[key]
+			if !ok {
+				dependentFilePaths = make(map[string]struct{})
+				publicImportKeyToDependentFilePaths[key] = dependentFilePaths
+			}
+			dependentFilePaths[forward.File.Path()] = struct{}{}
+		}
+	}
+	var declarations []*Declaration
+	for _, file := range files {
+		if imageFile := image.GetFile(file.Path()); imageFile == nil || imageFile.IsImport() {
+			continue
+		}
+		for _, fileImport := range file.FileImports() {
+			var kind Kind
+			switch {
+			case fileImport.IsPublic():
+				kind = KindPublic
+			case fileImport.IsWeak():
+				kind = KindWeak
+			default:
+				continue
+			}
+			declaration := &Declaration{
+				File:       file,
+				FileImport: fileImport,
+				Kind:       kind,
+			}
+			for dependentFilePath := range publicImportKeyToDependentFilePaths[getFileImportKey(fileImport)] {
+				declaration.DependentFilePaths = append(declaration.DependentFilePaths, dependentFilePath)
+			}
+			sort.Strings(declaration.DependentFilePaths)
+			declarations = append(declarations, declaration)
+		}
+	}
+	return declarations, nil
+}
+
+// getPublicImportChain returns the public imports through which the file at filePath
+// forwards the file at definingFilePath, or nil if it does not.
+//
+// The chain may be empty but non-nil if filePath is definingFilePath.
+func getPublicImportChain(
+	filePathToFile map[string]protosource.File,
+	filePath string,
+	definingFilePath string,
+	chain []protosource.FileImport,
+) []protosource.FileImport {
+	if filePath == definingFilePath {
+		if chain == nil {
+			return []protosource.FileImport{}
+		}
+		return chain
+	}
+	// Cycles of imports are not valid, but the chain is bounded regardless.
+	if len(chain) > len(filePathToFile) {
+		return nil
+	}
+	file, ok := filePathToFile[filePath]
+	if !ok {
+		return nil
+	}
+	for _, fileImport := range file.FileImports() {
+		if !fileImport.IsPublic() {
+			continue
+		}
+		nextChain := append(append([]protosource.FileImport{}, chain...), fileImport)
+		if result := getPublicImportChain(filePathToFile, fileImport.Import(), definingFilePath, nextChain); result != nil {
+			return result
+		}
+	}
+	return nil
+}
+
+func getTypeNameToFilePath(files []protosource.File) (map[string]string, error) {
+	typeNameToFilePath := make(map[string]string)
+	fullNameToMessage, err := protosource.FullNameToMessage(files...)
+	if err != nil {
+		return nil, err
+	}
+	for fullName, message := range fullNameToMessage {
+		typeNameToFilePath[fullName] = message.File().Path()
+	}
+	fullNameToEnum, err := protosource.FullNameToEnum(files...)
+	if err != nil {
+		return nil, err
+	}
+	for fullName, enum := range fullNameToEnum {
+		typeNameToFilePath[fullName] = enum.File().Path()
+	}
+	return typeNameToFilePath, nil
+}
+
+// forEachTypeUse calls f for every use of a message or enum type in the file, which
+// are the types of fields and extensions, the extendees of extensions, and the request
+// and response types of methods.
+func forEachTypeUse(file protosource.File, f func(string, protosource.Location) error) error {
+	forField := func(field protosource.Field, typeNameLocation protosource.Location) error {
+		switch field.Type() {
+		case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+			descriptorpb.FieldDescriptorProto_TYPE_ENUM,
+			descriptorpb.FieldDescriptorProto_TYPE_GROUP:
+			if err := f(field.TypeName(), typeNameLocation); err != nil {
+				return err
+			}
+		}
+		if extendee := field.Extendee(); extendee != "" {
+			return f(extendee, field.ExtendeeLocation())
+		}
+		return nil
+	}
+	for _, extension := range file.Extensions() {
+		if err := forField(extension, extension.TypeNameLocation()); err != nil {
+			return err
+		}
+	}
+	if err := protosource.ForEachMessage(
+		func(message protosource.Message) error {
+			if message.IsMapEntry() {
+				// The fields of map entries have no locations, so the location
+				// of the type of the map field is used instead.
+				location := getMapFieldTypeNameLocation(message)
+				for _, field := range message.Fields() {
+					if err := forField(field, location); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			for _, field := range message.Fields() {
+				if err := forField(field, field.TypeNameLocation()); err != nil {
+					return err
+				}
+			}
+			for _, extension := range message.Extensions() {
+				if err := forField(extension, extension.TypeNameLocation()); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		file,
+	); err != nil {
+		return err
+	}
+	for _, service := range file.Services() {
+		for _, method := range service.Methods() {
+			if err := f(method.InputTypeName(), method.InputTypeLocation()); err != nil {
+				return err
+			}
+			if err := f(method.OutputTypeName(), method.OutputTypeLocation()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getMapFieldTypeNameLocation returns the location of the type of the map field
+// of the map entry, or nil.
+func getMapFieldTypeNameLocation(mapEntry protosource.Message) protosource.Location {
+	parent := mapEntry.Parent()
+	if parent == nil {
+		return nil
+	}
+	for _, field := range parent.Fields() {
+		if field.TypeName() == mapEntry.FullName() {
+			return field.TypeNameLocation()
+		}
+	}
+	return nil
+}
+
+func getFileImportKey(fileImport protosource.FileImport) string {
+	return fileImport.File().Path() + "\x00" + fileImport.Import()
+}
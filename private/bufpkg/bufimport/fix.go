@@ -0,0 +1,89 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufimport
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/bufbuild/buf/private/pkg/protosource"
+)
+
+// GetMissingImports returns the paths of the files that every file must import directly
+// to no longer use types that are forwarded by public imports, by the path of the file.
+//
+// The paths are sorted.
+func GetMissingImports(forwards []*Forward) map[string][]string {
+	filePathToMissingImportPaths := make(map[string]map[string]struct{})
+	for _, forward := range forwards {
+		missingImportPaths, ok := filePathToMissingImportPaths[forward.File.Path()]
+		if !ok {
+			missingImportPaths = make(map[string]struct{})
+			filePathToMissingImportPaths[forward.File.Path()] = missingImportPaths
+		}
+		missingImportPaths[forward.DefiningFilePath] = struct{}{}
+	}
+	filePathToSortedMissingImportPaths := make(map[string][]string, len(filePathToMissingImportPaths))
+	for filePath, missingImportPaths := range filePathToMissingImportPaths {
+		sortedMissingImportPaths := make([]string, 0, len(missingImportPaths))
+		for missingImportPath := range missingImportPaths {
+			sortedMissingImportPaths = append(sortedMissingImportPaths, missingImportPath)
+		}
+		sort.Strings(sortedMissingImportPaths)
+		filePathToSortedMissingImportPaths[filePath] = sortedMissingImportPaths
+	}
+	return filePathToSortedMissingImportPaths
+}
+
+// AddImports returns the content of the file with import statements for the import
+// paths added on the lines after its last import statement.
+//
+// The file must have at least one import with a location, which is always the case for
+// files that use forwarded types if the file has source code info.
+func AddImports(file protosource.File, data []byte, importPaths []string) ([]byte, error) {
+	lastImportEndLine := 0
+	for _, fileImport := range file.FileImports() {
+		location := fileImport.Location()
+		if location == nil {
+			return nil, fmt.Errorf("%s: import %q has no location", file.Path(), fileImport.Import())
+		}
+		if location.EndLine() > lastImportEndLine {
+			lastImportEndLine = location.EndLine()
+		}
+	}
+	if lastImportEndLine == 0 {
+		return nil, fmt.Errorf("%s: file has no imports to add imports after", file.Path())
+	}
+	// Find the offset after the end of the line of the last import.
+	offset := 0
+	for line := 0; line < lastImportEndLine; line++ {
+		index := bytes.IndexByte(data[offset:], '\n')
+		if index < 0 {
+			return nil, fmt.Errorf("%s: expected line %d to exist", file.Path(), lastImportEndLine)
+		}
+		offset += index + 1
+	}
+	var importStatements bytes.Buffer
+	for _, importPath := range importPaths {
+		importStatements.WriteString("import " + strconv.Quote(importPath) + ";\n")
+	}
+	result := make([]byte, 0, len(data)+importStatements.Len())
+	result = append(result, data[:offset]...)
+	result = append(result, importStatements.Bytes()...)
+	result = append(result, data[offset:]...)
+	return result, nil
+}
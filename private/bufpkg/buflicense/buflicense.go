@@ -0,0 +1,152 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package buflicense detects the license of a module from its LICENSE file.
+package buflicense
+
+import (
+	"bufio"
+	"strings"
+
+	"github.com/bufbuild/buf/private/gen/data/dataspdx"
+)
+
+const spdxLicenseIdentifierPrefix = "SPDX-License-Identifier:"
+
+// DetectLicenseID returns the SPDX license identifier for the given contents
+// of a LICENSE file.
+//
+// If the contents contain an SPDX-License-Identifier line with a known SPDX
+// identifier, that identifier is returned. Otherwise, the contents are matched
+// against the text of common licenses.
+//
+// Returns false if the license could not be detected.
+func DetectLicenseID(content string) (string, bool) {
+	if strings.TrimSpace(content) == "" {
+		return "", false
+	}
+	if licenseID, ok := detectSPDXLicenseIdentifier(content); ok {
+		return licenseID, true
+	}
+	normalizedContent := normalize(content)
+	for _, licenseMatcher := range licenseMatchers {
+		if licenseMatcher.matches(normalizedContent) {
+			return licenseMatcher.licenseID, true
+		}
+	}
+	return "", false
+}
+
+type licenseMatcher struct {
+	licenseID string
+	// all of these phrases must be present.
+	phrases []string
+}
+
+func (l *licenseMatcher) matches(normalizedContent string) bool {
+	for _, phrase := range l.phrases {
+		if !strings.Contains(normalizedContent, phrase) {
+			return false
+		}
+	}
+	return true
+}
+
+// licenseMatchers are checked in order, so more specific licenses
+// must come before the licenses whose text they contain.
+//
+// The full text of the GNU licenses always includes the "any later version"
+// wording in its appendix, so these are detected as the -only variants unless
+// an SPDX-License-Identifier says otherwise.
+//
+// All phrases are normalized, see normalize.
+var licenseMatchers = []*licenseMatcher{
+	{
+		licenseID: "Apache-2.0",
+		phrases:   []string{"apache license", "version 2.0"},
+	},
+	{
+		licenseID: "MPL-2.0",
+		phrases:   []string{"mozilla public license", "2.0"},
+	},
+	{
+		licenseID: "AGPL-3.0-only",
+		phrases:   []string{"gnu affero general public license", "version 3"},
+	},
+	{
+		licenseID: "LGPL-3.0-only",
+		phrases:   []string{"gnu lesser general public license", "version 3"},
+	},
+	{
+		licenseID: "GPL-3.0-only",
+		phrases:   []string{"gnu general public license", "version 3"},
+	},
+	{
+		licenseID: "GPL-2.0-only",
+		phrases:   []string{"gnu general public license", "version 2"},
+	},
+	{
+		licenseID: "BSD-3-Clause",
+		phrases: []string{
+			"redistribution and use in source and binary forms",
+			"neither the name",
+		},
+	},
+	{
+		licenseID: "BSD-2-Clause",
+		phrases:   []string{"redistribution and use in source and binary forms"},
+	},
+	{
+		licenseID: "ISC",
+		phrases: []string{
+			"permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted",
+		},
+	},
+	{
+		licenseID: "MIT",
+		phrases: []string{
+			"permission is hereby granted, free of charge",
+			"the above copyright notice and this permission notice shall be included",
+		},
+	},
+	{
+		licenseID: "Unlicense",
+		phrases:   []string{"this is free and unencumbered software released into the public domain"},
+	},
+	{
+		licenseID: "CC0-1.0",
+		phrases:   []string{"cc0 1.0 universal"},
+	},
+}
+
+func detectSPDXLicenseIdentifier(content string) (string, bool) {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		index := strings.Index(line, spdxLicenseIdentifierPrefix)
+		if index < 0 {
+			continue
+		}
+		licenseID := strings.TrimSpace(line[index+len(spdxLicenseIdentifierPrefix):])
+		if licenseInfo, ok := dataspdx.GetLicenseInfo(licenseID); ok {
+			return licenseInfo.ID(), true
+		}
+	}
+	return "", false
+}
+
+// normalize lowercases the content and collapses all whitespace into single spaces.
+func normalize(content string) string {
+	return strings.Join(strings.Fields(strings.ToLower(content)), " ")
+}
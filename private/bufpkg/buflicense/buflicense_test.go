@@ -0,0 +1,129 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buflicense
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectLicenseID(t *testing.T) {
+	t.Parallel()
+	testDetectLicenseID(
+		t,
+		"spdx identifier",
+		"Copyright 2023 Acme\n\nSPDX-License-Identifier: gpl-3.0-or-later\n",
+		"GPL-3.0-or-later",
+	)
+	testDetectLicenseID(
+		t,
+		"apache",
+		`
+                                 Apache License
+                           Version 2.0, January 2004
+                        http://www.apache.org/licenses/
+`,
+		"Apache-2.0",
+	)
+	testDetectLicenseID(
+		t,
+		"mit",
+		`MIT License
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction.
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+`,
+		"MIT",
+	)
+	testDetectLicenseID(
+		t,
+		"bsd 3 clause",
+		`Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are met:
+
+3. Neither the name of the copyright holder nor the names of its
+   contributors may be used to endorse or promote products derived from
+   this software without specific prior written permission.
+`,
+		"BSD-3-Clause",
+	)
+	testDetectLicenseID(
+		t,
+		"mpl mentions gnu licenses",
+		`Mozilla Public License Version 2.0
+
+"Secondary License" means either the GNU General Public License, Version 2.0,
+the GNU Lesser General Public License, Version 2.1, the GNU Affero General
+Public License, Version 3.0, or any later versions of those licenses.
+`,
+		"MPL-2.0",
+	)
+	testDetectLicenseID(
+		t,
+		"unknown",
+		"All rights reserved.",
+		"",
+	)
+	testDetectLicenseID(
+		t,
+		"empty",
+		"",
+		"",
+	)
+}
+
+func testDetectLicenseID(
+	t *testing.T,
+	desc string,
+	content string,
+	expectedLicenseID string,
+) {
+	t.Run(desc, func(t *testing.T) {
+		t.Parallel()
+		licenseID, ok := DetectLicenseID(content)
+		assert.Equal(t, expectedLicenseID != "", ok)
+		assert.Equal(t, expectedLicenseID, licenseID)
+	})
+}
+
+func TestConfigV1(t *testing.T) {
+	t.Parallel()
+	config, err := NewConfigV1(ExternalConfigV1{})
+	require.NoError(t, err)
+	assert.Nil(t, config)
+	_, err = NewConfigV1(ExternalConfigV1{Allow: []string{"not-a-license"}})
+	assert.Error(t, err)
+	_, err = NewConfigV1(ExternalConfigV1{Allow: []string{"MIT"}, Deny: []string{"mit"}})
+	assert.Error(t, err)
+
+	config, err = NewConfigV1(ExternalConfigV1{Deny: []string{"gpl-3.0-only"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GPL-3.0-only"}, config.DenyLicenseIDs)
+	assert.Error(t, config.Check("GPL-3.0-only"))
+	assert.NoError(t, config.Check("MIT"))
+	assert.NoError(t, config.Check(""))
+
+	config, err = NewConfigV1(ExternalConfigV1{Allow: []string{"Apache-2.0", "MIT"}})
+	require.NoError(t, err)
+	assert.NoError(t, config.Check("MIT"))
+	assert.Error(t, config.Check("GPL-3.0-only"))
+	assert.Error(t, config.Check(""))
+}
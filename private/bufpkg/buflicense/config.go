@@ -0,0 +1,114 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buflicense
+
+import (
+	"fmt"
+
+	"github.com/bufbuild/buf/private/gen/data/dataspdx"
+)
+
+// Config is the license policy for the dependencies of a module.
+type Config struct {
+	// AllowLicenseIDs are the SPDX identifiers of the licenses that dependencies
+	// may use.
+	//
+	// If empty, all licenses that are not denied are allowed. If not empty, dependencies
+	// whose license cannot be detected violate the policy.
+	AllowLicenseIDs []string
+	// DenyLicenseIDs are the SPDX identifiers of the licenses that dependencies
+	// may not use.
+	DenyLicenseIDs []string
+}
+
+// NewConfigV1 returns a new Config for the ExternalConfigV1.
+//
+// Returns nil if no license policy is configured.
+func NewConfigV1(externalConfig ExternalConfigV1) (*Config, error) {
+	if len(externalConfig.Allow) == 0 && len(externalConfig.Deny) == 0 {
+		return nil, nil
+	}
+	allowLicenseIDs, err := getLicenseIDs(externalConfig.Allow)
+	if err != nil {
+		return nil, err
+	}
+	denyLicenseIDs, err := getLicenseIDs(externalConfig.Deny)
+	if err != nil {
+		return nil, err
+	}
+	allowLicenseIDMap := make(map[string]struct{}, len(allowLicenseIDs))
+	for _, allowLicenseID := range allowLicenseIDs {
+		allowLicenseIDMap[allowLicenseID] = struct{}{}
+	}
+	for _, denyLicenseID := range denyLicenseIDs {
+		if _, ok := allowLicenseIDMap[denyLicenseID]; ok {
+			return nil, fmt.Errorf("license %q is both allowed and denied", denyLicenseID)
+		}
+	}
+	return &Config{
+		AllowLicenseIDs: allowLicenseIDs,
+		DenyLicenseIDs:  denyLicenseIDs,
+	}, nil
+}
+
+// Check checks the license with the given SPDX identifier against the Config.
+//
+// The licenseID is empty if the license could not be detected.
+// Returns an error describing the violation if the license is not allowed.
+func (c *Config) Check(licenseID string) error {
+	for _, denyLicenseID := range c.DenyLicenseIDs {
+		if licenseID == denyLicenseID {
+			return fmt.Errorf("license %s is denied", licenseID)
+		}
+	}
+	if len(c.AllowLicenseIDs) == 0 {
+		return nil
+	}
+	if licenseID == "" {
+		return fmt.Errorf("license could not be detected and only %v are allowed", c.AllowLicenseIDs)
+	}
+	for _, allowLicenseID := range c.AllowLicenseIDs {
+		if licenseID == allowLicenseID {
+			return nil
+		}
+	}
+	return fmt.Errorf("license %s is not allowed", licenseID)
+}
+
+// ExternalConfigV1 is the on-disk representation of the license policy
+// at version v1.
+type ExternalConfigV1 struct {
+	Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+}
+
+// getLicenseIDs validates the given SPDX identifiers and returns them
+// in their canonical case.
+func getLicenseIDs(ids []string) ([]string, error) {
+	licenseIDs := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		licenseInfo, ok := dataspdx.GetLicenseInfo(id)
+		if !ok {
+			return nil, fmt.Errorf("unknown SPDX license identifier %q", id)
+		}
+		if _, ok := seen[licenseInfo.ID()]; ok {
+			continue
+		}
+		seen[licenseInfo.ID()] = struct{}{}
+		licenseIDs = append(licenseIDs, licenseInfo.ID())
+	}
+	return licenseIDs, nil
+}
@@ -27,9 +27,11 @@ import (
 var (
 	protoDigestTypeToDigestType = map[modulev1alpha1.DigestType]manifest.DigestType{
 		modulev1alpha1.DigestType_DIGEST_TYPE_SHAKE256: manifest.DigestTypeShake256,
+		modulev1alpha1.DigestType_DIGEST_TYPE_BLAKE3:   manifest.DigestTypeBlake3,
 	}
 	digestTypeToProtoDigestType = map[manifest.DigestType]modulev1alpha1.DigestType{
 		manifest.DigestTypeShake256: modulev1alpha1.DigestType_DIGEST_TYPE_SHAKE256,
+		manifest.DigestTypeBlake3:   modulev1alpha1.DigestType_DIGEST_TYPE_BLAKE3,
 	}
 )
 
@@ -45,6 +47,27 @@ func NewDigestFromProtoDigest(digest *modulev1alpha1.Digest) (*manifest.Digest,
 	return manifest.NewDigestFromBytes(dType, digest.Digest)
 }
 
+// NegotiateDigestType returns the most preferred digest type supported by both
+// this package and the peer, given the proto digest types the peer supports.
+//
+// Digest types unknown to this package are ignored. If the peer does not
+// advertise any digest types, manifest.DigestTypeShake256 is returned.
+func NegotiateDigestType(peerProtoDigestTypes []modulev1alpha1.DigestType) (manifest.DigestType, error) {
+	if len(peerProtoDigestTypes) == 0 {
+		return manifest.NegotiateDigestType(nil)
+	}
+	peerDigestTypes := make([]manifest.DigestType, 0, len(peerProtoDigestTypes))
+	for _, peerProtoDigestType := range peerProtoDigestTypes {
+		if digestType, ok := protoDigestTypeToDigestType[peerProtoDigestType]; ok {
+			peerDigestTypes = append(peerDigestTypes, digestType)
+		}
+	}
+	if len(peerDigestTypes) == 0 {
+		return "", fmt.Errorf("no supported digest type in %v", peerProtoDigestTypes)
+	}
+	return manifest.NegotiateDigestType(peerDigestTypes)
+}
+
 // AsProtoBlob returns the passed blob as a proto module blob.
 func AsProtoBlob(ctx context.Context, b manifest.Blob) (_ *modulev1alpha1.Blob, retErr error) {
 	digestType, ok := digestTypeToProtoDigestType[b.Digest().Type()]
@@ -131,3 +131,54 @@ func mustDigestShake256(t *testing.T, content []byte) *manifest.Digest {
 	require.NoError(t, err)
 	return digest
 }
+
+func TestNegotiateDigestType(t *testing.T) {
+	t.Parallel()
+	digestType, err := bufmanifest.NegotiateDigestType(nil)
+	require.NoError(t, err)
+	assert.Equal(t, manifest.DigestTypeShake256, digestType)
+	digestType, err = bufmanifest.NegotiateDigestType(
+		[]modulev1alpha1.DigestType{
+			modulev1alpha1.DigestType_DIGEST_TYPE_SHAKE256,
+			modulev1alpha1.DigestType_DIGEST_TYPE_BLAKE3,
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, manifest.DigestTypeBlake3, digestType)
+	_, err = bufmanifest.NegotiateDigestType(
+		[]modulev1alpha1.DigestType{
+			modulev1alpha1.DigestType_DIGEST_TYPE_UNSPECIFIED,
+		},
+	)
+	assert.Error(t, err)
+}
+
+func TestBlobSetFromProtoMixedDigestTypes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	var protoBlobs []*modulev1alpha1.Blob
+	for _, digestType := range []manifest.DigestType{
+		manifest.DigestTypeShake256,
+		manifest.DigestTypeBlake3,
+	} {
+		content := []byte("content for " + string(digestType))
+		digester, err := manifest.NewDigester(digestType)
+		require.NoError(t, err)
+		digest, err := digester.Digest(bytes.NewReader(content))
+		require.NoError(t, err)
+		blob, err := manifest.NewMemoryBlob(*digest, content)
+		require.NoError(t, err)
+		protoBlob, err := bufmanifest.AsProtoBlob(ctx, blob)
+		require.NoError(t, err)
+		protoBlobs = append(protoBlobs, protoBlob)
+	}
+	blobSet, err := bufmanifest.NewBlobSetFromProto(ctx, protoBlobs)
+	require.NoError(t, err)
+	assert.Len(t, blobSet.Blobs(), 2)
+	// Tampered content fails verification for either digest type.
+	for _, protoBlob := range protoBlobs {
+		protoBlob.Content = []byte("tampered")
+		_, err := bufmanifest.NewBlobFromProto(protoBlob)
+		assert.Error(t, err)
+	}
+}
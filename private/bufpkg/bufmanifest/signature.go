@@ -0,0 +1,346 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmanifest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"go.uber.org/multierr"
+)
+
+// ManifestPayloadType is the payload type of signature envelopes whose
+// payload is a manifest in its canonical text form.
+const ManifestPayloadType = "application/vnd.buf.manifest.v1+text"
+
+// Envelope is a detached signature envelope over a manifest.
+//
+// The envelope follows the DSSE (Dead Simple Signing Envelope) JSON format,
+// see https://github.com/secure-systems-lab/dsse. Signatures are computed
+// over the DSSE pre-authentication encoding of the payload type and payload,
+// never over the payload alone.
+type Envelope struct {
+	PayloadType string               `json:"payloadType"`
+	Payload     []byte               `json:"payload"`
+	Signatures  []*EnvelopeSignature `json:"signatures"`
+}
+
+// EnvelopeSignature is a single signature in an Envelope.
+type EnvelopeSignature struct {
+	KeyID string `json:"keyid,omitempty"`
+	Sig   []byte `json:"sig"`
+	// Certificate is the PEM-encoded certificate chain of the signer, leaf
+	// first. It is not part of the DSSE specification and is only set for
+	// signatures made with a certificate-bound key.
+	Certificate []byte `json:"certificate,omitempty"`
+}
+
+// SignatureVerifier verifies a single envelope signature.
+type SignatureVerifier interface {
+	// Verify returns nil if sig is a valid signature of message by the
+	// expected signer.
+	Verify(message []byte, signature *EnvelopeSignature) error
+}
+
+// SignOption is an option for SignManifest.
+type SignOption func(*signOptions)
+
+// SignWithKeyID sets the key ID of the produced signature.
+func SignWithKeyID(keyID string) SignOption {
+	return func(opts *signOptions) {
+		opts.keyID = keyID
+	}
+}
+
+// SignWithCertificate attaches the PEM-encoded certificate chain of the signer
+// to the produced signature.
+func SignWithCertificate(certificatePEM []byte) SignOption {
+	return func(opts *signOptions) {
+		opts.certificate = certificatePEM
+	}
+}
+
+// SignManifest signs the canonical form of the manifest and returns the
+// resulting envelope.
+//
+// Only ed25519 and ECDSA signers are supported.
+func SignManifest(m *manifest.Manifest, signer crypto.Signer, opts ...SignOption) (*Envelope, error) {
+	signOptions := &signOptions{}
+	for _, opt := range opts {
+		opt(signOptions)
+	}
+	payload, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	message := preAuthEncoding(ManifestPayloadType, payload)
+	var sig []byte
+	switch signer.Public().(type) {
+	case ed25519.PublicKey:
+		sig, err = signer.Sign(rand.Reader, message, crypto.Hash(0))
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %T", signer.Public())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sign manifest: %w", err)
+	}
+	return &Envelope{
+		PayloadType: ManifestPayloadType,
+		Payload:     payload,
+		Signatures: []*EnvelopeSignature{
+			{
+				KeyID:       signOptions.keyID,
+				Sig:         sig,
+				Certificate: signOptions.certificate,
+			},
+		},
+	}, nil
+}
+
+// ParseEnvelope parses a JSON-encoded envelope.
+func ParseEnvelope(data []byte) (*Envelope, error) {
+	var envelope Envelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid signature envelope: %w", err)
+	}
+	if envelope.PayloadType == "" {
+		return nil, errors.New("invalid signature envelope: missing payload type")
+	}
+	if len(envelope.Signatures) == 0 {
+		return nil, errors.New("invalid signature envelope: no signatures")
+	}
+	return &envelope, nil
+}
+
+// MarshalEnvelope encodes the envelope as JSON.
+func MarshalEnvelope(envelope *Envelope) ([]byte, error) {
+	return json.Marshal(envelope)
+}
+
+// VerifyManifestSignature verifies that the JSON-encoded envelope holds a
+// signature by the verifier's signer over exactly the passed manifest.
+//
+// The manifest is valid if at least one of the envelope's signatures verifies.
+func VerifyManifestSignature(m *manifest.Manifest, envelopeData []byte, verifier SignatureVerifier) error {
+	envelope, err := ParseEnvelope(envelopeData)
+	if err != nil {
+		return err
+	}
+	if envelope.PayloadType != ManifestPayloadType {
+		return fmt.Errorf("unexpected signature payload type %q", envelope.PayloadType)
+	}
+	message := preAuthEncoding(envelope.PayloadType, envelope.Payload)
+	var verifyErr error
+	verified := false
+	for _, signature := range envelope.Signatures {
+		if err := verifier.Verify(message, signature); err != nil {
+			verifyErr = multierr.Append(verifyErr, err)
+			continue
+		}
+		verified = true
+		break
+	}
+	if !verified {
+		return fmt.Errorf("no valid manifest signature: %w", verifyErr)
+	}
+	// Only compare the payload once it is known to be authentic.
+	manifestText, err := m.MarshalText()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(manifestText, envelope.Payload) {
+		return errors.New("manifest does not match signed manifest")
+	}
+	return nil
+}
+
+// NewKeyVerifier returns a new SignatureVerifier that accepts signatures made
+// by the private key of the PEM-encoded PKIX public key.
+func NewKeyVerifier(publicKeyPEM []byte) (SignatureVerifier, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM data found in public key")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	switch publicKey.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey:
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return &keyVerifier{
+		publicKey: publicKey,
+	}, nil
+}
+
+// NewKeySigner returns a new signer for the PEM-encoded private key.
+//
+// The key must be an ed25519 or ECDSA key in the PKCS #8 form, or an ECDSA key
+// in the SEC 1 form.
+func NewKeySigner(privateKeyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM data found in private key")
+	}
+	var privateKey interface{}
+	var err error
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	switch privateKey := privateKey.(type) {
+	case ed25519.PrivateKey:
+		return privateKey, nil
+	case *ecdsa.PrivateKey:
+		return privateKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+}
+
+// NewCertificateIdentityVerifier returns a new SignatureVerifier that accepts
+// signatures made by the key of a certificate that chains up to roots and has
+// the identity as an email or URI subject alternative name.
+//
+// If roots is nil, the system roots are used.
+func NewCertificateIdentityVerifier(identity string, roots *x509.CertPool) SignatureVerifier {
+	return &certificateIdentityVerifier{
+		identity: identity,
+		roots:    roots,
+	}
+}
+
+type signOptions struct {
+	keyID       string
+	certificate []byte
+}
+
+type keyVerifier struct {
+	publicKey crypto.PublicKey
+}
+
+func (v *keyVerifier) Verify(message []byte, signature *EnvelopeSignature) error {
+	return verifySignature(v.publicKey, message, signature.Sig)
+}
+
+type certificateIdentityVerifier struct {
+	identity string
+	roots    *x509.CertPool
+}
+
+func (v *certificateIdentityVerifier) Verify(message []byte, signature *EnvelopeSignature) error {
+	if len(signature.Certificate) == 0 {
+		return errors.New("signature has no certificate")
+	}
+	var certificates []*x509.Certificate
+	rest := signature.Certificate
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("invalid signature certificate: %w", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(certificates) == 0 {
+		return errors.New("no PEM data found in signature certificate")
+	}
+	leaf := certificates[0]
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("untrusted signature certificate: %w", err)
+	}
+	if !certificateHasIdentity(leaf, v.identity) {
+		return fmt.Errorf("signature certificate does not have identity %q", v.identity)
+	}
+	return verifySignature(leaf.PublicKey, message, signature.Sig)
+}
+
+func certificateHasIdentity(certificate *x509.Certificate, identity string) bool {
+	for _, email := range certificate.EmailAddresses {
+		if email == identity {
+			return true
+		}
+	}
+	for _, uri := range certificate.URIs {
+		if uri.String() == identity {
+			return true
+		}
+	}
+	return false
+}
+
+func verifySignature(publicKey crypto.PublicKey, message []byte, sig []byte) error {
+	switch publicKey := publicKey.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(publicKey, message, sig) {
+			return errors.New("invalid ed25519 signature")
+		}
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		if !ecdsa.VerifyASN1(publicKey, digest[:], sig) {
+			return errors.New("invalid ECDSA signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return nil
+}
+
+// preAuthEncoding returns the DSSE pre-authentication encoding of the payload.
+func preAuthEncoding(payloadType string, payload []byte) []byte {
+	var buffer bytes.Buffer
+	buffer.WriteString("DSSEv1 ")
+	buffer.WriteString(strconv.Itoa(len(payloadType)))
+	buffer.WriteString(" ")
+	buffer.WriteString(payloadType)
+	buffer.WriteString(" ")
+	buffer.WriteString(strconv.Itoa(len(payload)))
+	buffer.WriteString(" ")
+	buffer.Write(payload)
+	return buffer.Bytes()
+}
@@ -0,0 +1,216 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmanifest_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerifyManifestWithKey(t *testing.T) {
+	t.Parallel()
+	_, ed25519PrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecdsaPrivateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	for _, signer := range []crypto.Signer{ed25519PrivateKey, ecdsaPrivateKey} {
+		m := newTestManifest(t, "a.proto", "b.proto")
+		envelopeData := mustSignManifest(t, m, signer)
+		verifier, err := bufmanifest.NewKeyVerifier(mustMarshalPublicKeyPEM(t, signer.Public()))
+		require.NoError(t, err)
+		assert.NoError(t, bufmanifest.VerifyManifestSignature(m, envelopeData, verifier))
+
+		// a manifest with different content does not verify
+		otherManifest := newTestManifest(t, "a.proto", "c.proto")
+		assert.Error(t, bufmanifest.VerifyManifestSignature(otherManifest, envelopeData, verifier))
+	}
+}
+
+func TestVerifyManifestWithWrongKey(t *testing.T) {
+	t.Parallel()
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	m := newTestManifest(t, "a.proto")
+	envelopeData := mustSignManifest(t, m, privateKey)
+	verifier, err := bufmanifest.NewKeyVerifier(mustMarshalPublicKeyPEM(t, otherPublicKey))
+	require.NoError(t, err)
+	assert.Error(t, bufmanifest.VerifyManifestSignature(m, envelopeData, verifier))
+}
+
+func TestVerifyManifestWithTamperedPayload(t *testing.T) {
+	t.Parallel()
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	m := newTestManifest(t, "a.proto")
+	envelope, err := bufmanifest.SignManifest(m, privateKey)
+	require.NoError(t, err)
+	otherManifest := newTestManifest(t, "b.proto")
+	envelope.Payload, err = otherManifest.MarshalText()
+	require.NoError(t, err)
+	envelopeData, err := bufmanifest.MarshalEnvelope(envelope)
+	require.NoError(t, err)
+	verifier, err := bufmanifest.NewKeyVerifier(mustMarshalPublicKeyPEM(t, privateKey.Public()))
+	require.NoError(t, err)
+	assert.Error(t, bufmanifest.VerifyManifestSignature(otherManifest, envelopeData, verifier))
+}
+
+func TestSignAndVerifyManifestWithCertificateIdentity(t *testing.T) {
+	t.Parallel()
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, rootKey.Public(), rootKey)
+	require.NoError(t, err)
+	root, err := x509.ParseCertificate(rootDER)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber:   big.NewInt(2),
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(time.Hour),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses: []string{"releaser@example.com"},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, root, leafKey.Public(), rootKey)
+	require.NoError(t, err)
+	leafPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})
+
+	m := newTestManifest(t, "a.proto")
+	envelope, err := bufmanifest.SignManifest(m, leafKey, bufmanifest.SignWithCertificate(leafPEM))
+	require.NoError(t, err)
+	envelopeData, err := bufmanifest.MarshalEnvelope(envelope)
+	require.NoError(t, err)
+
+	assert.NoError(
+		t,
+		bufmanifest.VerifyManifestSignature(
+			m,
+			envelopeData,
+			bufmanifest.NewCertificateIdentityVerifier("releaser@example.com", roots),
+		),
+	)
+	assert.Error(
+		t,
+		bufmanifest.VerifyManifestSignature(
+			m,
+			envelopeData,
+			bufmanifest.NewCertificateIdentityVerifier("someone@example.com", roots),
+		),
+	)
+	assert.Error(
+		t,
+		bufmanifest.VerifyManifestSignature(
+			m,
+			envelopeData,
+			bufmanifest.NewCertificateIdentityVerifier("releaser@example.com", x509.NewCertPool()),
+		),
+	)
+}
+
+func TestNewKeySigner(t *testing.T) {
+	t.Parallel()
+	_, ed25519PrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecdsaPrivateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecdsaDER, err := x509.MarshalECPrivateKey(ecdsaPrivateKey)
+	require.NoError(t, err)
+	for _, privateKeyPEM := range [][]byte{
+		mustMarshalPrivateKeyPEM(t, ed25519PrivateKey),
+		mustMarshalPrivateKeyPEM(t, ecdsaPrivateKey),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecdsaDER}),
+	} {
+		signer, err := bufmanifest.NewKeySigner(privateKeyPEM)
+		require.NoError(t, err)
+		m := newTestManifest(t, "a.proto")
+		envelopeData := mustSignManifest(t, m, signer)
+		verifier, err := bufmanifest.NewKeyVerifier(mustMarshalPublicKeyPEM(t, signer.Public()))
+		require.NoError(t, err)
+		assert.NoError(t, bufmanifest.VerifyManifestSignature(m, envelopeData, verifier))
+	}
+	_, err = bufmanifest.NewKeySigner([]byte("not pem"))
+	assert.Error(t, err)
+	_, err = bufmanifest.NewKeySigner(mustMarshalPublicKeyPEM(t, ecdsaPrivateKey.Public()))
+	assert.Error(t, err)
+}
+
+func TestParseEnvelopeInvalid(t *testing.T) {
+	t.Parallel()
+	_, err := bufmanifest.ParseEnvelope([]byte("not json"))
+	assert.Error(t, err)
+	_, err = bufmanifest.ParseEnvelope([]byte(`{"payloadType":"foo","payload":""}`))
+	assert.Error(t, err)
+}
+
+func newTestManifest(t *testing.T, paths ...string) *manifest.Manifest {
+	t.Helper()
+	var m manifest.Manifest
+	for _, path := range paths {
+		require.NoError(t, m.AddEntry(path, *mustDigestShake256(t, []byte(path))))
+	}
+	return &m
+}
+
+func mustSignManifest(t *testing.T, m *manifest.Manifest, signer crypto.Signer) []byte {
+	t.Helper()
+	envelope, err := bufmanifest.SignManifest(m, signer)
+	require.NoError(t, err)
+	envelopeData, err := bufmanifest.MarshalEnvelope(envelope)
+	require.NoError(t, err)
+	return envelopeData
+}
+
+func mustMarshalPublicKeyPEM(t *testing.T, publicKey crypto.PublicKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func mustMarshalPrivateKeyPEM(t *testing.T, privateKey crypto.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
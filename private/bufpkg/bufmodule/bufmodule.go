@@ -20,6 +20,8 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/bufbreaking/bufbreakingconfig"
 	"github.com/bufbuild/buf/private/bufpkg/bufcheck/buflint/buflintconfig"
@@ -30,6 +32,7 @@ import (
 	modulev1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/module/v1alpha1"
 	"github.com/bufbuild/buf/private/pkg/manifest"
 	"github.com/bufbuild/buf/private/pkg/storage"
+	"github.com/bufbuild/buf/private/pkg/stringutil"
 	"go.uber.org/multierr"
 )
 
@@ -112,6 +115,10 @@ type Module interface {
 	// DocumentationPath returns the path to the documentation file for the module.
 	// Can be one of `buf.md`, `README.md` or `README.markdown`
 	DocumentationPath() string
+	// DocsDirectory returns the directory of additional documentation pages, such as markdown
+	// files and images, relative to the root of the module, as configured in buf.yaml.
+	// This may return an empty string if no docs directory is configured.
+	DocsDirectory() string
 	// License gets the contents of the module license file, LICENSE and returns the string representation.
 	// This may return an empty string if the documentation file does not exist.
 	License() string
@@ -138,8 +145,15 @@ type Module interface {
 	// of every file in the module, which is useful for caching or recreating a module's
 	// original files.
 	BlobSet() *manifest.BlobSet
+	// ManifestSignature returns the detached signature envelope over the manifest of
+	// the module, as downloaded from the registry (possibly nil).
+	//
+	// The signature is not verified by the Module, see bufmanifest.VerifyManifestSignature.
+	ManifestSignature() []byte
 
 	getSourceReadBucket() storage.ReadBucket
+	// Note this *can* be nil if there is no docs directory.
+	getDocsReadBucket() storage.ReadBucket
 	// Note this *can* be nil if we did not build from a named module.
 	// All code must assume this can be nil.
 	// nil checking should work since the backing type is always a pointer.
@@ -174,6 +188,14 @@ func ModuleWithModuleIdentityAndCommit(moduleIdentity bufmoduleref.ModuleIdentit
 	}
 }
 
+// ModuleWithManifestSignature is used to construct a Module with the detached signature
+// envelope over its manifest.
+func ModuleWithManifestSignature(manifestSignature []byte) ModuleOption {
+	return func(module *module) {
+		module.manifestSignature = manifestSignature
+	}
+}
+
 // NewModuleForBucket returns a new Module. It attempts to read dependencies
 // from a lock file in the read bucket.
 func NewModuleForBucket(
@@ -296,6 +318,9 @@ type ModuleFileSet interface {
 	//
 	// The returned FileInfos are sorted by path.
 	AllFileInfos(ctx context.Context) ([]bufmoduleref.FileInfo, error)
+	// WellKnownTypesStrategy returns how imports of the well-known types are
+	// resolved when building the ModuleFileSet.
+	WellKnownTypesStrategy() WellKnownTypesStrategy
 
 	isModuleFileSet()
 }
@@ -304,8 +329,36 @@ type ModuleFileSet interface {
 func NewModuleFileSet(
 	module Module,
 	dependencies []Module,
+	options ...ModuleFileSetOption,
 ) ModuleFileSet {
-	return newModuleFileSet(module, dependencies)
+	return newModuleFileSet(module, dependencies, options...)
+}
+
+// ModuleFileSetOption is an option for a new ModuleFileSet.
+type ModuleFileSetOption func(*moduleFileSetOptions)
+
+// ModuleFileSetWithExcludedSourcePaths returns a new ModuleFileSetOption that
+// excludes the given paths from the sources of the given Module, which is either
+// the module or one of the dependencies of the ModuleFileSet.
+//
+// This is used to resolve files that exist in multiple modules, see DuplicateStrategy.
+func ModuleFileSetWithExcludedSourcePaths(module Module, paths []string) ModuleFileSetOption {
+	return func(moduleFileSetOptions *moduleFileSetOptions) {
+		moduleFileSetOptions.moduleToExcludedSourcePaths[module] = append(
+			moduleFileSetOptions.moduleToExcludedSourcePaths[module],
+			paths...,
+		)
+	}
+}
+
+// ModuleFileSetWithWellKnownTypesStrategy returns a new ModuleFileSetOption that
+// sets the WellKnownTypesStrategy of the ModuleFileSet.
+//
+// The default is WellKnownTypesStrategyFallback.
+func ModuleFileSetWithWellKnownTypesStrategy(wellKnownTypesStrategy WellKnownTypesStrategy) ModuleFileSetOption {
+	return func(moduleFileSetOptions *moduleFileSetOptions) {
+		moduleFileSetOptions.wellKnownTypesStrategy = wellKnownTypesStrategy
+	}
 }
 
 // Workspace represents a module workspace.
@@ -314,19 +367,167 @@ type Workspace interface {
 	GetModule(moduleIdentity bufmoduleref.ModuleIdentity) (Module, bool)
 	// GetModules returns all of the modules found in the workspace.
 	GetModules() []Module
+	// DuplicateStrategy returns how files that exist in both the workspace
+	// and its dependencies are handled.
+	DuplicateStrategy() DuplicateStrategy
+	// WellKnownTypesStrategy returns how imports of the well-known types
+	// are resolved.
+	WellKnownTypesStrategy() WellKnownTypesStrategy
 }
 
 // NewWorkspace returns a new module workspace.
 func NewWorkspace(
 	namedModules map[string]Module,
 	allModules []Module,
+	options ...WorkspaceOption,
 ) Workspace {
 	return newWorkspace(
 		namedModules,
 		allModules,
+		options...,
 	)
 }
 
+// WorkspaceOption is an option for a new Workspace.
+type WorkspaceOption func(*workspace)
+
+// WorkspaceWithDuplicateStrategy returns a new WorkspaceOption that sets the
+// DuplicateStrategy of the workspace.
+//
+// The default is DuplicateStrategyFail.
+func WorkspaceWithDuplicateStrategy(duplicateStrategy DuplicateStrategy) WorkspaceOption {
+	return func(workspace *workspace) {
+		workspace.duplicateStrategy = duplicateStrategy
+	}
+}
+
+// WorkspaceWithWellKnownTypesStrategy returns a new WorkspaceOption that sets the
+// WellKnownTypesStrategy of the workspace.
+//
+// The default is WellKnownTypesStrategyFallback.
+func WorkspaceWithWellKnownTypesStrategy(wellKnownTypesStrategy WellKnownTypesStrategy) WorkspaceOption {
+	return func(workspace *workspace) {
+		workspace.wellKnownTypesStrategy = wellKnownTypesStrategy
+	}
+}
+
+// DuplicateStrategy determines how a file path that exists in both the modules
+// of a workspace and the dependencies of the workspace is handled.
+type DuplicateStrategy int
+
+const (
+	// DuplicateStrategyFail results in an error that lists every duplicate file.
+	DuplicateStrategyFail DuplicateStrategy = iota + 1
+	// DuplicateStrategyPreferWorkspace uses the file from the workspace.
+	DuplicateStrategyPreferWorkspace
+	// DuplicateStrategyPreferDependency uses the file from the dependency.
+	DuplicateStrategyPreferDependency
+)
+
+var (
+	// AllDuplicateStrategyStrings are all DuplicateStrategy strings.
+	AllDuplicateStrategyStrings = []string{
+		DuplicateStrategyFail.String(),
+		DuplicateStrategyPreferWorkspace.String(),
+		DuplicateStrategyPreferDependency.String(),
+	}
+
+	duplicateStrategyToString = map[DuplicateStrategy]string{
+		DuplicateStrategyFail:             "fail",
+		DuplicateStrategyPreferWorkspace:  "prefer-workspace",
+		DuplicateStrategyPreferDependency: "prefer-dep",
+	}
+	stringToDuplicateStrategy = map[string]DuplicateStrategy{
+		"fail":             DuplicateStrategyFail,
+		"prefer-workspace": DuplicateStrategyPreferWorkspace,
+		"prefer-dep":       DuplicateStrategyPreferDependency,
+	}
+)
+
+// String implements fmt.Stringer.
+func (d DuplicateStrategy) String() string {
+	s, ok := duplicateStrategyToString[d]
+	if !ok {
+		return strconv.Itoa(int(d))
+	}
+	return s
+}
+
+// ParseDuplicateStrategy parses the DuplicateStrategy.
+//
+// The empty string parses to DuplicateStrategyFail.
+func ParseDuplicateStrategy(s string) (DuplicateStrategy, error) {
+	if s == "" {
+		return DuplicateStrategyFail, nil
+	}
+	duplicateStrategy, ok := stringToDuplicateStrategy[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return 0, fmt.Errorf("unknown duplicate strategy %q, must be one of %s", s, stringutil.SliceToString(AllDuplicateStrategyStrings))
+	}
+	return duplicateStrategy, nil
+}
+
+// WellKnownTypesStrategy determines where the files of the well-known types,
+// such as google/protobuf/timestamp.proto, are read from.
+type WellKnownTypesStrategy int
+
+const (
+	// WellKnownTypesStrategyFallback reads the well-known types from the modules
+	// if they contain them, and otherwise from the versions bundled with buf.
+	//
+	// Module files that shadow a bundled well-known type are reported.
+	WellKnownTypesStrategyFallback WellKnownTypesStrategy = iota + 1
+	// WellKnownTypesStrategyBundled always reads the well-known types from the
+	// versions bundled with buf, ignoring any module files at the same paths.
+	WellKnownTypesStrategyBundled
+	// WellKnownTypesStrategyModule only reads the well-known types from the modules,
+	// for example from a pinned dependency. The bundled versions are never used.
+	WellKnownTypesStrategyModule
+)
+
+var (
+	// AllWellKnownTypesStrategyStrings are all WellKnownTypesStrategy strings.
+	AllWellKnownTypesStrategyStrings = []string{
+		WellKnownTypesStrategyFallback.String(),
+		WellKnownTypesStrategyBundled.String(),
+		WellKnownTypesStrategyModule.String(),
+	}
+
+	wellKnownTypesStrategyToString = map[WellKnownTypesStrategy]string{
+		WellKnownTypesStrategyFallback: "fallback",
+		WellKnownTypesStrategyBundled:  "bundled",
+		WellKnownTypesStrategyModule:   "module",
+	}
+	stringToWellKnownTypesStrategy = map[string]WellKnownTypesStrategy{
+		"fallback": WellKnownTypesStrategyFallback,
+		"bundled":  WellKnownTypesStrategyBundled,
+		"module":   WellKnownTypesStrategyModule,
+	}
+)
+
+// String implements fmt.Stringer.
+func (w WellKnownTypesStrategy) String() string {
+	s, ok := wellKnownTypesStrategyToString[w]
+	if !ok {
+		return strconv.Itoa(int(w))
+	}
+	return s
+}
+
+// ParseWellKnownTypesStrategy parses the WellKnownTypesStrategy.
+//
+// The empty string parses to WellKnownTypesStrategyFallback.
+func ParseWellKnownTypesStrategy(s string) (WellKnownTypesStrategy, error) {
+	if s == "" {
+		return WellKnownTypesStrategyFallback, nil
+	}
+	wellKnownTypesStrategy, ok := stringToWellKnownTypesStrategy[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return 0, fmt.Errorf("unknown well-known types strategy %q, must be one of %s", s, stringutil.SliceToString(AllWellKnownTypesStrategyStrings))
+	}
+	return wellKnownTypesStrategy, nil
+}
+
 // ModuleToProtoModule converts the Module to a proto Module.
 //
 // This takes all Sources and puts them in the Module, not just Targets.
@@ -485,6 +686,11 @@ func ModuleToBucket(
 			return err
 		}
 	}
+	if docsReadBucket := module.getDocsReadBucket(); docsReadBucket != nil {
+		if _, err := storage.Copy(ctx, docsReadBucket, writeBucket); err != nil {
+			return err
+		}
+	}
 	if license := module.License(); license != "" {
 		if err := storage.PutPath(ctx, writeBucket, LicenseFilePath, []byte(license)); err != nil {
 			return err
@@ -518,10 +724,43 @@ func ModuleToBucket(
 		bufconfig.WriteConfigWithBreakingConfig(module.BreakingConfig()),
 		bufconfig.WriteConfigWithLintConfig(module.LintConfig()),
 		bufconfig.WriteConfigWithVersion(version),
+		bufconfig.WriteConfigWithDocsDirectory(module.DocsDirectory()),
 	}
 	return bufconfig.WriteConfig(ctx, writeBucket, writeConfigOptions...)
 }
 
+// ModuleDocsToBucket writes the documentation of the given Module to the WriteBucket.
+//
+// This writes the documentation file, such as buf.md, and the pages in the docs
+// directory, if any. Returns false if the Module has no documentation.
+func ModuleDocsToBucket(
+	ctx context.Context,
+	module Module,
+	writeBucket storage.WriteBucket,
+) (bool, error) {
+	var wroteDocs bool
+	if docs := module.Documentation(); docs != "" {
+		moduleDocPath := DefaultDocumentationPath
+		if docPath := module.DocumentationPath(); docPath != "" {
+			moduleDocPath = docPath
+		}
+		if err := storage.PutPath(ctx, writeBucket, moduleDocPath, []byte(docs)); err != nil {
+			return false, err
+		}
+		wroteDocs = true
+	}
+	if docsReadBucket := module.getDocsReadBucket(); docsReadBucket != nil {
+		copied, err := storage.Copy(ctx, docsReadBucket, writeBucket)
+		if err != nil {
+			return false, err
+		}
+		if copied > 0 {
+			wroteDocs = true
+		}
+	}
+	return wroteDocs, nil
+}
+
 // TargetModuleFilesToBucket writes the target files of the given Module to the WriteBucket.
 //
 // This does not write the buf.lock file.
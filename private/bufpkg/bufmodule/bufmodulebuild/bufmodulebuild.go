@@ -17,6 +17,7 @@ package bufmodulebuild
 import (
 	"context"
 
+	"github.com/bufbuild/buf/private/bufpkg/buflicense"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
@@ -50,6 +51,16 @@ func WithWorkspace(workspace bufmodule.Workspace) BuildModuleFileSetOption {
 	}
 }
 
+// WithLicenseConfig returns a new BuildModuleFileSetOption that checks the licenses
+// of the dependencies that are not provided by the workspace against the license config.
+//
+// The default is to not check the licenses of the dependencies.
+func WithLicenseConfig(licenseConfig *buflicense.Config) BuildModuleFileSetOption {
+	return func(buildModuleFileSetOptions *buildModuleFileSetOptions) {
+		buildModuleFileSetOptions.licenseConfig = licenseConfig
+	}
+}
+
 // ModuleBucketBuilder builds modules for buckets.
 type ModuleBucketBuilder = *moduleBucketBuilder
 
@@ -145,3 +156,13 @@ func WithExcludePathsAllowNotExist(excludePaths []string) BuildOption {
 		buildOptions.pathsAllowNotExist = true
 	}
 }
+
+// WithLookupEnv returns a new BuildOption that expands references to environment
+// variables within the configuration files of the module using the given lookup function.
+//
+// See bufconfig.ReadConfigOS for the supported syntax.
+func WithLookupEnv(lookupEnv func(string) (string, bool)) BuildOption {
+	return func(buildOptions *buildOptions) {
+		buildOptions.lookupEnv = lookupEnv
+	}
+}
@@ -16,11 +16,13 @@ package bufmodulebuild
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufconfig"
 	"github.com/bufbuild/buf/private/bufpkg/buflock"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleconfig"
+	"github.com/bufbuild/buf/private/pkg/encoding"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/storage"
 	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
@@ -84,8 +86,7 @@ func (b *moduleBucketBuilder) BuildForBucket(
 		buflock.ExternalConfigFilePath,
 		bufmodule.LicenseFilePath,
 	}
-	externalPaths = append(externalPaths, bufconfig.AllConfigFilePaths...)
-	rootBuckets := make([]storage.ReadBucket, 0, len(externalPaths)+1)
+	rootBuckets := make([]storage.ReadBucket, 0, len(externalPaths)+len(bufconfig.AllConfigFilePaths)+1)
 	for _, docPath := range bufmodule.AllDocumentationPaths {
 		bucket, err := getFileReadBucket(ctx, readBucket, docPath)
 		if err != nil {
@@ -105,6 +106,24 @@ func (b *moduleBucketBuilder) BuildForBucket(
 			rootBuckets = append(rootBuckets, bucket)
 		}
 	}
+	var configBuckets []storage.ReadBucket
+	for _, path := range bufconfig.AllConfigFilePaths {
+		bucket, err := getConfigFileReadBucket(ctx, readBucket, path, b.opt.lookupEnv)
+		if err != nil {
+			return nil, err
+		}
+		if bucket != nil {
+			rootBuckets = append(rootBuckets, bucket)
+			configBuckets = append(configBuckets, bucket)
+		}
+	}
+	docsBucket, err := getDocsReadBucket(ctx, readBucket, configBuckets)
+	if err != nil {
+		return nil, err
+	}
+	if docsBucket != nil {
+		rootBuckets = append(rootBuckets, docsBucket)
+	}
 
 	roots := make([]string, 0, len(config.RootToExcludes))
 	for root, excludes := range config.RootToExcludes {
@@ -190,3 +209,65 @@ func getFileReadBucket(
 		},
 	)
 }
+
+// may return nil.
+//
+// If lookupEnv is non-nil, references to environment variables within the
+// configuration file are expanded so that the module is built from the same
+// configuration that was read from the OS.
+func getConfigFileReadBucket(
+	ctx context.Context,
+	readBucket storage.ReadBucket,
+	filePath string,
+	lookupEnv func(string) (string, bool),
+) (storage.ReadBucket, error) {
+	if lookupEnv == nil {
+		return getFileReadBucket(ctx, readBucket, filePath)
+	}
+	fileData, err := storage.ReadPath(ctx, readBucket, filePath)
+	if err != nil {
+		if storage.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(fileData) == 0 {
+		return nil, nil
+	}
+	fileData, err = encoding.ExpandYAMLEnv(fileData, lookupEnv)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+	return storagemem.NewReadBucket(
+		map[string][]byte{
+			filePath: fileData,
+		},
+	)
+}
+
+// may return nil.
+//
+// The docs directory is read from the configuration in configBuckets, so that
+// the pages are included in the module alongside the configuration that lists them.
+func getDocsReadBucket(
+	ctx context.Context,
+	readBucket storage.ReadBucket,
+	configBuckets []storage.ReadBucket,
+) (storage.ReadBucket, error) {
+	if len(configBuckets) == 0 {
+		return nil, nil
+	}
+	config, err := bufconfig.GetConfigForBucket(ctx, storage.MultiReadBucket(configBuckets...))
+	if err != nil {
+		return nil, err
+	}
+	if config.DocsDirectory == "" {
+		return nil, nil
+	}
+	return storage.MapReadBucket(
+		readBucket,
+		storage.MatchPathContained(config.DocsDirectory),
+		// .proto files are only included through the roots.
+		storage.MatchNot(storage.MatchPathExt(".proto")),
+	), nil
+}
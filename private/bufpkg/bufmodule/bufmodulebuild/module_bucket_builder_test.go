@@ -377,6 +377,51 @@ lint:
 	assert.NotEqual(t, zeroLint, module.LintConfig(), "empty LintConfig")
 }
 
+func TestDocsDirectoryInclusion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	bucket, err := memBucket(ctx,
+		"buf.yaml", "version: v1\ndocs:\n  directory: docs\n",
+		"buf.md", "# Module",
+		"docs/index.md", "# Index",
+		"docs/images/diagram.png", "png",
+		"a/1.proto", "",
+		"other/page.md", "# Not included",
+	)
+	require.NoError(t, err)
+	config, err := bufmoduleconfig.NewConfigV1(
+		bufmoduleconfig.ExternalConfigV1{},
+	)
+	require.NoError(t, err)
+	builtModule, err := BuildForBucket(
+		ctx,
+		bucket,
+		config,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "docs", builtModule.Module.DocsDirectory())
+	// The pages are in the bucket that is pushed.
+	for _, path := range []string{"docs/index.md", "docs/images/diagram.png"} {
+		exists, err := storage.Exists(ctx, builtModule.Bucket, path)
+		require.NoError(t, err)
+		assert.True(t, exists, path)
+	}
+	exists, err := storage.Exists(ctx, builtModule.Bucket, "other/page.md")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	readWriteBucket := storagemem.NewReadWriteBucket()
+	wroteDocs, err := bufmodule.ModuleDocsToBucket(ctx, builtModule.Module, readWriteBucket)
+	require.NoError(t, err)
+	assert.True(t, wroteDocs)
+	var paths []string
+	require.NoError(t, readWriteBucket.Walk(ctx, "", func(objectInfo storage.ObjectInfo) error {
+		paths = append(paths, objectInfo.Path())
+		return nil
+	}))
+	assert.ElementsMatch(t, []string{"buf.md", "docs/images/diagram.png", "docs/index.md"}, paths)
+}
+
 func memBucket(ctx context.Context, pathcontent ...string) (storage.ReadBucket, error) {
 	membucket := storagemem.NewReadWriteBucket()
 	for i := 0; i < len(pathcontent); i += 2 {
@@ -16,8 +16,11 @@ package bufmodulebuild
 
 import (
 	"context"
+	"sort"
 
+	"github.com/bufbuild/buf/private/bufpkg/buflicense"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulelicense"
 	"go.uber.org/zap"
 )
 
@@ -48,6 +51,7 @@ func (m *moduleFileSetBuilder) Build(
 		ctx,
 		module,
 		buildModuleFileSetOptions.workspace,
+		buildModuleFileSetOptions.licenseConfig,
 	)
 }
 
@@ -55,8 +59,13 @@ func (m *moduleFileSetBuilder) build(
 	ctx context.Context,
 	module bufmodule.Module,
 	workspace bufmodule.Workspace,
+	licenseConfig *buflicense.Config,
 ) (bufmodule.ModuleFileSet, error) {
 	var dependencyModules []bufmodule.Module
+	// The module itself is part of the workspace, even if there is no workspace.
+	workspaceModules := []bufmodule.Module{module}
+	duplicateStrategy := bufmodule.DuplicateStrategyFail
+	wellKnownTypesStrategy := bufmodule.WellKnownTypesStrategyFallback
 	if workspace != nil {
 		// From the perspective of the ModuleFileSet, we include all of the files
 		// specified in the workspace. When we build the Image from the ModuleFileSet,
@@ -68,7 +77,12 @@ func (m *moduleFileSetBuilder) build(
 		// used. We already get this for free in Image construction, so it's simplest and
 		// most efficient to bundle all of the modules together like so.
 		dependencyModules = workspace.GetModules()
+		workspaceModules = append(workspaceModules, workspace.GetModules()...)
+		duplicateStrategy = workspace.DuplicateStrategy()
+		wellKnownTypesStrategy = workspace.WellKnownTypesStrategy()
 	}
+	var nonWorkspaceDependencyModules []bufmodule.Module
+	var licenseDependencies []*bufmodulelicense.Dependency
 	// We know these are unique by remote, owner, repository and
 	// contain all transitive dependencies.
 	for _, dependencyModulePin := range module.DependencyModulePins() {
@@ -84,6 +98,104 @@ func (m *moduleFileSetBuilder) build(
 			return nil, err
 		}
 		dependencyModules = append(dependencyModules, dependencyModule)
+		nonWorkspaceDependencyModules = append(nonWorkspaceDependencyModules, dependencyModule)
+		licenseDependencies = append(
+			licenseDependencies,
+			&bufmodulelicense.Dependency{
+				ModulePin: dependencyModulePin,
+				Module:    dependencyModule,
+			},
+		)
 	}
-	return bufmodule.NewModuleFileSet(module, dependencyModules), nil
+	if err := bufmodulelicense.CheckDependencies(licenseConfig, licenseDependencies...); err != nil {
+		return nil, err
+	}
+	moduleFileSetOptions, err := getDuplicateStrategyModuleFileSetOptions(
+		ctx,
+		workspaceModules,
+		nonWorkspaceDependencyModules,
+		duplicateStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	moduleFileSetOptions = append(
+		moduleFileSetOptions,
+		bufmodule.ModuleFileSetWithWellKnownTypesStrategy(wellKnownTypesStrategy),
+	)
+	return bufmodule.NewModuleFileSet(module, dependencyModules, moduleFileSetOptions...), nil
+}
+
+// getDuplicateStrategyModuleFileSetOptions returns the ModuleFileSetOptions that
+// resolve the files that exist in both a workspace module and a dependency module
+// according to the DuplicateStrategy.
+//
+// Files that exist in multiple workspace modules, or in multiple dependency modules,
+// are not resolved. Neither are any files for DuplicateStrategyFail. The ModuleFileSet
+// returns an error for these files if they are read.
+func getDuplicateStrategyModuleFileSetOptions(
+	ctx context.Context,
+	workspaceModules []bufmodule.Module,
+	dependencyModules []bufmodule.Module,
+	duplicateStrategy bufmodule.DuplicateStrategy,
+) ([]bufmodule.ModuleFileSetOption, error) {
+	if duplicateStrategy == bufmodule.DuplicateStrategyFail || len(dependencyModules) == 0 {
+		return nil, nil
+	}
+	workspacePathToModules, err := getPathToModules(ctx, workspaceModules)
+	if err != nil {
+		return nil, err
+	}
+	dependencyPathToModules, err := getPathToModules(ctx, dependencyModules)
+	if err != nil {
+		return nil, err
+	}
+	excludedModuleToPaths := make(map[bufmodule.Module][]string)
+	var excludedModules []bufmodule.Module
+	for _, path := range sortedKeys(workspacePathToModules) {
+		workspacePathModules := workspacePathToModules[path]
+		dependencyPathModules := dependencyPathToModules[path]
+		if len(workspacePathModules) != 1 || len(dependencyPathModules) != 1 {
+			continue
+		}
+		excludedModule := dependencyPathModules[0]
+		if duplicateStrategy == bufmodule.DuplicateStrategyPreferDependency {
+			excludedModule = workspacePathModules[0]
+		}
+		if _, ok := excludedModuleToPaths[excludedModule]; !ok {
+			excludedModules = append(excludedModules, excludedModule)
+		}
+		excludedModuleToPaths[excludedModule] = append(excludedModuleToPaths[excludedModule], path)
+	}
+	moduleFileSetOptions := make([]bufmodule.ModuleFileSetOption, 0, len(excludedModules))
+	for _, excludedModule := range excludedModules {
+		moduleFileSetOptions = append(
+			moduleFileSetOptions,
+			bufmodule.ModuleFileSetWithExcludedSourcePaths(excludedModule, excludedModuleToPaths[excludedModule]),
+		)
+	}
+	return moduleFileSetOptions, nil
+}
+
+func getPathToModules(ctx context.Context, modules []bufmodule.Module) (map[string][]bufmodule.Module, error) {
+	pathToModules := make(map[string][]bufmodule.Module)
+	for _, module := range modules {
+		fileInfos, err := module.SourceFileInfos(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileInfo := range fileInfos {
+			pathToModules[fileInfo.Path()] = append(pathToModules[fileInfo.Path()], module)
+		}
+	}
+	return pathToModules, nil
+}
+
+func sortedKeys(pathToModules map[string][]bufmodule.Module) []string {
+	paths := make([]string, 0, len(pathToModules))
+	for path := range pathToModules {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
 }
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/bufbuild/buf/private/bufpkg/buflicense"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
@@ -114,8 +115,11 @@ type buildOptions struct {
 	// Paths that will be excluded from the module build process. This is handled in conjunction
 	// with `paths`.
 	excludePaths []string
+	// If non-nil, environment variable references in configuration files are expanded.
+	lookupEnv func(string) (string, bool)
 }
 
 type buildModuleFileSetOptions struct {
-	workspace bufmodule.Workspace
+	workspace     bufmodule.Workspace
+	licenseConfig *buflicense.Config
 }
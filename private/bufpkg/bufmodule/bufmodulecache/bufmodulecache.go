@@ -15,7 +15,11 @@
 package bufmodulecache
 
 import (
+	"context"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
 	"github.com/bufbuild/buf/private/pkg/connectclient"
 	"github.com/bufbuild/buf/private/pkg/filelock"
@@ -75,6 +79,7 @@ func NewCASModuleReader(
 	bucket storage.ReadWriteBucket,
 	delegate bufmodule.ModuleReader,
 	repositoryClientFactory RepositoryServiceClientFactory,
+	options ...CASModuleReaderOption,
 ) bufmodule.ModuleReader {
 	return newCASModuleReader(
 		bucket,
@@ -82,9 +87,47 @@ func NewCASModuleReader(
 		repositoryClientFactory,
 		logger,
 		verbosePrinter,
+		options...,
 	)
 }
 
+// CASModuleReaderOption is an option for creating a CAS ModuleReader.
+type CASModuleReaderOption func(*casModuleReader)
+
+// CASModuleReaderWithManifestSignatureVerifier requires the manifest signature of
+// every module read from the cache to verify with the given verifier.
+//
+// Manifest signatures are stored in the cache along with the modules. Cached modules
+// without a valid manifest signature are read from the delegate, which must verify
+// their manifest signatures as well.
+func CASModuleReaderWithManifestSignatureVerifier(verifier bufmanifest.SignatureVerifier) CASModuleReaderOption {
+	return func(reader *casModuleReader) {
+		reader.signatureVerifier = verifier
+	}
+}
+
+// CASModuleStore stores modules in a bucket with the content addressable layout of
+// the module cache, so that the bucket can be used as a module cache.
+type CASModuleStore interface {
+	// GetModule gets the module for the pin from the bucket, validating the content
+	// of its blobs against their digests.
+	//
+	// Returns an error that fulfills storage.IsNotExist if the module is not stored.
+	bufmodule.ModuleReader
+	// PutModule stores the module for the pin in the bucket.
+	//
+	// The module must have a manifest and a blob set.
+	PutModule(ctx context.Context, modulePin bufmoduleref.ModulePin, module bufmodule.Module) error
+}
+
+// NewCASModuleStore returns a new CASModuleStore for the bucket.
+func NewCASModuleStore(logger *zap.Logger, bucket storage.ReadWriteBucket) CASModuleStore {
+	return &casModuleCacher{
+		logger: logger,
+		bucket: bucket,
+	}
+}
+
 type moduleReaderOptions struct {
 	allowCacheExternalPaths bool
 }
@@ -15,6 +15,7 @@
 package bufmodulecache
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -32,8 +33,9 @@ import (
 
 // subdirectories under ~/.cache/buf/v2/{remote}/{owner}/{repo}
 const (
-	blobsDir   = "blobs"
-	commitsDir = "commits"
+	blobsDir      = "blobs"
+	commitsDir    = "commits"
+	signaturesDir = "signatures"
 )
 
 type casModuleCacher struct {
@@ -79,7 +81,16 @@ func (c *casModuleCacher) GetModule(
 	if err != nil {
 		return nil, err
 	}
-	return bufmodule.NewModuleForManifestAndBlobSet(ctx, manifestFromCache, blobSet)
+	manifestSignature, err := c.loadPath(ctx, manifestSignaturePath(moduleBasedir, *manifestDigest))
+	if err != nil && !storage.IsNotExist(err) {
+		return nil, err
+	}
+	return bufmodule.NewModuleForManifestAndBlobSet(
+		ctx,
+		manifestFromCache,
+		blobSet,
+		bufmodule.ModuleWithManifestSignature(manifestSignature),
+	)
 }
 
 func (c *casModuleCacher) PutModule(
@@ -125,6 +136,16 @@ func (c *casModuleCacher) PutModule(
 	if err := c.writeBlob(ctx, moduleBasedir, manifestBlob); err != nil {
 		return err
 	}
+	// Write manifest signature
+	if manifestSignature := module.ManifestSignature(); len(manifestSignature) > 0 {
+		if err := c.atomicWrite(
+			ctx,
+			bytes.NewReader(manifestSignature),
+			manifestSignaturePath(moduleBasedir, *manifestDigest),
+		); err != nil {
+			return err
+		}
+	}
 	// Write commit
 	commitPath := normalpath.Join(moduleBasedir, commitsDir, modulePin.Commit())
 	if err := c.atomicWrite(ctx, strings.NewReader(manifestBlob.Digest().String()), commitPath); err != nil {
@@ -257,3 +278,10 @@ func (c *casModuleCacher) loadPath(
 	}()
 	return io.ReadAll(f)
 }
+
+// manifestSignaturePath returns the path of the signature envelope over the
+// manifest with the given digest.
+func manifestSignaturePath(moduleBasedir string, manifestDigest manifest.Digest) string {
+	hexDigest := manifestDigest.Hex()
+	return normalpath.Join(moduleBasedir, signaturesDir, hexDigest[:2], hexDigest[2:])
+}
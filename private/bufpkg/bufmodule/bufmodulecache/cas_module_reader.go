@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/manifest"
@@ -32,6 +33,8 @@ type casModuleReader struct {
 	repositoryClientFactory RepositoryServiceClientFactory
 	logger                  *zap.Logger
 	verbosePrinter          verbose.Printer
+	// optional parameters
+	signatureVerifier bufmanifest.SignatureVerifier
 	// initialized in newCASModuleReader
 	cache *casModuleCacher
 	stats *cacheStats
@@ -45,8 +48,9 @@ func newCASModuleReader(
 	repositoryClientFactory RepositoryServiceClientFactory,
 	logger *zap.Logger,
 	verbosePrinter verbose.Printer,
+	options ...CASModuleReaderOption,
 ) *casModuleReader {
-	return &casModuleReader{
+	reader := &casModuleReader{
 		delegate:                delegate,
 		repositoryClientFactory: repositoryClientFactory,
 		logger:                  logger,
@@ -57,6 +61,10 @@ func newCASModuleReader(
 		},
 		stats: &cacheStats{},
 	}
+	for _, option := range options {
+		option(reader)
+	}
+	return reader
 }
 
 func (c *casModuleReader) GetModule(
@@ -73,6 +81,11 @@ func (c *casModuleReader) GetModule(
 		}
 	}
 	cachedModule, err := c.cache.GetModule(ctx, modulePin)
+	if err == nil && c.signatureVerifier != nil {
+		// The cache may have been written without signature verification, or
+		// modified since, so the signature is verified on every read.
+		err = c.verifyManifestSignature(modulePin, cachedModule)
+	}
 	if err == nil {
 		c.stats.MarkHit()
 		return cachedModule, nil
@@ -107,3 +120,17 @@ func (c *casModuleReader) GetModule(
 	}
 	return remoteModule, nil
 }
+
+func (c *casModuleReader) verifyManifestSignature(
+	modulePin bufmoduleref.ModulePin,
+	module bufmodule.Module,
+) error {
+	manifestSignature := module.ManifestSignature()
+	if len(manifestSignature) == 0 {
+		return fmt.Errorf("module %s has no manifest signature", modulePin.String())
+	}
+	if err := bufmanifest.VerifyManifestSignature(module.Manifest(), manifestSignature, c.signatureVerifier); err != nil {
+		return fmt.Errorf("module %s: %w", modulePin.String(), err)
+	}
+	return nil
+}
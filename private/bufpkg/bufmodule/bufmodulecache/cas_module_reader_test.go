@@ -17,11 +17,18 @@ package bufmodulecache
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"io"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/bufbuild/buf/private/bufpkg/bufmanifest"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/gen/proto/connect/buf/alpha/registry/v1alpha1/registryv1alpha1connect"
@@ -93,6 +100,39 @@ func TestCASModuleReaderHappyPath(t *testing.T) {
 	verifyCache(t, storageBucket, pin, moduleManifest, blobs)
 }
 
+func TestCASModuleStore(t *testing.T) {
+	t.Parallel()
+	moduleManifest, blobs := createSampleManifestAndBlobs(t)
+	moduleBlob, err := moduleManifest.Blob()
+	require.NoError(t, err)
+	testModule, err := bufmodule.NewModuleForManifestAndBlobSet(context.Background(), moduleManifest, blobs)
+	require.NoError(t, err)
+	storageProvider := storageos.NewProvider()
+	storageBucket, err := storageProvider.NewReadWriteBucket(t.TempDir())
+	require.NoError(t, err)
+	store := NewCASModuleStore(zaptest.NewLogger(t), storageBucket)
+	pin, err := bufmoduleref.NewModulePin(
+		"buf.build",
+		"test",
+		"ping",
+		"",
+		"abcd",
+		"",
+		time.Now(),
+	)
+	require.NoError(t, err)
+	_, err = store.GetModule(context.Background(), pin)
+	assert.True(t, storage.IsNotExist(err))
+	require.NoError(t, store.PutModule(context.Background(), pin, testModule))
+	// The commit is stored with the digest of the manifest.
+	storedModule, err := store.GetModule(context.Background(), pin)
+	require.NoError(t, err)
+	storedModuleBlob, err := storedModule.Manifest().Blob()
+	require.NoError(t, err)
+	assert.Equal(t, moduleBlob.Digest().String(), storedModuleBlob.Digest().String())
+	verifyCache(t, storageBucket, pin, moduleManifest, blobs)
+}
+
 func TestCASModuleReaderNoDigest(t *testing.T) {
 	t.Parallel()
 	moduleManifest, blobs := createSampleManifestAndBlobs(t)
@@ -153,6 +193,104 @@ func TestCASModuleReaderDigestMismatch(t *testing.T) {
 	assert.Equal(t, 0, numFiles) // Verify nothing written to cache on digest mismatch
 }
 
+func TestCASModuleReaderManifestSignature(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	verifier, err := bufmanifest.NewKeyVerifier(
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}),
+	)
+	require.NoError(t, err)
+	moduleManifest, blobs := createSampleManifestAndBlobs(t)
+	moduleBlob, err := moduleManifest.Blob()
+	require.NoError(t, err)
+	manifestSignature := signManifest(t, moduleManifest, privateKey)
+	testModule, err := bufmodule.NewModuleForManifestAndBlobSet(
+		ctx,
+		moduleManifest,
+		blobs,
+		bufmodule.ModuleWithManifestSignature(manifestSignature),
+	)
+	require.NoError(t, err)
+	storageProvider := storageos.NewProvider()
+	storageBucket, err := storageProvider.NewReadWriteBucket(t.TempDir())
+	require.NoError(t, err)
+	moduleReader := newCASModuleReader(
+		storageBucket,
+		&testModuleReader{module: testModule},
+		func(_ string) registryv1alpha1connect.RepositoryServiceClient {
+			return &testRepositoryServiceClient{}
+		},
+		zaptest.NewLogger(t),
+		&testVerbosePrinter{t: t},
+		CASModuleReaderWithManifestSignatureVerifier(verifier),
+	)
+	pin, err := bufmoduleref.NewModulePin(
+		"buf.build",
+		"test",
+		"ping",
+		"",
+		"abcd",
+		moduleBlob.Digest().String(),
+		time.Now(),
+	)
+	require.NoError(t, err)
+	_, err = moduleReader.GetModule(ctx, pin)
+	require.NoError(t, err)
+	cachedModule, err := moduleReader.GetModule(ctx, pin)
+	require.NoError(t, err)
+	assert.Equal(t, 1, moduleReader.stats.Hits())
+	assert.Equal(t, manifestSignature, cachedModule.ManifestSignature())
+
+	// A cached signature that does not verify is a cache miss, and is repaired
+	// from the delegate.
+	signaturePath := manifestSignaturePath(
+		normalpath.Join(pin.Remote(), pin.Owner(), pin.Repository()),
+		*moduleBlob.Digest(),
+	)
+	require.NoError(
+		t,
+		storage.PutPath(ctx, storageBucket, signaturePath, signManifest(t, moduleManifest, otherPrivateKey)),
+	)
+	_, err = moduleReader.GetModule(ctx, pin)
+	require.NoError(t, err)
+	assert.Equal(t, 3, moduleReader.stats.Count())
+	assert.Equal(t, 1, moduleReader.stats.Hits())
+	cachedSignature, err := storage.ReadPath(ctx, storageBucket, signaturePath)
+	require.NoError(t, err)
+	assert.Equal(t, manifestSignature, cachedSignature)
+
+	// A cached module without a signature is a cache miss.
+	require.NoError(t, storageBucket.Delete(ctx, signaturePath))
+	unsignedModuleReader := newCASModuleReader(
+		storageBucket,
+		&testModuleReader{err: errors.New("registry unavailable")},
+		func(_ string) registryv1alpha1connect.RepositoryServiceClient {
+			return &testRepositoryServiceClient{}
+		},
+		zaptest.NewLogger(t),
+		&testVerbosePrinter{t: t},
+		CASModuleReaderWithManifestSignatureVerifier(verifier),
+	)
+	_, err = unsignedModuleReader.GetModule(ctx, pin)
+	assert.ErrorContains(t, err, "registry unavailable")
+	assert.Equal(t, 0, unsignedModuleReader.stats.Hits())
+}
+
+func signManifest(t *testing.T, m *manifest.Manifest, signer crypto.Signer) []byte {
+	t.Helper()
+	envelope, err := bufmanifest.SignManifest(m, signer)
+	require.NoError(t, err)
+	envelopeData, err := bufmanifest.MarshalEnvelope(envelope)
+	require.NoError(t, err)
+	return envelopeData
+}
+
 func verifyCache(
 	t *testing.T,
 	bucket storage.ReadWriteBucket,
@@ -214,11 +352,15 @@ func verifyBlobContents(t *testing.T, bucket storage.ReadWriteBucket, basedir st
 
 type testModuleReader struct {
 	module bufmodule.Module
+	err    error
 }
 
 var _ bufmodule.ModuleReader = (*testModuleReader)(nil)
 
 func (t *testModuleReader) GetModule(_ context.Context, _ bufmoduleref.ModulePin) (bufmodule.Module, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
 	return t.module, nil
 }
 
@@ -0,0 +1,89 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufmodulelicense enforces a license policy on the dependencies of a module.
+package bufmodulelicense
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/buflicense"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+)
+
+// Dependency is a dependency module to check.
+type Dependency struct {
+	ModulePin bufmoduleref.ModulePin
+	Module    bufmodule.Module
+}
+
+// CheckDependencies checks the licenses of the given dependencies against the config.
+//
+// Returns an error that lists every dependency that violates the license policy.
+// Returns nil if config is nil.
+func CheckDependencies(config *buflicense.Config, dependencies ...*Dependency) error {
+	if config == nil {
+		return nil
+	}
+	var violations []string
+	for _, dependency := range dependencies {
+		licenseID, _ := buflicense.DetectLicenseID(dependency.Module.License())
+		if err := config.Check(licenseID); err != nil {
+			violations = append(
+				violations,
+				fmt.Sprintf("%s: %v", dependency.ModulePin.IdentityString(), err),
+			)
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return fmt.Errorf(
+		"dependencies violate the license policy:\n\t%s",
+		strings.Join(violations, "\n\t"),
+	)
+}
+
+// CheckDependencyModulePins reads the modules for the given ModulePins and checks
+// their licenses against the config.
+//
+// Returns nil without reading any modules if config is nil.
+func CheckDependencyModulePins(
+	ctx context.Context,
+	moduleReader bufmodule.ModuleReader,
+	config *buflicense.Config,
+	modulePins ...bufmoduleref.ModulePin,
+) error {
+	if config == nil {
+		return nil
+	}
+	dependencies := make([]*Dependency, 0, len(modulePins))
+	for _, modulePin := range modulePins {
+		module, err := moduleReader.GetModule(ctx, modulePin)
+		if err != nil {
+			return err
+		}
+		dependencies = append(
+			dependencies,
+			&Dependency{
+				ModulePin: modulePin,
+				Module:    module,
+			},
+		)
+	}
+	return CheckDependencies(config, dependencies...)
+}
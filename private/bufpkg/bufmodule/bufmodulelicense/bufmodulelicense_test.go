@@ -0,0 +1,77 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmodulelicense
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/buflicense"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDependencies(t *testing.T) {
+	t.Parallel()
+	config, err := buflicense.NewConfigV1(
+		buflicense.ExternalConfigV1{
+			Allow: []string{"Apache-2.0", "MIT"},
+		},
+	)
+	require.NoError(t, err)
+	apacheDependency := newTestDependency(t, "units", "SPDX-License-Identifier: Apache-2.0")
+	gplDependency := newTestDependency(t, "money", "SPDX-License-Identifier: GPL-3.0-only")
+	unknownDependency := newTestDependency(t, "geo", "")
+
+	assert.NoError(t, CheckDependencies(nil, gplDependency, unknownDependency))
+	assert.NoError(t, CheckDependencies(config, apacheDependency))
+	err = CheckDependencies(config, apacheDependency, gplDependency, unknownDependency)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "buf.build/acme/money: license GPL-3.0-only is not allowed")
+	assert.Contains(t, err.Error(), "buf.build/acme/geo: license could not be detected")
+	assert.NotContains(t, err.Error(), "buf.build/acme/units")
+}
+
+func newTestDependency(t *testing.T, repository string, license string) *Dependency {
+	ctx := context.Background()
+	files := map[string][]byte{
+		"a.proto": []byte(`syntax = "proto3";`),
+	}
+	if license != "" {
+		files["LICENSE"] = []byte(license)
+	}
+	readBucket, err := storagemem.NewReadBucket(files)
+	require.NoError(t, err)
+	module, err := bufmodule.NewModuleForBucket(ctx, readBucket)
+	require.NoError(t, err)
+	modulePin, err := bufmoduleref.NewModulePin(
+		"buf.build",
+		"acme",
+		repository,
+		"",
+		"62f35d8aed1149c291d606d958a7ce32",
+		"",
+		time.Now(),
+	)
+	require.NoError(t, err)
+	return &Dependency{
+		ModulePin: modulePin,
+		Module:    module,
+	}
+}
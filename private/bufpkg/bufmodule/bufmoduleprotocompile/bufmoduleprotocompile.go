@@ -17,14 +17,28 @@ package bufmoduleprotocompile
 import (
 	"context"
 	"io"
+	"regexp"
+	"strconv"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"github.com/bufbuild/protocompile/ast"
 	"github.com/bufbuild/protocompile/reporter"
 )
 
+// previouslyDefinedRegexp matches the location of a previous definition within
+// a compiler error message, for example:
+//
+//	symbol "a.Foo" already defined at a2.proto:5:9
+//
+// The symbol collision errors of the compiler only carry the position of the
+// conflicting definition, the position of the previous definition is only
+// available within the message. The matched position is only used if a token
+// starts at it, see getRelatedInformation.
+var previouslyDefinedRegexp = regexp.MustCompile(`already defined at (.+\.proto):(\d+):(\d+)`)
+
 // ParserAccessorHandler handles source file access operations for protocompile.
 type ParserAccessorHandler interface {
 	// Open opens the given path, and tracks the external path and import status.
@@ -41,6 +55,11 @@ type ParserAccessorHandler interface {
 	ModuleIdentity(path string) bufmoduleref.ModuleIdentity
 	// Commit returns empty if not available.
 	Commit(path string) string
+	// ShadowedWellKnownTypePaths returns the sorted paths of the opened files that
+	// were read from the module instead of the well-known types bundled with buf.
+	//
+	// This is only populated for bufmodule.WellKnownTypesStrategyFallback.
+	ShadowedWellKnownTypePaths() []string
 }
 
 // NewParserAccessorHandler returns a new ParserAccessorHandler.
@@ -49,22 +68,75 @@ type ParserAccessorHandler interface {
 // access to not just the target files, but all dependency files as well.
 //
 // For AST building, this can just be a bufmodule.Module.
-func NewParserAccessorHandler(ctx context.Context, module bufmodule.Module) ParserAccessorHandler {
-	return newParserAccessorHandler(ctx, module)
+func NewParserAccessorHandler(
+	ctx context.Context,
+	module bufmodule.Module,
+	options ...ParserAccessorHandlerOption,
+) ParserAccessorHandler {
+	return newParserAccessorHandler(ctx, module, options...)
+}
+
+// ParserAccessorHandlerOption is an option for a new ParserAccessorHandler.
+type ParserAccessorHandlerOption func(*parserAccessorHandler)
+
+// ParserAccessorHandlerWithWellKnownTypesStrategy returns a new ParserAccessorHandlerOption
+// that determines where the well-known types are read from.
+//
+// The default is bufmodule.WellKnownTypesStrategyFallback.
+func ParserAccessorHandlerWithWellKnownTypesStrategy(
+	wellKnownTypesStrategy bufmodule.WellKnownTypesStrategy,
+) ParserAccessorHandlerOption {
+	return func(parserAccessorHandler *parserAccessorHandler) {
+		parserAccessorHandler.wellKnownTypesStrategy = wellKnownTypesStrategy
+	}
 }
 
 // GetFileAnnotations gets the FileAnnotations for the ErrorWithPos errors.
+//
+// All errors are converted, not just the first. Locations of related definitions
+// referenced by an error, such as the previous definition of a duplicate symbol,
+// are attached as RelatedInformation.
 func GetFileAnnotations(
 	ctx context.Context,
 	parserAccessorHandler ParserAccessorHandler,
 	errorsWithPos []reporter.ErrorWithPos,
 ) ([]bufanalysis.FileAnnotation, error) {
+	tokenFinder := newTokenFinder(parserAccessorHandler)
 	fileAnnotations := make([]bufanalysis.FileAnnotation, 0, len(errorsWithPos))
 	for _, errorWithPos := range errorsWithPos {
-		fileAnnotation, err := GetFileAnnotation(
+		fileAnnotation, err := getFileAnnotation(
 			ctx,
 			parserAccessorHandler,
+			tokenFinder,
 			errorWithPos,
+			bufanalysis.SeverityError,
+		)
+		if err != nil {
+			return nil, err
+		}
+		fileAnnotations = append(fileAnnotations, fileAnnotation)
+	}
+	return fileAnnotations, nil
+}
+
+// GetWarningFileAnnotations gets the FileAnnotations for the ErrorWithPos warnings
+// reported by the compiler, such as unused imports or a missing syntax declaration.
+//
+// The returned FileAnnotations have SeverityWarning.
+func GetWarningFileAnnotations(
+	ctx context.Context,
+	parserAccessorHandler ParserAccessorHandler,
+	warningsWithPos []reporter.ErrorWithPos,
+) ([]bufanalysis.FileAnnotation, error) {
+	tokenFinder := newTokenFinder(parserAccessorHandler)
+	fileAnnotations := make([]bufanalysis.FileAnnotation, 0, len(warningsWithPos))
+	for _, warningWithPos := range warningsWithPos {
+		fileAnnotation, err := getFileAnnotation(
+			ctx,
+			parserAccessorHandler,
+			tokenFinder,
+			warningWithPos,
+			bufanalysis.SeverityWarning,
 		)
 		if err != nil {
 			return nil, err
@@ -79,6 +151,22 @@ func GetFileAnnotation(
 	ctx context.Context,
 	parserAccessorHandler ParserAccessorHandler,
 	errorWithPos reporter.ErrorWithPos,
+) (bufanalysis.FileAnnotation, error) {
+	return getFileAnnotation(
+		ctx,
+		parserAccessorHandler,
+		newTokenFinder(parserAccessorHandler),
+		errorWithPos,
+		bufanalysis.SeverityError,
+	)
+}
+
+func getFileAnnotation(
+	ctx context.Context,
+	parserAccessorHandler ParserAccessorHandler,
+	tokenFinder *tokenFinder,
+	errorWithPos reporter.ErrorWithPos,
+	severity bufanalysis.Severity,
 ) (bufanalysis.FileAnnotation, error) {
 	var fileInfo bufmoduleref.FileInfo
 	var startLine int
@@ -94,17 +182,8 @@ func GetFileAnnotation(
 	}
 	sourcePos := errorWithPos.GetPosition()
 	if sourcePos.Filename != "" {
-		path, err := normalpath.NormalizeAndValidate(sourcePos.Filename)
-		if err != nil {
-			return nil, err
-		}
-		fileInfo, err = bufmoduleref.NewFileInfo(
-			path,
-			parserAccessorHandler.ExternalPath(path),
-			parserAccessorHandler.IsImport(path),
-			nil,
-			"",
-		)
+		var err error
+		fileInfo, err = getFileInfo(parserAccessorHandler, sourcePos.Filename)
 		if err != nil {
 			return nil, err
 		}
@@ -117,6 +196,21 @@ func GetFileAnnotation(
 		startColumn = sourcePos.Col
 		endColumn = sourcePos.Col
 	}
+	// The position is the start of the offending token, so the annotation
+	// spans the token.
+	if tokenInfo, ok := tokenFinder.getTokenInfo(sourcePos); ok {
+		endPos := tokenInfo.End()
+		endLine = endPos.Line
+		endColumn = endPos.Col
+	}
+	relatedInformation := getRelatedInformation(parserAccessorHandler, tokenFinder, message)
+	if cycleMessage, cycleRelatedInformation, ok := getImportCycleMessageAndRelatedInformation(
+		parserAccessorHandler,
+		message,
+	); ok {
+		message = cycleMessage
+		relatedInformation = cycleRelatedInformation
+	}
 	return bufanalysis.NewFileAnnotation(
 		fileInfo,
 		startLine,
@@ -125,5 +219,74 @@ func GetFileAnnotation(
 		endColumn,
 		typeString,
 		message,
+		bufanalysis.FileAnnotationWithRelatedInformation(
+			relatedInformation...,
+		),
+		bufanalysis.FileAnnotationWithSeverity(severity),
 	), nil
 }
+
+func getRelatedInformation(
+	parserAccessorHandler ParserAccessorHandler,
+	tokenFinder *tokenFinder,
+	message string,
+) []bufanalysis.RelatedInformation {
+	matches := previouslyDefinedRegexp.FindStringSubmatch(message)
+	if len(matches) != 4 {
+		return nil
+	}
+	// The message is not guaranteed to contain a valid path, in which
+	// case we do not have any related information to report.
+	fileInfo, err := getFileInfo(parserAccessorHandler, matches[1])
+	if err != nil {
+		return nil
+	}
+	line, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return nil
+	}
+	column, err := strconv.Atoi(matches[3])
+	if err != nil {
+		return nil
+	}
+	// The previous definition is the name of a declaration, so a token starts at
+	// its position. If not, the message was not in the expected format.
+	tokenInfo, ok := tokenFinder.getTokenInfo(
+		ast.SourcePos{
+			Filename: fileInfo.Path(),
+			Line:     line,
+			Col:      column,
+		},
+	)
+	if !ok {
+		return nil
+	}
+	endPos := tokenInfo.End()
+	return []bufanalysis.RelatedInformation{
+		bufanalysis.NewRelatedInformation(
+			fileInfo,
+			line,
+			column,
+			endPos.Line,
+			endPos.Col,
+			"previously defined here",
+		),
+	}
+}
+
+func getFileInfo(
+	parserAccessorHandler ParserAccessorHandler,
+	filename string,
+) (bufmoduleref.FileInfo, error) {
+	path, err := normalpath.NormalizeAndValidate(filename)
+	if err != nil {
+		return nil, err
+	}
+	return bufmoduleref.NewFileInfo(
+		path,
+		parserAccessorHandler.ExternalPath(path),
+		parserAccessorHandler.IsImport(path),
+		nil,
+		"",
+	)
+}
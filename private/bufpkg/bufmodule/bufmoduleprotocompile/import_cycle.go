@@ -0,0 +1,205 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmoduleprotocompile
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/pkg/importcycle"
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"go.uber.org/multierr"
+)
+
+const importCycleMessagePrefix = "cycle found in imports: "
+
+// importCycleFileRegexp matches a quoted file within the import cycle message
+// produced by the compiler, for example:
+//
+//	cycle found in imports: "a.proto" -> "b.proto" -> "a.proto"
+var importCycleFileRegexp = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// getImportCycleMessageAndRelatedInformation returns a message describing the full import cycle
+// reported by the compiler, along with the location of every import that is part of
+// the cycle.
+//
+// The compiler only reports the sequence of files that led to the cycle. We parse the
+// imports of the files in the cycle to find the location of each import, and to suggest
+// an import to remove to break the cycle.
+//
+// Returns false if the message is not for an import cycle, or if we could not
+// determine the imports of the cycle, in which case the compiler message should be used.
+func getImportCycleMessageAndRelatedInformation(
+	parserAccessorHandler ParserAccessorHandler,
+	message string,
+) (string, []bufanalysis.RelatedInformation, bool) {
+	if !strings.HasPrefix(message, importCycleMessagePrefix) {
+		return "", nil, false
+	}
+	cycleFiles, ok := parseImportCycleFiles(strings.TrimPrefix(message, importCycleMessagePrefix))
+	if !ok {
+		return "", nil, false
+	}
+	cycleFileSet := make(map[string]struct{}, len(cycleFiles))
+	for _, cycleFile := range cycleFiles {
+		cycleFileSet[cycleFile] = struct{}{}
+	}
+	var imports []*importcycle.Import
+	for cycleFile := range cycleFileSet {
+		fileImports, err := getImportCycleImports(parserAccessorHandler, cycleFile, cycleFileSet)
+		if err != nil {
+			return "", nil, false
+		}
+		imports = append(imports, fileImports...)
+	}
+	fromToToImport := make(map[string]map[string]*importcycle.Import)
+	for _, fileImport := range imports {
+		if _, ok := fromToToImport[fileImport.From]; !ok {
+			fromToToImport[fileImport.From] = make(map[string]*importcycle.Import)
+		}
+		fromToToImport[fileImport.From][fileImport.To] = fileImport
+	}
+	relatedInformation := make([]bufanalysis.RelatedInformation, 0, len(cycleFiles))
+	for i, cycleFile := range cycleFiles {
+		next := cycleFiles[(i+1)%len(cycleFiles)]
+		fileImport, ok := fromToToImport[cycleFile][next]
+		if !ok {
+			return "", nil, false
+		}
+		fileInfo, err := getFileInfo(parserAccessorHandler, cycleFile)
+		if err != nil {
+			return "", nil, false
+		}
+		relatedInformation = append(
+			relatedInformation,
+			bufanalysis.NewRelatedInformation(
+				fileInfo,
+				fileImport.Line,
+				fileImport.Column,
+				fileImport.Line,
+				fileImport.Column,
+				fmt.Sprintf("%s imports %q", cycleFile, next),
+			),
+		)
+	}
+	cycleMessage := fmt.Sprintf(
+		"import cycle: %s -> %s",
+		strings.Join(cycleFiles, " -> "),
+		cycleFiles[0],
+	)
+	if breakPoint := getImportCycleBreakPoint(imports, cycleFiles); breakPoint != nil {
+		cycleMessage += fmt.Sprintf(
+			`; consider removing the import of %q from %s to break the cycle`,
+			breakPoint.To,
+			breakPoint.From,
+		)
+	}
+	return cycleMessage, relatedInformation, true
+}
+
+// parseImportCycleFiles parses the files of the cycle from the compiler message.
+//
+// The compiler message lists the import sequence that led to the cycle, which may
+// start with files that are not part of the cycle itself. The last file is the
+// one that was imported again, so the cycle starts at its first occurrence.
+//
+// The returned files do not repeat the first file at the end.
+func parseImportCycleFiles(sequenceString string) ([]string, bool) {
+	quotedFiles := importCycleFileRegexp.FindAllString(sequenceString, -1)
+	if len(quotedFiles) < 2 {
+		return nil, false
+	}
+	files := make([]string, len(quotedFiles))
+	for i, quotedFile := range quotedFiles {
+		file, err := strconv.Unquote(quotedFile)
+		if err != nil {
+			return nil, false
+		}
+		files[i] = file
+	}
+	last := files[len(files)-1]
+	for i, file := range files[:len(files)-1] {
+		if file == last {
+			return files[i : len(files)-1], true
+		}
+	}
+	return nil, false
+}
+
+// getImportCycleImports returns the imports of the given file that import other files
+// in the cycle.
+func getImportCycleImports(
+	parserAccessorHandler ParserAccessorHandler,
+	path string,
+	cycleFileSet map[string]struct{},
+) (_ []*importcycle.Import, retErr error) {
+	readCloser, err := parserAccessorHandler.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, readCloser.Close())
+	}()
+	externalPath := parserAccessorHandler.ExternalPath(path)
+	fileNode, err := parser.Parse(path, readCloser, reporter.NewHandler(nil))
+	if err != nil {
+		return nil, err
+	}
+	var imports []*importcycle.Import
+	for _, decl := range fileNode.Decls {
+		importNode, ok := decl.(*ast.ImportNode)
+		if !ok {
+			continue
+		}
+		importPath := importNode.Name.AsString()
+		if _, ok := cycleFileSet[importPath]; !ok {
+			continue
+		}
+		// use the location of the imported path, which is where the compiler reports the cycle
+		start := fileNode.NodeInfo(importNode.Name).Start()
+		imports = append(
+			imports,
+			&importcycle.Import{
+				From:       path,
+				To:         importPath,
+				ImportPath: importPath,
+				Path:       externalPath,
+				Line:       start.Line,
+				Column:     start.Col,
+			},
+		)
+	}
+	return imports, nil
+}
+
+// getImportCycleBreakPoint returns the suggested edge to remove to break the cycle
+// made up of the given files, taking into account all of the cycles between them.
+func getImportCycleBreakPoint(imports []*importcycle.Import, cycleFiles []string) *importcycle.Edge {
+	fromToTo := make(map[string]string, len(cycleFiles))
+	for i, cycleFile := range cycleFiles {
+		fromToTo[cycleFile] = cycleFiles[(i+1)%len(cycleFiles)]
+	}
+	for _, cycle := range importcycle.FindCycles(imports) {
+		if breakPoint := cycle.BreakPoint; breakPoint != nil && fromToTo[breakPoint.From] == breakPoint.To {
+			return breakPoint
+		}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
@@ -34,38 +35,48 @@ type parserAccessorHandler struct {
 	nonImportPaths       map[string]struct{}
 	pathToModuleIdentity map[string]bufmoduleref.ModuleIdentity
 	pathToCommit         map[string]string
-	lock                 sync.RWMutex
+	// wellKnownTypesStrategy determines whether the module or the bundled
+	// well-known types are used for well-known type paths.
+	wellKnownTypesStrategy bufmodule.WellKnownTypesStrategy
+	// shadowedWellKnownTypePaths are the paths of the module files that
+	// were opened in place of a bundled well-known type.
+	shadowedWellKnownTypePaths map[string]struct{}
+	lock                       sync.RWMutex
 }
 
 func newParserAccessorHandler(
 	ctx context.Context,
 	module bufmodule.Module,
+	options ...ParserAccessorHandlerOption,
 ) *parserAccessorHandler {
-	return &parserAccessorHandler{
-		ctx:                  ctx,
-		module:               module,
-		pathToExternalPath:   make(map[string]string),
-		nonImportPaths:       make(map[string]struct{}),
-		pathToModuleIdentity: make(map[string]bufmoduleref.ModuleIdentity),
-		pathToCommit:         make(map[string]string),
+	parserAccessorHandler := &parserAccessorHandler{
+		ctx:                        ctx,
+		module:                     module,
+		pathToExternalPath:         make(map[string]string),
+		nonImportPaths:             make(map[string]struct{}),
+		pathToModuleIdentity:       make(map[string]bufmoduleref.ModuleIdentity),
+		pathToCommit:               make(map[string]string),
+		wellKnownTypesStrategy:     bufmodule.WellKnownTypesStrategyFallback,
+		shadowedWellKnownTypePaths: make(map[string]struct{}),
 	}
+	for _, option := range options {
+		option(parserAccessorHandler)
+	}
+	return parserAccessorHandler
 }
 
 func (p *parserAccessorHandler) Open(path string) (_ io.ReadCloser, retErr error) {
+	isWellKnownType := datawkt.Exists(path)
+	if isWellKnownType && p.wellKnownTypesStrategy == bufmodule.WellKnownTypesStrategyBundled {
+		return p.openWellKnownType(path)
+	}
 	moduleFile, moduleErr := p.module.GetModuleFile(p.ctx, path)
 	if moduleErr != nil {
 		if !storage.IsNotExist(moduleErr) {
 			return nil, moduleErr
 		}
-		if wktModuleFile, wktErr := datawkt.ReadBucket.Get(p.ctx, path); wktErr == nil {
-			if wktModuleFile.Path() != path {
-				// this should never happen, but just in case
-				return nil, fmt.Errorf("parser accessor requested path %q but got %q", path, wktModuleFile.Path())
-			}
-			if err := p.addPath(path, path, true, nil, ""); err != nil {
-				return nil, err
-			}
-			return wktModuleFile, nil
+		if isWellKnownType && p.wellKnownTypesStrategy != bufmodule.WellKnownTypesStrategyModule {
+			return p.openWellKnownType(path)
 		}
 		return nil, moduleErr
 	}
@@ -87,6 +98,11 @@ func (p *parserAccessorHandler) Open(path string) (_ io.ReadCloser, retErr error
 	); err != nil {
 		return nil, err
 	}
+	if isWellKnownType && p.wellKnownTypesStrategy == bufmodule.WellKnownTypesStrategyFallback {
+		p.lock.Lock()
+		p.shadowedWellKnownTypePaths[path] = struct{}{}
+		p.lock.Unlock()
+	}
 	return moduleFile, nil
 }
 
@@ -118,6 +134,36 @@ func (p *parserAccessorHandler) Commit(path string) string {
 	return p.pathToCommit[path] // empty is a valid value.
 }
 
+func (p *parserAccessorHandler) ShadowedWellKnownTypePaths() []string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	paths := make([]string, 0, len(p.shadowedWellKnownTypePaths))
+	for path := range p.shadowedWellKnownTypePaths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// openWellKnownType opens the version of the well-known type at the path that is bundled with buf.
+func (p *parserAccessorHandler) openWellKnownType(path string) (io.ReadCloser, error) {
+	wktModuleFile, err := datawkt.ReadBucket.Get(p.ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if wktModuleFile.Path() != path {
+		// this should never happen, but just in case
+		return nil, multierr.Append(
+			fmt.Errorf("parser accessor requested path %q but got %q", path, wktModuleFile.Path()),
+			wktModuleFile.Close(),
+		)
+	}
+	if err := p.addPath(path, path, true, nil, ""); err != nil {
+		return nil, multierr.Append(err, wktModuleFile.Close())
+	}
+	return wktModuleFile, nil
+}
+
 func (p *parserAccessorHandler) addPath(
 	path string,
 	externalPath string,
@@ -0,0 +1,88 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmoduleprotocompile
+
+import (
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"go.uber.org/multierr"
+)
+
+// tokenFinder finds the tokens at the positions reported by the compiler.
+//
+// The errors reported by the compiler only have a start position, which is the
+// start of the offending token, so the files are parsed again to find where the
+// token ends. Each file is parsed at most once.
+type tokenFinder struct {
+	parserAccessorHandler ParserAccessorHandler
+	pathToFileNode        map[string]*ast.FileNode
+}
+
+func newTokenFinder(parserAccessorHandler ParserAccessorHandler) *tokenFinder {
+	return &tokenFinder{
+		parserAccessorHandler: parserAccessorHandler,
+		pathToFileNode:        make(map[string]*ast.FileNode),
+	}
+}
+
+// getTokenInfo returns the NodeInfo of the token that starts at the position.
+//
+// Returns false if the file cannot be parsed up to the position, or if no token
+// starts at the position.
+func (t *tokenFinder) getTokenInfo(sourcePos ast.SourcePos) (ast.NodeInfo, bool) {
+	if sourcePos.Filename == "" || sourcePos.Line <= 0 || sourcePos.Col <= 0 {
+		return ast.NodeInfo{}, false
+	}
+	fileNode := t.getFileNode(sourcePos.Filename)
+	if fileNode == nil {
+		return ast.NodeInfo{}, false
+	}
+	tokens := fileNode.Tokens()
+	for token, ok := tokens.First(); ok; token, ok = tokens.Next(token) {
+		tokenInfo := fileNode.TokenInfo(token)
+		start := tokenInfo.Start()
+		if start.Line > sourcePos.Line || (start.Line == sourcePos.Line && start.Col > sourcePos.Col) {
+			return ast.NodeInfo{}, false
+		}
+		if start.Line == sourcePos.Line && start.Col == sourcePos.Col {
+			return tokenInfo, true
+		}
+	}
+	return ast.NodeInfo{}, false
+}
+
+func (t *tokenFinder) getFileNode(path string) *ast.FileNode {
+	if fileNode, ok := t.pathToFileNode[path]; ok {
+		return fileNode
+	}
+	// The error is ignored, as the FileNode of a file with syntax errors still
+	// has the tokens up to the first error. The FileNode is nil if the file
+	// could not be read.
+	fileNode, _ := t.parseFile(path)
+	t.pathToFileNode[path] = fileNode
+	return fileNode
+}
+
+func (t *tokenFinder) parseFile(path string) (_ *ast.FileNode, retErr error) {
+	readCloser, err := t.parserAccessorHandler.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, readCloser.Close())
+	}()
+	return parser.Parse(path, readCloser, reporter.NewHandler(nil))
+}
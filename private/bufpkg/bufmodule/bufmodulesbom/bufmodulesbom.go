@@ -0,0 +1,134 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufmodulesbom produces software bills of materials for modules.
+package bufmodulesbom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+)
+
+const (
+	// FormatSPDX is the SPDX 2.3 JSON format.
+	FormatSPDX Format = iota + 1
+	// FormatCycloneDX is the CycloneDX 1.4 JSON format.
+	FormatCycloneDX
+)
+
+var (
+	// AllFormatStrings are all format strings.
+	AllFormatStrings = []string{
+		"spdx",
+		"cyclonedx",
+	}
+
+	formatToString = map[Format]string{
+		FormatSPDX:      "spdx",
+		FormatCycloneDX: "cyclonedx",
+	}
+	stringToFormat = map[string]Format{
+		"spdx":      FormatSPDX,
+		"cyclonedx": FormatCycloneDX,
+	}
+)
+
+// Format is a SBOM format.
+type Format int
+
+// String implements fmt.Stringer.
+func (f Format) String() string {
+	s, ok := formatToString[f]
+	if !ok {
+		return fmt.Sprintf("%d", f)
+	}
+	return s
+}
+
+// ParseFormat parses the format.
+//
+// The empty string defaults to FormatSPDX.
+func ParseFormat(s string) (Format, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return FormatSPDX, nil
+	}
+	f, ok := stringToFormat[s]
+	if ok {
+		return f, nil
+	}
+	return 0, fmt.Errorf("unknown format: %q", s)
+}
+
+// Subject is a module a SBOM is produced for.
+type Subject struct {
+	Module bufmodule.Module
+	// ModuleIdentity may be nil if the module does not have a name.
+	ModuleIdentity bufmoduleref.ModuleIdentity
+}
+
+// Component is a module listed in a SBOM.
+type Component struct {
+	// Name is the full name of the module, such as buf.build/acme/weather.
+	//
+	// This may be empty for a local module without a name.
+	Name string
+	// Commit may be empty for a local module.
+	Commit string
+	// Digest is the digest of the module's contents, such as shake256:....
+	Digest string
+	// LicenseID is the SPDX license identifier detected from the module's
+	// LICENSE file. This is empty if no license could be detected.
+	LicenseID string
+}
+
+// SBOM is a software bill of materials for one or more modules.
+type SBOM struct {
+	// Components are the modules the SBOM was produced for.
+	Components []*Component
+	// Dependencies are all of the direct and transitive dependencies of the
+	// Components, sorted by name.
+	Dependencies []*Component
+	CreateTime   time.Time
+}
+
+// NewSBOM returns a new SBOM for the subjects.
+//
+// The moduleReader is used to read the dependencies of the subjects to
+// detect their licenses.
+func NewSBOM(
+	ctx context.Context,
+	moduleReader bufmodule.ModuleReader,
+	createTime time.Time,
+	subjects ...*Subject,
+) (*SBOM, error) {
+	return newSBOM(ctx, moduleReader, createTime, subjects...)
+}
+
+// Marshal marshals the SBOM in the given format.
+func Marshal(sbom *SBOM, format Format) ([]byte, error) {
+	switch format {
+	case FormatSPDX:
+		return marshalSPDX(sbom)
+	case FormatCycloneDX:
+		return marshalCycloneDX(sbom)
+	default:
+		return nil, fmt.Errorf("unknown format: %v", format)
+	}
+}
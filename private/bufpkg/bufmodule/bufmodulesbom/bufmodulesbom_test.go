@@ -0,0 +1,171 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmodulesbom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCommit = "62f35d8aed1149c291d606d958a7ce32"
+
+func TestSBOM(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	digester, err := manifest.NewDigester(manifest.DigestTypeShake256)
+	require.NoError(t, err)
+	dependencyDigest, err := digester.Digest(&bytes.Buffer{})
+	require.NoError(t, err)
+	readBucket, err := storagemem.NewReadBucket(
+		map[string][]byte{
+			"a.proto": []byte(`syntax = "proto3";`),
+			"LICENSE": []byte("SPDX-License-Identifier: Apache-2.0"),
+			"buf.lock": []byte(fmt.Sprintf(`
+version: v1
+deps:
+  - remote: buf.build
+    owner: acme
+    repository: units
+    commit: %s
+    digest: %s
+`, testCommit, dependencyDigest)),
+		},
+	)
+	require.NoError(t, err)
+	module, err := bufmodule.NewModuleForBucket(ctx, readBucket)
+	require.NoError(t, err)
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.build/acme/weather")
+	require.NoError(t, err)
+	sbom, err := NewSBOM(
+		ctx,
+		newTestModuleReader(t, "MIT License\n\nPermission is hereby granted, free of charge, to any person obtaining a copy. The above copyright notice and this permission notice shall be included in all copies."),
+		time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		&Subject{
+			Module:         module,
+			ModuleIdentity: moduleIdentity,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, sbom.Components, 1)
+	assert.Equal(t, "buf.build/acme/weather", sbom.Components[0].Name)
+	assert.Equal(t, "Apache-2.0", sbom.Components[0].LicenseID)
+	assert.Contains(t, sbom.Components[0].Digest, "shake256:")
+	assert.Equal(
+		t,
+		[]*Component{
+			{
+				Name:      "buf.build/acme/units",
+				Commit:    testCommit,
+				Digest:    dependencyDigest.String(),
+				LicenseID: "MIT",
+			},
+		},
+		sbom.Dependencies,
+	)
+
+	data, err := Marshal(sbom, FormatSPDX)
+	require.NoError(t, err)
+	spdx := &spdxDocument{}
+	require.NoError(t, json.Unmarshal(data, spdx))
+	assert.Equal(t, "SPDX-2.3", spdx.SPDXVersion)
+	assert.Equal(t, "2023-01-02T03:04:05Z", spdx.CreationInfo.Created)
+	require.Len(t, spdx.Packages, 2)
+	assert.Equal(t, "Apache-2.0", spdx.Packages[0].LicenseDeclared)
+	assert.Equal(t, "buf.build/acme/units", spdx.Packages[1].Name)
+	assert.Equal(t, testCommit, spdx.Packages[1].VersionInfo)
+	assert.Equal(t, "MIT", spdx.Packages[1].LicenseDeclared)
+	assert.Equal(
+		t,
+		[]*spdxRelationship{
+			{
+				SPDXElementID:      "SPDXRef-DOCUMENT",
+				RelationshipType:   "DESCRIBES",
+				RelatedSPDXElement: "SPDXRef-Module-1",
+			},
+			{
+				SPDXElementID:      "SPDXRef-Module-1",
+				RelationshipType:   "DEPENDS_ON",
+				RelatedSPDXElement: "SPDXRef-Dependency-1",
+			},
+		},
+		spdx.Relationships,
+	)
+
+	data, err = Marshal(sbom, FormatCycloneDX)
+	require.NoError(t, err)
+	cycloneDX := &cycloneDXDocument{}
+	require.NoError(t, json.Unmarshal(data, cycloneDX))
+	assert.Equal(t, "CycloneDX", cycloneDX.BOMFormat)
+	require.NotNil(t, cycloneDX.Metadata.Component)
+	assert.Equal(t, "buf.build/acme/weather", cycloneDX.Metadata.Component.Name)
+	require.Len(t, cycloneDX.Components, 1)
+	assert.Equal(t, "buf.build/acme/units:"+testCommit, cycloneDX.Components[0].BOMRef)
+	assert.Equal(
+		t,
+		[]*cycloneDXDependency{
+			{
+				Ref:       "buf.build/acme/weather",
+				DependsOn: []string{"buf.build/acme/units:" + testCommit},
+			},
+		},
+		cycloneDX.Dependencies,
+	)
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+	format, err := ParseFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, FormatSPDX, format)
+	format, err = ParseFormat("CycloneDX")
+	require.NoError(t, err)
+	assert.Equal(t, FormatCycloneDX, format)
+	_, err = ParseFormat("swid")
+	assert.Error(t, err)
+}
+
+type testModuleReader struct {
+	t       *testing.T
+	license string
+}
+
+func newTestModuleReader(t *testing.T, license string) *testModuleReader {
+	return &testModuleReader{
+		t:       t,
+		license: license,
+	}
+}
+
+func (r *testModuleReader) GetModule(ctx context.Context, modulePin bufmoduleref.ModulePin) (bufmodule.Module, error) {
+	readBucket, err := storagemem.NewReadBucket(
+		map[string][]byte{
+			"b.proto": []byte(`syntax = "proto3";`),
+			"LICENSE": []byte(r.license),
+		},
+	)
+	require.NoError(r.t, err)
+	return bufmodule.NewModuleForBucket(ctx, readBucket)
+}
@@ -0,0 +1,145 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmodulesbom
+
+import (
+	"time"
+)
+
+const (
+	cycloneDXBOMFormat      = "CycloneDX"
+	cycloneDXSpecVersion    = "1.4"
+	cycloneDXDigestProperty = "buf:digest"
+)
+
+type cycloneDXDocument struct {
+	BOMFormat    string                 `json:"bomFormat"`
+	SpecVersion  string                 `json:"specVersion"`
+	Version      int                    `json:"version"`
+	Metadata     cycloneDXMetadata      `json:"metadata"`
+	Components   []*cycloneDXComponent  `json:"components"`
+	Dependencies []*cycloneDXDependency `json:"dependencies"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string              `json:"timestamp"`
+	Tools     []*cycloneDXTool    `json:"tools"`
+	Component *cycloneDXComponent `json:"component,omitempty"`
+}
+
+type cycloneDXTool struct {
+	Vendor string `json:"vendor"`
+	Name   string `json:"name"`
+}
+
+type cycloneDXComponent struct {
+	Type       string               `json:"type"`
+	BOMRef     string               `json:"bom-ref"`
+	Name       string               `json:"name"`
+	Version    string               `json:"version,omitempty"`
+	Licenses   []*cycloneDXLicense  `json:"licenses,omitempty"`
+	Properties []*cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXLicense struct {
+	License cycloneDXLicenseID `json:"license"`
+}
+
+type cycloneDXLicenseID struct {
+	ID string `json:"id"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+func marshalCycloneDX(sbom *SBOM) ([]byte, error) {
+	document := &cycloneDXDocument{
+		BOMFormat:   cycloneDXBOMFormat,
+		SpecVersion: cycloneDXSpecVersion,
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: sbom.CreateTime.UTC().Format(time.RFC3339),
+			Tools: []*cycloneDXTool{
+				{
+					Vendor: "Buf",
+					Name:   "buf",
+				},
+			},
+		},
+		Components:   []*cycloneDXComponent{},
+		Dependencies: []*cycloneDXDependency{},
+	}
+	dependencyRefs := make([]string, len(sbom.Dependencies))
+	for i, component := range sbom.Dependencies {
+		cycloneDXComponent := newCycloneDXComponent(component)
+		document.Components = append(document.Components, cycloneDXComponent)
+		dependencyRefs[i] = cycloneDXComponent.BOMRef
+	}
+	for i, component := range sbom.Components {
+		cycloneDXComponent := newCycloneDXComponent(component)
+		// The metadata can only describe a single component, so all other
+		// described modules are listed along with the dependencies.
+		if i == 0 {
+			document.Metadata.Component = cycloneDXComponent
+		} else {
+			document.Components = append(document.Components, cycloneDXComponent)
+		}
+		document.Dependencies = append(
+			document.Dependencies,
+			&cycloneDXDependency{
+				Ref:       cycloneDXComponent.BOMRef,
+				DependsOn: dependencyRefs,
+			},
+		)
+	}
+	return marshalJSON(document)
+}
+
+func newCycloneDXComponent(component *Component) *cycloneDXComponent {
+	cycloneDXComponent := &cycloneDXComponent{
+		Type:    "library",
+		BOMRef:  componentName(component),
+		Name:    componentName(component),
+		Version: component.Commit,
+	}
+	if component.Commit != "" {
+		cycloneDXComponent.BOMRef += ":" + component.Commit
+	}
+	if component.LicenseID != "" {
+		cycloneDXComponent.Licenses = []*cycloneDXLicense{
+			{
+				License: cycloneDXLicenseID{
+					ID: component.LicenseID,
+				},
+			},
+		}
+	}
+	if component.Digest != "" {
+		cycloneDXComponent.Properties = []*cycloneDXProperty{
+			{
+				Name:  cycloneDXDigestProperty,
+				Value: component.Digest,
+			},
+		}
+	}
+	return cycloneDXComponent
+}
@@ -0,0 +1,121 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmodulesbom
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/buflicense"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+)
+
+func newSBOM(
+	ctx context.Context,
+	moduleReader bufmodule.ModuleReader,
+	createTime time.Time,
+	subjects ...*Subject,
+) (*SBOM, error) {
+	sbom := &SBOM{
+		CreateTime: createTime,
+	}
+	// Dependencies are shared between subjects in a workspace, so we only list them once.
+	seenDependencies := make(map[string]struct{})
+	for _, subject := range subjects {
+		component, err := newSubjectComponent(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+		sbom.Components = append(sbom.Components, component)
+		for _, modulePin := range subject.Module.DependencyModulePins() {
+			if _, ok := seenDependencies[modulePin.IdentityString()]; ok {
+				continue
+			}
+			seenDependencies[modulePin.IdentityString()] = struct{}{}
+			component, err := newDependencyComponent(ctx, moduleReader, modulePin)
+			if err != nil {
+				return nil, err
+			}
+			sbom.Dependencies = append(sbom.Dependencies, component)
+		}
+	}
+	sort.Slice(
+		sbom.Dependencies,
+		func(i int, j int) bool {
+			return sbom.Dependencies[i].Name < sbom.Dependencies[j].Name
+		},
+	)
+	return sbom, nil
+}
+
+func newSubjectComponent(ctx context.Context, subject *Subject) (*Component, error) {
+	digest, err := moduleDigest(ctx, subject.Module)
+	if err != nil {
+		return nil, err
+	}
+	component := &Component{
+		Digest: digest,
+	}
+	if subject.ModuleIdentity != nil {
+		component.Name = subject.ModuleIdentity.IdentityString()
+	}
+	component.LicenseID, _ = buflicense.DetectLicenseID(subject.Module.License())
+	return component, nil
+}
+
+func newDependencyComponent(
+	ctx context.Context,
+	moduleReader bufmodule.ModuleReader,
+	modulePin bufmoduleref.ModulePin,
+) (*Component, error) {
+	module, err := moduleReader.GetModule(ctx, modulePin)
+	if err != nil {
+		return nil, fmt.Errorf("could not read dependency %s: %w", modulePin.String(), err)
+	}
+	component := &Component{
+		Name:   modulePin.IdentityString(),
+		Commit: modulePin.Commit(),
+		Digest: modulePin.Digest(),
+	}
+	component.LicenseID, _ = buflicense.DetectLicenseID(module.License())
+	return component, nil
+}
+
+// moduleDigest returns the manifest digest of the module, which is the
+// same digest used for module pins in buf.lock files.
+func moduleDigest(ctx context.Context, module bufmodule.Module) (string, error) {
+	moduleManifest := module.Manifest()
+	if moduleManifest == nil {
+		readWriteBucket := storagemem.NewReadWriteBucket()
+		if err := bufmodule.ModuleToBucket(ctx, module, readWriteBucket); err != nil {
+			return "", err
+		}
+		var err error
+		moduleManifest, _, err = manifest.NewFromBucket(ctx, readWriteBucket)
+		if err != nil {
+			return "", err
+		}
+	}
+	blob, err := moduleManifest.Blob()
+	if err != nil {
+		return "", err
+	}
+	return blob.Digest().String(), nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmodulesbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	spdxVersion            = "SPDX-2.3"
+	spdxDataLicense        = "CC0-1.0"
+	spdxDocumentID         = "SPDXRef-DOCUMENT"
+	spdxNoAssertion        = "NOASSERTION"
+	spdxCreator            = "Tool: buf"
+	spdxDigestExternalType = "buf-module-digest"
+	spdxNamespacePrefix    = "https://buf.build/sbom/"
+
+	// unnamedComponentName is used for local modules without a name, as
+	// both formats require every component to have a name.
+	unnamedComponentName = "local-module"
+)
+
+type spdxDocument struct {
+	SPDXVersion       string              `json:"spdxVersion"`
+	DataLicense       string              `json:"dataLicense"`
+	SPDXID            string              `json:"SPDXID"`
+	Name              string              `json:"name"`
+	DocumentNamespace string              `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo    `json:"creationInfo"`
+	Packages          []*spdxPackage      `json:"packages"`
+	Relationships     []*spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string             `json:"SPDXID"`
+	Name             string             `json:"name"`
+	VersionInfo      string             `json:"versionInfo,omitempty"`
+	DownloadLocation string             `json:"downloadLocation"`
+	FilesAnalyzed    bool               `json:"filesAnalyzed"`
+	LicenseConcluded string             `json:"licenseConcluded"`
+	LicenseDeclared  string             `json:"licenseDeclared"`
+	CopyrightText    string             `json:"copyrightText"`
+	ExternalRefs     []*spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func marshalSPDX(sbom *SBOM) ([]byte, error) {
+	document := &spdxDocument{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       spdxDataLicense,
+		SPDXID:            spdxDocumentID,
+		Name:              documentName(sbom),
+		DocumentNamespace: spdxNamespacePrefix + documentName(sbom) + "/" + documentDigest(sbom),
+		CreationInfo: spdxCreationInfo{
+			Created:  sbom.CreateTime.UTC().Format(time.RFC3339),
+			Creators: []string{spdxCreator},
+		},
+	}
+	for i, component := range sbom.Components {
+		spdxID := fmt.Sprintf("SPDXRef-Module-%d", i+1)
+		document.Packages = append(document.Packages, newSPDXPackage(spdxID, component))
+		document.Relationships = append(
+			document.Relationships,
+			&spdxRelationship{
+				SPDXElementID:      spdxDocumentID,
+				RelationshipType:   "DESCRIBES",
+				RelatedSPDXElement: spdxID,
+			},
+		)
+	}
+	for i, component := range sbom.Dependencies {
+		spdxID := fmt.Sprintf("SPDXRef-Dependency-%d", i+1)
+		document.Packages = append(document.Packages, newSPDXPackage(spdxID, component))
+		// The dependencies of a module include all of its transitive dependencies,
+		// so we do not know which of the described modules depend on which
+		// dependency directly.
+		for j := range sbom.Components {
+			document.Relationships = append(
+				document.Relationships,
+				&spdxRelationship{
+					SPDXElementID:      fmt.Sprintf("SPDXRef-Module-%d", j+1),
+					RelationshipType:   "DEPENDS_ON",
+					RelatedSPDXElement: spdxID,
+				},
+			)
+		}
+	}
+	return marshalJSON(document)
+}
+
+func newSPDXPackage(spdxID string, component *Component) *spdxPackage {
+	licenseDeclared := component.LicenseID
+	if licenseDeclared == "" {
+		licenseDeclared = spdxNoAssertion
+	}
+	spdxPackage := &spdxPackage{
+		SPDXID:           spdxID,
+		Name:             componentName(component),
+		VersionInfo:      component.Commit,
+		DownloadLocation: spdxNoAssertion,
+		LicenseConcluded: spdxNoAssertion,
+		LicenseDeclared:  licenseDeclared,
+		CopyrightText:    spdxNoAssertion,
+	}
+	if component.Digest != "" {
+		spdxPackage.ExternalRefs = []*spdxExternalRef{
+			{
+				ReferenceCategory: "OTHER",
+				ReferenceType:     spdxDigestExternalType,
+				ReferenceLocator:  component.Digest,
+			},
+		}
+	}
+	return spdxPackage
+}
+
+func componentName(component *Component) string {
+	if component.Name == "" {
+		return unnamedComponentName
+	}
+	return component.Name
+}
+
+// documentName returns the name of the first described module.
+func documentName(sbom *SBOM) string {
+	if len(sbom.Components) == 0 {
+		return unnamedComponentName
+	}
+	return componentName(sbom.Components[0])
+}
+
+// documentDigest returns the hex part of the digest of the first described
+// module, so that the namespace is unique for the contents of the module.
+func documentDigest(sbom *SBOM) string {
+	if len(sbom.Components) == 0 {
+		return ""
+	}
+	digest := sbom.Components[0].Digest
+	if index := strings.Index(digest, ":"); index >= 0 {
+		return digest[index+1:]
+	}
+	return digest
+}
+
+func marshalJSON(v interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
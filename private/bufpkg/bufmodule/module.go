@@ -38,11 +38,14 @@ type module struct {
 	commit               string
 	documentation        string
 	documentationPath    string
+	docsDirectory        string
+	docsReadBucket       storage.ReadBucket
 	license              string
 	breakingConfig       *bufbreakingconfig.Config
 	lintConfig           *buflintconfig.Config
 	manifest             *manifest.Manifest
 	blobSet              *manifest.BlobSet
+	manifestSignature    []byte
 }
 
 func newModuleForProto(
@@ -171,7 +174,15 @@ func newModuleForBucket(
 	if moduleConfig.ModuleIdentity != nil {
 		moduleIdentity = moduleConfig.ModuleIdentity
 	}
-	return newModule(
+	var docsReadBucket storage.ReadBucket
+	if moduleConfig.DocsDirectory != "" {
+		docsReadBucket = storage.MapReadBucket(
+			sourceReadBucket,
+			storage.MatchPathContained(moduleConfig.DocsDirectory),
+			storage.MatchNot(storage.MatchPathExt(".proto")),
+		)
+	}
+	module, err := newModule(
 		ctx,
 		storage.MapReadBucket(sourceReadBucket, storage.MatchPathExt(".proto")),
 		dependencyModulePins,
@@ -183,6 +194,12 @@ func newModuleForBucket(
 		moduleConfig.Lint,
 		options...,
 	)
+	if err != nil {
+		return nil, err
+	}
+	module.docsDirectory = moduleConfig.DocsDirectory
+	module.docsReadBucket = docsReadBucket
+	return module, nil
 }
 
 func newModuleForManifestAndBlobSet(
@@ -309,6 +326,10 @@ func (m *module) DocumentationPath() string {
 	return m.documentationPath
 }
 
+func (m *module) DocsDirectory() string {
+	return m.docsDirectory
+}
+
 func (m *module) License() string {
 	return m.license
 }
@@ -329,6 +350,10 @@ func (m *module) BlobSet() *manifest.BlobSet {
 	return m.blobSet
 }
 
+func (m *module) ManifestSignature() []byte {
+	return m.manifestSignature
+}
+
 func (m *module) getModuleIdentity() bufmoduleref.ModuleIdentity {
 	return m.moduleIdentity
 }
@@ -337,6 +362,10 @@ func (m *module) getSourceReadBucket() storage.ReadBucket {
 	return m.sourceReadBucket
 }
 
+func (m *module) getDocsReadBucket() storage.ReadBucket {
+	return m.docsReadBucket
+}
+
 func (m *module) getCommit() string {
 	return m.commit
 }
@@ -16,6 +16,8 @@ package bufmodule
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 	"github.com/bufbuild/buf/private/pkg/storage"
@@ -26,13 +28,21 @@ var _ ModuleFileSet = &moduleFileSet{}
 type moduleFileSet struct {
 	Module
 
-	allModuleReadBucket moduleReadBucket
+	moduleSourceReadBucket storage.ReadBucket
+	allModuleReadBucket    *multiModuleReadBucket
+	wellKnownTypesStrategy WellKnownTypesStrategy
 }
 
 func newModuleFileSet(
 	module Module,
 	dependencies []Module,
+	options ...ModuleFileSetOption,
 ) *moduleFileSet {
+	moduleFileSetOptions := newModuleFileSetOptions()
+	for _, option := range options {
+		option(moduleFileSetOptions)
+	}
+	moduleSourceReadBucket := moduleFileSetOptions.getSourceReadBucket(module)
 	// TODO: We can remove the getModuleRef method on the
 	// Module type if we fetch FileInfos from the Module
 	// and plumb in the ModuleRef here.
@@ -42,7 +52,7 @@ func newModuleFileSet(
 	// set to the same value. That can be enforced here.
 	moduleReadBuckets := []moduleReadBucket{
 		newSingleModuleReadBucket(
-			module.getSourceReadBucket(),
+			moduleSourceReadBucket,
 			module.getModuleIdentity(),
 			module.getCommit(),
 		),
@@ -51,15 +61,17 @@ func newModuleFileSet(
 		moduleReadBuckets = append(
 			moduleReadBuckets,
 			newSingleModuleReadBucket(
-				dependency.getSourceReadBucket(),
+				moduleFileSetOptions.getSourceReadBucket(dependency),
 				dependency.getModuleIdentity(),
 				dependency.getCommit(),
 			),
 		)
 	}
 	return &moduleFileSet{
-		Module:              module,
-		allModuleReadBucket: newMultiModuleReadBucket(moduleReadBuckets...),
+		Module:                 module,
+		moduleSourceReadBucket: moduleSourceReadBucket,
+		allModuleReadBucket:    newMultiModuleReadBucket(moduleReadBuckets...),
+		wellKnownTypesStrategy: moduleFileSetOptions.wellKnownTypesStrategy,
 	}
 }
 
@@ -69,7 +81,7 @@ func (m *moduleFileSet) AllFileInfos(ctx context.Context) ([]bufmoduleref.FileIn
 		if err := bufmoduleref.ValidateModuleFilePath(moduleObjectInfo.Path()); err != nil {
 			return err
 		}
-		isNotImport, err := storage.Exists(ctx, m.Module.getSourceReadBucket(), moduleObjectInfo.Path())
+		isNotImport, err := storage.Exists(ctx, m.moduleSourceReadBucket, moduleObjectInfo.Path())
 		if err != nil {
 			return err
 		}
@@ -98,9 +110,12 @@ func (m *moduleFileSet) GetModuleFile(ctx context.Context, path string) (ModuleF
 	}
 	readObjectCloser, err := m.allModuleReadBucket.Get(ctx, path)
 	if err != nil {
+		if storage.IsExistsMultipleLocations(err) {
+			return nil, m.newDuplicateError(ctx, path)
+		}
 		return nil, err
 	}
-	isNotImport, err := storage.Exists(ctx, m.Module.getSourceReadBucket(), path)
+	isNotImport, err := storage.Exists(ctx, m.moduleSourceReadBucket, path)
 	if err != nil {
 		return nil, err
 	}
@@ -121,4 +136,56 @@ func (m *moduleFileSet) GetModuleFile(ctx context.Context, path string) (ModuleF
 	return newModuleFile(fileInfo, readObjectCloser), nil
 }
 
+func (m *moduleFileSet) WellKnownTypesStrategy() WellKnownTypesStrategy {
+	return m.wellKnownTypesStrategy
+}
+
 func (*moduleFileSet) isModuleFileSet() {}
+
+// newDuplicateError returns an error that lists every module that contains the path.
+func (m *moduleFileSet) newDuplicateError(ctx context.Context, path string) error {
+	moduleObjectInfos, err := m.allModuleReadBucket.statAllModuleFiles(ctx, path)
+	if err != nil {
+		return err
+	}
+	locations := make([]string, len(moduleObjectInfos))
+	for i, moduleObjectInfo := range moduleObjectInfos {
+		locations[i] = getModuleObjectInfoLocation(moduleObjectInfo)
+	}
+	return fmt.Errorf(
+		"%s exists in multiple modules: %s. If the file exists in both a workspace module and a dependency, set duplicate_strategy to %q or %q in buf.work.yaml to choose one",
+		path,
+		strings.Join(locations, ", "),
+		DuplicateStrategyPreferWorkspace.String(),
+		DuplicateStrategyPreferDependency.String(),
+	)
+}
+
+type moduleFileSetOptions struct {
+	moduleToExcludedSourcePaths map[Module][]string
+	wellKnownTypesStrategy      WellKnownTypesStrategy
+}
+
+func newModuleFileSetOptions() *moduleFileSetOptions {
+	return &moduleFileSetOptions{
+		moduleToExcludedSourcePaths: make(map[Module][]string),
+		wellKnownTypesStrategy:      WellKnownTypesStrategyFallback,
+	}
+}
+
+// getSourceReadBucket returns the source ReadBucket of the module without
+// the excluded source paths of the module.
+func (m *moduleFileSetOptions) getSourceReadBucket(module Module) storage.ReadBucket {
+	excludedSourcePaths := m.moduleToExcludedSourcePaths[module]
+	if len(excludedSourcePaths) == 0 {
+		return module.getSourceReadBucket()
+	}
+	matchers := make([]storage.Matcher, len(excludedSourcePaths))
+	for i, excludedSourcePath := range excludedSourcePaths {
+		matchers[i] = storage.MatchPathEqual(excludedSourcePath)
+	}
+	return storage.MapReadBucket(
+		module.getSourceReadBucket(),
+		storage.MatchNot(storage.MatchOr(matchers...)),
+	)
+}
@@ -0,0 +1,120 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufmodule
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/pkg/storage/storagemem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleFileSetDuplicate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	moduleBucket, err := storagemem.NewReadBucket(
+		map[string][]byte{
+			"a.proto": []byte(`syntax = "proto3"; package module;`),
+		},
+	)
+	require.NoError(t, err)
+	module, err := NewModuleForBucket(ctx, moduleBucket)
+	require.NoError(t, err)
+	dependencyBucket, err := storagemem.NewReadBucket(
+		map[string][]byte{
+			"a.proto": []byte(`syntax = "proto3"; package dependency;`),
+			"b.proto": []byte(`syntax = "proto3"; package dependency;`),
+		},
+	)
+	require.NoError(t, err)
+	dependencyModuleIdentity, err := bufmoduleref.NewModuleIdentity("buf.build", "acme", "dependency")
+	require.NoError(t, err)
+	dependency, err := NewModuleForBucket(
+		ctx,
+		dependencyBucket,
+		ModuleWithModuleIdentityAndCommit(dependencyModuleIdentity, "abc"),
+	)
+	require.NoError(t, err)
+
+	_, err = NewModuleFileSet(module, []Module{dependency}).GetModuleFile(ctx, "a.proto")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a.proto exists in multiple modules: a.proto, buf.build/acme/dependency:abc.")
+
+	moduleFile, err := NewModuleFileSet(
+		module,
+		[]Module{dependency},
+		ModuleFileSetWithExcludedSourcePaths(dependency, []string{"a.proto"}),
+	).GetModuleFile(ctx, "a.proto")
+	require.NoError(t, err)
+	assert.False(t, moduleFile.IsImport())
+	assert.Nil(t, moduleFile.ModuleIdentity())
+	data, err := io.ReadAll(moduleFile)
+	require.NoError(t, err)
+	assert.Equal(t, `syntax = "proto3"; package module;`, string(data))
+	require.NoError(t, moduleFile.Close())
+
+	moduleFile, err = NewModuleFileSet(
+		module,
+		[]Module{dependency},
+		ModuleFileSetWithExcludedSourcePaths(module, []string{"a.proto"}),
+	).GetModuleFile(ctx, "a.proto")
+	require.NoError(t, err)
+	assert.True(t, moduleFile.IsImport())
+	assert.Equal(t, "abc", moduleFile.Commit())
+	data, err = io.ReadAll(moduleFile)
+	require.NoError(t, err)
+	assert.Equal(t, `syntax = "proto3"; package dependency;`, string(data))
+	require.NoError(t, moduleFile.Close())
+}
+
+func TestParseDuplicateStrategy(t *testing.T) {
+	t.Parallel()
+	for _, duplicateStrategy := range []DuplicateStrategy{
+		DuplicateStrategyFail,
+		DuplicateStrategyPreferWorkspace,
+		DuplicateStrategyPreferDependency,
+	} {
+		parsed, err := ParseDuplicateStrategy(duplicateStrategy.String())
+		require.NoError(t, err)
+		assert.Equal(t, duplicateStrategy, parsed)
+	}
+	parsed, err := ParseDuplicateStrategy("")
+	require.NoError(t, err)
+	assert.Equal(t, DuplicateStrategyFail, parsed)
+	_, err = ParseDuplicateStrategy("prefer-nothing")
+	assert.Error(t, err)
+}
+
+func TestParseWellKnownTypesStrategy(t *testing.T) {
+	t.Parallel()
+	for _, wellKnownTypesStrategy := range []WellKnownTypesStrategy{
+		WellKnownTypesStrategyFallback,
+		WellKnownTypesStrategyBundled,
+		WellKnownTypesStrategyModule,
+	} {
+		parsed, err := ParseWellKnownTypesStrategy(wellKnownTypesStrategy.String())
+		require.NoError(t, err)
+		assert.Equal(t, wellKnownTypesStrategy, parsed)
+	}
+	parsed, err := ParseWellKnownTypesStrategy("")
+	require.NoError(t, err)
+	assert.Equal(t, WellKnownTypesStrategyFallback, parsed)
+	_, err = ParseWellKnownTypesStrategy("latest")
+	assert.Error(t, err)
+}
@@ -53,6 +53,23 @@ func (m *multiModuleReadBucket) StatModuleFile(ctx context.Context, path string)
 	return nil, storage.NewErrNotExist(path)
 }
 
+// statAllModuleFiles returns the moduleObjectInfos for the path in every delegate
+// that contains the path.
+func (m *multiModuleReadBucket) statAllModuleFiles(ctx context.Context, path string) ([]*moduleObjectInfo, error) {
+	var moduleObjectInfos []*moduleObjectInfo
+	for _, delegate := range m.delegates {
+		objectInfo, err := delegate.StatModuleFile(ctx, path)
+		if err != nil {
+			if storage.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		moduleObjectInfos = append(moduleObjectInfos, objectInfo)
+	}
+	return moduleObjectInfos, nil
+}
+
 func (m *multiModuleReadBucket) WalkModuleFiles(ctx context.Context, prefix string, f func(*moduleObjectInfo) error) error {
 	for _, delegate := range m.delegates {
 		if err := delegate.WalkModuleFiles(ctx, prefix, f); err != nil {
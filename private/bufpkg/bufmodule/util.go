@@ -125,3 +125,16 @@ func modulePinCompareToOnlyCommit(a bufmoduleref.ModulePin, b bufmoduleref.Modul
 	}
 	return 0
 }
+
+// getModuleObjectInfoLocation returns the module and commit of the object if it
+// belongs to a named module, otherwise its external path.
+func getModuleObjectInfoLocation(moduleObjectInfo *moduleObjectInfo) string {
+	moduleIdentity := moduleObjectInfo.ModuleIdentity()
+	if moduleIdentity == nil {
+		return moduleObjectInfo.ExternalPath()
+	}
+	if commit := moduleObjectInfo.Commit(); commit != "" {
+		return moduleIdentity.IdentityString() + ":" + commit
+	}
+	return moduleIdentity.IdentityString()
+}
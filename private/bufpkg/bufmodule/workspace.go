@@ -18,18 +18,27 @@ import "github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
 
 type workspace struct {
 	// bufmoduleref.ModuleIdentity -> bufmodule.Module
-	namedModules map[string]Module
-	allModules   []Module
+	namedModules           map[string]Module
+	allModules             []Module
+	duplicateStrategy      DuplicateStrategy
+	wellKnownTypesStrategy WellKnownTypesStrategy
 }
 
 func newWorkspace(
 	namedModules map[string]Module,
 	allModules []Module,
+	options ...WorkspaceOption,
 ) *workspace {
-	return &workspace{
-		namedModules: namedModules,
-		allModules:   allModules,
+	workspace := &workspace{
+		namedModules:           namedModules,
+		allModules:             allModules,
+		duplicateStrategy:      DuplicateStrategyFail,
+		wellKnownTypesStrategy: WellKnownTypesStrategyFallback,
 	}
+	for _, option := range options {
+		option(workspace)
+	}
+	return workspace
 }
 
 func (w *workspace) GetModule(moduleIdentity bufmoduleref.ModuleIdentity) (Module, bool) {
@@ -40,3 +49,11 @@ func (w *workspace) GetModule(moduleIdentity bufmoduleref.ModuleIdentity) (Modul
 func (w *workspace) GetModules() []Module {
 	return w.allModules
 }
+
+func (w *workspace) DuplicateStrategy() DuplicateStrategy {
+	return w.duplicateStrategy
+}
+
+func (w *workspace) WellKnownTypesStrategy() WellKnownTypesStrategy {
+	return w.wellKnownTypesStrategy
+}
@@ -0,0 +1,316 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufowner maps the files of a module to the teams that own them.
+//
+// Ownership is configured with rules in the owners section of buf.yaml. A rule
+// matches a file if the file is at or below one of the paths of the rule, or if
+// the package of the file is one of the packages of the rule or a sub-package of
+// one of them. As for CODEOWNERS files, the last rule that matches a file
+// determines its owners.
+package bufowner
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Config is the ownership of the files of a module.
+type Config struct {
+	// Rules are the ownership rules, in the order they are configured.
+	Rules []*Rule
+}
+
+// Rule assigns owners to the files that match its paths or packages.
+type Rule struct {
+	// Paths are the normalized paths of files or directories relative to the
+	// root of the module.
+	Paths []string
+	// Packages are the packages of the files.
+	Packages []string
+	// Owners are the owners of the matching files, such as "@acme/payments".
+	Owners []string
+}
+
+// NewConfigV1 returns a new Config for the ExternalRuleV1s.
+//
+// Returns nil if no rules are configured.
+func NewConfigV1(externalRules []ExternalRuleV1) (*Config, error) {
+	if len(externalRules) == 0 {
+		return nil, nil
+	}
+	rules := make([]*Rule, len(externalRules))
+	for i, externalRule := range externalRules {
+		if len(externalRule.Paths) == 0 && len(externalRule.Packages) == 0 {
+			return nil, fmt.Errorf("owner rule %d must have at least one path or package", i+1)
+		}
+		if len(externalRule.Teams) == 0 {
+			return nil, fmt.Errorf("owner rule %d must have at least one team", i+1)
+		}
+		paths := make([]string, len(externalRule.Paths))
+		for j, path := range externalRule.Paths {
+			normalizedPath, err := normalpath.NormalizeAndValidate(path)
+			if err != nil {
+				return nil, fmt.Errorf("owner rule %d path %q is invalid: %w", i+1, path, err)
+			}
+			paths[j] = normalizedPath
+		}
+		for _, pkg := range externalRule.Packages {
+			if pkg == "" || strings.HasPrefix(pkg, ".") || strings.HasSuffix(pkg, ".") {
+				return nil, fmt.Errorf("owner rule %d package %q is invalid", i+1, pkg)
+			}
+		}
+		for _, team := range externalRule.Teams {
+			if strings.TrimSpace(team) == "" {
+				return nil, errors.New("owner teams cannot be empty")
+			}
+		}
+		rules[i] = &Rule{
+			Paths:    paths,
+			Packages: externalRule.Packages,
+			Owners:   externalRule.Teams,
+		}
+	}
+	return &Config{
+		Rules: rules,
+	}, nil
+}
+
+// GetOwners returns the owners of the file with the given path relative to the
+// root of the module and package.
+//
+// Returns nil if no rule matches the file.
+func (c *Config) GetOwners(path string, pkg string) []string {
+	for i := len(c.Rules) - 1; i >= 0; i-- {
+		if c.Rules[i].matches(path, pkg) {
+			return c.Rules[i].Owners
+		}
+	}
+	return nil
+}
+
+// Ownership is the ownership of a file.
+type Ownership struct {
+	// Path is the path of the file relative to the root of the module.
+	Path string
+	// Package is the package of the file.
+	Package string
+	// Owners are the owners of the file.
+	//
+	// This is empty if the file has no owners.
+	Owners []string
+}
+
+// GetOwnership returns the Ownership of the file of the Image that has the given
+// path, or that defines the descriptor with the given fully-qualified name.
+//
+// The path may be relative to the root of the module, or be the external path of
+// the file. If config is nil, the Ownership has no owners.
+func GetOwnership(config *Config, image bufimage.Image, fileOrSymbol string) (*Ownership, error) {
+	imageFile, err := getImageFile(image, fileOrSymbol)
+	if err != nil {
+		return nil, err
+	}
+	ownership := &Ownership{
+		Path:    imageFile.Path(),
+		Package: imageFile.Proto().GetPackage(),
+	}
+	if config != nil {
+		ownership.Owners = config.GetOwners(ownership.Path, ownership.Package)
+	}
+	return ownership, nil
+}
+
+// AnnotateFileAnnotations returns copies of the FileAnnotations with the owners
+// of their files.
+//
+// The packages of the files are looked up in the Images, in order. The file of a
+// FileAnnotation without a file is the file of its against location, if any.
+// FileAnnotations without a file, or whose file has no owners, are returned as is. If config is nil,
+// the FileAnnotations are returned as is.
+func AnnotateFileAnnotations(
+	config *Config,
+	fileAnnotations []bufanalysis.FileAnnotation,
+	images ...bufimage.Image,
+) []bufanalysis.FileAnnotation {
+	if config == nil {
+		return fileAnnotations
+	}
+	annotatedFileAnnotations := make([]bufanalysis.FileAnnotation, len(fileAnnotations))
+	for i, fileAnnotation := range fileAnnotations {
+		annotatedFileAnnotations[i] = fileAnnotation
+		fileInfo := fileAnnotation.FileInfo()
+		if fileInfo == nil && fileAnnotation.AgainstLocation() != nil {
+			// Annotations for deleted files only have the location of the file
+			// in the input that was compared against.
+			fileInfo = fileAnnotation.AgainstLocation().FileInfo()
+		}
+		if fileInfo == nil {
+			continue
+		}
+		path := fileInfo.Path()
+		var pkg string
+		for _, image := range images {
+			if imageFile := image.GetFile(path); imageFile != nil {
+				pkg = imageFile.Proto().GetPackage()
+				break
+			}
+		}
+		if owners := config.GetOwners(path, pkg); len(owners) > 0 {
+			annotatedFileAnnotations[i] = bufanalysis.CopyFileAnnotation(
+				fileAnnotation,
+				bufanalysis.FileAnnotationWithOwners(owners...),
+			)
+		}
+	}
+	return annotatedFileAnnotations
+}
+
+// ExternalRuleV1 is the on-disk representation of an ownership rule at version v1.
+type ExternalRuleV1 struct {
+	Paths    []string `json:"paths,omitempty" yaml:"paths,omitempty"`
+	Packages []string `json:"packages,omitempty" yaml:"packages,omitempty"`
+	Teams    []string `json:"teams,omitempty" yaml:"teams,omitempty"`
+}
+
+func getImageFile(image bufimage.Image, fileOrSymbol string) (bufimage.ImageFile, error) {
+	if strings.HasSuffix(fileOrSymbol, ".proto") {
+		if imageFile := image.GetFile(normalpath.Normalize(fileOrSymbol)); imageFile != nil {
+			return imageFile, nil
+		}
+		for _, imageFile := range image.Files() {
+			if normalpath.Normalize(imageFile.ExternalPath()) == normalpath.Normalize(fileOrSymbol) {
+				return imageFile, nil
+			}
+		}
+		return nil, fmt.Errorf("file %q not found", fileOrSymbol)
+	}
+	symbol := strings.TrimPrefix(fileOrSymbol, ".")
+	for _, imageFile := range image.Files() {
+		if fileDefinesSymbol(imageFile.Proto(), symbol) {
+			return imageFile, nil
+		}
+	}
+	return nil, fmt.Errorf("symbol %q not found", fileOrSymbol)
+}
+
+func fileDefinesSymbol(fileDescriptor *descriptorpb.FileDescriptorProto, symbol string) bool {
+	prefix := ""
+	if pkg := fileDescriptor.GetPackage(); pkg != "" {
+		prefix = pkg + "."
+	}
+	if !strings.HasPrefix(symbol, prefix) {
+		return false
+	}
+	for _, descriptor := range fileDescriptor.GetMessageType() {
+		if messageDefinesSymbol(descriptor, prefix, symbol) {
+			return true
+		}
+	}
+	for _, descriptor := range fileDescriptor.GetEnumType() {
+		if enumDefinesSymbol(descriptor, prefix, symbol) {
+			return true
+		}
+	}
+	for _, descriptor := range fileDescriptor.GetExtension() {
+		if prefix+descriptor.GetName() == symbol {
+			return true
+		}
+	}
+	for _, descriptor := range fileDescriptor.GetService() {
+		serviceName := prefix + descriptor.GetName()
+		if serviceName == symbol {
+			return true
+		}
+		for _, method := range descriptor.GetMethod() {
+			if serviceName+"."+method.GetName() == symbol {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func messageDefinesSymbol(descriptor *descriptorpb.DescriptorProto, prefix string, symbol string) bool {
+	name := prefix + descriptor.GetName()
+	if name == symbol {
+		return true
+	}
+	if !strings.HasPrefix(symbol, name+".") {
+		return false
+	}
+	for _, field := range descriptor.GetField() {
+		if name+"."+field.GetName() == symbol {
+			return true
+		}
+	}
+	for _, extension := range descriptor.GetExtension() {
+		if name+"."+extension.GetName() == symbol {
+			return true
+		}
+	}
+	for _, oneof := range descriptor.GetOneofDecl() {
+		if name+"."+oneof.GetName() == symbol {
+			return true
+		}
+	}
+	for _, nestedDescriptor := range descriptor.GetNestedType() {
+		if messageDefinesSymbol(nestedDescriptor, name+".", symbol) {
+			return true
+		}
+	}
+	for _, enumDescriptor := range descriptor.GetEnumType() {
+		if enumDefinesSymbol(enumDescriptor, name+".", symbol) {
+			return true
+		}
+	}
+	return false
+}
+
+// enumDefinesSymbol follows the scoping rules of Protocol Buffers, for which
+// enum values are siblings of their enum.
+func enumDefinesSymbol(descriptor *descriptorpb.EnumDescriptorProto, prefix string, symbol string) bool {
+	if prefix+descriptor.GetName() == symbol {
+		return true
+	}
+	for _, value := range descriptor.GetValue() {
+		if prefix+value.GetName() == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matches(path string, pkg string) bool {
+	for _, rulePath := range r.Paths {
+		if normalpath.EqualsOrContainsPath(rulePath, path, normalpath.Relative) {
+			return true
+		}
+	}
+	if pkg == "" {
+		return false
+	}
+	for _, rulePackage := range r.Packages {
+		if pkg == rulePackage || strings.HasPrefix(pkg, rulePackage+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,221 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufowner
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufanalysis"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagetesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestNewConfigV1(t *testing.T) {
+	t.Parallel()
+	config, err := NewConfigV1(nil)
+	require.NoError(t, err)
+	assert.Nil(t, config)
+	config, err = NewConfigV1(
+		[]ExternalRuleV1{
+			{
+				Paths: []string{"./acme/payments/"},
+				Teams: []string{"@acme/payments"},
+			},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme/payments"}, config.Rules[0].Paths)
+	for _, externalRule := range []ExternalRuleV1{
+		{Teams: []string{"@acme/payments"}},
+		{Paths: []string{"acme"}},
+		{Paths: []string{"../acme"}, Teams: []string{"@acme/payments"}},
+		{Packages: []string{"acme."}, Teams: []string{"@acme/payments"}},
+		{Packages: []string{"acme"}, Teams: []string{" "}},
+	} {
+		_, err := NewConfigV1([]ExternalRuleV1{externalRule})
+		assert.Error(t, err, "%+v", externalRule)
+	}
+}
+
+func TestGetOwners(t *testing.T) {
+	t.Parallel()
+	config := testNewConfig(t)
+	assert.Equal(t, []string{"@acme/platform"}, config.GetOwners("acme/a.proto", "acme"))
+	assert.Equal(t, []string{"@acme/payments"}, config.GetOwners("acme/payments/v1/payments.proto", "acme.payments.v1"))
+	assert.Equal(t, []string{"@acme/platform"}, config.GetOwners("acme/paymentsv2/payments.proto", "acme.paymentsv2"))
+	// The last matching rule wins.
+	assert.Equal(t, []string{"@acme/billing", "@acme/finance"}, config.GetOwners("acme/payments/v1/billing.proto", "acme.billing.v1"))
+	assert.Equal(t, []string{"@acme/billing", "@acme/finance"}, config.GetOwners("other/billing.proto", "acme.billing"))
+	assert.Nil(t, config.GetOwners("other/billing.proto", "acme.billingv2"))
+	assert.Nil(t, config.GetOwners("other/other.proto", ""))
+}
+
+func TestGetOwnership(t *testing.T) {
+	t.Parallel()
+	config := testNewConfig(t)
+	image := testNewImage(t)
+	for fileOrSymbol, expectedPath := range map[string]string{
+		"acme/payments/v1/payments.proto":           "acme/payments/v1/payments.proto",
+		"acme.payments.v1.Payment":                  "acme/payments/v1/payments.proto",
+		".acme.payments.v1.Payment.amount":          "acme/payments/v1/payments.proto",
+		"acme.payments.v1.Payment.Kind":             "acme/payments/v1/payments.proto",
+		"acme.payments.v1.Payment.KIND_UNSPECIFIED": "acme/payments/v1/payments.proto",
+		"acme.payments.v1.PaymentService.Pay":       "acme/payments/v1/payments.proto",
+		"acme.billing.v1.Invoice":                   "acme/billing/v1/billing.proto",
+	} {
+		ownership, err := GetOwnership(config, image, fileOrSymbol)
+		require.NoError(t, err, fileOrSymbol)
+		assert.Equal(t, expectedPath, ownership.Path, fileOrSymbol)
+	}
+	ownership, err := GetOwnership(config, image, "acme.billing.v1.Invoice")
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		&Ownership{
+			Path:    "acme/billing/v1/billing.proto",
+			Package: "acme.billing.v1",
+			Owners:  []string{"@acme/billing", "@acme/finance"},
+		},
+		ownership,
+	)
+	ownership, err = GetOwnership(nil, image, "acme.billing.v1.Invoice")
+	require.NoError(t, err)
+	assert.Empty(t, ownership.Owners)
+	for _, fileOrSymbol := range []string{
+		"acme/other.proto",
+		"acme.payments.v1",
+		"acme.payments.v1.Payment.other",
+		"acme.payments.v1.Payment.Kind.KIND_UNSPECIFIED",
+	} {
+		_, err := GetOwnership(config, image, fileOrSymbol)
+		assert.Error(t, err, fileOrSymbol)
+	}
+}
+
+func TestAnnotateFileAnnotations(t *testing.T) {
+	t.Parallel()
+	config := testNewConfig(t)
+	image := testNewImage(t)
+	fileAnnotations := []bufanalysis.FileAnnotation{
+		bufanalysis.NewFileAnnotation(
+			testNewFileInfo(t, image, "acme/payments/v1/payments.proto"),
+			1, 1, 1, 1, "TEST", "message",
+		),
+		bufanalysis.NewFileAnnotation(nil, 0, 0, 0, 0, "TEST", "message"),
+		bufanalysis.NewFileAnnotation(
+			nil, 0, 0, 0, 0, "TEST", "message",
+			bufanalysis.FileAnnotationWithAgainstLocation(
+				bufanalysis.NewRelatedInformation(
+					testNewFileInfo(t, image, "acme/billing/v1/billing.proto"),
+					1, 1, 1, 1, "",
+				),
+			),
+		),
+	}
+	annotatedFileAnnotations := AnnotateFileAnnotations(config, fileAnnotations, image)
+	require.Len(t, annotatedFileAnnotations, 3)
+	assert.Equal(t, []string{"@acme/payments"}, annotatedFileAnnotations[0].Owners())
+	assert.Equal(t, "TEST", annotatedFileAnnotations[0].Type())
+	assert.Empty(t, annotatedFileAnnotations[1].Owners())
+	assert.Equal(t, []string{"@acme/billing", "@acme/finance"}, annotatedFileAnnotations[2].Owners())
+	assert.Equal(t, fileAnnotations, AnnotateFileAnnotations(nil, fileAnnotations, image))
+}
+
+func testNewConfig(t *testing.T) *Config {
+	config, err := NewConfigV1(
+		[]ExternalRuleV1{
+			{
+				Paths: []string{"acme"},
+				Teams: []string{"@acme/platform"},
+			},
+			{
+				Paths: []string{"acme/payments"},
+				Teams: []string{"@acme/payments"},
+			},
+			{
+				Packages: []string{"acme.billing"},
+				Teams:    []string{"@acme/billing", "@acme/finance"},
+			},
+		},
+	)
+	require.NoError(t, err)
+	return config
+}
+
+func testNewImage(t *testing.T) bufimage.Image {
+	return bufimagetesting.NewImage(
+		t,
+		&descriptorpb.FileDescriptorProto{
+			Name:    proto.String("acme/payments/v1/payments.proto"),
+			Package: proto.String("acme.payments.v1"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("Payment"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:   proto.String("amount"),
+							Number: proto.Int32(1),
+							Type:   descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+						},
+					},
+					EnumType: []*descriptorpb.EnumDescriptorProto{
+						{
+							Name: proto.String("Kind"),
+							Value: []*descriptorpb.EnumValueDescriptorProto{
+								{
+									Name:   proto.String("KIND_UNSPECIFIED"),
+									Number: proto.Int32(0),
+								},
+							},
+						},
+					},
+				},
+			},
+			Service: []*descriptorpb.ServiceDescriptorProto{
+				{
+					Name: proto.String("PaymentService"),
+					Method: []*descriptorpb.MethodDescriptorProto{
+						{
+							Name:       proto.String("Pay"),
+							InputType:  proto.String(".acme.payments.v1.Payment"),
+							OutputType: proto.String(".acme.payments.v1.Payment"),
+						},
+					},
+				},
+			},
+		},
+		&descriptorpb.FileDescriptorProto{
+			Name:    proto.String("acme/billing/v1/billing.proto"),
+			Package: proto.String("acme.billing.v1"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("Invoice"),
+				},
+			},
+		},
+	)
+}
+
+func testNewFileInfo(t *testing.T, image bufimage.Image, path string) bufanalysis.FileInfo {
+	imageFile := image.GetFile(path)
+	require.NotNil(t, imageFile)
+	return imageFile
+}
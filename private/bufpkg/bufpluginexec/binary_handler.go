@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"os"
 	"path/filepath"
 
 	"github.com/bufbuild/buf/private/pkg/app"
@@ -29,6 +30,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/multierr"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
@@ -37,18 +39,27 @@ type binaryHandler struct {
 	pluginPath string
 	tracer     trace.Tracer
 	pluginArgs []string
+	dir        string
+	sandbox    bool
+	maxMemory  uint64
 }
 
 func newBinaryHandler(
 	runner command.Runner,
 	pluginPath string,
 	pluginArgs []string,
+	dir string,
+	sandbox bool,
+	maxMemory uint64,
 ) *binaryHandler {
 	return &binaryHandler{
 		runner:     runner,
 		pluginPath: pluginPath,
 		tracer:     otel.GetTracerProvider().Tracer("bufbuild/buf"),
 		pluginArgs: pluginArgs,
+		dir:        dir,
+		sandbox:    sandbox,
+		maxMemory:  maxMemory,
 	}
 }
 
@@ -57,7 +68,7 @@ func (h *binaryHandler) Handle(
 	container app.EnvStderrContainer,
 	responseWriter appproto.ResponseBuilder,
 	request *pluginpb.CodeGeneratorRequest,
-) error {
+) (retErr error) {
 	ctx, span := h.tracer.Start(ctx, "plugin_proxy", trace.WithAttributes(
 		attribute.Key("plugin").String(filepath.Base(h.pluginPath)),
 	))
@@ -71,7 +82,6 @@ func (h *binaryHandler) Handle(
 	responseBuffer := bytes.NewBuffer(nil)
 	stderrWriteCloser := newStderrWriteCloser(container.Stderr(), h.pluginPath)
 	runOptions := []command.RunOption{
-		command.RunWithEnv(app.EnvironMap(container)),
 		command.RunWithStdin(bytes.NewReader(requestData)),
 		command.RunWithStdout(responseBuffer),
 		command.RunWithStderr(stderrWriteCloser),
@@ -79,6 +89,26 @@ func (h *binaryHandler) Handle(
 	if len(h.pluginArgs) > 0 {
 		runOptions = append(runOptions, command.RunWithArgs(h.pluginArgs...))
 	}
+	if h.sandbox {
+		sandboxDirPath, sandboxRunOptions, err := newSandboxDir(app.EnvironMap(container))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+		defer func() {
+			retErr = multierr.Append(retErr, os.RemoveAll(sandboxDirPath))
+		}()
+		runOptions = append(runOptions, sandboxRunOptions...)
+	} else {
+		runOptions = append(runOptions, command.RunWithEnv(app.EnvironMap(container)))
+		if h.dir != "" {
+			runOptions = append(runOptions, command.RunWithDir(h.dir))
+		}
+	}
+	if h.maxMemory > 0 {
+		runOptions = append(runOptions, command.RunWithMaxMemory(h.maxMemory))
+	}
 	if err := h.runner.Run(
 		ctx,
 		h.pluginPath,
@@ -21,6 +21,7 @@ package bufpluginexec
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/bufbuild/buf/private/bufpkg/bufwasm"
@@ -124,6 +125,42 @@ func GenerateWithWASMEnabled() GenerateOption {
 	}
 }
 
+// GenerateWithDir returns a new GenerateOption that runs the plugin binary in the
+// given working directory, see HandlerWithDir.
+func GenerateWithDir(dir string) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.dir = dir
+	}
+}
+
+// GenerateWithEnv returns a new GenerateOption that adds the given environment
+// variables to the environment of the plugin. These take precedence over the
+// environment variables of the container.
+func GenerateWithEnv(env map[string]string) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.env = env
+	}
+}
+
+// GenerateWithSandbox returns a new GenerateOption that runs the plugin in a
+// sandbox, see HandlerWithSandbox.
+//
+// The plugin only has access to the PATH of the container, and to the environment
+// variables added with GenerateWithEnv.
+func GenerateWithSandbox() GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.sandbox = true
+	}
+}
+
+// GenerateWithMaxMemory returns a new GenerateOption that limits the memory of
+// the plugin binary, see HandlerWithMaxMemory.
+func GenerateWithMaxMemory(maxMemory uint64) GenerateOption {
+	return func(generateOptions *generateOptions) {
+		generateOptions.maxMemory = maxMemory
+	}
+}
+
 // NewHandler returns a new Handler based on the plugin name and optional path.
 //
 // protocPath and pluginPath are optional.
@@ -156,11 +193,25 @@ func NewHandler(
 	// Initialize binary plugin handler when path is specified with optional args. Return
 	// on error as something is wrong with the supplied pluginPath option.
 	if len(handlerOptions.pluginPath) > 0 {
-		return NewBinaryHandler(runner, handlerOptions.pluginPath[0], handlerOptions.pluginPath[1:])
+		return newBinaryHandlerForPluginPath(
+			runner,
+			handlerOptions.pluginPath[0],
+			handlerOptions.pluginPath[1:],
+			handlerOptions.dir,
+			handlerOptions.sandbox,
+			handlerOptions.maxMemory,
+		)
 	}
 
 	// Initialize binary plugin handler based on plugin name.
-	if handler, err := NewBinaryHandler(runner, "protoc-gen-"+pluginName, nil); err == nil {
+	if handler, err := newBinaryHandlerForPluginPath(
+		runner,
+		"protoc-gen-"+pluginName,
+		nil,
+		handlerOptions.dir,
+		handlerOptions.sandbox,
+		handlerOptions.maxMemory,
+	); err == nil {
 		return handler, nil
 	}
 
@@ -214,22 +265,85 @@ func HandlerWithWASMEnabled() HandlerOption {
 	}
 }
 
+// HandlerWithDir returns a new HandlerOption that runs the plugin binary in the given
+// working directory.
+//
+// The default is to run the plugin binary in the current working directory. The plugin
+// path is still resolved relative to the current working directory. This has no effect
+// on WASM plugins and on the plugins built-in to protoc. dir is expected to be
+// unnormalized.
+func HandlerWithDir(dir string) HandlerOption {
+	return func(handlerOptions *handlerOptions) {
+		handlerOptions.dir = dir
+	}
+}
+
+// HandlerWithSandbox returns a new HandlerOption that runs the plugin binary in a
+// sandbox.
+//
+// The plugin binary is run in a new temporary working directory that is also its
+// home and temporary directory, and that is removed after the plugin exits. On Linux,
+// the plugin binary is also run without network access. This has no effect on WASM
+// plugins and on the plugins built-in to protoc, and takes precedence over
+// HandlerWithDir.
+func HandlerWithSandbox() HandlerOption {
+	return func(handlerOptions *handlerOptions) {
+		handlerOptions.sandbox = true
+	}
+}
+
+// HandlerWithMaxMemory returns a new HandlerOption that limits the address space
+// of the plugin binary to the given number of bytes.
+//
+// This is only supported on Linux. On other platforms, running the plugin binary
+// returns an error. This has no effect on WASM plugins and on the plugins built-in
+// to protoc.
+//
+// The default is to not limit the memory of the plugin binary.
+func HandlerWithMaxMemory(maxMemory uint64) HandlerOption {
+	return func(handlerOptions *handlerOptions) {
+		handlerOptions.maxMemory = maxMemory
+	}
+}
+
 // NewBinaryHandler returns a new Handler that invokes the specific plugin
 // specified by pluginPath.
 //
 // Used by other repositories.
 func NewBinaryHandler(runner command.Runner, pluginPath string, pluginArgs []string) (appproto.Handler, error) {
+	return newBinaryHandlerForPluginPath(runner, pluginPath, pluginArgs, "", false, 0)
+}
+
+func newBinaryHandlerForPluginPath(
+	runner command.Runner,
+	pluginPath string,
+	pluginArgs []string,
+	dir string,
+	sandbox bool,
+	maxMemory uint64,
+) (appproto.Handler, error) {
 	pluginPath, err := unsafeLookPath(pluginPath)
 	if err != nil {
 		return nil, err
 	}
-	return newBinaryHandler(runner, pluginPath, pluginArgs), nil
+	if dir != "" || sandbox {
+		// A relative plugin path would otherwise be evaluated relative to the
+		// working directory of the plugin.
+		pluginPath, err = filepath.Abs(pluginPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newBinaryHandler(runner, pluginPath, pluginArgs, dir, sandbox, maxMemory), nil
 }
 
 type handlerOptions struct {
 	protocPath  string
 	pluginPath  []string
 	wasmEnabled bool
+	dir         string
+	sandbox     bool
+	maxMemory   uint64
 }
 
 func newHandlerOptions() *handlerOptions {
@@ -68,6 +68,34 @@ func (g *generator) Generate(
 			HandlerWithWASMEnabled(),
 		)
 	}
+	if generateOptions.dir != "" {
+		handlerOptions = append(
+			handlerOptions,
+			HandlerWithDir(generateOptions.dir),
+		)
+	}
+	if generateOptions.sandbox {
+		handlerOptions = append(
+			handlerOptions,
+			HandlerWithSandbox(),
+		)
+		container = newEnvStderrContainer(
+			newSandboxEnvContainer(container),
+			container,
+		)
+	}
+	if generateOptions.maxMemory > 0 {
+		handlerOptions = append(
+			handlerOptions,
+			HandlerWithMaxMemory(generateOptions.maxMemory),
+		)
+	}
+	if len(generateOptions.env) > 0 {
+		container = newEnvStderrContainer(
+			app.NewEnvContainerWithOverrides(container, generateOptions.env),
+			container,
+		)
+	}
 	handler, err := NewHandler(
 		g.storageosProvider,
 		g.runner,
@@ -92,8 +120,27 @@ type generateOptions struct {
 	pluginPath  []string
 	protocPath  string
 	wasmEnabled bool
+	dir         string
+	env         map[string]string
+	sandbox     bool
+	maxMemory   uint64
 }
 
 func newGenerateOptions() *generateOptions {
 	return &generateOptions{}
 }
+
+type envStderrContainer struct {
+	app.EnvContainer
+	app.StderrContainer
+}
+
+func newEnvStderrContainer(
+	envContainer app.EnvContainer,
+	stderrContainer app.StderrContainer,
+) *envStderrContainer {
+	return &envStderrContainer{
+		EnvContainer:    envContainer,
+		StderrContainer: stderrContainer,
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufpluginexec
+
+import (
+	"os"
+	"runtime"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/command"
+)
+
+// sandboxEnvKeys are the environment variables of the container that sandboxed
+// plugins have access to. These are required to find and run binaries.
+var sandboxEnvKeys = []string{
+	"PATH",
+	// Required by many programs on Windows.
+	"SYSTEMROOT",
+}
+
+// sandboxTempDirEnvKeys are the environment variables that are set to the
+// sandbox directory, so that sandboxed plugins do not touch the home and
+// temporary directories of the user.
+var sandboxTempDirEnvKeys = []string{
+	"HOME",
+	"TMPDIR",
+	"TMP",
+	"TEMP",
+	"USERPROFILE",
+}
+
+// newSandboxEnvContainer returns a new EnvContainer with the environment
+// variables of the container that sandboxed plugins have access to.
+func newSandboxEnvContainer(container app.EnvContainer) app.EnvContainer {
+	env := make(map[string]string, len(sandboxEnvKeys))
+	for _, key := range sandboxEnvKeys {
+		if value := container.Env(key); value != "" {
+			env[key] = value
+		}
+	}
+	return app.NewEnvContainer(env)
+}
+
+// newSandboxDir creates a new temporary directory for a sandboxed plugin to
+// run in, and returns the run options that run the plugin in it.
+//
+// The caller is responsible for removing the directory.
+func newSandboxDir(env map[string]string) (string, []command.RunOption, error) {
+	sandboxDirPath, err := os.MkdirTemp("", "buf-plugin-sandbox-")
+	if err != nil {
+		return "", nil, err
+	}
+	for _, key := range sandboxTempDirEnvKeys {
+		env[key] = sandboxDirPath
+	}
+	runOptions := []command.RunOption{
+		command.RunWithEnv(env),
+		command.RunWithDir(sandboxDirPath),
+	}
+	// Network isolation is only supported on Linux, on other platforms the
+	// sandbox is limited to the environment and working directory.
+	if runtime.GOOS == "linux" {
+		runOptions = append(runOptions, command.RunWithoutNetwork())
+	}
+	return sandboxDirPath, runOptions, nil
+}
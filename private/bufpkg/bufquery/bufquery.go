@@ -0,0 +1,65 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufquery evaluates CEL expressions over a schema model of an Image.
+//
+// The expressions have access to the following variables, which are lists of maps
+// in the order of the files of the Image and of the declarations within the files:
+//
+//	files     path, package, syntax, imports, deprecated, is_import
+//	messages  name, full_name, file, package, parent, fields, oneofs, reserved_names,
+//	          reserved_ranges, is_map_entry, deprecated, is_import
+//	fields    name, full_name, file, message, number, label, type, type_name, json_name,
+//	          oneof, proto3_optional, deprecated, is_import
+//	enums     name, full_name, file, package, parent, values, deprecated, is_import
+//	services  name, full_name, file, package, methods, deprecated, is_import
+//	methods   name, full_name, file, service, input_type, output_type, client_streaming,
+//	          server_streaming, idempotency_level, deprecated, is_import
+//
+// The fields of a message and the methods of a service are also the elements of the fields
+// and methods variables, the values of an enum have a name, number, and deprecated key, and
+// reserved ranges have an inclusive start and end key. Types and type names are written
+// as in .proto files, such as "int32" or "acme.pet.v1.Pet", and labels are one of "optional",
+// "required", or "repeated".
+//
+// For example, this selects the messages with more than 50 fields:
+//
+//	messages.filter(m, m.fields.size() > 50)
+package bufquery
+
+import (
+	"context"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+)
+
+// Query is a compiled CEL expression.
+type Query interface {
+	// Expression returns the CEL expression.
+	Expression() string
+	// Evaluate evaluates the expression over the schema model of the Image.
+	//
+	// The files of the Image that are imports are only included if includeImports is set.
+	// The result is a value that can be marshaled with encoding/json.
+	Evaluate(ctx context.Context, image bufimage.Image, includeImports bool) (interface{}, error)
+
+	isQuery()
+}
+
+// NewQuery compiles the CEL expression into a new Query.
+//
+// Returns an error if the expression does not compile.
+func NewQuery(expression string) (Query, error) {
+	return newQuery(expression)
+}
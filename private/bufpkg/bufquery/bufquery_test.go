@@ -0,0 +1,140 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufquery_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimagebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmodulebuild"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleconfig"
+	"github.com/bufbuild/buf/private/bufpkg/bufquery"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	image := testGetImage(t, "query")
+	testEvaluate(
+		t,
+		image,
+		false,
+		`messages.filter(m, m.fields.size() > 2).map(m, m.full_name)`,
+		[]interface{}{"a.Large"},
+	)
+	testEvaluate(
+		t,
+		image,
+		false,
+		`messages.map(m, m.full_name)`,
+		[]interface{}{"a.Small", "a.Large", "a.Large.Nested"},
+	)
+	testEvaluate(
+		t,
+		image,
+		false,
+		`fields.filter(f, f.type == "float").map(f, f.full_name)`,
+		[]interface{}{"a.Large.three", "a.Large.Nested.value"},
+	)
+	testEvaluate(
+		t,
+		image,
+		false,
+		`fields.filter(f, f.type_name == "google.protobuf.Timestamp").map(f, {"name": f.name, "number": f.number})`,
+		[]interface{}{map[string]interface{}{"name": "four", "number": float64(4)}},
+	)
+	testEvaluate(
+		t,
+		image,
+		false,
+		`messages.filter(m, m.name == "Large")[0].reserved_ranges`,
+		[]interface{}{map[string]interface{}{"start": float64(5), "end": float64(10)}},
+	)
+	testEvaluate(
+		t,
+		image,
+		false,
+		`enums.map(e, e.values.map(v, v.name))`,
+		[]interface{}{[]interface{}{"STATUS_UNSPECIFIED", "STATUS_OK"}},
+	)
+	testEvaluate(
+		t,
+		image,
+		false,
+		`methods.filter(m, m.server_streaming).map(m, m.full_name)`,
+		[]interface{}{"a.StoreService.Watch"},
+	)
+	testEvaluate(t, image, false, `files.map(f, f.path)`, []interface{}{"a.proto"})
+	testEvaluate(t, image, true, `files.filter(f, f.is_import).map(f, f.path)`, []interface{}{"google/protobuf/timestamp.proto"})
+	testEvaluate(t, image, false, `files[0].imports`, []interface{}{"google/protobuf/timestamp.proto"})
+	testEvaluate(t, image, false, `services.size()`, float64(1))
+}
+
+func TestNewQueryError(t *testing.T) {
+	t.Parallel()
+	_, err := bufquery.NewQuery("")
+	assert.Error(t, err)
+	_, err = bufquery.NewQuery("messages.filter(m,")
+	assert.Error(t, err)
+	_, err = bufquery.NewQuery("unknown.size()")
+	assert.Error(t, err)
+}
+
+func TestEvaluateError(t *testing.T) {
+	t.Parallel()
+	query, err := bufquery.NewQuery("messages.filter(m, m.unknown > 1)")
+	require.NoError(t, err)
+	_, err = query.Evaluate(context.Background(), testGetImage(t, "query"), false)
+	assert.Error(t, err)
+}
+
+func testEvaluate(t *testing.T, image bufimage.Image, includeImports bool, expression string, expected interface{}) {
+	query, err := bufquery.NewQuery(expression)
+	require.NoError(t, err)
+	actual, err := query.Evaluate(context.Background(), image, includeImports)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual, expression)
+}
+
+func testGetImage(t *testing.T, relDirPath string) bufimage.Image {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	readWriteBucket, err := storageos.NewProvider().NewReadWriteBucket(filepath.Join("testdata", relDirPath))
+	require.NoError(t, err)
+	moduleConfig, err := bufmoduleconfig.NewConfigV1(bufmoduleconfig.ExternalConfigV1{})
+	require.NoError(t, err)
+	module, err := bufmodulebuild.BuildForBucket(ctx, readWriteBucket, moduleConfig)
+	require.NoError(t, err)
+	moduleFileSet, err := bufmodulebuild.NewModuleFileSetBuilder(
+		zap.NewNop(),
+		bufmodule.NewNopModuleReader(),
+	).Build(
+		ctx,
+		module,
+	)
+	require.NoError(t, err)
+	image, fileAnnotations, err := bufimagebuild.NewBuilder(zap.NewNop()).Build(ctx, moduleFileSet)
+	require.NoError(t, err)
+	require.Empty(t, fileAnnotations)
+	return image
+}
@@ -0,0 +1,249 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufquery
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/bufbuild/buf/private/bufpkg/bufimage/bufimageutil"
+	"github.com/bufbuild/buf/private/pkg/protodescriptor"
+	"github.com/bufbuild/buf/private/pkg/protosource"
+)
+
+const (
+	filesVariableName    = "files"
+	messagesVariableName = "messages"
+	fieldsVariableName   = "fields"
+	enumsVariableName    = "enums"
+	servicesVariableName = "services"
+	methodsVariableName  = "methods"
+)
+
+// model is the schema model of an Image.
+//
+// Every element is a map[string]interface{}, as CEL can select the keys
+// of maps without any type registration.
+type model struct {
+	files    []interface{}
+	messages []interface{}
+	fields   []interface{}
+	enums    []interface{}
+	services []interface{}
+	methods  []interface{}
+}
+
+func newModel(ctx context.Context, image bufimage.Image, includeImports bool) (*model, error) {
+	if !includeImports {
+		image = bufimage.ImageWithoutImports(image)
+	}
+	imageFiles := image.Files()
+	files, err := protosource.NewFilesUnstable(ctx, bufimageutil.NewInputFiles(imageFiles)...)
+	if err != nil {
+		return nil, err
+	}
+	model := &model{
+		files:    []interface{}{},
+		messages: []interface{}{},
+		fields:   []interface{}{},
+		enums:    []interface{}{},
+		services: []interface{}{},
+		methods:  []interface{}{},
+	}
+	for i, file := range files {
+		// NewFilesUnstable keeps the order of the input files.
+		model.addFile(file, imageFiles[i].IsImport())
+	}
+	return model, nil
+}
+
+func (m *model) variables() map[string]interface{} {
+	return map[string]interface{}{
+		filesVariableName:    m.files,
+		messagesVariableName: m.messages,
+		fieldsVariableName:   m.fields,
+		enumsVariableName:    m.enums,
+		servicesVariableName: m.services,
+		methodsVariableName:  m.methods,
+	}
+}
+
+func (m *model) addFile(file protosource.File, isImport bool) {
+	imports := make([]interface{}, 0, len(file.FileImports()))
+	for _, fileImport := range file.FileImports() {
+		imports = append(imports, fileImport.Import())
+	}
+	m.files = append(
+		m.files,
+		map[string]interface{}{
+			"path":       file.Path(),
+			"package":    file.Package(),
+			"syntax":     file.Syntax().String(),
+			"imports":    imports,
+			"deprecated": file.Deprecated(),
+			"is_import":  isImport,
+		},
+	)
+	for _, enum := range file.Enums() {
+		m.addEnum(enum, isImport)
+	}
+	for _, message := range file.Messages() {
+		m.addMessage(message, isImport)
+	}
+	for _, service := range file.Services() {
+		m.addService(service, isImport)
+	}
+}
+
+func (m *model) addMessage(message protosource.Message, isImport bool) {
+	fields := make([]interface{}, 0, len(message.Fields()))
+	for _, field := range message.Fields() {
+		fieldValue := newFieldValue(field, isImport)
+		fields = append(fields, fieldValue)
+		m.fields = append(m.fields, fieldValue)
+	}
+	oneofs := make([]interface{}, 0, len(message.Oneofs()))
+	for _, oneof := range message.Oneofs() {
+		oneofs = append(oneofs, oneof.Name())
+	}
+	reservedNames := make([]interface{}, 0, len(message.ReservedNames()))
+	for _, reservedName := range message.ReservedNames() {
+		reservedNames = append(reservedNames, reservedName.Value())
+	}
+	reservedRanges := make([]interface{}, 0, len(message.ReservedTagRanges()))
+	for _, reservedRange := range message.ReservedTagRanges() {
+		reservedRanges = append(
+			reservedRanges,
+			map[string]interface{}{
+				"start": int64(reservedRange.Start()),
+				"end":   int64(reservedRange.End()),
+			},
+		)
+	}
+	var parent string
+	if message.Parent() != nil {
+		parent = message.Parent().FullName()
+	}
+	m.messages = append(
+		m.messages,
+		map[string]interface{}{
+			"name":            message.Name(),
+			"full_name":       message.FullName(),
+			"file":            message.File().Path(),
+			"package":         message.File().Package(),
+			"parent":          parent,
+			"fields":          fields,
+			"oneofs":          oneofs,
+			"reserved_names":  reservedNames,
+			"reserved_ranges": reservedRanges,
+			"is_map_entry":    message.IsMapEntry(),
+			"deprecated":      message.Deprecated(),
+			"is_import":       isImport,
+		},
+	)
+	for _, enum := range message.Enums() {
+		m.addEnum(enum, isImport)
+	}
+	for _, nestedMessage := range message.Messages() {
+		m.addMessage(nestedMessage, isImport)
+	}
+}
+
+func (m *model) addEnum(enum protosource.Enum, isImport bool) {
+	values := make([]interface{}, 0, len(enum.Values()))
+	for _, enumValue := range enum.Values() {
+		values = append(
+			values,
+			map[string]interface{}{
+				"name":       enumValue.Name(),
+				"number":     int64(enumValue.Number()),
+				"deprecated": enumValue.Deprecated(),
+			},
+		)
+	}
+	var parent string
+	if enum.Parent() != nil {
+		parent = enum.Parent().FullName()
+	}
+	m.enums = append(
+		m.enums,
+		map[string]interface{}{
+			"name":       enum.Name(),
+			"full_name":  enum.FullName(),
+			"file":       enum.File().Path(),
+			"package":    enum.File().Package(),
+			"parent":     parent,
+			"values":     values,
+			"deprecated": enum.Deprecated(),
+			"is_import":  isImport,
+		},
+	)
+}
+
+func (m *model) addService(service protosource.Service, isImport bool) {
+	methods := make([]interface{}, 0, len(service.Methods()))
+	for _, method := range service.Methods() {
+		methodValue := map[string]interface{}{
+			"name":              method.Name(),
+			"full_name":         method.FullName(),
+			"file":              method.File().Path(),
+			"service":           service.FullName(),
+			"input_type":        strings.TrimPrefix(method.InputTypeName(), "."),
+			"output_type":       strings.TrimPrefix(method.OutputTypeName(), "."),
+			"client_streaming":  method.ClientStreaming(),
+			"server_streaming":  method.ServerStreaming(),
+			"idempotency_level": method.IdempotencyLevel().String(),
+			"deprecated":        method.Deprecated(),
+			"is_import":         isImport,
+		}
+		methods = append(methods, methodValue)
+		m.methods = append(m.methods, methodValue)
+	}
+	m.services = append(
+		m.services,
+		map[string]interface{}{
+			"name":       service.Name(),
+			"full_name":  service.FullName(),
+			"file":       service.File().Path(),
+			"package":    service.File().Package(),
+			"methods":    methods,
+			"deprecated": service.Deprecated(),
+			"is_import":  isImport,
+		},
+	)
+}
+
+func newFieldValue(field protosource.Field, isImport bool) map[string]interface{} {
+	var oneof string
+	if field.Oneof() != nil {
+		oneof = field.Oneof().Name()
+	}
+	return map[string]interface{}{
+		"name":            field.Name(),
+		"full_name":       field.FullName(),
+		"file":            field.File().Path(),
+		"message":         field.Message().FullName(),
+		"number":          int64(field.Number()),
+		"label":           strings.ToLower(strings.TrimPrefix(field.Label().String(), "LABEL_")),
+		"type":            protodescriptor.FieldDescriptorProtoTypePrettyString(field.Type()),
+		"type_name":       strings.TrimPrefix(field.TypeName(), "."),
+		"json_name":       field.JSONName(),
+		"oneof":           oneof,
+		"proto3_optional": field.Proto3Optional(),
+		"deprecated":      field.Deprecated(),
+		"is_import":       isImport,
+	}
+}
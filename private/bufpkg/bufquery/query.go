@@ -0,0 +1,101 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufimage"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	variableNames = []string{
+		filesVariableName,
+		messagesVariableName,
+		fieldsVariableName,
+		enumsVariableName,
+		servicesVariableName,
+		methodsVariableName,
+	}
+	structValueType = reflect.TypeOf(&structpb.Value{})
+)
+
+type query struct {
+	expression string
+	program    cel.Program
+}
+
+func newQuery(expression string) (*query, error) {
+	if expression == "" {
+		return nil, errors.New("expression is empty")
+	}
+	envOptions := []cel.EnvOption{
+		ext.Strings(),
+	}
+	for _, variableName := range variableNames {
+		envOptions = append(
+			envOptions,
+			cel.Variable(variableName, cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+		)
+	}
+	env, err := cel.NewEnv(envOptions...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid expression: %w", issues.Err())
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	return &query{
+		expression: expression,
+		program:    program,
+	}, nil
+}
+
+func (q *query) Expression() string {
+	return q.expression
+}
+
+func (q *query) Evaluate(ctx context.Context, image bufimage.Image, includeImports bool) (interface{}, error) {
+	model, err := newModel(ctx, image, includeImports)
+	if err != nil {
+		return nil, err
+	}
+	value, _, err := q.program.ContextEval(ctx, model.variables())
+	if err != nil {
+		return nil, fmt.Errorf("could not evaluate expression: %w", err)
+	}
+	native, err := value.ConvertToNative(structValueType)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert the result of type %s to JSON: %w", value.Type().TypeName(), err)
+	}
+	structValue, ok := native.(*structpb.Value)
+	if !ok {
+		return nil, fmt.Errorf("could not convert the result of type %s to JSON", value.Type().TypeName())
+	}
+	return structValue.AsInterface(), nil
+}
+
+func (*query) isQuery() {}
@@ -0,0 +1,313 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufrefactor plans refactorings of the files of modules and workspaces,
+// such as moving packages between modules and renaming symbols.
+package bufrefactor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule"
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/gen/data/datawkt"
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"go.uber.org/multierr"
+)
+
+const (
+	// DirectionNone is the Direction of a move after which neither module
+	// imports files of the other.
+	DirectionNone Direction = iota + 1
+	// DirectionSourceToTarget is the Direction of a move after which the
+	// source module imports files of the target module.
+	DirectionSourceToTarget
+	// DirectionTargetToSource is the Direction of a move after which the
+	// target module imports files of the source module.
+	DirectionTargetToSource
+	// DirectionCycle is the Direction of a move after which both modules
+	// import files of each other. Such a move cannot be done, as modules
+	// cannot depend on each other.
+	DirectionCycle
+)
+
+// Direction is the direction of the dependency between the source and the
+// target module after a move.
+type Direction int
+
+// String implements fmt.Stringer.
+func (d Direction) String() string {
+	switch d {
+	case DirectionNone:
+		return "none"
+	case DirectionSourceToTarget:
+		return "source_to_target"
+	case DirectionTargetToSource:
+		return "target_to_source"
+	case DirectionCycle:
+		return "cycle"
+	default:
+		return ""
+	}
+}
+
+// File is a file of a module.
+type File struct {
+	// Path is the path of the file within the module.
+	Path string
+	// Package is the package of the file, which is empty if the file
+	// declares no package.
+	Package string
+	// Imports are the import statements of the file.
+	Imports []*Import
+}
+
+// Import is an import statement.
+type Import struct {
+	// FilePath is the path of the file that contains the import statement.
+	FilePath string
+	// Line is the line of the import statement.
+	Line int
+	// ImportPath is the path of the imported file.
+	ImportPath string
+}
+
+// MovePackagePlan is the plan to move the files of a package from the source
+// module to the target module.
+//
+// The files keep their paths, so that the import statements that refer to
+// them do not change, but resolve to the target module instead of the source
+// module.
+type MovePackagePlan struct {
+	// Package is the package to move.
+	Package string
+	// SourceModuleIdentity is the identity of the source module, which is
+	// nil if the source module is not named.
+	SourceModuleIdentity bufmoduleref.ModuleIdentity
+	// TargetModuleIdentity is the identity of the target module.
+	TargetModuleIdentity bufmoduleref.ModuleIdentity
+	// MovedFilePaths are the sorted paths of the files to move.
+	MovedFilePaths []string
+	// SourceImports are the imports of the moved files by the files that stay
+	// in the source module, which resolve to the target module after the move.
+	SourceImports []*Import
+	// TargetImports are the imports of the files that stay in the source
+	// module by the moved files, which resolve to the source module after the
+	// move.
+	TargetImports []*Import
+	// ExternalImportPaths are the sorted paths of the files outside of the
+	// source module that the moved files import, except for well-known types.
+	// They are provided by the dependencies of the source module.
+	ExternalImportPaths []string
+	// Direction is the direction of the dependency between the modules after
+	// the move.
+	Direction Direction
+}
+
+// Steps returns the steps to do the move, in an order in which every module
+// that is pushed resolves its dependencies.
+//
+// Returns nil if the Direction is DirectionCycle.
+func (p *MovePackagePlan) Steps() []string {
+	return getMovePackageSteps(p)
+}
+
+// GetFiles parses the package declarations and import statements of the
+// files of the module, without compiling them, so that packages can be moved
+// out of modules whose dependencies are not available.
+//
+// The returned Files are sorted by path.
+func GetFiles(ctx context.Context, module bufmodule.Module) ([]*File, error) {
+	fileInfos, err := module.SourceFileInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*File, 0, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		file, err := getFile(ctx, module, fileInfo.Path())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// NewMovePackagePlan returns the plan to move the files of the package from
+// the module of the files to the target module.
+//
+// sourceModuleIdentity may be nil if the source module is not named. Returns
+// an error if no file has the package.
+func NewMovePackagePlan(
+	files []*File,
+	pkg string,
+	sourceModuleIdentity bufmoduleref.ModuleIdentity,
+	targetModuleIdentity bufmoduleref.ModuleIdentity,
+) (*MovePackagePlan, error) {
+	if sourceModuleIdentity != nil && sourceModuleIdentity.IdentityString() == targetModuleIdentity.IdentityString() {
+		return nil, fmt.Errorf("the target module %q is the source module", targetModuleIdentity.IdentityString())
+	}
+	pathToFile := make(map[string]*File, len(files))
+	for _, file := range files {
+		pathToFile[file.Path] = file
+	}
+	plan := &MovePackagePlan{
+		Package:              pkg,
+		SourceModuleIdentity: sourceModuleIdentity,
+		TargetModuleIdentity: targetModuleIdentity,
+	}
+	for _, file := range files {
+		if file.Package == pkg {
+			plan.MovedFilePaths = append(plan.MovedFilePaths, file.Path)
+		}
+	}
+	if len(plan.MovedFilePaths) == 0 {
+		return nil, fmt.Errorf("no file of the module has package %q", pkg)
+	}
+	sort.Strings(plan.MovedFilePaths)
+	externalImportPathSet := make(map[string]struct{})
+	for _, file := range files {
+		moved := file.Package == pkg
+		for _, fileImport := range file.Imports {
+			importedFile, ok := pathToFile[fileImport.ImportPath]
+			if !ok {
+				if moved && !datawkt.Exists(fileImport.ImportPath) {
+					externalImportPathSet[fileImport.ImportPath] = struct{}{}
+				}
+				continue
+			}
+			importedMoved := importedFile.Package == pkg
+			switch {
+			case moved && !importedMoved:
+				plan.TargetImports = append(plan.TargetImports, fileImport)
+			case !moved && importedMoved:
+				plan.SourceImports = append(plan.SourceImports, fileImport)
+			}
+		}
+	}
+	for externalImportPath := range externalImportPathSet {
+		plan.ExternalImportPaths = append(plan.ExternalImportPaths, externalImportPath)
+	}
+	sort.Strings(plan.ExternalImportPaths)
+	switch {
+	case len(plan.SourceImports) > 0 && len(plan.TargetImports) > 0:
+		plan.Direction = DirectionCycle
+	case len(plan.SourceImports) > 0:
+		plan.Direction = DirectionSourceToTarget
+	case len(plan.TargetImports) > 0:
+		plan.Direction = DirectionTargetToSource
+	default:
+		plan.Direction = DirectionNone
+	}
+	return plan, nil
+}
+
+func getFile(ctx context.Context, module bufmodule.Module, path string) (_ *File, retErr error) {
+	moduleFile, err := module.GetModuleFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, moduleFile.Close())
+	}()
+	fileNode, err := parser.Parse(moduleFile.ExternalPath(), moduleFile, reporter.NewHandler(nil))
+	if err != nil {
+		return nil, err
+	}
+	file := &File{
+		Path: path,
+	}
+	for _, decl := range fileNode.Decls {
+		switch node := decl.(type) {
+		case *ast.PackageNode:
+			file.Package = string(node.Name.AsIdentifier())
+		case *ast.ImportNode:
+			file.Imports = append(
+				file.Imports,
+				&Import{
+					FilePath:   path,
+					Line:       fileNode.NodeInfo(node).Start().Line,
+					ImportPath: node.Name.AsString(),
+				},
+			)
+		}
+	}
+	return file, nil
+}
+
+func getMovePackageSteps(plan *MovePackagePlan) []string {
+	if plan.Direction == DirectionCycle {
+		return nil
+	}
+	source := "the source module"
+	if plan.SourceModuleIdentity != nil {
+		source = plan.SourceModuleIdentity.IdentityString()
+	}
+	target := plan.TargetModuleIdentity.IdentityString()
+	var steps []string
+	if plan.Direction == DirectionTargetToSource && plan.SourceModuleIdentity == nil {
+		steps = append(steps, "Name the source module, so that the target module can depend on it.")
+	}
+	steps = append(
+		steps,
+		fmt.Sprintf("Create the module %s with the moved files, keeping their paths.", target),
+	)
+	if len(plan.ExternalImportPaths) > 0 {
+		steps = append(
+			steps,
+			fmt.Sprintf("Add the deps of %s that provide the external imports of the moved files to the deps of %s.", source, target),
+		)
+	}
+	removeSteps := []string{
+		fmt.Sprintf("Remove the moved files from %s.", source),
+		fmt.Sprintf("Push %s.", source),
+	}
+	switch plan.Direction {
+	case DirectionTargetToSource:
+		// The target module must pin a commit of the source module without the
+		// moved files, as the files of the dependencies of a module must be unique.
+		steps = append(steps, removeSteps...)
+		steps = append(
+			steps,
+			fmt.Sprintf("Add %s to the deps of %s.", source, target),
+			fmt.Sprintf(`Run "buf mod update" in %s.`, target),
+			fmt.Sprintf("Push %s.", target),
+		)
+	case DirectionSourceToTarget:
+		if len(plan.ExternalImportPaths) > 0 {
+			steps = append(steps, fmt.Sprintf(`Run "buf mod update" in %s.`, target))
+		}
+		steps = append(
+			steps,
+			fmt.Sprintf("Push %s.", target),
+			fmt.Sprintf("Add %s to the deps of %s.", target, source),
+			fmt.Sprintf(`Run "buf mod update" in %s.`, source),
+		)
+		steps = append(steps, removeSteps...)
+	default:
+		if len(plan.ExternalImportPaths) > 0 {
+			steps = append(steps, fmt.Sprintf(`Run "buf mod update" in %s.`, target))
+		}
+		steps = append(steps, fmt.Sprintf("Push %s.", target))
+		steps = append(steps, removeSteps...)
+	}
+	return append(
+		steps,
+		fmt.Sprintf("Add %s to the deps of the modules that import the moved files from %s.", target, source),
+	)
+}
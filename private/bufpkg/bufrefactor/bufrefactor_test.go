@@ -0,0 +1,165 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrefactor
+
+import (
+	"testing"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMovePackagePlan(t *testing.T) {
+	t.Parallel()
+	files := []*File{
+		newTestFile("acme/billing/v1/billing.proto", "acme.billing.v1", "acme/billing/v1/money.proto", "google/protobuf/timestamp.proto", "google/type/money.proto"),
+		newTestFile("acme/billing/v1/money.proto", "acme.billing.v1"),
+		newTestFile("acme/order/v1/order.proto", "acme.order.v1", "acme/billing/v1/billing.proto"),
+		newTestFile("acme/user/v1/user.proto", "acme.user.v1"),
+	}
+	plan, err := NewMovePackagePlan(
+		files,
+		"acme.billing.v1",
+		newTestModuleIdentity(t, "buf.build/acme/platform"),
+		newTestModuleIdentity(t, "buf.build/acme/billing"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme/billing/v1/billing.proto", "acme/billing/v1/money.proto"}, plan.MovedFilePaths)
+	assert.Equal(
+		t,
+		[]*Import{
+			{
+				FilePath:   "acme/order/v1/order.proto",
+				Line:       1,
+				ImportPath: "acme/billing/v1/billing.proto",
+			},
+		},
+		plan.SourceImports,
+	)
+	assert.Empty(t, plan.TargetImports)
+	assert.Equal(t, []string{"google/type/money.proto"}, plan.ExternalImportPaths)
+	assert.Equal(t, DirectionSourceToTarget, plan.Direction)
+	assert.Equal(
+		t,
+		[]string{
+			"Create the module buf.build/acme/billing with the moved files, keeping their paths.",
+			"Add the deps of buf.build/acme/platform that provide the external imports of the moved files to the deps of buf.build/acme/billing.",
+			`Run "buf mod update" in buf.build/acme/billing.`,
+			"Push buf.build/acme/billing.",
+			"Add buf.build/acme/billing to the deps of buf.build/acme/platform.",
+			`Run "buf mod update" in buf.build/acme/platform.`,
+			"Remove the moved files from buf.build/acme/platform.",
+			"Push buf.build/acme/platform.",
+			"Add buf.build/acme/billing to the deps of the modules that import the moved files from buf.build/acme/platform.",
+		},
+		plan.Steps(),
+	)
+}
+
+func TestNewMovePackagePlanTargetToSource(t *testing.T) {
+	t.Parallel()
+	files := []*File{
+		newTestFile("acme/billing/v1/billing.proto", "acme.billing.v1", "acme/user/v1/user.proto"),
+		newTestFile("acme/user/v1/user.proto", "acme.user.v1"),
+	}
+	plan, err := NewMovePackagePlan(
+		files,
+		"acme.billing.v1",
+		nil,
+		newTestModuleIdentity(t, "buf.build/acme/billing"),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, plan.SourceImports)
+	assert.Len(t, plan.TargetImports, 1)
+	assert.Equal(t, DirectionTargetToSource, plan.Direction)
+	assert.Equal(
+		t,
+		[]string{
+			"Name the source module, so that the target module can depend on it.",
+			"Create the module buf.build/acme/billing with the moved files, keeping their paths.",
+			"Remove the moved files from the source module.",
+			"Push the source module.",
+			"Add the source module to the deps of buf.build/acme/billing.",
+			`Run "buf mod update" in buf.build/acme/billing.`,
+			"Push buf.build/acme/billing.",
+			"Add buf.build/acme/billing to the deps of the modules that import the moved files from the source module.",
+		},
+		plan.Steps(),
+	)
+}
+
+func TestNewMovePackagePlanCycle(t *testing.T) {
+	t.Parallel()
+	files := []*File{
+		newTestFile("acme/billing/v1/billing.proto", "acme.billing.v1", "acme/user/v1/user.proto"),
+		newTestFile("acme/order/v1/order.proto", "acme.order.v1", "acme/billing/v1/billing.proto"),
+		newTestFile("acme/user/v1/user.proto", "acme.user.v1"),
+	}
+	plan, err := NewMovePackagePlan(
+		files,
+		"acme.billing.v1",
+		nil,
+		newTestModuleIdentity(t, "buf.build/acme/billing"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, DirectionCycle, plan.Direction)
+	assert.Nil(t, plan.Steps())
+}
+
+func TestNewMovePackagePlanErrors(t *testing.T) {
+	t.Parallel()
+	files := []*File{
+		newTestFile("acme/user/v1/user.proto", "acme.user.v1"),
+	}
+	_, err := NewMovePackagePlan(
+		files,
+		"acme.billing.v1",
+		nil,
+		newTestModuleIdentity(t, "buf.build/acme/billing"),
+	)
+	assert.Error(t, err)
+	_, err = NewMovePackagePlan(
+		files,
+		"acme.user.v1",
+		newTestModuleIdentity(t, "buf.build/acme/user"),
+		newTestModuleIdentity(t, "buf.build/acme/user"),
+	)
+	assert.Error(t, err)
+}
+
+func newTestFile(path string, pkg string, importPaths ...string) *File {
+	file := &File{
+		Path:    path,
+		Package: pkg,
+	}
+	for i, importPath := range importPaths {
+		file.Imports = append(
+			file.Imports,
+			&Import{
+				FilePath:   path,
+				Line:       i + 1,
+				ImportPath: importPath,
+			},
+		)
+	}
+	return file
+}
+
+func newTestModuleIdentity(t *testing.T, identity string) bufmoduleref.ModuleIdentity {
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString(identity)
+	require.NoError(t, err)
+	return moduleIdentity
+}
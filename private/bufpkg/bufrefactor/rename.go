@@ -0,0 +1,689 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrefactor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bufbuild/buf/private/pkg/protosource"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// SymbolKindMessage is the SymbolKind of messages.
+	SymbolKindMessage SymbolKind = iota + 1
+	// SymbolKindEnum is the SymbolKind of enums.
+	SymbolKindEnum
+	// SymbolKindField is the SymbolKind of the fields of messages.
+	SymbolKindField
+)
+
+// SymbolKind is the kind of a symbol that can be renamed.
+type SymbolKind int
+
+// String implements fmt.Stringer.
+func (s SymbolKind) String() string {
+	switch s {
+	case SymbolKindMessage:
+		return "message"
+	case SymbolKindEnum:
+		return "enum"
+	case SymbolKindField:
+		return "field"
+	default:
+		return ""
+	}
+}
+
+// BreakingChange is a change that the breaking change detector reports for a
+// rename.
+type BreakingChange struct {
+	// ID is the ID of the breaking rule that reports the change.
+	ID string
+	// File is the file of the changed descriptor.
+	File protosource.File
+	// Message describes the change.
+	Message string
+}
+
+// RenamePlan is the plan to rename a symbol, along with the references to it.
+type RenamePlan struct {
+	// Kind is the kind of the symbol.
+	Kind SymbolKind
+	// FullName is the fully-qualified name of the symbol.
+	FullName string
+	// NewFullName is the fully-qualified name of the symbol after the rename.
+	NewFullName string
+	// DefiningFile is the file that defines the symbol.
+	DefiningFile protosource.File
+	// Files are the files to change, sorted by path.
+	Files []protosource.File
+	// BreakingChanges are the changes that breaking rules report for the
+	// rename, sorted by rule ID.
+	BreakingChanges []*BreakingChange
+
+	filePathToEdits map[string][]*edit
+}
+
+// Apply returns the data of the file with the edits of the plan for the file.
+//
+// Returns an error if the data does not contain the symbol or its references
+// at their locations.
+func (p *RenamePlan) Apply(file protosource.File, data []byte) ([]byte, error) {
+	return applyEdits(data, p.filePathToEdits[file.Path()])
+}
+
+// NewRenamePlan returns the plan to rename the message, enum, or field of
+// the files with the fully-qualified name to the new name.
+//
+// Renamed fields keep their number, and their previous name is added to the
+// reserved names of their message. The references to renamed messages and
+// enums in the files are changed to the new name. The symbol must be defined
+// in one of the files.
+func NewRenamePlan(files []protosource.File, fullName string, newName string) (*RenamePlan, error) {
+	fullName = strings.TrimPrefix(fullName, ".")
+	if !isIdentifier(newName) {
+		return nil, fmt.Errorf("%q is not a valid name", newName)
+	}
+	fullNameToMessage, err := protosource.FullNameToMessage(files...)
+	if err != nil {
+		return nil, err
+	}
+	fullNameToEnum, err := protosource.FullNameToEnum(files...)
+	if err != nil {
+		return nil, err
+	}
+	plan := &RenamePlan{
+		FullName:        fullName,
+		filePathToEdits: make(map[string][]*edit),
+	}
+	var namedDescriptor protosource.NamedDescriptor
+	if message, ok := fullNameToMessage[fullName]; ok && !message.IsMapEntry() {
+		plan.Kind = SymbolKindMessage
+		namedDescriptor = message
+	} else if enum, ok := fullNameToEnum[fullName]; ok {
+		plan.Kind = SymbolKindEnum
+		namedDescriptor = enum
+	} else if field, err := getMessageField(fullNameToMessage, fullName); err != nil {
+		return nil, err
+	} else if field != nil {
+		plan.Kind = SymbolKindField
+		namedDescriptor = field
+	} else {
+		return nil, fmt.Errorf("no message, enum, or field is named %q", fullName)
+	}
+	if namedDescriptor.Name() == newName {
+		return nil, fmt.Errorf("%q is already named %q", fullName, newName)
+	}
+	plan.DefiningFile = namedDescriptor.File()
+	plan.NewFullName = strings.TrimSuffix(fullName, namedDescriptor.Name()) + newName
+	plan.addEdit(
+		namedDescriptor.File(),
+		&edit{
+			location: namedDescriptor.NameLocation(),
+			oldText:  namedDescriptor.Name(),
+			newText:  newName,
+		},
+	)
+	switch plan.Kind {
+	case SymbolKindField:
+		if err := plan.addFieldRename(namedDescriptor.(protosource.Field), newName); err != nil {
+			return nil, err
+		}
+	default:
+		if _, ok := fullNameToMessage[plan.NewFullName]; ok {
+			return nil, fmt.Errorf("a message is already named %q", plan.NewFullName)
+		}
+		if _, ok := fullNameToEnum[plan.NewFullName]; ok {
+			return nil, fmt.Errorf("an enum is already named %q", plan.NewFullName)
+		}
+		if err := plan.addTypeRename(files, namedDescriptor, newName); err != nil {
+			return nil, err
+		}
+	}
+	filePathToFile := make(map[string]protosource.File)
+	for _, file := range files {
+		if _, ok := plan.filePathToEdits[file.Path()]; ok {
+			filePathToFile[file.Path()] = file
+		}
+	}
+	for _, file := range filePathToFile {
+		plan.Files = append(plan.Files, file)
+	}
+	sort.Slice(
+		plan.Files,
+		func(i int, j int) bool {
+			return plan.Files[i].Path() < plan.Files[j].Path()
+		},
+	)
+	sort.SliceStable(
+		plan.BreakingChanges,
+		func(i int, j int) bool {
+			return plan.BreakingChanges[i].ID < plan.BreakingChanges[j].ID
+		},
+	)
+	return plan, nil
+}
+
+// addFieldRename adds the reserved name and the breaking changes of the
+// rename of the field.
+func (p *RenamePlan) addFieldRename(field protosource.Field, newName string) error {
+	message := field.Message()
+	for _, otherField := range message.Fields() {
+		if otherField.Name() == newName {
+			return fmt.Errorf("message %q already has a field named %q", message.FullName(), newName)
+		}
+	}
+	if protosource.NameInReservedNames(newName, message.ReservedNames()...) {
+		return fmt.Errorf("the name %q is reserved in message %q", newName, message.FullName())
+	}
+	if field.Type() == descriptorpb.FieldDescriptorProto_TYPE_GROUP {
+		return fmt.Errorf("field %q is a group, and renaming groups is not supported", field.FullName())
+	}
+	if !protosource.NameInReservedNames(field.Name(), message.ReservedNames()...) {
+		// The reserved name is added after the declaration of the field, or of
+		// its oneof, as reserved names cannot be declared in oneofs.
+		location := field.Location()
+		if oneof := field.Oneof(); oneof != nil && !field.Proto3Optional() {
+			location = oneof.Location()
+		}
+		if location == nil {
+			return fmt.Errorf("field %q has no source location", field.FullName())
+		}
+		reserved := fmt.Sprintf("reserved %q;", field.Name())
+		if messageLocation := message.Location(); messageLocation != nil && messageLocation.EndLine() == location.EndLine() {
+			p.addEdit(
+				field.File(),
+				&edit{
+					insertLine:   location.EndLine(),
+					insertColumn: location.EndColumn(),
+					newText:      " " + reserved,
+				},
+			)
+		} else {
+			p.addEdit(
+				field.File(),
+				&edit{
+					insertLine:   location.EndLine() + 1,
+					insertColumn: 1,
+					newText:      strings.Repeat(" ", location.StartColumn()-1) + reserved + "\n",
+				},
+			)
+		}
+	}
+	p.BreakingChanges = append(
+		p.BreakingChanges,
+		&BreakingChange{
+			ID:   "FIELD_SAME_NAME",
+			File: field.File(),
+			Message: fmt.Sprintf(
+				"Field %q on message %q changes name from %q to %q.",
+				strconv.Itoa(field.Number()),
+				message.NestedName(),
+				field.Name(),
+				newName,
+			),
+		},
+	)
+	if field.JSONNameLocation() == nil {
+		if jsonName, newJSONName := getJSONName(field.Name()), getJSONName(newName); jsonName != newJSONName {
+			p.BreakingChanges = append(
+				p.BreakingChanges,
+				&BreakingChange{
+					ID:   "FIELD_SAME_JSON_NAME",
+					File: field.File(),
+					Message: fmt.Sprintf(
+						"Field %q on message %q changes option \"json_name\" from %q to %q.",
+						strconv.Itoa(field.Number()),
+						message.NestedName(),
+						jsonName,
+						newJSONName,
+					),
+				},
+			)
+		}
+	}
+	return nil
+}
+
+// addTypeRename adds the edits of the references to the message or enum, and
+// the breaking changes of the rename.
+func (p *RenamePlan) addTypeRename(files []protosource.File, namedDescriptor protosource.NamedDescriptor, newName string) error {
+	kind := p.Kind.String()
+	deleteID, packageDeleteID := "MESSAGE_NO_DELETE", "PACKAGE_MESSAGE_NO_DELETE"
+	if p.Kind == SymbolKindEnum {
+		deleteID, packageDeleteID = "ENUM_NO_DELETE", "PACKAGE_ENUM_NO_DELETE"
+	}
+	p.BreakingChanges = append(
+		p.BreakingChanges,
+		&BreakingChange{
+			ID:      deleteID,
+			File:    namedDescriptor.File(),
+			Message: fmt.Sprintf("Previously present %s %q is deleted from file %q.", kind, namedDescriptor.NestedName(), namedDescriptor.File().Path()),
+		},
+		&BreakingChange{
+			ID:      packageDeleteID,
+			File:    namedDescriptor.File(),
+			Message: fmt.Sprintf("Previously present %s %q is deleted from package %q.", kind, namedDescriptor.NestedName(), namedDescriptor.File().Package()),
+		},
+	)
+	for _, file := range files {
+		if err := forEachTypeReference(
+			file,
+			func(reference *typeReference) error {
+				typeName := reference.typeName
+				if typeName != p.FullName && !strings.HasPrefix(typeName, p.FullName+".") {
+					return nil
+				}
+				if reference.location == nil {
+					return fmt.Errorf("the reference to %q in file %q has no source location", typeName, file.Path())
+				}
+				p.addEdit(
+					file,
+					&edit{
+						location:         reference.location,
+						oldText:          namedDescriptor.Name(),
+						newText:          newName,
+						componentFromEnd: strings.Count(strings.TrimPrefix(typeName, p.FullName), "."),
+					},
+				)
+				// References from within the renamed message are not reported, as
+				// the breaking rules report the message as deleted instead.
+				if reference.breakingChange != nil && typeName == p.FullName &&
+					reference.containerFullName != p.FullName && !strings.HasPrefix(reference.containerFullName, p.FullName+".") {
+					p.BreakingChanges = append(p.BreakingChanges, reference.breakingChange(typeName, p.NewFullName))
+				}
+				return nil
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *RenamePlan) addEdit(file protosource.File, edit *edit) {
+	p.filePathToEdits[file.Path()] = append(p.filePathToEdits[file.Path()], edit)
+}
+
+// typeReference is a reference to a message or enum.
+type typeReference struct {
+	typeName string
+	location protosource.Location
+	// containerFullName is the fully-qualified name of the message or service
+	// that contains the reference, or empty.
+	containerFullName string
+	// breakingChange returns the breaking change of the change of the type
+	// of the reference, or is nil if the change is not breaking.
+	breakingChange func(typeName string, newTypeName string) *BreakingChange
+}
+
+// forEachTypeReference calls f for each reference to a message or enum by the
+// fields, extensions, and methods of the file.
+func forEachTypeReference(file protosource.File, f func(*typeReference) error) error {
+	// The breaking changes of the types of fields are reported for
+	// breakingField, which is the map field for the fields of map entries,
+	// and nil for extensions.
+	forField := func(field protosource.Field, location protosource.Location, breakingField protosource.Field) error {
+		switch field.Type() {
+		case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+			descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+			reference := &typeReference{
+				typeName: field.TypeName(),
+				location: location,
+			}
+			if breakingField != nil {
+				reference.containerFullName = breakingField.Message().FullName()
+				reference.breakingChange = func(typeName string, newTypeName string) *BreakingChange {
+					return &BreakingChange{
+						ID:   "FIELD_SAME_TYPE",
+						File: file,
+						Message: fmt.Sprintf(
+							"Field %q on message %q changes type from %q to %q.",
+							strconv.Itoa(breakingField.Number()),
+							breakingField.Message().NestedName(),
+							typeName,
+							newTypeName,
+						),
+					}
+				}
+			}
+			if err := f(reference); err != nil {
+				return err
+			}
+		}
+		if extendee := field.Extendee(); extendee != "" {
+			return f(
+				&typeReference{
+					typeName: extendee,
+					location: field.ExtendeeLocation(),
+				},
+			)
+		}
+		return nil
+	}
+	for _, extension := range file.Extensions() {
+		if err := forField(extension, extension.TypeNameLocation(), nil); err != nil {
+			return err
+		}
+	}
+	if err := protosource.ForEachMessage(
+		func(message protosource.Message) error {
+			if message.IsMapEntry() {
+				// The fields of map entries have no locations, so the location
+				// of the type of the map field is used instead.
+				mapField := getMapField(message)
+				if mapField == nil {
+					return nil
+				}
+				for _, field := range message.Fields() {
+					if err := forField(field, mapField.TypeNameLocation(), mapField); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			mapEntryTypeNames := make(map[string]struct{})
+			for _, nestedMessage := range message.Messages() {
+				if nestedMessage.IsMapEntry() {
+					mapEntryTypeNames[nestedMessage.FullName()] = struct{}{}
+				}
+			}
+			for _, field := range message.Fields() {
+				if _, ok := mapEntryTypeNames[field.TypeName()]; ok {
+					// The types of map fields are referenced by the fields of their
+					// map entries.
+					continue
+				}
+				if err := forField(field, field.TypeNameLocation(), field); err != nil {
+					return err
+				}
+			}
+			for _, extension := range message.Extensions() {
+				if err := forField(extension, extension.TypeNameLocation(), nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		file,
+	); err != nil {
+		return err
+	}
+	for _, service := range file.Services() {
+		for _, method := range service.Methods() {
+			method := method
+			for _, reference := range []struct {
+				typeName string
+				location protosource.Location
+				id       string
+				kind     string
+			}{
+				{method.InputTypeName(), method.InputTypeLocation(), "RPC_SAME_REQUEST_TYPE", "request"},
+				{method.OutputTypeName(), method.OutputTypeLocation(), "RPC_SAME_RESPONSE_TYPE", "response"},
+			} {
+				reference := reference
+				if err := f(
+					&typeReference{
+						typeName:          reference.typeName,
+						location:          reference.location,
+						containerFullName: service.FullName(),
+						breakingChange: func(typeName string, newTypeName string) *BreakingChange {
+							return &BreakingChange{
+								ID:   reference.id,
+								File: file,
+								Message: fmt.Sprintf(
+									"RPC %q on service %q changes %s type from %q to %q.",
+									method.Name(),
+									service.Name(),
+									reference.kind,
+									typeName,
+									newTypeName,
+								),
+							}
+						},
+					},
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// getMapField returns the map field of the map entry, or nil.
+func getMapField(mapEntry protosource.Message) protosource.Field {
+	for _, field := range mapEntry.Parent().Fields() {
+		if field.TypeName() == mapEntry.FullName() {
+			return field
+		}
+	}
+	return nil
+}
+
+// getMessageField returns the field of a message with the fully-qualified name,
+// or nil.
+func getMessageField(fullNameToMessage map[string]protosource.Message, fullName string) (protosource.Field, error) {
+	index := strings.LastIndexByte(fullName, '.')
+	if index < 0 {
+		return nil, nil
+	}
+	message, ok := fullNameToMessage[fullName[:index]]
+	if !ok {
+		return nil, nil
+	}
+	if message.IsMapEntry() {
+		return nil, fmt.Errorf("%q is a field of a map entry, rename the map field instead", fullName)
+	}
+	for _, field := range message.Fields() {
+		if field.Name() == fullName[index+1:] {
+			return field, nil
+		}
+	}
+	return nil, nil
+}
+
+// getJSONName returns the JSON name that protoc derives from the name of a field.
+func getJSONName(name string) string {
+	var builder strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case '0' <= r && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// edit is a change of the text of a file.
+//
+// If location is nil, newText is inserted at insertLine and insertColumn.
+// Otherwise, oldText is replaced with newText in the text at location. If
+// componentFromEnd is set, the text at location is a type name, and oldText
+// is the component of the type name at componentFromEnd from its end. If the
+// type name does not have this component, as it is resolved relative to the
+// scope of the reference, the edit does nothing.
+type edit struct {
+	location         protosource.Location
+	oldText          string
+	newText          string
+	componentFromEnd int
+	insertLine       int
+	insertColumn     int
+}
+
+type replacement struct {
+	start   int
+	end     int
+	newText string
+}
+
+// applyEdits applies the edits to the data. Columns are counted as protocompile
+// counts them, with tabs advancing to the next multiple of eight.
+func applyEdits(data []byte, edits []*edit) ([]byte, error) {
+	lineOffsets := getLineOffsets(data)
+	replacements := make([]*replacement, 0, len(edits))
+	for _, edit := range edits {
+		if edit.location == nil {
+			offset, err := getOffset(data, lineOffsets, edit.insertLine, edit.insertColumn)
+			if err != nil {
+				return nil, err
+			}
+			replacements = append(replacements, &replacement{start: offset, end: offset, newText: edit.newText})
+			continue
+		}
+		start, err := getOffset(data, lineOffsets, edit.location.StartLine(), edit.location.StartColumn())
+		if err != nil {
+			return nil, err
+		}
+		end, err := getOffset(data, lineOffsets, edit.location.EndLine(), edit.location.EndColumn())
+		if err != nil {
+			return nil, err
+		}
+		if edit.componentFromEnd == 0 && !strings.Contains(string(data[start:end]), ".") && !strings.Contains(string(data[start:end]), "<") {
+			if string(data[start:end]) != edit.oldText {
+				return nil, fmt.Errorf("expected %q at line %d, column %d, found %q", edit.oldText, edit.location.StartLine(), edit.location.StartColumn(), string(data[start:end]))
+			}
+			replacements = append(replacements, &replacement{start: start, end: end, newText: edit.newText})
+			continue
+		}
+		componentStart, componentEnd, ok := getTypeNameComponent(data[start:end], edit.componentFromEnd)
+		if !ok {
+			continue
+		}
+		if component := string(data[start+componentStart : start+componentEnd]); component != edit.oldText {
+			if edit.componentFromEnd == 0 {
+				return nil, fmt.Errorf("expected %q at line %d, column %d, found %q", edit.oldText, edit.location.StartLine(), edit.location.StartColumn(), component)
+			}
+			continue
+		}
+		replacements = append(replacements, &replacement{start: start + componentStart, end: start + componentEnd, newText: edit.newText})
+	}
+	sort.SliceStable(
+		replacements,
+		func(i int, j int) bool {
+			return replacements[i].start < replacements[j].start
+		},
+	)
+	buffer := bytes.NewBuffer(nil)
+	offset := 0
+	for i, replacement := range replacements {
+		if i > 0 && *replacement == *replacements[i-1] {
+			continue
+		}
+		if replacement.start < offset {
+			return nil, errors.New("overlapping edits")
+		}
+		_, _ = buffer.Write(data[offset:replacement.start])
+		_, _ = buffer.WriteString(replacement.newText)
+		offset = replacement.end
+	}
+	_, _ = buffer.Write(data[offset:])
+	return buffer.Bytes(), nil
+}
+
+// getTypeNameComponent returns the start and end of the component of the type
+// name in the data at componentFromEnd from its end. For map types, the type
+// name is the type of the values.
+func getTypeNameComponent(data []byte, componentFromEnd int) (int, int, bool) {
+	start, end := 0, len(data)
+	if index := bytes.IndexByte(data, '<'); index >= 0 {
+		start = index + 1
+		if index := bytes.LastIndexByte(data, ','); index >= 0 {
+			start = index + 1
+		}
+		if index := bytes.LastIndexByte(data, '>'); index >= start {
+			end = index
+		}
+	}
+	for i := 0; ; i++ {
+		componentStart := start
+		if index := bytes.LastIndexByte(data[start:end], '.'); index >= 0 {
+			componentStart = start + index + 1
+		}
+		if i == componentFromEnd {
+			trimmed := bytes.TrimSpace(data[componentStart:end])
+			if len(trimmed) == 0 {
+				return 0, 0, false
+			}
+			componentStart += bytes.Index(data[componentStart:end], trimmed)
+			return componentStart, componentStart + len(trimmed), true
+		}
+		if componentStart == start {
+			return 0, 0, false
+		}
+		end = componentStart - 1
+	}
+}
+
+func getLineOffsets(data []byte) []int {
+	lineOffsets := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			lineOffsets = append(lineOffsets, i+1)
+		}
+	}
+	return lineOffsets
+}
+
+// getOffset returns the offset of the 1-based line and column in the data.
+func getOffset(data []byte, lineOffsets []int, line int, column int) (int, error) {
+	if line < 1 || line > len(lineOffsets) {
+		return 0, fmt.Errorf("line %d is out of range", line)
+	}
+	offset := lineOffsets[line-1]
+	currentColumn := 1
+	for currentColumn < column {
+		if offset >= len(data) || data[offset] == '\n' {
+			return 0, fmt.Errorf("column %d of line %d is out of range", column, line)
+		}
+		if data[offset] == '\t' {
+			currentColumn += 8 - (currentColumn-1)%8
+		} else {
+			currentColumn++
+		}
+		_, size := utf8.DecodeRune(data[offset:])
+		offset += size
+	}
+	return offset, nil
+}
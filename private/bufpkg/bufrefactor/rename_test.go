@@ -0,0 +1,121 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufrefactor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTypeNameComponent(t *testing.T) {
+	t.Parallel()
+	testGetTypeNameComponent(t, "Order", 0, "Order")
+	testGetTypeNameComponent(t, "acme.v1.Order", 0, "Order")
+	testGetTypeNameComponent(t, ".acme.v1.Order.Item", 1, "Order")
+	testGetTypeNameComponent(t, "acme . v1 . Order", 0, "Order")
+	testGetTypeNameComponent(t, "map<string, Order.Item>", 1, "Order")
+	testGetTypeNameComponent(t, "map<string,acme.v1.Order>", 0, "Order")
+	testGetTypeNameComponent(t, "Item", 1, "")
+	testGetTypeNameComponent(t, "map<string, Item>", 1, "")
+}
+
+func TestApplyEdits(t *testing.T) {
+	t.Parallel()
+	// Tabs advance to the next multiple of eight columns.
+	data := []byte("message A {\n\tB b = 1;\n}\n")
+	newData, err := applyEdits(
+		data,
+		[]*edit{
+			{
+				location: newTestLocation(2, 11, 2, 12),
+				oldText:  "b",
+				newText:  "c",
+			},
+			{
+				location: newTestLocation(2, 11, 2, 12),
+				oldText:  "b",
+				newText:  "c",
+			},
+			{
+				location: newTestLocation(2, 9, 2, 10),
+				oldText:  "B",
+				newText:  "D",
+			},
+			{
+				insertLine:   3,
+				insertColumn: 1,
+				newText:      "\treserved \"b\";\n",
+			},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "message A {\n\tD c = 1;\n\treserved \"b\";\n}\n", string(newData))
+	_, err = applyEdits(
+		data,
+		[]*edit{
+			{
+				location: newTestLocation(2, 11, 2, 12),
+				oldText:  "a",
+				newText:  "c",
+			},
+		},
+	)
+	assert.Error(t, err)
+}
+
+func TestGetJSONName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "total", getJSONName("total"))
+	assert.Equal(t, "totalAmount", getJSONName("total_amount"))
+	assert.Equal(t, "totalAmount", getJSONName("total__amount"))
+	assert.Equal(t, "total1", getJSONName("total_1"))
+}
+
+func testGetTypeNameComponent(t *testing.T, typeName string, componentFromEnd int, expected string) {
+	t.Helper()
+	start, end, ok := getTypeNameComponent([]byte(typeName), componentFromEnd)
+	if expected == "" {
+		assert.False(t, ok, typeName)
+		return
+	}
+	require.True(t, ok, typeName)
+	assert.Equal(t, expected, typeName[start:end], typeName)
+}
+
+type testLocation struct {
+	startLine   int
+	startColumn int
+	endLine     int
+	endColumn   int
+}
+
+func newTestLocation(startLine int, startColumn int, endLine int, endColumn int) *testLocation {
+	return &testLocation{
+		startLine:   startLine,
+		startColumn: startColumn,
+		endLine:     endLine,
+		endColumn:   endColumn,
+	}
+}
+
+func (l *testLocation) StartLine() int                    { return l.startLine }
+func (l *testLocation) StartColumn() int                  { return l.startColumn }
+func (l *testLocation) EndLine() int                      { return l.endLine }
+func (l *testLocation) EndColumn() int                    { return l.endColumn }
+func (l *testLocation) LeadingComments() string           { return "" }
+func (l *testLocation) TrailingComments() string          { return "" }
+func (l *testLocation) LeadingDetachedComments() []string { return nil }
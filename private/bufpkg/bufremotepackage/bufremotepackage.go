@@ -0,0 +1,150 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bufremotepackage computes the names and versions of remote packages,
+// the SDKs that the BSR generates for a module commit with a plugin.
+package bufremotepackage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/bufplugin/bufpluginref"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+)
+
+const (
+	// commitTimestampLayout is the layout of the commit timestamp in remote package versions.
+	commitTimestampLayout = "20060102150405"
+	// shortCommitNameLength is the length of the commit name in remote package versions.
+	shortCommitNameLength = 12
+)
+
+// RemotePackage is a remote package generated by the BSR.
+type RemotePackage interface {
+	// RegistryType is the type of the registry that serves the remote package.
+	RegistryType() registryv1alpha1.PluginRegistryType
+	// Name is the name of the remote package in its registry, such as
+	// buf.build/gen/go/acme/weather/bufbuild/connect-go for Go.
+	Name() string
+	// Version is the version of the remote package for the module commit
+	// and plugin version and revision.
+	Version() string
+	// RegistryURL is the URL of the registry that serves the remote package.
+	RegistryURL() string
+}
+
+// NewRemotePackage returns a new RemotePackage for the module commit and plugin.
+//
+// The commit name must be the full commit name, and the commit time is the time
+// the commit was created.
+func NewRemotePackage(
+	moduleIdentity bufmoduleref.ModuleIdentity,
+	commitName string,
+	commitTime time.Time,
+	pluginIdentity bufpluginref.PluginIdentity,
+	pluginVersion string,
+	pluginRevision uint32,
+	registryType registryv1alpha1.PluginRegistryType,
+) (RemotePackage, error) {
+	if len(commitName) < shortCommitNameLength {
+		return nil, fmt.Errorf("invalid commit name: %q", commitName)
+	}
+	if pluginVersion == "" {
+		return nil, fmt.Errorf("plugin %s has no version", pluginIdentity.IdentityString())
+	}
+	shortCommitName := commitName[:shortCommitNameLength]
+	commitTimestamp := commitTime.UTC().Format(commitTimestampLayout)
+	pluginVersion = strings.TrimPrefix(pluginVersion, "v")
+	revision := strconv.FormatUint(uint64(pluginRevision), 10)
+	baseURL := "https://" + moduleIdentity.Remote() + "/gen"
+	switch registryType {
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_GO:
+		return newRemotePackage(
+			registryType,
+			strings.Join(
+				[]string{
+					moduleIdentity.Remote(),
+					"gen",
+					"go",
+					moduleIdentity.Owner(),
+					moduleIdentity.Repository(),
+					pluginIdentity.Owner(),
+					pluginIdentity.Plugin(),
+				},
+				"/",
+			),
+			"v"+pluginVersion+"-"+commitTimestamp+"-"+shortCommitName+"."+revision,
+			baseURL+"/go",
+		), nil
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_NPM:
+		return newRemotePackage(
+			registryType,
+			"@buf/"+moduleIdentity.Owner()+"_"+moduleIdentity.Repository()+"."+pluginIdentity.Owner()+"_"+pluginIdentity.Plugin(),
+			pluginVersion+"-"+commitTimestamp+"-"+shortCommitName+"."+revision,
+			baseURL+"/npm/v1",
+		), nil
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_MAVEN:
+		return newRemotePackage(
+			registryType,
+			"build.buf.gen:"+getUnderscoreJoinedName(moduleIdentity, pluginIdentity),
+			pluginVersion+"."+revision+"."+commitTimestamp+"."+shortCommitName,
+			baseURL+"/maven",
+		), nil
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_SWIFT:
+		return newRemotePackage(
+			registryType,
+			getUnderscoreJoinedName(moduleIdentity, pluginIdentity),
+			pluginVersion+"-"+commitTimestamp+"-"+shortCommitName+"."+revision,
+			baseURL+"/swift",
+		), nil
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_UNSPECIFIED:
+		return nil, fmt.Errorf("plugin %s does not support remote packages", pluginIdentity.IdentityString())
+	default:
+		return nil, fmt.Errorf("unknown plugin registry type: %v", registryType)
+	}
+}
+
+// RegistryTypeString returns the short string for the registry type, such as "go".
+//
+// Returns the empty string if the registry type is unknown.
+func RegistryTypeString(registryType registryv1alpha1.PluginRegistryType) string {
+	switch registryType {
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_GO:
+		return "go"
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_NPM:
+		return "npm"
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_MAVEN:
+		return "maven"
+	case registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_SWIFT:
+		return "swift"
+	default:
+		return ""
+	}
+}
+
+func getUnderscoreJoinedName(moduleIdentity bufmoduleref.ModuleIdentity, pluginIdentity bufpluginref.PluginIdentity) string {
+	return strings.Join(
+		[]string{
+			moduleIdentity.Owner(),
+			moduleIdentity.Repository(),
+			pluginIdentity.Owner(),
+			pluginIdentity.Plugin(),
+		},
+		"_",
+	)
+}
@@ -0,0 +1,125 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufremotepackage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/bufpkg/bufmodule/bufmoduleref"
+	"github.com/bufbuild/buf/private/bufpkg/bufplugin/bufpluginref"
+	registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRemotePackage(t *testing.T) {
+	t.Parallel()
+	testNewRemotePackage(
+		t,
+		"buf.build/bufbuild/connect-go",
+		"v1.5.2",
+		registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_GO,
+		"buf.build/gen/go/acme/weather/bufbuild/connect-go",
+		"v1.5.2-20230222163843-c806e06ce889.1",
+		"https://buf.build/gen/go",
+	)
+	testNewRemotePackage(
+		t,
+		"buf.build/bufbuild/connect-es",
+		"v0.8.1",
+		registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_NPM,
+		"@buf/acme_weather.bufbuild_connect-es",
+		"0.8.1-20230222163843-c806e06ce889.1",
+		"https://buf.build/gen/npm/v1",
+	)
+	testNewRemotePackage(
+		t,
+		"buf.build/bufbuild/connect-kotlin",
+		"v0.1.4",
+		registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_MAVEN,
+		"build.buf.gen:acme_weather_bufbuild_connect-kotlin",
+		"0.1.4.1.20230222163843.c806e06ce889",
+		"https://buf.build/gen/maven",
+	)
+	testNewRemotePackage(
+		t,
+		"buf.build/bufbuild/connect-swift",
+		"v0.3.0",
+		registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_SWIFT,
+		"acme_weather_bufbuild_connect-swift",
+		"0.3.0-20230222163843-c806e06ce889.1",
+		"https://buf.build/gen/swift",
+	)
+}
+
+func TestNewRemotePackageError(t *testing.T) {
+	t.Parallel()
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.build/acme/weather")
+	require.NoError(t, err)
+	pluginIdentity, err := bufpluginref.PluginIdentityForString("buf.build/protocolbuffers/cpp")
+	require.NoError(t, err)
+	_, err = NewRemotePackage(
+		moduleIdentity,
+		"c806e06ce8894ef6a41e8d40e2d1e54b",
+		time.Now(),
+		pluginIdentity,
+		"v21.9",
+		1,
+		registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_UNSPECIFIED,
+	)
+	assert.Error(t, err)
+	_, err = NewRemotePackage(
+		moduleIdentity,
+		"c806e06",
+		time.Now(),
+		pluginIdentity,
+		"v21.9",
+		1,
+		registryv1alpha1.PluginRegistryType_PLUGIN_REGISTRY_TYPE_GO,
+	)
+	assert.Error(t, err)
+}
+
+func testNewRemotePackage(
+	t *testing.T,
+	plugin string,
+	pluginVersion string,
+	registryType registryv1alpha1.PluginRegistryType,
+	expectedName string,
+	expectedVersion string,
+	expectedRegistryURL string,
+) {
+	moduleIdentity, err := bufmoduleref.ModuleIdentityForString("buf.build/acme/weather")
+	require.NoError(t, err)
+	pluginIdentity, err := bufpluginref.PluginIdentityForString(plugin)
+	require.NoError(t, err)
+	// Commit times are converted to UTC.
+	commitTime := time.Date(2023, time.February, 22, 11, 38, 43, 0, time.FixedZone("EST", -5*60*60))
+	remotePackage, err := NewRemotePackage(
+		moduleIdentity,
+		"c806e06ce8894ef6a41e8d40e2d1e54b",
+		commitTime,
+		pluginIdentity,
+		pluginVersion,
+		1,
+		registryType,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, registryType, remotePackage.RegistryType())
+	assert.Equal(t, expectedName, remotePackage.Name())
+	assert.Equal(t, expectedVersion, remotePackage.Version())
+	assert.Equal(t, expectedRegistryURL, remotePackage.RegistryURL())
+}
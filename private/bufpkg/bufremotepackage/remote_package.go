@@ -0,0 +1,54 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufremotepackage
+
+import registryv1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/registry/v1alpha1"
+
+type remotePackage struct {
+	registryType registryv1alpha1.PluginRegistryType
+	name         string
+	version      string
+	registryURL  string
+}
+
+func newRemotePackage(
+	registryType registryv1alpha1.PluginRegistryType,
+	name string,
+	version string,
+	registryURL string,
+) *remotePackage {
+	return &remotePackage{
+		registryType: registryType,
+		name:         name,
+		version:      version,
+		registryURL:  registryURL,
+	}
+}
+
+func (r *remotePackage) RegistryType() registryv1alpha1.PluginRegistryType {
+	return r.registryType
+}
+
+func (r *remotePackage) Name() string {
+	return r.name
+}
+
+func (r *remotePackage) Version() string {
+	return r.version
+}
+
+func (r *remotePackage) RegistryURL() string {
+	return r.registryURL
+}
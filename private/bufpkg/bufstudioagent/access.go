@@ -0,0 +1,96 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufstudioagent
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type requestInfoContextKey struct{}
+
+// requestInfo is filled in by the handlers with information about the
+// request that is only known once the request has been handled.
+type requestInfo struct {
+	// target is the host of the target server, if any.
+	target string
+	// upstreamError is the error code of a failed upstream request, if any.
+	upstreamError string
+}
+
+// requestInfoFromContext returns the requestInfo for the request, or a
+// requestInfo that is discarded if the request is not wrapped by an access handler.
+func requestInfoFromContext(ctx context.Context) *requestInfo {
+	if info, ok := ctx.Value(requestInfoContextKey{}).(*requestInfo); ok {
+		return info
+	}
+	return &requestInfo{}
+}
+
+// newAccessHandler returns a handler that writes an access log entry for every
+// request, and records the request in metrics if metrics is non-nil.
+func newAccessHandler(logger *zap.Logger, metrics *metrics, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		info := &requestInfo{}
+		recorder := &statusRecorder{
+			ResponseWriter: w,
+			status:         http.StatusOK,
+		}
+		next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), requestInfoContextKey{}, info)))
+		duration := time.Since(start)
+		fields := []zap.Field{
+			zap.String("method", r.Method),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.String("origin", r.Header.Get("Origin")),
+			zap.Int("status", recorder.status),
+			zap.Duration("duration", duration),
+		}
+		if info.target != "" {
+			fields = append(fields, zap.String("target", info.target))
+		}
+		if info.upstreamError != "" {
+			fields = append(fields, zap.String("upstream_error", info.upstreamError))
+		}
+		logger.Info("access", fields...)
+		if metrics != nil {
+			metrics.observe(info.target, recorder.status, duration, info.upstreamError)
+		}
+	})
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+
+	status      int
+	wroteHeader bool
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	if !s.wroteHeader {
+		s.status = status
+		s.wroteHeader = true
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(data []byte) (int, error) {
+	s.wroteHeader = true
+	return s.ResponseWriter.Write(data)
+}
@@ -31,9 +31,14 @@ func NewHandler(
 	disallowedHeaders map[string]struct{},
 	forwardHeaders map[string]string,
 	privateNetwork bool,
+	options ...HandlerOption,
 ) http.Handler {
+	handlerOptions := newHandlerOptions()
+	for _, option := range options {
+		option(handlerOptions)
+	}
 	corsHandlerOptions := cors.Options{
-		AllowedOrigins:   []string{origin},
+		AllowedOrigins:   append([]string{origin}, handlerOptions.allowedOrigins...),
 		AllowedMethods:   []string{http.MethodPost, http.MethodOptions},
 		AllowCredentials: true,
 	}
@@ -41,9 +46,25 @@ func NewHandler(
 		corsHandlerOptions.AllowPrivateNetwork = true
 	}
 	corsHandler := cors.New(corsHandlerOptions)
-	plainHandler := corsHandler.Handler(newPlainPostHandler(logger, disallowedHeaders, forwardHeaders, tlsClientConfig))
+	plainHandler := corsHandler.Handler(
+		newPlainPostHandler(
+			logger,
+			disallowedHeaders,
+			forwardHeaders,
+			tlsClientConfig,
+			handlerOptions.allowedTargetHosts,
+			handlerOptions.strippedHeaders,
+		),
+	)
+	var handlerMetrics *metrics
+	if handlerOptions.metrics {
+		handlerMetrics = newMetrics()
+	}
 	mux := http.NewServeMux()
-	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+	if handlerMetrics != nil {
+		mux.Handle("/metrics", handlerMetrics)
+	}
+	mux.Handle("/", newAccessHandler(logger, handlerMetrics, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
 			// In the future we could check for an upgrade header here.
@@ -62,6 +83,61 @@ func NewHandler(
 			http.Error(w, "", http.StatusMethodNotAllowed)
 			return
 		}
-	})
+	})))
 	return mux
 }
+
+// HandlerOption is an option for a new handler.
+type HandlerOption func(*handlerOptions)
+
+// HandlerWithAllowedOrigins returns a new HandlerOption that allows the given
+// origins for CORS, in addition to the origin passed to NewHandler.
+func HandlerWithAllowedOrigins(allowedOrigins []string) HandlerOption {
+	return func(handlerOptions *handlerOptions) {
+		handlerOptions.allowedOrigins = allowedOrigins
+	}
+}
+
+// HandlerWithAllowedTargetHosts returns a new HandlerOption that restricts the
+// targets that requests are forwarded to.
+//
+// Each host is a hostname, optionally with a port such as "example.com:8443". A
+// hostname starting with "*." matches any subdomain. If no port is given, any port
+// is allowed. The default is to allow all targets.
+func HandlerWithAllowedTargetHosts(allowedTargetHosts []string) HandlerOption {
+	return func(handlerOptions *handlerOptions) {
+		handlerOptions.allowedTargetHosts = allowedTargetHosts
+	}
+}
+
+// HandlerWithStrippedHeaders returns a new HandlerOption that removes the given
+// headers from enveloped requests before they are forwarded to the target server.
+//
+// Unlike disallowed headers, requests with these headers set are not rejected.
+func HandlerWithStrippedHeaders(strippedHeaders []string) HandlerOption {
+	return func(handlerOptions *handlerOptions) {
+		handlerOptions.strippedHeaders = strippedHeaders
+	}
+}
+
+// HandlerWithMetrics returns a new HandlerOption that serves request counts,
+// latencies, and upstream errors by target on "/metrics" in the Prometheus text
+// exposition format.
+//
+// Every request is written to the access log regardless of this option.
+func HandlerWithMetrics() HandlerOption {
+	return func(handlerOptions *handlerOptions) {
+		handlerOptions.metrics = true
+	}
+}
+
+type handlerOptions struct {
+	allowedOrigins     []string
+	allowedTargetHosts []string
+	strippedHeaders    []string
+	metrics            bool
+}
+
+func newHandlerOptions() *handlerOptions {
+	return &handlerOptions{}
+}
@@ -24,6 +24,7 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"testing"
 
@@ -219,6 +220,116 @@ func testPlainPostHandlerErrors(t *testing.T, upstreamServer *httptest.Server) {
 	})
 }
 
+func TestPlainPostHandlerOptions(t *testing.T) {
+	upstreamServer := newTestConnectServer(t, false)
+	defer upstreamServer.Close()
+	newAgentServer := func(allowedTargetHosts []string) *httptest.Server {
+		return httptest.NewTLSServer(
+			NewHandler(
+				zaptest.NewLogger(t),
+				"https://example.buf.build",
+				nil,
+				nil,
+				nil,
+				false,
+				HandlerWithAllowedOrigins([]string{"https://studio.example.com"}),
+				HandlerWithAllowedTargetHosts(allowedTargetHosts),
+				HandlerWithStrippedHeaders([]string{"strip-me"}),
+			),
+		)
+	}
+	invoke := func(t *testing.T, agentServer *httptest.Server) *http.Response {
+		requestProto := &studiov1alpha1.InvokeRequest{
+			Target: upstreamServer.URL + echoPath,
+			Headers: goHeadersToProtoHeaders(http.Header{
+				"Content-Type": []string{"application/proto"},
+				"Strip-Me":     []string{"secret"},
+				"Keep-Me":      []string{"value"},
+			}),
+			Body: []byte("echothis"),
+		}
+		request, err := http.NewRequest(http.MethodPost, agentServer.URL, bytes.NewReader(protoMarshalBase64(t, requestProto)))
+		require.NoError(t, err)
+		request.Header.Set("Content-Type", "text/plain")
+		request.Header.Set("Origin", "https://studio.example.com")
+		response, err := agentServer.Client().Do(request)
+		require.NoError(t, err)
+		return response
+	}
+
+	t.Run("allowed_target_host", func(t *testing.T) {
+		agentServer := newAgentServer([]string{"example.com", "127.0.0.1"})
+		defer agentServer.Close()
+		response := invoke(t, agentServer)
+		defer response.Body.Close()
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, "https://studio.example.com", response.Header.Get("Access-Control-Allow-Origin"))
+		responseBytes, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		invokeResponse := &studiov1alpha1.InvokeResponse{}
+		protoUnmarshalBase64(t, responseBytes, invokeResponse)
+		upstreamResponseHeaders := make(http.Header)
+		addProtoHeadersToGoHeader(invokeResponse.Headers, upstreamResponseHeaders)
+		assert.Equal(t, "value", upstreamResponseHeaders.Get("Echo-Keep-Me"))
+		assert.Empty(t, upstreamResponseHeaders.Values("Echo-Strip-Me"))
+	})
+
+	t.Run("disallowed_target_host", func(t *testing.T) {
+		agentServer := newAgentServer([]string{"example.com", "*.example.com", "127.0.0.1:1"})
+		defer agentServer.Close()
+		response := invoke(t, agentServer)
+		defer response.Body.Close()
+		assert.Equal(t, http.StatusForbidden, response.StatusCode)
+	})
+}
+
+func TestPlainPostHandlerMetrics(t *testing.T) {
+	upstreamServer := newTestConnectServer(t, false)
+	defer upstreamServer.Close()
+	agentServer := httptest.NewTLSServer(
+		NewHandler(
+			zaptest.NewLogger(t),
+			"https://example.buf.build",
+			nil,
+			nil,
+			nil,
+			false,
+			HandlerWithMetrics(),
+		),
+	)
+	defer agentServer.Close()
+	upstreamURL, err := url.Parse(upstreamServer.URL)
+	require.NoError(t, err)
+	for _, path := range []string{echoPath, errorPath} {
+		requestProto := &studiov1alpha1.InvokeRequest{
+			Target: upstreamServer.URL + path,
+			Headers: goHeadersToProtoHeaders(http.Header{
+				"Content-Type": []string{"application/proto"},
+			}),
+			Body: []byte("body"),
+		}
+		request, err := http.NewRequest(http.MethodPost, agentServer.URL, bytes.NewReader(protoMarshalBase64(t, requestProto)))
+		require.NoError(t, err)
+		request.Header.Set("Content-Type", "text/plain")
+		request.Header.Set("Origin", "https://example.buf.build")
+		response, err := agentServer.Client().Do(request)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	}
+	response, err := agentServer.Client().Get(agentServer.URL + "/metrics")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	metricsBytes, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	metricsString := string(metricsBytes)
+	target := strconv.Quote(upstreamURL.Host)
+	assert.Contains(t, metricsString, "buf_studio_agent_requests_total{target="+target+`,code="200"} 2`)
+	assert.Contains(t, metricsString, "buf_studio_agent_request_duration_seconds_count{target="+target+"} 2")
+	assert.Contains(t, metricsString, "buf_studio_agent_upstream_errors_total{target="+target+`,code="failed_precondition"} 1`)
+}
+
 func newTestConnectServer(t *testing.T, tls bool) *httptest.Server {
 	mux := http.NewServeMux()
 	// echoPath echoes all incoming headers (prefixed with "Echo-") and the
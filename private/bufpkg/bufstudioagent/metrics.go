@@ -0,0 +1,161 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bufstudioagent
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// durationBuckets are the upper bounds of the request duration histogram buckets, in seconds.
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// metrics collects request metrics for the agent and serves them in the
+// Prometheus text exposition format.
+type metrics struct {
+	lock sync.Mutex
+	// target -> status code -> count
+	requests map[string]map[string]uint64
+	// target -> duration histogram
+	durations map[string]*histogram
+	// target -> upstream error code -> count
+	upstreamErrors map[string]map[string]uint64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests:       make(map[string]map[string]uint64),
+		durations:      make(map[string]*histogram),
+		upstreamErrors: make(map[string]map[string]uint64),
+	}
+}
+
+func (m *metrics) observe(target string, status int, duration time.Duration, upstreamError string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	incrementCounter(m.requests, target, strconv.Itoa(status))
+	durationHistogram, ok := m.durations[target]
+	if !ok {
+		durationHistogram = newHistogram(durationBuckets)
+		m.durations[target] = durationHistogram
+	}
+	durationHistogram.observe(duration.Seconds())
+	if upstreamError != "" {
+		incrementCounter(m.upstreamErrors, target, upstreamError)
+	}
+}
+
+func (m *metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	_ = m.write(w)
+}
+
+func (m *metrics) write(writer io.Writer) error {
+	var builder strings.Builder
+	builder.WriteString("# HELP buf_studio_agent_requests_total The number of requests handled by the agent.\n")
+	builder.WriteString("# TYPE buf_studio_agent_requests_total counter\n")
+	writeCounter(&builder, "buf_studio_agent_requests_total", "code", m.requests)
+	builder.WriteString("# HELP buf_studio_agent_request_duration_seconds The duration of requests handled by the agent.\n")
+	builder.WriteString("# TYPE buf_studio_agent_request_duration_seconds histogram\n")
+	for _, target := range sortedKeys(m.durations) {
+		m.durations[target].write(&builder, "buf_studio_agent_request_duration_seconds", target)
+	}
+	builder.WriteString("# HELP buf_studio_agent_upstream_errors_total The number of failed requests to target servers.\n")
+	builder.WriteString("# TYPE buf_studio_agent_upstream_errors_total counter\n")
+	writeCounter(&builder, "buf_studio_agent_upstream_errors_total", "code", m.upstreamErrors)
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
+
+type histogram struct {
+	buckets []float64
+	// counts[i] is the number of observations <= buckets[i], not cumulative.
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)),
+	}
+}
+
+func (h *histogram) observe(value float64) {
+	h.count++
+	h.sum += value
+	for i, bucket := range h.buckets {
+		if value <= bucket {
+			h.counts[i]++
+			return
+		}
+	}
+}
+
+func (h *histogram) write(builder *strings.Builder, name string, target string) {
+	var cumulative uint64
+	for i, bucket := range h.buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(builder, "%s_bucket{target=%s,le=\"%s\"} %d\n", name, quoteLabelValue(target), strconv.FormatFloat(bucket, 'g', -1, 64), cumulative)
+	}
+	fmt.Fprintf(builder, "%s_bucket{target=%s,le=\"+Inf\"} %d\n", name, quoteLabelValue(target), h.count)
+	fmt.Fprintf(builder, "%s_sum{target=%s} %s\n", name, quoteLabelValue(target), strconv.FormatFloat(h.sum, 'g', -1, 64))
+	fmt.Fprintf(builder, "%s_count{target=%s} %d\n", name, quoteLabelValue(target), h.count)
+}
+
+func incrementCounter(counter map[string]map[string]uint64, target string, label string) {
+	labelToCount, ok := counter[target]
+	if !ok {
+		labelToCount = make(map[string]uint64)
+		counter[target] = labelToCount
+	}
+	labelToCount[label]++
+}
+
+func writeCounter(builder *strings.Builder, name string, labelName string, counter map[string]map[string]uint64) {
+	for _, target := range sortedKeys(counter) {
+		labelToCount := counter[target]
+		for _, label := range sortedKeys(labelToCount) {
+			fmt.Fprintf(builder, "%s{target=%s,%s=%s} %d\n", name, quoteLabelValue(target), labelName, quoteLabelValue(label), labelToCount[label])
+		}
+	}
+}
+
+// quoteLabelValue quotes a label value per the Prometheus text exposition format.
+func quoteLabelValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"net/textproto"
 	"net/url"
+	"strings"
 
 	studiov1alpha1 "github.com/bufbuild/buf/private/gen/proto/go/buf/alpha/studio/v1alpha1"
 	"github.com/bufbuild/buf/private/pkg/protoencoding"
@@ -57,6 +58,9 @@ type plainPostHandler struct {
 	H2CClient           *http.Client
 	DisallowedHeaders   map[string]struct{}
 	ForwardHeaders      map[string]string
+	// If empty, all target hosts are allowed.
+	AllowedTargetHosts []string
+	StrippedHeaders    map[string]struct{}
 }
 
 func newPlainPostHandler(
@@ -64,6 +68,8 @@ func newPlainPostHandler(
 	disallowedHeaders map[string]struct{},
 	forwardHeaders map[string]string,
 	tlsClientConfig *tls.Config,
+	allowedTargetHosts []string,
+	strippedHeaders []string,
 ) *plainPostHandler {
 	canonicalDisallowedHeaders := make(map[string]struct{}, len(disallowedHeaders))
 	for k := range disallowedHeaders {
@@ -73,10 +79,16 @@ func newPlainPostHandler(
 	for k, v := range forwardHeaders {
 		canonicalForwardHeaders[textproto.CanonicalMIMEHeaderKey(k)] = v
 	}
+	canonicalStrippedHeaders := make(map[string]struct{}, len(strippedHeaders))
+	for _, k := range strippedHeaders {
+		canonicalStrippedHeaders[textproto.CanonicalMIMEHeaderKey(k)] = struct{}{}
+	}
 	return &plainPostHandler{
-		B64Encoding:       base64.StdEncoding,
-		DisallowedHeaders: canonicalDisallowedHeaders,
-		ForwardHeaders:    canonicalForwardHeaders,
+		B64Encoding:        base64.StdEncoding,
+		DisallowedHeaders:  canonicalDisallowedHeaders,
+		ForwardHeaders:     canonicalForwardHeaders,
+		AllowedTargetHosts: allowedTargetHosts,
+		StrippedHeaders:    canonicalStrippedHeaders,
 		H2CClient: &http.Client{
 			Transport: &http2.Transport{
 				AllowHTTP: true,
@@ -129,6 +141,9 @@ func (i *plainPostHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, fmt.Sprintf("header %q disallowed by agent", header.Key), http.StatusBadRequest)
 			return
 		}
+		if _, ok := i.StrippedHeaders[textproto.CanonicalMIMEHeaderKey(header.Key)]; ok {
+			continue
+		}
 		for _, value := range header.Value {
 			request.Header().Add(header.Key, value)
 		}
@@ -147,6 +162,8 @@ func (i *plainPostHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	info := requestInfoFromContext(r.Context())
+	info.target = targetURL.Host
 	var httpClient *http.Client
 	switch targetURL.Scheme {
 	case "http":
@@ -157,6 +174,10 @@ func (i *plainPostHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, fmt.Sprintf("must specify http or https url scheme, got %q", targetURL.Scheme), http.StatusBadRequest)
 		return
 	}
+	if !i.isTargetHostAllowed(targetURL) {
+		http.Error(w, fmt.Sprintf("target host %q not allowed by agent", targetURL.Host), http.StatusForbidden)
+		return
+	}
 	clientOptions, err := connectClientOptionsFromContentType(request.Header().Get("Content-Type"))
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -179,7 +200,9 @@ func (i *plainPostHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		// request never left the client, or a response never arrived from the
 		// server. In those scenarios we trigger a `StatusBadGateway` to signal
 		// that the upstream server is unreachable or in a bad status...
+		info.upstreamError = connect.CodeOf(err).String()
 		if !connect.IsWireError(err) {
+			info.upstreamError = "unreachable"
 			http.Error(w, err.Error(), http.StatusBadGateway)
 			return
 		}
@@ -213,6 +236,44 @@ func (i *plainPostHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// isTargetHostAllowed returns true if the target URL matches one of the
+// allowed target hosts, or if there are no allowed target hosts.
+func (i *plainPostHandler) isTargetHostAllowed(targetURL *url.URL) bool {
+	if len(i.AllowedTargetHosts) == 0 {
+		return true
+	}
+	hostname := strings.ToLower(targetURL.Hostname())
+	port := targetURL.Port()
+	if port == "" {
+		switch targetURL.Scheme {
+		case "http":
+			port = "80"
+		case "https":
+			port = "443"
+		}
+	}
+	for _, allowedTargetHost := range i.AllowedTargetHosts {
+		allowedHostname, allowedPort := allowedTargetHost, ""
+		if splitHostname, splitPort, err := net.SplitHostPort(allowedTargetHost); err == nil {
+			allowedHostname, allowedPort = splitHostname, splitPort
+		}
+		if allowedPort != "" && allowedPort != port {
+			continue
+		}
+		allowedHostname = strings.ToLower(allowedHostname)
+		if strings.HasPrefix(allowedHostname, "*.") {
+			if strings.HasSuffix(hostname, allowedHostname[1:]) {
+				return true
+			}
+			continue
+		}
+		if hostname == allowedHostname {
+			return true
+		}
+	}
+	return false
+}
+
 func connectClientOptionsFromContentType(contentType string) ([]connect.ClientOption, error) {
 	switch contentType {
 	case "application/grpc", "application/grpc+proto":
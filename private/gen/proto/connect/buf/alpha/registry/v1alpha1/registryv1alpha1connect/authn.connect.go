@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !connectrpc
+
 // Code generated by protoc-gen-connect-go. DO NOT EDIT.
 //
 // Source: buf/alpha/registry/v1alpha1/authn.proto
@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !connectrpc
+
 // Code generated by protoc-gen-connect-go. DO NOT EDIT.
 //
 // Source: buf/alpha/registry/v1alpha1/push.proto
@@ -52,6 +54,9 @@ const (
 	// PushServicePushManifestAndBlobsProcedure is the fully-qualified name of the PushService's
 	// PushManifestAndBlobs RPC.
 	PushServicePushManifestAndBlobsProcedure = "/buf.alpha.registry.v1alpha1.PushService/PushManifestAndBlobs"
+	// PushServiceValidatePushManifestAndBlobsProcedure is the fully-qualified name of the PushService's
+	// ValidatePushManifestAndBlobs RPC.
+	PushServiceValidatePushManifestAndBlobsProcedure = "/buf.alpha.registry.v1alpha1.PushService/ValidatePushManifestAndBlobs"
 )
 
 // PushServiceClient is a client for the buf.alpha.registry.v1alpha1.PushService service.
@@ -61,6 +66,10 @@ type PushServiceClient interface {
 	Push(context.Context, *connect_go.Request[v1alpha1.PushRequest]) (*connect_go.Response[v1alpha1.PushResponse], error)
 	// PushManifestAndBlobs pushes a module by encoding it in a manifest and blobs format.
 	PushManifestAndBlobs(context.Context, *connect_go.Request[v1alpha1.PushManifestAndBlobsRequest]) (*connect_go.Response[v1alpha1.PushManifestAndBlobsResponse], error)
+	// ValidatePushManifestAndBlobs checks that PushManifestAndBlobs would succeed for
+	// the request, including authorization, breaking change policies, and size limits,
+	// without creating a commit or tags.
+	ValidatePushManifestAndBlobs(context.Context, *connect_go.Request[v1alpha1.ValidatePushManifestAndBlobsRequest]) (*connect_go.Response[v1alpha1.ValidatePushManifestAndBlobsResponse], error)
 }
 
 // NewPushServiceClient constructs a client for the buf.alpha.registry.v1alpha1.PushService service.
@@ -83,13 +92,19 @@ func NewPushServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts
 			baseURL+PushServicePushManifestAndBlobsProcedure,
 			opts...,
 		),
+		validatePushManifestAndBlobs: connect_go.NewClient[v1alpha1.ValidatePushManifestAndBlobsRequest, v1alpha1.ValidatePushManifestAndBlobsResponse](
+			httpClient,
+			baseURL+PushServiceValidatePushManifestAndBlobsProcedure,
+			opts...,
+		),
 	}
 }
 
 // pushServiceClient implements PushServiceClient.
 type pushServiceClient struct {
-	push                 *connect_go.Client[v1alpha1.PushRequest, v1alpha1.PushResponse]
-	pushManifestAndBlobs *connect_go.Client[v1alpha1.PushManifestAndBlobsRequest, v1alpha1.PushManifestAndBlobsResponse]
+	push                         *connect_go.Client[v1alpha1.PushRequest, v1alpha1.PushResponse]
+	pushManifestAndBlobs         *connect_go.Client[v1alpha1.PushManifestAndBlobsRequest, v1alpha1.PushManifestAndBlobsResponse]
+	validatePushManifestAndBlobs *connect_go.Client[v1alpha1.ValidatePushManifestAndBlobsRequest, v1alpha1.ValidatePushManifestAndBlobsResponse]
 }
 
 // Push calls buf.alpha.registry.v1alpha1.PushService.Push.
@@ -102,6 +117,12 @@ func (c *pushServiceClient) PushManifestAndBlobs(ctx context.Context, req *conne
 	return c.pushManifestAndBlobs.CallUnary(ctx, req)
 }
 
+// ValidatePushManifestAndBlobs calls
+// buf.alpha.registry.v1alpha1.PushService.ValidatePushManifestAndBlobs.
+func (c *pushServiceClient) ValidatePushManifestAndBlobs(ctx context.Context, req *connect_go.Request[v1alpha1.ValidatePushManifestAndBlobsRequest]) (*connect_go.Response[v1alpha1.ValidatePushManifestAndBlobsResponse], error) {
+	return c.validatePushManifestAndBlobs.CallUnary(ctx, req)
+}
+
 // PushServiceHandler is an implementation of the buf.alpha.registry.v1alpha1.PushService service.
 type PushServiceHandler interface {
 	// Push pushes.
@@ -109,6 +130,10 @@ type PushServiceHandler interface {
 	Push(context.Context, *connect_go.Request[v1alpha1.PushRequest]) (*connect_go.Response[v1alpha1.PushResponse], error)
 	// PushManifestAndBlobs pushes a module by encoding it in a manifest and blobs format.
 	PushManifestAndBlobs(context.Context, *connect_go.Request[v1alpha1.PushManifestAndBlobsRequest]) (*connect_go.Response[v1alpha1.PushManifestAndBlobsResponse], error)
+	// ValidatePushManifestAndBlobs checks that PushManifestAndBlobs would succeed for
+	// the request, including authorization, breaking change policies, and size limits,
+	// without creating a commit or tags.
+	ValidatePushManifestAndBlobs(context.Context, *connect_go.Request[v1alpha1.ValidatePushManifestAndBlobsRequest]) (*connect_go.Response[v1alpha1.ValidatePushManifestAndBlobsResponse], error)
 }
 
 // NewPushServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -128,6 +153,11 @@ func NewPushServiceHandler(svc PushServiceHandler, opts ...connect_go.HandlerOpt
 		svc.PushManifestAndBlobs,
 		opts...,
 	))
+	mux.Handle(PushServiceValidatePushManifestAndBlobsProcedure, connect_go.NewUnaryHandler(
+		PushServiceValidatePushManifestAndBlobsProcedure,
+		svc.ValidatePushManifestAndBlobs,
+		opts...,
+	))
 	return "/buf.alpha.registry.v1alpha1.PushService/", mux
 }
 
@@ -141,3 +171,7 @@ func (UnimplementedPushServiceHandler) Push(context.Context, *connect_go.Request
 func (UnimplementedPushServiceHandler) PushManifestAndBlobs(context.Context, *connect_go.Request[v1alpha1.PushManifestAndBlobsRequest]) (*connect_go.Response[v1alpha1.PushManifestAndBlobsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("buf.alpha.registry.v1alpha1.PushService.PushManifestAndBlobs is not implemented"))
 }
+
+func (UnimplementedPushServiceHandler) ValidatePushManifestAndBlobs(context.Context, *connect_go.Request[v1alpha1.ValidatePushManifestAndBlobsRequest]) (*connect_go.Response[v1alpha1.ValidatePushManifestAndBlobsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("buf.alpha.registry.v1alpha1.PushService.ValidatePushManifestAndBlobs is not implemented"))
+}
@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !connectrpc
+
 // Code generated by protoc-gen-connect-go. DO NOT EDIT.
 //
 // Source: buf/alpha/registry/v1alpha1/repository.proto
@@ -101,6 +103,9 @@ const (
 	// RepositoryServiceGetRepositoriesMetadataProcedure is the fully-qualified name of the
 	// RepositoryService's GetRepositoriesMetadata RPC.
 	RepositoryServiceGetRepositoriesMetadataProcedure = "/buf.alpha.registry.v1alpha1.RepositoryService/GetRepositoriesMetadata"
+	// RepositoryServiceMoveRepositoryByFullNameProcedure is the fully-qualified name of the
+	// RepositoryService's MoveRepositoryByFullName RPC.
+	RepositoryServiceMoveRepositoryByFullNameProcedure = "/buf.alpha.registry.v1alpha1.RepositoryService/MoveRepositoryByFullName"
 )
 
 // RepositoryServiceClient is a client for the buf.alpha.registry.v1alpha1.RepositoryService
@@ -147,6 +152,9 @@ type RepositoryServiceClient interface {
 	// request should match the length of the metadata in the response, and the order of repositories in the response
 	// should match the order of the metadata in the request.
 	GetRepositoriesMetadata(context.Context, *connect_go.Request[v1alpha1.GetRepositoriesMetadataRequest]) (*connect_go.Response[v1alpha1.GetRepositoriesMetadataResponse], error)
+	// MoveRepositoryByFullName renames a repository, moves it to another owner, or both.
+	// The previous full name redirects to the repository after the move.
+	MoveRepositoryByFullName(context.Context, *connect_go.Request[v1alpha1.MoveRepositoryByFullNameRequest]) (*connect_go.Response[v1alpha1.MoveRepositoryByFullNameResponse], error)
 }
 
 // NewRepositoryServiceClient constructs a client for the
@@ -261,6 +269,11 @@ func NewRepositoryServiceClient(httpClient connect_go.HTTPClient, baseURL string
 			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 			connect_go.WithClientOptions(opts...),
 		),
+		moveRepositoryByFullName: connect_go.NewClient[v1alpha1.MoveRepositoryByFullNameRequest, v1alpha1.MoveRepositoryByFullNameResponse](
+			httpClient,
+			baseURL+RepositoryServiceMoveRepositoryByFullNameProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -284,6 +297,7 @@ type repositoryServiceClient struct {
 	getRepositorySettings          *connect_go.Client[v1alpha1.GetRepositorySettingsRequest, v1alpha1.GetRepositorySettingsResponse]
 	updateRepositorySettingsByName *connect_go.Client[v1alpha1.UpdateRepositorySettingsByNameRequest, v1alpha1.UpdateRepositorySettingsByNameResponse]
 	getRepositoriesMetadata        *connect_go.Client[v1alpha1.GetRepositoriesMetadataRequest, v1alpha1.GetRepositoriesMetadataResponse]
+	moveRepositoryByFullName       *connect_go.Client[v1alpha1.MoveRepositoryByFullNameRequest, v1alpha1.MoveRepositoryByFullNameResponse]
 }
 
 // GetRepository calls buf.alpha.registry.v1alpha1.RepositoryService.GetRepository.
@@ -389,6 +403,12 @@ func (c *repositoryServiceClient) GetRepositoriesMetadata(ctx context.Context, r
 	return c.getRepositoriesMetadata.CallUnary(ctx, req)
 }
 
+// MoveRepositoryByFullName calls
+// buf.alpha.registry.v1alpha1.RepositoryService.MoveRepositoryByFullName.
+func (c *repositoryServiceClient) MoveRepositoryByFullName(ctx context.Context, req *connect_go.Request[v1alpha1.MoveRepositoryByFullNameRequest]) (*connect_go.Response[v1alpha1.MoveRepositoryByFullNameResponse], error) {
+	return c.moveRepositoryByFullName.CallUnary(ctx, req)
+}
+
 // RepositoryServiceHandler is an implementation of the
 // buf.alpha.registry.v1alpha1.RepositoryService service.
 type RepositoryServiceHandler interface {
@@ -433,6 +453,9 @@ type RepositoryServiceHandler interface {
 	// request should match the length of the metadata in the response, and the order of repositories in the response
 	// should match the order of the metadata in the request.
 	GetRepositoriesMetadata(context.Context, *connect_go.Request[v1alpha1.GetRepositoriesMetadataRequest]) (*connect_go.Response[v1alpha1.GetRepositoriesMetadataResponse], error)
+	// MoveRepositoryByFullName renames a repository, moves it to another owner, or both.
+	// The previous full name redirects to the repository after the move.
+	MoveRepositoryByFullName(context.Context, *connect_go.Request[v1alpha1.MoveRepositoryByFullNameRequest]) (*connect_go.Response[v1alpha1.MoveRepositoryByFullNameResponse], error)
 }
 
 // NewRepositoryServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -543,6 +566,11 @@ func NewRepositoryServiceHandler(svc RepositoryServiceHandler, opts ...connect_g
 		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 		connect_go.WithHandlerOptions(opts...),
 	))
+	mux.Handle(RepositoryServiceMoveRepositoryByFullNameProcedure, connect_go.NewUnaryHandler(
+		RepositoryServiceMoveRepositoryByFullNameProcedure,
+		svc.MoveRepositoryByFullName,
+		opts...,
+	))
 	return "/buf.alpha.registry.v1alpha1.RepositoryService/", mux
 }
 
@@ -620,3 +648,7 @@ func (UnimplementedRepositoryServiceHandler) UpdateRepositorySettingsByName(cont
 func (UnimplementedRepositoryServiceHandler) GetRepositoriesMetadata(context.Context, *connect_go.Request[v1alpha1.GetRepositoriesMetadataRequest]) (*connect_go.Response[v1alpha1.GetRepositoriesMetadataResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("buf.alpha.registry.v1alpha1.RepositoryService.GetRepositoriesMetadata is not implemented"))
 }
+
+func (UnimplementedRepositoryServiceHandler) MoveRepositoryByFullName(context.Context, *connect_go.Request[v1alpha1.MoveRepositoryByFullNameRequest]) (*connect_go.Response[v1alpha1.MoveRepositoryByFullNameResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("buf.alpha.registry.v1alpha1.RepositoryService.MoveRepositoryByFullName is not implemented"))
+}
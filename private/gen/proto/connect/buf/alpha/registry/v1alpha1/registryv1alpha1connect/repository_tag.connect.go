@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !connectrpc
+
 // Code generated by protoc-gen-connect-go. DO NOT EDIT.
 //
 // Source: buf/alpha/registry/v1alpha1/repository_tag.proto
@@ -53,6 +55,9 @@ const (
 	// RepositoryTagServiceListRepositoryTagsProcedure is the fully-qualified name of the
 	// RepositoryTagService's ListRepositoryTags RPC.
 	RepositoryTagServiceListRepositoryTagsProcedure = "/buf.alpha.registry.v1alpha1.RepositoryTagService/ListRepositoryTags"
+	// RepositoryTagServiceAnnotateRepositoryTagProcedure is the fully-qualified name of the
+	// RepositoryTagService's AnnotateRepositoryTag RPC.
+	RepositoryTagServiceAnnotateRepositoryTagProcedure = "/buf.alpha.registry.v1alpha1.RepositoryTagService/AnnotateRepositoryTag"
 )
 
 // RepositoryTagServiceClient is a client for the buf.alpha.registry.v1alpha1.RepositoryTagService
@@ -62,6 +67,8 @@ type RepositoryTagServiceClient interface {
 	CreateRepositoryTag(context.Context, *connect_go.Request[v1alpha1.CreateRepositoryTagRequest]) (*connect_go.Response[v1alpha1.CreateRepositoryTagResponse], error)
 	// ListRepositoryTags lists the repository tags associated with a Repository.
 	ListRepositoryTags(context.Context, *connect_go.Request[v1alpha1.ListRepositoryTagsRequest]) (*connect_go.Response[v1alpha1.ListRepositoryTagsResponse], error)
+	// AnnotateRepositoryTag sets the message annotating an existing repository tag.
+	AnnotateRepositoryTag(context.Context, *connect_go.Request[v1alpha1.AnnotateRepositoryTagRequest]) (*connect_go.Response[v1alpha1.AnnotateRepositoryTagResponse], error)
 }
 
 // NewRepositoryTagServiceClient constructs a client for the
@@ -86,13 +93,19 @@ func NewRepositoryTagServiceClient(httpClient connect_go.HTTPClient, baseURL str
 			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 			connect_go.WithClientOptions(opts...),
 		),
+		annotateRepositoryTag: connect_go.NewClient[v1alpha1.AnnotateRepositoryTagRequest, v1alpha1.AnnotateRepositoryTagResponse](
+			httpClient,
+			baseURL+RepositoryTagServiceAnnotateRepositoryTagProcedure,
+			opts...,
+		),
 	}
 }
 
 // repositoryTagServiceClient implements RepositoryTagServiceClient.
 type repositoryTagServiceClient struct {
-	createRepositoryTag *connect_go.Client[v1alpha1.CreateRepositoryTagRequest, v1alpha1.CreateRepositoryTagResponse]
-	listRepositoryTags  *connect_go.Client[v1alpha1.ListRepositoryTagsRequest, v1alpha1.ListRepositoryTagsResponse]
+	createRepositoryTag   *connect_go.Client[v1alpha1.CreateRepositoryTagRequest, v1alpha1.CreateRepositoryTagResponse]
+	listRepositoryTags    *connect_go.Client[v1alpha1.ListRepositoryTagsRequest, v1alpha1.ListRepositoryTagsResponse]
+	annotateRepositoryTag *connect_go.Client[v1alpha1.AnnotateRepositoryTagRequest, v1alpha1.AnnotateRepositoryTagResponse]
 }
 
 // CreateRepositoryTag calls buf.alpha.registry.v1alpha1.RepositoryTagService.CreateRepositoryTag.
@@ -105,6 +118,12 @@ func (c *repositoryTagServiceClient) ListRepositoryTags(ctx context.Context, req
 	return c.listRepositoryTags.CallUnary(ctx, req)
 }
 
+// AnnotateRepositoryTag calls
+// buf.alpha.registry.v1alpha1.RepositoryTagService.AnnotateRepositoryTag.
+func (c *repositoryTagServiceClient) AnnotateRepositoryTag(ctx context.Context, req *connect_go.Request[v1alpha1.AnnotateRepositoryTagRequest]) (*connect_go.Response[v1alpha1.AnnotateRepositoryTagResponse], error) {
+	return c.annotateRepositoryTag.CallUnary(ctx, req)
+}
+
 // RepositoryTagServiceHandler is an implementation of the
 // buf.alpha.registry.v1alpha1.RepositoryTagService service.
 type RepositoryTagServiceHandler interface {
@@ -112,6 +131,8 @@ type RepositoryTagServiceHandler interface {
 	CreateRepositoryTag(context.Context, *connect_go.Request[v1alpha1.CreateRepositoryTagRequest]) (*connect_go.Response[v1alpha1.CreateRepositoryTagResponse], error)
 	// ListRepositoryTags lists the repository tags associated with a Repository.
 	ListRepositoryTags(context.Context, *connect_go.Request[v1alpha1.ListRepositoryTagsRequest]) (*connect_go.Response[v1alpha1.ListRepositoryTagsResponse], error)
+	// AnnotateRepositoryTag sets the message annotating an existing repository tag.
+	AnnotateRepositoryTag(context.Context, *connect_go.Request[v1alpha1.AnnotateRepositoryTagRequest]) (*connect_go.Response[v1alpha1.AnnotateRepositoryTagResponse], error)
 }
 
 // NewRepositoryTagServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -132,6 +153,11 @@ func NewRepositoryTagServiceHandler(svc RepositoryTagServiceHandler, opts ...con
 		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 		connect_go.WithHandlerOptions(opts...),
 	))
+	mux.Handle(RepositoryTagServiceAnnotateRepositoryTagProcedure, connect_go.NewUnaryHandler(
+		RepositoryTagServiceAnnotateRepositoryTagProcedure,
+		svc.AnnotateRepositoryTag,
+		opts...,
+	))
 	return "/buf.alpha.registry.v1alpha1.RepositoryTagService/", mux
 }
 
@@ -145,3 +171,7 @@ func (UnimplementedRepositoryTagServiceHandler) CreateRepositoryTag(context.Cont
 func (UnimplementedRepositoryTagServiceHandler) ListRepositoryTags(context.Context, *connect_go.Request[v1alpha1.ListRepositoryTagsRequest]) (*connect_go.Response[v1alpha1.ListRepositoryTagsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("buf.alpha.registry.v1alpha1.RepositoryTagService.ListRepositoryTags is not implemented"))
 }
+
+func (UnimplementedRepositoryTagServiceHandler) AnnotateRepositoryTag(context.Context, *connect_go.Request[v1alpha1.AnnotateRepositoryTagRequest]) (*connect_go.Response[v1alpha1.AnnotateRepositoryTagResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("buf.alpha.registry.v1alpha1.RepositoryTagService.AnnotateRepositoryTag is not implemented"))
+}
@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !connectrpc
+
 // Code generated by protoc-gen-connect-go. DO NOT EDIT.
 //
 // Source: buf/alpha/registry/v1alpha1/resolve.proto
@@ -52,6 +54,9 @@ const (
 	// ResolveServiceGetModulePinsProcedure is the fully-qualified name of the ResolveService's
 	// GetModulePins RPC.
 	ResolveServiceGetModulePinsProcedure = "/buf.alpha.registry.v1alpha1.ResolveService/GetModulePins"
+	// ResolveServiceResolveImportPathsProcedure is the fully-qualified name of the ResolveService's
+	// ResolveImportPaths RPC.
+	ResolveServiceResolveImportPathsProcedure = "/buf.alpha.registry.v1alpha1.ResolveService/ResolveImportPaths"
 	// LocalResolveServiceGetLocalModulePinsProcedure is the fully-qualified name of the
 	// LocalResolveService's GetLocalModulePins RPC.
 	LocalResolveServiceGetLocalModulePinsProcedure = "/buf.alpha.registry.v1alpha1.LocalResolveService/GetLocalModulePins"
@@ -67,6 +72,11 @@ type ResolveServiceClient interface {
 	//
 	// This function also deals with tiebreaking what ModulePin wins for the same repository.
 	GetModulePins(context.Context, *connect_go.Request[v1alpha1.GetModulePinsRequest]) (*connect_go.Response[v1alpha1.GetModulePinsResponse], error)
+	// ResolveImportPaths finds the modules that contain files at the provided
+	// import paths.
+	//
+	// This allows clients to infer the dependencies of a module from its imports.
+	ResolveImportPaths(context.Context, *connect_go.Request[v1alpha1.ResolveImportPathsRequest]) (*connect_go.Response[v1alpha1.ResolveImportPathsResponse], error)
 }
 
 // NewResolveServiceClient constructs a client for the buf.alpha.registry.v1alpha1.ResolveService
@@ -85,12 +95,19 @@ func NewResolveServiceClient(httpClient connect_go.HTTPClient, baseURL string, o
 			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 			connect_go.WithClientOptions(opts...),
 		),
+		resolveImportPaths: connect_go.NewClient[v1alpha1.ResolveImportPathsRequest, v1alpha1.ResolveImportPathsResponse](
+			httpClient,
+			baseURL+ResolveServiceResolveImportPathsProcedure,
+			connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+			connect_go.WithClientOptions(opts...),
+		),
 	}
 }
 
 // resolveServiceClient implements ResolveServiceClient.
 type resolveServiceClient struct {
-	getModulePins *connect_go.Client[v1alpha1.GetModulePinsRequest, v1alpha1.GetModulePinsResponse]
+	getModulePins      *connect_go.Client[v1alpha1.GetModulePinsRequest, v1alpha1.GetModulePinsResponse]
+	resolveImportPaths *connect_go.Client[v1alpha1.ResolveImportPathsRequest, v1alpha1.ResolveImportPathsResponse]
 }
 
 // GetModulePins calls buf.alpha.registry.v1alpha1.ResolveService.GetModulePins.
@@ -98,6 +115,11 @@ func (c *resolveServiceClient) GetModulePins(ctx context.Context, req *connect_g
 	return c.getModulePins.CallUnary(ctx, req)
 }
 
+// ResolveImportPaths calls buf.alpha.registry.v1alpha1.ResolveService.ResolveImportPaths.
+func (c *resolveServiceClient) ResolveImportPaths(ctx context.Context, req *connect_go.Request[v1alpha1.ResolveImportPathsRequest]) (*connect_go.Response[v1alpha1.ResolveImportPathsResponse], error) {
+	return c.resolveImportPaths.CallUnary(ctx, req)
+}
+
 // ResolveServiceHandler is an implementation of the buf.alpha.registry.v1alpha1.ResolveService
 // service.
 type ResolveServiceHandler interface {
@@ -109,6 +131,11 @@ type ResolveServiceHandler interface {
 	//
 	// This function also deals with tiebreaking what ModulePin wins for the same repository.
 	GetModulePins(context.Context, *connect_go.Request[v1alpha1.GetModulePinsRequest]) (*connect_go.Response[v1alpha1.GetModulePinsResponse], error)
+	// ResolveImportPaths finds the modules that contain files at the provided
+	// import paths.
+	//
+	// This allows clients to infer the dependencies of a module from its imports.
+	ResolveImportPaths(context.Context, *connect_go.Request[v1alpha1.ResolveImportPathsRequest]) (*connect_go.Response[v1alpha1.ResolveImportPathsResponse], error)
 }
 
 // NewResolveServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -124,6 +151,12 @@ func NewResolveServiceHandler(svc ResolveServiceHandler, opts ...connect_go.Hand
 		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
 		connect_go.WithHandlerOptions(opts...),
 	))
+	mux.Handle(ResolveServiceResolveImportPathsProcedure, connect_go.NewUnaryHandler(
+		ResolveServiceResolveImportPathsProcedure,
+		svc.ResolveImportPaths,
+		connect_go.WithIdempotency(connect_go.IdempotencyNoSideEffects),
+		connect_go.WithHandlerOptions(opts...),
+	))
 	return "/buf.alpha.registry.v1alpha1.ResolveService/", mux
 }
 
@@ -134,6 +167,10 @@ func (UnimplementedResolveServiceHandler) GetModulePins(context.Context, *connec
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("buf.alpha.registry.v1alpha1.ResolveService.GetModulePins is not implemented"))
 }
 
+func (UnimplementedResolveServiceHandler) ResolveImportPaths(context.Context, *connect_go.Request[v1alpha1.ResolveImportPathsRequest]) (*connect_go.Response[v1alpha1.ResolveImportPathsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("buf.alpha.registry.v1alpha1.ResolveService.ResolveImportPaths is not implemented"))
+}
+
 // LocalResolveServiceClient is a client for the buf.alpha.registry.v1alpha1.LocalResolveService
 // service.
 type LocalResolveServiceClient interface {
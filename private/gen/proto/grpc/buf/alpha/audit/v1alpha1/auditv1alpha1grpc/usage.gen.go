@@ -0,0 +1,5 @@
+// Generated. DO NOT EDIT.
+
+package auditv1alpha1grpc
+
+import _ "github.com/bufbuild/buf/private/usage"
@@ -23,6 +23,7 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/bufbuild/buf/private/pkg/interrupt"
 )
@@ -189,6 +190,18 @@ func NewContainerForArgs(container Container, newArgs ...string) Container {
 	)
 }
 
+// NewContainerWithEnvOverrides returns a new Container with the environment variables
+// overridden by the values in overrides.
+func NewContainerWithEnvOverrides(container Container, overrides map[string]string) Container {
+	return newContainer(
+		NewEnvContainerWithOverrides(container, overrides),
+		container,
+		container,
+		container,
+		container,
+	)
+}
+
 // StdioContainer is a stdio container.
 type StdioContainer interface {
 	StdinContainer
@@ -271,6 +284,24 @@ func EnvBool(container EnvContainer, key string, defaultValue bool) (bool, error
 	return strconv.ParseBool(value)
 }
 
+// EnvDuration gets and parses the environment variable duration value for the key.
+//
+// Returns error on parsing error or if the duration is negative.
+func EnvDuration(container EnvContainer, key string, defaultValue time.Duration) (time.Duration, error) {
+	value := container.Env(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	if duration < 0 {
+		return 0, fmt.Errorf("invalid value for %s: duration must not be negative: %q", key, value)
+	}
+	return duration, nil
+}
+
 // IsDevStdin returns true if the path is the equivalent of /dev/stdin.
 func IsDevStdin(path string) bool {
 	return path != "" && path == DevStdinFilePath
@@ -16,6 +16,7 @@ package app
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -156,3 +157,24 @@ func TestEnvBool(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, true, val)
 }
+
+func TestEnvDuration(t *testing.T) {
+	envContainer := NewEnvContainer(
+		map[string]string{
+			"foo1": "bar1",
+			"foo2": "30s",
+			"foo3": "-1m",
+		},
+	)
+	val, err := EnvDuration(envContainer, "foo1", time.Minute)
+	assert.Error(t, err)
+	assert.Equal(t, time.Duration(0), val)
+	val, err = EnvDuration(envContainer, "foo2", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, val)
+	_, err = EnvDuration(envContainer, "foo3", time.Minute)
+	assert.Error(t, err)
+	val, err = EnvDuration(envContainer, "notset", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, val)
+}
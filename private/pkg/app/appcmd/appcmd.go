@@ -17,6 +17,7 @@ package appcmd
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -27,6 +28,11 @@ import (
 	"github.com/spf13/pflag"
 )
 
+const (
+	versionFormatText = "text"
+	versionFormatJSON = "json"
+)
+
 // Command is a command.
 type Command struct {
 	// Use is the one-line usage message.
@@ -64,14 +70,32 @@ type Command struct {
 	// SubCommands are the sub-commands. Optional.
 	// Must be unset if there is a run function.
 	SubCommands []*Command
+	// CompleteArgs returns the shell completion candidates for positional arguments. Optional.
+	CompleteArgs CompletionFunc
+	// CompleteFlags maps flag names to functions that return the shell completion
+	// candidates for the value of the flag. Optional.
+	//
+	// Every key must be the name of a flag bound by BindFlags or BindPersistentFlags.
+	CompleteFlags map[string]CompletionFunc
 	// Version the version of the command.
 	//
 	// If this is specified, a flag --version will be added to the command
 	// that precedes all other functionality, and which prints the version
 	// to stdout.
 	Version string
+	// VersionInfo returns structured version information. Optional.
+	//
+	// If this is specified along with Version, a flag --format is added to the command
+	// that controls how --version is printed. The format "text" prints Version, and the
+	// format "json" prints the value returned by VersionInfo marshalled as JSON.
+	VersionInfo func(app.EnvContainer) (interface{}, error)
 }
 
+// CompletionFunc returns the shell completion candidates for a partially typed value.
+//
+// If no candidates are returned, the shell falls back to its default file completion.
+type CompletionFunc func(ctx context.Context, container app.Container, toComplete string) []string
+
 // NewInvalidArgumentError creates a new invalidArgumentError, indicating that
 // the error was caused by argument validation. This causes us to print the usage
 // help text for the command that it is returned from.
@@ -298,6 +322,17 @@ func commandToCobra(
 	if command.NormalizePersistentFlag != nil {
 		cobraCommand.PersistentFlags().SetNormalizeFunc(normalizeFunc(command.NormalizePersistentFlag))
 	}
+	if command.CompleteArgs != nil {
+		cobraCommand.ValidArgsFunction = cobraCompletionFunc(ctx, container, command.CompleteArgs)
+	}
+	for flagName, completionFunc := range command.CompleteFlags {
+		if err := cobraCommand.RegisterFlagCompletionFunc(
+			flagName,
+			cobraCompletionFunc(ctx, container, completionFunc),
+		); err != nil {
+			return nil, err
+		}
+	}
 	if command.Run != nil {
 		cobraCommand.Run = func(_ *cobra.Command, args []string) {
 			runErr := command.Run(ctx, app.NewContainerForArgs(container, args...))
@@ -330,6 +365,7 @@ func commandToCobra(
 	}
 	if command.Version != "" {
 		doVersion := false
+		versionFormat := versionFormatText
 		oldRun := cobraCommand.Run
 		cobraCommand.Flags().BoolVar(
 			&doVersion,
@@ -337,10 +373,17 @@ func commandToCobra(
 			false,
 			"Print the version",
 		)
+		if command.VersionInfo != nil {
+			cobraCommand.Flags().StringVar(
+				&versionFormat,
+				"format",
+				versionFormatText,
+				fmt.Sprintf(`The format to print the version in with --version. Must be one of [%s,%s]`, versionFormatText, versionFormatJSON),
+			)
+		}
 		cobraCommand.Run = func(cmd *cobra.Command, args []string) {
 			if doVersion {
-				_, err := container.Stdout().Write([]byte(command.Version + "\n"))
-				*runErrAddr = err
+				*runErrAddr = printVersion(container, command, versionFormat)
 				return
 			}
 			oldRun(cmd, args)
@@ -351,6 +394,27 @@ func commandToCobra(
 	return cobraCommand, nil
 }
 
+func printVersion(container app.Container, command *Command, versionFormat string) error {
+	switch versionFormat {
+	case versionFormatText:
+		_, err := container.Stdout().Write([]byte(command.Version + "\n"))
+		return err
+	case versionFormatJSON:
+		versionInfo, err := command.VersionInfo(container)
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(versionInfo)
+		if err != nil {
+			return err
+		}
+		_, err = container.Stdout().Write(append(data, '\n'))
+		return err
+	default:
+		return NewInvalidArgumentErrorf("--format must be one of [%s,%s] but was %q", versionFormatText, versionFormatJSON, versionFormat)
+	}
+}
+
 func commandValidate(command *Command) error {
 	if command.Use == "" {
 		return errors.New("must set Command.Use")
@@ -364,9 +428,26 @@ func commandValidate(command *Command) error {
 	if command.Run == nil && len(command.SubCommands) == 0 {
 		return errors.New("must set one of Command.Run and Command.SubCommands")
 	}
+	if command.VersionInfo != nil && command.Version == "" {
+		return errors.New("must set Command.Version if Command.VersionInfo is set")
+	}
 	return nil
 }
 
+func cobraCompletionFunc(
+	ctx context.Context,
+	container app.Container,
+	completionFunc CompletionFunc,
+) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		completions := completionFunc(ctx, app.NewContainerForArgs(container, args...), toComplete)
+		if len(completions) == 0 {
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
 func normalizeFunc(f func(*pflag.FlagSet, string) string) func(*pflag.FlagSet, string) pflag.NormalizedName {
 	return func(flagSet *pflag.FlagSet, name string) pflag.NormalizedName {
 		return pflag.NormalizedName(f(flagSet, name))
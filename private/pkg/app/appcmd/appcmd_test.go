@@ -221,3 +221,57 @@ func TestIncorrectFlagEmptyStdout(t *testing.T) {
 	require.Empty(t, stdout.String())
 	require.NotEmpty(t, stderr.String())
 }
+
+func TestCompletion(t *testing.T) {
+	var bar string
+	rootCommand := &Command{
+		Use: "test",
+		SubCommands: []*Command{
+			{
+				Use: "sub",
+				BindFlags: func(flagSet *pflag.FlagSet) {
+					flagSet.StringVar(&bar, "bar", "", "Bar.")
+				},
+				Run: func(context.Context, app.Container) error {
+					return nil
+				},
+				CompleteArgs: func(_ context.Context, _ app.Container, toComplete string) []string {
+					return []string{toComplete + "one", toComplete + "two"}
+				},
+				CompleteFlags: map[string]CompletionFunc{
+					"bar": func(context.Context, app.Container, string) []string {
+						return []string{"three"}
+					},
+				},
+			},
+		},
+	}
+	buffer := bytes.NewBuffer(nil)
+	container := app.NewContainer(
+		nil,
+		nil,
+		buffer,
+		nil,
+		"test",
+		"__complete",
+		"sub",
+		"a",
+	)
+	require.NoError(t, Run(context.Background(), container, rootCommand))
+	assert.True(t, strings.HasPrefix(buffer.String(), "aone\natwo\n:4\n"), buffer.String())
+
+	buffer = bytes.NewBuffer(nil)
+	container = app.NewContainer(
+		nil,
+		nil,
+		buffer,
+		nil,
+		"test",
+		"__complete",
+		"sub",
+		"--bar",
+		"",
+	)
+	require.NoError(t, Run(context.Background(), container, rootCommand))
+	assert.True(t, strings.HasPrefix(buffer.String(), "three\n:4\n"), buffer.String())
+}
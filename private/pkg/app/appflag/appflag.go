@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/applog"
 	"github.com/bufbuild/buf/private/pkg/app/appname"
 	"github.com/bufbuild/buf/private/pkg/app/appverbose"
@@ -41,6 +42,11 @@ type Interceptor func(func(context.Context, Container) error) func(context.Conte
 type Builder interface {
 	BindRoot(flagSet *pflag.FlagSet)
 	NewRunFunc(func(context.Context, Container) error, ...Interceptor) func(context.Context, app.Container) error
+	// NewCompletionFunc returns a new appcmd.CompletionFunc that calls the function
+	// with a Container.
+	//
+	// Completion happens on every keystroke of the user, so the Container logs nothing.
+	NewCompletionFunc(func(context.Context, Container, string) []string) appcmd.CompletionFunc
 }
 
 // NewBuilder returns a new Builder.
@@ -64,3 +70,21 @@ func BuilderWithTracing() BuilderOption {
 		builder.tracing = true
 	}
 }
+
+// BuilderWithBoolEnvFlag returns a new BuilderOption that adds a bool flag which, when set,
+// sets the environment variable envKey to "true" in the Container.
+//
+// This lets a flag and an environment variable turn on the same behavior, which then only
+// has to read the environment variable.
+func BuilderWithBoolEnvFlag(flagName string, envKey string, usage string) BuilderOption {
+	return func(builder *builder) {
+		builder.boolEnvFlags = append(
+			builder.boolEnvFlags,
+			&boolEnvFlag{
+				flagName: flagName,
+				envKey:   envKey,
+				usage:    usage,
+			},
+		)
+	}
+}
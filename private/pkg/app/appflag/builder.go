@@ -18,12 +18,18 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
 	"time"
 
 	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/app/appcmd"
 	"github.com/bufbuild/buf/private/pkg/app/applog"
 	"github.com/bufbuild/buf/private/pkg/app/appverbose"
 	"github.com/bufbuild/buf/private/pkg/observabilityzap"
+	"github.com/bufbuild/buf/private/pkg/timing"
+	"github.com/bufbuild/buf/private/pkg/verbose"
 	"github.com/pkg/profile"
 	"github.com/spf13/pflag"
 	"go.opentelemetry.io/otel"
@@ -44,12 +50,26 @@ type builder struct {
 	profileLoops      int
 	profileType       string
 	profileAllowError bool
+	profileCPUPath    string
+	profileMemPath    string
+	profileTracePath  string
+
+	timing bool
 
 	timeout time.Duration
 
 	defaultTimeout time.Duration
 
 	tracing bool
+
+	boolEnvFlags []*boolEnvFlag
+}
+
+type boolEnvFlag struct {
+	flagName string
+	envKey   string
+	usage    string
+	value    bool
 }
 
 func newBuilder(appName string, options ...BuilderOption) *builder {
@@ -69,6 +89,9 @@ func (b *builder) BindRoot(flagSet *pflag.FlagSet) {
 	if b.defaultTimeout > 0 {
 		flagSet.DurationVar(&b.timeout, "timeout", b.defaultTimeout, `The duration until timing out`)
 	}
+	for _, boolEnvFlag := range b.boolEnvFlags {
+		flagSet.BoolVar(&boolEnvFlag.value, boolEnvFlag.flagName, false, boolEnvFlag.usage)
+	}
 
 	flagSet.BoolVar(&b.profile, "profile", false, "Run profiling")
 	_ = flagSet.MarkHidden("profile")
@@ -80,6 +103,14 @@ func (b *builder) BindRoot(flagSet *pflag.FlagSet) {
 	_ = flagSet.MarkHidden("profile-type")
 	flagSet.BoolVar(&b.profileAllowError, "profile-allow-error", false, "Allow errors for profiled commands")
 	_ = flagSet.MarkHidden("profile-allow-error")
+	flagSet.StringVar(&b.profileCPUPath, "profile-cpu", "", "Write a pprof CPU profile of the command to the file path")
+	_ = flagSet.MarkHidden("profile-cpu")
+	flagSet.StringVar(&b.profileMemPath, "profile-mem", "", "Write a pprof memory profile of the command to the file path")
+	_ = flagSet.MarkHidden("profile-mem")
+	flagSet.StringVar(&b.profileTracePath, "profile-trace", "", "Write an execution trace of the command to the file path")
+	_ = flagSet.MarkHidden("profile-trace")
+	flagSet.BoolVar(&b.timing, "timing", false, "Print the duration of each phase of the command to stderr")
+	_ = flagSet.MarkHidden("timing")
 
 	// We do not officially support this flag, this is for testing, where we need warnings turned off.
 	flagSet.BoolVar(&b.noWarn, "no-warn", false, "Turn off warn logging")
@@ -99,6 +130,18 @@ func (b *builder) NewRunFunc(
 	}
 }
 
+func (b *builder) NewCompletionFunc(
+	f func(context.Context, Container, string) []string,
+) appcmd.CompletionFunc {
+	return func(ctx context.Context, appContainer app.Container, toComplete string) []string {
+		container, err := newContainer(appContainer, b.appName, zap.NewNop(), verbose.NopPrinter)
+		if err != nil {
+			return nil
+		}
+		return f(ctx, container, toComplete)
+	}
+}
+
 func (b *builder) run(
 	ctx context.Context,
 	appContainer app.Container,
@@ -115,12 +158,25 @@ func (b *builder) run(
 	defer func() {
 		retErr = multierr.Append(retErr, logger.Sync())
 	}()
+	envOverrides := make(map[string]string)
+	for _, boolEnvFlag := range b.boolEnvFlags {
+		if boolEnvFlag.value {
+			envOverrides[boolEnvFlag.envKey] = "true"
+		}
+	}
+	if len(envOverrides) > 0 {
+		appContainer = app.NewContainerWithEnvOverrides(appContainer, envOverrides)
+	}
 	verbosePrinter := appverbose.NewVerbosePrinter(appContainer.Stderr(), b.appName, b.verbose)
 	container, err := newContainer(appContainer, b.appName, logger, verbosePrinter)
 	if err != nil {
 		return err
 	}
 
+	if b.profile && (b.profileCPUPath != "" || b.profileMemPath != "" || b.profileTracePath != "") {
+		return fmt.Errorf("cannot set --profile with --profile-cpu, --profile-mem, or --profile-trace")
+	}
+
 	var cancel context.CancelFunc
 	if !b.profile && b.timeout != 0 {
 		ctx, cancel = context.WithTimeout(ctx, b.timeout)
@@ -135,8 +191,22 @@ func (b *builder) run(
 		_, span := otel.GetTracerProvider().Tracer("bufbuild/buf").Start(ctx, "command")
 		defer span.End()
 	}
+	if b.timing {
+		recorder := timing.NewRecorder()
+		ctx = timing.WithRecorder(ctx, recorder)
+		defer func() {
+			retErr = multierr.Append(retErr, timing.Print(appContainer.Stderr(), recorder.PhaseDurations()))
+		}()
+	}
 	if !b.profile {
-		return f(ctx, container)
+		return runProfileFiles(
+			b.profileCPUPath,
+			b.profileMemPath,
+			b.profileTracePath,
+			func() error {
+				return f(ctx, container)
+			},
+		)
 	}
 	return runProfile(
 		logger,
@@ -202,6 +272,62 @@ func runProfile(
 	return nil
 }
 
+// runProfileFiles runs the function, writing a CPU profile, memory profile,
+// and execution trace to the given file paths if they are non-empty.
+func runProfileFiles(
+	cpuPath string,
+	memPath string,
+	tracePath string,
+	f func() error,
+) (retErr error) {
+	if cpuPath != "" {
+		file, err := os.Create(cpuPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			retErr = multierr.Append(retErr, file.Close())
+		}()
+		if err := pprof.StartCPUProfile(file); err != nil {
+			return err
+		}
+		defer pprof.StopCPUProfile()
+	}
+	if tracePath != "" {
+		file, err := os.Create(tracePath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			retErr = multierr.Append(retErr, file.Close())
+		}()
+		if err := trace.Start(file); err != nil {
+			return err
+		}
+		defer trace.Stop()
+	}
+	if memPath != "" {
+		defer func() {
+			retErr = multierr.Append(retErr, writeMemProfile(memPath))
+		}()
+	}
+	return f()
+}
+
+// writeMemProfile writes the allocations profile to the file path.
+func writeMemProfile(memPath string) (retErr error) {
+	file, err := os.Create(memPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, file.Close())
+	}()
+	// Get up-to-date statistics.
+	runtime.GC()
+	return pprof.Lookup("allocs").WriteTo(file, 0)
+}
+
 func getLogLevel(debugFlag bool, noWarnFlag bool) (string, error) {
 	if debugFlag && noWarnFlag {
 		return "", fmt.Errorf("cannot set both --debug and --no-warn")
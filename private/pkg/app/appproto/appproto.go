@@ -45,6 +45,10 @@ const (
 	// We don't use insertion points internally, but assume they are smaller than
 	// entire generated files.
 	averageInsertionPointSize = 1024
+	// Some generated files contain very long lines, such as the serialized
+	// FileDescriptorProto in Python stubs, which exceed the default
+	// bufio.MaxScanTokenSize. A single line is not expected to exceed this.
+	maxGeneratedLineSize = 64 * 1024 * 1024
 )
 
 // ResponseBuilder builds CodeGeneratorResponses.
@@ -174,6 +178,17 @@ func WriteResponseWithInsertionPointReadBucket(
 	}
 }
 
+// WriteResponseWithFileHeader returns a new WriteResponseOption that adds the header
+// that fileHeader returns for the name of each file to the beginning of the file.
+//
+// fileHeader returns the empty string for files that should not have a header. The
+// header is not added to the content of insertion points.
+func WriteResponseWithFileHeader(fileHeader func(fileName string) string) WriteResponseOption {
+	return func(writeResponseOptions *writeResponseOptions) {
+		writeResponseOptions.fileHeader = fileHeader
+	}
+}
+
 // PluginResponse encapsulates a CodeGeneratorResponse,
 // along with the name of the plugin that created it.
 type PluginResponse struct {
@@ -282,6 +297,14 @@ func leadingWhitespace(buf []byte) []byte {
 	return buf
 }
 
+// newLineScanner returns a new bufio.Scanner that splits on lines and accepts
+// lines up to maxGeneratedLineSize.
+func newLineScanner(reader io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxGeneratedLineSize)
+	return scanner
+}
+
 // scanWithPrefixAndLineEnding iterates over each of the given scanner's lines
 // prepends prefix, and appends the newline sequence.
 func scanWithPrefixAndLineEnding(scanner *bufio.Scanner, prefix []byte, newline []byte) []byte {
@@ -119,6 +119,27 @@ at varied indentation levels
 
 		assert.Equal(t, expectContent, postInsertionContent)
 	})
+	t.Run("long_line", func(t *testing.T) {
+		// longer than bufio.MaxScanTokenSize
+		longLine := strings.Repeat("x", 128*1024)
+		insertionPointName := "ip1"
+		insertionPointConsumer := &pluginpb.CodeGeneratorResponse_File{
+			Name:           &targetFileName,
+			InsertionPoint: &insertionPointName,
+			Content:        &insertionPointContent,
+		}
+		postInsertionContent, err := writeInsertionPoint(
+			context.Background(),
+			insertionPointConsumer,
+			strings.NewReader(longLine+"\n// @@protoc_insertion_point(ip1)\n"),
+		)
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			[]byte(longLine+"\n"+insertionPointContent+"\n// @@protoc_insertion_point(ip1)"),
+			postInsertionContent,
+		)
+	})
 }
 
 func BenchmarkWriteInsertionPoint(b *testing.B) {
@@ -206,3 +227,29 @@ at varied indentation levels` + whitespacePrefix + "// @@protoc_insertion_point(
 		assert.Equal(b, inflatedExpectContent, postInsertionContent)
 	})
 }
+
+func TestAddFileHeader(t *testing.T) {
+	t.Parallel()
+	header := "// Code generated by buf. DO NOT EDIT.\n"
+	assert.Equal(
+		t,
+		"// Code generated by buf. DO NOT EDIT.\n\npackage foo\n",
+		addFileHeader("package foo\n", header),
+	)
+	assert.Equal(
+		t,
+		"// Code generated by buf. DO NOT EDIT.\n\npackage foo\n",
+		addFileHeader("package foo\n", strings.TrimSuffix(header, "\n")),
+	)
+	assert.Equal(
+		t,
+		"<?php\n\n// Code generated by buf. DO NOT EDIT.\n\nnamespace Foo;\n",
+		addFileHeader("<?php\nnamespace Foo;\n", header),
+	)
+	assert.Equal(
+		t,
+		"#!/usr/bin/env python3\n\n# header\n\nimport foo\n",
+		addFileHeader("#!/usr/bin/env python3\nimport foo\n", "# header\n"),
+	)
+	assert.Equal(t, "package foo\n", addFileHeader("package foo\n", ""))
+}
@@ -66,3 +66,29 @@ func ResponseWriterWithCreateOutDirIfNotExists() ResponseWriterOption {
 		responseWriterOptions.createOutDirIfNotExists = true
 	}
 }
+
+// ResponseWriterWithInsertionPointsAcrossOutDirs returns a new ResponseWriterOption that
+// allows insertion points to target files written to a parent or child output directory
+// by an earlier response.
+//
+// For example, a response added with the output directory "gen" can insert into the file
+// "go/a.pb.go" written by an earlier response added with the output directory "gen/go".
+//
+// By default, insertion points can only target files written to the same output
+// directory, which is equivalent to protoc's behavior.
+func ResponseWriterWithInsertionPointsAcrossOutDirs() ResponseWriterOption {
+	return func(responseWriterOptions *responseWriterOptions) {
+		responseWriterOptions.insertionPointsAcrossOutDirs = true
+	}
+}
+
+// ResponseWriterWithFileHeader returns a new ResponseWriterOption that adds the header
+// that fileHeader returns for the name of each written file to the beginning of the file.
+//
+// fileHeader returns the empty string for files that should not have a header. The
+// header is not added to the content of insertion points.
+func ResponseWriterWithFileHeader(fileHeader func(fileName string) string) ResponseWriterOption {
+	return func(responseWriterOptions *responseWriterOptions) {
+		responseWriterOptions.fileHeader = fileHeader
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 
 	"github.com/bufbuild/buf/private/pkg/app/appproto"
@@ -29,6 +30,7 @@ import (
 	"github.com/bufbuild/buf/private/pkg/storage/storageos"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
@@ -47,6 +49,11 @@ type responseWriter struct {
 	responseWriter    appproto.ResponseWriter
 	// If set, create directories if they don't already exist.
 	createOutDirIfNotExists bool
+	// If set, insertion points can target files in a parent or child
+	// output directory.
+	insertionPointsAcrossOutDirs bool
+	// If set, returns the header to add to the beginning of each file.
+	fileHeader func(string) string
 	// Cache the readWriteBuckets by their respective output paths.
 	// These builders are transformed to storage.ReadBuckets and written
 	// to disk once the responseWriter is flushed.
@@ -80,11 +87,13 @@ func newResponseWriter(
 		option(responseWriterOptions)
 	}
 	return &responseWriter{
-		logger:                  logger,
-		storageosProvider:       storageosProvider,
-		responseWriter:          appproto.NewResponseWriter(logger),
-		createOutDirIfNotExists: responseWriterOptions.createOutDirIfNotExists,
-		readWriteBuckets:        make(map[string]storage.ReadWriteBucket),
+		logger:                       logger,
+		storageosProvider:            storageosProvider,
+		responseWriter:               appproto.NewResponseWriter(logger),
+		createOutDirIfNotExists:      responseWriterOptions.createOutDirIfNotExists,
+		insertionPointsAcrossOutDirs: responseWriterOptions.insertionPointsAcrossOutDirs,
+		fileHeader:                   responseWriterOptions.fileHeader,
+		readWriteBuckets:             make(map[string]storage.ReadWriteBucket),
 	}
 }
 
@@ -184,7 +193,7 @@ func (w *responseWriter) writeZip(
 			ctx,
 			readWriteBucket,
 			response,
-			appproto.WriteResponseWithInsertionPointReadBucket(readWriteBucket),
+			w.getWriteResponseOptions(readWriteBucket)...,
 		); err != nil {
 			return err
 		}
@@ -216,7 +225,7 @@ func (w *responseWriter) writeZip(
 		ctx,
 		readWriteBucket,
 		response,
-		appproto.WriteResponseWithInsertionPointReadBucket(readWriteBucket),
+		w.getWriteResponseOptions(readWriteBucket)...,
 	); err != nil {
 		return err
 	}
@@ -245,55 +254,143 @@ func (w *responseWriter) writeDirectory(
 	outDirPath string,
 	createOutDirIfNotExists bool,
 ) error {
-	if readWriteBucket, ok := w.readWriteBuckets[outDirPath]; ok {
-		// We already have a readWriteBucket for this outDirPath, so
-		// we can write to the same bucket.
-		if err := w.responseWriter.WriteResponse(
+	readWriteBucket, ok := w.readWriteBuckets[outDirPath]
+	if !ok {
+		readWriteBucket = storagemem.NewReadWriteBucket()
+		// Add this readWriteBucket to the set so that other plugins
+		// can write to the same files (re: insertion points).
+		w.readWriteBuckets[outDirPath] = readWriteBucket
+		w.closers = append(w.closers, func() error {
+			if createOutDirIfNotExists {
+				if err := os.MkdirAll(outDirPath, 0755); err != nil {
+					return err
+				}
+			}
+			// This checks that the directory exists.
+			osReadWriteBucket, err := w.storageosProvider.NewReadWriteBucket(
+				outDirPath,
+				storageos.ReadWriteBucketWithSymlinksIfSupported(),
+			)
+			if err != nil {
+				return err
+			}
+			if _, err := storage.Copy(ctx, readWriteBucket, osReadWriteBucket); err != nil {
+				return err
+			}
+			return nil
+		})
+	}
+	if !w.insertionPointsAcrossOutDirs {
+		return w.responseWriter.WriteResponse(
 			ctx,
 			readWriteBucket,
 			response,
-			appproto.WriteResponseWithInsertionPointReadBucket(readWriteBucket),
+			w.getWriteResponseOptions(readWriteBucket)...,
+		)
+	}
+	// Files are written one at a time, as an insertion point may target a file
+	// written by an earlier response to a different output directory.
+	for _, file := range response.File {
+		fileReadWriteBucket := readWriteBucket
+		if file.GetInsertionPoint() != "" {
+			var err error
+			fileReadWriteBucket, file, err = w.getInsertionPointReadWriteBucketAndFile(
+				ctx,
+				outDirPath,
+				readWriteBucket,
+				file,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		if err := w.responseWriter.WriteResponse(
+			ctx,
+			fileReadWriteBucket,
+			&pluginpb.CodeGeneratorResponse{
+				File: []*pluginpb.CodeGeneratorResponse_File{file},
+			},
+			w.getWriteResponseOptions(fileReadWriteBucket)...,
 		); err != nil {
 			return err
 		}
-		return nil
 	}
-	readWriteBucket := storagemem.NewReadWriteBucket()
-	if err := w.responseWriter.WriteResponse(
-		ctx,
-		readWriteBucket,
-		response,
-		appproto.WriteResponseWithInsertionPointReadBucket(readWriteBucket),
-	); err != nil {
-		return err
+	return nil
+}
+
+// getInsertionPointReadWriteBucketAndFile gets the readWriteBucket that contains
+// the target file of the insertion point, and the file with its name relative to
+// that readWriteBucket.
+//
+// The target file is usually written to the same output directory. If it is not,
+// the output directories of earlier responses that contain the target file path
+// are checked, so that responses with an output directory of "gen" and "gen/go"
+// can insert into each other's files.
+//
+// If no readWriteBucket contains the target file, the given readWriteBucket and
+// file are returned as-is, and writing the insertion point fails.
+func (w *responseWriter) getInsertionPointReadWriteBucketAndFile(
+	ctx context.Context,
+	outDirPath string,
+	readWriteBucket storage.ReadWriteBucket,
+	file *pluginpb.CodeGeneratorResponse_File,
+) (storage.ReadWriteBucket, *pluginpb.CodeGeneratorResponse_File, error) {
+	exists, err := storage.Exists(ctx, readWriteBucket, file.GetName())
+	if err != nil {
+		return nil, nil, err
 	}
-	// Add this readWriteBucket to the set so that other plugins
-	// can write to the same files (re: insertion points).
-	w.readWriteBuckets[outDirPath] = readWriteBucket
-	w.closers = append(w.closers, func() error {
-		if createOutDirIfNotExists {
-			if err := os.MkdirAll(outDirPath, 0755); err != nil {
-				return err
-			}
+	if exists {
+		return readWriteBucket, file, nil
+	}
+	targetFilePath := normalpath.Join(normalpath.Normalize(outDirPath), file.GetName())
+	otherOutDirPaths := make([]string, 0, len(w.readWriteBuckets))
+	for otherOutDirPath := range w.readWriteBuckets {
+		otherOutDirPaths = append(otherOutDirPaths, otherOutDirPath)
+	}
+	sort.Strings(otherOutDirPaths)
+	for _, otherOutDirPath := range otherOutDirPaths {
+		switch filepath.Ext(otherOutDirPath) {
+		case ".jar", ".zip":
+			continue
 		}
-		// This checks that the directory exists.
-		osReadWriteBucket, err := w.storageosProvider.NewReadWriteBucket(
-			outDirPath,
-			storageos.ReadWriteBucketWithSymlinksIfSupported(),
-		)
+		normalOtherOutDirPath := normalpath.Normalize(otherOutDirPath)
+		if otherOutDirPath == outDirPath || !normalpath.ContainsPath(normalOtherOutDirPath, targetFilePath, normalpath.Absolute) {
+			continue
+		}
+		relTargetFilePath, err := normalpath.Rel(normalOtherOutDirPath, targetFilePath)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
-		if _, err := storage.Copy(ctx, readWriteBucket, osReadWriteBucket); err != nil {
-			return err
+		otherReadWriteBucket := w.readWriteBuckets[otherOutDirPath]
+		exists, err := storage.Exists(ctx, otherReadWriteBucket, relTargetFilePath)
+		if err != nil {
+			return nil, nil, err
 		}
-		return nil
-	})
-	return nil
+		if exists {
+			otherFile := proto.Clone(file).(*pluginpb.CodeGeneratorResponse_File)
+			otherFile.Name = proto.String(relTargetFilePath)
+			return otherReadWriteBucket, otherFile, nil
+		}
+	}
+	return readWriteBucket, file, nil
+}
+
+// getWriteResponseOptions returns the options to write a response to the
+// readWriteBucket, which is also used to read the targets of insertion points.
+func (w *responseWriter) getWriteResponseOptions(readWriteBucket storage.ReadWriteBucket) []appproto.WriteResponseOption {
+	writeResponseOptions := []appproto.WriteResponseOption{
+		appproto.WriteResponseWithInsertionPointReadBucket(readWriteBucket),
+	}
+	if w.fileHeader != nil {
+		writeResponseOptions = append(writeResponseOptions, appproto.WriteResponseWithFileHeader(w.fileHeader))
+	}
+	return writeResponseOptions
 }
 
 type responseWriterOptions struct {
-	createOutDirIfNotExists bool
+	createOutDirIfNotExists      bool
+	insertionPointsAcrossOutDirs bool
+	fileHeader                   func(string) string
 }
 
 func newResponseWriterOptions() *responseWriterOptions {
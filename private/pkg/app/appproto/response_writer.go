@@ -15,10 +15,11 @@
 package appproto
 
 import (
-	"bufio"
 	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"strings"
 
 	"github.com/bufbuild/buf/private/pkg/storage"
 	"go.uber.org/multierr"
@@ -56,13 +57,38 @@ func (h *responseWriter) WriteResponse(
 			if err := applyInsertionPoint(ctx, file, writeResponseOptions.insertionPointReadBucket, writeBucket); err != nil {
 				return err
 			}
-		} else if err := storage.PutPath(ctx, writeBucket, file.GetName(), []byte(file.GetContent())); err != nil {
+			continue
+		}
+		content := file.GetContent()
+		if writeResponseOptions.fileHeader != nil {
+			content = addFileHeader(content, writeResponseOptions.fileHeader(file.GetName()))
+		}
+		if err := storage.PutPath(ctx, writeBucket, file.GetName(), []byte(content)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// addFileHeader adds the header to the beginning of the content, followed by an
+// empty line. If the first line of the content is an interpreter directive or a
+// PHP opening tag, which must come first, the header is added after it instead.
+func addFileHeader(content string, header string) string {
+	if header == "" {
+		return content
+	}
+	if !strings.HasSuffix(header, "\n") {
+		header += "\n"
+	}
+	header += "\n"
+	if strings.HasPrefix(content, "#!") || strings.HasPrefix(content, "<?php") {
+		if index := strings.IndexByte(content, '\n'); index >= 0 {
+			return content[:index+1] + "\n" + header + content[index+1:]
+		}
+	}
+	return header + content
+}
+
 // applyInsertionPoint inserts the content of the given file at the insertion point that it specfiies.
 // For more details on insertion points, see the following:
 //
@@ -75,6 +101,13 @@ func applyInsertionPoint(
 ) (retErr error) {
 	targetReadObjectCloser, err := readBucket.Get(ctx, file.GetName())
 	if err != nil {
+		if storage.IsNotExist(err) {
+			return fmt.Errorf(
+				"insertion point %q: file %q was not generated by an earlier plugin or earlier in the same response",
+				file.GetInsertionPoint(),
+				file.GetName(),
+			)
+		}
 		return err
 	}
 	defer func() {
@@ -96,7 +129,7 @@ func writeInsertionPoint(
 	insertionPointFile *pluginpb.CodeGeneratorResponse_File,
 	targetFile io.Reader,
 ) (_ []byte, retErr error) {
-	targetScanner := bufio.NewScanner(targetFile)
+	targetScanner := newLineScanner(targetFile)
 	match := []byte("@@protoc_insertion_point(" + insertionPointFile.GetInsertionPoint() + ")")
 	postInsertionContent := bytes.NewBuffer(nil)
 	postInsertionContent.Grow(averageGeneratedFileSize)
@@ -121,7 +154,7 @@ func writeInsertionPoint(
 
 		// Create another scanner so that we can seamlessly handle
 		// newlines in a platform-agnostic manner.
-		insertedContentScanner := bufio.NewScanner(bytes.NewBufferString(insertionPointFile.GetContent()))
+		insertedContentScanner := newLineScanner(bytes.NewBufferString(insertionPointFile.GetContent()))
 		insertedContent := scanWithPrefixAndLineEnding(insertedContentScanner, whitespace, newline)
 		// This write cannot fail, it will panic if it cannot
 		// allocate
@@ -147,6 +180,7 @@ func writeInsertionPoint(
 
 type writeResponseOptions struct {
 	insertionPointReadBucket storage.ReadBucket
+	fileHeader               func(string) string
 }
 
 func newWriteResponseOptions() *writeResponseOptions {
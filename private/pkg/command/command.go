@@ -108,6 +108,34 @@ func RunWithDir(dir string) RunOption {
 	}
 }
 
+// RunWithoutNetwork returns a new RunOption that runs the command without network access.
+//
+// This is only supported on Linux, where the command is run in new user and network
+// namespaces, so that the only network interface it sees is a loopback interface
+// that is down. On other platforms, Run returns an error.
+//
+// The default is to run the command with the network access of the current process.
+func RunWithoutNetwork() RunOption {
+	return func(execOptions *execOptions) {
+		execOptions.withoutNetwork = true
+	}
+}
+
+// RunWithMaxMemory returns a new RunOption that limits the address space of the
+// command to the given number of bytes.
+//
+// This is only supported on Linux, where the limit is applied with prlimit(2)
+// immediately after the command is started. Allocations that exceed the limit
+// fail, which usually causes the command to exit with an error. On other
+// platforms, Run returns an error.
+//
+// The default is to not limit the memory of the command.
+func RunWithMaxMemory(maxMemory uint64) RunOption {
+	return func(execOptions *execOptions) {
+		execOptions.maxMemory = maxMemory
+	}
+}
+
 // StartOption is an option for Start.
 type StartOption func(*execOptions)
 
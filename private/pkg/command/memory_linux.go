@@ -0,0 +1,47 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"os/exec"
+
+	"go.uber.org/multierr"
+	"golang.org/x/sys/unix"
+)
+
+// runWithMaxMemory runs the command with its address space limited to
+// maxMemory bytes.
+func runWithMaxMemory(cmd *exec.Cmd, maxMemory uint64) error {
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	if err := unix.Prlimit(
+		cmd.Process.Pid,
+		unix.RLIMIT_AS,
+		&unix.Rlimit{
+			Cur: maxMemory,
+			Max: maxMemory,
+		},
+		nil,
+	); err != nil {
+		// The command must not run without the limit, so kill it and
+		// release its resources before returning. The error of Wait is
+		// expected as the command was killed.
+		err = multierr.Append(err, cmd.Process.Kill())
+		_ = cmd.Wait()
+		return err
+	}
+	return cmd.Wait()
+}
@@ -0,0 +1,47 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// applyWithoutNetwork runs the command in new user and network namespaces.
+//
+// The user namespace is required to create the network namespace without
+// privileges. The current user and group are mapped to themselves, so that
+// the command sees the same file ownership as the current process.
+func applyWithoutNetwork(cmd *exec.Cmd) error {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWUSER | syscall.CLONE_NEWNET,
+		UidMappings: []syscall.SysProcIDMap{
+			{
+				ContainerID: os.Getuid(),
+				HostID:      os.Getuid(),
+				Size:        1,
+			},
+		},
+		GidMappings: []syscall.SysProcIDMap{
+			{
+				ContainerID: os.Getgid(),
+				HostID:      os.Getgid(),
+				Size:        1,
+			},
+		},
+	}
+	return nil
+}
@@ -53,11 +53,16 @@ func (r *runner) Run(ctx context.Context, name string, options ...RunOption) err
 		option(execOptions)
 	}
 	cmd := exec.CommandContext(ctx, name, execOptions.args...)
-	execOptions.ApplyToCmd(cmd)
+	if err := execOptions.ApplyToCmd(cmd); err != nil {
+		return err
+	}
+	setWaitDelay(cmd)
 	r.increment()
-	err := cmd.Run()
-	r.decrement()
-	return err
+	defer r.decrement()
+	if execOptions.maxMemory > 0 {
+		return runWithMaxMemory(cmd, execOptions.maxMemory)
+	}
+	return cmd.Run()
 }
 
 func (r *runner) Start(name string, options ...StartOption) (Process, error) {
@@ -66,7 +71,9 @@ func (r *runner) Start(name string, options ...StartOption) (Process, error) {
 		option(execOptions)
 	}
 	cmd := exec.Command(name, execOptions.args...)
-	execOptions.ApplyToCmd(cmd)
+	if err := execOptions.ApplyToCmd(cmd); err != nil {
+		return nil, err
+	}
 	r.increment()
 	if err := cmd.Start(); err != nil {
 		return nil, err
@@ -91,13 +98,16 @@ type execOptions struct {
 	stdout io.Writer
 	stderr io.Writer
 	dir    string
+
+	withoutNetwork bool
+	maxMemory      uint64
 }
 
 func newExecOptions() *execOptions {
 	return &execOptions{}
 }
 
-func (e *execOptions) ApplyToCmd(cmd *exec.Cmd) {
+func (e *execOptions) ApplyToCmd(cmd *exec.Cmd) error {
 	// If the user did not specify env vars, we want to make sure
 	// the command has access to none, as the default is the current env.
 	if len(e.env) == 0 {
@@ -119,6 +129,10 @@ func (e *execOptions) ApplyToCmd(cmd *exec.Cmd) {
 	// The default behavior for dir is what we want already, i.e. the current
 	// working directory.
 	cmd.Dir = e.dir
+	if e.withoutNetwork {
+		return applyWithoutNetwork(cmd)
+	}
+	return nil
 }
 
 func envSlice(env map[string]string) []string {
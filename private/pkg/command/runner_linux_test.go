@@ -0,0 +1,73 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunWithoutNetwork(t *testing.T) {
+	t.Parallel()
+
+	runner := NewRunner()
+	ctx := context.Background()
+	netNamespace := bytes.NewBuffer(nil)
+	require.NoError(
+		t,
+		runner.Run(
+			ctx,
+			"readlink",
+			RunWithArgs("/proc/self/ns/net"),
+			RunWithStdout(netNamespace),
+		),
+	)
+	isolatedNetNamespace := bytes.NewBuffer(nil)
+	if err := runner.Run(
+		ctx,
+		"readlink",
+		RunWithArgs("/proc/self/ns/net"),
+		RunWithStdout(isolatedNetNamespace),
+		RunWithoutNetwork(),
+	); err != nil {
+		// Unprivileged user namespaces may be disabled on the host.
+		t.Skipf("could not create a network namespace: %v", err)
+	}
+	require.NotEmpty(t, isolatedNetNamespace.String())
+	require.NotEqual(t, netNamespace.String(), isolatedNetNamespace.String())
+}
+
+func TestRunWithMaxMemory(t *testing.T) {
+	t.Parallel()
+
+	runner := NewRunner()
+	ctx := context.Background()
+	stdout := bytes.NewBuffer(nil)
+	require.NoError(
+		t,
+		runner.Run(
+			ctx,
+			"sh",
+			RunWithArgs("-c", "ulimit -v"),
+			RunWithStdout(stdout),
+			RunWithMaxMemory(256*1024*1024),
+		),
+	)
+	// ulimit -v reports the limit in kibibytes.
+	require.Equal(t, "262144\n", stdout.String())
+}
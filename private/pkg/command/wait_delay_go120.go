@@ -0,0 +1,34 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.20
+
+package command
+
+import (
+	"os/exec"
+	"time"
+)
+
+// waitDelay is the time to wait for the I/O pipes of a command to be closed
+// after the command was killed or exited.
+//
+// Without it, a child process of the command that inherited its I/O pipes,
+// such as a process started by a wrapper script, blocks Run until it exits,
+// even if the command itself was killed when the context was done.
+const waitDelay = 5 * time.Second
+
+func setWaitDelay(cmd *exec.Cmd) {
+	cmd.WaitDelay = waitDelay
+}
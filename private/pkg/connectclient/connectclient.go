@@ -15,7 +15,11 @@
 package connectclient
 
 import (
+	"context"
+	"time"
+
 	"github.com/bufbuild/connect-go"
+	"go.uber.org/zap"
 )
 
 // Config holds configuration for creating Connect RPC clients.
@@ -24,6 +28,15 @@ type Config struct {
 	addressMapper           func(string) string
 	interceptors            []connect.Interceptor
 	authInterceptorProvider func(string) connect.UnaryInterceptorFunc
+	timeoutProvider         func(string) time.Duration
+	requestsPerSecond       float64
+	burst                   int
+	maxConcurrentRequests   int
+	maxRetries              int
+	maxRetryAfter           time.Duration
+	debugLogger             *zap.Logger
+	// Shared by all clients created from this Config.
+	limitInterceptor connect.Interceptor
 }
 
 // NewConfig creates a new client configuration with the given HTTP client
@@ -35,6 +48,15 @@ func NewConfig(httpClient connect.HTTPClient, options ...ConfigOption) *Config {
 	for _, opt := range options {
 		opt(cfg)
 	}
+	if cfg.requestsPerSecond > 0 || cfg.maxConcurrentRequests > 0 || cfg.maxRetries > 0 {
+		cfg.limitInterceptor = newLimitInterceptor(
+			newRateLimiter(cfg.requestsPerSecond, cfg.burst),
+			newSemaphore(cfg.maxConcurrentRequests),
+			cfg.maxRetries,
+			cfg.maxRetryAfter,
+			cfg.debugLogger,
+		)
+	}
 	return cfg
 }
 
@@ -63,18 +85,97 @@ func WithAuthInterceptorProvider(authInterceptorProvider func(string) connect.Un
 	}
 }
 
+// WithTimeoutProvider configures a provider that, when invoked, returns the timeout
+// to apply to each unary RPC made by a client for the given address.
+//
+// A zero timeout means no timeout, in which case only the deadline of the context
+// passed to the RPC applies.
+func WithTimeoutProvider(timeoutProvider func(string) time.Duration) ConfigOption {
+	return func(cfg *Config) {
+		cfg.timeoutProvider = timeoutProvider
+	}
+}
+
+// WithRateLimit limits the unary RPCs made by all clients created from the Config
+// to requestsPerSecond, allowing bursts of up to burst RPCs.
+//
+// A requestsPerSecond of 0 means no limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ConfigOption {
+	return func(cfg *Config) {
+		cfg.requestsPerSecond = requestsPerSecond
+		cfg.burst = burst
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of unary RPCs in flight at once
+// across all clients created from the Config.
+//
+// A maxConcurrentRequests of 0 means no limit.
+func WithMaxConcurrentRequests(maxConcurrentRequests int) ConfigOption {
+	return func(cfg *Config) {
+		cfg.maxConcurrentRequests = maxConcurrentRequests
+	}
+}
+
+// WithRetryAfter retries unary RPCs that fail with ResourceExhausted or Unavailable
+// and a Retry-After response header, waiting for the requested duration before each retry.
+//
+// At most maxRetries retries are made, and an RPC is not retried if the requested
+// duration is longer than maxRetryAfter.
+func WithRetryAfter(maxRetries int, maxRetryAfter time.Duration) ConfigOption {
+	return func(cfg *Config) {
+		cfg.maxRetries = maxRetries
+		cfg.maxRetryAfter = maxRetryAfter
+	}
+}
+
+// WithDebugLogger logs the metadata and duration of every unary RPC attempt made by
+// clients created from the Config, as well as the retries of RPCs, to the logger.
+//
+// The values of headers that carry credentials, such as Authorization, are redacted.
+func WithDebugLogger(logger *zap.Logger) ConfigOption {
+	return func(cfg *Config) {
+		cfg.debugLogger = logger
+	}
+}
+
 // StubFactory is the type of a generated factory function, for creating Connect client stubs.
 type StubFactory[T any] func(connect.HTTPClient, string, ...connect.ClientOption) T
 
 // Make uses the given generated factory function to create a new connect client.
 func Make[T any](cfg *Config, address string, factory StubFactory[T]) T {
-	interceptors := append([]connect.Interceptor{}, cfg.interceptors...)
+	var interceptors []connect.Interceptor
+	if cfg.limitInterceptor != nil {
+		// The limit interceptor must be first so that it wraps every attempt.
+		interceptors = append(interceptors, cfg.limitInterceptor)
+	}
+	interceptors = append(interceptors, cfg.interceptors...)
 	if cfg.authInterceptorProvider != nil {
 		interceptor := cfg.authInterceptorProvider(address)
 		interceptors = append(interceptors, interceptor)
 	}
+	if cfg.timeoutProvider != nil {
+		if timeout := cfg.timeoutProvider(address); timeout > 0 {
+			interceptors = append(interceptors, newTimeoutInterceptor(timeout))
+		}
+	}
 	if cfg.addressMapper != nil {
 		address = cfg.addressMapper(address)
 	}
+	if cfg.debugLogger != nil {
+		// The debug interceptor must be last so that it logs the headers set by
+		// the other interceptors, and each attempt of a retried RPC.
+		interceptors = append(interceptors, newDebugInterceptor(cfg.debugLogger, address))
+	}
 	return factory(cfg.httpClient, address, connect.WithInterceptors(interceptors...))
 }
+
+func newTimeoutInterceptor(timeout time.Duration) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return next(ctx, request)
+		}
+	}
+}
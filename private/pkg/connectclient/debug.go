@@ -0,0 +1,96 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"go.uber.org/zap"
+)
+
+const redactedHeaderValue = "[REDACTED]"
+
+// sensitiveHeaderKeys are the canonical keys of the headers whose values are
+// redacted from the debug logs.
+var sensitiveHeaderKeys = map[string]struct{}{
+	"Authorization":       {},
+	"Cookie":              {},
+	"Proxy-Authorization": {},
+	"Set-Cookie":          {},
+}
+
+// newDebugInterceptor returns an interceptor that logs the metadata and
+// duration of every RPC attempt made to the address.
+func newDebugInterceptor(logger *zap.Logger, address string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := request.Spec().Procedure
+			logger.Info(
+				"http_request",
+				zap.String("address", address),
+				zap.String("procedure", procedure),
+				zap.Strings("header", sanitizeHeader(request.Header())),
+			)
+			start := time.Now()
+			response, err := next(ctx, request)
+			fields := []zap.Field{
+				zap.String("address", address),
+				zap.String("procedure", procedure),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("code", codeString(err)),
+			}
+			if err != nil {
+				fields = append(fields, zap.Error(err))
+				if connectErr := (&connect.Error{}); errors.As(err, &connectErr) {
+					fields = append(fields, zap.Strings("header", sanitizeHeader(connectErr.Meta())))
+				}
+			} else {
+				fields = append(fields, zap.Strings("header", sanitizeHeader(response.Header())))
+			}
+			logger.Info("http_response", fields...)
+			return response, err
+		}
+	}
+}
+
+// sanitizeHeader returns the header as sorted key: value strings, with the
+// values of headers that carry credentials redacted.
+func sanitizeHeader(header http.Header) []string {
+	lines := make([]string, 0, len(header))
+	for key, values := range header {
+		canonicalKey := http.CanonicalHeaderKey(key)
+		value := strings.Join(values, ", ")
+		if _, ok := sensitiveHeaderKeys[canonicalKey]; ok {
+			value = redactedHeaderValue
+		}
+		lines = append(lines, canonicalKey+": "+value)
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+// codeString returns the Connect code of the error, or "ok" if the error is nil.
+func codeString(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	return connect.CodeOf(err).String()
+}
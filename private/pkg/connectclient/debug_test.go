@@ -0,0 +1,73 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestSanitizeHeader(t *testing.T) {
+	t.Parallel()
+	header := make(http.Header)
+	header.Set("Authorization", "Bearer secret")
+	header.Set("Buf-Version", "1.0.0")
+	header.Add("Accept-Encoding", "gzip")
+	header.Add("Accept-Encoding", "br")
+	header["cookie"] = []string{"session=secret"}
+	assert.Equal(
+		t,
+		[]string{
+			"Accept-Encoding: gzip, br",
+			"Authorization: [REDACTED]",
+			"Buf-Version: 1.0.0",
+			"Cookie: [REDACTED]",
+		},
+		sanitizeHeader(header),
+	)
+}
+
+func TestDebugInterceptor(t *testing.T) {
+	t.Parallel()
+	core, observedLogs := observer.New(zap.InfoLevel)
+	interceptor := newDebugInterceptor(zap.New(core), "https://buf.build")
+	request := connect.NewRequest(&emptypb.Empty{})
+	request.Header().Set("Authorization", "Bearer secret")
+	_, err := interceptor(
+		func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("bad token"))
+		},
+	)(context.Background(), request)
+	require.Error(t, err)
+	entries := observedLogs.AllUntimed()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "http_request", entries[0].Message)
+	assert.Equal(
+		t,
+		[]interface{}{"Authorization: [REDACTED]"},
+		entries[0].ContextMap()["header"],
+	)
+	assert.Equal(t, "http_response", entries[1].Message)
+	assert.Equal(t, "unauthenticated", entries[1].ContextMap()["code"])
+}
@@ -0,0 +1,221 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectclient
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"go.uber.org/zap"
+)
+
+const retryAfterHeader = "Retry-After"
+
+func newLimitInterceptor(
+	rateLimiter *rateLimiter,
+	semaphore *semaphore,
+	maxRetries int,
+	maxRetryAfter time.Duration,
+	debugLogger *zap.Logger,
+) connect.UnaryInterceptorFunc {
+	if debugLogger == nil {
+		debugLogger = zap.NewNop()
+	}
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+			for attempt := 0; ; attempt++ {
+				response, err := callWithLimits(ctx, rateLimiter, semaphore, next, request)
+				if err == nil || attempt >= maxRetries {
+					return response, err
+				}
+				retryAfter, ok := getRetryAfter(err, time.Now())
+				if !ok || retryAfter > maxRetryAfter {
+					return response, err
+				}
+				debugLogger.Info(
+					"http_retry",
+					zap.String("procedure", request.Spec().Procedure),
+					zap.Int("attempt", attempt+1),
+					zap.Duration("retry_after", retryAfter),
+				)
+				if err := sleep(ctx, retryAfter); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+}
+
+func callWithLimits(
+	ctx context.Context,
+	rateLimiter *rateLimiter,
+	semaphore *semaphore,
+	next connect.UnaryFunc,
+	request connect.AnyRequest,
+) (connect.AnyResponse, error) {
+	if err := rateLimiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	if err := semaphore.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer semaphore.release()
+	return next(ctx, request)
+}
+
+// getRetryAfter returns the duration to wait before retrying, if the error is
+// retryable and has a Retry-After header.
+func getRetryAfter(err error, now time.Time) (time.Duration, bool) {
+	switch connect.CodeOf(err) {
+	case connect.CodeResourceExhausted, connect.CodeUnavailable:
+	default:
+		return 0, false
+	}
+	connectErr := &connect.Error{}
+	if !errors.As(err, &connectErr) {
+		return 0, false
+	}
+	return parseRetryAfter(connectErr.Meta().Get(retryAfterHeader), now)
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number
+// of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if retryAfter := date.Sub(now); retryAfter > 0 {
+		return retryAfter, true
+	}
+	return 0, true
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimiter is a token bucket rate limiter.
+//
+// A nil rateLimiter does not limit.
+type rateLimiter struct {
+	requestsPerSecond float64
+	burst             float64
+
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a new rateLimiter, or nil if requestsPerSecond is not positive.
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		burst:             float64(burst),
+		tokens:            float64(burst),
+	}
+}
+
+// wait blocks until a request is allowed, or the context is done.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+	return sleep(ctx, r.reserve(time.Now()))
+}
+
+// reserve takes a token and returns how long to wait until the token is available.
+func (r *rateLimiter) reserve(now time.Time) time.Duration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.last.IsZero() {
+		r.tokens = math.Min(r.burst, r.tokens+now.Sub(r.last).Seconds()*r.requestsPerSecond)
+	}
+	r.last = now
+	r.tokens--
+	if r.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-r.tokens / r.requestsPerSecond * float64(time.Second))
+}
+
+// semaphore limits the number of concurrent requests.
+//
+// A nil semaphore does not limit.
+type semaphore struct {
+	c chan struct{}
+}
+
+// newSemaphore returns a new semaphore, or nil if size is not positive.
+func newSemaphore(size int) *semaphore {
+	if size <= 0 {
+		return nil
+	}
+	return &semaphore{
+		c: make(chan struct{}, size),
+	}
+}
+
+func (s *semaphore) acquire(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case s.c <- struct{}{}:
+		return nil
+	}
+}
+
+func (s *semaphore) release() {
+	if s == nil {
+		return
+	}
+	<-s.c
+}
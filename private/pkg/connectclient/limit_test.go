@@ -0,0 +1,73 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectclient
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	retryAfter, ok := parseRetryAfter("3", now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, retryAfter)
+	retryAfter, ok = parseRetryAfter("Mon, 01 May 2023 12:00:10 GMT", now)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, retryAfter)
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("-1", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	err := connect.NewError(connect.CodeResourceExhausted, errors.New("slow down"))
+	_, ok := getRetryAfter(err, now)
+	assert.False(t, ok)
+	err.Meta().Set(retryAfterHeader, "2")
+	retryAfter, ok := getRetryAfter(err, now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, retryAfter)
+	err = connect.NewError(connect.CodeInvalidArgument, errors.New("bad"))
+	err.Meta().Set(retryAfterHeader, "2")
+	_, ok = getRetryAfter(err, now)
+	assert.False(t, ok)
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	rateLimiter := newRateLimiter(2, 2)
+	assert.Equal(t, time.Duration(0), rateLimiter.reserve(now))
+	assert.Equal(t, time.Duration(0), rateLimiter.reserve(now))
+	assert.Equal(t, 500*time.Millisecond, rateLimiter.reserve(now))
+	assert.Equal(t, time.Second, rateLimiter.reserve(now))
+	// After a second, two tokens have been added to pay off the two reserved
+	// tokens, so the next request must wait again.
+	assert.Equal(t, 500*time.Millisecond, rateLimiter.reserve(now.Add(time.Second)))
+	// After a long time, the bucket is full again.
+	assert.Equal(t, time.Duration(0), rateLimiter.reserve(now.Add(time.Minute)))
+	assert.Nil(t, newRateLimiter(0, 1))
+}
@@ -42,3 +42,64 @@ func testInterfaceSliceOrStringToCommaSepString(t *testing.T, in interface{}, ex
 	require.NoError(t, err)
 	require.Equal(t, expected, v)
 }
+
+func TestExpandYAMLEnv(t *testing.T) {
+	t.Parallel()
+	lookupEnv := func(key string) (string, bool) {
+		switch key {
+		case "REMOTE":
+			return "buf.example.com", true
+		case "EMPTY":
+			return "", true
+		default:
+			return "", false
+		}
+	}
+	data, err := ExpandYAMLEnv(
+		[]byte(`version: v1
+# ${COMMENT} is not expanded.
+name: ${REMOTE}/acme/weather
+deps:
+  - ${REMOTE}/acme/units
+  - ${EMPTY:-buf.build}/googleapis/googleapis
+  - ${UNSET:-buf.build}/acme/${OWNER:-pets}
+lint:
+  ignore:
+    - $${REMOTE}
+`),
+		lookupEnv,
+	)
+	require.NoError(t, err)
+	var externalConfig struct {
+		Version string   `yaml:"version"`
+		Name    string   `yaml:"name"`
+		Deps    []string `yaml:"deps"`
+		Lint    struct {
+			Ignore []string `yaml:"ignore"`
+		} `yaml:"lint"`
+	}
+	require.NoError(t, UnmarshalYAMLStrict(data, &externalConfig))
+	require.Equal(t, "buf.example.com/acme/weather", externalConfig.Name)
+	require.Equal(
+		t,
+		[]string{
+			"buf.example.com/acme/units",
+			"buf.build/googleapis/googleapis",
+			"buf.build/acme/pets",
+		},
+		externalConfig.Deps,
+	)
+	require.Equal(t, []string{"${REMOTE}"}, externalConfig.Lint.Ignore)
+
+	_, err = ExpandYAMLEnv([]byte("name: ${UNSET}/acme/${EMPTY}\n"), lookupEnv)
+	require.EqualError(t, err, "environment variables referenced in configuration are not set: EMPTY, UNSET")
+	_, err = ExpandYAMLEnv([]byte("name: ${1INVALID}\n"), lookupEnv)
+	require.Error(t, err)
+	_, err = ExpandYAMLEnv([]byte("name: ${UNTERMINATED\n"), lookupEnv)
+	require.Error(t, err)
+
+	data = []byte("name: $REMOTE\n")
+	expandedData, err := ExpandYAMLEnv(data, lookupEnv)
+	require.NoError(t, err)
+	require.Equal(t, data, expandedData)
+}
@@ -0,0 +1,137 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExpandYAMLEnv expands references to environment variables within the scalar values
+// of the YAML data, using lookupEnv to get the value of each environment variable.
+//
+// References are of the form ${KEY}, or ${KEY:-default} to use default if KEY is not set
+// or empty. A literal ${ can be written as $${. Keys and comments are never expanded.
+//
+// Returns an error listing every referenced environment variable that is not set or empty and has
+// no default. If the data does not contain any references, it is returned unchanged.
+func ExpandYAMLEnv(data []byte, lookupEnv func(string) (string, bool)) ([]byte, error) {
+	if !bytes.Contains(data, []byte("${")) {
+		return data, nil
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("could not unmarshal as YAML: %v", err)
+	}
+	unsetKeySet := make(map[string]struct{})
+	if err := expandYAMLNodeEnv(&node, lookupEnv, unsetKeySet); err != nil {
+		return nil, err
+	}
+	if len(unsetKeySet) > 0 {
+		unsetKeys := make([]string, 0, len(unsetKeySet))
+		for unsetKey := range unsetKeySet {
+			unsetKeys = append(unsetKeys, unsetKey)
+		}
+		sort.Strings(unsetKeys)
+		return nil, fmt.Errorf("environment variables referenced in configuration are not set: %s", strings.Join(unsetKeys, ", "))
+	}
+	return MarshalYAML(&node)
+}
+
+func expandYAMLNodeEnv(node *yaml.Node, lookupEnv func(string) (string, bool), unsetKeySet map[string]struct{}) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		value, err := expandEnv(node.Value, lookupEnv, unsetKeySet)
+		if err != nil {
+			return err
+		}
+		node.Value = value
+	case yaml.MappingNode:
+		// Only expand the values, which are at the odd indexes.
+		for i := 1; i < len(node.Content); i += 2 {
+			if err := expandYAMLNodeEnv(node.Content[i], lookupEnv, unsetKeySet); err != nil {
+				return err
+			}
+		}
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			if err := expandYAMLNodeEnv(child, lookupEnv, unsetKeySet); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// expandEnv expands the ${KEY} and ${KEY:-default} references within the value.
+//
+// Keys that are not set and have no default are added to unsetKeySet.
+func expandEnv(value string, lookupEnv func(string) (string, bool), unsetKeySet map[string]struct{}) (string, error) {
+	var builder strings.Builder
+	for len(value) > 0 {
+		index := strings.Index(value, "${")
+		if index < 0 {
+			builder.WriteString(value)
+			break
+		}
+		if index > 0 && value[index-1] == '$' {
+			// Escaped, write everything up to and including the ${ without the escaping $.
+			builder.WriteString(value[:index-1])
+			builder.WriteString("${")
+			value = value[index+2:]
+			continue
+		}
+		builder.WriteString(value[:index])
+		end := strings.IndexByte(value[index:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated environment variable reference in %q", value)
+		}
+		reference := value[index+2 : index+end]
+		value = value[index+end+1:]
+		key, defaultValue, hasDefault := strings.Cut(reference, ":-")
+		if !isValidEnvKey(key) {
+			return "", fmt.Errorf("invalid environment variable reference ${%s}", reference)
+		}
+		if envValue, ok := lookupEnv(key); ok && envValue != "" {
+			builder.WriteString(envValue)
+			continue
+		}
+		if hasDefault {
+			builder.WriteString(defaultValue)
+			continue
+		}
+		unsetKeySet[key] = struct{}{}
+	}
+	return builder.String(), nil
+}
+
+func isValidEnvKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, c := range key {
+		switch {
+		case c == '_', 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z':
+		case '0' <= c && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
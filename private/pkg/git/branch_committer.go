@@ -0,0 +1,126 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os/exec"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/tmp"
+	"go.uber.org/multierr"
+)
+
+type branchCommitter struct {
+	runner command.Runner
+}
+
+func newBranchCommitter(runner command.Runner) *branchCommitter {
+	return &branchCommitter{
+		runner: runner,
+	}
+}
+
+func (b *branchCommitter) CommitToBranch(
+	ctx context.Context,
+	envContainer app.EnvContainer,
+	repositoryDirPath string,
+	branch string,
+	message string,
+	write func(worktreeDirPath string) error,
+) (_ bool, retErr error) {
+	branchExists, err := b.branchExists(ctx, envContainer, repositoryDirPath, branch)
+	if err != nil {
+		return false, err
+	}
+	worktreeDir, err := tmp.NewDir()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, worktreeDir.Close())
+	}()
+	worktreeDirPath := worktreeDir.AbsPath()
+	if branchExists {
+		if _, err := b.run(ctx, envContainer, repositoryDirPath, "worktree", "add", "--quiet", worktreeDirPath, branch); err != nil {
+			return false, err
+		}
+	} else {
+		if _, err := b.run(ctx, envContainer, repositoryDirPath, "worktree", "add", "--quiet", "--detach", worktreeDirPath); err != nil {
+			return false, err
+		}
+	}
+	defer func() {
+		_, err := b.run(ctx, envContainer, repositoryDirPath, "worktree", "remove", "--force", worktreeDirPath)
+		retErr = multierr.Append(retErr, err)
+	}()
+	if !branchExists {
+		if _, err := b.run(ctx, envContainer, worktreeDirPath, "checkout", "--quiet", "--orphan", branch); err != nil {
+			return false, err
+		}
+	}
+	// The branch only contains what is written, files that are not written
+	// again are deleted.
+	if _, err := b.run(ctx, envContainer, worktreeDirPath, "rm", "-r", "--quiet", "--force", "--ignore-unmatch", "--", "."); err != nil {
+		return false, err
+	}
+	if err := write(worktreeDirPath); err != nil {
+		return false, err
+	}
+	if _, err := b.run(ctx, envContainer, worktreeDirPath, "add", "--all"); err != nil {
+		return false, err
+	}
+	status, err := b.run(ctx, envContainer, worktreeDirPath, "status", "--porcelain")
+	if err != nil {
+		return false, err
+	}
+	if len(bytes.TrimSpace(status)) == 0 {
+		return false, nil
+	}
+	if _, err := b.run(ctx, envContainer, worktreeDirPath, "commit", "--quiet", "--message", message); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (b *branchCommitter) branchExists(
+	ctx context.Context,
+	envContainer app.EnvContainer,
+	repositoryDirPath string,
+	branch string,
+) (bool, error) {
+	_, err := b.run(ctx, envContainer, repositoryDirPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	if err == nil {
+		return true, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return false, nil
+	}
+	return false, err
+}
+
+// run runs git with the args in the directory, and returns its stdout.
+func (b *branchCommitter) run(
+	ctx context.Context,
+	envContainer app.EnvContainer,
+	dirPath string,
+	args ...string,
+) ([]byte, error) {
+	return runGit(ctx, b.runner, envContainer, dirPath, args...)
+}
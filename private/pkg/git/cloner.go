@@ -104,6 +104,35 @@ func (c *cloner) CloneToBucket(
 
 	depthArg := strconv.Itoa(int(depth))
 
+	timeout := c.options.Timeout
+	if c.options.TimeoutEnvKey != "" {
+		envTimeout, err := app.EnvDuration(envContainer, c.options.TimeoutEnvKey, timeout)
+		if err != nil {
+			return err
+		}
+		timeout = envTimeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	if c.options.NoPromptEnvKey != "" {
+		noPrompt, err := app.EnvBool(envContainer, c.options.NoPromptEnvKey, false)
+		if err != nil {
+			return err
+		}
+		if noPrompt {
+			envContainer = app.NewEnvContainerWithOverrides(
+				envContainer,
+				map[string]string{
+					"GIT_TERMINAL_PROMPT": "0",
+				},
+			)
+		}
+	}
+
 	bareDir, err := tmp.NewDir()
 	if err != nil {
 		span.RecordError(err)
@@ -185,6 +214,9 @@ func (c *cloner) CloneToBucket(
 		command.RunWithEnv(app.EnvironMap(envContainer)),
 		command.RunWithStderr(buffer),
 	); err != nil {
+		if isAuthenticationFailure(buffer.String()) {
+			return fmt.Errorf("%w: %v", ErrAuthenticationFailed, newGitCommandError(err, buffer, bareDir))
+		}
 		return newGitCommandError(err, buffer, bareDir)
 	}
 
@@ -388,6 +420,24 @@ func getRefspecsForName(gitName Name) (fetchRefSpec string, worktreeRefSpec stri
 	}
 }
 
+// isAuthenticationFailure returns true if the stderr of a git command shows
+// that the remote required credentials that were not provided, or rejected the
+// provided credentials.
+func isAuthenticationFailure(stderr string) bool {
+	for _, message := range []string{
+		"Authentication failed",
+		"could not read Username",
+		"could not read Password",
+		"terminal prompts disabled",
+		"Permission denied (publickey",
+	} {
+		if strings.Contains(stderr, message) {
+			return true
+		}
+	}
+	return false
+}
+
 func newGitCommandError(
 	err error,
 	buffer *bytes.Buffer,
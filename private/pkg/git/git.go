@@ -16,7 +16,10 @@ package git
 
 import (
 	"context"
+	"errors"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/command"
@@ -25,6 +28,10 @@ import (
 	"go.uber.org/zap"
 )
 
+// ErrAuthenticationFailed is returned by a Cloner when the remote requires
+// credentials that were not provided, or rejects the provided credentials.
+var ErrAuthenticationFailed = errors.New("authentication failed")
+
 // Name is a name identifiable by git.
 type Name interface {
 	// If cloneBranch returns a non-empty string, any clones will be performed with --branch set to the value.
@@ -92,6 +99,16 @@ type ClonerOptions struct {
 	HTTPSPasswordEnvKey      string
 	SSHKeyFileEnvKey         string
 	SSHKnownHostsFilesEnvKey string
+	// TimeoutEnvKey is the environment variable key for the maximum duration of
+	// a clone, for example "5m". If unset, Timeout applies.
+	TimeoutEnvKey string
+	// Timeout is the maximum duration of a clone if the environment variable of
+	// TimeoutEnvKey is not set. If 0, only the deadline of the context applies.
+	Timeout time.Duration
+	// NoPromptEnvKey is the environment variable key that, if set to true, stops
+	// git from prompting on the terminal for credentials. A clone that requires
+	// credentials then fails with ErrAuthenticationFailed instead.
+	NoPromptEnvKey string
 }
 
 // Lister lists files in git repositories.
@@ -118,6 +135,25 @@ type Lister interface {
 		envContainer app.EnvStdioContainer,
 		options ListFilesAndUnstagedFilesOptions,
 	) ([]string, error)
+	// ListChangedFiles lists the files in the directory that changed relative to
+	// the git ref, including staged, unstaged and untracked files.
+	//
+	// This does not list deleted files.
+	// This does not list ignored files.
+	//
+	// The returned paths will be normalized and relative to the directory.
+	//
+	// This is the equivalent of doing:
+	//
+	//	cd DIR_PATH && sort -u \
+	//		<(git diff --name-only --relative --diff-filter=d GIT_REF -- .) \
+	//		<(git ls-files --others --exclude-standard)
+	ListChangedFiles(
+		ctx context.Context,
+		envContainer app.EnvContainer,
+		dirPath string,
+		gitRef string,
+	) ([]string, error)
 }
 
 // NewLister returns a new Lister.
@@ -125,6 +161,50 @@ func NewLister(runner command.Runner) Lister {
 	return newLister(runner)
 }
 
+// BranchCommitter commits files to branches of git repositories.
+type BranchCommitter interface {
+	// CommitToBranch checks out the branch of the repository in a temporary worktree,
+	// replaces the files of the branch with the files that write writes to the
+	// worktree directory, and commits the changes to the branch with the message.
+	//
+	// The branch is created as a branch without history if it does not exist. The
+	// checked out branch of the repository and its working directory are not changed.
+	//
+	// Returns false if the files of the branch did not change, in which case nothing
+	// is committed.
+	CommitToBranch(
+		ctx context.Context,
+		envContainer app.EnvContainer,
+		repositoryDirPath string,
+		branch string,
+		message string,
+		write func(worktreeDirPath string) error,
+	) (bool, error)
+}
+
+// NewBranchCommitter returns a new BranchCommitter.
+func NewBranchCommitter(runner command.Runner) BranchCommitter {
+	return newBranchCommitter(runner)
+}
+
+// RevParse runs git rev-parse with the args in the directory, and returns its
+// output with surrounding whitespace trimmed.
+//
+// If dirPath is empty, git is run in the current directory.
+func RevParse(
+	ctx context.Context,
+	runner command.Runner,
+	envContainer app.EnvContainer,
+	dirPath string,
+	args ...string,
+) (string, error) {
+	output, err := runGit(ctx, runner, envContainer, dirPath, append([]string{"rev-parse"}, args...)...)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
 // ListFilesAndUnstagedFilesOptions are options for ListFilesAndUnstagedFiles.
 type ListFilesAndUnstagedFilesOptions struct {
 	// IgnorePathRegexps are regexes of paths to ignore.
@@ -17,6 +17,7 @@ package git
 import (
 	"context"
 	"errors"
+	"net/http"
 	"net/http/cgi"
 	"net/http/httptest"
 	"os"
@@ -174,6 +175,167 @@ func TestGitCloner(t *testing.T) {
 	})
 }
 
+func TestGitClonerNoPrompt(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseWriter.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+		responseWriter.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+	container := app.NewEnvContainer(map[string]string{
+		"PATH":           os.Getenv("PATH"),
+		"TEST_NO_PROMPT": "true",
+	})
+	cloner := NewCloner(
+		zap.NewNop(),
+		storageos.NewProvider(),
+		command.NewRunner(),
+		ClonerOptions{NoPromptEnvKey: "TEST_NO_PROMPT"},
+	)
+	err := cloner.CloneToBucket(
+		context.Background(),
+		container,
+		server.URL+"/repository.git",
+		1,
+		storagemem.NewReadWriteBucket(),
+		CloneToBucketOptions{},
+	)
+	assert.ErrorIs(t, err, ErrAuthenticationFailed)
+}
+
+func TestBranchCommitter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	container, err := app.NewContainerForOS()
+	require.NoError(t, err)
+	runner := command.NewRunner()
+	_, workDir := createGitDirs(ctx, t, container, runner)
+	branchCommitter := NewBranchCommitter(runner)
+	writeFiles := func(pathToContent map[string]string) func(string) error {
+		return func(worktreeDirPath string) error {
+			for path, content := range pathToContent {
+				filePath := filepath.Join(worktreeDirPath, path)
+				if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+					return err
+				}
+				if err := os.WriteFile(filePath, []byte(content), 0600); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	readBranch := func(branch string) storage.ReadBucket {
+		return readBucketForName(ctx, t, runner, workDir, 1, NewBranchName(branch), false)
+	}
+
+	committed, err := branchCommitter.CommitToBranch(
+		ctx,
+		container,
+		workDir,
+		"generated",
+		"generate 0",
+		writeFiles(map[string]string{"gen/a.proto": "// a", "gen/b.proto": "// b"}),
+	)
+	require.NoError(t, err)
+	assert.True(t, committed)
+	readBucket := readBranch("generated")
+	content, err := storage.ReadPath(ctx, readBucket, "gen/a.proto")
+	require.NoError(t, err)
+	assert.Equal(t, "// a", string(content))
+	_, err = readBucket.Stat(ctx, "test.proto")
+	assert.True(t, storage.IsNotExist(err), "expected the new branch to not contain the files of the checked out branch")
+
+	committed, err = branchCommitter.CommitToBranch(
+		ctx,
+		container,
+		workDir,
+		"generated",
+		"generate 1",
+		writeFiles(map[string]string{"gen/a.proto": "// a", "gen/b.proto": "// b"}),
+	)
+	require.NoError(t, err)
+	assert.False(t, committed, "expected no commit if the files did not change")
+
+	committed, err = branchCommitter.CommitToBranch(
+		ctx,
+		container,
+		workDir,
+		"generated",
+		"generate 2",
+		writeFiles(map[string]string{"gen/a.proto": "// a2"}),
+	)
+	require.NoError(t, err)
+	assert.True(t, committed)
+	readBucket = readBranch("generated")
+	content, err = storage.ReadPath(ctx, readBucket, "gen/a.proto")
+	require.NoError(t, err)
+	assert.Equal(t, "// a2", string(content))
+	_, err = readBucket.Stat(ctx, "gen/b.proto")
+	assert.True(t, storage.IsNotExist(err), "expected files that were not written again to be deleted")
+	log, err := command.RunStdout(ctx, container, runner, "git", "-C", workDir, "log", "--format=%s", "generated")
+	require.NoError(t, err)
+	assert.Equal(t, "generate 2\ngenerate 0", strings.TrimSpace(string(log)))
+
+	// The checked out branch and its working directory are not changed.
+	currentBranch, err := command.RunStdout(ctx, container, runner, "git", "-C", workDir, "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "local-branch", strings.TrimSpace(string(currentBranch)))
+	content, err = os.ReadFile(filepath.Join(workDir, "test.proto"))
+	require.NoError(t, err)
+	assert.Equal(t, "// commit 2", string(content))
+	_, err = os.Stat(filepath.Join(workDir, "gen"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestListerListChangedFiles(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	container, err := app.NewContainerForOS()
+	require.NoError(t, err)
+	runner := command.NewRunner()
+	_, workDir := createGitDirs(ctx, t, container, runner)
+	protoDir := filepath.Join(workDir, "proto")
+	require.NoError(t, os.MkdirAll(filepath.Join(protoDir, "foo"), 0755))
+	for _, path := range []string{"a.proto", "b.proto", "c.proto"} {
+		require.NoError(t, os.WriteFile(filepath.Join(protoDir, path), []byte("// "+path), 0600))
+	}
+	runCommand(ctx, t, container, runner, "git", "-C", workDir, "add", ".")
+	runCommand(ctx, t, container, runner, "git", "-C", workDir, "commit", "-m", "commit 3")
+	lister := NewLister(runner)
+
+	changedFiles, err := lister.ListChangedFiles(ctx, container, protoDir, "HEAD")
+	require.NoError(t, err)
+	assert.Empty(t, changedFiles)
+
+	// A staged file, an unstaged file, a deleted file, an untracked file, an
+	// ignored file and a file outside of the directory.
+	require.NoError(t, os.WriteFile(filepath.Join(protoDir, "a.proto"), []byte("// a2"), 0600))
+	runCommand(ctx, t, container, runner, "git", "-C", workDir, "add", "proto/a.proto")
+	require.NoError(t, os.WriteFile(filepath.Join(protoDir, "b.proto"), []byte("// b2"), 0600))
+	require.NoError(t, os.Remove(filepath.Join(protoDir, "c.proto")))
+	require.NoError(t, os.WriteFile(filepath.Join(protoDir, "foo", "d.proto"), []byte("// d"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(protoDir, ".gitignore"), []byte("e.proto\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(protoDir, "e.proto"), []byte("// e"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "test.proto"), []byte("// commit 4"), 0600))
+
+	changedFiles, err = lister.ListChangedFiles(ctx, container, protoDir, "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, []string{".gitignore", "a.proto", "b.proto", "foo/d.proto"}, changedFiles)
+
+	// Changes relative to an earlier commit include the committed changes.
+	changedFiles, err = lister.ListChangedFiles(ctx, container, workDir, "HEAD~1")
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		[]string{"proto/.gitignore", "proto/a.proto", "proto/b.proto", "proto/foo/d.proto", "test.proto"},
+		changedFiles,
+	)
+
+	_, err = lister.ListChangedFiles(ctx, container, protoDir, "unknown-ref")
+	assert.Error(t, err)
+}
+
 func readBucketForName(ctx context.Context, t *testing.T, runner command.Runner, path string, depth uint32, name Name, recurseSubmodules bool) storage.ReadBucket {
 	t.Helper()
 	storageosProvider := storageos.NewProvider(storageos.ProviderWithSymlinks())
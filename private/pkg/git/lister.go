@@ -18,9 +18,11 @@ import (
 	"context"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/bufbuild/buf/private/pkg/app"
 	"github.com/bufbuild/buf/private/pkg/command"
+	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/stringutil"
 )
 
@@ -79,6 +81,53 @@ func (l *lister) ListFilesAndUnstagedFiles(
 	), nil
 }
 
+func (l *lister) ListChangedFiles(
+	ctx context.Context,
+	envContainer app.EnvContainer,
+	dirPath string,
+	gitRef string,
+) ([]string, error) {
+	diffOutput, err := runGit(
+		ctx,
+		l.runner,
+		envContainer,
+		dirPath,
+		"diff",
+		"--name-only",
+		"-z",
+		"--relative",
+		"--diff-filter=d",
+		gitRef,
+		"--",
+		".",
+	)
+	if err != nil {
+		return nil, err
+	}
+	untrackedOutput, err := runGit(
+		ctx,
+		l.runner,
+		envContainer,
+		dirPath,
+		"ls-files",
+		"-z",
+		"--others",
+		"--exclude-standard",
+	)
+	if err != nil {
+		return nil, err
+	}
+	var changedFiles []string
+	for _, output := range [][]byte{diffOutput, untrackedOutput} {
+		for _, file := range strings.Split(string(output), "\x00") {
+			if file != "" {
+				changedFiles = append(changedFiles, normalpath.Normalize(file))
+			}
+		}
+	}
+	return stringutil.SliceToUniqueSortedSlice(changedFiles), nil
+}
+
 // stringSliceExcept returns all elements in source that are not in except.
 func stringSliceExcept(source []string, except []string) []string {
 	exceptMap := stringutil.SliceToMap(except)
@@ -35,6 +35,24 @@ type Authenticator interface {
 	SetAuth(envContainer app.EnvContainer, request *http.Request) (bool, error)
 }
 
+// ChallengeAuthenticator is an Authenticator that can also add authentication
+// to a request after the server rejected it as unauthorized.
+type ChallengeAuthenticator interface {
+	Authenticator
+	// SetChallengeAuth sets authentication on a request that the server rejected
+	// with http.StatusUnauthorized.
+	//
+	// Returns true if authentication successfully set.
+	// Does nothing and returns false if no further authentication available for the given request.
+	// Does nothing and returns false if the request scheme is not https.
+	SetChallengeAuth(envStdinContainer app.EnvStdinContainer, request *http.Request) (bool, error)
+}
+
+// PromptFunc prompts for the username and password for the host.
+//
+// Returns empty values if credentials cannot be prompted for.
+type PromptFunc func(envStdinContainer app.EnvStdinContainer, host string) (username string, password string, err error)
+
 // NewEnvAuthenticator returns a new env Authenticator for the environment.
 func NewEnvAuthenticator(usernameKey string, passwordKey string) Authenticator {
 	return newEnvAuthenticator(
@@ -61,3 +79,12 @@ func NewNopAuthenticator() Authenticator {
 func NewMultiAuthenticator(authenticators ...Authenticator) Authenticator {
 	return newMultiAuthenticator(authenticators...)
 }
+
+// NewPromptAuthenticator returns a new ChallengeAuthenticator that sets authentication
+// with the delegate, and prompts for credentials with the PromptFunc when the server
+// rejects a request as unauthorized.
+//
+// The prompted credentials are used for all later requests to the same host.
+func NewPromptAuthenticator(delegate Authenticator, promptFunc PromptFunc) ChallengeAuthenticator {
+	return newPromptAuthenticator(delegate, promptFunc)
+}
@@ -0,0 +1,103 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpauth
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/bufbuild/buf/private/pkg/app"
+)
+
+type promptAuthenticator struct {
+	delegate   Authenticator
+	promptFunc PromptFunc
+
+	// hostToCredentials are the prompted credentials for each host.
+	hostToCredentials map[string]*credentials
+	lock              sync.Mutex
+}
+
+func newPromptAuthenticator(delegate Authenticator, promptFunc PromptFunc) *promptAuthenticator {
+	return &promptAuthenticator{
+		delegate:          delegate,
+		promptFunc:        promptFunc,
+		hostToCredentials: make(map[string]*credentials),
+	}
+}
+
+func (a *promptAuthenticator) SetAuth(envContainer app.EnvContainer, request *http.Request) (bool, error) {
+	if request.URL == nil {
+		return false, errors.New("malformed request: no url")
+	}
+	a.lock.Lock()
+	credentials, ok := a.hostToCredentials[request.URL.Host]
+	a.lock.Unlock()
+	if ok {
+		return setBasicAuth(
+			request,
+			credentials.username,
+			credentials.password,
+			"prompted username",
+			"prompted password",
+		)
+	}
+	return a.delegate.SetAuth(envContainer, request)
+}
+
+func (a *promptAuthenticator) SetChallengeAuth(envStdinContainer app.EnvStdinContainer, request *http.Request) (bool, error) {
+	if request.URL == nil {
+		return false, errors.New("malformed request: no url")
+	}
+	if request.URL.Scheme != "https" {
+		return false, nil
+	}
+	// Only prompt once at a time, so that concurrent requests to the same
+	// host do not prompt for the same credentials.
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if credentials, ok := a.hostToCredentials[request.URL.Host]; ok {
+		// The request was sent before another request prompted for credentials.
+		if username, password, ok := request.BasicAuth(); !ok || username != credentials.username || password != credentials.password {
+			request.SetBasicAuth(credentials.username, credentials.password)
+			return true, nil
+		}
+	}
+	username, password, err := a.promptFunc(envStdinContainer, request.URL.Host)
+	if err != nil {
+		return false, err
+	}
+	ok, err := setBasicAuth(
+		request,
+		username,
+		password,
+		"prompted username",
+		"prompted password",
+	)
+	if err != nil || !ok {
+		return ok, err
+	}
+	a.hostToCredentials[request.URL.Host] = &credentials{
+		username: username,
+		password: password,
+	}
+	return true, nil
+}
+
+type credentials struct {
+	username string
+	password string
+}
@@ -0,0 +1,337 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package importcycle finds import cycles and suggests imports to remove to break them.
+//
+// The nodes of the graph are opaque strings, and can be files or modules depending
+// on what is being analyzed.
+package importcycle
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Import is a single import statement that creates an edge from one node to another.
+type Import struct {
+	// From is the importing node.
+	From string
+	// To is the imported node.
+	To string
+	// ImportPath is the path as written in the import statement.
+	ImportPath string
+	// Path is the external path of the file that contains the import statement.
+	Path string
+	// Line is the line of the import statement, or 0 if unknown.
+	Line int
+	// Column is the column of the import statement, or 0 if unknown.
+	Column int
+}
+
+// Location returns the location of the import statement in the form path:line:column.
+//
+// The line and column are omitted if unknown.
+func (i *Import) Location() string {
+	location := i.Path
+	if i.Line > 0 {
+		location += ":" + strconv.Itoa(i.Line)
+		if i.Column > 0 {
+			location += ":" + strconv.Itoa(i.Column)
+		}
+	}
+	return location
+}
+
+// Edge is the set of import statements from one node to another.
+type Edge struct {
+	From string
+	To   string
+	// Imports are the import statements that make up this edge, sorted by location.
+	//
+	// Never empty.
+	Imports []*Import
+}
+
+// Cycle is an import cycle.
+type Cycle struct {
+	// Edges are the edges that make up the cycle, in order.
+	//
+	// The From of the first Edge is the To of the last Edge, and the first
+	// Edge starts at the lexicographically smallest node of the cycle.
+	Edges []*Edge
+	// BreakPoint is the Edge we suggest removing to break this cycle.
+	//
+	// Break points are chosen greedily across all cycles of the graph, preferring
+	// Edges that break many cycles at once and are made up of few import statements.
+	BreakPoint *Edge
+}
+
+// Nodes returns the nodes of the cycle, starting and ending with the same node.
+func (c *Cycle) Nodes() []string {
+	nodes := make([]string, 0, len(c.Edges)+1)
+	for _, edge := range c.Edges {
+		nodes = append(nodes, edge.From)
+	}
+	return append(nodes, c.Edges[0].From)
+}
+
+// String returns the cycle in the form "a -> b -> c -> a".
+func (c *Cycle) String() string {
+	return strings.Join(c.Nodes(), " -> ")
+}
+
+// Description returns a multi-line description of the cycle.
+//
+// The first line is the cycle itself, followed by the location of every import
+// statement that is part of the cycle and the suggested break point.
+func (c *Cycle) Description() string {
+	var builder strings.Builder
+	builder.WriteString(c.String())
+	builder.WriteString("\n")
+	for _, edge := range c.Edges {
+		for _, fileImport := range edge.Imports {
+			fmt.Fprintf(&builder, "  %s: %s imports %q\n", fileImport.Location(), edge.From, fileImport.ImportPath)
+		}
+	}
+	if c.BreakPoint != nil {
+		imports := "the import"
+		if len(c.BreakPoint.Imports) > 1 {
+			imports = "the " + strconv.Itoa(len(c.BreakPoint.Imports)) + " imports"
+		}
+		fmt.Fprintf(
+			&builder,
+			"  suggested break point: remove %s from %s to %s\n",
+			imports,
+			c.BreakPoint.From,
+			c.BreakPoint.To,
+		)
+	}
+	return builder.String()
+}
+
+// FindCycles finds the import cycles in the graph formed by the given imports.
+//
+// Every Edge that is part of a cycle is part of at least one returned Cycle. Note
+// that this does not return every elementary cycle of the graph, which can be
+// exponential in number.
+//
+// The returned Cycles are sorted by their String value.
+func FindCycles(imports []*Import) []*Cycle {
+	graph := newGraph(imports)
+	cycles := graph.findCycles(nil)
+	if len(cycles) == 0 {
+		return nil
+	}
+	// Greedily remove the best break point of the remaining cycles until the graph
+	// is acyclic. Every original cycle must contain at least one removed edge once
+	// we are done, otherwise it would still be present.
+	removedEdges := make(map[*Edge]struct{})
+	var breakPoints []*Edge
+	for remainingCycles := cycles; len(remainingCycles) > 0; remainingCycles = graph.findCycles(removedEdges) {
+		breakPoint := selectBreakPoint(remainingCycles)
+		removedEdges[breakPoint] = struct{}{}
+		breakPoints = append(breakPoints, breakPoint)
+	}
+	for _, cycle := range cycles {
+		for _, breakPoint := range breakPoints {
+			if cycle.contains(breakPoint) {
+				cycle.BreakPoint = breakPoint
+				break
+			}
+		}
+	}
+	sort.Slice(
+		cycles,
+		func(i int, j int) bool {
+			return cycles[i].String() < cycles[j].String()
+		},
+	)
+	return cycles
+}
+
+func (c *Cycle) contains(edge *Edge) bool {
+	for _, cycleEdge := range c.Edges {
+		if cycleEdge == edge {
+			return true
+		}
+	}
+	return false
+}
+
+type graph struct {
+	// sorted
+	nodes []string
+	// the edges of each node are sorted by To
+	nodeToEdges map[string][]*Edge
+}
+
+func newGraph(imports []*Import) *graph {
+	nodeToToToEdge := make(map[string]map[string]*Edge)
+	for _, fileImport := range imports {
+		toToEdge, ok := nodeToToToEdge[fileImport.From]
+		if !ok {
+			toToEdge = make(map[string]*Edge)
+			nodeToToToEdge[fileImport.From] = toToEdge
+		}
+		if _, ok := nodeToToToEdge[fileImport.To]; !ok {
+			nodeToToToEdge[fileImport.To] = make(map[string]*Edge)
+		}
+		edge, ok := toToEdge[fileImport.To]
+		if !ok {
+			edge = &Edge{
+				From: fileImport.From,
+				To:   fileImport.To,
+			}
+			toToEdge[fileImport.To] = edge
+		}
+		edge.Imports = append(edge.Imports, fileImport)
+	}
+	graph := &graph{
+		nodes:       make([]string, 0, len(nodeToToToEdge)),
+		nodeToEdges: make(map[string][]*Edge, len(nodeToToToEdge)),
+	}
+	for node, toToEdge := range nodeToToToEdge {
+		graph.nodes = append(graph.nodes, node)
+		edges := make([]*Edge, 0, len(toToEdge))
+		for _, edge := range toToEdge {
+			sort.Slice(
+				edge.Imports,
+				func(i int, j int) bool {
+					return compareImports(edge.Imports[i], edge.Imports[j]) < 0
+				},
+			)
+			edges = append(edges, edge)
+		}
+		sort.Slice(
+			edges,
+			func(i int, j int) bool {
+				return edges[i].To < edges[j].To
+			},
+		)
+		graph.nodeToEdges[node] = edges
+	}
+	sort.Strings(graph.nodes)
+	return graph
+}
+
+// findCycles does a depth-first search of the graph, ignoring the removed edges,
+// and returns a cycle for every back edge found.
+func (g *graph) findCycles(removedEdges map[*Edge]struct{}) []*Cycle {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(g.nodes))
+	var nodeStack []string
+	// edgeStack[i] is the edge from nodeStack[i] to nodeStack[i+1]
+	var edgeStack []*Edge
+	var cycles []*Cycle
+	var visit func(string)
+	visit = func(node string) {
+		state[node] = visiting
+		nodeStack = append(nodeStack, node)
+		for _, edge := range g.nodeToEdges[node] {
+			if _, ok := removedEdges[edge]; ok {
+				continue
+			}
+			switch state[edge.To] {
+			case unvisited:
+				edgeStack = append(edgeStack, edge)
+				visit(edge.To)
+				edgeStack = edgeStack[:len(edgeStack)-1]
+			case visiting:
+				for i := len(nodeStack) - 1; i >= 0; i-- {
+					if nodeStack[i] == edge.To {
+						cycleEdges := append(append([]*Edge{}, edgeStack[i:]...), edge)
+						cycles = append(cycles, newCycle(cycleEdges))
+						break
+					}
+				}
+			}
+		}
+		nodeStack = nodeStack[:len(nodeStack)-1]
+		state[node] = visited
+	}
+	for _, node := range g.nodes {
+		if state[node] == unvisited {
+			visit(node)
+		}
+	}
+	return cycles
+}
+
+// newCycle returns a new Cycle for the edges, rotated so that the cycle starts
+// at its lexicographically smallest node.
+func newCycle(edges []*Edge) *Cycle {
+	start := 0
+	for i, edge := range edges {
+		if edge.From < edges[start].From {
+			start = i
+		}
+	}
+	return &Cycle{
+		Edges: append(append([]*Edge{}, edges[start:]...), edges[:start]...),
+	}
+}
+
+// selectBreakPoint selects the edge that is part of the most cycles, preferring
+// edges made up of fewer import statements, and then edges that come first.
+func selectBreakPoint(cycles []*Cycle) *Edge {
+	edgeToCount := make(map[*Edge]int)
+	var edges []*Edge
+	for _, cycle := range cycles {
+		for _, edge := range cycle.Edges {
+			if _, ok := edgeToCount[edge]; !ok {
+				edges = append(edges, edge)
+			}
+			edgeToCount[edge]++
+		}
+	}
+	var breakPoint *Edge
+	for _, edge := range edges {
+		if breakPoint == nil {
+			breakPoint = edge
+			continue
+		}
+		if count, breakPointCount := edgeToCount[edge], edgeToCount[breakPoint]; count != breakPointCount {
+			if count > breakPointCount {
+				breakPoint = edge
+			}
+			continue
+		}
+		if len(edge.Imports) != len(breakPoint.Imports) {
+			if len(edge.Imports) < len(breakPoint.Imports) {
+				breakPoint = edge
+			}
+			continue
+		}
+		if edge.From < breakPoint.From || (edge.From == breakPoint.From && edge.To < breakPoint.To) {
+			breakPoint = edge
+		}
+	}
+	return breakPoint
+}
+
+func compareImports(one *Import, two *Import) int {
+	if one.Path != two.Path {
+		return strings.Compare(one.Path, two.Path)
+	}
+	if one.Line != two.Line {
+		return one.Line - two.Line
+	}
+	return one.Column - two.Column
+}
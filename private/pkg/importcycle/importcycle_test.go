@@ -0,0 +1,125 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importcycle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCyclesAcyclic(t *testing.T) {
+	t.Parallel()
+	cycles := FindCycles(
+		[]*Import{
+			newTestImport("a.proto", "b.proto", 5),
+			newTestImport("b.proto", "c.proto", 5),
+			newTestImport("a.proto", "c.proto", 6),
+		},
+	)
+	assert.Empty(t, cycles)
+}
+
+func TestFindCyclesSingle(t *testing.T) {
+	t.Parallel()
+	cycles := FindCycles(
+		[]*Import{
+			newTestImport("c.proto", "a.proto", 7),
+			newTestImport("a.proto", "b.proto", 5),
+			newTestImport("b.proto", "c.proto", 6),
+		},
+	)
+	require.Len(t, cycles, 1)
+	assert.Equal(t, []string{"a.proto", "b.proto", "c.proto", "a.proto"}, cycles[0].Nodes())
+	assert.Equal(t, "a.proto -> b.proto -> c.proto -> a.proto", cycles[0].String())
+	require.NotNil(t, cycles[0].BreakPoint)
+	assert.Equal(t, "a.proto", cycles[0].BreakPoint.From)
+	assert.Equal(t, "b.proto", cycles[0].BreakPoint.To)
+	assert.Equal(
+		t,
+		`a.proto -> b.proto -> c.proto -> a.proto
+  a.proto:5:1: a.proto imports "b.proto"
+  b.proto:6:1: b.proto imports "c.proto"
+  c.proto:7:1: c.proto imports "a.proto"
+  suggested break point: remove the import from a.proto to b.proto
+`,
+		cycles[0].Description(),
+	)
+}
+
+func TestFindCyclesSelfImport(t *testing.T) {
+	t.Parallel()
+	cycles := FindCycles(
+		[]*Import{
+			newTestImport("a.proto", "a.proto", 5),
+		},
+	)
+	require.Len(t, cycles, 1)
+	assert.Equal(t, "a.proto -> a.proto", cycles[0].String())
+}
+
+func TestFindCyclesSharedBreakPoint(t *testing.T) {
+	t.Parallel()
+	// a -> b -> c -> a and a -> b -> d -> a share a -> b, so removing
+	// it breaks both cycles.
+	cycles := FindCycles(
+		[]*Import{
+			newTestImport("a.proto", "b.proto", 5),
+			newTestImport("b.proto", "c.proto", 5),
+			newTestImport("b.proto", "d.proto", 6),
+			newTestImport("c.proto", "a.proto", 5),
+			newTestImport("d.proto", "a.proto", 5),
+		},
+	)
+	require.Len(t, cycles, 2)
+	assert.Equal(t, "a.proto -> b.proto -> c.proto -> a.proto", cycles[0].String())
+	assert.Equal(t, "a.proto -> b.proto -> d.proto -> a.proto", cycles[1].String())
+	for _, cycle := range cycles {
+		require.NotNil(t, cycle.BreakPoint)
+		assert.Equal(t, "a.proto", cycle.BreakPoint.From)
+		assert.Equal(t, "b.proto", cycle.BreakPoint.To)
+	}
+}
+
+func TestFindCyclesPrefersFewerImports(t *testing.T) {
+	t.Parallel()
+	// module graph where proto/a imports proto/b from two files, but
+	// proto/b only imports proto/a from one file
+	cycles := FindCycles(
+		[]*Import{
+			{From: "proto/a", To: "proto/b", ImportPath: "b/one.proto", Path: "proto/a/a1.proto", Line: 5, Column: 1},
+			{From: "proto/a", To: "proto/b", ImportPath: "b/two.proto", Path: "proto/a/a2.proto", Line: 5, Column: 1},
+			{From: "proto/b", To: "proto/a", ImportPath: "a/a1.proto", Path: "proto/b/two.proto", Line: 6, Column: 1},
+		},
+	)
+	require.Len(t, cycles, 1)
+	assert.Equal(t, "proto/a -> proto/b -> proto/a", cycles[0].String())
+	require.NotNil(t, cycles[0].BreakPoint)
+	assert.Equal(t, "proto/b", cycles[0].BreakPoint.From)
+	assert.Equal(t, "proto/a", cycles[0].BreakPoint.To)
+	assert.Contains(t, cycles[0].Description(), "  proto/a/a2.proto:5:1: proto/a imports \"b/two.proto\"\n")
+}
+
+func newTestImport(from string, to string, line int) *Import {
+	return &Import{
+		From:       from,
+		To:         to,
+		ImportPath: to,
+		Path:       from,
+		Line:       line,
+		Column:     1,
+	}
+}
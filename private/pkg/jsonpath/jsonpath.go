@@ -0,0 +1,60 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonpath selects values from decoded JSON with a subset of JSONPath.
+//
+// Paths are made of the following segments, optionally starting with "$":
+//
+//   - .name or ["name"] selects the value of a key of an object.
+//   - [n] selects the element at index n of an array. Negative indexes count from the end.
+//   - [*] or .* selects all elements of an array or all values of an object.
+//
+// For example, "$.items[0].name" or "items[*].tags[-1]".
+package jsonpath
+
+import (
+	"io"
+)
+
+// Path is a parsed path.
+type Path interface {
+	// String returns the path as given to Parse.
+	String() string
+	// Select returns the values in the decoded JSON value that match the Path.
+	//
+	// The value is expected to be the result of decoding JSON into an interface{}.
+	// Keys that do not exist and indexes that are out of range do not match.
+	Select(value interface{}) []interface{}
+
+	isPath()
+}
+
+// Parse parses the path.
+func Parse(path string) (Path, error) {
+	return parsePath(path)
+}
+
+// Decode decodes the JSON data into a value that can be passed to Path.Select.
+//
+// Numbers are decoded as json.Number so that they are not rounded.
+func Decode(reader io.Reader) (interface{}, error) {
+	return decode(reader)
+}
+
+// Format formats a selected value.
+//
+// Strings are returned as is, and all other values are returned as compact JSON.
+func Format(value interface{}) (string, error) {
+	return format(value)
+}
@@ -0,0 +1,80 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJSON = `{
+  "name": "store",
+  "items": [
+    {"id": "1", "tags": ["a", "b"], "price": 1.5},
+    {"id": "2", "tags": ["c"], "price": 10000000000000001}
+  ],
+  "detail": {"@type": "type.googleapis.com/acme.v1.Detail", "owner": {"name": "alice"}},
+  "dotted.key": true
+}`
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+	testSelect(t, "$", `{"detail":{"@type":"type.googleapis.com/acme.v1.Detail","owner":{"name":"alice"}},"dotted.key":true,"items":[{"id":"1","price":1.5,"tags":["a","b"]},{"id":"2","price":10000000000000001,"tags":["c"]}],"name":"store"}`)
+	testSelect(t, "$.name", "store")
+	testSelect(t, "name", "store")
+	testSelect(t, "items[0].id", "1")
+	testSelect(t, "$.items[-1].id", "2")
+	testSelect(t, "$.items[*].id", "1", "2")
+	testSelect(t, "$.items.*.tags[0]", "a", "c")
+	testSelect(t, "$.items[1].price", "10000000000000001")
+	testSelect(t, "$.items[0].tags", `["a","b"]`)
+	testSelect(t, `$["dotted.key"]`, "true")
+	testSelect(t, `$.detail['@type']`, "type.googleapis.com/acme.v1.Detail")
+	testSelect(t, "$.detail.owner.name", "alice")
+	testSelect(t, "$.items[2].id")
+	testSelect(t, "$.missing")
+	testSelect(t, "$.name.length")
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+	for _, path := range []string{
+		"",
+		"$.",
+		"$.items[0",
+		"$.items[a]",
+		"$.items[0]id",
+	} {
+		_, err := Parse(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func testSelect(t *testing.T, pathString string, expected ...string) {
+	path, err := Parse(pathString)
+	require.NoError(t, err)
+	value, err := Decode(strings.NewReader(testJSON))
+	require.NoError(t, err)
+	var actual []string
+	for _, selected := range path.Select(value) {
+		formatted, err := Format(selected)
+		require.NoError(t, err)
+		actual = append(actual, formatted)
+	}
+	assert.Equal(t, expected, actual, pathString)
+}
@@ -0,0 +1,210 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type segmentKind int
+
+const (
+	segmentKindKey segmentKind = iota + 1
+	segmentKindIndex
+	segmentKindWildcard
+)
+
+type segment struct {
+	kind  segmentKind
+	key   string
+	index int
+}
+
+type path struct {
+	value    string
+	segments []segment
+}
+
+func parsePath(value string) (*path, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, errors.New("path is empty")
+	}
+	segments, err := parseSegments(strings.TrimPrefix(value, "$"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", value, err)
+	}
+	return &path{
+		value:    value,
+		segments: segments,
+	}, nil
+}
+
+func (p *path) String() string {
+	return p.value
+}
+
+func (p *path) Select(value interface{}) []interface{} {
+	values := []interface{}{value}
+	for _, segment := range p.segments {
+		var next []interface{}
+		for _, value := range values {
+			next = append(next, segment.selectValues(value)...)
+		}
+		values = next
+	}
+	return values
+}
+
+func (*path) isPath() {}
+
+func (s segment) selectValues(value interface{}) []interface{} {
+	switch s.kind {
+	case segmentKindKey:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		child, ok := object[s.key]
+		if !ok {
+			return nil
+		}
+		return []interface{}{child}
+	case segmentKindIndex:
+		array, ok := value.([]interface{})
+		if !ok {
+			return nil
+		}
+		index := s.index
+		if index < 0 {
+			index += len(array)
+		}
+		if index < 0 || index >= len(array) {
+			return nil
+		}
+		return []interface{}{array[index]}
+	case segmentKindWildcard:
+		switch typedValue := value.(type) {
+		case []interface{}:
+			return typedValue
+		case map[string]interface{}:
+			keys := make([]string, 0, len(typedValue))
+			for key := range typedValue {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			children := make([]interface{}, 0, len(keys))
+			for _, key := range keys {
+				children = append(children, typedValue[key])
+			}
+			return children
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+}
+
+func parseSegments(value string) ([]segment, error) {
+	var segments []segment
+	for i := 0; i < len(value); {
+		switch value[i] {
+		case '.':
+			i++
+			if i < len(value) && value[i] == '*' {
+				segments = append(segments, segment{kind: segmentKindWildcard})
+				i++
+				continue
+			}
+			key, n := readName(value[i:])
+			if key == "" {
+				return nil, fmt.Errorf("expected a name at offset %d", i)
+			}
+			segments = append(segments, segment{kind: segmentKindKey, key: key})
+			i += n
+		case '[':
+			end := strings.IndexByte(value[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated [ at offset %d", i)
+			}
+			inner := value[i+1 : i+end]
+			bracketSegment, err := parseBracket(inner)
+			if err != nil {
+				return nil, fmt.Errorf("%w at offset %d", err, i)
+			}
+			segments = append(segments, bracketSegment)
+			i += end + 1
+		default:
+			if i != 0 {
+				return nil, fmt.Errorf("unexpected %q at offset %d", value[i], i)
+			}
+			// The first name does not need a leading dot.
+			key, n := readName(value)
+			segments = append(segments, segment{kind: segmentKindKey, key: key})
+			i += n
+		}
+	}
+	return segments, nil
+}
+
+func parseBracket(inner string) (segment, error) {
+	if inner == "*" {
+		return segment{kind: segmentKindWildcard}, nil
+	}
+	if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+		return segment{kind: segmentKindKey, key: inner[1 : len(inner)-1]}, nil
+	}
+	index, err := strconv.Atoi(inner)
+	if err != nil {
+		return segment{}, fmt.Errorf("expected an index, a quoted name, or * but got %q", inner)
+	}
+	return segment{kind: segmentKindIndex, index: index}, nil
+}
+
+// readName reads a name up to the next segment, returning the name and its length.
+func readName(value string) (string, int) {
+	n := strings.IndexAny(value, ".[")
+	if n < 0 {
+		n = len(value)
+	}
+	return value[:n], n
+}
+
+func decode(reader io.Reader) (interface{}, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func format(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// DiffTypeAdded is a path only in the newer manifest.
+	DiffTypeAdded DiffType = iota + 1
+	// DiffTypeRemoved is a path only in the older manifest.
+	DiffTypeRemoved
+	// DiffTypeChanged is a path in both manifests with different digests.
+	DiffTypeChanged
+)
+
+// DiffType is the type of a PathDiff.
+type DiffType int
+
+// String implements fmt.Stringer.
+func (d DiffType) String() string {
+	switch d {
+	case DiffTypeAdded:
+		return "added"
+	case DiffTypeRemoved:
+		return "removed"
+	case DiffTypeChanged:
+		return "changed"
+	default:
+		return fmt.Sprintf("%d", d)
+	}
+}
+
+// PathDiff is a path that differs between two manifests.
+type PathDiff struct {
+	Path string
+	Type DiffType
+	// From is the digest of the path in the older manifest, or nil if the
+	// path was added.
+	From *Digest
+	// To is the digest of the path in the newer manifest, or nil if the
+	// path was removed.
+	To *Digest
+}
+
+// Diff returns the paths that differ between the from and to manifests,
+// sorted by path.
+//
+// Digests of different types are always considered different, even if they
+// are of the same content.
+func Diff(from *Manifest, to *Manifest) []PathDiff {
+	var pathDiffs []PathDiff
+	for path, fromDigest := range from.pathToDigest {
+		fromDigest := fromDigest
+		toDigest, ok := to.pathToDigest[path]
+		if !ok {
+			pathDiffs = append(pathDiffs, PathDiff{
+				Path: path,
+				Type: DiffTypeRemoved,
+				From: &fromDigest,
+			})
+			continue
+		}
+		if !fromDigest.Equal(toDigest) {
+			toDigest := toDigest
+			pathDiffs = append(pathDiffs, PathDiff{
+				Path: path,
+				Type: DiffTypeChanged,
+				From: &fromDigest,
+				To:   &toDigest,
+			})
+		}
+	}
+	for path, toDigest := range to.pathToDigest {
+		toDigest := toDigest
+		if _, ok := from.pathToDigest[path]; !ok {
+			pathDiffs = append(pathDiffs, PathDiff{
+				Path: path,
+				Type: DiffTypeAdded,
+				To:   &toDigest,
+			})
+		}
+	}
+	sort.Slice(pathDiffs, func(i, j int) bool {
+		return pathDiffs[i].Path < pathDiffs[j].Path
+	})
+	return pathDiffs
+}
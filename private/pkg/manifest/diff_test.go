@@ -0,0 +1,67 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	digester, err := manifest.NewDigester(manifest.DigestTypeShake256)
+	require.NoError(t, err)
+	newDigest := func(content string) *manifest.Digest {
+		digest, err := digester.Digest(strings.NewReader(content))
+		require.NoError(t, err)
+		return digest
+	}
+	var from manifest.Manifest
+	require.NoError(t, from.AddEntry("same", *newDigest("same")))
+	require.NoError(t, from.AddEntry("removed", *newDigest("removed")))
+	require.NoError(t, from.AddEntry("changed", *newDigest("before")))
+	var to manifest.Manifest
+	require.NoError(t, to.AddEntry("same", *newDigest("same")))
+	require.NoError(t, to.AddEntry("changed", *newDigest("after")))
+	require.NoError(t, to.AddEntry("added", *newDigest("added")))
+
+	assert.Equal(
+		t,
+		[]manifest.PathDiff{
+			{
+				Path: "added",
+				Type: manifest.DiffTypeAdded,
+				To:   newDigest("added"),
+			},
+			{
+				Path: "changed",
+				Type: manifest.DiffTypeChanged,
+				From: newDigest("before"),
+				To:   newDigest("after"),
+			},
+			{
+				Path: "removed",
+				Type: manifest.DiffTypeRemoved,
+				From: newDigest("removed"),
+			},
+		},
+		manifest.Diff(&from, &to),
+	)
+	assert.Empty(t, manifest.Diff(&from, &from))
+}
@@ -19,9 +19,11 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"strings"
 
+	"github.com/bufbuild/buf/private/pkg/manifest/internal/blake3"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -30,10 +32,45 @@ type DigestType string
 
 const (
 	DigestTypeShake256 DigestType = "shake256"
+	DigestTypeBlake3   DigestType = "blake3"
 
 	shake256Length = 64
+	blake3Length   = blake3.Size
 )
 
+var (
+	// SupportedDigestTypes are the digest types supported by this package, in
+	// order of preference.
+	SupportedDigestTypes = []DigestType{
+		DigestTypeBlake3,
+		DigestTypeShake256,
+	}
+
+	digestTypeToLength = map[DigestType]int{
+		DigestTypeShake256: shake256Length,
+		DigestTypeBlake3:   blake3Length,
+	}
+)
+
+// NegotiateDigestType returns the most preferred digest type in
+// SupportedDigestTypes that the peer also supports.
+//
+// If the peer does not advertise any digest types, DigestTypeShake256 is
+// returned, as it is the original digest type that all peers support.
+func NegotiateDigestType(peerDigestTypes []DigestType) (DigestType, error) {
+	if len(peerDigestTypes) == 0 {
+		return DigestTypeShake256, nil
+	}
+	for _, digestType := range SupportedDigestTypes {
+		for _, peerDigestType := range peerDigestTypes {
+			if digestType == peerDigestType {
+				return digestType, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no supported digest type in %v", peerDigestTypes)
+}
+
 // Digest represents a hash function's value.
 type Digest struct {
 	dtype  DigestType
@@ -46,13 +83,14 @@ func NewDigestFromBytes(dtype DigestType, digest []byte) (*Digest, error) {
 	if dtype == "" {
 		return nil, errors.New("digest type cannot be empty")
 	}
-	if dtype != DigestTypeShake256 {
+	length, ok := digestTypeToLength[dtype]
+	if !ok {
 		return nil, fmt.Errorf("unsupported digest type: %q", dtype)
 	}
-	if len(digest) != shake256Length {
+	if len(digest) != length {
 		return nil, fmt.Errorf(
 			"invalid digest: got %d bytes, expected %d bytes for type %q",
-			len(digest), shake256Length, dtype,
+			len(digest), length, dtype,
 		)
 	}
 	return &Digest{
@@ -115,12 +153,20 @@ type shake256Digester struct {
 	hash sha3.ShakeHash
 }
 
+type blake3Digester struct {
+	hash hash.Hash
+}
+
 // NewDigester returns a digester of the requested type.
 func NewDigester(dtype DigestType) (Digester, error) {
-	if dtype != DigestTypeShake256 {
+	switch dtype {
+	case DigestTypeShake256:
+		return &shake256Digester{hash: sha3.NewShake256()}, nil
+	case DigestTypeBlake3:
+		return &blake3Digester{hash: blake3.New()}, nil
+	default:
 		return nil, fmt.Errorf("not supported digest type %q", dtype)
 	}
-	return &shake256Digester{hash: sha3.NewShake256()}, nil
 }
 
 func (d *shake256Digester) Digest(content io.Reader) (*Digest, error) {
@@ -136,3 +182,11 @@ func (d *shake256Digester) Digest(content io.Reader) (*Digest, error) {
 	}
 	return NewDigestFromBytes(DigestTypeShake256, digest)
 }
+
+func (d *blake3Digester) Digest(content io.Reader) (*Digest, error) {
+	d.hash.Reset()
+	if _, err := io.Copy(d.hash, content); err != nil {
+		return nil, err
+	}
+	return NewDigestFromBytes(DigestTypeBlake3, d.hash.Sum(nil))
+}
@@ -0,0 +1,190 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"hash/crc64"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/bufbuild/buf/private/pkg/storage"
+	"go.uber.org/multierr"
+)
+
+var digestCacheChecksumTable = crc64.MakeTable(crc64.ECMA)
+
+// DigestCache caches the digests of files across calls to [NewFromBucket].
+//
+// The content of a file is always passed, so that a cached digest is only
+// returned for the exact content it was computed from.
+//
+// DigestCaches must be safe for concurrent use.
+type DigestCache interface {
+	// GetDigest returns the cached digest of the content of the object, or nil
+	// if there is no cached digest for this content.
+	GetDigest(objectInfo storage.ObjectInfo, content []byte) *Digest
+	// PutDigest caches the digest of the content of the object.
+	//
+	// Implementations may choose not to cache the digest.
+	PutDigest(objectInfo storage.ObjectInfo, content []byte, digest Digest)
+}
+
+// FileDigestCache is a [DigestCache] for local files that is persisted to a
+// file.
+//
+// Files are identified by the absolute path of the object's external path.
+// The size and modification time of a file are only used as a hint that the
+// file has not changed. A cached digest is only returned if the CRC-64
+// checksum of the content that was read also matches the checksum of the
+// content the digest was computed from, which is considerably cheaper to
+// compute than the digest itself. This must only be used with buckets whose
+// external paths are the local file paths of the objects, such as storageos
+// buckets.
+type FileDigestCache struct {
+	filePath string
+
+	lock              sync.RWMutex
+	pathToCacheEntry  map[string]*digestCacheEntry
+	changed           bool
+	getFileInfoForKey func(string) (fs.FileInfo, error)
+}
+
+// NewFileDigestCache returns a new FileDigestCache persisted to the file path.
+//
+// If the file does not exist or cannot be decoded, the cache starts empty.
+// Call [FileDigestCache.Save] to persist the cache.
+func NewFileDigestCache(filePath string) *FileDigestCache {
+	fileDigestCache := &FileDigestCache{
+		filePath:          filePath,
+		pathToCacheEntry:  make(map[string]*digestCacheEntry),
+		getFileInfoForKey: os.Stat,
+	}
+	if data, err := os.ReadFile(filePath); err == nil {
+		// The cache is an optimization, so a corrupt cache is treated as empty.
+		if err := json.Unmarshal(data, &fileDigestCache.pathToCacheEntry); err != nil {
+			fileDigestCache.pathToCacheEntry = make(map[string]*digestCacheEntry)
+		}
+	}
+	return fileDigestCache
+}
+
+// GetDigest implements [DigestCache].
+func (c *FileDigestCache) GetDigest(objectInfo storage.ObjectInfo, content []byte) *Digest {
+	key, fileInfo, ok := c.getKeyAndFileInfo(objectInfo)
+	if !ok {
+		return nil
+	}
+	c.lock.RLock()
+	cacheEntry, ok := c.pathToCacheEntry[key]
+	c.lock.RUnlock()
+	if !ok ||
+		cacheEntry.Size != fileInfo.Size() ||
+		cacheEntry.ModTime != fileInfo.ModTime().UnixNano() ||
+		cacheEntry.Size != int64(len(content)) ||
+		cacheEntry.Checksum != crc64.Checksum(content, digestCacheChecksumTable) {
+		return nil
+	}
+	digest, err := NewDigestFromString(cacheEntry.Digest)
+	if err != nil {
+		return nil
+	}
+	return digest
+}
+
+// PutDigest implements [DigestCache].
+func (c *FileDigestCache) PutDigest(objectInfo storage.ObjectInfo, content []byte, digest Digest) {
+	key, fileInfo, ok := c.getKeyAndFileInfo(objectInfo)
+	if !ok {
+		return
+	}
+	cacheEntry := &digestCacheEntry{
+		Size:     fileInfo.Size(),
+		ModTime:  fileInfo.ModTime().UnixNano(),
+		Checksum: crc64.Checksum(content, digestCacheChecksumTable),
+		Digest:   digest.String(),
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pathToCacheEntry[key] = cacheEntry
+	c.changed = true
+}
+
+// Save persists the cache to its file path if it has changed.
+//
+// Entries for files that no longer exist are removed.
+func (c *FileDigestCache) Save() (retErr error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key := range c.pathToCacheEntry {
+		if _, err := c.getFileInfoForKey(key); errors.Is(err, fs.ErrNotExist) {
+			delete(c.pathToCacheEntry, key)
+			c.changed = true
+		}
+	}
+	if !c.changed {
+		return nil
+	}
+	data, err := json.Marshal(c.pathToCacheEntry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.filePath), 0755); err != nil {
+		return err
+	}
+	// Write to a temporary file and rename so that concurrent readers never
+	// see a partially-written cache.
+	file, err := os.CreateTemp(filepath.Dir(c.filePath), filepath.Base(c.filePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			retErr = multierr.Append(retErr, os.Remove(file.Name()))
+		}
+	}()
+	_, err = file.Write(data)
+	err = multierr.Append(err, file.Close())
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(file.Name(), c.filePath); err != nil {
+		return err
+	}
+	c.changed = false
+	return nil
+}
+
+func (c *FileDigestCache) getKeyAndFileInfo(objectInfo storage.ObjectInfo) (string, fs.FileInfo, bool) {
+	key, err := filepath.Abs(objectInfo.ExternalPath())
+	if err != nil {
+		return "", nil, false
+	}
+	fileInfo, err := c.getFileInfoForKey(key)
+	if err != nil || !fileInfo.Mode().IsRegular() {
+		return "", nil, false
+	}
+	return key, fileInfo, true
+}
+
+type digestCacheEntry struct {
+	Size     int64  `json:"size"`
+	ModTime  int64  `json:"mod_time"`
+	Checksum uint64 `json:"checksum"`
+	Digest   string `json:"digest"`
+}
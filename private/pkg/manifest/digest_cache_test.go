@@ -0,0 +1,83 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/buf/private/pkg/manifest"
+	"github.com/bufbuild/buf/private/pkg/storage/storageos"
+	"github.com/bufbuild/buf/private/pkg/storage/storageutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileDigestCache(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dirPath := t.TempDir()
+	cacheFilePath := filepath.Join(t.TempDir(), "digests.json")
+	modTime := time.Now().Add(-time.Hour)
+	writeFile := func(path string, content string) {
+		filePath := filepath.Join(dirPath, path)
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0600))
+		require.NoError(t, os.Chtimes(filePath, modTime, modTime))
+	}
+	writeFile("a", "old content")
+	bucket, err := storageos.NewProvider().NewReadWriteBucket(dirPath)
+	require.NoError(t, err)
+	objectInfo := storageutil.NewObjectInfo("a", filepath.Join(dirPath, "a"))
+
+	digestCache := manifest.NewFileDigestCache(cacheFilePath)
+	m, _, err := manifest.NewFromBucket(ctx, bucket, manifest.FromBucketWithDigestCache(digestCache))
+	require.NoError(t, err)
+	require.NoError(t, digestCache.Save())
+	digest, ok := m.DigestFor("a")
+	require.True(t, ok)
+	assert.Equal(t, mustDigestShake256(t, []byte("old content")), digest)
+
+	digestCache = manifest.NewFileDigestCache(cacheFilePath)
+	cachedDigest := digestCache.GetDigest(objectInfo, []byte("old content"))
+	require.NotNil(t, cachedDigest)
+	assert.Equal(t, digest, cachedDigest)
+	// The cached digest is reused when building the manifest.
+	cachedManifest, cachedBlobSet, err := manifest.NewFromBucket(ctx, bucket, manifest.FromBucketWithDigestCache(digestCache))
+	require.NoError(t, err)
+	assert.Equal(t, m, cachedManifest)
+	_, ok = cachedBlobSet.BlobFor(digest.String())
+	assert.True(t, ok)
+
+	// A file changed without a change in its size or modification time is
+	// not served from the cache, as its content does not match.
+	writeFile("a", "new content")
+	assert.Nil(t, digestCache.GetDigest(objectInfo, []byte("new content")))
+	changedManifest, changedBlobSet, err := manifest.NewFromBucket(ctx, bucket, manifest.FromBucketWithDigestCache(digestCache))
+	require.NoError(t, err)
+	changedDigest, ok := changedManifest.DigestFor("a")
+	require.True(t, ok)
+	assert.Equal(t, mustDigestShake256(t, []byte("new content")), changedDigest)
+	blob, ok := changedBlobSet.BlobFor(changedDigest.String())
+	require.True(t, ok)
+	expectedBlob, err := manifest.NewMemoryBlobFromReader(strings.NewReader("new content"))
+	require.NoError(t, err)
+	equal, err := manifest.BlobEqual(ctx, blob, expectedBlob)
+	require.NoError(t, err)
+	assert.True(t, equal)
+}
@@ -151,6 +151,39 @@ func TestDigesterDigest(t *testing.T) {
 	assert.Nil(t, digest)
 }
 
+func TestBlake3DigesterDigest(t *testing.T) {
+	t.Parallel()
+	digester, err := manifest.NewDigester(manifest.DigestTypeBlake3)
+	require.NoError(t, err)
+	digest, err := digester.Digest(strings.NewReader("abc"))
+	require.NoError(t, err)
+	assert.Equal(t, manifest.DigestTypeBlake3, digest.Type())
+	assert.Equal(t, "blake3:6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85", digest.String())
+	// Digests of different types are not equal.
+	assert.False(t, digest.Equal(*mustDigestShake256(t, []byte("abc"))))
+	parsedDigest, err := manifest.NewDigestFromString(digest.String())
+	require.NoError(t, err)
+	assert.True(t, digest.Equal(*parsedDigest))
+	// A blake3 digest must be 32 bytes.
+	_, err = manifest.NewDigestFromBytes(manifest.DigestTypeBlake3, make([]byte, 64))
+	assert.Error(t, err)
+}
+
+func TestNegotiateDigestType(t *testing.T) {
+	t.Parallel()
+	digestType, err := manifest.NegotiateDigestType(nil)
+	require.NoError(t, err)
+	assert.Equal(t, manifest.DigestTypeShake256, digestType)
+	digestType, err = manifest.NegotiateDigestType([]manifest.DigestType{manifest.DigestTypeShake256})
+	require.NoError(t, err)
+	assert.Equal(t, manifest.DigestTypeShake256, digestType)
+	digestType, err = manifest.NegotiateDigestType([]manifest.DigestType{manifest.DigestTypeShake256, manifest.DigestTypeBlake3})
+	require.NoError(t, err)
+	assert.Equal(t, manifest.DigestTypeBlake3, digestType)
+	_, err = manifest.NegotiateDigestType([]manifest.DigestType{"md5"})
+	assert.Error(t, err)
+}
+
 func TestEqualDigests(t *testing.T) {
 	t.Parallel()
 	const fileContent = "one line\nanother line\nyet another one\n"
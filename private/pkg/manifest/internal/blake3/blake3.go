@@ -0,0 +1,303 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blake3 implements the BLAKE3 hash function.
+//
+// This is a straightforward port of the BLAKE3 reference implementation
+// (https://github.com/BLAKE3-team/BLAKE3/blob/master/reference_impl/reference_impl.rs),
+// supporting the default hash mode with 32-byte output only.
+package blake3
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+const (
+	// Size is the size of a BLAKE3 digest in bytes.
+	Size = 32
+	// BlockSize is the block size of BLAKE3 in bytes.
+	BlockSize = 64
+
+	chunkLen = 1024
+
+	flagChunkStart = 1 << 0
+	flagChunkEnd   = 1 << 1
+	flagParent     = 1 << 2
+	flagRoot       = 1 << 3
+)
+
+var (
+	iv = [8]uint32{
+		0x6A09E667, 0xBB67AE85, 0x3C6EF372, 0xA54FF53A,
+		0x510E527F, 0x9B05688C, 0x1F83D9AB, 0x5BE0CD19,
+	}
+	msgPermutation = [16]int{2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8}
+)
+
+// New returns a new hash.Hash computing the BLAKE3 digest.
+func New() hash.Hash {
+	h := &hasher{}
+	h.Reset()
+	return h
+}
+
+// Sum256 returns the BLAKE3 digest of the data.
+func Sum256(data []byte) [Size]byte {
+	h := &hasher{}
+	h.Reset()
+	_, _ = h.Write(data)
+	var sum [Size]byte
+	h.finalize(sum[:])
+	return sum
+}
+
+type hasher struct {
+	chunkState chunkState
+	// cvStack holds the chaining values of completed subtrees. 54 entries
+	// are enough for the maximum input length of 2^64 bytes.
+	cvStack    [54][8]uint32
+	cvStackLen int
+}
+
+func (h *hasher) Write(input []byte) (int, error) {
+	n := len(input)
+	for len(input) > 0 {
+		// If the current chunk is complete, finalize it and reset the chunk
+		// state. More input is coming, so this chunk is not the root.
+		if h.chunkState.len() == chunkLen {
+			chunkCV := h.chunkState.output().chainingValue()
+			totalChunks := h.chunkState.chunkCounter + 1
+			h.addChunkChainingValue(chunkCV, totalChunks)
+			h.chunkState = newChunkState(iv, totalChunks)
+		}
+		take := chunkLen - h.chunkState.len()
+		if take > len(input) {
+			take = len(input)
+		}
+		h.chunkState.update(input[:take])
+		input = input[take:]
+	}
+	return n, nil
+}
+
+func (h *hasher) Sum(b []byte) []byte {
+	var sum [Size]byte
+	h.finalize(sum[:])
+	return append(b, sum[:]...)
+}
+
+func (h *hasher) Reset() {
+	h.chunkState = newChunkState(iv, 0)
+	h.cvStackLen = 0
+}
+
+func (h *hasher) Size() int {
+	return Size
+}
+
+func (h *hasher) BlockSize() int {
+	return BlockSize
+}
+
+// finalize writes the root output to out without modifying the hasher.
+func (h *hasher) finalize(out []byte) {
+	// Starting with the output from the current chunk, compute all the parent
+	// chaining values along the right edge of the tree, until we have the
+	// root output.
+	output := h.chunkState.output()
+	for i := h.cvStackLen - 1; i >= 0; i-- {
+		output = parentOutput(h.cvStack[i], output.chainingValue())
+	}
+	output.rootOutputBytes(out)
+}
+
+// addChunkChainingValue adds the chaining value of a completed chunk,
+// merging completed subtrees. The number of trailing zero bits in
+// totalChunks is the number of completed subtrees to merge.
+func (h *hasher) addChunkChainingValue(newCV [8]uint32, totalChunks uint64) {
+	for totalChunks&1 == 0 {
+		h.cvStackLen--
+		newCV = parentOutput(h.cvStack[h.cvStackLen], newCV).chainingValue()
+		totalChunks >>= 1
+	}
+	h.cvStack[h.cvStackLen] = newCV
+	h.cvStackLen++
+}
+
+type chunkState struct {
+	chainingValue    [8]uint32
+	chunkCounter     uint64
+	block            [BlockSize]byte
+	blockLen         int
+	blocksCompressed int
+}
+
+func newChunkState(key [8]uint32, chunkCounter uint64) chunkState {
+	return chunkState{
+		chainingValue: key,
+		chunkCounter:  chunkCounter,
+	}
+}
+
+func (c *chunkState) len() int {
+	return BlockSize*c.blocksCompressed + c.blockLen
+}
+
+func (c *chunkState) startFlag() uint32 {
+	if c.blocksCompressed == 0 {
+		return flagChunkStart
+	}
+	return 0
+}
+
+func (c *chunkState) update(input []byte) {
+	for len(input) > 0 {
+		// If the block buffer is full, compress it and clear it. More input
+		// is coming, so this compression is not flagChunkEnd.
+		if c.blockLen == BlockSize {
+			blockWords := wordsFromBlock(&c.block)
+			out := compress(&c.chainingValue, &blockWords, c.chunkCounter, BlockSize, c.startFlag())
+			copy(c.chainingValue[:], out[:8])
+			c.blocksCompressed++
+			c.block = [BlockSize]byte{}
+			c.blockLen = 0
+		}
+		take := copy(c.block[c.blockLen:], input)
+		c.blockLen += take
+		input = input[take:]
+	}
+}
+
+func (c *chunkState) output() output {
+	return output{
+		inputChainingValue: c.chainingValue,
+		blockWords:         wordsFromBlock(&c.block),
+		counter:            c.chunkCounter,
+		blockLen:           uint32(c.blockLen),
+		flags:              c.startFlag() | flagChunkEnd,
+	}
+}
+
+// output is the state just prior to the final compression of a node. It can
+// produce either an 8-word chaining value or, with the root flag, any number
+// of final output bytes.
+type output struct {
+	inputChainingValue [8]uint32
+	blockWords         [16]uint32
+	counter            uint64
+	blockLen           uint32
+	flags              uint32
+}
+
+func parentOutput(leftChildCV [8]uint32, rightChildCV [8]uint32) output {
+	var blockWords [16]uint32
+	copy(blockWords[:8], leftChildCV[:])
+	copy(blockWords[8:], rightChildCV[:])
+	return output{
+		inputChainingValue: iv,
+		blockWords:         blockWords,
+		counter:            0,
+		blockLen:           BlockSize,
+		flags:              flagParent,
+	}
+}
+
+func (o output) chainingValue() [8]uint32 {
+	out := compress(&o.inputChainingValue, &o.blockWords, o.counter, o.blockLen, o.flags)
+	var chainingValue [8]uint32
+	copy(chainingValue[:], out[:8])
+	return chainingValue
+}
+
+func (o output) rootOutputBytes(out []byte) {
+	var outputBlockCounter uint64
+	for len(out) > 0 {
+		words := compress(&o.inputChainingValue, &o.blockWords, outputBlockCounter, o.blockLen, o.flags|flagRoot)
+		var block [BlockSize]byte
+		for i, word := range words {
+			binary.LittleEndian.PutUint32(block[4*i:], word)
+		}
+		out = out[copy(out, block[:]):]
+		outputBlockCounter++
+	}
+}
+
+func compress(
+	chainingValue *[8]uint32,
+	blockWords *[16]uint32,
+	counter uint64,
+	blockLen uint32,
+	flags uint32,
+) [16]uint32 {
+	state := [16]uint32{
+		chainingValue[0], chainingValue[1], chainingValue[2], chainingValue[3],
+		chainingValue[4], chainingValue[5], chainingValue[6], chainingValue[7],
+		iv[0], iv[1], iv[2], iv[3],
+		uint32(counter), uint32(counter >> 32), blockLen, flags,
+	}
+	block := *blockWords
+	for i := 0; i < 7; i++ {
+		round(&state, &block)
+		if i < 6 {
+			permute(&block)
+		}
+	}
+	for i := 0; i < 8; i++ {
+		state[i] ^= state[i+8]
+		state[i+8] ^= chainingValue[i]
+	}
+	return state
+}
+
+func round(state *[16]uint32, m *[16]uint32) {
+	// Mix the columns.
+	g(state, 0, 4, 8, 12, m[0], m[1])
+	g(state, 1, 5, 9, 13, m[2], m[3])
+	g(state, 2, 6, 10, 14, m[4], m[5])
+	g(state, 3, 7, 11, 15, m[6], m[7])
+	// Mix the diagonals.
+	g(state, 0, 5, 10, 15, m[8], m[9])
+	g(state, 1, 6, 11, 12, m[10], m[11])
+	g(state, 2, 7, 8, 13, m[12], m[13])
+	g(state, 3, 4, 9, 14, m[14], m[15])
+}
+
+func g(state *[16]uint32, a, b, c, d int, mx, my uint32) {
+	state[a] = state[a] + state[b] + mx
+	state[d] = bits.RotateLeft32(state[d]^state[a], -16)
+	state[c] = state[c] + state[d]
+	state[b] = bits.RotateLeft32(state[b]^state[c], -12)
+	state[a] = state[a] + state[b] + my
+	state[d] = bits.RotateLeft32(state[d]^state[a], -8)
+	state[c] = state[c] + state[d]
+	state[b] = bits.RotateLeft32(state[b]^state[c], -7)
+}
+
+func permute(m *[16]uint32) {
+	var permuted [16]uint32
+	for i := range permuted {
+		permuted[i] = m[msgPermutation[i]]
+	}
+	*m = permuted
+}
+
+func wordsFromBlock(block *[BlockSize]byte) [16]uint32 {
+	var words [16]uint32
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint32(block[4*i:])
+	}
+	return words
+}
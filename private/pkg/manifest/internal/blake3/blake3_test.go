@@ -0,0 +1,64 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blake3
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum256(t *testing.T) {
+	t.Parallel()
+	// Inputs are bytes i%251 per the official BLAKE3 test vectors.
+	testSum256(t, 0, "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262")
+	testSum256(t, 1024, "42214739f095a406f3fc83deb889744ac00df831c10daa55189b5d121c855af7")
+	testSum256(t, 1025, "d00278ae47eb27b34faecf67b4fe263f82d5412916c1ffd97c8cb7fb814b8444")
+	sum := Sum256([]byte("abc"))
+	assert.Equal(t, "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85", hex.EncodeToString(sum[:]))
+}
+
+func TestIncrementalWrites(t *testing.T) {
+	t.Parallel()
+	for _, length := range []int{63, 64, 65, 2048, 2049, 4097, 31745} {
+		input := newTestInput(length)
+		expected := Sum256(input)
+		hasher := New()
+		for i := 0; i < length; i += 7 {
+			end := i + 7
+			if end > length {
+				end = length
+			}
+			_, _ = hasher.Write(input[i:end])
+		}
+		assert.Equal(t, expected[:], hasher.Sum(nil), "length %d", length)
+		// Sum does not change the state.
+		assert.Equal(t, expected[:], hasher.Sum(nil), "length %d", length)
+	}
+}
+
+func testSum256(t *testing.T, length int, expectedHex string) {
+	sum := Sum256(newTestInput(length))
+	assert.Equal(t, expectedHex, hex.EncodeToString(sum[:]), "length %d", length)
+}
+
+func newTestInput(length int) []byte {
+	input := make([]byte, length)
+	for i := range input {
+		input[i] = byte(i % 251)
+	}
+	return input
+}
@@ -18,9 +18,12 @@
 //
 //	<digest type>:<digest>[SP][SP]<path>[LF]
 //
-// "shake256" is the only supported digest type. The digest is 64 bytes of hex
-// encoded output of SHAKE256. See golang.org/x/crypto/sha3 and FIPS 202 for
-// details on the SHAKE hash.
+// The supported digest types are "shake256" and "blake3". A "shake256" digest
+// is 64 bytes of hex encoded output of SHAKE256. See golang.org/x/crypto/sha3
+// and FIPS 202 for details on the SHAKE hash. A "blake3" digest is 32 bytes of
+// hex encoded output of BLAKE3. See https://github.com/BLAKE3-team/BLAKE3-specs
+// for details on the BLAKE3 hash. A manifest may mix digest types, and each
+// entry is verified with the digest type of its digest.
 //
 // [Manifest] can read and write manifest files. Canonical form is produced
 // when serialized ([Manifest.MarshalText]). Non-canonical form is a valid
@@ -15,6 +15,7 @@
 package manifest
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -22,6 +23,7 @@ import (
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/storage"
 	"github.com/bufbuild/buf/private/pkg/storage/storageutil"
+	"github.com/bufbuild/buf/private/pkg/thread"
 	"go.uber.org/multierr"
 )
 
@@ -43,33 +45,58 @@ func (o *manifestBucketObject) Read(p []byte) (int, error) { return o.file.Read(
 func (o *manifestBucketObject) Close() error               { return o.file.Close() }
 
 // NewFromBucket creates a manifest and blob set from the bucket's files. Blobs
-// in the blob set use the [DigestTypeShake256] digest.
+// in the blob set use the [DigestTypeShake256] digest, unless another digest
+// type is passed with [FromBucketWithDigestType].
+//
+// Files are read and digested concurrently.
 func NewFromBucket(
 	ctx context.Context,
 	bucket storage.ReadBucket,
+	opts ...FromBucketOption,
 ) (*Manifest, *BlobSet, error) {
-	var m Manifest
-	digester, err := NewDigester(DigestTypeShake256)
-	if err != nil {
+	config := fromBucketOptions{
+		digestType: DigestTypeShake256,
+	}
+	for _, option := range opts {
+		option(&config)
+	}
+	if _, err := NewDigester(config.digestType); err != nil {
 		return nil, nil, err
 	}
-	var blobs []Blob
-	if walkErr := bucket.Walk(ctx, "", func(info storage.ObjectInfo) (retErr error) {
-		path := info.Path()
-		obj, err := bucket.Get(ctx, path)
-		if err != nil {
-			return err
-		}
-		defer func() { retErr = multierr.Append(retErr, obj.Close()) }()
-		blob, err := NewMemoryBlobFromReaderWithDigester(obj, digester)
-		if err != nil {
-			return err
-		}
-		blobs = append(blobs, blob)
-		return m.AddEntry(path, *blob.Digest())
+	var objectInfos []storage.ObjectInfo
+	if walkErr := bucket.Walk(ctx, "", func(info storage.ObjectInfo) error {
+		objectInfos = append(objectInfos, info)
+		return nil
 	}); walkErr != nil {
 		return nil, nil, walkErr
 	}
+	blobs := make([]Blob, len(objectInfos))
+	jobs := make([]func(context.Context) error, len(objectInfos))
+	for i, objectInfo := range objectInfos {
+		i := i
+		objectInfo := objectInfo
+		jobs[i] = func(ctx context.Context) error {
+			blob, err := newMemoryBlobFromBucket(ctx, bucket, objectInfo, config.digestType, config.digestCache)
+			if err != nil {
+				return err
+			}
+			blobs[i] = blob
+			return nil
+		}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if err := thread.Parallelize(ctx, jobs, thread.ParallelizeWithCancel(cancel)); err != nil {
+		return nil, nil, err
+	}
+	// Add the entries in walk order so that the manifest is the same as if the
+	// files were digested serially.
+	var m Manifest
+	for i, objectInfo := range objectInfos {
+		if err := m.AddEntry(objectInfo.Path(), *blobs[i].Digest()); err != nil {
+			return nil, nil, err
+		}
+	}
 	blobSet, err := NewBlobSet(ctx, blobs) // no need to pass validation options, we're building and digesting the blobs
 	if err != nil {
 		return nil, nil, err
@@ -77,6 +104,77 @@ func NewFromBucket(
 	return &m, blobSet, nil
 }
 
+// FromBucketOption is an option for [NewFromBucket].
+type FromBucketOption func(*fromBucketOptions)
+
+// FromBucketWithDigestCache returns a new FromBucketOption that reuses the
+// digests in the cache for files whose content has not changed, and adds the
+// digests of files that were digested to the cache.
+func FromBucketWithDigestCache(digestCache DigestCache) FromBucketOption {
+	return func(opts *fromBucketOptions) {
+		opts.digestCache = digestCache
+	}
+}
+
+// FromBucketWithDigestType returns a new FromBucketOption that sets the digest
+// type of the blobs.
+//
+// The default is DigestTypeShake256.
+func FromBucketWithDigestType(digestType DigestType) FromBucketOption {
+	return func(opts *fromBucketOptions) {
+		opts.digestType = digestType
+	}
+}
+
+type fromBucketOptions struct {
+	digestType  DigestType
+	digestCache DigestCache
+}
+
+func newMemoryBlobFromBucket(
+	ctx context.Context,
+	bucket storage.ReadBucket,
+	objectInfo storage.ObjectInfo,
+	digestType DigestType,
+	digestCache DigestCache,
+) (_ Blob, retErr error) {
+	obj, err := bucket.Get(ctx, objectInfo.Path())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { retErr = multierr.Append(retErr, obj.Close()) }()
+	// Digesters are not safe for concurrent use, so we create one per file.
+	digester, err := NewDigester(digestType)
+	if err != nil {
+		return nil, err
+	}
+	if digestCache == nil {
+		return NewMemoryBlobFromReaderWithDigester(obj, digester)
+	}
+	// The cached digest is checked against the content that was read, so that
+	// the digest of the blob always matches its content.
+	content, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, err
+	}
+	// Digests of other types are treated as cache misses.
+	if digest := digestCache.GetDigest(objectInfo, content); digest != nil && digest.Type() == digestType {
+		return &memoryBlob{
+			digest:  *digest,
+			content: content,
+		}, nil
+	}
+	digest, err := digester.Digest(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	digestCache.PutDigest(objectInfo, content, *digest)
+	return &memoryBlob{
+		digest:  *digest,
+		content: content,
+	}, nil
+}
+
 type bucketOptions struct {
 	allManifestBlobs bool
 	noExtraBlobs     bool
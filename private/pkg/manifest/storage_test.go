@@ -206,3 +206,28 @@ func TestToBucketEmpty(t *testing.T) {
 		return nil
 	}))
 }
+
+func TestFromBucketWithDigestType(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	bucket, err := storagemem.NewReadBucket(
+		map[string][]byte{
+			"foo": []byte("bar"),
+		})
+	require.NoError(t, err)
+	m, blobSet, err := manifest.NewFromBucket(ctx, bucket, manifest.FromBucketWithDigestType(manifest.DigestTypeBlake3))
+	require.NoError(t, err)
+	digest, ok := m.DigestFor("foo")
+	require.True(t, ok)
+	assert.Equal(t, manifest.DigestTypeBlake3, digest.Type())
+	_, ok = blobSet.BlobFor(digest.String())
+	assert.True(t, ok)
+	// The manifest round trips through its text form.
+	text, err := m.MarshalText()
+	require.NoError(t, err)
+	parsedManifest, err := manifest.NewFromReader(bytes.NewReader(text))
+	require.NoError(t, err)
+	assert.Equal(t, m, parsedManifest)
+	_, _, err = manifest.NewFromBucket(ctx, bucket, manifest.FromBucketWithDigestType("md5"))
+	assert.Error(t, err)
+}
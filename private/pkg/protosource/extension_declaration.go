@@ -0,0 +1,207 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protosource
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// The field numbers of google.protobuf.ExtensionRangeOptions and
+// google.protobuf.ExtensionRangeOptions.Declaration that are used to read
+// declarations from the encoded options.
+const (
+	extensionRangeOptionsDeclarationFieldNumber  protowire.Number = 2
+	extensionRangeOptionsVerificationFieldNumber protowire.Number = 3
+	extensionDeclarationNumberFieldNumber        protowire.Number = 1
+	extensionDeclarationFullNameFieldNumber      protowire.Number = 2
+	extensionDeclarationTypeFieldNumber          protowire.Number = 3
+	extensionDeclarationReservedFieldNumber      protowire.Number = 5
+	extensionDeclarationRepeatedFieldNumber      protowire.Number = 6
+
+	// extensionRangeVerificationDeclaration is the DECLARATION value of
+	// google.protobuf.ExtensionRangeOptions.VerificationState.
+	extensionRangeVerificationDeclaration = 0
+)
+
+var errInvalidExtensionRangeOptions = errors.New("invalid encoding of extension range options")
+
+type extensionDeclaration struct {
+	locationDescriptor
+
+	extensionRange ExtensionRange
+	number         int
+	fullName       string
+	typ            string
+	reserved       bool
+	repeated       bool
+}
+
+func newExtensionDeclaration(
+	locationDescriptor locationDescriptor,
+	extensionRange ExtensionRange,
+	value *extensionDeclarationValue,
+) *extensionDeclaration {
+	return &extensionDeclaration{
+		locationDescriptor: locationDescriptor,
+		extensionRange:     extensionRange,
+		number:             value.number,
+		fullName:           value.fullName,
+		typ:                value.typ,
+		reserved:           value.reserved,
+		repeated:           value.repeated,
+	}
+}
+
+func (d *extensionDeclaration) ExtensionRange() ExtensionRange {
+	return d.extensionRange
+}
+
+func (d *extensionDeclaration) Number() int {
+	return d.number
+}
+
+func (d *extensionDeclaration) FullName() string {
+	return d.fullName
+}
+
+func (d *extensionDeclaration) Type() string {
+	return d.typ
+}
+
+func (d *extensionDeclaration) Reserved() bool {
+	return d.reserved
+}
+
+func (d *extensionDeclaration) Repeated() bool {
+	return d.repeated
+}
+
+type extensionDeclarationValue struct {
+	number   int
+	fullName string
+	typ      string
+	reserved bool
+	repeated bool
+}
+
+// getExtensionDeclarationValues returns the declarations of the extension range
+// options, and whether the extensions in the range must be declared.
+//
+// The options are decoded from their encoding, as the declarations are unknown
+// fields for versions of descriptor.proto that predate them.
+func getExtensionDeclarationValues(options *descriptorpb.ExtensionRangeOptions) ([]*extensionDeclarationValue, bool, error) {
+	if options == nil {
+		return nil, false, nil
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(options)
+	if err != nil {
+		return nil, false, err
+	}
+	var values []*extensionDeclarationValue
+	verificationSet := false
+	declared := false
+	for len(data) > 0 {
+		fieldNumber, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, false, errInvalidExtensionRangeOptions
+		}
+		data = data[n:]
+		switch {
+		case fieldNumber == extensionRangeOptionsDeclarationFieldNumber && wireType == protowire.BytesType:
+			declarationData, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				return nil, false, errInvalidExtensionRangeOptions
+			}
+			value, err := getExtensionDeclarationValue(declarationData)
+			if err != nil {
+				return nil, false, err
+			}
+			values = append(values, value)
+			n = m
+		case fieldNumber == extensionRangeOptionsVerificationFieldNumber && wireType == protowire.VarintType:
+			verification, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, false, errInvalidExtensionRangeOptions
+			}
+			verificationSet = true
+			declared = verification == extensionRangeVerificationDeclaration
+			n = m
+		default:
+			n = protowire.ConsumeFieldValue(fieldNumber, wireType, data)
+			if n < 0 {
+				return nil, false, errInvalidExtensionRangeOptions
+			}
+		}
+		data = data[n:]
+	}
+	if !verificationSet {
+		// The verification state defaults to UNVERIFIED, but ranges with
+		// declarations are always verified.
+		declared = len(values) > 0
+	}
+	return values, declared, nil
+}
+
+func getExtensionDeclarationValue(data []byte) (*extensionDeclarationValue, error) {
+	value := &extensionDeclarationValue{}
+	for len(data) > 0 {
+		fieldNumber, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, errInvalidExtensionRangeOptions
+		}
+		data = data[n:]
+		switch {
+		case wireType == protowire.VarintType && (fieldNumber == extensionDeclarationNumberFieldNumber ||
+			fieldNumber == extensionDeclarationReservedFieldNumber ||
+			fieldNumber == extensionDeclarationRepeatedFieldNumber):
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, errInvalidExtensionRangeOptions
+			}
+			switch fieldNumber {
+			case extensionDeclarationNumberFieldNumber:
+				value.number = int(int32(v))
+			case extensionDeclarationReservedFieldNumber:
+				value.reserved = protowire.DecodeBool(v)
+			case extensionDeclarationRepeatedFieldNumber:
+				value.repeated = protowire.DecodeBool(v)
+			}
+			n = m
+		case wireType == protowire.BytesType && (fieldNumber == extensionDeclarationFullNameFieldNumber ||
+			fieldNumber == extensionDeclarationTypeFieldNumber):
+			v, m := protowire.ConsumeString(data)
+			if m < 0 {
+				return nil, errInvalidExtensionRangeOptions
+			}
+			if fieldNumber == extensionDeclarationFullNameFieldNumber {
+				value.fullName = v
+			} else {
+				value.typ = v
+			}
+			n = m
+		default:
+			n = protowire.ConsumeFieldValue(fieldNumber, wireType, data)
+			if n < 0 {
+				return nil, errInvalidExtensionRangeOptions
+			}
+		}
+		data = data[n:]
+	}
+	return value, nil
+}
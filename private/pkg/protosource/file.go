@@ -38,6 +38,10 @@ type file struct {
 	optimizeMode   descriptorpb.FileOptions_OptimizeMode
 }
 
+func (f *file) FileDescriptor() protodescriptor.FileDescriptor {
+	return f.fileDescriptor
+}
+
 func (f *file) Syntax() Syntax {
 	return f.syntax
 }
@@ -659,6 +663,10 @@ func (f *file) populateMessage(
 			f.descriptor,
 			getMessageExtensionRangePath(extensionRangeIndex, topLevelMessageIndex, nestedMessageIndexes...),
 		)
+		declarationValues, declared, err := getExtensionDeclarationValues(extensionRangeDescriptorProto.GetOptions())
+		if err != nil {
+			return nil, err
+		}
 		extensionMessageRange := newExtensionRange(
 			extensionRangeLocationDescriptor,
 			message,
@@ -667,7 +675,20 @@ func (f *file) populateMessage(
 			newOptionExtensionDescriptor(
 				extensionRangeDescriptorProto.GetOptions(),
 			),
+			declared,
 		)
+		for declarationIndex, declarationValue := range declarationValues {
+			extensionMessageRange.addDeclaration(
+				newExtensionDeclaration(
+					newLocationDescriptor(
+						f.descriptor,
+						getMessageExtensionRangeDeclarationPath(declarationIndex, extensionRangeIndex, topLevelMessageIndex, nestedMessageIndexes...),
+					),
+					extensionMessageRange,
+					declarationValue,
+				),
+			)
+		}
 		message.addExtensionRange(extensionMessageRange)
 	}
 	for enumIndex, enumDescriptorProto := range descriptorProto.GetEnumType() {
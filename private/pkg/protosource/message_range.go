@@ -76,6 +76,9 @@ func (r *messageRange) Max() bool {
 type extensionRange struct {
 	*messageRange
 	optionExtensionDescriptor
+
+	declarations []ExtensionDeclaration
+	declared     bool
 }
 
 func newExtensionRange(
@@ -84,11 +87,25 @@ func newExtensionRange(
 	start int,
 	end int,
 	opts optionExtensionDescriptor,
+	declared bool,
 ) *extensionRange {
 	return &extensionRange{
 		messageRange: newMessageRange(
 			locationDescriptor, message, start, end,
 		),
 		optionExtensionDescriptor: opts,
+		declared:                  declared,
 	}
 }
+
+func (r *extensionRange) Declarations() []ExtensionDeclaration {
+	return r.declarations
+}
+
+func (r *extensionRange) Declared() bool {
+	return r.declared
+}
+
+func (r *extensionRange) addDeclaration(declaration ExtensionDeclaration) {
+	r.declarations = append(r.declarations, declaration)
+}
@@ -72,3 +72,45 @@ func (o *optionExtensionDescriptor) PresentExtensionNumbers() []int32 {
 
 	return fieldNumbers
 }
+
+func (o *optionExtensionDescriptor) OptionExtensionMessageBytes(fieldNumber int32) ([]byte, bool) {
+	msg := o.message.ProtoReflect()
+	var data []byte
+	found := false
+	// As for PresentExtensionNumbers, the extension may be unknown or known.
+	msg.Range(func(fieldDescriptor protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if !fieldDescriptor.IsExtension() || int32(fieldDescriptor.Number()) != fieldNumber || fieldDescriptor.Message() == nil {
+			return true
+		}
+		// The options of descriptors are already valid messages.
+		messageData, err := proto.Marshal(value.Message().Interface())
+		if err == nil {
+			data = append(data, messageData...)
+			found = true
+		}
+		return false
+	})
+	// Multiple occurrences of a message field are merged, which is the same as
+	// concatenating their encodings.
+	for b := msg.GetUnknown(); len(b) > 0; {
+		fieldNo, wireType, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			break
+		}
+		b = b[n:]
+		if int32(fieldNo) == fieldNumber && wireType == protowire.BytesType {
+			value, m := protowire.ConsumeBytes(b)
+			if m < 0 {
+				break
+			}
+			data = append(data, value...)
+			found = true
+		}
+		m := protowire.ConsumeFieldValue(fieldNo, wireType, b)
+		if m < 0 {
+			break
+		}
+		b = b[m:]
+	}
+	return data, found
+}
@@ -161,6 +161,10 @@ func getMessageExtensionRangePath(extensionRangeIndex int, topLevelMessageIndex
 	return append(getMessagePath(topLevelMessageIndex, nestedMessageIndexes...), 5, int32(extensionRangeIndex))
 }
 
+func getMessageExtensionRangeDeclarationPath(declarationIndex int, extensionRangeIndex int, topLevelMessageIndex int, nestedMessageIndexes ...int) []int32 {
+	return append(getMessageExtensionRangePath(extensionRangeIndex, topLevelMessageIndex, nestedMessageIndexes...), 3, 2, int32(declarationIndex))
+}
+
 func getEnumPath(enumIndex int, nestedMessageIndexes ...int) []int32 {
 	if len(nestedMessageIndexes) == 0 {
 		return []int32{5, int32(enumIndex)}
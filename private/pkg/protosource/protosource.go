@@ -134,6 +134,13 @@ type OptionExtensionDescriptor interface {
 	// PresentExtensionNumbers returns field numbers for all options that
 	// have a set value on this descriptor.
 	PresentExtensionNumbers() []int32
+
+	// OptionExtensionMessageBytes returns the binary encoding of the value of
+	// the message options extension field with the number, which allows reading
+	// extensions whose types are not known to this program.
+	//
+	// Returns false if the extension is not set.
+	OptionExtensionMessageBytes(fieldNumber int32) ([]byte, bool)
 }
 
 // Location defines source code info location information.
@@ -200,6 +207,11 @@ type File interface {
 	ContainerDescriptor
 	OptionExtensionDescriptor
 
+	// FileDescriptor is the backing FileDescriptor for this File.
+	//
+	// This is used to compare descriptors as a whole, and should not be used to
+	// read properties that are available on this File or its descriptors.
+	FileDescriptor() protodescriptor.FileDescriptor
 	Syntax() Syntax
 	Package() string
 	FileImports() []FileImport
@@ -305,6 +317,31 @@ type MessageRange interface {
 type ExtensionRange interface {
 	MessageRange
 	OptionExtensionDescriptor
+
+	// Declarations returns the extension declarations of the range.
+	//
+	// Declarations are read from the encoded options of the range, as they
+	// are not known to all versions of descriptor.proto.
+	Declarations() []ExtensionDeclaration
+	// Declared returns true if all extensions in the range must be declared,
+	// which is the case if the verification state of the range is DECLARATION,
+	// or if the range has declarations.
+	Declared() bool
+}
+
+// ExtensionDeclaration is the declaration of an extension in an ExtensionRange.
+type ExtensionDeclaration interface {
+	LocationDescriptor
+
+	ExtensionRange() ExtensionRange
+	Number() int
+	// FullName is the fully-qualified name of the extension, with a leading dot.
+	FullName() string
+	// Type is the name of a scalar type such as "int32", or the
+	// fully-qualified name of a message or enum type, with a leading dot.
+	Type() string
+	Reserved() bool
+	Repeated() bool
 }
 
 // Enum is an enum descriptor.
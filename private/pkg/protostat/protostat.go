@@ -17,27 +17,48 @@ package protostat
 import (
 	"context"
 	"io"
+	"strings"
 
 	"github.com/bufbuild/protocompile/ast"
 	"github.com/bufbuild/protocompile/parser"
 	"github.com/bufbuild/protocompile/reporter"
 )
 
+const (
+	// These mirror the comment ignore prefixes of the lint and breaking change
+	// detection packages, which cannot be imported from here.
+	lintIgnorePrefix     = "buf:lint:ignore"
+	breakingIgnorePrefix = "buf:breaking:ignore"
+)
+
 // Stats represents some statistics about one or more Protobuf files.
 //
 // Note that as opposed to most structs in this codebase, we do not omitempty for
 // the fields for JSON or YAML.
 type Stats struct {
-	NumFiles                 int `json:"num_files" yaml:"num_files"`
-	NumPackages              int `json:"num_packages" yaml:"num_packages"`
-	NumFilesWithSyntaxErrors int `json:"num_files_with_syntax_errors" yaml:"num_files_with_syntax_errors"`
-	NumMessages              int `json:"num_messages" yaml:"num_messages"`
-	NumFields                int `json:"num_fields" yaml:"num_fields"`
-	NumEnums                 int `json:"num_enums" yaml:"num_enums"`
-	NumEnumValues            int `json:"num_enum_values" yaml:"num_enum_values"`
-	NumExtensions            int `json:"num_extensions" yaml:"num_extensions"`
-	NumServices              int `json:"num_services" yaml:"num_services"`
-	NumMethods               int `json:"num_methods" yaml:"num_methods"`
+	NumFiles                  int `json:"num_files" yaml:"num_files"`
+	NumPackages               int `json:"num_packages" yaml:"num_packages"`
+	NumFilesWithSyntaxErrors  int `json:"num_files_with_syntax_errors" yaml:"num_files_with_syntax_errors"`
+	NumMessages               int `json:"num_messages" yaml:"num_messages"`
+	NumFields                 int `json:"num_fields" yaml:"num_fields"`
+	NumEnums                  int `json:"num_enums" yaml:"num_enums"`
+	NumEnumValues             int `json:"num_enum_values" yaml:"num_enum_values"`
+	NumExtensions             int `json:"num_extensions" yaml:"num_extensions"`
+	NumServices               int `json:"num_services" yaml:"num_services"`
+	NumMethods                int `json:"num_methods" yaml:"num_methods"`
+	NumDeprecatedFields       int `json:"num_deprecated_fields" yaml:"num_deprecated_fields"`
+	NumLintIgnoreComments     int `json:"num_lint_ignore_comments" yaml:"num_lint_ignore_comments"`
+	NumBreakingIgnoreComments int `json:"num_breaking_ignore_comments" yaml:"num_breaking_ignore_comments"`
+
+	// The below are derived from configuration and not from the files themselves,
+	// and are therefore not set by GetStats. Callers with access to configuration
+	// set these.
+	//
+	// NumLintConfigIgnores is the number of lint ignore paths and per-rule ignore paths.
+	// NumBreakingConfigExceptions is the number of breaking except rules, ignore paths,
+	// and per-rule ignore paths.
+	NumLintConfigIgnores        int `json:"num_lint_config_ignores" yaml:"num_lint_config_ignores"`
+	NumBreakingConfigExceptions int `json:"num_breaking_config_exceptions" yaml:"num_breaking_config_exceptions"`
 }
 
 // FileWalker goes through all .proto files for GetStats.
@@ -103,6 +124,11 @@ func MergeStats(statsSlice ...*Stats) *Stats {
 		resultStats.NumExtensions += stats.NumExtensions
 		resultStats.NumServices += stats.NumServices
 		resultStats.NumMethods += stats.NumMethods
+		resultStats.NumDeprecatedFields += stats.NumDeprecatedFields
+		resultStats.NumLintIgnoreComments += stats.NumLintIgnoreComments
+		resultStats.NumBreakingIgnoreComments += stats.NumBreakingIgnoreComments
+		resultStats.NumLintConfigIgnores += stats.NumLintConfigIgnores
+		resultStats.NumBreakingConfigExceptions += stats.NumBreakingConfigExceptions
 	}
 	return resultStats
 }
@@ -122,6 +148,7 @@ func newStatsBuilder() *statsBuilder {
 
 func examineFile(statsBuilder *statsBuilder, fileNode *ast.FileNode) {
 	statsBuilder.NumFiles++
+	examineComments(statsBuilder, fileNode)
 	for _, decl := range fileNode.Decls {
 		switch decl := decl.(type) {
 		case *ast.PackageNode:
@@ -148,18 +175,20 @@ func examineMessage(statsBuilder *statsBuilder, messageBody *ast.MessageBody) {
 	statsBuilder.NumMessages++
 	for _, decl := range messageBody.Decls {
 		switch decl := decl.(type) {
-		case *ast.FieldNode, *ast.MapFieldNode:
-			statsBuilder.NumFields++
+		case *ast.FieldNode:
+			examineField(statsBuilder, decl.Options)
+		case *ast.MapFieldNode:
+			examineField(statsBuilder, decl.Options)
 		case *ast.GroupNode:
-			statsBuilder.NumFields++
+			examineField(statsBuilder, decl.Options)
 			examineMessage(statsBuilder, &decl.MessageBody)
 		case *ast.OneOfNode:
 			for _, ooDecl := range decl.Decls {
 				switch ooDecl := ooDecl.(type) {
 				case *ast.FieldNode:
-					statsBuilder.NumFields++
+					examineField(statsBuilder, ooDecl.Options)
 				case *ast.GroupNode:
-					statsBuilder.NumFields++
+					examineField(statsBuilder, ooDecl.Options)
 					examineMessage(statsBuilder, &ooDecl.MessageBody)
 				}
 			}
@@ -173,6 +202,13 @@ func examineMessage(statsBuilder *statsBuilder, messageBody *ast.MessageBody) {
 	}
 }
 
+func examineField(statsBuilder *statsBuilder, optionsNode *ast.CompactOptionsNode) {
+	statsBuilder.NumFields++
+	if isDeprecated(optionsNode) {
+		statsBuilder.NumDeprecatedFields++
+	}
+}
+
 func examineEnum(statsBuilder *statsBuilder, enumNode *ast.EnumNode) {
 	statsBuilder.NumEnums++
 	for _, decl := range enumNode.Decls {
@@ -194,3 +230,49 @@ func examineExtend(statsBuilder *statsBuilder, extendNode *ast.ExtendNode) {
 		}
 	}
 }
+
+func examineComments(statsBuilder *statsBuilder, fileNode *ast.FileNode) {
+	items := fileNode.Items()
+	for item, ok := items.First(); ok; item, ok = items.Next(item) {
+		_, comment := fileNode.GetItem(item)
+		if !comment.IsValid() {
+			continue
+		}
+		for _, line := range strings.Split(comment.RawText(), "\n") {
+			line = strings.TrimSpace(line)
+			line = strings.TrimPrefix(line, "//")
+			line = strings.TrimPrefix(line, "/*")
+			line = strings.TrimPrefix(line, "*")
+			line = strings.TrimSpace(line)
+			switch {
+			case strings.HasPrefix(line, lintIgnorePrefix):
+				statsBuilder.NumLintIgnoreComments++
+			case strings.HasPrefix(line, breakingIgnorePrefix):
+				statsBuilder.NumBreakingIgnoreComments++
+			}
+		}
+	}
+}
+
+// isDeprecated returns true if the compact options contain deprecated = true.
+func isDeprecated(optionsNode *ast.CompactOptionsNode) bool {
+	if optionsNode == nil {
+		return false
+	}
+	for _, optionNode := range optionsNode.Options {
+		if optionNode.Name == nil || len(optionNode.Name.Parts) != 1 {
+			continue
+		}
+		part := optionNode.Name.Parts[0]
+		if part.IsExtension() || part.Name.AsIdentifier() != "deprecated" {
+			continue
+		}
+		if optionNode.Val == nil {
+			continue
+		}
+		if identifier, ok := optionNode.Val.Value().(ast.Identifier); ok && identifier == "true" {
+			return true
+		}
+	}
+	return false
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"os"
 
 	"github.com/bufbuild/buf/private/pkg/normalpath"
 	"github.com/bufbuild/buf/private/pkg/storage"
@@ -219,6 +220,41 @@ func Unzip(
 	return nil
 }
 
+// UnzipReader unzips the given zip archive from the reader into the bucket.
+//
+// Zip archives require random access to read the central directory at the end
+// of the archive. If the reader is an io.ReaderAt and the size is known, the
+// archive is read in place. Otherwise, the archive is streamed to a temporary
+// file first, so that memory usage is bounded regardless of the archive size.
+//
+// Size should be -1 if unknown.
+// See Unzip for the semantics of the remaining parameters.
+func UnzipReader(
+	ctx context.Context,
+	reader io.Reader,
+	size int64,
+	writeBucket storage.WriteBucket,
+	mapper storage.Mapper,
+	stripComponentCount uint32,
+) (retErr error) {
+	if readerAt, ok := reader.(io.ReaderAt); ok && size >= 0 {
+		return Unzip(ctx, readerAt, size, writeBucket, mapper, stripComponentCount)
+	}
+	file, err := os.CreateTemp("", "unzip-*.zip")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, file.Close())
+		retErr = multierr.Append(retErr, os.Remove(file.Name()))
+	}()
+	size, err = io.Copy(file, reader)
+	if err != nil {
+		return err
+	}
+	return Unzip(ctx, file, size, writeBucket, mapper, stripComponentCount)
+}
+
 func copyZipFile(
 	ctx context.Context,
 	writeBucket storage.WriteBucket,
@@ -981,6 +981,30 @@ func RunTestSuite(
 			readBucket = writeBucketToReadBucket(t, writeBucket)
 			AssertPathToContent(t, readBucket, testCase.prefix, testCase.expectedPathToContent)
 		})
+		t.Run(fmt.Sprintf("zip-reader-mapper-write%s", testCase.name), func(t *testing.T) {
+			t.Parallel()
+			readBucket := testCase.newReadBucketFunc(t)
+			writeBucket := newWriteBucket(t, defaultProvider)
+			buffer := bytes.NewBuffer(nil)
+			require.NoError(t, storagearchive.Zip(
+				context.Background(),
+				readBucket,
+				buffer,
+				true,
+			))
+			// bytes.Buffer is not an io.ReaderAt, and the size is unknown, so
+			// this spills the archive to a temporary file.
+			require.NoError(t, storagearchive.UnzipReader(
+				context.Background(),
+				buffer,
+				-1,
+				writeBucket,
+				storage.MapChain(testCase.mappers...),
+				testCase.stripComponentCount,
+			))
+			readBucket = writeBucketToReadBucket(t, writeBucket)
+			AssertPathToContent(t, readBucket, testCase.prefix, testCase.expectedPathToContent)
+		})
 	}
 
 	t.Run("diff", func(t *testing.T) {
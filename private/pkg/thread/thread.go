@@ -68,7 +68,11 @@ func Parallelize(ctx context.Context, jobs []func(context.Context) error, option
 	if multiplier < 1 {
 		multiplier = 1
 	}
-	semaphoreC := make(chan struct{}, Parallelism()*multiplier)
+	parallelism := parallelizeOptions.parallelism
+	if parallelism < 1 {
+		parallelism = Parallelism()
+	}
+	semaphoreC := make(chan struct{}, parallelism*multiplier)
 	var retErr error
 	var wg sync.WaitGroup
 	var lock sync.Mutex
@@ -127,6 +131,17 @@ func ParallelizeWithMultiplier(multiplier int) ParallelizeOption {
 	}
 }
 
+// ParallelizeWithParallelism returns a new ParallelizeOption that will use the
+// given parallelism instead of Parallelism() for the number of jobs that can be
+// run at once.
+//
+// A parallelism of <1 has no meaning.
+func ParallelizeWithParallelism(parallelism int) ParallelizeOption {
+	return func(parallelizeOptions *parallelizeOptions) {
+		parallelizeOptions.parallelism = parallelism
+	}
+}
+
 // ParallelizeWithCancel returns a new ParallelizeOption that will call the
 // given context.CancelFunc if any job fails.
 func ParallelizeWithCancel(cancel context.CancelFunc) ParallelizeOption {
@@ -136,8 +151,9 @@ func ParallelizeWithCancel(cancel context.CancelFunc) ParallelizeOption {
 }
 
 type parallelizeOptions struct {
-	multiplier int
-	cancel     context.CancelFunc
+	multiplier  int
+	parallelism int
+	cancel      context.CancelFunc
 }
 
 func newParallelizeOptions() *parallelizeOptions {
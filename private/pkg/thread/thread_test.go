@@ -17,6 +17,7 @@ package thread
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
@@ -40,3 +41,27 @@ func TestParallelizeWithImmediateCancellation(t *testing.T) {
 	assert.Nil(t, err, "parallelize error")
 	assert.Equal(t, int64(0), executed.Load(), "jobs executed")
 }
+
+func TestParallelizeWithParallelism(t *testing.T) {
+	t.Parallel()
+	var running atomic.Int64
+	var maxRunning atomic.Int64
+	var jobs []func(context.Context) error
+	for i := 0; i < 10; i++ {
+		jobs = append(jobs, func(_ context.Context) error {
+			current := running.Inc()
+			for {
+				previous := maxRunning.Load()
+				if current <= previous || maxRunning.CAS(previous, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Dec()
+			return nil
+		})
+	}
+	err := Parallelize(context.Background(), jobs, ParallelizeWithParallelism(2))
+	assert.Nil(t, err, "parallelize error")
+	assert.LessOrEqual(t, maxRunning.Load(), int64(2), "max jobs running at once")
+}
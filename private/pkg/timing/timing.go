@@ -0,0 +1,160 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package timing records the durations of the phases of a command.
+//
+// This backs the --timing flag. Phases are recorded via the Recorder attached
+// to the context, if any, so that packages deep in the call stack can record
+// durations without threading a Recorder through every function.
+package timing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	// PhaseFetch is the phase where inputs and dependencies are fetched.
+	PhaseFetch = "fetch"
+	// PhaseCompile is the phase where Protobuf sources are parsed and linked.
+	//
+	// Parsing and linking are interleaved per file by the compiler, so they
+	// are recorded together.
+	PhaseCompile = "compile"
+	// PhaseSourceCodeInfo is the phase where source code info is computed lazily.
+	PhaseSourceCodeInfo = "source_code_info"
+	// PhaseCheck is the phase where lint or breaking change checks are run.
+	PhaseCheck = "check"
+	// PhaseGenerate is the phase where plugins are run.
+	PhaseGenerate = "generate"
+)
+
+// Recorder records phase durations.
+//
+// Recorders are safe for concurrent use.
+type Recorder interface {
+	// Start starts timing the phase and returns a function that stops it.
+	//
+	// A phase may be started more than once, including concurrently. The
+	// recorded duration is the sum of all durations for the phase, so concurrent
+	// phases may sum to more than the wall-clock time of the command.
+	Start(phase string) func()
+	// PhaseDurations returns the recorded PhaseDurations in the order the
+	// phases were first started.
+	PhaseDurations() []*PhaseDuration
+}
+
+// NewRecorder returns a new Recorder.
+func NewRecorder() Recorder {
+	return newRecorder()
+}
+
+// PhaseDuration is the total duration of a phase.
+type PhaseDuration struct {
+	Phase    string
+	Duration time.Duration
+	// Count is the number of times the phase was started.
+	Count int
+}
+
+// WithRecorder returns a new context with the Recorder attached.
+func WithRecorder(ctx context.Context, recorder Recorder) context.Context {
+	return context.WithValue(ctx, recorderContextKey{}, recorder)
+}
+
+// Start starts timing the phase on the Recorder attached to the context and
+// returns a function that stops it.
+//
+// If no Recorder is attached, this is a no-op.
+func Start(ctx context.Context, phase string) func() {
+	if recorder, ok := ctx.Value(recorderContextKey{}).(Recorder); ok {
+		return recorder.Start(phase)
+	}
+	return func() {}
+}
+
+// Print prints the PhaseDurations to the Writer as a table.
+func Print(writer io.Writer, phaseDurations []*PhaseDuration) error {
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tabWriter, "PHASE\tDURATION\tCOUNT"); err != nil {
+		return err
+	}
+	for _, phaseDuration := range phaseDurations {
+		if _, err := fmt.Fprintf(
+			tabWriter,
+			"%s\t%v\t%d\n",
+			phaseDuration.Phase,
+			phaseDuration.Duration.Round(time.Microsecond),
+			phaseDuration.Count,
+		); err != nil {
+			return err
+		}
+	}
+	return tabWriter.Flush()
+}
+
+type recorderContextKey struct{}
+
+type recorder struct {
+	phaseToPhaseDuration map[string]*PhaseDuration
+	phases               []string
+	lock                 sync.Mutex
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		phaseToPhaseDuration: make(map[string]*PhaseDuration),
+	}
+}
+
+func (r *recorder) Start(phase string) func() {
+	r.lock.Lock()
+	if _, ok := r.phaseToPhaseDuration[phase]; !ok {
+		r.phaseToPhaseDuration[phase] = &PhaseDuration{
+			Phase: phase,
+		}
+		r.phases = append(r.phases, phase)
+	}
+	r.lock.Unlock()
+	start := time.Now()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.add(phase, time.Since(start))
+		})
+	}
+}
+
+func (r *recorder) PhaseDurations() []*PhaseDuration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	phaseDurations := make([]*PhaseDuration, len(r.phases))
+	for i, phase := range r.phases {
+		phaseDuration := *r.phaseToPhaseDuration[phase]
+		phaseDurations[i] = &phaseDuration
+	}
+	return phaseDurations
+}
+
+func (r *recorder) add(phase string, duration time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	phaseDuration := r.phaseToPhaseDuration[phase]
+	phaseDuration.Duration += duration
+	phaseDuration.Count++
+}
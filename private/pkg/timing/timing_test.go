@@ -0,0 +1,53 @@
+// Copyright 2020-2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timing
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	recorder := NewRecorder()
+	ctx := WithRecorder(context.Background(), recorder)
+	stopFetch := Start(ctx, PhaseFetch)
+	stopCompile := Start(ctx, PhaseCompile)
+	stopCompile()
+	// Stopping more than once only records the duration once.
+	stopCompile()
+	stopFetch()
+	Start(ctx, PhaseCompile)()
+	phaseDurations := recorder.PhaseDurations()
+	require.Len(t, phaseDurations, 2)
+	assert.Equal(t, PhaseFetch, phaseDurations[0].Phase)
+	assert.Equal(t, 1, phaseDurations[0].Count)
+	assert.Equal(t, PhaseCompile, phaseDurations[1].Phase)
+	assert.Equal(t, 2, phaseDurations[1].Count)
+	buffer := bytes.NewBuffer(nil)
+	require.NoError(t, Print(buffer, phaseDurations))
+	assert.Contains(t, buffer.String(), "PHASE")
+	assert.Contains(t, buffer.String(), PhaseCompile)
+}
+
+func TestStartWithoutRecorder(t *testing.T) {
+	t.Parallel()
+	// Must not panic.
+	Start(context.Background(), PhaseCheck)()
+}
@@ -16,14 +16,37 @@ package httpclient
 
 import (
 	"crypto/tls"
+	"net"
 	"net/http"
+	"time"
 )
 
-func newClient(clientTLSConfig *tls.Config) *http.Client {
+func newClient(clientTLSConfig *tls.Config, options ...ClientOption) *http.Client {
+	clientOptions := newClientOptions()
+	for _, option := range options {
+		option(clientOptions)
+	}
+	transport := &http.Transport{
+		TLSClientConfig:       clientTLSConfig,
+		Proxy:                 http.ProxyFromEnvironment,
+		TLSHandshakeTimeout:   clientOptions.connectTimeout,
+		ResponseHeaderTimeout: clientOptions.readTimeout,
+	}
+	if clientOptions.connectTimeout > 0 {
+		transport.DialContext = (&net.Dialer{
+			Timeout: clientOptions.connectTimeout,
+		}).DialContext
+	}
 	return &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: clientTLSConfig,
-			Proxy:           http.ProxyFromEnvironment,
-		},
+		Transport: transport,
 	}
 }
+
+type clientOptions struct {
+	connectTimeout time.Duration
+	readTimeout    time.Duration
+}
+
+func newClientOptions() *clientOptions {
+	return &clientOptions{}
+}
@@ -17,9 +17,33 @@ package httpclient
 import (
 	"crypto/tls"
 	"net/http"
+	"time"
 )
 
 // NewClient returns a new Client.
-func NewClient(clientTLSConfig *tls.Config) *http.Client {
-	return newClient(clientTLSConfig)
+func NewClient(clientTLSConfig *tls.Config, options ...ClientOption) *http.Client {
+	return newClient(clientTLSConfig, options...)
+}
+
+// ClientOption is an option for a new Client.
+type ClientOption func(*clientOptions)
+
+// WithConnectTimeout returns a new ClientOption that limits the time spent
+// establishing a connection, including the TLS handshake.
+//
+// The default is to not time out.
+func WithConnectTimeout(connectTimeout time.Duration) ClientOption {
+	return func(clientOptions *clientOptions) {
+		clientOptions.connectTimeout = connectTimeout
+	}
+}
+
+// WithReadTimeout returns a new ClientOption that limits the time spent waiting
+// for the response headers after the request has been written.
+//
+// The default is to not time out.
+func WithReadTimeout(readTimeout time.Duration) ClientOption {
+	return func(clientOptions *clientOptions) {
+		clientOptions.readTimeout = readTimeout
+	}
 }